@@ -8,12 +8,20 @@ import (
 
 	authHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/auth/delivery/grpc"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/bootstrap"
+	categoryHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/category/delivery/grpc"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/config"
 	listingHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/listing/delivery/grpc"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
+	notificationHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/notification/delivery/grpc"
+	uploadHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/delivery/grpc"
+	userHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/user/delivery/grpc"
 	auth_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/auth"
+	categories_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/categories"
 	listings_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/listings"
+	notifications_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/notifications"
+	uploads_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/uploads"
+	users_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/users"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
@@ -32,6 +40,11 @@ func StartGRPCServer(ctx context.Context, cfg *config.Config, services *bootstra
 			middleware.UnaryLoggerInterceptor(appLogger),
 			middleware.AuthInterceptor(services.AuthUseCase, appLogger),
 		),
+		grpc.ChainStreamInterceptor(
+			middleware.ValidationStreamInterceptor(appLogger),
+			middleware.StreamLoggerInterceptor(appLogger),
+			middleware.AuthStreamInterceptor(services.AuthUseCase, appLogger),
+		),
 		grpc.KeepaliveParams(keepAliveParams),
 	)
 
@@ -41,6 +54,18 @@ func StartGRPCServer(ctx context.Context, cfg *config.Config, services *bootstra
 	listingServer := listingHandler.New(services.ListingsUseCase, appLogger)
 	listings_pb.RegisterListingsServiceServer(grpcServer, listingServer)
 
+	categoryServer := categoryHandler.New(services.CategoriesUseCase, appLogger)
+	categories_pb.RegisterCategoriesServiceServer(grpcServer, categoryServer)
+
+	uploadServer := uploadHandler.New(services.UploadsUseCase, appLogger)
+	uploads_pb.RegisterUploadsServiceServer(grpcServer, uploadServer)
+
+	notificationServer := notificationHandler.New(services.NotificationsUseCase, appLogger)
+	notifications_pb.RegisterNotificationsServiceServer(grpcServer, notificationServer)
+
+	userServer := userHandler.New(services.UsersUseCase, appLogger)
+	users_pb.RegisterUsersServiceServer(grpcServer, userServer)
+
 	reflection.Register(grpcServer)
 
 	addr := cfg.GRPC.Host + ":" + cfg.GRPC.Port
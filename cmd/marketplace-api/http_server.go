@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -17,8 +18,13 @@ import (
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/config"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
+	uploadHandler "github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/delivery/http"
 	auth_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/auth"
+	categories_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/categories"
 	listings_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/listings"
+	notifications_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/notifications"
+	uploads_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/uploads"
+	users_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/users"
 )
 
 // StartHTTPServer запускает HTTP Gateway и Swagger UI
@@ -47,11 +53,50 @@ func StartHTTPServer(ctx context.Context, cfg *config.Config, appLogger *logger.
 		return err
 	}
 
+	if err := categories_pb.RegisterCategoriesServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		appLogger.Error(ctx, "Не удалось зарегистрировать Categories сервис для gRPC-gateway",
+			zap.String("endpoint", grpcEndpoint),
+			zap.Error(err))
+		return err
+	}
+
+	if err := notifications_pb.RegisterNotificationsServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		appLogger.Error(ctx, "Не удалось зарегистрировать Notifications сервис для gRPC-gateway",
+			zap.String("endpoint", grpcEndpoint),
+			zap.Error(err))
+		return err
+	}
+
+	if err := users_pb.RegisterUsersServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		appLogger.Error(ctx, "Не удалось зарегистрировать Users сервис для gRPC-gateway",
+			zap.String("endpoint", grpcEndpoint),
+			zap.Error(err))
+		return err
+	}
+
+	// Multipart-загрузка не описывается аннотациями gRPC-gateway, поэтому обработчик сам передает файл в gRPC-поток
+	uploadsConn, err := grpc.NewClient(grpcEndpoint, opts...)
+	if err != nil {
+		appLogger.Error(ctx, "Не удалось создать gRPC клиент для загрузки файлов",
+			zap.String("endpoint", grpcEndpoint),
+			zap.Error(err))
+		return err
+	}
+	defer uploadsConn.Close()
+
+	uploads := uploadHandler.New(uploads_pb.NewUploadsServiceClient(uploadsConn), mux, cfg.Uploads.MaxSize, appLogger)
+
 	router := chi.NewRouter()
 	router.Use(middleware.HTTPLoggerMiddleware(appLogger))
 
 	router.Mount("/", mux)
 
+	router.Post("/v1/uploads/images", uploads.UploadImage)
+
+	if cfg.Storage.Backend == "local" {
+		router.Handle("/media/*", http.StripPrefix("/media/", mediaHandler(cfg.Storage.LocalDir)))
+	}
+
 	router.Get("/swagger/auth.json", func(w http.ResponseWriter, r *http.Request) {
 		b, err := os.ReadFile(cfg.Swagger.AuthPath)
 		if err != nil {
@@ -84,6 +129,70 @@ func StartHTTPServer(ctx context.Context, cfg *config.Config, appLogger *logger.
 		}
 	})
 
+	router.Get("/swagger/categories.json", func(w http.ResponseWriter, r *http.Request) {
+		b, err := os.ReadFile(cfg.Swagger.CategoriesPath)
+		if err != nil {
+			appLogger.Error(r.Context(), "Ошибка чтения swagger.json",
+				zap.String("path", cfg.Swagger.CategoriesPath),
+				zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, writeErr := w.Write(b)
+		if writeErr != nil {
+			appLogger.Error(r.Context(), "Ошибка записи ответа", zap.Error(writeErr))
+		}
+	})
+
+	router.Get("/swagger/notifications.json", func(w http.ResponseWriter, r *http.Request) {
+		b, err := os.ReadFile(cfg.Swagger.NotificationsPath)
+		if err != nil {
+			appLogger.Error(r.Context(), "Ошибка чтения swagger.json",
+				zap.String("path", cfg.Swagger.NotificationsPath),
+				zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, writeErr := w.Write(b)
+		if writeErr != nil {
+			appLogger.Error(r.Context(), "Ошибка записи ответа", zap.Error(writeErr))
+		}
+	})
+
+	router.Get("/swagger/users.json", func(w http.ResponseWriter, r *http.Request) {
+		b, err := os.ReadFile(cfg.Swagger.UsersPath)
+		if err != nil {
+			appLogger.Error(r.Context(), "Ошибка чтения swagger.json",
+				zap.String("path", cfg.Swagger.UsersPath),
+				zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, writeErr := w.Write(b)
+		if writeErr != nil {
+			appLogger.Error(r.Context(), "Ошибка записи ответа", zap.Error(writeErr))
+		}
+	})
+
+	router.Get("/swagger/uploads.json", func(w http.ResponseWriter, r *http.Request) {
+		b, err := os.ReadFile(cfg.Swagger.UploadsPath)
+		if err != nil {
+			appLogger.Error(r.Context(), "Ошибка чтения swagger.json",
+				zap.String("path", cfg.Swagger.UploadsPath),
+				zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, writeErr := w.Write(b)
+		if writeErr != nil {
+			appLogger.Error(r.Context(), "Ошибка записи ответа", zap.Error(writeErr))
+		}
+	})
+
 	router.Get("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
 		b, err := os.ReadFile(cfg.Swagger.AuthPath)
 		if err != nil {
@@ -112,6 +221,22 @@ func StartHTTPServer(ctx context.Context, cfg *config.Config, appLogger *logger.
 		httpSwagger.URL("/swagger/listings.json"),
 	))
 
+	router.Get("/swagger/categories/*", httpSwagger.Handler(
+		httpSwagger.URL("/swagger/categories.json"),
+	))
+
+	router.Get("/swagger/notifications/*", httpSwagger.Handler(
+		httpSwagger.URL("/swagger/notifications.json"),
+	))
+
+	router.Get("/swagger/users/*", httpSwagger.Handler(
+		httpSwagger.URL("/swagger/users.json"),
+	))
+
+	router.Get("/swagger/uploads/*", httpSwagger.Handler(
+		httpSwagger.URL("/swagger/uploads.json"),
+	))
+
 	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, "/swagger/", http.StatusMovedPermanently)
 	})
@@ -156,3 +281,16 @@ func StartHTTPServer(ctx context.Context, cfg *config.Config, appLogger *logger.
 		return nil
 	}
 }
+
+// mediaHandler раздает файлы локального хранилища без вывода содержимого каталогов
+func mediaHandler(dir string) http.Handler {
+	fileServer := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "" || strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		fileServer.ServeHTTP(w, r)
+	})
+}
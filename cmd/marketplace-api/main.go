@@ -8,7 +8,11 @@ import (
 	"sync"
 	"syscall"
 
+	authWorker "github.com/Snake1-1eyes/vk_task_marketplace/internal/auth/worker"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/bootstrap"
+	currencyWorker "github.com/Snake1-1eyes/vk_task_marketplace/internal/currency/worker"
+	listingWorker "github.com/Snake1-1eyes/vk_task_marketplace/internal/listing/worker"
+	uploadWorker "github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/worker"
 	"go.uber.org/zap"
 )
 
@@ -34,8 +38,15 @@ func main() {
 
 	services := bootstrap.InitializeServices(ctx, cfg, repos, appLogger)
 
+	purger := listingWorker.NewPurger(services.ListingsUseCase, cfg.Listings.PurgeInterval, appLogger)
+	suggestionsRefresher := listingWorker.NewSuggestionsRefresher(services.ListingsUseCase, cfg.Listings.SuggestionsRefreshInterval, appLogger)
+	ratesRefresher := currencyWorker.NewRatesRefresher(services.CurrencyUseCase, cfg.Currency.RefreshInterval, appLogger)
+	variantsProcessor := uploadWorker.NewVariantsProcessor(services.UploadsUseCase, cfg.Uploads.ProcessInterval, appLogger)
+	savedSearchMatcher := listingWorker.NewSavedSearchMatcher(services.ListingsUseCase, cfg.Listings.SavedSearchesInterval, appLogger)
+	refreshTokensPurger := authWorker.NewPurger(services.AuthUseCase, cfg.JWT.RefreshTokenPurgeInterval, appLogger)
+
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(8)
 
 	go func() {
 		defer wg.Done()
@@ -53,6 +64,36 @@ func main() {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+		purger.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		suggestionsRefresher.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		ratesRefresher.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		variantsProcessor.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		savedSearchMatcher.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		refreshTokensPurger.Run(ctx)
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -0,0 +1,61 @@
+package adapter
+
+import (
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	categories_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/categories"
+)
+
+// MapCategoryToProto преобразует внутреннюю модель категории в proto-объект
+func MapCategoryToProto(category *entity.Category) *categories_pb.Category {
+	return &categories_pb.Category{
+		Id:       category.ID,
+		ParentId: category.ParentID,
+		Name:     category.Name,
+		Slug:     category.Slug,
+	}
+}
+
+// MapCategoryNodeToProto рекурсивно преобразует узел дерева категорий в proto-объект
+func MapCategoryNodeToProto(node *entity.CategoryNode) *categories_pb.CategoryNode {
+	result := &categories_pb.CategoryNode{
+		Category: MapCategoryToProto(node.Category),
+		Children: make([]*categories_pb.CategoryNode, 0, len(node.Children)),
+	}
+
+	for _, child := range node.Children {
+		result.Children = append(result.Children, MapCategoryNodeToProto(child))
+	}
+
+	return result
+}
+
+// MapCategoryAttributeToProto преобразует атрибут категории в proto-объект
+func MapCategoryAttributeToProto(attribute *entity.CategoryAttribute) *categories_pb.CategoryAttribute {
+	return &categories_pb.CategoryAttribute{
+		Key:        attribute.Key,
+		Name:       attribute.Name,
+		Type:       MapAttributeTypeToProto(attribute.Type),
+		Required:   attribute.Required,
+		MinValue:   attribute.MinValue,
+		MaxValue:   attribute.MaxValue,
+		MaxLength:  attribute.MaxLength,
+		Options:    attribute.Options,
+		CategoryId: attribute.CategoryID,
+	}
+}
+
+// MapAttributeTypeToProto преобразует тип атрибута в proto-перечисление
+func MapAttributeTypeToProto(attributeType entity.AttributeType) categories_pb.AttributeType {
+	switch attributeType {
+	case entity.AttributeTypeString:
+		return categories_pb.AttributeType_ATTRIBUTE_TYPE_STRING
+	case entity.AttributeTypeNumber:
+		return categories_pb.AttributeType_ATTRIBUTE_TYPE_NUMBER
+	case entity.AttributeTypeEnum:
+		return categories_pb.AttributeType_ATTRIBUTE_TYPE_ENUM
+	case entity.AttributeTypeBool:
+		return categories_pb.AttributeType_ATTRIBUTE_TYPE_BOOL
+	default:
+		return categories_pb.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+	}
+}
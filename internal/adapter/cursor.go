@@ -0,0 +1,44 @@
+package adapter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+// EncodeListingCursor преобразует курсор ленты в непрозрачный токен страницы.
+// Для nil-курсора возвращается пустая строка
+func EncodeListingCursor(cursor *entity.ListingCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeListingCursor восстанавливает курсор ленты из токена страницы.
+// Для пустого токена возвращается nil
+func DecodeListingCursor(token string) (*entity.ListingCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "некорректный токен страницы")
+	}
+
+	cursor := &entity.ListingCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID == 0 {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "некорректный токен страницы")
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,76 @@
+package adapter
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+func TestListingCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 8, 20, 10, 30, 15, 123456000, time.UTC)
+
+	tests := []struct {
+		name   string
+		cursor *entity.ListingCursor
+	}{
+		{name: "по дате создания", cursor: &entity.ListingCursor{SortBy: "created_at", SortDesc: true, CreatedAt: createdAt, ID: 15}},
+		{name: "по цене", cursor: &entity.ListingCursor{SortBy: "price", CreatedAt: createdAt, Price: 7_500_050, ID: 3}},
+		{name: "по цене в валюте отображения", cursor: &entity.ListingCursor{SortBy: "price", SortDesc: true, CreatedAt: createdAt, Price: 99, Currency: "USD", ID: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := EncodeListingCursor(tt.cursor)
+			got, err := DecodeListingCursor(token)
+			if err != nil {
+				t.Fatalf("DecodeListingCursor(%q): %v", token, err)
+			}
+			if *got != *tt.cursor {
+				t.Fatalf("курсор %+v, ожидался %+v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeListingCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		wantNil bool
+		wantErr error
+	}{
+		{name: "пустой токен", token: "", wantNil: true},
+		{name: "не base64", token: "!!!", wantErr: app_errors.ErrValidation},
+		{name: "не JSON", token: base64.RawURLEncoding.EncodeToString([]byte("page=2")), wantErr: app_errors.ErrValidation},
+		{name: "без ID", token: base64.RawURLEncoding.EncodeToString([]byte(`{"sort_by":"price"}`)), wantErr: app_errors.ErrValidation},
+		{name: "стандартный base64 с дополнением", token: base64.StdEncoding.EncodeToString([]byte(`{"id":1}`)), wantErr: app_errors.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeListingCursor(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ошибка %v, ожидалась %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if tt.wantNil && got != nil {
+				t.Fatalf("курсор %+v, ожидался nil", got)
+			}
+		})
+	}
+}
+
+func TestEncodeListingCursorNil(t *testing.T) {
+	if token := EncodeListingCursor(nil); token != "" {
+		t.Fatalf("токен %q для nil-курсора, ожидалась пустая строка", token)
+	}
+}
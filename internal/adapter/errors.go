@@ -17,15 +17,19 @@ import (
 )
 
 const (
-	ErrorCodeUserNotFound       = "USER_NOT_FOUND"
-	ErrorCodeUserAlreadyExists  = "USER_ALREADY_EXISTS"
-	ErrorCodeListingNotFound    = "LISTING_NOT_FOUND"
-	ErrorCodeInvalidCredentials = "INVALID_CREDENTIALS"
-	ErrorCodeInvalidToken       = "INVALID_TOKEN"
-	ErrorCodeUnauthorized       = "UNAUTHORIZED"
-	ErrorCodeForbidden          = "FORBIDDEN"
-	ErrorCodeValidationFailed   = "VALIDATION_FAILED"
-	ErrorCodeInternalError      = "INTERNAL_ERROR"
+	ErrorCodeUserNotFound        = "USER_NOT_FOUND"
+	ErrorCodeUserAlreadyExists   = "USER_ALREADY_EXISTS"
+	ErrorCodeListingNotFound     = "LISTING_NOT_FOUND"
+	ErrorCodeCategoryNotFound    = "CATEGORY_NOT_FOUND"
+	ErrorCodeImageNotFound       = "IMAGE_NOT_FOUND"
+	ErrorCodeSavedSearchNotFound = "SAVED_SEARCH_NOT_FOUND"
+	ErrorCodeInvalidCredentials  = "INVALID_CREDENTIALS"
+	ErrorCodeInvalidToken        = "INVALID_TOKEN"
+	ErrorCodeUnauthorized        = "UNAUTHORIZED"
+	ErrorCodeForbidden           = "FORBIDDEN"
+	ErrorCodeValidationFailed    = "VALIDATION_FAILED"
+	ErrorCodeConflict            = "CONFLICT"
+	ErrorCodeInternalError       = "INTERNAL_ERROR"
 )
 
 // ErrorResponse представляет формат ошибки для JSON-ответа
@@ -45,6 +49,12 @@ func ExtractErrorCode(err error) string {
 		return ErrorCodeUserAlreadyExists
 	case errors.Is(err, apperrors.ErrListingNotFound):
 		return ErrorCodeListingNotFound
+	case errors.Is(err, apperrors.ErrCategoryNotFound):
+		return ErrorCodeCategoryNotFound
+	case errors.Is(err, apperrors.ErrImageNotFound):
+		return ErrorCodeImageNotFound
+	case errors.Is(err, apperrors.ErrSavedSearchNotFound):
+		return ErrorCodeSavedSearchNotFound
 	case errors.Is(err, apperrors.ErrInvalidCredentials):
 		return ErrorCodeInvalidCredentials
 	case errors.Is(err, apperrors.ErrInvalidToken):
@@ -55,6 +65,8 @@ func ExtractErrorCode(err error) string {
 		return ErrorCodeForbidden
 	case errors.Is(err, apperrors.ErrValidation):
 		return ErrorCodeValidationFailed
+	case errors.Is(err, apperrors.ErrConflict):
+		return ErrorCodeConflict
 	default:
 		return ErrorCodeInternalError
 	}
@@ -84,7 +96,7 @@ func MapError(err error) error {
 // mapErrorCodeToGRPCCode преобразует код ошибки в gRPC код
 func mapErrorCodeToGRPCCode(code string) codes.Code {
 	switch code {
-	case ErrorCodeUserNotFound, ErrorCodeListingNotFound:
+	case ErrorCodeUserNotFound, ErrorCodeListingNotFound, ErrorCodeCategoryNotFound, ErrorCodeImageNotFound, ErrorCodeSavedSearchNotFound:
 		return codes.NotFound
 	case ErrorCodeUserAlreadyExists:
 		return codes.AlreadyExists
@@ -94,6 +106,8 @@ func mapErrorCodeToGRPCCode(code string) codes.Code {
 		return codes.PermissionDenied
 	case ErrorCodeValidationFailed:
 		return codes.InvalidArgument
+	case ErrorCodeConflict:
+		return codes.Aborted
 	default:
 		return codes.Internal
 	}
@@ -0,0 +1,195 @@
+package adapter
+
+import (
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	listings_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/listings"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MapListingToProto преобразует внутреннюю модель объявления в proto-объект
+func MapListingToProto(listing *entity.Listing, userID uint64) *listings_pb.ListingResponse {
+	response := &listings_pb.ListingResponse{
+		Id:             listing.ID,
+		Title:          listing.Title,
+		Description:    listing.Description,
+		ImageUrl:       listing.ImageURL,
+		CoverImageUrl:  listing.ImageURL,
+		Price:          MapMoneyToProto(listing.Price),
+		AuthorUsername: listing.AuthorUsername,
+		CreatedAt:      timestamppb.New(listing.CreatedAt),
+		IsOwner:        userID != 0 && listing.AuthorID == userID,
+		Status:         MapListingStatusToProto(listing.Status),
+		CategoryId:     listing.CategoryID,
+		Version:        listing.Version,
+		UpdatedAt:      timestamppb.New(listing.UpdatedAt),
+		IsFavorite:     listing.IsFavorite,
+		FavoritesCount: listing.FavoritesCount,
+	}
+
+	if attributes, err := structpb.NewStruct(listing.Attributes); err == nil {
+		response.Attributes = attributes
+	}
+
+	for _, image := range listing.Images {
+		response.Images = append(response.Images, &listings_pb.ListingImage{
+			Id:       image.ID,
+			Url:      image.URL,
+			Position: image.Position,
+			Variants: MapImageVariantsToProto(image.Variants),
+		})
+	}
+
+	response.CoverVariants = MapImageVariantsToProto(listing.CoverVariants)
+
+	if listing.DisplayPrice != nil {
+		response.DisplayPrice = MapMoneyToProto(*listing.DisplayPrice)
+	}
+
+	if listing.Highlight != nil {
+		response.Highlight = &listings_pb.ListingHighlight{
+			Title:       listing.Highlight.Title,
+			Description: listing.Highlight.Description,
+		}
+	}
+
+	return response
+}
+
+// MapFavoriteStateToProto преобразует состояние избранного в proto-объект
+func MapFavoriteStateToProto(state *entity.FavoriteState) *listings_pb.FavoriteResponse {
+	response := &listings_pb.FavoriteResponse{
+		ListingId:      state.ListingID,
+		IsFavorite:     state.IsFavorite,
+		FavoritesCount: state.FavoritesCount,
+	}
+
+	if state.AlertPrice != nil {
+		response.AlertPrice = MapMoneyToProto(*state.AlertPrice)
+	}
+
+	return response
+}
+
+// MapImageVariantsToProto преобразует уменьшенные копии изображения в proto-объекты
+func MapImageVariantsToProto(variants []*entity.ImageVariant) []*listings_pb.ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	result := make([]*listings_pb.ImageVariant, 0, len(variants))
+	for _, variant := range variants {
+		result = append(result, &listings_pb.ImageVariant{
+			Name:        variant.Name,
+			Url:         variant.URL,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			ContentType: variant.ContentType,
+		})
+	}
+	return result
+}
+
+// MapListingStatusToProto преобразует статус объявления в proto-перечисление
+func MapListingStatusToProto(status entity.ListingStatus) listings_pb.ListingStatus {
+	switch status {
+	case entity.ListingStatusDraft:
+		return listings_pb.ListingStatus_LISTING_STATUS_DRAFT
+	case entity.ListingStatusActive:
+		return listings_pb.ListingStatus_LISTING_STATUS_ACTIVE
+	case entity.ListingStatusReserved:
+		return listings_pb.ListingStatus_LISTING_STATUS_RESERVED
+	case entity.ListingStatusSold:
+		return listings_pb.ListingStatus_LISTING_STATUS_SOLD
+	case entity.ListingStatusArchived:
+		return listings_pb.ListingStatus_LISTING_STATUS_ARCHIVED
+	default:
+		return listings_pb.ListingStatus_LISTING_STATUS_UNSPECIFIED
+	}
+}
+
+// MapListingStatusFromProto преобразует proto-перечисление в статус объявления.
+// Для неуказанного статуса возвращается пустая строка
+func MapListingStatusFromProto(status listings_pb.ListingStatus) entity.ListingStatus {
+	switch status {
+	case listings_pb.ListingStatus_LISTING_STATUS_DRAFT:
+		return entity.ListingStatusDraft
+	case listings_pb.ListingStatus_LISTING_STATUS_ACTIVE:
+		return entity.ListingStatusActive
+	case listings_pb.ListingStatus_LISTING_STATUS_RESERVED:
+		return entity.ListingStatusReserved
+	case listings_pb.ListingStatus_LISTING_STATUS_SOLD:
+		return entity.ListingStatusSold
+	case listings_pb.ListingStatus_LISTING_STATUS_ARCHIVED:
+		return entity.ListingStatusArchived
+	default:
+		return ""
+	}
+}
+
+// MapListingFacetsToProto преобразует фасеты ленты объявлений в proto-объект
+func MapListingFacetsToProto(facets *entity.ListingFacets) *listings_pb.ListingFacetsResponse {
+	response := &listings_pb.ListingFacetsResponse{
+		Total:          facets.Total,
+		Categories:     make([]*listings_pb.CategoryFacet, 0, len(facets.Categories)),
+		Statuses:       make([]*listings_pb.StatusFacet, 0, len(facets.Statuses)),
+		PriceHistogram: make([]*listings_pb.PriceBucket, 0, len(facets.PriceHistogram)),
+	}
+
+	for _, facet := range facets.Categories {
+		response.Categories = append(response.Categories, &listings_pb.CategoryFacet{
+			CategoryId: facet.CategoryID,
+			Name:       facet.Name,
+			Count:      facet.Count,
+		})
+	}
+
+	for _, facet := range facets.Statuses {
+		response.Statuses = append(response.Statuses, &listings_pb.StatusFacet{
+			Status: MapListingStatusToProto(facet.Status),
+			Count:  facet.Count,
+		})
+	}
+
+	for _, bucket := range facets.PriceHistogram {
+		result := &listings_pb.PriceBucket{Count: bucket.Count}
+		if bucket.From != nil {
+			result.From = MapMoneyToProto(*bucket.From)
+		}
+		if bucket.To != nil {
+			result.To = MapMoneyToProto(*bucket.To)
+		}
+		response.PriceHistogram = append(response.PriceHistogram, result)
+	}
+
+	return response
+}
+
+// MapTotalModeToProto преобразует способ подсчета total в proto-перечисление
+func MapTotalModeToProto(mode entity.TotalMode) listings_pb.TotalMode {
+	switch mode {
+	case entity.TotalModeExact:
+		return listings_pb.TotalMode_TOTAL_MODE_EXACT
+	case entity.TotalModeEstimated:
+		return listings_pb.TotalMode_TOTAL_MODE_ESTIMATED
+	case entity.TotalModeNone:
+		return listings_pb.TotalMode_TOTAL_MODE_NONE
+	default:
+		return listings_pb.TotalMode_TOTAL_MODE_UNSPECIFIED
+	}
+}
+
+// MapTotalModeFromProto преобразует proto-перечисление в способ подсчета total.
+// Для неуказанного способа возвращается пустая строка
+func MapTotalModeFromProto(mode listings_pb.TotalMode) entity.TotalMode {
+	switch mode {
+	case listings_pb.TotalMode_TOTAL_MODE_EXACT:
+		return entity.TotalModeExact
+	case listings_pb.TotalMode_TOTAL_MODE_ESTIMATED:
+		return entity.TotalModeEstimated
+	case listings_pb.TotalMode_TOTAL_MODE_NONE:
+		return entity.TotalModeNone
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,48 @@
+package adapter
+
+import (
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	listings_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/listings"
+)
+
+const (
+	// nanosPerMinorUnit количество nanos в одной минимальной единице валюты
+	nanosPerMinorUnit = 1_000_000_000 / entity.MoneyScale
+	// maxMoneyUnits наибольшая целая часть суммы, которую вмещает столбец цены NUMERIC(10,2)
+	maxMoneyUnits = 99_999_999
+)
+
+// MapMoneyToProto преобразует денежную сумму в proto-объект
+func MapMoneyToProto(money entity.Money) *listings_pb.Money {
+	return &listings_pb.Money{
+		Units:        money.Amount / entity.MoneyScale,
+		Nanos:        int32(money.Amount%entity.MoneyScale) * nanosPerMinorUnit,
+		CurrencyCode: money.Currency,
+	}
+}
+
+// MapMoneyFromProto преобразует proto-объект в денежную сумму.
+// Для nil возвращается nil, дробная часть точнее минимальной единицы валюты считается ошибкой
+func MapMoneyFromProto(money *listings_pb.Money) (*entity.Money, error) {
+	if money == nil {
+		return nil, nil
+	}
+
+	if money.Units < 0 || money.Units > maxMoneyUnits {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "сумма вне допустимого диапазона")
+	}
+
+	if money.Nanos < 0 || money.Nanos >= 1_000_000_000 {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "дробная часть суммы вне допустимого диапазона")
+	}
+
+	if money.Nanos%nanosPerMinorUnit != 0 {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "цена указывается с точностью до сотых")
+	}
+
+	return &entity.Money{
+		Amount:   money.Units*entity.MoneyScale + int64(money.Nanos/nanosPerMinorUnit),
+		Currency: money.CurrencyCode,
+	}, nil
+}
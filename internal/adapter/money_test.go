@@ -0,0 +1,108 @@
+package adapter
+
+import (
+	"errors"
+	"testing"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	listings_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/listings"
+)
+
+func TestMapMoneyFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		money   *listings_pb.Money
+		want    *entity.Money
+		wantErr error
+	}{
+		{name: "nil", money: nil, want: nil},
+		{name: "ноль", money: &listings_pb.Money{CurrencyCode: "RUB"}, want: &entity.Money{Amount: 0, Currency: "RUB"}},
+		{
+			name:  "целая и дробная часть",
+			money: &listings_pb.Money{Units: 75000, Nanos: 500_000_000, CurrencyCode: "RUB"},
+			want:  &entity.Money{Amount: 7_500_050, Currency: "RUB"},
+		},
+		{
+			name:  "одна копейка",
+			money: &listings_pb.Money{Nanos: 10_000_000, CurrencyCode: "USD"},
+			want:  &entity.Money{Amount: 1, Currency: "USD"},
+		},
+		{
+			name:  "наибольшая сумма столбца цены",
+			money: &listings_pb.Money{Units: 99_999_999, Nanos: 990_000_000, CurrencyCode: "RUB"},
+			want:  &entity.Money{Amount: 9_999_999_999, Currency: "RUB"},
+		},
+		{name: "без валюты", money: &listings_pb.Money{Units: 10}, want: &entity.Money{Amount: 1000}},
+		{
+			name:    "точнее копейки",
+			money:   &listings_pb.Money{Units: 1, Nanos: 5_000_000},
+			wantErr: app_errors.ErrValidation,
+		},
+		{name: "отрицательная целая часть", money: &listings_pb.Money{Units: -1}, wantErr: app_errors.ErrValidation},
+		{name: "отрицательная дробная часть", money: &listings_pb.Money{Nanos: -10_000_000}, wantErr: app_errors.ErrValidation},
+		{name: "дробная часть больше единицы", money: &listings_pb.Money{Nanos: 1_000_000_000}, wantErr: app_errors.ErrValidation},
+		{name: "больше столбца цены", money: &listings_pb.Money{Units: 100_000_000}, wantErr: app_errors.ErrValidation},
+		{name: "переполнение int64", money: &listings_pb.Money{Units: 92_233_720_368_547_758}, wantErr: app_errors.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MapMoneyFromProto(tt.money)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ошибка %v, ожидалась %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("MapMoneyFromProto() = %+v, ожидалось %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapMoneyToProto(t *testing.T) {
+	tests := []struct {
+		name  string
+		money entity.Money
+		want  *listings_pb.Money
+	}{
+		{name: "ноль", money: entity.Money{Currency: "RUB"}, want: &listings_pb.Money{CurrencyCode: "RUB"}},
+		{
+			name:  "целая и дробная часть",
+			money: entity.Money{Amount: 7_500_050, Currency: "RUB"},
+			want:  &listings_pb.Money{Units: 75000, Nanos: 500_000_000, CurrencyCode: "RUB"},
+		},
+		{
+			name:  "только копейки",
+			money: entity.Money{Amount: 99, Currency: "EUR"},
+			want:  &listings_pb.Money{Units: 0, Nanos: 990_000_000, CurrencyCode: "EUR"},
+		},
+		{
+			name:  "наибольшая сумма столбца цены",
+			money: entity.Money{Amount: 9_999_999_999, Currency: "RUB"},
+			want:  &listings_pb.Money{Units: 99_999_999, Nanos: 990_000_000, CurrencyCode: "RUB"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapMoneyToProto(tt.money)
+			if got.Units != tt.want.Units || got.Nanos != tt.want.Nanos || got.CurrencyCode != tt.want.CurrencyCode {
+				t.Fatalf("MapMoneyToProto() = %v, ожидалось %v", got, tt.want)
+			}
+
+			back, err := MapMoneyFromProto(got)
+			if err != nil {
+				t.Fatalf("обратное преобразование: %v", err)
+			}
+			if *back != tt.money {
+				t.Fatalf("обратное преобразование = %+v, ожидалось %+v", *back, tt.money)
+			}
+		})
+	}
+}
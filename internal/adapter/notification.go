@@ -0,0 +1,43 @@
+package adapter
+
+import (
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	notifications_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/notifications"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MapNotificationToProto преобразует уведомление в proto-объект
+func MapNotificationToProto(notification *entity.Notification) *notifications_pb.Notification {
+	response := &notifications_pb.Notification{
+		Id:        notification.ID,
+		Type:      MapNotificationTypeToProto(notification.Type),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+	}
+
+	if notification.ListingID != nil {
+		response.ListingId = *notification.ListingID
+	}
+
+	if data, err := structpb.NewStruct(notification.Data); err == nil {
+		response.Data = data
+	}
+
+	return response
+}
+
+// MapNotificationTypeToProto преобразует тип уведомления в proto-перечисление
+func MapNotificationTypeToProto(notificationType entity.NotificationType) notifications_pb.NotificationType {
+	switch notificationType {
+	case entity.NotificationTypePriceDrop:
+		return notifications_pb.NotificationType_NOTIFICATION_TYPE_PRICE_DROP
+	case entity.NotificationTypePriceThreshold:
+		return notifications_pb.NotificationType_NOTIFICATION_TYPE_PRICE_THRESHOLD
+	case entity.NotificationTypeSavedSearch:
+		return notifications_pb.NotificationType_NOTIFICATION_TYPE_SAVED_SEARCH
+	default:
+		return notifications_pb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,110 @@
+package adapter
+
+import (
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	listings_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/listings"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MapSavedSearchToProto преобразует сохраненный поиск в proto-объект
+func MapSavedSearchToProto(search *entity.SavedSearch) *listings_pb.SavedSearchResponse {
+	return &listings_pb.SavedSearchResponse{
+		Id:        search.ID,
+		Name:      search.Name,
+		Filter:    MapSavedSearchFilterToProto(&search.Filter),
+		Frequency: MapSearchFrequencyToProto(search.Frequency),
+		CreatedAt: timestamppb.New(search.CreatedAt),
+		UpdatedAt: timestamppb.New(search.UpdatedAt),
+	}
+}
+
+// MapSavedSearchFilterToProto преобразует условия сохраненного поиска в proto-объект
+func MapSavedSearchFilterToProto(filter *entity.SavedSearchFilter) *listings_pb.SavedSearchFilter {
+	response := &listings_pb.SavedSearchFilter{
+		SortBy:          MapSortFieldToProto(filter.SortBy),
+		SortOrder:       listings_pb.SortOrder_SORT_ORDER_ASC,
+		Query:           filter.Query,
+		CategoryId:      filter.CategoryID,
+		Attributes:      filter.Attributes,
+		DisplayCurrency: filter.DisplayCurrency,
+	}
+
+	if filter.SortDesc {
+		response.SortOrder = listings_pb.SortOrder_SORT_ORDER_DESC
+	}
+	if filter.MinPrice != nil {
+		response.MinPrice = MapMoneyToProto(*filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		response.MaxPrice = MapMoneyToProto(*filter.MaxPrice)
+	}
+
+	for key, bounds := range filter.AttributeRanges {
+		if bounds.Min != nil {
+			if response.AttributesMin == nil {
+				response.AttributesMin = make(map[string]float64)
+			}
+			response.AttributesMin[key] = *bounds.Min
+		}
+		if bounds.Max != nil {
+			if response.AttributesMax == nil {
+				response.AttributesMax = make(map[string]float64)
+			}
+			response.AttributesMax[key] = *bounds.Max
+		}
+	}
+
+	return response
+}
+
+// MapSortFieldToProto преобразует поле сортировки ленты в proto-перечисление
+func MapSortFieldToProto(sortBy string) listings_pb.SortField {
+	switch sortBy {
+	case "created_at":
+		return listings_pb.SortField_SORT_FIELD_CREATED_AT
+	case "price":
+		return listings_pb.SortField_SORT_FIELD_PRICE
+	case "relevance":
+		return listings_pb.SortField_SORT_FIELD_RELEVANCE
+	default:
+		return listings_pb.SortField_SORT_FIELD_UNSPECIFIED
+	}
+}
+
+// MapSortFieldFromProto преобразует proto-перечисление в поле сортировки ленты.
+// По умолчанию лента сортируется по дате создания
+func MapSortFieldFromProto(sortBy listings_pb.SortField) string {
+	switch sortBy {
+	case listings_pb.SortField_SORT_FIELD_PRICE:
+		return "price"
+	case listings_pb.SortField_SORT_FIELD_RELEVANCE:
+		return "relevance"
+	default:
+		return "created_at"
+	}
+}
+
+// MapSearchFrequencyToProto преобразует частоту уведомлений в proto-перечисление
+func MapSearchFrequencyToProto(frequency entity.SearchFrequency) listings_pb.SearchFrequency {
+	switch frequency {
+	case entity.SearchFrequencyInstant:
+		return listings_pb.SearchFrequency_SEARCH_FREQUENCY_INSTANT
+	case entity.SearchFrequencyDaily:
+		return listings_pb.SearchFrequency_SEARCH_FREQUENCY_DAILY
+	default:
+		return listings_pb.SearchFrequency_SEARCH_FREQUENCY_UNSPECIFIED
+	}
+}
+
+// MapSearchFrequencyFromProto преобразует proto-перечисление в частоту уведомлений.
+// Для неуказанной частоты возвращается пустая строка
+func MapSearchFrequencyFromProto(frequency listings_pb.SearchFrequency) entity.SearchFrequency {
+	switch frequency {
+	case listings_pb.SearchFrequency_SEARCH_FREQUENCY_INSTANT:
+		return entity.SearchFrequencyInstant
+	case listings_pb.SearchFrequency_SEARCH_FREQUENCY_DAILY:
+		return entity.SearchFrequencyDaily
+	default:
+		return ""
+	}
+}
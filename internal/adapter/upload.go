@@ -0,0 +1,33 @@
+package adapter
+
+import (
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	uploads_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/uploads"
+)
+
+// MapUploadToProto преобразует загруженный файл в proto-ответ
+func MapUploadToProto(upload *entity.Upload) *uploads_pb.UploadResponse {
+	return &uploads_pb.UploadResponse{
+		Id:             upload.ID,
+		Url:            upload.URL,
+		ContentType:    upload.ContentType,
+		Size:           uint64(upload.Size),
+		VariantsStatus: MapVariantsStatusToProto(upload.VariantsStatus),
+	}
+}
+
+// MapVariantsStatusToProto преобразует состояние обработки изображения в proto-перечисление
+func MapVariantsStatusToProto(status entity.VariantsStatus) uploads_pb.VariantsStatus {
+	switch status {
+	case entity.VariantsStatusPending:
+		return uploads_pb.VariantsStatus_VARIANTS_STATUS_PENDING
+	case entity.VariantsStatusProcessing:
+		return uploads_pb.VariantsStatus_VARIANTS_STATUS_PROCESSING
+	case entity.VariantsStatusReady:
+		return uploads_pb.VariantsStatus_VARIANTS_STATUS_READY
+	case entity.VariantsStatusFailed:
+		return uploads_pb.VariantsStatus_VARIANTS_STATUS_FAILED
+	default:
+		return uploads_pb.VariantsStatus_VARIANTS_STATUS_UNSPECIFIED
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
 	auth_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/auth"
+	users_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/users"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MapUserToProto преобразует внутреннюю модель пользователя в proto-объект
@@ -15,3 +17,19 @@ func MapUserToProto(user *entity.UserResponse) *auth_pb.User {
 		CreatedAt: user.CreatedAt.Format(time.RFC3339),
 	}
 }
+
+// MapUserProfileToProto преобразует профиль пользователя в proto-объект
+func MapUserProfileToProto(profile *entity.UserProfile) *users_pb.UserProfile {
+	return &users_pb.UserProfile{
+		Id:                  profile.ID,
+		Username:            profile.Username,
+		DisplayName:         profile.DisplayName,
+		AvatarUrl:           profile.AvatarURL,
+		Bio:                 profile.Bio,
+		City:                profile.City,
+		CreatedAt:           timestamppb.New(profile.CreatedAt),
+		ActiveListingsCount: profile.ActiveListingsCount,
+		Rating:              profile.Rating,
+		RatingsCount:        profile.RatingsCount,
+	}
+}
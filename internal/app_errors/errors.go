@@ -13,13 +13,20 @@ var (
 	ErrUnauthorized       = errors.New("необходима авторизация")
 	ErrForbidden          = errors.New("доступ запрещен")
 	ErrValidation         = errors.New("ошибка валидации")
+	ErrConflict           = errors.New("конфликт изменений")
 	ErrInternal           = errors.New("внутренняя ошибка сервера")
 )
 
 var (
-	ErrUserNotFound      = fmt.Errorf("пользователь не найден: %w", ErrNotFound)
-	ErrUserAlreadyExists = fmt.Errorf("пользователь с таким именем уже существует: %w", ErrAlreadyExists)
-	ErrListingNotFound   = fmt.Errorf("объявление не найдено: %w", ErrNotFound)
+	ErrUserNotFound        = fmt.Errorf("пользователь не найден: %w", ErrNotFound)
+	ErrUserAlreadyExists   = fmt.Errorf("пользователь с таким именем уже существует: %w", ErrAlreadyExists)
+	ErrListingNotFound     = fmt.Errorf("объявление не найдено: %w", ErrNotFound)
+	ErrCategoryNotFound    = fmt.Errorf("категория не найдена: %w", ErrNotFound)
+	ErrCurrencyNotFound    = fmt.Errorf("курс валюты не найден: %w", ErrNotFound)
+	ErrImageNotFound       = fmt.Errorf("изображение не найдено: %w", ErrNotFound)
+	ErrSavedSearchNotFound = fmt.Errorf("сохраненный поиск не найден: %w", ErrNotFound)
+	ErrListingConflict     = fmt.Errorf("объявление было изменено, обновите данные и повторите попытку: %w", ErrConflict)
+	ErrRefreshTokenReused  = fmt.Errorf("refresh-токен использован повторно: %w", ErrInvalidToken)
 )
 
 // WrapError оборачивает ошибку с дополнительным контекстом
@@ -37,6 +44,11 @@ func IsAlreadyExists(err error) bool {
 	return errors.Is(err, ErrAlreadyExists)
 }
 
+// IsConflict проверяет, является ли ошибка типа "конфликт изменений"
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
 // IsUnauthorized проверяет, является ли ошибка типа "необходима авторизация"
 func IsUnauthorized(err error) bool {
 	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrInvalidToken)
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"time"
 
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
 	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/auth"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
 	auth_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/auth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -59,7 +61,7 @@ func (h *Handler) Register(ctx context.Context, req *auth_pb.RegisterRequest) (*
 func (h *Handler) Login(ctx context.Context, req *auth_pb.LoginRequest) (*auth_pb.LoginResponse, error) {
 	h.log.Info(ctx, "Запрос на авторизацию пользователя", zap.String("username", req.Username))
 
-	token, userResp, err := h.authUC.Login(ctx, req.Username, req.Password)
+	tokens, userResp, err := h.authUC.Login(ctx, req.Username, req.Password)
 	if err != nil {
 		h.log.Warn(ctx, "Ошибка при авторизации пользователя",
 			zap.String("username", req.Username),
@@ -76,11 +78,74 @@ func (h *Handler) Login(ctx context.Context, req *auth_pb.LoginRequest) (*auth_p
 	h.log.Info(ctx, "Пользователь успешно авторизован", zap.Uint64("user_id", userResp.ID))
 
 	return &auth_pb.LoginResponse{
-		Token: token,
+		Token: tokens.AccessToken,
 		User: &auth_pb.User{
 			Id:        userResp.ID,
 			Username:  userResp.Username,
 			CreatedAt: userResp.CreatedAt.Format(time.RFC3339),
 		},
+		RefreshToken:          tokens.RefreshToken,
+		TokenExpiresAt:        tokens.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt.Format(time.RFC3339),
 	}, nil
 }
+
+// RefreshToken обрабатывает запрос на обновление токенов по refresh-токену
+func (h *Handler) RefreshToken(ctx context.Context, req *auth_pb.RefreshTokenRequest) (*auth_pb.RefreshTokenResponse, error) {
+	tokens, err := h.authUC.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при обновлении токенов", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return &auth_pb.RefreshTokenResponse{
+		Token:                 tokens.AccessToken,
+		RefreshToken:          tokens.RefreshToken,
+		TokenExpiresAt:        tokens.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// Logout обрабатывает запрос на выход, отзывая refresh-токены входа
+func (h *Handler) Logout(ctx context.Context, req *auth_pb.LogoutRequest) (*auth_pb.LogoutResponse, error) {
+	if err := h.authUC.Logout(ctx, req.RefreshToken); err != nil {
+		h.log.Warn(ctx, "Ошибка при выходе", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return &auth_pb.LogoutResponse{}, nil
+}
+
+// GetMe обрабатывает запрос на получение текущего пользователя по токену авторизации
+func (h *Handler) GetMe(ctx context.Context, _ *auth_pb.GetMeRequest) (*auth_pb.GetMeResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка получить текущего пользователя без авторизации")
+		return nil, status.Error(codes.Unauthenticated, "требуется авторизация")
+	}
+
+	userResp, err := h.authUC.GetMe(ctx, userID)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrUserNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "недействительный токен")
+		}
+
+		h.log.Error(ctx, "Ошибка при получении текущего пользователя", zap.Error(err))
+		return nil, status.Error(codes.Internal, "ошибка при получении текущего пользователя")
+	}
+
+	response := &auth_pb.GetMeResponse{
+		User:  adapter.MapUserToProto(userResp),
+		Roles: make([]string, 0, len(userResp.Roles)),
+	}
+
+	if expiresAt, ok := middleware.GetTokenExpiresAt(ctx); ok {
+		response.TokenExpiresAt = expiresAt.Format(time.RFC3339)
+	}
+
+	for _, role := range userResp.Roles {
+		response.Roles = append(response.Roles, string(role))
+	}
+
+	return response, nil
+}
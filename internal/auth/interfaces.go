@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
 )
@@ -10,10 +11,18 @@ type Repository interface {
 	CreateUser(ctx context.Context, user *entity.User) (*entity.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*entity.User, error)
 	GetUserByID(ctx context.Context, id uint64) (*entity.User, error)
+	CreateRefreshToken(ctx context.Context, token *entity.RefreshToken) error
+	RotateRefreshToken(ctx context.Context, tokenHash string, next *entity.RefreshToken) (*entity.RefreshToken, error)
+	RevokeRefreshTokenFamily(ctx context.Context, tokenHash string) error
+	PurgeExpiredRefreshTokens(ctx context.Context, expiredBefore time.Time) (int64, error)
 }
 
 type UseCase interface {
 	Register(ctx context.Context, username, password string) (*entity.UserResponse, error)
-	Login(ctx context.Context, username, password string) (string, *entity.UserResponse, error)
-	VerifyToken(ctx context.Context, token string) (uint64, error)
+	Login(ctx context.Context, username, password string) (*entity.AuthTokens, *entity.UserResponse, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*entity.AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	PurgeExpiredRefreshTokens(ctx context.Context) (int64, error)
+	VerifyToken(ctx context.Context, token string) (*entity.TokenClaims, error)
+	GetMe(ctx context.Context, userID uint64) (*entity.UserResponse, error)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
@@ -19,6 +20,13 @@ type RepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCreateRefreshToken          func(ctx context.Context, token *entity.RefreshToken) (err error)
+	funcCreateRefreshTokenOrigin    string
+	inspectFuncCreateRefreshToken   func(ctx context.Context, token *entity.RefreshToken)
+	afterCreateRefreshTokenCounter  uint64
+	beforeCreateRefreshTokenCounter uint64
+	CreateRefreshTokenMock          mRepositoryMockCreateRefreshToken
+
 	funcCreateUser          func(ctx context.Context, user *entity.User) (up1 *entity.User, err error)
 	funcCreateUserOrigin    string
 	inspectFuncCreateUser   func(ctx context.Context, user *entity.User)
@@ -39,6 +47,27 @@ type RepositoryMock struct {
 	afterGetUserByUsernameCounter  uint64
 	beforeGetUserByUsernameCounter uint64
 	GetUserByUsernameMock          mRepositoryMockGetUserByUsername
+
+	funcPurgeExpiredRefreshTokens          func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)
+	funcPurgeExpiredRefreshTokensOrigin    string
+	inspectFuncPurgeExpiredRefreshTokens   func(ctx context.Context, expiredBefore time.Time)
+	afterPurgeExpiredRefreshTokensCounter  uint64
+	beforePurgeExpiredRefreshTokensCounter uint64
+	PurgeExpiredRefreshTokensMock          mRepositoryMockPurgeExpiredRefreshTokens
+
+	funcRevokeRefreshTokenFamily          func(ctx context.Context, tokenHash string) (err error)
+	funcRevokeRefreshTokenFamilyOrigin    string
+	inspectFuncRevokeRefreshTokenFamily   func(ctx context.Context, tokenHash string)
+	afterRevokeRefreshTokenFamilyCounter  uint64
+	beforeRevokeRefreshTokenFamilyCounter uint64
+	RevokeRefreshTokenFamilyMock          mRepositoryMockRevokeRefreshTokenFamily
+
+	funcRotateRefreshToken          func(ctx context.Context, tokenHash string, next *entity.RefreshToken) (rp1 *entity.RefreshToken, err error)
+	funcRotateRefreshTokenOrigin    string
+	inspectFuncRotateRefreshToken   func(ctx context.Context, tokenHash string, next *entity.RefreshToken)
+	afterRotateRefreshTokenCounter  uint64
+	beforeRotateRefreshTokenCounter uint64
+	RotateRefreshTokenMock          mRepositoryMockRotateRefreshToken
 }
 
 // NewRepositoryMock returns a mock for mm_auth.Repository
@@ -49,6 +78,9 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateRefreshTokenMock = mRepositoryMockCreateRefreshToken{mock: m}
+	m.CreateRefreshTokenMock.callArgs = []*RepositoryMockCreateRefreshTokenParams{}
+
 	m.CreateUserMock = mRepositoryMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*RepositoryMockCreateUserParams{}
 
@@ -58,11 +90,362 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.GetUserByUsernameMock = mRepositoryMockGetUserByUsername{mock: m}
 	m.GetUserByUsernameMock.callArgs = []*RepositoryMockGetUserByUsernameParams{}
 
+	m.PurgeExpiredRefreshTokensMock = mRepositoryMockPurgeExpiredRefreshTokens{mock: m}
+	m.PurgeExpiredRefreshTokensMock.callArgs = []*RepositoryMockPurgeExpiredRefreshTokensParams{}
+
+	m.RevokeRefreshTokenFamilyMock = mRepositoryMockRevokeRefreshTokenFamily{mock: m}
+	m.RevokeRefreshTokenFamilyMock.callArgs = []*RepositoryMockRevokeRefreshTokenFamilyParams{}
+
+	m.RotateRefreshTokenMock = mRepositoryMockRotateRefreshToken{mock: m}
+	m.RotateRefreshTokenMock.callArgs = []*RepositoryMockRotateRefreshTokenParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mRepositoryMockCreateRefreshToken struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateRefreshTokenExpectation
+	expectations       []*RepositoryMockCreateRefreshTokenExpectation
+
+	callArgs []*RepositoryMockCreateRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateRefreshTokenExpectation specifies expectation struct of the Repository.CreateRefreshToken
+type RepositoryMockCreateRefreshTokenExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateRefreshTokenParams
+	paramPtrs          *RepositoryMockCreateRefreshTokenParamPtrs
+	expectationOrigins RepositoryMockCreateRefreshTokenExpectationOrigins
+	results            *RepositoryMockCreateRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateRefreshTokenParams contains parameters of the Repository.CreateRefreshToken
+type RepositoryMockCreateRefreshTokenParams struct {
+	ctx   context.Context
+	token *entity.RefreshToken
+}
+
+// RepositoryMockCreateRefreshTokenParamPtrs contains pointers to parameters of the Repository.CreateRefreshToken
+type RepositoryMockCreateRefreshTokenParamPtrs struct {
+	ctx   *context.Context
+	token **entity.RefreshToken
+}
+
+// RepositoryMockCreateRefreshTokenResults contains results of the Repository.CreateRefreshToken
+type RepositoryMockCreateRefreshTokenResults struct {
+	err error
+}
+
+// RepositoryMockCreateRefreshTokenOrigins contains origins of expectations of the Repository.CreateRefreshToken
+type RepositoryMockCreateRefreshTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Optional() *mRepositoryMockCreateRefreshToken {
+	mmCreateRefreshToken.optional = true
+	return mmCreateRefreshToken
+}
+
+// Expect sets up expected params for Repository.CreateRefreshToken
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Expect(ctx context.Context, token *entity.RefreshToken) *mRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRefreshToken.defaultExpectation.params = &RepositoryMockCreateRefreshTokenParams{ctx, token}
+	mmCreateRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRefreshToken.expectations {
+		if minimock.Equal(e.params, mmCreateRefreshToken.defaultExpectation.params) {
+			mmCreateRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateRefreshToken
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.params != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Expect")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmCreateRefreshToken.defaultExpectation.paramPtrs = &RepositoryMockCreateRefreshTokenParamPtrs{}
+	}
+	mmCreateRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRefreshToken
+}
+
+// ExpectTokenParam2 sets up expected param token for Repository.CreateRefreshToken
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) ExpectTokenParam2(token *entity.RefreshToken) *mRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.params != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Expect")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmCreateRefreshToken.defaultExpectation.paramPtrs = &RepositoryMockCreateRefreshTokenParamPtrs{}
+	}
+	mmCreateRefreshToken.defaultExpectation.paramPtrs.token = &token
+	mmCreateRefreshToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmCreateRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateRefreshToken
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Inspect(f func(ctx context.Context, token *entity.RefreshToken)) *mRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.inspectFuncCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateRefreshToken")
+	}
+
+	mmCreateRefreshToken.mock.inspectFuncCreateRefreshToken = f
+
+	return mmCreateRefreshToken
+}
+
+// Return sets up results that will be returned by Repository.CreateRefreshToken
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Return(err error) *RepositoryMock {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RepositoryMockCreateRefreshTokenExpectation{mock: mmCreateRefreshToken.mock}
+	}
+	mmCreateRefreshToken.defaultExpectation.results = &RepositoryMockCreateRefreshTokenResults{err}
+	mmCreateRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRefreshToken.mock
+}
+
+// Set uses given function f to mock the Repository.CreateRefreshToken method
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Set(f func(ctx context.Context, token *entity.RefreshToken) (err error)) *RepositoryMock {
+	if mmCreateRefreshToken.defaultExpectation != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("Default expectation is already set for the Repository.CreateRefreshToken method")
+	}
+
+	if len(mmCreateRefreshToken.expectations) > 0 {
+		mmCreateRefreshToken.mock.t.Fatalf("Some expectations are already set for the Repository.CreateRefreshToken method")
+	}
+
+	mmCreateRefreshToken.mock.funcCreateRefreshToken = f
+	mmCreateRefreshToken.mock.funcCreateRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmCreateRefreshToken.mock
+}
+
+// When sets expectation for the Repository.CreateRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) When(ctx context.Context, token *entity.RefreshToken) *RepositoryMockCreateRefreshTokenExpectation {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateRefreshTokenExpectation{
+		mock:               mmCreateRefreshToken.mock,
+		params:             &RepositoryMockCreateRefreshTokenParams{ctx, token},
+		expectationOrigins: RepositoryMockCreateRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRefreshToken.expectations = append(mmCreateRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreateRefreshToken return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateRefreshTokenExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateRefreshTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreateRefreshToken should be invoked
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Times(n uint64) *mRepositoryMockCreateRefreshToken {
+	if n == 0 {
+		mmCreateRefreshToken.mock.t.Fatalf("Times of RepositoryMock.CreateRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRefreshToken.expectedInvocations, n)
+	mmCreateRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRefreshToken
+}
+
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) invocationsDone() bool {
+	if len(mmCreateRefreshToken.expectations) == 0 && mmCreateRefreshToken.defaultExpectation == nil && mmCreateRefreshToken.mock.funcCreateRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRefreshToken.mock.afterCreateRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRefreshToken implements mm_auth.Repository
+func (mmCreateRefreshToken *RepositoryMock) CreateRefreshToken(ctx context.Context, token *entity.RefreshToken) (err error) {
+	mm_atomic.AddUint64(&mmCreateRefreshToken.beforeCreateRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRefreshToken.afterCreateRefreshTokenCounter, 1)
+
+	mmCreateRefreshToken.t.Helper()
+
+	if mmCreateRefreshToken.inspectFuncCreateRefreshToken != nil {
+		mmCreateRefreshToken.inspectFuncCreateRefreshToken(ctx, token)
+	}
+
+	mm_params := RepositoryMockCreateRefreshTokenParams{ctx, token}
+
+	// Record call args
+	mmCreateRefreshToken.CreateRefreshTokenMock.mutex.Lock()
+	mmCreateRefreshToken.CreateRefreshTokenMock.callArgs = append(mmCreateRefreshToken.CreateRefreshTokenMock.callArgs, &mm_params)
+	mmCreateRefreshToken.CreateRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmCreateRefreshToken.CreateRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateRefreshTokenParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRefreshToken.t.Errorf("RepositoryMock.CreateRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreateRefreshToken.t.Errorf("RepositoryMock.CreateRefreshToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRefreshToken.t.Errorf("RepositoryMock.CreateRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRefreshToken.t.Fatal("No results are set for the RepositoryMock.CreateRefreshToken")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRefreshToken.funcCreateRefreshToken != nil {
+		return mmCreateRefreshToken.funcCreateRefreshToken(ctx, token)
+	}
+	mmCreateRefreshToken.t.Fatalf("Unexpected call to RepositoryMock.CreateRefreshToken. %v %v", ctx, token)
+	return
+}
+
+// CreateRefreshTokenAfterCounter returns a count of finished RepositoryMock.CreateRefreshToken invocations
+func (mmCreateRefreshToken *RepositoryMock) CreateRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefreshToken.afterCreateRefreshTokenCounter)
+}
+
+// CreateRefreshTokenBeforeCounter returns a count of RepositoryMock.CreateRefreshToken invocations
+func (mmCreateRefreshToken *RepositoryMock) CreateRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefreshToken.beforeCreateRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRefreshToken *mRepositoryMockCreateRefreshToken) Calls() []*RepositoryMockCreateRefreshTokenParams {
+	mmCreateRefreshToken.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateRefreshTokenParams, len(mmCreateRefreshToken.callArgs))
+	copy(argCopy, mmCreateRefreshToken.callArgs)
+
+	mmCreateRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRefreshTokenDone returns true if the count of the CreateRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateRefreshTokenDone() bool {
+	if m.CreateRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRefreshTokenMock.invocationsDone()
+}
+
+// MinimockCreateRefreshTokenInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateRefreshTokenInspect() {
+	for _, e := range m.CreateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreateRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterCreateRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRefreshTokenMock.defaultExpectation != nil && afterCreateRefreshTokenCounter < 1 {
+		if m.CreateRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateRefreshToken at\n%s", m.CreateRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreateRefreshToken at\n%s with params: %#v", m.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.CreateRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRefreshToken != nil && afterCreateRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateRefreshToken at\n%s", m.funcCreateRefreshTokenOrigin)
+	}
+
+	if !m.CreateRefreshTokenMock.invocationsDone() && afterCreateRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRefreshTokenMock.expectedInvocations), m.CreateRefreshTokenMock.expectedInvocationsOrigin, afterCreateRefreshTokenCounter)
+	}
+}
+
 type mRepositoryMockCreateUser struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -1092,15 +1475,1082 @@ func (m *RepositoryMock) MinimockGetUserByUsernameInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *RepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateUserInspect()
+type mRepositoryMockPurgeExpiredRefreshTokens struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockPurgeExpiredRefreshTokensExpectation
+	expectations       []*RepositoryMockPurgeExpiredRefreshTokensExpectation
 
-			m.MinimockGetUserByIDInspect()
+	callArgs []*RepositoryMockPurgeExpiredRefreshTokensParams
+	mutex    sync.RWMutex
 
-			m.MinimockGetUserByUsernameInspect()
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockPurgeExpiredRefreshTokensExpectation specifies expectation struct of the Repository.PurgeExpiredRefreshTokens
+type RepositoryMockPurgeExpiredRefreshTokensExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockPurgeExpiredRefreshTokensParams
+	paramPtrs          *RepositoryMockPurgeExpiredRefreshTokensParamPtrs
+	expectationOrigins RepositoryMockPurgeExpiredRefreshTokensExpectationOrigins
+	results            *RepositoryMockPurgeExpiredRefreshTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockPurgeExpiredRefreshTokensParams contains parameters of the Repository.PurgeExpiredRefreshTokens
+type RepositoryMockPurgeExpiredRefreshTokensParams struct {
+	ctx           context.Context
+	expiredBefore time.Time
+}
+
+// RepositoryMockPurgeExpiredRefreshTokensParamPtrs contains pointers to parameters of the Repository.PurgeExpiredRefreshTokens
+type RepositoryMockPurgeExpiredRefreshTokensParamPtrs struct {
+	ctx           *context.Context
+	expiredBefore *time.Time
+}
+
+// RepositoryMockPurgeExpiredRefreshTokensResults contains results of the Repository.PurgeExpiredRefreshTokens
+type RepositoryMockPurgeExpiredRefreshTokensResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryMockPurgeExpiredRefreshTokensOrigins contains origins of expectations of the Repository.PurgeExpiredRefreshTokens
+type RepositoryMockPurgeExpiredRefreshTokensExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originExpiredBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Optional() *mRepositoryMockPurgeExpiredRefreshTokens {
+	mmPurgeExpiredRefreshTokens.optional = true
+	return mmPurgeExpiredRefreshTokens
+}
+
+// Expect sets up expected params for Repository.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Expect(ctx context.Context, expiredBefore time.Time) *mRepositoryMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &RepositoryMockPurgeExpiredRefreshTokensExpectation{}
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeExpiredRefreshTokens.defaultExpectation.params = &RepositoryMockPurgeExpiredRefreshTokensParams{ctx, expiredBefore}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeExpiredRefreshTokens.expectations {
+		if minimock.Equal(e.params, mmPurgeExpiredRefreshTokens.defaultExpectation.params) {
+			mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeExpiredRefreshTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) ExpectCtxParam1(ctx context.Context) *mRepositoryMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &RepositoryMockPurgeExpiredRefreshTokensExpectation{}
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.params != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Expect")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs = &RepositoryMockPurgeExpiredRefreshTokensParamPtrs{}
+	}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeExpiredRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// ExpectExpiredBeforeParam2 sets up expected param expiredBefore for Repository.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) ExpectExpiredBeforeParam2(expiredBefore time.Time) *mRepositoryMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &RepositoryMockPurgeExpiredRefreshTokensExpectation{}
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.params != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Expect")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs = &RepositoryMockPurgeExpiredRefreshTokensParamPtrs{}
+	}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs.expiredBefore = &expiredBefore
+	mmPurgeExpiredRefreshTokens.defaultExpectation.expectationOrigins.originExpiredBefore = minimock.CallerInfo(1)
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Inspect(f func(ctx context.Context, expiredBefore time.Time)) *mRepositoryMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.inspectFuncPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Inspect function is already set for RepositoryMock.PurgeExpiredRefreshTokens")
+	}
+
+	mmPurgeExpiredRefreshTokens.mock.inspectFuncPurgeExpiredRefreshTokens = f
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// Return sets up results that will be returned by Repository.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Return(i1 int64, err error) *RepositoryMock {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &RepositoryMockPurgeExpiredRefreshTokensExpectation{mock: mmPurgeExpiredRefreshTokens.mock}
+	}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.results = &RepositoryMockPurgeExpiredRefreshTokensResults{i1, err}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredRefreshTokens.mock
+}
+
+// Set uses given function f to mock the Repository.PurgeExpiredRefreshTokens method
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Set(f func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)) *RepositoryMock {
+	if mmPurgeExpiredRefreshTokens.defaultExpectation != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Default expectation is already set for the Repository.PurgeExpiredRefreshTokens method")
+	}
+
+	if len(mmPurgeExpiredRefreshTokens.expectations) > 0 {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Some expectations are already set for the Repository.PurgeExpiredRefreshTokens method")
+	}
+
+	mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens = f
+	mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokensOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredRefreshTokens.mock
+}
+
+// When sets expectation for the Repository.PurgeExpiredRefreshTokens which will trigger the result defined by the following
+// Then helper
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) When(ctx context.Context, expiredBefore time.Time) *RepositoryMockPurgeExpiredRefreshTokensExpectation {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("RepositoryMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockPurgeExpiredRefreshTokensExpectation{
+		mock:               mmPurgeExpiredRefreshTokens.mock,
+		params:             &RepositoryMockPurgeExpiredRefreshTokensParams{ctx, expiredBefore},
+		expectationOrigins: RepositoryMockPurgeExpiredRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeExpiredRefreshTokens.expectations = append(mmPurgeExpiredRefreshTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.PurgeExpiredRefreshTokens return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockPurgeExpiredRefreshTokensExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockPurgeExpiredRefreshTokensResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.PurgeExpiredRefreshTokens should be invoked
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Times(n uint64) *mRepositoryMockPurgeExpiredRefreshTokens {
+	if n == 0 {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Times of RepositoryMock.PurgeExpiredRefreshTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeExpiredRefreshTokens.expectedInvocations, n)
+	mmPurgeExpiredRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredRefreshTokens
+}
+
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) invocationsDone() bool {
+	if len(mmPurgeExpiredRefreshTokens.expectations) == 0 && mmPurgeExpiredRefreshTokens.defaultExpectation == nil && mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.mock.afterPurgeExpiredRefreshTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeExpiredRefreshTokens implements mm_auth.Repository
+func (mmPurgeExpiredRefreshTokens *RepositoryMock) PurgeExpiredRefreshTokens(ctx context.Context, expiredBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeExpiredRefreshTokens.beforePurgeExpiredRefreshTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeExpiredRefreshTokens.afterPurgeExpiredRefreshTokensCounter, 1)
+
+	mmPurgeExpiredRefreshTokens.t.Helper()
+
+	if mmPurgeExpiredRefreshTokens.inspectFuncPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.inspectFuncPurgeExpiredRefreshTokens(ctx, expiredBefore)
+	}
+
+	mm_params := RepositoryMockPurgeExpiredRefreshTokensParams{ctx, expiredBefore}
+
+	// Record call args
+	mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.mutex.Lock()
+	mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.callArgs = append(mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.callArgs, &mm_params)
+	mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.mutex.Unlock()
+
+	for _, e := range mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockPurgeExpiredRefreshTokensParams{ctx, expiredBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeExpiredRefreshTokens.t.Errorf("RepositoryMock.PurgeExpiredRefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.expiredBefore != nil && !minimock.Equal(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore) {
+				mmPurgeExpiredRefreshTokens.t.Errorf("RepositoryMock.PurgeExpiredRefreshTokens got unexpected parameter expiredBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.originExpiredBefore, *mm_want_ptrs.expiredBefore, mm_got.expiredBefore, minimock.Diff(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeExpiredRefreshTokens.t.Errorf("RepositoryMock.PurgeExpiredRefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeExpiredRefreshTokens.t.Fatal("No results are set for the RepositoryMock.PurgeExpiredRefreshTokens")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeExpiredRefreshTokens.funcPurgeExpiredRefreshTokens != nil {
+		return mmPurgeExpiredRefreshTokens.funcPurgeExpiredRefreshTokens(ctx, expiredBefore)
+	}
+	mmPurgeExpiredRefreshTokens.t.Fatalf("Unexpected call to RepositoryMock.PurgeExpiredRefreshTokens. %v %v", ctx, expiredBefore)
+	return
+}
+
+// PurgeExpiredRefreshTokensAfterCounter returns a count of finished RepositoryMock.PurgeExpiredRefreshTokens invocations
+func (mmPurgeExpiredRefreshTokens *RepositoryMock) PurgeExpiredRefreshTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.afterPurgeExpiredRefreshTokensCounter)
+}
+
+// PurgeExpiredRefreshTokensBeforeCounter returns a count of RepositoryMock.PurgeExpiredRefreshTokens invocations
+func (mmPurgeExpiredRefreshTokens *RepositoryMock) PurgeExpiredRefreshTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.beforePurgeExpiredRefreshTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.PurgeExpiredRefreshTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeExpiredRefreshTokens *mRepositoryMockPurgeExpiredRefreshTokens) Calls() []*RepositoryMockPurgeExpiredRefreshTokensParams {
+	mmPurgeExpiredRefreshTokens.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockPurgeExpiredRefreshTokensParams, len(mmPurgeExpiredRefreshTokens.callArgs))
+	copy(argCopy, mmPurgeExpiredRefreshTokens.callArgs)
+
+	mmPurgeExpiredRefreshTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeExpiredRefreshTokensDone returns true if the count of the PurgeExpiredRefreshTokens invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockPurgeExpiredRefreshTokensDone() bool {
+	if m.PurgeExpiredRefreshTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeExpiredRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeExpiredRefreshTokensMock.invocationsDone()
+}
+
+// MinimockPurgeExpiredRefreshTokensInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockPurgeExpiredRefreshTokensInspect() {
+	for _, e := range m.PurgeExpiredRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.PurgeExpiredRefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeExpiredRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterPurgeExpiredRefreshTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeExpiredRefreshTokensMock.defaultExpectation != nil && afterPurgeExpiredRefreshTokensCounter < 1 {
+		if m.PurgeExpiredRefreshTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.PurgeExpiredRefreshTokens at\n%s", m.PurgeExpiredRefreshTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.PurgeExpiredRefreshTokens at\n%s with params: %#v", m.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.PurgeExpiredRefreshTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeExpiredRefreshTokens != nil && afterPurgeExpiredRefreshTokensCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.PurgeExpiredRefreshTokens at\n%s", m.funcPurgeExpiredRefreshTokensOrigin)
+	}
+
+	if !m.PurgeExpiredRefreshTokensMock.invocationsDone() && afterPurgeExpiredRefreshTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.PurgeExpiredRefreshTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeExpiredRefreshTokensMock.expectedInvocations), m.PurgeExpiredRefreshTokensMock.expectedInvocationsOrigin, afterPurgeExpiredRefreshTokensCounter)
+	}
+}
+
+type mRepositoryMockRevokeRefreshTokenFamily struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockRevokeRefreshTokenFamilyExpectation
+	expectations       []*RepositoryMockRevokeRefreshTokenFamilyExpectation
+
+	callArgs []*RepositoryMockRevokeRefreshTokenFamilyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockRevokeRefreshTokenFamilyExpectation specifies expectation struct of the Repository.RevokeRefreshTokenFamily
+type RepositoryMockRevokeRefreshTokenFamilyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockRevokeRefreshTokenFamilyParams
+	paramPtrs          *RepositoryMockRevokeRefreshTokenFamilyParamPtrs
+	expectationOrigins RepositoryMockRevokeRefreshTokenFamilyExpectationOrigins
+	results            *RepositoryMockRevokeRefreshTokenFamilyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockRevokeRefreshTokenFamilyParams contains parameters of the Repository.RevokeRefreshTokenFamily
+type RepositoryMockRevokeRefreshTokenFamilyParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// RepositoryMockRevokeRefreshTokenFamilyParamPtrs contains pointers to parameters of the Repository.RevokeRefreshTokenFamily
+type RepositoryMockRevokeRefreshTokenFamilyParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// RepositoryMockRevokeRefreshTokenFamilyResults contains results of the Repository.RevokeRefreshTokenFamily
+type RepositoryMockRevokeRefreshTokenFamilyResults struct {
+	err error
+}
+
+// RepositoryMockRevokeRefreshTokenFamilyOrigins contains origins of expectations of the Repository.RevokeRefreshTokenFamily
+type RepositoryMockRevokeRefreshTokenFamilyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Optional() *mRepositoryMockRevokeRefreshTokenFamily {
+	mmRevokeRefreshTokenFamily.optional = true
+	return mmRevokeRefreshTokenFamily
+}
+
+// Expect sets up expected params for Repository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Expect(ctx context.Context, tokenHash string) *mRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeRefreshTokenFamily.defaultExpectation.params = &RepositoryMockRevokeRefreshTokenFamilyParams{ctx, tokenHash}
+	mmRevokeRefreshTokenFamily.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeRefreshTokenFamily.expectations {
+		if minimock.Equal(e.params, mmRevokeRefreshTokenFamily.defaultExpectation.params) {
+			mmRevokeRefreshTokenFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeRefreshTokenFamily.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) ExpectCtxParam1(ctx context.Context) *mRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.params != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Expect")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs = &RepositoryMockRevokeRefreshTokenFamilyParamPtrs{}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeRefreshTokenFamily.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for Repository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) ExpectTokenHashParam2(tokenHash string) *mRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.params != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Expect")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs = &RepositoryMockRevokeRefreshTokenFamilyParamPtrs{}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmRevokeRefreshTokenFamily.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Inspect(f func(ctx context.Context, tokenHash string)) *mRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.inspectFuncRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Inspect function is already set for RepositoryMock.RevokeRefreshTokenFamily")
+	}
+
+	mmRevokeRefreshTokenFamily.mock.inspectFuncRevokeRefreshTokenFamily = f
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// Return sets up results that will be returned by Repository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Return(err error) *RepositoryMock {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RepositoryMockRevokeRefreshTokenFamilyExpectation{mock: mmRevokeRefreshTokenFamily.mock}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.results = &RepositoryMockRevokeRefreshTokenFamilyResults{err}
+	mmRevokeRefreshTokenFamily.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeRefreshTokenFamily.mock
+}
+
+// Set uses given function f to mock the Repository.RevokeRefreshTokenFamily method
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Set(f func(ctx context.Context, tokenHash string) (err error)) *RepositoryMock {
+	if mmRevokeRefreshTokenFamily.defaultExpectation != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Default expectation is already set for the Repository.RevokeRefreshTokenFamily method")
+	}
+
+	if len(mmRevokeRefreshTokenFamily.expectations) > 0 {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Some expectations are already set for the Repository.RevokeRefreshTokenFamily method")
+	}
+
+	mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily = f
+	mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamilyOrigin = minimock.CallerInfo(1)
+	return mmRevokeRefreshTokenFamily.mock
+}
+
+// When sets expectation for the Repository.RevokeRefreshTokenFamily which will trigger the result defined by the following
+// Then helper
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) When(ctx context.Context, tokenHash string) *RepositoryMockRevokeRefreshTokenFamilyExpectation {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockRevokeRefreshTokenFamilyExpectation{
+		mock:               mmRevokeRefreshTokenFamily.mock,
+		params:             &RepositoryMockRevokeRefreshTokenFamilyParams{ctx, tokenHash},
+		expectationOrigins: RepositoryMockRevokeRefreshTokenFamilyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeRefreshTokenFamily.expectations = append(mmRevokeRefreshTokenFamily.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.RevokeRefreshTokenFamily return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockRevokeRefreshTokenFamilyExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockRevokeRefreshTokenFamilyResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.RevokeRefreshTokenFamily should be invoked
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Times(n uint64) *mRepositoryMockRevokeRefreshTokenFamily {
+	if n == 0 {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Times of RepositoryMock.RevokeRefreshTokenFamily mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeRefreshTokenFamily.expectedInvocations, n)
+	mmRevokeRefreshTokenFamily.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeRefreshTokenFamily
+}
+
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) invocationsDone() bool {
+	if len(mmRevokeRefreshTokenFamily.expectations) == 0 && mmRevokeRefreshTokenFamily.defaultExpectation == nil && mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.mock.afterRevokeRefreshTokenFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeRefreshTokenFamily implements mm_auth.Repository
+func (mmRevokeRefreshTokenFamily *RepositoryMock) RevokeRefreshTokenFamily(ctx context.Context, tokenHash string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.beforeRevokeRefreshTokenFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.afterRevokeRefreshTokenFamilyCounter, 1)
+
+	mmRevokeRefreshTokenFamily.t.Helper()
+
+	if mmRevokeRefreshTokenFamily.inspectFuncRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.inspectFuncRevokeRefreshTokenFamily(ctx, tokenHash)
+	}
+
+	mm_params := RepositoryMockRevokeRefreshTokenFamilyParams{ctx, tokenHash}
+
+	// Record call args
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.mutex.Lock()
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.callArgs = append(mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.callArgs, &mm_params)
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockRevokeRefreshTokenFamilyParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeRefreshTokenFamily.t.Errorf("RepositoryMock.RevokeRefreshTokenFamily got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmRevokeRefreshTokenFamily.t.Errorf("RepositoryMock.RevokeRefreshTokenFamily got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeRefreshTokenFamily.t.Errorf("RepositoryMock.RevokeRefreshTokenFamily got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeRefreshTokenFamily.t.Fatal("No results are set for the RepositoryMock.RevokeRefreshTokenFamily")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeRefreshTokenFamily.funcRevokeRefreshTokenFamily != nil {
+		return mmRevokeRefreshTokenFamily.funcRevokeRefreshTokenFamily(ctx, tokenHash)
+	}
+	mmRevokeRefreshTokenFamily.t.Fatalf("Unexpected call to RepositoryMock.RevokeRefreshTokenFamily. %v %v", ctx, tokenHash)
+	return
+}
+
+// RevokeRefreshTokenFamilyAfterCounter returns a count of finished RepositoryMock.RevokeRefreshTokenFamily invocations
+func (mmRevokeRefreshTokenFamily *RepositoryMock) RevokeRefreshTokenFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.afterRevokeRefreshTokenFamilyCounter)
+}
+
+// RevokeRefreshTokenFamilyBeforeCounter returns a count of RepositoryMock.RevokeRefreshTokenFamily invocations
+func (mmRevokeRefreshTokenFamily *RepositoryMock) RevokeRefreshTokenFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.beforeRevokeRefreshTokenFamilyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.RevokeRefreshTokenFamily.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeRefreshTokenFamily *mRepositoryMockRevokeRefreshTokenFamily) Calls() []*RepositoryMockRevokeRefreshTokenFamilyParams {
+	mmRevokeRefreshTokenFamily.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockRevokeRefreshTokenFamilyParams, len(mmRevokeRefreshTokenFamily.callArgs))
+	copy(argCopy, mmRevokeRefreshTokenFamily.callArgs)
+
+	mmRevokeRefreshTokenFamily.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeRefreshTokenFamilyDone returns true if the count of the RevokeRefreshTokenFamily invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockRevokeRefreshTokenFamilyDone() bool {
+	if m.RevokeRefreshTokenFamilyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeRefreshTokenFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeRefreshTokenFamilyMock.invocationsDone()
+}
+
+// MinimockRevokeRefreshTokenFamilyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockRevokeRefreshTokenFamilyInspect() {
+	for _, e := range m.RevokeRefreshTokenFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.RevokeRefreshTokenFamily at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeRefreshTokenFamilyCounter := mm_atomic.LoadUint64(&m.afterRevokeRefreshTokenFamilyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeRefreshTokenFamilyMock.defaultExpectation != nil && afterRevokeRefreshTokenFamilyCounter < 1 {
+		if m.RevokeRefreshTokenFamilyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.RevokeRefreshTokenFamily at\n%s", m.RevokeRefreshTokenFamilyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.RevokeRefreshTokenFamily at\n%s with params: %#v", m.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.origin, *m.RevokeRefreshTokenFamilyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeRefreshTokenFamily != nil && afterRevokeRefreshTokenFamilyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.RevokeRefreshTokenFamily at\n%s", m.funcRevokeRefreshTokenFamilyOrigin)
+	}
+
+	if !m.RevokeRefreshTokenFamilyMock.invocationsDone() && afterRevokeRefreshTokenFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.RevokeRefreshTokenFamily at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeRefreshTokenFamilyMock.expectedInvocations), m.RevokeRefreshTokenFamilyMock.expectedInvocationsOrigin, afterRevokeRefreshTokenFamilyCounter)
+	}
+}
+
+type mRepositoryMockRotateRefreshToken struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockRotateRefreshTokenExpectation
+	expectations       []*RepositoryMockRotateRefreshTokenExpectation
+
+	callArgs []*RepositoryMockRotateRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockRotateRefreshTokenExpectation specifies expectation struct of the Repository.RotateRefreshToken
+type RepositoryMockRotateRefreshTokenExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockRotateRefreshTokenParams
+	paramPtrs          *RepositoryMockRotateRefreshTokenParamPtrs
+	expectationOrigins RepositoryMockRotateRefreshTokenExpectationOrigins
+	results            *RepositoryMockRotateRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockRotateRefreshTokenParams contains parameters of the Repository.RotateRefreshToken
+type RepositoryMockRotateRefreshTokenParams struct {
+	ctx       context.Context
+	tokenHash string
+	next      *entity.RefreshToken
+}
+
+// RepositoryMockRotateRefreshTokenParamPtrs contains pointers to parameters of the Repository.RotateRefreshToken
+type RepositoryMockRotateRefreshTokenParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+	next      **entity.RefreshToken
+}
+
+// RepositoryMockRotateRefreshTokenResults contains results of the Repository.RotateRefreshToken
+type RepositoryMockRotateRefreshTokenResults struct {
+	rp1 *entity.RefreshToken
+	err error
+}
+
+// RepositoryMockRotateRefreshTokenOrigins contains origins of expectations of the Repository.RotateRefreshToken
+type RepositoryMockRotateRefreshTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+	originNext      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Optional() *mRepositoryMockRotateRefreshToken {
+	mmRotateRefreshToken.optional = true
+	return mmRotateRefreshToken
+}
+
+// Expect sets up expected params for Repository.RotateRefreshToken
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Expect(ctx context.Context, tokenHash string, next *entity.RefreshToken) *mRepositoryMockRotateRefreshToken {
+	if mmRotateRefreshToken.mock.funcRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Set")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation == nil {
+		mmRotateRefreshToken.defaultExpectation = &RepositoryMockRotateRefreshTokenExpectation{}
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmRotateRefreshToken.defaultExpectation.params = &RepositoryMockRotateRefreshTokenParams{ctx, tokenHash, next}
+	mmRotateRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRotateRefreshToken.expectations {
+		if minimock.Equal(e.params, mmRotateRefreshToken.defaultExpectation.params) {
+			mmRotateRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRotateRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmRotateRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.RotateRefreshToken
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) ExpectCtxParam1(ctx context.Context) *mRepositoryMockRotateRefreshToken {
+	if mmRotateRefreshToken.mock.funcRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Set")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation == nil {
+		mmRotateRefreshToken.defaultExpectation = &RepositoryMockRotateRefreshTokenExpectation{}
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.params != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Expect")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRotateRefreshToken.defaultExpectation.paramPtrs = &RepositoryMockRotateRefreshTokenParamPtrs{}
+	}
+	mmRotateRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRotateRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRotateRefreshToken
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for Repository.RotateRefreshToken
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) ExpectTokenHashParam2(tokenHash string) *mRepositoryMockRotateRefreshToken {
+	if mmRotateRefreshToken.mock.funcRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Set")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation == nil {
+		mmRotateRefreshToken.defaultExpectation = &RepositoryMockRotateRefreshTokenExpectation{}
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.params != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Expect")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRotateRefreshToken.defaultExpectation.paramPtrs = &RepositoryMockRotateRefreshTokenParamPtrs{}
+	}
+	mmRotateRefreshToken.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmRotateRefreshToken.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmRotateRefreshToken
+}
+
+// ExpectNextParam3 sets up expected param next for Repository.RotateRefreshToken
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) ExpectNextParam3(next *entity.RefreshToken) *mRepositoryMockRotateRefreshToken {
+	if mmRotateRefreshToken.mock.funcRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Set")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation == nil {
+		mmRotateRefreshToken.defaultExpectation = &RepositoryMockRotateRefreshTokenExpectation{}
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.params != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Expect")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRotateRefreshToken.defaultExpectation.paramPtrs = &RepositoryMockRotateRefreshTokenParamPtrs{}
+	}
+	mmRotateRefreshToken.defaultExpectation.paramPtrs.next = &next
+	mmRotateRefreshToken.defaultExpectation.expectationOrigins.originNext = minimock.CallerInfo(1)
+
+	return mmRotateRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.RotateRefreshToken
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Inspect(f func(ctx context.Context, tokenHash string, next *entity.RefreshToken)) *mRepositoryMockRotateRefreshToken {
+	if mmRotateRefreshToken.mock.inspectFuncRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("Inspect function is already set for RepositoryMock.RotateRefreshToken")
+	}
+
+	mmRotateRefreshToken.mock.inspectFuncRotateRefreshToken = f
+
+	return mmRotateRefreshToken
+}
+
+// Return sets up results that will be returned by Repository.RotateRefreshToken
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Return(rp1 *entity.RefreshToken, err error) *RepositoryMock {
+	if mmRotateRefreshToken.mock.funcRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Set")
+	}
+
+	if mmRotateRefreshToken.defaultExpectation == nil {
+		mmRotateRefreshToken.defaultExpectation = &RepositoryMockRotateRefreshTokenExpectation{mock: mmRotateRefreshToken.mock}
+	}
+	mmRotateRefreshToken.defaultExpectation.results = &RepositoryMockRotateRefreshTokenResults{rp1, err}
+	mmRotateRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRotateRefreshToken.mock
+}
+
+// Set uses given function f to mock the Repository.RotateRefreshToken method
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Set(f func(ctx context.Context, tokenHash string, next *entity.RefreshToken) (rp1 *entity.RefreshToken, err error)) *RepositoryMock {
+	if mmRotateRefreshToken.defaultExpectation != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("Default expectation is already set for the Repository.RotateRefreshToken method")
+	}
+
+	if len(mmRotateRefreshToken.expectations) > 0 {
+		mmRotateRefreshToken.mock.t.Fatalf("Some expectations are already set for the Repository.RotateRefreshToken method")
+	}
+
+	mmRotateRefreshToken.mock.funcRotateRefreshToken = f
+	mmRotateRefreshToken.mock.funcRotateRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmRotateRefreshToken.mock
+}
+
+// When sets expectation for the Repository.RotateRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) When(ctx context.Context, tokenHash string, next *entity.RefreshToken) *RepositoryMockRotateRefreshTokenExpectation {
+	if mmRotateRefreshToken.mock.funcRotateRefreshToken != nil {
+		mmRotateRefreshToken.mock.t.Fatalf("RepositoryMock.RotateRefreshToken mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockRotateRefreshTokenExpectation{
+		mock:               mmRotateRefreshToken.mock,
+		params:             &RepositoryMockRotateRefreshTokenParams{ctx, tokenHash, next},
+		expectationOrigins: RepositoryMockRotateRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRotateRefreshToken.expectations = append(mmRotateRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.RotateRefreshToken return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockRotateRefreshTokenExpectation) Then(rp1 *entity.RefreshToken, err error) *RepositoryMock {
+	e.results = &RepositoryMockRotateRefreshTokenResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.RotateRefreshToken should be invoked
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Times(n uint64) *mRepositoryMockRotateRefreshToken {
+	if n == 0 {
+		mmRotateRefreshToken.mock.t.Fatalf("Times of RepositoryMock.RotateRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRotateRefreshToken.expectedInvocations, n)
+	mmRotateRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRotateRefreshToken
+}
+
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) invocationsDone() bool {
+	if len(mmRotateRefreshToken.expectations) == 0 && mmRotateRefreshToken.defaultExpectation == nil && mmRotateRefreshToken.mock.funcRotateRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRotateRefreshToken.mock.afterRotateRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRotateRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RotateRefreshToken implements mm_auth.Repository
+func (mmRotateRefreshToken *RepositoryMock) RotateRefreshToken(ctx context.Context, tokenHash string, next *entity.RefreshToken) (rp1 *entity.RefreshToken, err error) {
+	mm_atomic.AddUint64(&mmRotateRefreshToken.beforeRotateRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmRotateRefreshToken.afterRotateRefreshTokenCounter, 1)
+
+	mmRotateRefreshToken.t.Helper()
+
+	if mmRotateRefreshToken.inspectFuncRotateRefreshToken != nil {
+		mmRotateRefreshToken.inspectFuncRotateRefreshToken(ctx, tokenHash, next)
+	}
+
+	mm_params := RepositoryMockRotateRefreshTokenParams{ctx, tokenHash, next}
+
+	// Record call args
+	mmRotateRefreshToken.RotateRefreshTokenMock.mutex.Lock()
+	mmRotateRefreshToken.RotateRefreshTokenMock.callArgs = append(mmRotateRefreshToken.RotateRefreshTokenMock.callArgs, &mm_params)
+	mmRotateRefreshToken.RotateRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmRotateRefreshToken.RotateRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockRotateRefreshTokenParams{ctx, tokenHash, next}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRotateRefreshToken.t.Errorf("RepositoryMock.RotateRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmRotateRefreshToken.t.Errorf("RepositoryMock.RotateRefreshToken got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+			if mm_want_ptrs.next != nil && !minimock.Equal(*mm_want_ptrs.next, mm_got.next) {
+				mmRotateRefreshToken.t.Errorf("RepositoryMock.RotateRefreshToken got unexpected parameter next, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.expectationOrigins.originNext, *mm_want_ptrs.next, mm_got.next, minimock.Diff(*mm_want_ptrs.next, mm_got.next))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRotateRefreshToken.t.Errorf("RepositoryMock.RotateRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRotateRefreshToken.RotateRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRotateRefreshToken.t.Fatal("No results are set for the RepositoryMock.RotateRefreshToken")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmRotateRefreshToken.funcRotateRefreshToken != nil {
+		return mmRotateRefreshToken.funcRotateRefreshToken(ctx, tokenHash, next)
+	}
+	mmRotateRefreshToken.t.Fatalf("Unexpected call to RepositoryMock.RotateRefreshToken. %v %v %v", ctx, tokenHash, next)
+	return
+}
+
+// RotateRefreshTokenAfterCounter returns a count of finished RepositoryMock.RotateRefreshToken invocations
+func (mmRotateRefreshToken *RepositoryMock) RotateRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateRefreshToken.afterRotateRefreshTokenCounter)
+}
+
+// RotateRefreshTokenBeforeCounter returns a count of RepositoryMock.RotateRefreshToken invocations
+func (mmRotateRefreshToken *RepositoryMock) RotateRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateRefreshToken.beforeRotateRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.RotateRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRotateRefreshToken *mRepositoryMockRotateRefreshToken) Calls() []*RepositoryMockRotateRefreshTokenParams {
+	mmRotateRefreshToken.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockRotateRefreshTokenParams, len(mmRotateRefreshToken.callArgs))
+	copy(argCopy, mmRotateRefreshToken.callArgs)
+
+	mmRotateRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRotateRefreshTokenDone returns true if the count of the RotateRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockRotateRefreshTokenDone() bool {
+	if m.RotateRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RotateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RotateRefreshTokenMock.invocationsDone()
+}
+
+// MinimockRotateRefreshTokenInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockRotateRefreshTokenInspect() {
+	for _, e := range m.RotateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.RotateRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRotateRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterRotateRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RotateRefreshTokenMock.defaultExpectation != nil && afterRotateRefreshTokenCounter < 1 {
+		if m.RotateRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.RotateRefreshToken at\n%s", m.RotateRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.RotateRefreshToken at\n%s with params: %#v", m.RotateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.RotateRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRotateRefreshToken != nil && afterRotateRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.RotateRefreshToken at\n%s", m.funcRotateRefreshTokenOrigin)
+	}
+
+	if !m.RotateRefreshTokenMock.invocationsDone() && afterRotateRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.RotateRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RotateRefreshTokenMock.expectedInvocations), m.RotateRefreshTokenMock.expectedInvocationsOrigin, afterRotateRefreshTokenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateRefreshTokenInspect()
+
+			m.MinimockCreateUserInspect()
+
+			m.MinimockGetUserByIDInspect()
+
+			m.MinimockGetUserByUsernameInspect()
+
+			m.MinimockPurgeExpiredRefreshTokensInspect()
+
+			m.MinimockRevokeRefreshTokenFamilyInspect()
+
+			m.MinimockRotateRefreshTokenInspect()
 		}
 	})
 }
@@ -1124,7 +2574,11 @@ func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateRefreshTokenDone() &&
 		m.MinimockCreateUserDone() &&
 		m.MinimockGetUserByIDDone() &&
-		m.MinimockGetUserByUsernameDone()
+		m.MinimockGetUserByUsernameDone() &&
+		m.MinimockPurgeExpiredRefreshTokensDone() &&
+		m.MinimockRevokeRefreshTokenFamilyDone() &&
+		m.MinimockRotateRefreshTokenDone()
 }
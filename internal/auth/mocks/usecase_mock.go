@@ -19,13 +19,41 @@ type UseCaseMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcLogin          func(ctx context.Context, username string, password string) (s1 string, up1 *entity.UserResponse, err error)
+	funcGetMe          func(ctx context.Context, userID uint64) (up1 *entity.UserResponse, err error)
+	funcGetMeOrigin    string
+	inspectFuncGetMe   func(ctx context.Context, userID uint64)
+	afterGetMeCounter  uint64
+	beforeGetMeCounter uint64
+	GetMeMock          mUseCaseMockGetMe
+
+	funcLogin          func(ctx context.Context, username string, password string) (ap1 *entity.AuthTokens, up1 *entity.UserResponse, err error)
 	funcLoginOrigin    string
 	inspectFuncLogin   func(ctx context.Context, username string, password string)
 	afterLoginCounter  uint64
 	beforeLoginCounter uint64
 	LoginMock          mUseCaseMockLogin
 
+	funcLogout          func(ctx context.Context, refreshToken string) (err error)
+	funcLogoutOrigin    string
+	inspectFuncLogout   func(ctx context.Context, refreshToken string)
+	afterLogoutCounter  uint64
+	beforeLogoutCounter uint64
+	LogoutMock          mUseCaseMockLogout
+
+	funcPurgeExpiredRefreshTokens          func(ctx context.Context) (i1 int64, err error)
+	funcPurgeExpiredRefreshTokensOrigin    string
+	inspectFuncPurgeExpiredRefreshTokens   func(ctx context.Context)
+	afterPurgeExpiredRefreshTokensCounter  uint64
+	beforePurgeExpiredRefreshTokensCounter uint64
+	PurgeExpiredRefreshTokensMock          mUseCaseMockPurgeExpiredRefreshTokens
+
+	funcRefreshToken          func(ctx context.Context, refreshToken string) (ap1 *entity.AuthTokens, err error)
+	funcRefreshTokenOrigin    string
+	inspectFuncRefreshToken   func(ctx context.Context, refreshToken string)
+	afterRefreshTokenCounter  uint64
+	beforeRefreshTokenCounter uint64
+	RefreshTokenMock          mUseCaseMockRefreshToken
+
 	funcRegister          func(ctx context.Context, username string, password string) (up1 *entity.UserResponse, err error)
 	funcRegisterOrigin    string
 	inspectFuncRegister   func(ctx context.Context, username string, password string)
@@ -33,87 +61,1467 @@ type UseCaseMock struct {
 	beforeRegisterCounter uint64
 	RegisterMock          mUseCaseMockRegister
 
-	funcVerifyToken          func(ctx context.Context, token string) (u1 uint64, err error)
-	funcVerifyTokenOrigin    string
-	inspectFuncVerifyToken   func(ctx context.Context, token string)
-	afterVerifyTokenCounter  uint64
-	beforeVerifyTokenCounter uint64
-	VerifyTokenMock          mUseCaseMockVerifyToken
+	funcVerifyToken          func(ctx context.Context, token string) (tp1 *entity.TokenClaims, err error)
+	funcVerifyTokenOrigin    string
+	inspectFuncVerifyToken   func(ctx context.Context, token string)
+	afterVerifyTokenCounter  uint64
+	beforeVerifyTokenCounter uint64
+	VerifyTokenMock          mUseCaseMockVerifyToken
+}
+
+// NewUseCaseMock returns a mock for mm_auth.UseCase
+func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
+	m := &UseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMeMock = mUseCaseMockGetMe{mock: m}
+	m.GetMeMock.callArgs = []*UseCaseMockGetMeParams{}
+
+	m.LoginMock = mUseCaseMockLogin{mock: m}
+	m.LoginMock.callArgs = []*UseCaseMockLoginParams{}
+
+	m.LogoutMock = mUseCaseMockLogout{mock: m}
+	m.LogoutMock.callArgs = []*UseCaseMockLogoutParams{}
+
+	m.PurgeExpiredRefreshTokensMock = mUseCaseMockPurgeExpiredRefreshTokens{mock: m}
+	m.PurgeExpiredRefreshTokensMock.callArgs = []*UseCaseMockPurgeExpiredRefreshTokensParams{}
+
+	m.RefreshTokenMock = mUseCaseMockRefreshToken{mock: m}
+	m.RefreshTokenMock.callArgs = []*UseCaseMockRefreshTokenParams{}
+
+	m.RegisterMock = mUseCaseMockRegister{mock: m}
+	m.RegisterMock.callArgs = []*UseCaseMockRegisterParams{}
+
+	m.VerifyTokenMock = mUseCaseMockVerifyToken{mock: m}
+	m.VerifyTokenMock.callArgs = []*UseCaseMockVerifyTokenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUseCaseMockGetMe struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetMeExpectation
+	expectations       []*UseCaseMockGetMeExpectation
+
+	callArgs []*UseCaseMockGetMeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetMeExpectation specifies expectation struct of the UseCase.GetMe
+type UseCaseMockGetMeExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetMeParams
+	paramPtrs          *UseCaseMockGetMeParamPtrs
+	expectationOrigins UseCaseMockGetMeExpectationOrigins
+	results            *UseCaseMockGetMeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetMeParams contains parameters of the UseCase.GetMe
+type UseCaseMockGetMeParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// UseCaseMockGetMeParamPtrs contains pointers to parameters of the UseCase.GetMe
+type UseCaseMockGetMeParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// UseCaseMockGetMeResults contains results of the UseCase.GetMe
+type UseCaseMockGetMeResults struct {
+	up1 *entity.UserResponse
+	err error
+}
+
+// UseCaseMockGetMeOrigins contains origins of expectations of the UseCase.GetMe
+type UseCaseMockGetMeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMe *mUseCaseMockGetMe) Optional() *mUseCaseMockGetMe {
+	mmGetMe.optional = true
+	return mmGetMe
+}
+
+// Expect sets up expected params for UseCase.GetMe
+func (mmGetMe *mUseCaseMockGetMe) Expect(ctx context.Context, userID uint64) *mUseCaseMockGetMe {
+	if mmGetMe.mock.funcGetMe != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Set")
+	}
+
+	if mmGetMe.defaultExpectation == nil {
+		mmGetMe.defaultExpectation = &UseCaseMockGetMeExpectation{}
+	}
+
+	if mmGetMe.defaultExpectation.paramPtrs != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by ExpectParams functions")
+	}
+
+	mmGetMe.defaultExpectation.params = &UseCaseMockGetMeParams{ctx, userID}
+	mmGetMe.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMe.expectations {
+		if minimock.Equal(e.params, mmGetMe.defaultExpectation.params) {
+			mmGetMe.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMe.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMe
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetMe
+func (mmGetMe *mUseCaseMockGetMe) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetMe {
+	if mmGetMe.mock.funcGetMe != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Set")
+	}
+
+	if mmGetMe.defaultExpectation == nil {
+		mmGetMe.defaultExpectation = &UseCaseMockGetMeExpectation{}
+	}
+
+	if mmGetMe.defaultExpectation.params != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Expect")
+	}
+
+	if mmGetMe.defaultExpectation.paramPtrs == nil {
+		mmGetMe.defaultExpectation.paramPtrs = &UseCaseMockGetMeParamPtrs{}
+	}
+	mmGetMe.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMe.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMe
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.GetMe
+func (mmGetMe *mUseCaseMockGetMe) ExpectUserIDParam2(userID uint64) *mUseCaseMockGetMe {
+	if mmGetMe.mock.funcGetMe != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Set")
+	}
+
+	if mmGetMe.defaultExpectation == nil {
+		mmGetMe.defaultExpectation = &UseCaseMockGetMeExpectation{}
+	}
+
+	if mmGetMe.defaultExpectation.params != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Expect")
+	}
+
+	if mmGetMe.defaultExpectation.paramPtrs == nil {
+		mmGetMe.defaultExpectation.paramPtrs = &UseCaseMockGetMeParamPtrs{}
+	}
+	mmGetMe.defaultExpectation.paramPtrs.userID = &userID
+	mmGetMe.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetMe
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetMe
+func (mmGetMe *mUseCaseMockGetMe) Inspect(f func(ctx context.Context, userID uint64)) *mUseCaseMockGetMe {
+	if mmGetMe.mock.inspectFuncGetMe != nil {
+		mmGetMe.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetMe")
+	}
+
+	mmGetMe.mock.inspectFuncGetMe = f
+
+	return mmGetMe
+}
+
+// Return sets up results that will be returned by UseCase.GetMe
+func (mmGetMe *mUseCaseMockGetMe) Return(up1 *entity.UserResponse, err error) *UseCaseMock {
+	if mmGetMe.mock.funcGetMe != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Set")
+	}
+
+	if mmGetMe.defaultExpectation == nil {
+		mmGetMe.defaultExpectation = &UseCaseMockGetMeExpectation{mock: mmGetMe.mock}
+	}
+	mmGetMe.defaultExpectation.results = &UseCaseMockGetMeResults{up1, err}
+	mmGetMe.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMe.mock
+}
+
+// Set uses given function f to mock the UseCase.GetMe method
+func (mmGetMe *mUseCaseMockGetMe) Set(f func(ctx context.Context, userID uint64) (up1 *entity.UserResponse, err error)) *UseCaseMock {
+	if mmGetMe.defaultExpectation != nil {
+		mmGetMe.mock.t.Fatalf("Default expectation is already set for the UseCase.GetMe method")
+	}
+
+	if len(mmGetMe.expectations) > 0 {
+		mmGetMe.mock.t.Fatalf("Some expectations are already set for the UseCase.GetMe method")
+	}
+
+	mmGetMe.mock.funcGetMe = f
+	mmGetMe.mock.funcGetMeOrigin = minimock.CallerInfo(1)
+	return mmGetMe.mock
+}
+
+// When sets expectation for the UseCase.GetMe which will trigger the result defined by the following
+// Then helper
+func (mmGetMe *mUseCaseMockGetMe) When(ctx context.Context, userID uint64) *UseCaseMockGetMeExpectation {
+	if mmGetMe.mock.funcGetMe != nil {
+		mmGetMe.mock.t.Fatalf("UseCaseMock.GetMe mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetMeExpectation{
+		mock:               mmGetMe.mock,
+		params:             &UseCaseMockGetMeParams{ctx, userID},
+		expectationOrigins: UseCaseMockGetMeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMe.expectations = append(mmGetMe.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetMe return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetMeExpectation) Then(up1 *entity.UserResponse, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetMeResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetMe should be invoked
+func (mmGetMe *mUseCaseMockGetMe) Times(n uint64) *mUseCaseMockGetMe {
+	if n == 0 {
+		mmGetMe.mock.t.Fatalf("Times of UseCaseMock.GetMe mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMe.expectedInvocations, n)
+	mmGetMe.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMe
+}
+
+func (mmGetMe *mUseCaseMockGetMe) invocationsDone() bool {
+	if len(mmGetMe.expectations) == 0 && mmGetMe.defaultExpectation == nil && mmGetMe.mock.funcGetMe == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMe.mock.afterGetMeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMe.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMe implements mm_auth.UseCase
+func (mmGetMe *UseCaseMock) GetMe(ctx context.Context, userID uint64) (up1 *entity.UserResponse, err error) {
+	mm_atomic.AddUint64(&mmGetMe.beforeGetMeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMe.afterGetMeCounter, 1)
+
+	mmGetMe.t.Helper()
+
+	if mmGetMe.inspectFuncGetMe != nil {
+		mmGetMe.inspectFuncGetMe(ctx, userID)
+	}
+
+	mm_params := UseCaseMockGetMeParams{ctx, userID}
+
+	// Record call args
+	mmGetMe.GetMeMock.mutex.Lock()
+	mmGetMe.GetMeMock.callArgs = append(mmGetMe.GetMeMock.callArgs, &mm_params)
+	mmGetMe.GetMeMock.mutex.Unlock()
+
+	for _, e := range mmGetMe.GetMeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetMe.GetMeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMe.GetMeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMe.GetMeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMe.GetMeMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetMeParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMe.t.Errorf("UseCaseMock.GetMe got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMe.GetMeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetMe.t.Errorf("UseCaseMock.GetMe got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMe.GetMeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMe.t.Errorf("UseCaseMock.GetMe got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMe.GetMeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMe.GetMeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMe.t.Fatal("No results are set for the UseCaseMock.GetMe")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetMe.funcGetMe != nil {
+		return mmGetMe.funcGetMe(ctx, userID)
+	}
+	mmGetMe.t.Fatalf("Unexpected call to UseCaseMock.GetMe. %v %v", ctx, userID)
+	return
+}
+
+// GetMeAfterCounter returns a count of finished UseCaseMock.GetMe invocations
+func (mmGetMe *UseCaseMock) GetMeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMe.afterGetMeCounter)
+}
+
+// GetMeBeforeCounter returns a count of UseCaseMock.GetMe invocations
+func (mmGetMe *UseCaseMock) GetMeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMe.beforeGetMeCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetMe.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMe *mUseCaseMockGetMe) Calls() []*UseCaseMockGetMeParams {
+	mmGetMe.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetMeParams, len(mmGetMe.callArgs))
+	copy(argCopy, mmGetMe.callArgs)
+
+	mmGetMe.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMeDone returns true if the count of the GetMe invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetMeDone() bool {
+	if m.GetMeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMeMock.invocationsDone()
+}
+
+// MinimockGetMeInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetMeInspect() {
+	for _, e := range m.GetMeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetMe at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMeCounter := mm_atomic.LoadUint64(&m.afterGetMeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMeMock.defaultExpectation != nil && afterGetMeCounter < 1 {
+		if m.GetMeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetMe at\n%s", m.GetMeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetMe at\n%s with params: %#v", m.GetMeMock.defaultExpectation.expectationOrigins.origin, *m.GetMeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMe != nil && afterGetMeCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetMe at\n%s", m.funcGetMeOrigin)
+	}
+
+	if !m.GetMeMock.invocationsDone() && afterGetMeCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetMe at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMeMock.expectedInvocations), m.GetMeMock.expectedInvocationsOrigin, afterGetMeCounter)
+	}
+}
+
+type mUseCaseMockLogin struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockLoginExpectation
+	expectations       []*UseCaseMockLoginExpectation
+
+	callArgs []*UseCaseMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockLoginExpectation specifies expectation struct of the UseCase.Login
+type UseCaseMockLoginExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockLoginParams
+	paramPtrs          *UseCaseMockLoginParamPtrs
+	expectationOrigins UseCaseMockLoginExpectationOrigins
+	results            *UseCaseMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockLoginParams contains parameters of the UseCase.Login
+type UseCaseMockLoginParams struct {
+	ctx      context.Context
+	username string
+	password string
+}
+
+// UseCaseMockLoginParamPtrs contains pointers to parameters of the UseCase.Login
+type UseCaseMockLoginParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	password *string
+}
+
+// UseCaseMockLoginResults contains results of the UseCase.Login
+type UseCaseMockLoginResults struct {
+	ap1 *entity.AuthTokens
+	up1 *entity.UserResponse
+	err error
+}
+
+// UseCaseMockLoginOrigins contains origins of expectations of the UseCase.Login
+type UseCaseMockLoginExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mUseCaseMockLogin) Optional() *mUseCaseMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for UseCase.Login
+func (mmLogin *mUseCaseMockLogin) Expect(ctx context.Context, username string, password string) *mUseCaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &UseCaseMockLoginParams{ctx, username, password}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.Login
+func (mmLogin *mUseCaseMockLogin) ExpectCtxParam1(ctx context.Context) *mUseCaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UseCaseMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectUsernameParam2 sets up expected param username for UseCase.Login
+func (mmLogin *mUseCaseMockLogin) ExpectUsernameParam2(username string) *mUseCaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UseCaseMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.username = &username
+	mmLogin.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectPasswordParam3 sets up expected param password for UseCase.Login
+func (mmLogin *mUseCaseMockLogin) ExpectPasswordParam3(password string) *mUseCaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UseCaseMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.password = &password
+	mmLogin.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.Login
+func (mmLogin *mUseCaseMockLogin) Inspect(f func(ctx context.Context, username string, password string)) *mUseCaseMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for UseCaseMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by UseCase.Login
+func (mmLogin *mUseCaseMockLogin) Return(ap1 *entity.AuthTokens, up1 *entity.UserResponse, err error) *UseCaseMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &UseCaseMockLoginResults{ap1, up1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the UseCase.Login method
+func (mmLogin *mUseCaseMockLogin) Set(f func(ctx context.Context, username string, password string) (ap1 *entity.AuthTokens, up1 *entity.UserResponse, err error)) *UseCaseMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the UseCase.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the UseCase.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the UseCase.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mUseCaseMockLogin) When(ctx context.Context, username string, password string) *UseCaseMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &UseCaseMockLoginParams{ctx, username, password},
+		expectationOrigins: UseCaseMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.Login return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockLoginExpectation) Then(ap1 *entity.AuthTokens, up1 *entity.UserResponse, err error) *UseCaseMock {
+	e.results = &UseCaseMockLoginResults{ap1, up1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.Login should be invoked
+func (mmLogin *mUseCaseMockLogin) Times(n uint64) *mUseCaseMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of UseCaseMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mUseCaseMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_auth.UseCase
+func (mmLogin *UseCaseMock) Login(ctx context.Context, username string, password string) (ap1 *entity.AuthTokens, up1 *entity.UserResponse, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, username, password)
+	}
+
+	mm_params := UseCaseMockLoginParams{ctx, username, password}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.up1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockLoginParams{ctx, username, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the UseCaseMock.Login")
+		}
+		return (*mm_results).ap1, (*mm_results).up1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, username, password)
+	}
+	mmLogin.t.Fatalf("Unexpected call to UseCaseMock.Login. %v %v %v", ctx, username, password)
+	return
+}
+
+// LoginAfterCounter returns a count of finished UseCaseMock.Login invocations
+func (mmLogin *UseCaseMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of UseCaseMock.Login invocations
+func (mmLogin *UseCaseMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mUseCaseMockLogin) Calls() []*UseCaseMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mUseCaseMockLogout struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockLogoutExpectation
+	expectations       []*UseCaseMockLogoutExpectation
+
+	callArgs []*UseCaseMockLogoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockLogoutExpectation specifies expectation struct of the UseCase.Logout
+type UseCaseMockLogoutExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockLogoutParams
+	paramPtrs          *UseCaseMockLogoutParamPtrs
+	expectationOrigins UseCaseMockLogoutExpectationOrigins
+	results            *UseCaseMockLogoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockLogoutParams contains parameters of the UseCase.Logout
+type UseCaseMockLogoutParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// UseCaseMockLogoutParamPtrs contains pointers to parameters of the UseCase.Logout
+type UseCaseMockLogoutParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// UseCaseMockLogoutResults contains results of the UseCase.Logout
+type UseCaseMockLogoutResults struct {
+	err error
+}
+
+// UseCaseMockLogoutOrigins contains origins of expectations of the UseCase.Logout
+type UseCaseMockLogoutExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogout *mUseCaseMockLogout) Optional() *mUseCaseMockLogout {
+	mmLogout.optional = true
+	return mmLogout
+}
+
+// Expect sets up expected params for UseCase.Logout
+func (mmLogout *mUseCaseMockLogout) Expect(ctx context.Context, refreshToken string) *mUseCaseMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UseCaseMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by ExpectParams functions")
+	}
+
+	mmLogout.defaultExpectation.params = &UseCaseMockLogoutParams{ctx, refreshToken}
+	mmLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogout.expectations {
+		if minimock.Equal(e.params, mmLogout.defaultExpectation.params) {
+			mmLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogout.defaultExpectation.params)
+		}
+	}
+
+	return mmLogout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.Logout
+func (mmLogout *mUseCaseMockLogout) ExpectCtxParam1(ctx context.Context) *mUseCaseMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UseCaseMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &UseCaseMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for UseCase.Logout
+func (mmLogout *mUseCaseMockLogout) ExpectRefreshTokenParam2(refreshToken string) *mUseCaseMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UseCaseMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &UseCaseMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmLogout.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.Logout
+func (mmLogout *mUseCaseMockLogout) Inspect(f func(ctx context.Context, refreshToken string)) *mUseCaseMockLogout {
+	if mmLogout.mock.inspectFuncLogout != nil {
+		mmLogout.mock.t.Fatalf("Inspect function is already set for UseCaseMock.Logout")
+	}
+
+	mmLogout.mock.inspectFuncLogout = f
+
+	return mmLogout
+}
+
+// Return sets up results that will be returned by UseCase.Logout
+func (mmLogout *mUseCaseMockLogout) Return(err error) *UseCaseMock {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UseCaseMockLogoutExpectation{mock: mmLogout.mock}
+	}
+	mmLogout.defaultExpectation.results = &UseCaseMockLogoutResults{err}
+	mmLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// Set uses given function f to mock the UseCase.Logout method
+func (mmLogout *mUseCaseMockLogout) Set(f func(ctx context.Context, refreshToken string) (err error)) *UseCaseMock {
+	if mmLogout.defaultExpectation != nil {
+		mmLogout.mock.t.Fatalf("Default expectation is already set for the UseCase.Logout method")
+	}
+
+	if len(mmLogout.expectations) > 0 {
+		mmLogout.mock.t.Fatalf("Some expectations are already set for the UseCase.Logout method")
+	}
+
+	mmLogout.mock.funcLogout = f
+	mmLogout.mock.funcLogoutOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// When sets expectation for the UseCase.Logout which will trigger the result defined by the following
+// Then helper
+func (mmLogout *mUseCaseMockLogout) When(ctx context.Context, refreshToken string) *UseCaseMockLogoutExpectation {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UseCaseMock.Logout mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockLogoutExpectation{
+		mock:               mmLogout.mock,
+		params:             &UseCaseMockLogoutParams{ctx, refreshToken},
+		expectationOrigins: UseCaseMockLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogout.expectations = append(mmLogout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.Logout return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockLogoutExpectation) Then(err error) *UseCaseMock {
+	e.results = &UseCaseMockLogoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.Logout should be invoked
+func (mmLogout *mUseCaseMockLogout) Times(n uint64) *mUseCaseMockLogout {
+	if n == 0 {
+		mmLogout.mock.t.Fatalf("Times of UseCaseMock.Logout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogout.expectedInvocations, n)
+	mmLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogout
+}
+
+func (mmLogout *mUseCaseMockLogout) invocationsDone() bool {
+	if len(mmLogout.expectations) == 0 && mmLogout.defaultExpectation == nil && mmLogout.mock.funcLogout == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogout.mock.afterLogoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogout.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Logout implements mm_auth.UseCase
+func (mmLogout *UseCaseMock) Logout(ctx context.Context, refreshToken string) (err error) {
+	mm_atomic.AddUint64(&mmLogout.beforeLogoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogout.afterLogoutCounter, 1)
+
+	mmLogout.t.Helper()
+
+	if mmLogout.inspectFuncLogout != nil {
+		mmLogout.inspectFuncLogout(ctx, refreshToken)
+	}
+
+	mm_params := UseCaseMockLogoutParams{ctx, refreshToken}
+
+	// Record call args
+	mmLogout.LogoutMock.mutex.Lock()
+	mmLogout.LogoutMock.callArgs = append(mmLogout.LogoutMock.callArgs, &mm_params)
+	mmLogout.LogoutMock.mutex.Unlock()
+
+	for _, e := range mmLogout.LogoutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogout.LogoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogout.LogoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogout.LogoutMock.defaultExpectation.params
+		mm_want_ptrs := mmLogout.LogoutMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockLogoutParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogout.t.Errorf("UseCaseMock.Logout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmLogout.t.Errorf("UseCaseMock.Logout got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogout.t.Errorf("UseCaseMock.Logout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogout.LogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogout.LogoutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogout.t.Fatal("No results are set for the UseCaseMock.Logout")
+		}
+		return (*mm_results).err
+	}
+	if mmLogout.funcLogout != nil {
+		return mmLogout.funcLogout(ctx, refreshToken)
+	}
+	mmLogout.t.Fatalf("Unexpected call to UseCaseMock.Logout. %v %v", ctx, refreshToken)
+	return
+}
+
+// LogoutAfterCounter returns a count of finished UseCaseMock.Logout invocations
+func (mmLogout *UseCaseMock) LogoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.afterLogoutCounter)
+}
+
+// LogoutBeforeCounter returns a count of UseCaseMock.Logout invocations
+func (mmLogout *UseCaseMock) LogoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.beforeLogoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.Logout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogout *mUseCaseMockLogout) Calls() []*UseCaseMockLogoutParams {
+	mmLogout.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockLogoutParams, len(mmLogout.callArgs))
+	copy(argCopy, mmLogout.callArgs)
+
+	mmLogout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogoutDone returns true if the count of the Logout invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockLogoutDone() bool {
+	if m.LogoutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogoutMock.invocationsDone()
+}
+
+// MinimockLogoutInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockLogoutInspect() {
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.Logout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogoutCounter := mm_atomic.LoadUint64(&m.afterLogoutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogoutMock.defaultExpectation != nil && afterLogoutCounter < 1 {
+		if m.LogoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.Logout at\n%s", m.LogoutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.Logout at\n%s with params: %#v", m.LogoutMock.defaultExpectation.expectationOrigins.origin, *m.LogoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogout != nil && afterLogoutCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.Logout at\n%s", m.funcLogoutOrigin)
+	}
+
+	if !m.LogoutMock.invocationsDone() && afterLogoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.Logout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutMock.expectedInvocations), m.LogoutMock.expectedInvocationsOrigin, afterLogoutCounter)
+	}
+}
+
+type mUseCaseMockPurgeExpiredRefreshTokens struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockPurgeExpiredRefreshTokensExpectation
+	expectations       []*UseCaseMockPurgeExpiredRefreshTokensExpectation
+
+	callArgs []*UseCaseMockPurgeExpiredRefreshTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockPurgeExpiredRefreshTokensExpectation specifies expectation struct of the UseCase.PurgeExpiredRefreshTokens
+type UseCaseMockPurgeExpiredRefreshTokensExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockPurgeExpiredRefreshTokensParams
+	paramPtrs          *UseCaseMockPurgeExpiredRefreshTokensParamPtrs
+	expectationOrigins UseCaseMockPurgeExpiredRefreshTokensExpectationOrigins
+	results            *UseCaseMockPurgeExpiredRefreshTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockPurgeExpiredRefreshTokensParams contains parameters of the UseCase.PurgeExpiredRefreshTokens
+type UseCaseMockPurgeExpiredRefreshTokensParams struct {
+	ctx context.Context
+}
+
+// UseCaseMockPurgeExpiredRefreshTokensParamPtrs contains pointers to parameters of the UseCase.PurgeExpiredRefreshTokens
+type UseCaseMockPurgeExpiredRefreshTokensParamPtrs struct {
+	ctx *context.Context
+}
+
+// UseCaseMockPurgeExpiredRefreshTokensResults contains results of the UseCase.PurgeExpiredRefreshTokens
+type UseCaseMockPurgeExpiredRefreshTokensResults struct {
+	i1  int64
+	err error
+}
+
+// UseCaseMockPurgeExpiredRefreshTokensOrigins contains origins of expectations of the UseCase.PurgeExpiredRefreshTokens
+type UseCaseMockPurgeExpiredRefreshTokensExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Optional() *mUseCaseMockPurgeExpiredRefreshTokens {
+	mmPurgeExpiredRefreshTokens.optional = true
+	return mmPurgeExpiredRefreshTokens
+}
+
+// Expect sets up expected params for UseCase.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Expect(ctx context.Context) *mUseCaseMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("UseCaseMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &UseCaseMockPurgeExpiredRefreshTokensExpectation{}
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("UseCaseMock.PurgeExpiredRefreshTokens mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeExpiredRefreshTokens.defaultExpectation.params = &UseCaseMockPurgeExpiredRefreshTokensParams{ctx}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeExpiredRefreshTokens.expectations {
+		if minimock.Equal(e.params, mmPurgeExpiredRefreshTokens.defaultExpectation.params) {
+			mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeExpiredRefreshTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) ExpectCtxParam1(ctx context.Context) *mUseCaseMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("UseCaseMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &UseCaseMockPurgeExpiredRefreshTokensExpectation{}
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.params != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("UseCaseMock.PurgeExpiredRefreshTokens mock is already set by Expect")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs = &UseCaseMockPurgeExpiredRefreshTokensParamPtrs{}
+	}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeExpiredRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Inspect(f func(ctx context.Context)) *mUseCaseMockPurgeExpiredRefreshTokens {
+	if mmPurgeExpiredRefreshTokens.mock.inspectFuncPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Inspect function is already set for UseCaseMock.PurgeExpiredRefreshTokens")
+	}
+
+	mmPurgeExpiredRefreshTokens.mock.inspectFuncPurgeExpiredRefreshTokens = f
+
+	return mmPurgeExpiredRefreshTokens
+}
+
+// Return sets up results that will be returned by UseCase.PurgeExpiredRefreshTokens
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Return(i1 int64, err error) *UseCaseMock {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("UseCaseMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	if mmPurgeExpiredRefreshTokens.defaultExpectation == nil {
+		mmPurgeExpiredRefreshTokens.defaultExpectation = &UseCaseMockPurgeExpiredRefreshTokensExpectation{mock: mmPurgeExpiredRefreshTokens.mock}
+	}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.results = &UseCaseMockPurgeExpiredRefreshTokensResults{i1, err}
+	mmPurgeExpiredRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredRefreshTokens.mock
+}
+
+// Set uses given function f to mock the UseCase.PurgeExpiredRefreshTokens method
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Set(f func(ctx context.Context) (i1 int64, err error)) *UseCaseMock {
+	if mmPurgeExpiredRefreshTokens.defaultExpectation != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Default expectation is already set for the UseCase.PurgeExpiredRefreshTokens method")
+	}
+
+	if len(mmPurgeExpiredRefreshTokens.expectations) > 0 {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Some expectations are already set for the UseCase.PurgeExpiredRefreshTokens method")
+	}
+
+	mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens = f
+	mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokensOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredRefreshTokens.mock
+}
+
+// When sets expectation for the UseCase.PurgeExpiredRefreshTokens which will trigger the result defined by the following
+// Then helper
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) When(ctx context.Context) *UseCaseMockPurgeExpiredRefreshTokensExpectation {
+	if mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("UseCaseMock.PurgeExpiredRefreshTokens mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockPurgeExpiredRefreshTokensExpectation{
+		mock:               mmPurgeExpiredRefreshTokens.mock,
+		params:             &UseCaseMockPurgeExpiredRefreshTokensParams{ctx},
+		expectationOrigins: UseCaseMockPurgeExpiredRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeExpiredRefreshTokens.expectations = append(mmPurgeExpiredRefreshTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.PurgeExpiredRefreshTokens return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockPurgeExpiredRefreshTokensExpectation) Then(i1 int64, err error) *UseCaseMock {
+	e.results = &UseCaseMockPurgeExpiredRefreshTokensResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.PurgeExpiredRefreshTokens should be invoked
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Times(n uint64) *mUseCaseMockPurgeExpiredRefreshTokens {
+	if n == 0 {
+		mmPurgeExpiredRefreshTokens.mock.t.Fatalf("Times of UseCaseMock.PurgeExpiredRefreshTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeExpiredRefreshTokens.expectedInvocations, n)
+	mmPurgeExpiredRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredRefreshTokens
+}
+
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) invocationsDone() bool {
+	if len(mmPurgeExpiredRefreshTokens.expectations) == 0 && mmPurgeExpiredRefreshTokens.defaultExpectation == nil && mmPurgeExpiredRefreshTokens.mock.funcPurgeExpiredRefreshTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.mock.afterPurgeExpiredRefreshTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeExpiredRefreshTokens implements mm_auth.UseCase
+func (mmPurgeExpiredRefreshTokens *UseCaseMock) PurgeExpiredRefreshTokens(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeExpiredRefreshTokens.beforePurgeExpiredRefreshTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeExpiredRefreshTokens.afterPurgeExpiredRefreshTokensCounter, 1)
+
+	mmPurgeExpiredRefreshTokens.t.Helper()
+
+	if mmPurgeExpiredRefreshTokens.inspectFuncPurgeExpiredRefreshTokens != nil {
+		mmPurgeExpiredRefreshTokens.inspectFuncPurgeExpiredRefreshTokens(ctx)
+	}
+
+	mm_params := UseCaseMockPurgeExpiredRefreshTokensParams{ctx}
+
+	// Record call args
+	mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.mutex.Lock()
+	mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.callArgs = append(mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.callArgs, &mm_params)
+	mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.mutex.Unlock()
+
+	for _, e := range mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockPurgeExpiredRefreshTokensParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeExpiredRefreshTokens.t.Errorf("UseCaseMock.PurgeExpiredRefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeExpiredRefreshTokens.t.Errorf("UseCaseMock.PurgeExpiredRefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeExpiredRefreshTokens.PurgeExpiredRefreshTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeExpiredRefreshTokens.t.Fatal("No results are set for the UseCaseMock.PurgeExpiredRefreshTokens")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeExpiredRefreshTokens.funcPurgeExpiredRefreshTokens != nil {
+		return mmPurgeExpiredRefreshTokens.funcPurgeExpiredRefreshTokens(ctx)
+	}
+	mmPurgeExpiredRefreshTokens.t.Fatalf("Unexpected call to UseCaseMock.PurgeExpiredRefreshTokens. %v", ctx)
+	return
 }
 
-// NewUseCaseMock returns a mock for mm_auth.UseCase
-func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
-	m := &UseCaseMock{t: t}
+// PurgeExpiredRefreshTokensAfterCounter returns a count of finished UseCaseMock.PurgeExpiredRefreshTokens invocations
+func (mmPurgeExpiredRefreshTokens *UseCaseMock) PurgeExpiredRefreshTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.afterPurgeExpiredRefreshTokensCounter)
+}
 
-	if controller, ok := t.(minimock.MockController); ok {
-		controller.RegisterMocker(m)
+// PurgeExpiredRefreshTokensBeforeCounter returns a count of UseCaseMock.PurgeExpiredRefreshTokens invocations
+func (mmPurgeExpiredRefreshTokens *UseCaseMock) PurgeExpiredRefreshTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredRefreshTokens.beforePurgeExpiredRefreshTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.PurgeExpiredRefreshTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeExpiredRefreshTokens *mUseCaseMockPurgeExpiredRefreshTokens) Calls() []*UseCaseMockPurgeExpiredRefreshTokensParams {
+	mmPurgeExpiredRefreshTokens.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockPurgeExpiredRefreshTokensParams, len(mmPurgeExpiredRefreshTokens.callArgs))
+	copy(argCopy, mmPurgeExpiredRefreshTokens.callArgs)
+
+	mmPurgeExpiredRefreshTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeExpiredRefreshTokensDone returns true if the count of the PurgeExpiredRefreshTokens invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockPurgeExpiredRefreshTokensDone() bool {
+	if m.PurgeExpiredRefreshTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	m.LoginMock = mUseCaseMockLogin{mock: m}
-	m.LoginMock.callArgs = []*UseCaseMockLoginParams{}
+	for _, e := range m.PurgeExpiredRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
 
-	m.RegisterMock = mUseCaseMockRegister{mock: m}
-	m.RegisterMock.callArgs = []*UseCaseMockRegisterParams{}
+	return m.PurgeExpiredRefreshTokensMock.invocationsDone()
+}
 
-	m.VerifyTokenMock = mUseCaseMockVerifyToken{mock: m}
-	m.VerifyTokenMock.callArgs = []*UseCaseMockVerifyTokenParams{}
+// MinimockPurgeExpiredRefreshTokensInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockPurgeExpiredRefreshTokensInspect() {
+	for _, e := range m.PurgeExpiredRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.PurgeExpiredRefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
 
-	t.Cleanup(m.MinimockFinish)
+	afterPurgeExpiredRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterPurgeExpiredRefreshTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeExpiredRefreshTokensMock.defaultExpectation != nil && afterPurgeExpiredRefreshTokensCounter < 1 {
+		if m.PurgeExpiredRefreshTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.PurgeExpiredRefreshTokens at\n%s", m.PurgeExpiredRefreshTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.PurgeExpiredRefreshTokens at\n%s with params: %#v", m.PurgeExpiredRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.PurgeExpiredRefreshTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeExpiredRefreshTokens != nil && afterPurgeExpiredRefreshTokensCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.PurgeExpiredRefreshTokens at\n%s", m.funcPurgeExpiredRefreshTokensOrigin)
+	}
 
-	return m
+	if !m.PurgeExpiredRefreshTokensMock.invocationsDone() && afterPurgeExpiredRefreshTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.PurgeExpiredRefreshTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeExpiredRefreshTokensMock.expectedInvocations), m.PurgeExpiredRefreshTokensMock.expectedInvocationsOrigin, afterPurgeExpiredRefreshTokensCounter)
+	}
 }
 
-type mUseCaseMockLogin struct {
+type mUseCaseMockRefreshToken struct {
 	optional           bool
 	mock               *UseCaseMock
-	defaultExpectation *UseCaseMockLoginExpectation
-	expectations       []*UseCaseMockLoginExpectation
+	defaultExpectation *UseCaseMockRefreshTokenExpectation
+	expectations       []*UseCaseMockRefreshTokenExpectation
 
-	callArgs []*UseCaseMockLoginParams
+	callArgs []*UseCaseMockRefreshTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UseCaseMockLoginExpectation specifies expectation struct of the UseCase.Login
-type UseCaseMockLoginExpectation struct {
+// UseCaseMockRefreshTokenExpectation specifies expectation struct of the UseCase.RefreshToken
+type UseCaseMockRefreshTokenExpectation struct {
 	mock               *UseCaseMock
-	params             *UseCaseMockLoginParams
-	paramPtrs          *UseCaseMockLoginParamPtrs
-	expectationOrigins UseCaseMockLoginExpectationOrigins
-	results            *UseCaseMockLoginResults
+	params             *UseCaseMockRefreshTokenParams
+	paramPtrs          *UseCaseMockRefreshTokenParamPtrs
+	expectationOrigins UseCaseMockRefreshTokenExpectationOrigins
+	results            *UseCaseMockRefreshTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UseCaseMockLoginParams contains parameters of the UseCase.Login
-type UseCaseMockLoginParams struct {
-	ctx      context.Context
-	username string
-	password string
+// UseCaseMockRefreshTokenParams contains parameters of the UseCase.RefreshToken
+type UseCaseMockRefreshTokenParams struct {
+	ctx          context.Context
+	refreshToken string
 }
 
-// UseCaseMockLoginParamPtrs contains pointers to parameters of the UseCase.Login
-type UseCaseMockLoginParamPtrs struct {
-	ctx      *context.Context
-	username *string
-	password *string
+// UseCaseMockRefreshTokenParamPtrs contains pointers to parameters of the UseCase.RefreshToken
+type UseCaseMockRefreshTokenParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
 }
 
-// UseCaseMockLoginResults contains results of the UseCase.Login
-type UseCaseMockLoginResults struct {
-	s1  string
-	up1 *entity.UserResponse
+// UseCaseMockRefreshTokenResults contains results of the UseCase.RefreshToken
+type UseCaseMockRefreshTokenResults struct {
+	ap1 *entity.AuthTokens
 	err error
 }
 
-// UseCaseMockLoginOrigins contains origins of expectations of the UseCase.Login
-type UseCaseMockLoginExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
-	originPassword string
+// UseCaseMockRefreshTokenOrigins contains origins of expectations of the UseCase.RefreshToken
+type UseCaseMockRefreshTokenExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -121,320 +1529,292 @@ type UseCaseMockLoginExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogin *mUseCaseMockLogin) Optional() *mUseCaseMockLogin {
-	mmLogin.optional = true
-	return mmLogin
+func (mmRefreshToken *mUseCaseMockRefreshToken) Optional() *mUseCaseMockRefreshToken {
+	mmRefreshToken.optional = true
+	return mmRefreshToken
 }
 
-// Expect sets up expected params for UseCase.Login
-func (mmLogin *mUseCaseMockLogin) Expect(ctx context.Context, username string, password string) *mUseCaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+// Expect sets up expected params for UseCase.RefreshToken
+func (mmRefreshToken *mUseCaseMockRefreshToken) Expect(ctx context.Context, refreshToken string) *mUseCaseMockRefreshToken {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &UseCaseMockRefreshTokenExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by ExpectParams functions")
+	if mmRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by ExpectParams functions")
 	}
 
-	mmLogin.defaultExpectation.params = &UseCaseMockLoginParams{ctx, username, password}
-	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogin.expectations {
-		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
-			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+	mmRefreshToken.defaultExpectation.params = &UseCaseMockRefreshTokenParams{ctx, refreshToken}
+	mmRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRefreshToken.expectations {
+		if minimock.Equal(e.params, mmRefreshToken.defaultExpectation.params) {
+			mmRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshToken.defaultExpectation.params)
 		}
 	}
 
-	return mmLogin
-}
-
-// ExpectCtxParam1 sets up expected param ctx for UseCase.Login
-func (mmLogin *mUseCaseMockLogin) ExpectCtxParam1(ctx context.Context) *mUseCaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
-	}
-
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
-	}
-
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Expect")
-	}
-
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UseCaseMockLoginParamPtrs{}
-	}
-	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmLogin
+	return mmRefreshToken
 }
 
-// ExpectUsernameParam2 sets up expected param username for UseCase.Login
-func (mmLogin *mUseCaseMockLogin) ExpectUsernameParam2(username string) *mUseCaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UseCase.RefreshToken
+func (mmRefreshToken *mUseCaseMockRefreshToken) ExpectCtxParam1(ctx context.Context) *mUseCaseMockRefreshToken {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &UseCaseMockRefreshTokenExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Expect")
+	if mmRefreshToken.defaultExpectation.params != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UseCaseMockLoginParamPtrs{}
+	if mmRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRefreshToken.defaultExpectation.paramPtrs = &UseCaseMockRefreshTokenParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.username = &username
-	mmLogin.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmRefreshToken
 }
 
-// ExpectPasswordParam3 sets up expected param password for UseCase.Login
-func (mmLogin *mUseCaseMockLogin) ExpectPasswordParam3(password string) *mUseCaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for UseCase.RefreshToken
+func (mmRefreshToken *mUseCaseMockRefreshToken) ExpectRefreshTokenParam2(refreshToken string) *mUseCaseMockRefreshToken {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{}
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &UseCaseMockRefreshTokenExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Expect")
+	if mmRefreshToken.defaultExpectation.params != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UseCaseMockLoginParamPtrs{}
+	if mmRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRefreshToken.defaultExpectation.paramPtrs = &UseCaseMockRefreshTokenParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.password = &password
-	mmLogin.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+	mmRefreshToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmRefreshToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmRefreshToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the UseCase.Login
-func (mmLogin *mUseCaseMockLogin) Inspect(f func(ctx context.Context, username string, password string)) *mUseCaseMockLogin {
-	if mmLogin.mock.inspectFuncLogin != nil {
-		mmLogin.mock.t.Fatalf("Inspect function is already set for UseCaseMock.Login")
+// Inspect accepts an inspector function that has same arguments as the UseCase.RefreshToken
+func (mmRefreshToken *mUseCaseMockRefreshToken) Inspect(f func(ctx context.Context, refreshToken string)) *mUseCaseMockRefreshToken {
+	if mmRefreshToken.mock.inspectFuncRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("Inspect function is already set for UseCaseMock.RefreshToken")
 	}
 
-	mmLogin.mock.inspectFuncLogin = f
+	mmRefreshToken.mock.inspectFuncRefreshToken = f
 
-	return mmLogin
+	return mmRefreshToken
 }
 
-// Return sets up results that will be returned by UseCase.Login
-func (mmLogin *mUseCaseMockLogin) Return(s1 string, up1 *entity.UserResponse, err error) *UseCaseMock {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+// Return sets up results that will be returned by UseCase.RefreshToken
+func (mmRefreshToken *mUseCaseMockRefreshToken) Return(ap1 *entity.AuthTokens, err error) *UseCaseMock {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UseCaseMockLoginExpectation{mock: mmLogin.mock}
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &UseCaseMockRefreshTokenExpectation{mock: mmRefreshToken.mock}
 	}
-	mmLogin.defaultExpectation.results = &UseCaseMockLoginResults{s1, up1, err}
-	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmRefreshToken.defaultExpectation.results = &UseCaseMockRefreshTokenResults{ap1, err}
+	mmRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefreshToken.mock
 }
 
-// Set uses given function f to mock the UseCase.Login method
-func (mmLogin *mUseCaseMockLogin) Set(f func(ctx context.Context, username string, password string) (s1 string, up1 *entity.UserResponse, err error)) *UseCaseMock {
-	if mmLogin.defaultExpectation != nil {
-		mmLogin.mock.t.Fatalf("Default expectation is already set for the UseCase.Login method")
+// Set uses given function f to mock the UseCase.RefreshToken method
+func (mmRefreshToken *mUseCaseMockRefreshToken) Set(f func(ctx context.Context, refreshToken string) (ap1 *entity.AuthTokens, err error)) *UseCaseMock {
+	if mmRefreshToken.defaultExpectation != nil {
+		mmRefreshToken.mock.t.Fatalf("Default expectation is already set for the UseCase.RefreshToken method")
 	}
 
-	if len(mmLogin.expectations) > 0 {
-		mmLogin.mock.t.Fatalf("Some expectations are already set for the UseCase.Login method")
+	if len(mmRefreshToken.expectations) > 0 {
+		mmRefreshToken.mock.t.Fatalf("Some expectations are already set for the UseCase.RefreshToken method")
 	}
 
-	mmLogin.mock.funcLogin = f
-	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmRefreshToken.mock.funcRefreshToken = f
+	mmRefreshToken.mock.funcRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmRefreshToken.mock
 }
 
-// When sets expectation for the UseCase.Login which will trigger the result defined by the following
+// When sets expectation for the UseCase.RefreshToken which will trigger the result defined by the following
 // Then helper
-func (mmLogin *mUseCaseMockLogin) When(ctx context.Context, username string, password string) *UseCaseMockLoginExpectation {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UseCaseMock.Login mock is already set by Set")
+func (mmRefreshToken *mUseCaseMockRefreshToken) When(ctx context.Context, refreshToken string) *UseCaseMockRefreshTokenExpectation {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("UseCaseMock.RefreshToken mock is already set by Set")
 	}
 
-	expectation := &UseCaseMockLoginExpectation{
-		mock:               mmLogin.mock,
-		params:             &UseCaseMockLoginParams{ctx, username, password},
-		expectationOrigins: UseCaseMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UseCaseMockRefreshTokenExpectation{
+		mock:               mmRefreshToken.mock,
+		params:             &UseCaseMockRefreshTokenParams{ctx, refreshToken},
+		expectationOrigins: UseCaseMockRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	mmRefreshToken.expectations = append(mmRefreshToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UseCase.Login return parameters for the expectation previously defined by the When method
-func (e *UseCaseMockLoginExpectation) Then(s1 string, up1 *entity.UserResponse, err error) *UseCaseMock {
-	e.results = &UseCaseMockLoginResults{s1, up1, err}
+// Then sets up UseCase.RefreshToken return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockRefreshTokenExpectation) Then(ap1 *entity.AuthTokens, err error) *UseCaseMock {
+	e.results = &UseCaseMockRefreshTokenResults{ap1, err}
 	return e.mock
 }
 
-// Times sets number of times UseCase.Login should be invoked
-func (mmLogin *mUseCaseMockLogin) Times(n uint64) *mUseCaseMockLogin {
+// Times sets number of times UseCase.RefreshToken should be invoked
+func (mmRefreshToken *mUseCaseMockRefreshToken) Times(n uint64) *mUseCaseMockRefreshToken {
 	if n == 0 {
-		mmLogin.mock.t.Fatalf("Times of UseCaseMock.Login mock can not be zero")
+		mmRefreshToken.mock.t.Fatalf("Times of UseCaseMock.RefreshToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
-	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogin
+	mm_atomic.StoreUint64(&mmRefreshToken.expectedInvocations, n)
+	mmRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefreshToken
 }
 
-func (mmLogin *mUseCaseMockLogin) invocationsDone() bool {
-	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+func (mmRefreshToken *mUseCaseMockRefreshToken) invocationsDone() bool {
+	if len(mmRefreshToken.expectations) == 0 && mmRefreshToken.defaultExpectation == nil && mmRefreshToken.mock.funcRefreshToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshToken.mock.afterRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Login implements mm_auth.UseCase
-func (mmLogin *UseCaseMock) Login(ctx context.Context, username string, password string) (s1 string, up1 *entity.UserResponse, err error) {
-	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+// RefreshToken implements mm_auth.UseCase
+func (mmRefreshToken *UseCaseMock) RefreshToken(ctx context.Context, refreshToken string) (ap1 *entity.AuthTokens, err error) {
+	mm_atomic.AddUint64(&mmRefreshToken.beforeRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshToken.afterRefreshTokenCounter, 1)
 
-	mmLogin.t.Helper()
+	mmRefreshToken.t.Helper()
 
-	if mmLogin.inspectFuncLogin != nil {
-		mmLogin.inspectFuncLogin(ctx, username, password)
+	if mmRefreshToken.inspectFuncRefreshToken != nil {
+		mmRefreshToken.inspectFuncRefreshToken(ctx, refreshToken)
 	}
 
-	mm_params := UseCaseMockLoginParams{ctx, username, password}
+	mm_params := UseCaseMockRefreshTokenParams{ctx, refreshToken}
 
 	// Record call args
-	mmLogin.LoginMock.mutex.Lock()
-	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
-	mmLogin.LoginMock.mutex.Unlock()
+	mmRefreshToken.RefreshTokenMock.mutex.Lock()
+	mmRefreshToken.RefreshTokenMock.callArgs = append(mmRefreshToken.RefreshTokenMock.callArgs, &mm_params)
+	mmRefreshToken.RefreshTokenMock.mutex.Unlock()
 
-	for _, e := range mmLogin.LoginMock.expectations {
+	for _, e := range mmRefreshToken.RefreshTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.up1, e.results.err
+			return e.results.ap1, e.results.err
 		}
 	}
 
-	if mmLogin.LoginMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogin.LoginMock.defaultExpectation.params
-		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+	if mmRefreshToken.RefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshToken.RefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshToken.RefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshToken.RefreshTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := UseCaseMockLoginParams{ctx, username, password}
+		mm_got := UseCaseMockRefreshTokenParams{ctx, refreshToken}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+				mmRefreshToken.t.Errorf("UseCaseMock.RefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshToken.RefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
-				mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmRefreshToken.t.Errorf("UseCaseMock.RefreshToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshToken.RefreshTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogin.t.Errorf("UseCaseMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRefreshToken.t.Errorf("UseCaseMock.RefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRefreshToken.RefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		mm_results := mmRefreshToken.RefreshTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogin.t.Fatal("No results are set for the UseCaseMock.Login")
+			mmRefreshToken.t.Fatal("No results are set for the UseCaseMock.RefreshToken")
 		}
-		return (*mm_results).s1, (*mm_results).up1, (*mm_results).err
+		return (*mm_results).ap1, (*mm_results).err
 	}
-	if mmLogin.funcLogin != nil {
-		return mmLogin.funcLogin(ctx, username, password)
+	if mmRefreshToken.funcRefreshToken != nil {
+		return mmRefreshToken.funcRefreshToken(ctx, refreshToken)
 	}
-	mmLogin.t.Fatalf("Unexpected call to UseCaseMock.Login. %v %v %v", ctx, username, password)
+	mmRefreshToken.t.Fatalf("Unexpected call to UseCaseMock.RefreshToken. %v %v", ctx, refreshToken)
 	return
 }
 
-// LoginAfterCounter returns a count of finished UseCaseMock.Login invocations
-func (mmLogin *UseCaseMock) LoginAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+// RefreshTokenAfterCounter returns a count of finished UseCaseMock.RefreshToken invocations
+func (mmRefreshToken *UseCaseMock) RefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshToken.afterRefreshTokenCounter)
 }
 
-// LoginBeforeCounter returns a count of UseCaseMock.Login invocations
-func (mmLogin *UseCaseMock) LoginBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+// RefreshTokenBeforeCounter returns a count of UseCaseMock.RefreshToken invocations
+func (mmRefreshToken *UseCaseMock) RefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshToken.beforeRefreshTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to UseCaseMock.Login.
+// Calls returns a list of arguments used in each call to UseCaseMock.RefreshToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogin *mUseCaseMockLogin) Calls() []*UseCaseMockLoginParams {
-	mmLogin.mutex.RLock()
+func (mmRefreshToken *mUseCaseMockRefreshToken) Calls() []*UseCaseMockRefreshTokenParams {
+	mmRefreshToken.mutex.RLock()
 
-	argCopy := make([]*UseCaseMockLoginParams, len(mmLogin.callArgs))
-	copy(argCopy, mmLogin.callArgs)
+	argCopy := make([]*UseCaseMockRefreshTokenParams, len(mmRefreshToken.callArgs))
+	copy(argCopy, mmRefreshToken.callArgs)
 
-	mmLogin.mutex.RUnlock()
+	mmRefreshToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// MinimockRefreshTokenDone returns true if the count of the RefreshToken invocations corresponds
 // the number of defined expectations
-func (m *UseCaseMock) MinimockLoginDone() bool {
-	if m.LoginMock.optional {
+func (m *UseCaseMock) MinimockRefreshTokenDone() bool {
+	if m.RefreshTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LoginMock.expectations {
+	for _, e := range m.RefreshTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LoginMock.invocationsDone()
+	return m.RefreshTokenMock.invocationsDone()
 }
 
-// MinimockLoginInspect logs each unmet expectation
-func (m *UseCaseMock) MinimockLoginInspect() {
-	for _, e := range m.LoginMock.expectations {
+// MinimockRefreshTokenInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockRefreshTokenInspect() {
+	for _, e := range m.RefreshTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UseCaseMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UseCaseMock.RefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	afterRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterRefreshTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
-		if m.LoginMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UseCaseMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+	if m.RefreshTokenMock.defaultExpectation != nil && afterRefreshTokenCounter < 1 {
+		if m.RefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshToken at\n%s", m.RefreshTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UseCaseMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UseCaseMock.RefreshToken at\n%s with params: %#v", m.RefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.RefreshTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogin != nil && afterLoginCounter < 1 {
-		m.t.Errorf("Expected call to UseCaseMock.Login at\n%s", m.funcLoginOrigin)
+	if m.funcRefreshToken != nil && afterRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.RefreshToken at\n%s", m.funcRefreshTokenOrigin)
 	}
 
-	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
-		m.t.Errorf("Expected %d calls to UseCaseMock.Login at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	if !m.RefreshTokenMock.invocationsDone() && afterRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.RefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshTokenMock.expectedInvocations), m.RefreshTokenMock.expectedInvocationsOrigin, afterRefreshTokenCounter)
 	}
 }
 
@@ -850,7 +2230,7 @@ type UseCaseMockVerifyTokenParamPtrs struct {
 
 // UseCaseMockVerifyTokenResults contains results of the UseCase.VerifyToken
 type UseCaseMockVerifyTokenResults struct {
-	u1  uint64
+	tp1 *entity.TokenClaims
 	err error
 }
 
@@ -954,7 +2334,7 @@ func (mmVerifyToken *mUseCaseMockVerifyToken) Inspect(f func(ctx context.Context
 }
 
 // Return sets up results that will be returned by UseCase.VerifyToken
-func (mmVerifyToken *mUseCaseMockVerifyToken) Return(u1 uint64, err error) *UseCaseMock {
+func (mmVerifyToken *mUseCaseMockVerifyToken) Return(tp1 *entity.TokenClaims, err error) *UseCaseMock {
 	if mmVerifyToken.mock.funcVerifyToken != nil {
 		mmVerifyToken.mock.t.Fatalf("UseCaseMock.VerifyToken mock is already set by Set")
 	}
@@ -962,13 +2342,13 @@ func (mmVerifyToken *mUseCaseMockVerifyToken) Return(u1 uint64, err error) *UseC
 	if mmVerifyToken.defaultExpectation == nil {
 		mmVerifyToken.defaultExpectation = &UseCaseMockVerifyTokenExpectation{mock: mmVerifyToken.mock}
 	}
-	mmVerifyToken.defaultExpectation.results = &UseCaseMockVerifyTokenResults{u1, err}
+	mmVerifyToken.defaultExpectation.results = &UseCaseMockVerifyTokenResults{tp1, err}
 	mmVerifyToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmVerifyToken.mock
 }
 
 // Set uses given function f to mock the UseCase.VerifyToken method
-func (mmVerifyToken *mUseCaseMockVerifyToken) Set(f func(ctx context.Context, token string) (u1 uint64, err error)) *UseCaseMock {
+func (mmVerifyToken *mUseCaseMockVerifyToken) Set(f func(ctx context.Context, token string) (tp1 *entity.TokenClaims, err error)) *UseCaseMock {
 	if mmVerifyToken.defaultExpectation != nil {
 		mmVerifyToken.mock.t.Fatalf("Default expectation is already set for the UseCase.VerifyToken method")
 	}
@@ -999,8 +2379,8 @@ func (mmVerifyToken *mUseCaseMockVerifyToken) When(ctx context.Context, token st
 }
 
 // Then sets up UseCase.VerifyToken return parameters for the expectation previously defined by the When method
-func (e *UseCaseMockVerifyTokenExpectation) Then(u1 uint64, err error) *UseCaseMock {
-	e.results = &UseCaseMockVerifyTokenResults{u1, err}
+func (e *UseCaseMockVerifyTokenExpectation) Then(tp1 *entity.TokenClaims, err error) *UseCaseMock {
+	e.results = &UseCaseMockVerifyTokenResults{tp1, err}
 	return e.mock
 }
 
@@ -1026,7 +2406,7 @@ func (mmVerifyToken *mUseCaseMockVerifyToken) invocationsDone() bool {
 }
 
 // VerifyToken implements mm_auth.UseCase
-func (mmVerifyToken *UseCaseMock) VerifyToken(ctx context.Context, token string) (u1 uint64, err error) {
+func (mmVerifyToken *UseCaseMock) VerifyToken(ctx context.Context, token string) (tp1 *entity.TokenClaims, err error) {
 	mm_atomic.AddUint64(&mmVerifyToken.beforeVerifyTokenCounter, 1)
 	defer mm_atomic.AddUint64(&mmVerifyToken.afterVerifyTokenCounter, 1)
 
@@ -1046,7 +2426,7 @@ func (mmVerifyToken *UseCaseMock) VerifyToken(ctx context.Context, token string)
 	for _, e := range mmVerifyToken.VerifyTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.u1, e.results.err
+			return e.results.tp1, e.results.err
 		}
 	}
 
@@ -1078,7 +2458,7 @@ func (mmVerifyToken *UseCaseMock) VerifyToken(ctx context.Context, token string)
 		if mm_results == nil {
 			mmVerifyToken.t.Fatal("No results are set for the UseCaseMock.VerifyToken")
 		}
-		return (*mm_results).u1, (*mm_results).err
+		return (*mm_results).tp1, (*mm_results).err
 	}
 	if mmVerifyToken.funcVerifyToken != nil {
 		return mmVerifyToken.funcVerifyToken(ctx, token)
@@ -1159,8 +2539,16 @@ func (m *UseCaseMock) MinimockVerifyTokenInspect() {
 func (m *UseCaseMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockGetMeInspect()
+
 			m.MinimockLoginInspect()
 
+			m.MinimockLogoutInspect()
+
+			m.MinimockPurgeExpiredRefreshTokensInspect()
+
+			m.MinimockRefreshTokenInspect()
+
 			m.MinimockRegisterInspect()
 
 			m.MinimockVerifyTokenInspect()
@@ -1187,7 +2575,11 @@ func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UseCaseMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockGetMeDone() &&
 		m.MinimockLoginDone() &&
+		m.MinimockLogoutDone() &&
+		m.MinimockPurgeExpiredRefreshTokensDone() &&
+		m.MinimockRefreshTokenDone() &&
 		m.MinimockRegisterDone() &&
 		m.MinimockVerifyTokenDone()
 }
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// scanRefreshToken сканирует строку базы данных в структуру RefreshToken
+func scanRefreshToken(row pgx.Row) (*entity.RefreshToken, error) {
+	token := &entity.RefreshToken{}
+	var usedAt, revokedAt pgtype.Timestamptz
+
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&usedAt,
+		&revokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+	return token, nil
+}
+
+// CreateRefreshToken сохраняет новый refresh-токен и заполняет его ID и дату создания
+func (r *Repository) CreateRefreshToken(ctx context.Context, token *entity.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query, token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt).
+		Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return app_errors.WrapError(err, "ошибка сохранения refresh-токена")
+	}
+
+	return nil
+}
+
+// RotateRefreshToken обменивает refresh-токен с хешем tokenHash на новый токен next.
+// Старый токен помечается использованным, новый наследует его пользователя и семейство.
+// Если токен уже был использован или отозван, все токены семейства отзываются
+// и вместе с найденным токеном возвращается ErrRefreshTokenReused. Неизвестный или истекший токен возвращает ErrInvalidToken
+func (r *Repository) RotateRefreshToken(ctx context.Context, tokenHash string, next *entity.RefreshToken) (*entity.RefreshToken, error) {
+	selectQuery := `
+		SELECT id, user_id, family_id, token_hash, expires_at, used_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+		FOR UPDATE`
+
+	var current *entity.RefreshToken
+	var reused bool
+
+	err := r.withTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		current, err = scanRefreshToken(r.db.QueryRow(ctx, selectQuery, tokenHash))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return app_errors.ErrInvalidToken
+			}
+			return err
+		}
+
+		if current.UsedAt != nil || current.RevokedAt != nil {
+			reused = true
+			_, err = r.db.Exec(ctx, `
+				UPDATE refresh_tokens SET revoked_at = NOW()
+				WHERE family_id = $1 AND revoked_at IS NULL`, current.FamilyID)
+			return err
+		}
+
+		if !current.ExpiresAt.After(time.Now()) {
+			return app_errors.ErrInvalidToken
+		}
+
+		if _, err = r.db.Exec(ctx, "UPDATE refresh_tokens SET used_at = NOW() WHERE id = $1", current.ID); err != nil {
+			return err
+		}
+
+		next.UserID = current.UserID
+		next.FamilyID = current.FamilyID
+		return r.CreateRefreshToken(ctx, next)
+	})
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidToken) {
+			return nil, err
+		}
+		return nil, app_errors.WrapError(err, "ошибка обновления refresh-токена")
+	}
+
+	if reused {
+		return current, app_errors.ErrRefreshTokenReused
+	}
+
+	return current, nil
+}
+
+// RevokeRefreshTokenFamily отзывает refresh-токен с хешем tokenHash и все токены того же семейства.
+// Повторный отзыв не считается ошибкой, неизвестный токен возвращает ErrInvalidToken
+func (r *Repository) RevokeRefreshTokenFamily(ctx context.Context, tokenHash string) error {
+	query := `
+		WITH t AS (
+			SELECT family_id FROM refresh_tokens WHERE token_hash = $1
+		), revoked AS (
+			UPDATE refresh_tokens SET revoked_at = NOW()
+			WHERE family_id IN (SELECT family_id FROM t) AND revoked_at IS NULL
+		)
+		SELECT family_id FROM t`
+
+	var familyID string
+	if err := r.db.QueryRow(ctx, query, tokenHash).Scan(&familyID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return app_errors.ErrInvalidToken
+		}
+		return app_errors.WrapError(err, "ошибка отзыва refresh-токена")
+	}
+
+	return nil
+}
+
+// PurgeExpiredRefreshTokens удаляет refresh-токены, истекшие раньше указанного времени.
+// Использованные и отозванные токены хранятся до истечения, чтобы распознавать их повторное предъявление
+func (r *Repository) PurgeExpiredRefreshTokens(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	query := `
+		DELETE FROM refresh_tokens
+		WHERE expires_at < $1`
+
+	tag, err := r.db.Exec(ctx, query, expiredBefore)
+	if err != nil {
+		return 0, app_errors.WrapError(err, "ошибка при очистке истекших refresh-токенов")
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
@@ -9,22 +10,25 @@ import (
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/utils"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 // UseCase реализует интерфейс auth.UseCase
 type UseCase struct {
-	repo      auth.Repository
-	jwtConfig utils.JWTConfig
-	log       *logger.Logger
+	repo                 auth.Repository
+	jwtConfig            utils.JWTConfig
+	refreshTokenDuration time.Duration
+	log                  *logger.Logger
 }
 
 // New создает новый экземпляр UseCase
-func New(repo auth.Repository, jwtConfig utils.JWTConfig, log *logger.Logger) *UseCase {
+func New(repo auth.Repository, jwtConfig utils.JWTConfig, refreshTokenDuration time.Duration, log *logger.Logger) *UseCase {
 	return &UseCase{
-		repo:      repo,
-		jwtConfig: jwtConfig,
-		log:       log,
+		repo:                 repo,
+		jwtConfig:            jwtConfig,
+		refreshTokenDuration: refreshTokenDuration,
+		log:                  log,
 	}
 }
 
@@ -56,39 +60,161 @@ func (uc *UseCase) Register(ctx context.Context, username, password string) (*en
 	return createdUser.ToResponse(), nil
 }
 
-// Login авторизует пользователя и возвращает токен
-func (uc *UseCase) Login(ctx context.Context, username, password string) (string, *entity.UserResponse, error) {
+// Login авторизует пользователя и возвращает токен доступа и refresh-токен нового семейства
+func (uc *UseCase) Login(ctx context.Context, username, password string) (*entity.AuthTokens, *entity.UserResponse, error) {
 	user, err := uc.repo.GetUserByUsername(ctx, username)
 	if err != nil {
 		uc.log.Error(ctx, "Пользователь не найден", zap.String("username", username), zap.Error(err))
-		return "", nil, app_errors.ErrInvalidCredentials
+		return nil, nil, app_errors.ErrInvalidCredentials
 	}
 
 	err = utils.CheckPasswordHash(password, user.Password)
 	if err != nil {
 		uc.log.Warn(ctx, "Неверный пароль", zap.String("username", username))
-		return "", nil, app_errors.ErrInvalidCredentials
+		return nil, nil, app_errors.ErrInvalidCredentials
 	}
 
-	token, err := utils.GenerateJWT(user.ID, uc.jwtConfig)
+	rawRefresh, refreshToken, err := uc.newRefreshToken()
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка генерации refresh-токена", zap.Error(err))
+		return nil, nil, app_errors.WrapError(err, "ошибка авторизации")
+	}
+	refreshToken.UserID = user.ID
+	refreshToken.FamilyID = uuid.NewString()
+
+	if err := uc.repo.CreateRefreshToken(ctx, refreshToken); err != nil {
+		uc.log.Error(ctx, "Ошибка сохранения refresh-токена", zap.Uint64("user_id", user.ID), zap.Error(err))
+		return nil, nil, app_errors.WrapError(err, "ошибка авторизации")
+	}
+
+	tokens, err := uc.issueTokens(user.ID, rawRefresh, refreshToken)
 	if err != nil {
 		uc.log.Error(ctx, "Ошибка генерации токена", zap.Error(err))
-		return "", nil, app_errors.WrapError(err, "ошибка авторизации")
+		return nil, nil, app_errors.WrapError(err, "ошибка авторизации")
+	}
+
+	return tokens, user.ToResponse(), nil
+}
+
+// RefreshToken обменивает refresh-токен на новую пару токенов.
+// Каждый refresh-токен можно использовать один раз: повторное предъявление уже обмененного токена
+// считается признаком кражи, и все токены, полученные от того же входа, отзываются
+func (uc *UseCase) RefreshToken(ctx context.Context, refreshToken string) (*entity.AuthTokens, error) {
+	rawRefresh, next, err := uc.newRefreshToken()
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка генерации refresh-токена", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка обновления токена")
 	}
 
-	return token, user.ToResponse(), nil
+	current, err := uc.repo.RotateRefreshToken(ctx, utils.HashToken(refreshToken), next)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrRefreshTokenReused) {
+			uc.log.Warn(ctx, "Повторное использование refresh-токена, семейство токенов отозвано",
+				zap.Uint64("user_id", current.UserID),
+				zap.String("family_id", current.FamilyID))
+			return nil, err
+		}
+		if errors.Is(err, app_errors.ErrInvalidToken) {
+			return nil, err
+		}
+		uc.log.Error(ctx, "Ошибка обновления refresh-токена", zap.Error(err))
+		return nil, err
+	}
+
+	tokens, err := uc.issueTokens(current.UserID, rawRefresh, next)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка генерации токена", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка обновления токена")
+	}
+
+	uc.log.Info(ctx, "Токены обновлены", zap.Uint64("user_id", current.UserID))
+
+	return tokens, nil
+}
+
+// Logout отзывает refresh-токен и все токены, полученные от того же входа.
+// Выданные токены доступа остаются действительными до истечения
+func (uc *UseCase) Logout(ctx context.Context, refreshToken string) error {
+	if err := uc.repo.RevokeRefreshTokenFamily(ctx, utils.HashToken(refreshToken)); err != nil {
+		if !errors.Is(err, app_errors.ErrInvalidToken) {
+			uc.log.Error(ctx, "Ошибка отзыва refresh-токена", zap.Error(err))
+		}
+		return err
+	}
+
+	uc.log.Info(ctx, "Refresh-токены отозваны при выходе")
+
+	return nil
 }
 
-func (uc *UseCase) VerifyToken(ctx context.Context, tokenString string) (uint64, error) {
-	userID, err := utils.VerifyToken(tokenString, uc.jwtConfig.SecretKey)
+// PurgeExpiredRefreshTokens удаляет истекшие refresh-токены
+func (uc *UseCase) PurgeExpiredRefreshTokens(ctx context.Context) (int64, error) {
+	purged, err := uc.repo.PurgeExpiredRefreshTokens(ctx, time.Now())
 	if err != nil {
+		uc.log.Error(ctx, "Ошибка при очистке истекших refresh-токенов", zap.Error(err))
 		return 0, err
 	}
 
-	_, err = uc.repo.GetUserByID(ctx, userID)
+	if purged > 0 {
+		uc.log.Info(ctx, "Истекшие refresh-токены удалены", zap.Int64("count", purged))
+	}
+
+	return purged, nil
+}
+
+// newRefreshToken генерирует refresh-токен и возвращает его вместе с записью для хранения без пользователя и семейства
+func (uc *UseCase) newRefreshToken() (string, *entity.RefreshToken, error) {
+	raw, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return raw, &entity.RefreshToken{
+		TokenHash: utils.HashToken(raw),
+		ExpiresAt: time.Now().Add(uc.refreshTokenDuration),
+	}, nil
+}
+
+// issueTokens выпускает токен доступа и собирает его вместе с сохраненным refresh-токеном в ответ
+func (uc *UseCase) issueTokens(userID uint64, rawRefresh string, refreshToken *entity.RefreshToken) (*entity.AuthTokens, error) {
+	accessToken, expiresAt, err := utils.GenerateJWT(userID, uc.jwtConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.AuthTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  expiresAt,
+		RefreshToken:          rawRefresh,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, nil
+}
+
+// VerifyToken проверяет токен и существование пользователя, возвращая данные токена
+func (uc *UseCase) VerifyToken(ctx context.Context, tokenString string) (*entity.TokenClaims, error) {
+	claims, err := utils.VerifyToken(tokenString, uc.jwtConfig.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.repo.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, app_errors.ErrInvalidToken
+	}
+
+	return &entity.TokenClaims{
+		UserID:    claims.UserID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// GetMe возвращает текущего пользователя по ID из токена
+func (uc *UseCase) GetMe(ctx context.Context, userID uint64) (*entity.UserResponse, error) {
+	user, err := uc.repo.GetUserByID(ctx, userID)
 	if err != nil {
-		return 0, app_errors.ErrInvalidToken
+		uc.log.Warn(ctx, "Пользователь из токена не найден", zap.Uint64("user_id", userID), zap.Error(err))
+		return nil, err
 	}
 
-	return userID, nil
+	return user.ToResponse(), nil
 }
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/auth/mocks"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/utils"
+	"github.com/gojuno/minimock/v3"
+)
+
+const (
+	testSecretKey            = "test-secret-key"
+	testRefreshTokenDuration = time.Hour
+	testRefreshToken         = "presented-refresh-token"
+)
+
+func newTestUseCase(t *testing.T, repo *mocks.RepositoryMock) *UseCase {
+	t.Helper()
+
+	log, err := logger.New("test", "error")
+	if err != nil {
+		t.Fatalf("не удалось создать логгер: %v", err)
+	}
+
+	jwtConfig := utils.JWTConfig{SecretKey: testSecretKey, TokenDuration: 15 * time.Minute}
+	return New(repo, jwtConfig, testRefreshTokenDuration, log)
+}
+
+func TestRefreshToken(t *testing.T) {
+	current := &entity.RefreshToken{
+		ID:        1,
+		UserID:    42,
+		FamilyID:  "3f1c2a9e-8c1b-4d59-9a43-0d6c7f0e2b11",
+		TokenHash: utils.HashToken(testRefreshToken),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	dbErr := errors.New("connection reset")
+
+	tests := []struct {
+		name       string
+		rotated    *entity.RefreshToken
+		rotateErr  error
+		wantErr    error
+		wantTokens bool
+	}{
+		{name: "успешная ротация", rotated: current, wantTokens: true},
+		{name: "повторное использование отзывает семейство", rotated: current, rotateErr: app_errors.ErrRefreshTokenReused, wantErr: app_errors.ErrRefreshTokenReused},
+		// Обработчик отвечает на повторное использование как на недействительный токен
+		{name: "повторное использование — недействительный токен", rotated: current, rotateErr: app_errors.ErrRefreshTokenReused, wantErr: app_errors.ErrInvalidToken},
+		{name: "неизвестный или истекший токен", rotateErr: app_errors.ErrInvalidToken, wantErr: app_errors.ErrInvalidToken},
+		{name: "ошибка базы данных", rotateErr: dbErr, wantErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repo := mocks.NewRepositoryMock(mc)
+
+			var next *entity.RefreshToken
+			repo.RotateRefreshTokenMock.Set(func(_ context.Context, tokenHash string, n *entity.RefreshToken) (*entity.RefreshToken, error) {
+				if tokenHash != utils.HashToken(testRefreshToken) {
+					t.Errorf("в репозиторий передан хеш %q, ожидался хеш предъявленного токена", tokenHash)
+				}
+				next = n
+				return tt.rotated, tt.rotateErr
+			})
+
+			uc := newTestUseCase(t, repo)
+			tokens, err := uc.RefreshToken(context.Background(), testRefreshToken)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ошибка %v, ожидалась %v", err, tt.wantErr)
+				}
+				if tokens != nil {
+					t.Fatalf("при ошибке выданы токены: %+v", tokens)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+
+			if tokens.RefreshToken == testRefreshToken {
+				t.Fatal("refresh-токен не обновлен")
+			}
+			if next.TokenHash != utils.HashToken(tokens.RefreshToken) {
+				t.Fatal("в базе данных сохраняется хеш другого refresh-токена")
+			}
+			if !tokens.RefreshTokenExpiresAt.Equal(next.ExpiresAt) {
+				t.Fatalf("срок refresh-токена %v не совпадает с сохраненным %v", tokens.RefreshTokenExpiresAt, next.ExpiresAt)
+			}
+
+			claims, err := utils.VerifyToken(tokens.AccessToken, testSecretKey)
+			if err != nil {
+				t.Fatalf("выдан недействительный токен доступа: %v", err)
+			}
+			if claims.UserID != current.UserID {
+				t.Fatalf("токен доступа выдан пользователю %d, ожидался %d", claims.UserID, current.UserID)
+			}
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	dbErr := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		revokeErr error
+		wantErr   error
+	}{
+		{name: "отзыв семейства"},
+		{name: "неизвестный токен", revokeErr: app_errors.ErrInvalidToken, wantErr: app_errors.ErrInvalidToken},
+		{name: "ошибка базы данных", revokeErr: dbErr, wantErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repo := mocks.NewRepositoryMock(mc)
+			repo.RevokeRefreshTokenFamilyMock.
+				Expect(minimock.AnyContext, utils.HashToken(testRefreshToken)).
+				Return(tt.revokeErr)
+
+			err := newTestUseCase(t, repo).Logout(context.Background(), testRefreshToken)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ошибка %v, ожидалась %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -9,11 +9,32 @@ import (
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/auth"
 	authRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/auth/repo/postgres"
 	authUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/auth/usecase"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/category"
+	categoryRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/category/repo/postgres"
+	categoryUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/category/usecase"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/config"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/currency"
+	currencyProvider "github.com/Snake1-1eyes/vk_task_marketplace/internal/currency/provider"
+	currencyRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/currency/repo/postgres"
+	currencyUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/currency/usecase"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/listing"
 	listingRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/listing/repo/postgres"
 	listingUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/listing/usecase"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+	notificationNotifier "github.com/Snake1-1eyes/vk_task_marketplace/internal/notification/notifier"
+	notificationRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/notification/repo/postgres"
+	notificationUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/notification/usecase"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/storage"
+	localStorage "github.com/Snake1-1eyes/vk_task_marketplace/internal/storage/local"
+	s3Storage "github.com/Snake1-1eyes/vk_task_marketplace/internal/storage/s3"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload"
+	uploadFetcher "github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/fetcher"
+	uploadRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/repo/postgres"
+	uploadUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/usecase"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/user"
+	userRepo "github.com/Snake1-1eyes/vk_task_marketplace/internal/user/repo/postgres"
+	userUC "github.com/Snake1-1eyes/vk_task_marketplace/internal/user/usecase"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/utils"
 	postgresstorage "github.com/Snake1-1eyes/vk_task_marketplace/pkg/postgres_storage"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -24,14 +45,24 @@ import (
 
 // Services содержит все сервисы приложения
 type Services struct {
-	AuthUseCase     auth.UseCase
-	ListingsUseCase listing.UseCase
+	AuthUseCase          auth.UseCase
+	ListingsUseCase      listing.UseCase
+	CategoriesUseCase    category.UseCase
+	CurrencyUseCase      currency.UseCase
+	UploadsUseCase       upload.UseCase
+	NotificationsUseCase notification.UseCase
+	UsersUseCase         user.UseCase
 }
 
 // Repositories содержит все репозитории приложения
 type Repositories struct {
-	AuthRepo     auth.Repository
-	ListingsRepo listing.Repository
+	AuthRepo          auth.Repository
+	ListingsRepo      listing.Repository
+	CategoriesRepo    category.Repository
+	CurrencyRepo      currency.Repository
+	UploadsRepo       upload.Repository
+	NotificationsRepo notification.Repository
+	UsersRepo         user.Repository
 }
 
 // InitializeConfig загружает конфигурацию приложения
@@ -85,10 +116,20 @@ func InitializeRepositories(ctx context.Context, cfg *config.Config, log *logger
 
 	authRepository := authRepo.New(dbClient, txManager, log)
 	listingsRepository := listingRepo.New(dbClient, txManager, log)
+	categoriesRepository := categoryRepo.New(dbClient, log)
+	currencyRepository := currencyRepo.New(dbClient, log)
+	uploadsRepository := uploadRepo.New(dbClient, txManager, log)
+	notificationsRepository := notificationRepo.New(dbClient, txManager, log)
+	usersRepository := userRepo.New(dbClient, txManager, log)
 
 	return &Repositories{
-		AuthRepo:     authRepository,
-		ListingsRepo: listingsRepository,
+		AuthRepo:          authRepository,
+		ListingsRepo:      listingsRepository,
+		CategoriesRepo:    categoriesRepository,
+		CurrencyRepo:      currencyRepository,
+		UploadsRepo:       uploadsRepository,
+		NotificationsRepo: notificationsRepository,
+		UsersRepo:         usersRepository,
 	}, nil
 }
 
@@ -96,16 +137,113 @@ func InitializeRepositories(ctx context.Context, cfg *config.Config, log *logger
 func InitializeServices(ctx context.Context, cfg *config.Config, repos *Repositories, log *logger.Logger) *Services {
 	jwtConfig := utils.JWTConfig{
 		SecretKey:     cfg.JWT.SecretKey,
-		TokenDuration: cfg.JWT.TokenDuration,
+		TokenDuration: cfg.JWT.AccessTokenDuration,
 	}
 
-	authService := authUC.New(repos.AuthRepo, jwtConfig, log)
-	listingsService := listingUC.New(repos.ListingsRepo, log)
+	authService := authUC.New(repos.AuthRepo, jwtConfig, cfg.JWT.RefreshTokenDuration, log)
+	listingsConfig := listingUC.Config{
+		DeletedRetention:    cfg.Listings.DeletedRetention,
+		SimilarityThreshold: cfg.Listings.SimilarityThreshold,
+		SuggestionsMaxTerms: cfg.Listings.SuggestionsMaxTerms,
+		PriceBuckets:        cfg.Listings.PriceBuckets,
+		DefaultCurrency:     cfg.Listings.DefaultCurrency,
+		MaxImages:           cfg.Listings.MaxImages,
+		ImportRemoteImages:  cfg.Listings.ImportRemoteImages,
+		MaxSavedSearches:    cfg.Listings.MaxSavedSearches,
+	}
+
+	variants, err := uploadUC.ParseVariantSpecs(cfg.Uploads.Variants)
+	if err != nil {
+		log.Fatal(ctx, "Некорректное описание уменьшенных копий изображений", zap.Error(err))
+	}
+
+	uploadsConfig := uploadUC.Config{
+		MaxSize:        cfg.Uploads.MaxSize,
+		AllowedTypes:   cfg.Uploads.AllowedTypes,
+		Variants:       variants,
+		VariantQuality: cfg.Uploads.VariantQuality,
+	}
+	imageFetcher := uploadFetcher.NewHTTPFetcher(uploadFetcher.Config{
+		Timeout:      cfg.Uploads.Import.Timeout,
+		MaxRedirects: cfg.Uploads.Import.MaxRedirects,
+		AllowPrivate: cfg.Uploads.Import.AllowPrivate,
+	})
+	uploadsService := uploadUC.New(repos.UploadsRepo, InitializeStorage(ctx, cfg, log), imageFetcher, uploadsConfig, log)
+
+	notifier := InitializeNotifier(ctx, cfg, repos.NotificationsRepo, log)
+	notificationsService := notificationUC.New(repos.NotificationsRepo, log)
+
+	listingsService := listingUC.New(repos.ListingsRepo, repos.CategoriesRepo, repos.CurrencyRepo, repos.AuthRepo, uploadsService, notifier, listingsConfig, log)
+	categoriesService := categoryUC.New(repos.CategoriesRepo, log)
+	usersService := userUC.New(repos.UsersRepo, log)
+	currencyService := currencyUC.New(repos.CurrencyRepo, InitializeRateProvider(ctx, cfg, log), cfg.Listings.DefaultCurrency, log)
 
 	return &Services{
-		AuthUseCase:     authService,
-		ListingsUseCase: listingsService,
+		AuthUseCase:          authService,
+		ListingsUseCase:      listingsService,
+		CategoriesUseCase:    categoriesService,
+		CurrencyUseCase:      currencyService,
+		UploadsUseCase:       uploadsService,
+		NotificationsUseCase: notificationsService,
+		UsersUseCase:         usersService,
+	}
+}
+
+// InitializeStorage создает файловое хранилище, выбранное в конфигурации
+func InitializeStorage(ctx context.Context, cfg *config.Config, log *logger.Logger) storage.Storage {
+	switch cfg.Storage.Backend {
+	case "s3":
+		log.Info(ctx, "Файлы хранятся в S3-совместимом хранилище",
+			zap.String("endpoint", cfg.Storage.S3.Endpoint),
+			zap.String("bucket", cfg.Storage.S3.Bucket))
+		return s3Storage.New(s3Storage.Config{
+			Endpoint:  cfg.Storage.S3.Endpoint,
+			Region:    cfg.Storage.S3.Region,
+			Bucket:    cfg.Storage.S3.Bucket,
+			AccessKey: cfg.Storage.S3.AccessKey,
+			SecretKey: cfg.Storage.S3.SecretKey,
+			PublicURL: cfg.Storage.PublicURL,
+			Timeout:   cfg.Storage.S3.Timeout,
+		})
+	case "local":
+	default:
+		log.Warn(ctx, "Неизвестное файловое хранилище, используется локальный диск",
+			zap.String("backend", cfg.Storage.Backend))
+	}
+	log.Info(ctx, "Файлы хранятся на локальном диске", zap.String("dir", cfg.Storage.LocalDir))
+	return localStorage.New(cfg.Storage.LocalDir, cfg.Storage.PublicURL)
+}
+
+// InitializeRateProvider создает поставщика курсов валют, выбранного в конфигурации
+func InitializeRateProvider(ctx context.Context, cfg *config.Config, log *logger.Logger) currency.RateProvider {
+	switch cfg.Currency.Provider {
+	case "file":
+		log.Info(ctx, "Курсы валют загружаются из файла", zap.String("path", cfg.Currency.RatesFile))
+		return currencyProvider.NewFileProvider(cfg.Currency.RatesFile, cfg.Listings.DefaultCurrency)
+	case "static":
+	default:
+		log.Warn(ctx, "Неизвестный поставщик курсов валют, используются курсы из конфигурации",
+			zap.String("provider", cfg.Currency.Provider))
+	}
+	return currencyProvider.NewStaticProvider(cfg.Currency.Rates)
+}
+
+// InitializeNotifier создает уведомитель, доставляющий уведомления через каналы из конфигурации
+func InitializeNotifier(ctx context.Context, cfg *config.Config, repo notification.Repository, log *logger.Logger) notification.Notifier {
+	notifiers := make([]notification.Notifier, 0, len(cfg.Notifications.Channels))
+	for _, channel := range cfg.Notifications.Channels {
+		switch channel {
+		case "inapp":
+			notifiers = append(notifiers, notificationNotifier.NewInAppNotifier(repo))
+		case "log":
+			notifiers = append(notifiers, notificationNotifier.NewLogNotifier(log))
+		default:
+			log.Warn(ctx, "Неизвестный канал доставки уведомлений пропущен", zap.String("channel", channel))
+			continue
+		}
+		log.Info(ctx, "Включен канал доставки уведомлений", zap.String("channel", channel))
 	}
+	return notificationNotifier.NewMultiNotifier(notifiers...)
 }
 
 // RunMigrations запускает миграции для PostgreSQL
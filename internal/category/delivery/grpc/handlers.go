@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/category"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	categories_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/categories"
+	"go.uber.org/zap"
+)
+
+// Handler структура обработчика gRPC запросов
+type Handler struct {
+	categories_pb.UnimplementedCategoriesServiceServer
+	categoryUC category.UseCase
+	log        *logger.Logger
+}
+
+// New создает новый экземпляр Handler
+func New(categoryUC category.UseCase, log *logger.Logger) *Handler {
+	return &Handler{
+		categoryUC: categoryUC,
+		log:        log,
+	}
+}
+
+// ListCategories обрабатывает запрос на получение списка категорий
+func (h *Handler) ListCategories(ctx context.Context, req *categories_pb.ListCategoriesRequest) (*categories_pb.ListCategoriesResponse, error) {
+	categories, err := h.categoryUC.ListCategories(ctx, req.ParentId)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при получении списка категорий", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &categories_pb.ListCategoriesResponse{
+		Categories: make([]*categories_pb.Category, 0, len(categories)),
+	}
+
+	for _, category := range categories {
+		response.Categories = append(response.Categories, adapter.MapCategoryToProto(category))
+	}
+
+	return response, nil
+}
+
+// GetCategoryTree обрабатывает запрос на получение дерева категорий
+func (h *Handler) GetCategoryTree(ctx context.Context, req *categories_pb.GetCategoryTreeRequest) (*categories_pb.CategoryTreeResponse, error) {
+	roots, err := h.categoryUC.GetCategoryTree(ctx, req.RootId)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при получении дерева категорий", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &categories_pb.CategoryTreeResponse{
+		Roots: make([]*categories_pb.CategoryNode, 0, len(roots)),
+	}
+
+	for _, root := range roots {
+		response.Roots = append(response.Roots, adapter.MapCategoryNodeToProto(root))
+	}
+
+	return response, nil
+}
+
+// GetCategoryAttributes обрабатывает запрос на получение схемы атрибутов категории
+func (h *Handler) GetCategoryAttributes(ctx context.Context, req *categories_pb.GetCategoryAttributesRequest) (*categories_pb.CategoryAttributesResponse, error) {
+	attributes, err := h.categoryUC.GetCategoryAttributes(ctx, req.Id)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при получении атрибутов категории",
+			zap.Uint64("category_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &categories_pb.CategoryAttributesResponse{
+		Attributes: make([]*categories_pb.CategoryAttribute, 0, len(attributes)),
+	}
+
+	for _, attribute := range attributes {
+		response.Attributes = append(response.Attributes, adapter.MapCategoryAttributeToProto(attribute))
+	}
+
+	return response, nil
+}
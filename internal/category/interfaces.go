@@ -0,0 +1,20 @@
+package category
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+type Repository interface {
+	GetCategories(ctx context.Context) ([]*entity.Category, error)
+	GetCategoriesByParent(ctx context.Context, parentID *uint64) ([]*entity.Category, error)
+	GetCategoryByID(ctx context.Context, id uint64) (*entity.Category, error)
+	GetCategoryAttributes(ctx context.Context, categoryID uint64) ([]*entity.CategoryAttribute, error)
+}
+
+type UseCase interface {
+	ListCategories(ctx context.Context, parentID *uint64) ([]*entity.Category, error)
+	GetCategoryTree(ctx context.Context, rootID *uint64) ([]*entity.CategoryNode, error)
+	GetCategoryAttributes(ctx context.Context, categoryID uint64) ([]*entity.CategoryAttribute, error)
+}
@@ -0,0 +1,1455 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/category.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_category.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetCategories          func(ctx context.Context) (cpa1 []*entity.Category, err error)
+	funcGetCategoriesOrigin    string
+	inspectFuncGetCategories   func(ctx context.Context)
+	afterGetCategoriesCounter  uint64
+	beforeGetCategoriesCounter uint64
+	GetCategoriesMock          mRepositoryMockGetCategories
+
+	funcGetCategoriesByParent          func(ctx context.Context, parentID *uint64) (cpa1 []*entity.Category, err error)
+	funcGetCategoriesByParentOrigin    string
+	inspectFuncGetCategoriesByParent   func(ctx context.Context, parentID *uint64)
+	afterGetCategoriesByParentCounter  uint64
+	beforeGetCategoriesByParentCounter uint64
+	GetCategoriesByParentMock          mRepositoryMockGetCategoriesByParent
+
+	funcGetCategoryAttributes          func(ctx context.Context, categoryID uint64) (cpa1 []*entity.CategoryAttribute, err error)
+	funcGetCategoryAttributesOrigin    string
+	inspectFuncGetCategoryAttributes   func(ctx context.Context, categoryID uint64)
+	afterGetCategoryAttributesCounter  uint64
+	beforeGetCategoryAttributesCounter uint64
+	GetCategoryAttributesMock          mRepositoryMockGetCategoryAttributes
+
+	funcGetCategoryByID          func(ctx context.Context, id uint64) (cp1 *entity.Category, err error)
+	funcGetCategoryByIDOrigin    string
+	inspectFuncGetCategoryByID   func(ctx context.Context, id uint64)
+	afterGetCategoryByIDCounter  uint64
+	beforeGetCategoryByIDCounter uint64
+	GetCategoryByIDMock          mRepositoryMockGetCategoryByID
+}
+
+// NewRepositoryMock returns a mock for mm_category.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetCategoriesMock = mRepositoryMockGetCategories{mock: m}
+	m.GetCategoriesMock.callArgs = []*RepositoryMockGetCategoriesParams{}
+
+	m.GetCategoriesByParentMock = mRepositoryMockGetCategoriesByParent{mock: m}
+	m.GetCategoriesByParentMock.callArgs = []*RepositoryMockGetCategoriesByParentParams{}
+
+	m.GetCategoryAttributesMock = mRepositoryMockGetCategoryAttributes{mock: m}
+	m.GetCategoryAttributesMock.callArgs = []*RepositoryMockGetCategoryAttributesParams{}
+
+	m.GetCategoryByIDMock = mRepositoryMockGetCategoryByID{mock: m}
+	m.GetCategoryByIDMock.callArgs = []*RepositoryMockGetCategoryByIDParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetCategories struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCategoriesExpectation
+	expectations       []*RepositoryMockGetCategoriesExpectation
+
+	callArgs []*RepositoryMockGetCategoriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetCategoriesExpectation specifies expectation struct of the Repository.GetCategories
+type RepositoryMockGetCategoriesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetCategoriesParams
+	paramPtrs          *RepositoryMockGetCategoriesParamPtrs
+	expectationOrigins RepositoryMockGetCategoriesExpectationOrigins
+	results            *RepositoryMockGetCategoriesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetCategoriesParams contains parameters of the Repository.GetCategories
+type RepositoryMockGetCategoriesParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockGetCategoriesParamPtrs contains pointers to parameters of the Repository.GetCategories
+type RepositoryMockGetCategoriesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockGetCategoriesResults contains results of the Repository.GetCategories
+type RepositoryMockGetCategoriesResults struct {
+	cpa1 []*entity.Category
+	err  error
+}
+
+// RepositoryMockGetCategoriesOrigins contains origins of expectations of the Repository.GetCategories
+type RepositoryMockGetCategoriesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategories *mRepositoryMockGetCategories) Optional() *mRepositoryMockGetCategories {
+	mmGetCategories.optional = true
+	return mmGetCategories
+}
+
+// Expect sets up expected params for Repository.GetCategories
+func (mmGetCategories *mRepositoryMockGetCategories) Expect(ctx context.Context) *mRepositoryMockGetCategories {
+	if mmGetCategories.mock.funcGetCategories != nil {
+		mmGetCategories.mock.t.Fatalf("RepositoryMock.GetCategories mock is already set by Set")
+	}
+
+	if mmGetCategories.defaultExpectation == nil {
+		mmGetCategories.defaultExpectation = &RepositoryMockGetCategoriesExpectation{}
+	}
+
+	if mmGetCategories.defaultExpectation.paramPtrs != nil {
+		mmGetCategories.mock.t.Fatalf("RepositoryMock.GetCategories mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategories.defaultExpectation.params = &RepositoryMockGetCategoriesParams{ctx}
+	mmGetCategories.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategories.expectations {
+		if minimock.Equal(e.params, mmGetCategories.defaultExpectation.params) {
+			mmGetCategories.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategories.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategories
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCategories
+func (mmGetCategories *mRepositoryMockGetCategories) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCategories {
+	if mmGetCategories.mock.funcGetCategories != nil {
+		mmGetCategories.mock.t.Fatalf("RepositoryMock.GetCategories mock is already set by Set")
+	}
+
+	if mmGetCategories.defaultExpectation == nil {
+		mmGetCategories.defaultExpectation = &RepositoryMockGetCategoriesExpectation{}
+	}
+
+	if mmGetCategories.defaultExpectation.params != nil {
+		mmGetCategories.mock.t.Fatalf("RepositoryMock.GetCategories mock is already set by Expect")
+	}
+
+	if mmGetCategories.defaultExpectation.paramPtrs == nil {
+		mmGetCategories.defaultExpectation.paramPtrs = &RepositoryMockGetCategoriesParamPtrs{}
+	}
+	mmGetCategories.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategories.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategories
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCategories
+func (mmGetCategories *mRepositoryMockGetCategories) Inspect(f func(ctx context.Context)) *mRepositoryMockGetCategories {
+	if mmGetCategories.mock.inspectFuncGetCategories != nil {
+		mmGetCategories.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCategories")
+	}
+
+	mmGetCategories.mock.inspectFuncGetCategories = f
+
+	return mmGetCategories
+}
+
+// Return sets up results that will be returned by Repository.GetCategories
+func (mmGetCategories *mRepositoryMockGetCategories) Return(cpa1 []*entity.Category, err error) *RepositoryMock {
+	if mmGetCategories.mock.funcGetCategories != nil {
+		mmGetCategories.mock.t.Fatalf("RepositoryMock.GetCategories mock is already set by Set")
+	}
+
+	if mmGetCategories.defaultExpectation == nil {
+		mmGetCategories.defaultExpectation = &RepositoryMockGetCategoriesExpectation{mock: mmGetCategories.mock}
+	}
+	mmGetCategories.defaultExpectation.results = &RepositoryMockGetCategoriesResults{cpa1, err}
+	mmGetCategories.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategories.mock
+}
+
+// Set uses given function f to mock the Repository.GetCategories method
+func (mmGetCategories *mRepositoryMockGetCategories) Set(f func(ctx context.Context) (cpa1 []*entity.Category, err error)) *RepositoryMock {
+	if mmGetCategories.defaultExpectation != nil {
+		mmGetCategories.mock.t.Fatalf("Default expectation is already set for the Repository.GetCategories method")
+	}
+
+	if len(mmGetCategories.expectations) > 0 {
+		mmGetCategories.mock.t.Fatalf("Some expectations are already set for the Repository.GetCategories method")
+	}
+
+	mmGetCategories.mock.funcGetCategories = f
+	mmGetCategories.mock.funcGetCategoriesOrigin = minimock.CallerInfo(1)
+	return mmGetCategories.mock
+}
+
+// When sets expectation for the Repository.GetCategories which will trigger the result defined by the following
+// Then helper
+func (mmGetCategories *mRepositoryMockGetCategories) When(ctx context.Context) *RepositoryMockGetCategoriesExpectation {
+	if mmGetCategories.mock.funcGetCategories != nil {
+		mmGetCategories.mock.t.Fatalf("RepositoryMock.GetCategories mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCategoriesExpectation{
+		mock:               mmGetCategories.mock,
+		params:             &RepositoryMockGetCategoriesParams{ctx},
+		expectationOrigins: RepositoryMockGetCategoriesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategories.expectations = append(mmGetCategories.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCategories return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCategoriesExpectation) Then(cpa1 []*entity.Category, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCategoriesResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCategories should be invoked
+func (mmGetCategories *mRepositoryMockGetCategories) Times(n uint64) *mRepositoryMockGetCategories {
+	if n == 0 {
+		mmGetCategories.mock.t.Fatalf("Times of RepositoryMock.GetCategories mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategories.expectedInvocations, n)
+	mmGetCategories.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategories
+}
+
+func (mmGetCategories *mRepositoryMockGetCategories) invocationsDone() bool {
+	if len(mmGetCategories.expectations) == 0 && mmGetCategories.defaultExpectation == nil && mmGetCategories.mock.funcGetCategories == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategories.mock.afterGetCategoriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategories.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategories implements mm_category.Repository
+func (mmGetCategories *RepositoryMock) GetCategories(ctx context.Context) (cpa1 []*entity.Category, err error) {
+	mm_atomic.AddUint64(&mmGetCategories.beforeGetCategoriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategories.afterGetCategoriesCounter, 1)
+
+	mmGetCategories.t.Helper()
+
+	if mmGetCategories.inspectFuncGetCategories != nil {
+		mmGetCategories.inspectFuncGetCategories(ctx)
+	}
+
+	mm_params := RepositoryMockGetCategoriesParams{ctx}
+
+	// Record call args
+	mmGetCategories.GetCategoriesMock.mutex.Lock()
+	mmGetCategories.GetCategoriesMock.callArgs = append(mmGetCategories.GetCategoriesMock.callArgs, &mm_params)
+	mmGetCategories.GetCategoriesMock.mutex.Unlock()
+
+	for _, e := range mmGetCategories.GetCategoriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCategories.GetCategoriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategories.GetCategoriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategories.GetCategoriesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategories.GetCategoriesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCategoriesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategories.t.Errorf("RepositoryMock.GetCategories got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategories.GetCategoriesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategories.t.Errorf("RepositoryMock.GetCategories got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategories.GetCategoriesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategories.GetCategoriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategories.t.Fatal("No results are set for the RepositoryMock.GetCategories")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCategories.funcGetCategories != nil {
+		return mmGetCategories.funcGetCategories(ctx)
+	}
+	mmGetCategories.t.Fatalf("Unexpected call to RepositoryMock.GetCategories. %v", ctx)
+	return
+}
+
+// GetCategoriesAfterCounter returns a count of finished RepositoryMock.GetCategories invocations
+func (mmGetCategories *RepositoryMock) GetCategoriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategories.afterGetCategoriesCounter)
+}
+
+// GetCategoriesBeforeCounter returns a count of RepositoryMock.GetCategories invocations
+func (mmGetCategories *RepositoryMock) GetCategoriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategories.beforeGetCategoriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCategories.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategories *mRepositoryMockGetCategories) Calls() []*RepositoryMockGetCategoriesParams {
+	mmGetCategories.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCategoriesParams, len(mmGetCategories.callArgs))
+	copy(argCopy, mmGetCategories.callArgs)
+
+	mmGetCategories.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoriesDone returns true if the count of the GetCategories invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCategoriesDone() bool {
+	if m.GetCategoriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoriesMock.invocationsDone()
+}
+
+// MinimockGetCategoriesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCategoriesInspect() {
+	for _, e := range m.GetCategoriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategories at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoriesCounter := mm_atomic.LoadUint64(&m.afterGetCategoriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoriesMock.defaultExpectation != nil && afterGetCategoriesCounter < 1 {
+		if m.GetCategoriesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategories at\n%s", m.GetCategoriesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategories at\n%s with params: %#v", m.GetCategoriesMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategories != nil && afterGetCategoriesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetCategories at\n%s", m.funcGetCategoriesOrigin)
+	}
+
+	if !m.GetCategoriesMock.invocationsDone() && afterGetCategoriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCategories at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoriesMock.expectedInvocations), m.GetCategoriesMock.expectedInvocationsOrigin, afterGetCategoriesCounter)
+	}
+}
+
+type mRepositoryMockGetCategoriesByParent struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCategoriesByParentExpectation
+	expectations       []*RepositoryMockGetCategoriesByParentExpectation
+
+	callArgs []*RepositoryMockGetCategoriesByParentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetCategoriesByParentExpectation specifies expectation struct of the Repository.GetCategoriesByParent
+type RepositoryMockGetCategoriesByParentExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetCategoriesByParentParams
+	paramPtrs          *RepositoryMockGetCategoriesByParentParamPtrs
+	expectationOrigins RepositoryMockGetCategoriesByParentExpectationOrigins
+	results            *RepositoryMockGetCategoriesByParentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetCategoriesByParentParams contains parameters of the Repository.GetCategoriesByParent
+type RepositoryMockGetCategoriesByParentParams struct {
+	ctx      context.Context
+	parentID *uint64
+}
+
+// RepositoryMockGetCategoriesByParentParamPtrs contains pointers to parameters of the Repository.GetCategoriesByParent
+type RepositoryMockGetCategoriesByParentParamPtrs struct {
+	ctx      *context.Context
+	parentID **uint64
+}
+
+// RepositoryMockGetCategoriesByParentResults contains results of the Repository.GetCategoriesByParent
+type RepositoryMockGetCategoriesByParentResults struct {
+	cpa1 []*entity.Category
+	err  error
+}
+
+// RepositoryMockGetCategoriesByParentOrigins contains origins of expectations of the Repository.GetCategoriesByParent
+type RepositoryMockGetCategoriesByParentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originParentID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Optional() *mRepositoryMockGetCategoriesByParent {
+	mmGetCategoriesByParent.optional = true
+	return mmGetCategoriesByParent
+}
+
+// Expect sets up expected params for Repository.GetCategoriesByParent
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Expect(ctx context.Context, parentID *uint64) *mRepositoryMockGetCategoriesByParent {
+	if mmGetCategoriesByParent.mock.funcGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Set")
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation == nil {
+		mmGetCategoriesByParent.defaultExpectation = &RepositoryMockGetCategoriesByParentExpectation{}
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation.paramPtrs != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategoriesByParent.defaultExpectation.params = &RepositoryMockGetCategoriesByParentParams{ctx, parentID}
+	mmGetCategoriesByParent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategoriesByParent.expectations {
+		if minimock.Equal(e.params, mmGetCategoriesByParent.defaultExpectation.params) {
+			mmGetCategoriesByParent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategoriesByParent.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategoriesByParent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCategoriesByParent
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCategoriesByParent {
+	if mmGetCategoriesByParent.mock.funcGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Set")
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation == nil {
+		mmGetCategoriesByParent.defaultExpectation = &RepositoryMockGetCategoriesByParentExpectation{}
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation.params != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Expect")
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation.paramPtrs == nil {
+		mmGetCategoriesByParent.defaultExpectation.paramPtrs = &RepositoryMockGetCategoriesByParentParamPtrs{}
+	}
+	mmGetCategoriesByParent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategoriesByParent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategoriesByParent
+}
+
+// ExpectParentIDParam2 sets up expected param parentID for Repository.GetCategoriesByParent
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) ExpectParentIDParam2(parentID *uint64) *mRepositoryMockGetCategoriesByParent {
+	if mmGetCategoriesByParent.mock.funcGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Set")
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation == nil {
+		mmGetCategoriesByParent.defaultExpectation = &RepositoryMockGetCategoriesByParentExpectation{}
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation.params != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Expect")
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation.paramPtrs == nil {
+		mmGetCategoriesByParent.defaultExpectation.paramPtrs = &RepositoryMockGetCategoriesByParentParamPtrs{}
+	}
+	mmGetCategoriesByParent.defaultExpectation.paramPtrs.parentID = &parentID
+	mmGetCategoriesByParent.defaultExpectation.expectationOrigins.originParentID = minimock.CallerInfo(1)
+
+	return mmGetCategoriesByParent
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCategoriesByParent
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Inspect(f func(ctx context.Context, parentID *uint64)) *mRepositoryMockGetCategoriesByParent {
+	if mmGetCategoriesByParent.mock.inspectFuncGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCategoriesByParent")
+	}
+
+	mmGetCategoriesByParent.mock.inspectFuncGetCategoriesByParent = f
+
+	return mmGetCategoriesByParent
+}
+
+// Return sets up results that will be returned by Repository.GetCategoriesByParent
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Return(cpa1 []*entity.Category, err error) *RepositoryMock {
+	if mmGetCategoriesByParent.mock.funcGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Set")
+	}
+
+	if mmGetCategoriesByParent.defaultExpectation == nil {
+		mmGetCategoriesByParent.defaultExpectation = &RepositoryMockGetCategoriesByParentExpectation{mock: mmGetCategoriesByParent.mock}
+	}
+	mmGetCategoriesByParent.defaultExpectation.results = &RepositoryMockGetCategoriesByParentResults{cpa1, err}
+	mmGetCategoriesByParent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategoriesByParent.mock
+}
+
+// Set uses given function f to mock the Repository.GetCategoriesByParent method
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Set(f func(ctx context.Context, parentID *uint64) (cpa1 []*entity.Category, err error)) *RepositoryMock {
+	if mmGetCategoriesByParent.defaultExpectation != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("Default expectation is already set for the Repository.GetCategoriesByParent method")
+	}
+
+	if len(mmGetCategoriesByParent.expectations) > 0 {
+		mmGetCategoriesByParent.mock.t.Fatalf("Some expectations are already set for the Repository.GetCategoriesByParent method")
+	}
+
+	mmGetCategoriesByParent.mock.funcGetCategoriesByParent = f
+	mmGetCategoriesByParent.mock.funcGetCategoriesByParentOrigin = minimock.CallerInfo(1)
+	return mmGetCategoriesByParent.mock
+}
+
+// When sets expectation for the Repository.GetCategoriesByParent which will trigger the result defined by the following
+// Then helper
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) When(ctx context.Context, parentID *uint64) *RepositoryMockGetCategoriesByParentExpectation {
+	if mmGetCategoriesByParent.mock.funcGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.mock.t.Fatalf("RepositoryMock.GetCategoriesByParent mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCategoriesByParentExpectation{
+		mock:               mmGetCategoriesByParent.mock,
+		params:             &RepositoryMockGetCategoriesByParentParams{ctx, parentID},
+		expectationOrigins: RepositoryMockGetCategoriesByParentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategoriesByParent.expectations = append(mmGetCategoriesByParent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCategoriesByParent return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCategoriesByParentExpectation) Then(cpa1 []*entity.Category, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCategoriesByParentResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCategoriesByParent should be invoked
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Times(n uint64) *mRepositoryMockGetCategoriesByParent {
+	if n == 0 {
+		mmGetCategoriesByParent.mock.t.Fatalf("Times of RepositoryMock.GetCategoriesByParent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategoriesByParent.expectedInvocations, n)
+	mmGetCategoriesByParent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategoriesByParent
+}
+
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) invocationsDone() bool {
+	if len(mmGetCategoriesByParent.expectations) == 0 && mmGetCategoriesByParent.defaultExpectation == nil && mmGetCategoriesByParent.mock.funcGetCategoriesByParent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategoriesByParent.mock.afterGetCategoriesByParentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategoriesByParent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategoriesByParent implements mm_category.Repository
+func (mmGetCategoriesByParent *RepositoryMock) GetCategoriesByParent(ctx context.Context, parentID *uint64) (cpa1 []*entity.Category, err error) {
+	mm_atomic.AddUint64(&mmGetCategoriesByParent.beforeGetCategoriesByParentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategoriesByParent.afterGetCategoriesByParentCounter, 1)
+
+	mmGetCategoriesByParent.t.Helper()
+
+	if mmGetCategoriesByParent.inspectFuncGetCategoriesByParent != nil {
+		mmGetCategoriesByParent.inspectFuncGetCategoriesByParent(ctx, parentID)
+	}
+
+	mm_params := RepositoryMockGetCategoriesByParentParams{ctx, parentID}
+
+	// Record call args
+	mmGetCategoriesByParent.GetCategoriesByParentMock.mutex.Lock()
+	mmGetCategoriesByParent.GetCategoriesByParentMock.callArgs = append(mmGetCategoriesByParent.GetCategoriesByParentMock.callArgs, &mm_params)
+	mmGetCategoriesByParent.GetCategoriesByParentMock.mutex.Unlock()
+
+	for _, e := range mmGetCategoriesByParent.GetCategoriesByParentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCategoriesByParentParams{ctx, parentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategoriesByParent.t.Errorf("RepositoryMock.GetCategoriesByParent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmGetCategoriesByParent.t.Errorf("RepositoryMock.GetCategoriesByParent got unexpected parameter parentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.expectationOrigins.originParentID, *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategoriesByParent.t.Errorf("RepositoryMock.GetCategoriesByParent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategoriesByParent.GetCategoriesByParentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategoriesByParent.t.Fatal("No results are set for the RepositoryMock.GetCategoriesByParent")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCategoriesByParent.funcGetCategoriesByParent != nil {
+		return mmGetCategoriesByParent.funcGetCategoriesByParent(ctx, parentID)
+	}
+	mmGetCategoriesByParent.t.Fatalf("Unexpected call to RepositoryMock.GetCategoriesByParent. %v %v", ctx, parentID)
+	return
+}
+
+// GetCategoriesByParentAfterCounter returns a count of finished RepositoryMock.GetCategoriesByParent invocations
+func (mmGetCategoriesByParent *RepositoryMock) GetCategoriesByParentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoriesByParent.afterGetCategoriesByParentCounter)
+}
+
+// GetCategoriesByParentBeforeCounter returns a count of RepositoryMock.GetCategoriesByParent invocations
+func (mmGetCategoriesByParent *RepositoryMock) GetCategoriesByParentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoriesByParent.beforeGetCategoriesByParentCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCategoriesByParent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategoriesByParent *mRepositoryMockGetCategoriesByParent) Calls() []*RepositoryMockGetCategoriesByParentParams {
+	mmGetCategoriesByParent.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCategoriesByParentParams, len(mmGetCategoriesByParent.callArgs))
+	copy(argCopy, mmGetCategoriesByParent.callArgs)
+
+	mmGetCategoriesByParent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoriesByParentDone returns true if the count of the GetCategoriesByParent invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCategoriesByParentDone() bool {
+	if m.GetCategoriesByParentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoriesByParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoriesByParentMock.invocationsDone()
+}
+
+// MinimockGetCategoriesByParentInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCategoriesByParentInspect() {
+	for _, e := range m.GetCategoriesByParentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoriesByParent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoriesByParentCounter := mm_atomic.LoadUint64(&m.afterGetCategoriesByParentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoriesByParentMock.defaultExpectation != nil && afterGetCategoriesByParentCounter < 1 {
+		if m.GetCategoriesByParentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoriesByParent at\n%s", m.GetCategoriesByParentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoriesByParent at\n%s with params: %#v", m.GetCategoriesByParentMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoriesByParentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategoriesByParent != nil && afterGetCategoriesByParentCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetCategoriesByParent at\n%s", m.funcGetCategoriesByParentOrigin)
+	}
+
+	if !m.GetCategoriesByParentMock.invocationsDone() && afterGetCategoriesByParentCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCategoriesByParent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoriesByParentMock.expectedInvocations), m.GetCategoriesByParentMock.expectedInvocationsOrigin, afterGetCategoriesByParentCounter)
+	}
+}
+
+type mRepositoryMockGetCategoryAttributes struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCategoryAttributesExpectation
+	expectations       []*RepositoryMockGetCategoryAttributesExpectation
+
+	callArgs []*RepositoryMockGetCategoryAttributesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetCategoryAttributesExpectation specifies expectation struct of the Repository.GetCategoryAttributes
+type RepositoryMockGetCategoryAttributesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetCategoryAttributesParams
+	paramPtrs          *RepositoryMockGetCategoryAttributesParamPtrs
+	expectationOrigins RepositoryMockGetCategoryAttributesExpectationOrigins
+	results            *RepositoryMockGetCategoryAttributesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetCategoryAttributesParams contains parameters of the Repository.GetCategoryAttributes
+type RepositoryMockGetCategoryAttributesParams struct {
+	ctx        context.Context
+	categoryID uint64
+}
+
+// RepositoryMockGetCategoryAttributesParamPtrs contains pointers to parameters of the Repository.GetCategoryAttributes
+type RepositoryMockGetCategoryAttributesParamPtrs struct {
+	ctx        *context.Context
+	categoryID *uint64
+}
+
+// RepositoryMockGetCategoryAttributesResults contains results of the Repository.GetCategoryAttributes
+type RepositoryMockGetCategoryAttributesResults struct {
+	cpa1 []*entity.CategoryAttribute
+	err  error
+}
+
+// RepositoryMockGetCategoryAttributesOrigins contains origins of expectations of the Repository.GetCategoryAttributes
+type RepositoryMockGetCategoryAttributesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCategoryID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Optional() *mRepositoryMockGetCategoryAttributes {
+	mmGetCategoryAttributes.optional = true
+	return mmGetCategoryAttributes
+}
+
+// Expect sets up expected params for Repository.GetCategoryAttributes
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Expect(ctx context.Context, categoryID uint64) *mRepositoryMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &RepositoryMockGetCategoryAttributesExpectation{}
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.paramPtrs != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategoryAttributes.defaultExpectation.params = &RepositoryMockGetCategoryAttributesParams{ctx, categoryID}
+	mmGetCategoryAttributes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategoryAttributes.expectations {
+		if minimock.Equal(e.params, mmGetCategoryAttributes.defaultExpectation.params) {
+			mmGetCategoryAttributes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategoryAttributes.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategoryAttributes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCategoryAttributes
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &RepositoryMockGetCategoryAttributesExpectation{}
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.params != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Expect")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryAttributes.defaultExpectation.paramPtrs = &RepositoryMockGetCategoryAttributesParamPtrs{}
+	}
+	mmGetCategoryAttributes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategoryAttributes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategoryAttributes
+}
+
+// ExpectCategoryIDParam2 sets up expected param categoryID for Repository.GetCategoryAttributes
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) ExpectCategoryIDParam2(categoryID uint64) *mRepositoryMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &RepositoryMockGetCategoryAttributesExpectation{}
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.params != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Expect")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryAttributes.defaultExpectation.paramPtrs = &RepositoryMockGetCategoryAttributesParamPtrs{}
+	}
+	mmGetCategoryAttributes.defaultExpectation.paramPtrs.categoryID = &categoryID
+	mmGetCategoryAttributes.defaultExpectation.expectationOrigins.originCategoryID = minimock.CallerInfo(1)
+
+	return mmGetCategoryAttributes
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCategoryAttributes
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Inspect(f func(ctx context.Context, categoryID uint64)) *mRepositoryMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.inspectFuncGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCategoryAttributes")
+	}
+
+	mmGetCategoryAttributes.mock.inspectFuncGetCategoryAttributes = f
+
+	return mmGetCategoryAttributes
+}
+
+// Return sets up results that will be returned by Repository.GetCategoryAttributes
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Return(cpa1 []*entity.CategoryAttribute, err error) *RepositoryMock {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &RepositoryMockGetCategoryAttributesExpectation{mock: mmGetCategoryAttributes.mock}
+	}
+	mmGetCategoryAttributes.defaultExpectation.results = &RepositoryMockGetCategoryAttributesResults{cpa1, err}
+	mmGetCategoryAttributes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryAttributes.mock
+}
+
+// Set uses given function f to mock the Repository.GetCategoryAttributes method
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Set(f func(ctx context.Context, categoryID uint64) (cpa1 []*entity.CategoryAttribute, err error)) *RepositoryMock {
+	if mmGetCategoryAttributes.defaultExpectation != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("Default expectation is already set for the Repository.GetCategoryAttributes method")
+	}
+
+	if len(mmGetCategoryAttributes.expectations) > 0 {
+		mmGetCategoryAttributes.mock.t.Fatalf("Some expectations are already set for the Repository.GetCategoryAttributes method")
+	}
+
+	mmGetCategoryAttributes.mock.funcGetCategoryAttributes = f
+	mmGetCategoryAttributes.mock.funcGetCategoryAttributesOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryAttributes.mock
+}
+
+// When sets expectation for the Repository.GetCategoryAttributes which will trigger the result defined by the following
+// Then helper
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) When(ctx context.Context, categoryID uint64) *RepositoryMockGetCategoryAttributesExpectation {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("RepositoryMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCategoryAttributesExpectation{
+		mock:               mmGetCategoryAttributes.mock,
+		params:             &RepositoryMockGetCategoryAttributesParams{ctx, categoryID},
+		expectationOrigins: RepositoryMockGetCategoryAttributesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategoryAttributes.expectations = append(mmGetCategoryAttributes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCategoryAttributes return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCategoryAttributesExpectation) Then(cpa1 []*entity.CategoryAttribute, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCategoryAttributesResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCategoryAttributes should be invoked
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Times(n uint64) *mRepositoryMockGetCategoryAttributes {
+	if n == 0 {
+		mmGetCategoryAttributes.mock.t.Fatalf("Times of RepositoryMock.GetCategoryAttributes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategoryAttributes.expectedInvocations, n)
+	mmGetCategoryAttributes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryAttributes
+}
+
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) invocationsDone() bool {
+	if len(mmGetCategoryAttributes.expectations) == 0 && mmGetCategoryAttributes.defaultExpectation == nil && mmGetCategoryAttributes.mock.funcGetCategoryAttributes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategoryAttributes.mock.afterGetCategoryAttributesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategoryAttributes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategoryAttributes implements mm_category.Repository
+func (mmGetCategoryAttributes *RepositoryMock) GetCategoryAttributes(ctx context.Context, categoryID uint64) (cpa1 []*entity.CategoryAttribute, err error) {
+	mm_atomic.AddUint64(&mmGetCategoryAttributes.beforeGetCategoryAttributesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategoryAttributes.afterGetCategoryAttributesCounter, 1)
+
+	mmGetCategoryAttributes.t.Helper()
+
+	if mmGetCategoryAttributes.inspectFuncGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.inspectFuncGetCategoryAttributes(ctx, categoryID)
+	}
+
+	mm_params := RepositoryMockGetCategoryAttributesParams{ctx, categoryID}
+
+	// Record call args
+	mmGetCategoryAttributes.GetCategoryAttributesMock.mutex.Lock()
+	mmGetCategoryAttributes.GetCategoryAttributesMock.callArgs = append(mmGetCategoryAttributes.GetCategoryAttributesMock.callArgs, &mm_params)
+	mmGetCategoryAttributes.GetCategoryAttributesMock.mutex.Unlock()
+
+	for _, e := range mmGetCategoryAttributes.GetCategoryAttributesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCategoryAttributesParams{ctx, categoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategoryAttributes.t.Errorf("RepositoryMock.GetCategoryAttributes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.categoryID != nil && !minimock.Equal(*mm_want_ptrs.categoryID, mm_got.categoryID) {
+				mmGetCategoryAttributes.t.Errorf("RepositoryMock.GetCategoryAttributes got unexpected parameter categoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.originCategoryID, *mm_want_ptrs.categoryID, mm_got.categoryID, minimock.Diff(*mm_want_ptrs.categoryID, mm_got.categoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategoryAttributes.t.Errorf("RepositoryMock.GetCategoryAttributes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategoryAttributes.t.Fatal("No results are set for the RepositoryMock.GetCategoryAttributes")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCategoryAttributes.funcGetCategoryAttributes != nil {
+		return mmGetCategoryAttributes.funcGetCategoryAttributes(ctx, categoryID)
+	}
+	mmGetCategoryAttributes.t.Fatalf("Unexpected call to RepositoryMock.GetCategoryAttributes. %v %v", ctx, categoryID)
+	return
+}
+
+// GetCategoryAttributesAfterCounter returns a count of finished RepositoryMock.GetCategoryAttributes invocations
+func (mmGetCategoryAttributes *RepositoryMock) GetCategoryAttributesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryAttributes.afterGetCategoryAttributesCounter)
+}
+
+// GetCategoryAttributesBeforeCounter returns a count of RepositoryMock.GetCategoryAttributes invocations
+func (mmGetCategoryAttributes *RepositoryMock) GetCategoryAttributesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryAttributes.beforeGetCategoryAttributesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCategoryAttributes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategoryAttributes *mRepositoryMockGetCategoryAttributes) Calls() []*RepositoryMockGetCategoryAttributesParams {
+	mmGetCategoryAttributes.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCategoryAttributesParams, len(mmGetCategoryAttributes.callArgs))
+	copy(argCopy, mmGetCategoryAttributes.callArgs)
+
+	mmGetCategoryAttributes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoryAttributesDone returns true if the count of the GetCategoryAttributes invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCategoryAttributesDone() bool {
+	if m.GetCategoryAttributesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoryAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoryAttributesMock.invocationsDone()
+}
+
+// MinimockGetCategoryAttributesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCategoryAttributesInspect() {
+	for _, e := range m.GetCategoryAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoryAttributes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoryAttributesCounter := mm_atomic.LoadUint64(&m.afterGetCategoryAttributesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoryAttributesMock.defaultExpectation != nil && afterGetCategoryAttributesCounter < 1 {
+		if m.GetCategoryAttributesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoryAttributes at\n%s", m.GetCategoryAttributesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoryAttributes at\n%s with params: %#v", m.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoryAttributesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategoryAttributes != nil && afterGetCategoryAttributesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetCategoryAttributes at\n%s", m.funcGetCategoryAttributesOrigin)
+	}
+
+	if !m.GetCategoryAttributesMock.invocationsDone() && afterGetCategoryAttributesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCategoryAttributes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoryAttributesMock.expectedInvocations), m.GetCategoryAttributesMock.expectedInvocationsOrigin, afterGetCategoryAttributesCounter)
+	}
+}
+
+type mRepositoryMockGetCategoryByID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCategoryByIDExpectation
+	expectations       []*RepositoryMockGetCategoryByIDExpectation
+
+	callArgs []*RepositoryMockGetCategoryByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetCategoryByIDExpectation specifies expectation struct of the Repository.GetCategoryByID
+type RepositoryMockGetCategoryByIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetCategoryByIDParams
+	paramPtrs          *RepositoryMockGetCategoryByIDParamPtrs
+	expectationOrigins RepositoryMockGetCategoryByIDExpectationOrigins
+	results            *RepositoryMockGetCategoryByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetCategoryByIDParams contains parameters of the Repository.GetCategoryByID
+type RepositoryMockGetCategoryByIDParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryMockGetCategoryByIDParamPtrs contains pointers to parameters of the Repository.GetCategoryByID
+type RepositoryMockGetCategoryByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryMockGetCategoryByIDResults contains results of the Repository.GetCategoryByID
+type RepositoryMockGetCategoryByIDResults struct {
+	cp1 *entity.Category
+	err error
+}
+
+// RepositoryMockGetCategoryByIDOrigins contains origins of expectations of the Repository.GetCategoryByID
+type RepositoryMockGetCategoryByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Optional() *mRepositoryMockGetCategoryByID {
+	mmGetCategoryByID.optional = true
+	return mmGetCategoryByID
+}
+
+// Expect sets up expected params for Repository.GetCategoryByID
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Expect(ctx context.Context, id uint64) *mRepositoryMockGetCategoryByID {
+	if mmGetCategoryByID.mock.funcGetCategoryByID != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Set")
+	}
+
+	if mmGetCategoryByID.defaultExpectation == nil {
+		mmGetCategoryByID.defaultExpectation = &RepositoryMockGetCategoryByIDExpectation{}
+	}
+
+	if mmGetCategoryByID.defaultExpectation.paramPtrs != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategoryByID.defaultExpectation.params = &RepositoryMockGetCategoryByIDParams{ctx, id}
+	mmGetCategoryByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategoryByID.expectations {
+		if minimock.Equal(e.params, mmGetCategoryByID.defaultExpectation.params) {
+			mmGetCategoryByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategoryByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategoryByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCategoryByID
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCategoryByID {
+	if mmGetCategoryByID.mock.funcGetCategoryByID != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Set")
+	}
+
+	if mmGetCategoryByID.defaultExpectation == nil {
+		mmGetCategoryByID.defaultExpectation = &RepositoryMockGetCategoryByIDExpectation{}
+	}
+
+	if mmGetCategoryByID.defaultExpectation.params != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Expect")
+	}
+
+	if mmGetCategoryByID.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryByID.defaultExpectation.paramPtrs = &RepositoryMockGetCategoryByIDParamPtrs{}
+	}
+	mmGetCategoryByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategoryByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategoryByID
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.GetCategoryByID
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) ExpectIdParam2(id uint64) *mRepositoryMockGetCategoryByID {
+	if mmGetCategoryByID.mock.funcGetCategoryByID != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Set")
+	}
+
+	if mmGetCategoryByID.defaultExpectation == nil {
+		mmGetCategoryByID.defaultExpectation = &RepositoryMockGetCategoryByIDExpectation{}
+	}
+
+	if mmGetCategoryByID.defaultExpectation.params != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Expect")
+	}
+
+	if mmGetCategoryByID.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryByID.defaultExpectation.paramPtrs = &RepositoryMockGetCategoryByIDParamPtrs{}
+	}
+	mmGetCategoryByID.defaultExpectation.paramPtrs.id = &id
+	mmGetCategoryByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetCategoryByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCategoryByID
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryMockGetCategoryByID {
+	if mmGetCategoryByID.mock.inspectFuncGetCategoryByID != nil {
+		mmGetCategoryByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCategoryByID")
+	}
+
+	mmGetCategoryByID.mock.inspectFuncGetCategoryByID = f
+
+	return mmGetCategoryByID
+}
+
+// Return sets up results that will be returned by Repository.GetCategoryByID
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Return(cp1 *entity.Category, err error) *RepositoryMock {
+	if mmGetCategoryByID.mock.funcGetCategoryByID != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Set")
+	}
+
+	if mmGetCategoryByID.defaultExpectation == nil {
+		mmGetCategoryByID.defaultExpectation = &RepositoryMockGetCategoryByIDExpectation{mock: mmGetCategoryByID.mock}
+	}
+	mmGetCategoryByID.defaultExpectation.results = &RepositoryMockGetCategoryByIDResults{cp1, err}
+	mmGetCategoryByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryByID.mock
+}
+
+// Set uses given function f to mock the Repository.GetCategoryByID method
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Set(f func(ctx context.Context, id uint64) (cp1 *entity.Category, err error)) *RepositoryMock {
+	if mmGetCategoryByID.defaultExpectation != nil {
+		mmGetCategoryByID.mock.t.Fatalf("Default expectation is already set for the Repository.GetCategoryByID method")
+	}
+
+	if len(mmGetCategoryByID.expectations) > 0 {
+		mmGetCategoryByID.mock.t.Fatalf("Some expectations are already set for the Repository.GetCategoryByID method")
+	}
+
+	mmGetCategoryByID.mock.funcGetCategoryByID = f
+	mmGetCategoryByID.mock.funcGetCategoryByIDOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryByID.mock
+}
+
+// When sets expectation for the Repository.GetCategoryByID which will trigger the result defined by the following
+// Then helper
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) When(ctx context.Context, id uint64) *RepositoryMockGetCategoryByIDExpectation {
+	if mmGetCategoryByID.mock.funcGetCategoryByID != nil {
+		mmGetCategoryByID.mock.t.Fatalf("RepositoryMock.GetCategoryByID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCategoryByIDExpectation{
+		mock:               mmGetCategoryByID.mock,
+		params:             &RepositoryMockGetCategoryByIDParams{ctx, id},
+		expectationOrigins: RepositoryMockGetCategoryByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategoryByID.expectations = append(mmGetCategoryByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCategoryByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCategoryByIDExpectation) Then(cp1 *entity.Category, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCategoryByIDResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCategoryByID should be invoked
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Times(n uint64) *mRepositoryMockGetCategoryByID {
+	if n == 0 {
+		mmGetCategoryByID.mock.t.Fatalf("Times of RepositoryMock.GetCategoryByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategoryByID.expectedInvocations, n)
+	mmGetCategoryByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryByID
+}
+
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) invocationsDone() bool {
+	if len(mmGetCategoryByID.expectations) == 0 && mmGetCategoryByID.defaultExpectation == nil && mmGetCategoryByID.mock.funcGetCategoryByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategoryByID.mock.afterGetCategoryByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategoryByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategoryByID implements mm_category.Repository
+func (mmGetCategoryByID *RepositoryMock) GetCategoryByID(ctx context.Context, id uint64) (cp1 *entity.Category, err error) {
+	mm_atomic.AddUint64(&mmGetCategoryByID.beforeGetCategoryByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategoryByID.afterGetCategoryByIDCounter, 1)
+
+	mmGetCategoryByID.t.Helper()
+
+	if mmGetCategoryByID.inspectFuncGetCategoryByID != nil {
+		mmGetCategoryByID.inspectFuncGetCategoryByID(ctx, id)
+	}
+
+	mm_params := RepositoryMockGetCategoryByIDParams{ctx, id}
+
+	// Record call args
+	mmGetCategoryByID.GetCategoryByIDMock.mutex.Lock()
+	mmGetCategoryByID.GetCategoryByIDMock.callArgs = append(mmGetCategoryByID.GetCategoryByIDMock.callArgs, &mm_params)
+	mmGetCategoryByID.GetCategoryByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCategoryByID.GetCategoryByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCategoryByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategoryByID.t.Errorf("RepositoryMock.GetCategoryByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetCategoryByID.t.Errorf("RepositoryMock.GetCategoryByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategoryByID.t.Errorf("RepositoryMock.GetCategoryByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategoryByID.GetCategoryByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategoryByID.t.Fatal("No results are set for the RepositoryMock.GetCategoryByID")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetCategoryByID.funcGetCategoryByID != nil {
+		return mmGetCategoryByID.funcGetCategoryByID(ctx, id)
+	}
+	mmGetCategoryByID.t.Fatalf("Unexpected call to RepositoryMock.GetCategoryByID. %v %v", ctx, id)
+	return
+}
+
+// GetCategoryByIDAfterCounter returns a count of finished RepositoryMock.GetCategoryByID invocations
+func (mmGetCategoryByID *RepositoryMock) GetCategoryByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryByID.afterGetCategoryByIDCounter)
+}
+
+// GetCategoryByIDBeforeCounter returns a count of RepositoryMock.GetCategoryByID invocations
+func (mmGetCategoryByID *RepositoryMock) GetCategoryByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryByID.beforeGetCategoryByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCategoryByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategoryByID *mRepositoryMockGetCategoryByID) Calls() []*RepositoryMockGetCategoryByIDParams {
+	mmGetCategoryByID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCategoryByIDParams, len(mmGetCategoryByID.callArgs))
+	copy(argCopy, mmGetCategoryByID.callArgs)
+
+	mmGetCategoryByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoryByIDDone returns true if the count of the GetCategoryByID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCategoryByIDDone() bool {
+	if m.GetCategoryByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoryByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoryByIDMock.invocationsDone()
+}
+
+// MinimockGetCategoryByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCategoryByIDInspect() {
+	for _, e := range m.GetCategoryByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoryByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoryByIDCounter := mm_atomic.LoadUint64(&m.afterGetCategoryByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoryByIDMock.defaultExpectation != nil && afterGetCategoryByIDCounter < 1 {
+		if m.GetCategoryByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoryByID at\n%s", m.GetCategoryByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCategoryByID at\n%s with params: %#v", m.GetCategoryByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoryByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategoryByID != nil && afterGetCategoryByIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetCategoryByID at\n%s", m.funcGetCategoryByIDOrigin)
+	}
+
+	if !m.GetCategoryByIDMock.invocationsDone() && afterGetCategoryByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCategoryByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoryByIDMock.expectedInvocations), m.GetCategoryByIDMock.expectedInvocationsOrigin, afterGetCategoryByIDCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetCategoriesInspect()
+
+			m.MinimockGetCategoriesByParentInspect()
+
+			m.MinimockGetCategoryAttributesInspect()
+
+			m.MinimockGetCategoryByIDInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetCategoriesDone() &&
+		m.MinimockGetCategoriesByParentDone() &&
+		m.MinimockGetCategoryAttributesDone() &&
+		m.MinimockGetCategoryByIDDone()
+}
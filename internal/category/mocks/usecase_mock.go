@@ -0,0 +1,1130 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/category.UseCase -o usecase_mock.go -n UseCaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UseCaseMock implements mm_category.UseCase
+type UseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetCategoryAttributes          func(ctx context.Context, categoryID uint64) (cpa1 []*entity.CategoryAttribute, err error)
+	funcGetCategoryAttributesOrigin    string
+	inspectFuncGetCategoryAttributes   func(ctx context.Context, categoryID uint64)
+	afterGetCategoryAttributesCounter  uint64
+	beforeGetCategoryAttributesCounter uint64
+	GetCategoryAttributesMock          mUseCaseMockGetCategoryAttributes
+
+	funcGetCategoryTree          func(ctx context.Context, rootID *uint64) (cpa1 []*entity.CategoryNode, err error)
+	funcGetCategoryTreeOrigin    string
+	inspectFuncGetCategoryTree   func(ctx context.Context, rootID *uint64)
+	afterGetCategoryTreeCounter  uint64
+	beforeGetCategoryTreeCounter uint64
+	GetCategoryTreeMock          mUseCaseMockGetCategoryTree
+
+	funcListCategories          func(ctx context.Context, parentID *uint64) (cpa1 []*entity.Category, err error)
+	funcListCategoriesOrigin    string
+	inspectFuncListCategories   func(ctx context.Context, parentID *uint64)
+	afterListCategoriesCounter  uint64
+	beforeListCategoriesCounter uint64
+	ListCategoriesMock          mUseCaseMockListCategories
+}
+
+// NewUseCaseMock returns a mock for mm_category.UseCase
+func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
+	m := &UseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetCategoryAttributesMock = mUseCaseMockGetCategoryAttributes{mock: m}
+	m.GetCategoryAttributesMock.callArgs = []*UseCaseMockGetCategoryAttributesParams{}
+
+	m.GetCategoryTreeMock = mUseCaseMockGetCategoryTree{mock: m}
+	m.GetCategoryTreeMock.callArgs = []*UseCaseMockGetCategoryTreeParams{}
+
+	m.ListCategoriesMock = mUseCaseMockListCategories{mock: m}
+	m.ListCategoriesMock.callArgs = []*UseCaseMockListCategoriesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUseCaseMockGetCategoryAttributes struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetCategoryAttributesExpectation
+	expectations       []*UseCaseMockGetCategoryAttributesExpectation
+
+	callArgs []*UseCaseMockGetCategoryAttributesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetCategoryAttributesExpectation specifies expectation struct of the UseCase.GetCategoryAttributes
+type UseCaseMockGetCategoryAttributesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetCategoryAttributesParams
+	paramPtrs          *UseCaseMockGetCategoryAttributesParamPtrs
+	expectationOrigins UseCaseMockGetCategoryAttributesExpectationOrigins
+	results            *UseCaseMockGetCategoryAttributesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetCategoryAttributesParams contains parameters of the UseCase.GetCategoryAttributes
+type UseCaseMockGetCategoryAttributesParams struct {
+	ctx        context.Context
+	categoryID uint64
+}
+
+// UseCaseMockGetCategoryAttributesParamPtrs contains pointers to parameters of the UseCase.GetCategoryAttributes
+type UseCaseMockGetCategoryAttributesParamPtrs struct {
+	ctx        *context.Context
+	categoryID *uint64
+}
+
+// UseCaseMockGetCategoryAttributesResults contains results of the UseCase.GetCategoryAttributes
+type UseCaseMockGetCategoryAttributesResults struct {
+	cpa1 []*entity.CategoryAttribute
+	err  error
+}
+
+// UseCaseMockGetCategoryAttributesOrigins contains origins of expectations of the UseCase.GetCategoryAttributes
+type UseCaseMockGetCategoryAttributesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCategoryID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Optional() *mUseCaseMockGetCategoryAttributes {
+	mmGetCategoryAttributes.optional = true
+	return mmGetCategoryAttributes
+}
+
+// Expect sets up expected params for UseCase.GetCategoryAttributes
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Expect(ctx context.Context, categoryID uint64) *mUseCaseMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &UseCaseMockGetCategoryAttributesExpectation{}
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.paramPtrs != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategoryAttributes.defaultExpectation.params = &UseCaseMockGetCategoryAttributesParams{ctx, categoryID}
+	mmGetCategoryAttributes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategoryAttributes.expectations {
+		if minimock.Equal(e.params, mmGetCategoryAttributes.defaultExpectation.params) {
+			mmGetCategoryAttributes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategoryAttributes.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategoryAttributes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetCategoryAttributes
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &UseCaseMockGetCategoryAttributesExpectation{}
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.params != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Expect")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryAttributes.defaultExpectation.paramPtrs = &UseCaseMockGetCategoryAttributesParamPtrs{}
+	}
+	mmGetCategoryAttributes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategoryAttributes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategoryAttributes
+}
+
+// ExpectCategoryIDParam2 sets up expected param categoryID for UseCase.GetCategoryAttributes
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) ExpectCategoryIDParam2(categoryID uint64) *mUseCaseMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &UseCaseMockGetCategoryAttributesExpectation{}
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.params != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Expect")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryAttributes.defaultExpectation.paramPtrs = &UseCaseMockGetCategoryAttributesParamPtrs{}
+	}
+	mmGetCategoryAttributes.defaultExpectation.paramPtrs.categoryID = &categoryID
+	mmGetCategoryAttributes.defaultExpectation.expectationOrigins.originCategoryID = minimock.CallerInfo(1)
+
+	return mmGetCategoryAttributes
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetCategoryAttributes
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Inspect(f func(ctx context.Context, categoryID uint64)) *mUseCaseMockGetCategoryAttributes {
+	if mmGetCategoryAttributes.mock.inspectFuncGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetCategoryAttributes")
+	}
+
+	mmGetCategoryAttributes.mock.inspectFuncGetCategoryAttributes = f
+
+	return mmGetCategoryAttributes
+}
+
+// Return sets up results that will be returned by UseCase.GetCategoryAttributes
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Return(cpa1 []*entity.CategoryAttribute, err error) *UseCaseMock {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	if mmGetCategoryAttributes.defaultExpectation == nil {
+		mmGetCategoryAttributes.defaultExpectation = &UseCaseMockGetCategoryAttributesExpectation{mock: mmGetCategoryAttributes.mock}
+	}
+	mmGetCategoryAttributes.defaultExpectation.results = &UseCaseMockGetCategoryAttributesResults{cpa1, err}
+	mmGetCategoryAttributes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryAttributes.mock
+}
+
+// Set uses given function f to mock the UseCase.GetCategoryAttributes method
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Set(f func(ctx context.Context, categoryID uint64) (cpa1 []*entity.CategoryAttribute, err error)) *UseCaseMock {
+	if mmGetCategoryAttributes.defaultExpectation != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("Default expectation is already set for the UseCase.GetCategoryAttributes method")
+	}
+
+	if len(mmGetCategoryAttributes.expectations) > 0 {
+		mmGetCategoryAttributes.mock.t.Fatalf("Some expectations are already set for the UseCase.GetCategoryAttributes method")
+	}
+
+	mmGetCategoryAttributes.mock.funcGetCategoryAttributes = f
+	mmGetCategoryAttributes.mock.funcGetCategoryAttributesOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryAttributes.mock
+}
+
+// When sets expectation for the UseCase.GetCategoryAttributes which will trigger the result defined by the following
+// Then helper
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) When(ctx context.Context, categoryID uint64) *UseCaseMockGetCategoryAttributesExpectation {
+	if mmGetCategoryAttributes.mock.funcGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.mock.t.Fatalf("UseCaseMock.GetCategoryAttributes mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetCategoryAttributesExpectation{
+		mock:               mmGetCategoryAttributes.mock,
+		params:             &UseCaseMockGetCategoryAttributesParams{ctx, categoryID},
+		expectationOrigins: UseCaseMockGetCategoryAttributesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategoryAttributes.expectations = append(mmGetCategoryAttributes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetCategoryAttributes return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetCategoryAttributesExpectation) Then(cpa1 []*entity.CategoryAttribute, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetCategoryAttributesResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetCategoryAttributes should be invoked
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Times(n uint64) *mUseCaseMockGetCategoryAttributes {
+	if n == 0 {
+		mmGetCategoryAttributes.mock.t.Fatalf("Times of UseCaseMock.GetCategoryAttributes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategoryAttributes.expectedInvocations, n)
+	mmGetCategoryAttributes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryAttributes
+}
+
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) invocationsDone() bool {
+	if len(mmGetCategoryAttributes.expectations) == 0 && mmGetCategoryAttributes.defaultExpectation == nil && mmGetCategoryAttributes.mock.funcGetCategoryAttributes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategoryAttributes.mock.afterGetCategoryAttributesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategoryAttributes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategoryAttributes implements mm_category.UseCase
+func (mmGetCategoryAttributes *UseCaseMock) GetCategoryAttributes(ctx context.Context, categoryID uint64) (cpa1 []*entity.CategoryAttribute, err error) {
+	mm_atomic.AddUint64(&mmGetCategoryAttributes.beforeGetCategoryAttributesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategoryAttributes.afterGetCategoryAttributesCounter, 1)
+
+	mmGetCategoryAttributes.t.Helper()
+
+	if mmGetCategoryAttributes.inspectFuncGetCategoryAttributes != nil {
+		mmGetCategoryAttributes.inspectFuncGetCategoryAttributes(ctx, categoryID)
+	}
+
+	mm_params := UseCaseMockGetCategoryAttributesParams{ctx, categoryID}
+
+	// Record call args
+	mmGetCategoryAttributes.GetCategoryAttributesMock.mutex.Lock()
+	mmGetCategoryAttributes.GetCategoryAttributesMock.callArgs = append(mmGetCategoryAttributes.GetCategoryAttributesMock.callArgs, &mm_params)
+	mmGetCategoryAttributes.GetCategoryAttributesMock.mutex.Unlock()
+
+	for _, e := range mmGetCategoryAttributes.GetCategoryAttributesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetCategoryAttributesParams{ctx, categoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategoryAttributes.t.Errorf("UseCaseMock.GetCategoryAttributes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.categoryID != nil && !minimock.Equal(*mm_want_ptrs.categoryID, mm_got.categoryID) {
+				mmGetCategoryAttributes.t.Errorf("UseCaseMock.GetCategoryAttributes got unexpected parameter categoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.originCategoryID, *mm_want_ptrs.categoryID, mm_got.categoryID, minimock.Diff(*mm_want_ptrs.categoryID, mm_got.categoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategoryAttributes.t.Errorf("UseCaseMock.GetCategoryAttributes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategoryAttributes.GetCategoryAttributesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategoryAttributes.t.Fatal("No results are set for the UseCaseMock.GetCategoryAttributes")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCategoryAttributes.funcGetCategoryAttributes != nil {
+		return mmGetCategoryAttributes.funcGetCategoryAttributes(ctx, categoryID)
+	}
+	mmGetCategoryAttributes.t.Fatalf("Unexpected call to UseCaseMock.GetCategoryAttributes. %v %v", ctx, categoryID)
+	return
+}
+
+// GetCategoryAttributesAfterCounter returns a count of finished UseCaseMock.GetCategoryAttributes invocations
+func (mmGetCategoryAttributes *UseCaseMock) GetCategoryAttributesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryAttributes.afterGetCategoryAttributesCounter)
+}
+
+// GetCategoryAttributesBeforeCounter returns a count of UseCaseMock.GetCategoryAttributes invocations
+func (mmGetCategoryAttributes *UseCaseMock) GetCategoryAttributesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryAttributes.beforeGetCategoryAttributesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetCategoryAttributes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategoryAttributes *mUseCaseMockGetCategoryAttributes) Calls() []*UseCaseMockGetCategoryAttributesParams {
+	mmGetCategoryAttributes.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetCategoryAttributesParams, len(mmGetCategoryAttributes.callArgs))
+	copy(argCopy, mmGetCategoryAttributes.callArgs)
+
+	mmGetCategoryAttributes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoryAttributesDone returns true if the count of the GetCategoryAttributes invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetCategoryAttributesDone() bool {
+	if m.GetCategoryAttributesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoryAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoryAttributesMock.invocationsDone()
+}
+
+// MinimockGetCategoryAttributesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetCategoryAttributesInspect() {
+	for _, e := range m.GetCategoryAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetCategoryAttributes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoryAttributesCounter := mm_atomic.LoadUint64(&m.afterGetCategoryAttributesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoryAttributesMock.defaultExpectation != nil && afterGetCategoryAttributesCounter < 1 {
+		if m.GetCategoryAttributesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetCategoryAttributes at\n%s", m.GetCategoryAttributesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetCategoryAttributes at\n%s with params: %#v", m.GetCategoryAttributesMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoryAttributesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategoryAttributes != nil && afterGetCategoryAttributesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetCategoryAttributes at\n%s", m.funcGetCategoryAttributesOrigin)
+	}
+
+	if !m.GetCategoryAttributesMock.invocationsDone() && afterGetCategoryAttributesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetCategoryAttributes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoryAttributesMock.expectedInvocations), m.GetCategoryAttributesMock.expectedInvocationsOrigin, afterGetCategoryAttributesCounter)
+	}
+}
+
+type mUseCaseMockGetCategoryTree struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetCategoryTreeExpectation
+	expectations       []*UseCaseMockGetCategoryTreeExpectation
+
+	callArgs []*UseCaseMockGetCategoryTreeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetCategoryTreeExpectation specifies expectation struct of the UseCase.GetCategoryTree
+type UseCaseMockGetCategoryTreeExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetCategoryTreeParams
+	paramPtrs          *UseCaseMockGetCategoryTreeParamPtrs
+	expectationOrigins UseCaseMockGetCategoryTreeExpectationOrigins
+	results            *UseCaseMockGetCategoryTreeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetCategoryTreeParams contains parameters of the UseCase.GetCategoryTree
+type UseCaseMockGetCategoryTreeParams struct {
+	ctx    context.Context
+	rootID *uint64
+}
+
+// UseCaseMockGetCategoryTreeParamPtrs contains pointers to parameters of the UseCase.GetCategoryTree
+type UseCaseMockGetCategoryTreeParamPtrs struct {
+	ctx    *context.Context
+	rootID **uint64
+}
+
+// UseCaseMockGetCategoryTreeResults contains results of the UseCase.GetCategoryTree
+type UseCaseMockGetCategoryTreeResults struct {
+	cpa1 []*entity.CategoryNode
+	err  error
+}
+
+// UseCaseMockGetCategoryTreeOrigins contains origins of expectations of the UseCase.GetCategoryTree
+type UseCaseMockGetCategoryTreeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRootID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Optional() *mUseCaseMockGetCategoryTree {
+	mmGetCategoryTree.optional = true
+	return mmGetCategoryTree
+}
+
+// Expect sets up expected params for UseCase.GetCategoryTree
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Expect(ctx context.Context, rootID *uint64) *mUseCaseMockGetCategoryTree {
+	if mmGetCategoryTree.mock.funcGetCategoryTree != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Set")
+	}
+
+	if mmGetCategoryTree.defaultExpectation == nil {
+		mmGetCategoryTree.defaultExpectation = &UseCaseMockGetCategoryTreeExpectation{}
+	}
+
+	if mmGetCategoryTree.defaultExpectation.paramPtrs != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategoryTree.defaultExpectation.params = &UseCaseMockGetCategoryTreeParams{ctx, rootID}
+	mmGetCategoryTree.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategoryTree.expectations {
+		if minimock.Equal(e.params, mmGetCategoryTree.defaultExpectation.params) {
+			mmGetCategoryTree.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategoryTree.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategoryTree
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetCategoryTree
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetCategoryTree {
+	if mmGetCategoryTree.mock.funcGetCategoryTree != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Set")
+	}
+
+	if mmGetCategoryTree.defaultExpectation == nil {
+		mmGetCategoryTree.defaultExpectation = &UseCaseMockGetCategoryTreeExpectation{}
+	}
+
+	if mmGetCategoryTree.defaultExpectation.params != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Expect")
+	}
+
+	if mmGetCategoryTree.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryTree.defaultExpectation.paramPtrs = &UseCaseMockGetCategoryTreeParamPtrs{}
+	}
+	mmGetCategoryTree.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategoryTree.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategoryTree
+}
+
+// ExpectRootIDParam2 sets up expected param rootID for UseCase.GetCategoryTree
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) ExpectRootIDParam2(rootID *uint64) *mUseCaseMockGetCategoryTree {
+	if mmGetCategoryTree.mock.funcGetCategoryTree != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Set")
+	}
+
+	if mmGetCategoryTree.defaultExpectation == nil {
+		mmGetCategoryTree.defaultExpectation = &UseCaseMockGetCategoryTreeExpectation{}
+	}
+
+	if mmGetCategoryTree.defaultExpectation.params != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Expect")
+	}
+
+	if mmGetCategoryTree.defaultExpectation.paramPtrs == nil {
+		mmGetCategoryTree.defaultExpectation.paramPtrs = &UseCaseMockGetCategoryTreeParamPtrs{}
+	}
+	mmGetCategoryTree.defaultExpectation.paramPtrs.rootID = &rootID
+	mmGetCategoryTree.defaultExpectation.expectationOrigins.originRootID = minimock.CallerInfo(1)
+
+	return mmGetCategoryTree
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetCategoryTree
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Inspect(f func(ctx context.Context, rootID *uint64)) *mUseCaseMockGetCategoryTree {
+	if mmGetCategoryTree.mock.inspectFuncGetCategoryTree != nil {
+		mmGetCategoryTree.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetCategoryTree")
+	}
+
+	mmGetCategoryTree.mock.inspectFuncGetCategoryTree = f
+
+	return mmGetCategoryTree
+}
+
+// Return sets up results that will be returned by UseCase.GetCategoryTree
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Return(cpa1 []*entity.CategoryNode, err error) *UseCaseMock {
+	if mmGetCategoryTree.mock.funcGetCategoryTree != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Set")
+	}
+
+	if mmGetCategoryTree.defaultExpectation == nil {
+		mmGetCategoryTree.defaultExpectation = &UseCaseMockGetCategoryTreeExpectation{mock: mmGetCategoryTree.mock}
+	}
+	mmGetCategoryTree.defaultExpectation.results = &UseCaseMockGetCategoryTreeResults{cpa1, err}
+	mmGetCategoryTree.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryTree.mock
+}
+
+// Set uses given function f to mock the UseCase.GetCategoryTree method
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Set(f func(ctx context.Context, rootID *uint64) (cpa1 []*entity.CategoryNode, err error)) *UseCaseMock {
+	if mmGetCategoryTree.defaultExpectation != nil {
+		mmGetCategoryTree.mock.t.Fatalf("Default expectation is already set for the UseCase.GetCategoryTree method")
+	}
+
+	if len(mmGetCategoryTree.expectations) > 0 {
+		mmGetCategoryTree.mock.t.Fatalf("Some expectations are already set for the UseCase.GetCategoryTree method")
+	}
+
+	mmGetCategoryTree.mock.funcGetCategoryTree = f
+	mmGetCategoryTree.mock.funcGetCategoryTreeOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryTree.mock
+}
+
+// When sets expectation for the UseCase.GetCategoryTree which will trigger the result defined by the following
+// Then helper
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) When(ctx context.Context, rootID *uint64) *UseCaseMockGetCategoryTreeExpectation {
+	if mmGetCategoryTree.mock.funcGetCategoryTree != nil {
+		mmGetCategoryTree.mock.t.Fatalf("UseCaseMock.GetCategoryTree mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetCategoryTreeExpectation{
+		mock:               mmGetCategoryTree.mock,
+		params:             &UseCaseMockGetCategoryTreeParams{ctx, rootID},
+		expectationOrigins: UseCaseMockGetCategoryTreeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategoryTree.expectations = append(mmGetCategoryTree.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetCategoryTree return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetCategoryTreeExpectation) Then(cpa1 []*entity.CategoryNode, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetCategoryTreeResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetCategoryTree should be invoked
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Times(n uint64) *mUseCaseMockGetCategoryTree {
+	if n == 0 {
+		mmGetCategoryTree.mock.t.Fatalf("Times of UseCaseMock.GetCategoryTree mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategoryTree.expectedInvocations, n)
+	mmGetCategoryTree.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategoryTree
+}
+
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) invocationsDone() bool {
+	if len(mmGetCategoryTree.expectations) == 0 && mmGetCategoryTree.defaultExpectation == nil && mmGetCategoryTree.mock.funcGetCategoryTree == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategoryTree.mock.afterGetCategoryTreeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategoryTree.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategoryTree implements mm_category.UseCase
+func (mmGetCategoryTree *UseCaseMock) GetCategoryTree(ctx context.Context, rootID *uint64) (cpa1 []*entity.CategoryNode, err error) {
+	mm_atomic.AddUint64(&mmGetCategoryTree.beforeGetCategoryTreeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategoryTree.afterGetCategoryTreeCounter, 1)
+
+	mmGetCategoryTree.t.Helper()
+
+	if mmGetCategoryTree.inspectFuncGetCategoryTree != nil {
+		mmGetCategoryTree.inspectFuncGetCategoryTree(ctx, rootID)
+	}
+
+	mm_params := UseCaseMockGetCategoryTreeParams{ctx, rootID}
+
+	// Record call args
+	mmGetCategoryTree.GetCategoryTreeMock.mutex.Lock()
+	mmGetCategoryTree.GetCategoryTreeMock.callArgs = append(mmGetCategoryTree.GetCategoryTreeMock.callArgs, &mm_params)
+	mmGetCategoryTree.GetCategoryTreeMock.mutex.Unlock()
+
+	for _, e := range mmGetCategoryTree.GetCategoryTreeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetCategoryTreeParams{ctx, rootID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategoryTree.t.Errorf("UseCaseMock.GetCategoryTree got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootID != nil && !minimock.Equal(*mm_want_ptrs.rootID, mm_got.rootID) {
+				mmGetCategoryTree.t.Errorf("UseCaseMock.GetCategoryTree got unexpected parameter rootID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.expectationOrigins.originRootID, *mm_want_ptrs.rootID, mm_got.rootID, minimock.Diff(*mm_want_ptrs.rootID, mm_got.rootID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategoryTree.t.Errorf("UseCaseMock.GetCategoryTree got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategoryTree.GetCategoryTreeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategoryTree.t.Fatal("No results are set for the UseCaseMock.GetCategoryTree")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCategoryTree.funcGetCategoryTree != nil {
+		return mmGetCategoryTree.funcGetCategoryTree(ctx, rootID)
+	}
+	mmGetCategoryTree.t.Fatalf("Unexpected call to UseCaseMock.GetCategoryTree. %v %v", ctx, rootID)
+	return
+}
+
+// GetCategoryTreeAfterCounter returns a count of finished UseCaseMock.GetCategoryTree invocations
+func (mmGetCategoryTree *UseCaseMock) GetCategoryTreeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryTree.afterGetCategoryTreeCounter)
+}
+
+// GetCategoryTreeBeforeCounter returns a count of UseCaseMock.GetCategoryTree invocations
+func (mmGetCategoryTree *UseCaseMock) GetCategoryTreeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategoryTree.beforeGetCategoryTreeCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetCategoryTree.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategoryTree *mUseCaseMockGetCategoryTree) Calls() []*UseCaseMockGetCategoryTreeParams {
+	mmGetCategoryTree.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetCategoryTreeParams, len(mmGetCategoryTree.callArgs))
+	copy(argCopy, mmGetCategoryTree.callArgs)
+
+	mmGetCategoryTree.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoryTreeDone returns true if the count of the GetCategoryTree invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetCategoryTreeDone() bool {
+	if m.GetCategoryTreeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoryTreeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoryTreeMock.invocationsDone()
+}
+
+// MinimockGetCategoryTreeInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetCategoryTreeInspect() {
+	for _, e := range m.GetCategoryTreeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetCategoryTree at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoryTreeCounter := mm_atomic.LoadUint64(&m.afterGetCategoryTreeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoryTreeMock.defaultExpectation != nil && afterGetCategoryTreeCounter < 1 {
+		if m.GetCategoryTreeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetCategoryTree at\n%s", m.GetCategoryTreeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetCategoryTree at\n%s with params: %#v", m.GetCategoryTreeMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoryTreeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategoryTree != nil && afterGetCategoryTreeCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetCategoryTree at\n%s", m.funcGetCategoryTreeOrigin)
+	}
+
+	if !m.GetCategoryTreeMock.invocationsDone() && afterGetCategoryTreeCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetCategoryTree at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoryTreeMock.expectedInvocations), m.GetCategoryTreeMock.expectedInvocationsOrigin, afterGetCategoryTreeCounter)
+	}
+}
+
+type mUseCaseMockListCategories struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockListCategoriesExpectation
+	expectations       []*UseCaseMockListCategoriesExpectation
+
+	callArgs []*UseCaseMockListCategoriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockListCategoriesExpectation specifies expectation struct of the UseCase.ListCategories
+type UseCaseMockListCategoriesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockListCategoriesParams
+	paramPtrs          *UseCaseMockListCategoriesParamPtrs
+	expectationOrigins UseCaseMockListCategoriesExpectationOrigins
+	results            *UseCaseMockListCategoriesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockListCategoriesParams contains parameters of the UseCase.ListCategories
+type UseCaseMockListCategoriesParams struct {
+	ctx      context.Context
+	parentID *uint64
+}
+
+// UseCaseMockListCategoriesParamPtrs contains pointers to parameters of the UseCase.ListCategories
+type UseCaseMockListCategoriesParamPtrs struct {
+	ctx      *context.Context
+	parentID **uint64
+}
+
+// UseCaseMockListCategoriesResults contains results of the UseCase.ListCategories
+type UseCaseMockListCategoriesResults struct {
+	cpa1 []*entity.Category
+	err  error
+}
+
+// UseCaseMockListCategoriesOrigins contains origins of expectations of the UseCase.ListCategories
+type UseCaseMockListCategoriesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originParentID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListCategories *mUseCaseMockListCategories) Optional() *mUseCaseMockListCategories {
+	mmListCategories.optional = true
+	return mmListCategories
+}
+
+// Expect sets up expected params for UseCase.ListCategories
+func (mmListCategories *mUseCaseMockListCategories) Expect(ctx context.Context, parentID *uint64) *mUseCaseMockListCategories {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &UseCaseMockListCategoriesExpectation{}
+	}
+
+	if mmListCategories.defaultExpectation.paramPtrs != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by ExpectParams functions")
+	}
+
+	mmListCategories.defaultExpectation.params = &UseCaseMockListCategoriesParams{ctx, parentID}
+	mmListCategories.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListCategories.expectations {
+		if minimock.Equal(e.params, mmListCategories.defaultExpectation.params) {
+			mmListCategories.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListCategories.defaultExpectation.params)
+		}
+	}
+
+	return mmListCategories
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ListCategories
+func (mmListCategories *mUseCaseMockListCategories) ExpectCtxParam1(ctx context.Context) *mUseCaseMockListCategories {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &UseCaseMockListCategoriesExpectation{}
+	}
+
+	if mmListCategories.defaultExpectation.params != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Expect")
+	}
+
+	if mmListCategories.defaultExpectation.paramPtrs == nil {
+		mmListCategories.defaultExpectation.paramPtrs = &UseCaseMockListCategoriesParamPtrs{}
+	}
+	mmListCategories.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListCategories.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListCategories
+}
+
+// ExpectParentIDParam2 sets up expected param parentID for UseCase.ListCategories
+func (mmListCategories *mUseCaseMockListCategories) ExpectParentIDParam2(parentID *uint64) *mUseCaseMockListCategories {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &UseCaseMockListCategoriesExpectation{}
+	}
+
+	if mmListCategories.defaultExpectation.params != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Expect")
+	}
+
+	if mmListCategories.defaultExpectation.paramPtrs == nil {
+		mmListCategories.defaultExpectation.paramPtrs = &UseCaseMockListCategoriesParamPtrs{}
+	}
+	mmListCategories.defaultExpectation.paramPtrs.parentID = &parentID
+	mmListCategories.defaultExpectation.expectationOrigins.originParentID = minimock.CallerInfo(1)
+
+	return mmListCategories
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ListCategories
+func (mmListCategories *mUseCaseMockListCategories) Inspect(f func(ctx context.Context, parentID *uint64)) *mUseCaseMockListCategories {
+	if mmListCategories.mock.inspectFuncListCategories != nil {
+		mmListCategories.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ListCategories")
+	}
+
+	mmListCategories.mock.inspectFuncListCategories = f
+
+	return mmListCategories
+}
+
+// Return sets up results that will be returned by UseCase.ListCategories
+func (mmListCategories *mUseCaseMockListCategories) Return(cpa1 []*entity.Category, err error) *UseCaseMock {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &UseCaseMockListCategoriesExpectation{mock: mmListCategories.mock}
+	}
+	mmListCategories.defaultExpectation.results = &UseCaseMockListCategoriesResults{cpa1, err}
+	mmListCategories.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListCategories.mock
+}
+
+// Set uses given function f to mock the UseCase.ListCategories method
+func (mmListCategories *mUseCaseMockListCategories) Set(f func(ctx context.Context, parentID *uint64) (cpa1 []*entity.Category, err error)) *UseCaseMock {
+	if mmListCategories.defaultExpectation != nil {
+		mmListCategories.mock.t.Fatalf("Default expectation is already set for the UseCase.ListCategories method")
+	}
+
+	if len(mmListCategories.expectations) > 0 {
+		mmListCategories.mock.t.Fatalf("Some expectations are already set for the UseCase.ListCategories method")
+	}
+
+	mmListCategories.mock.funcListCategories = f
+	mmListCategories.mock.funcListCategoriesOrigin = minimock.CallerInfo(1)
+	return mmListCategories.mock
+}
+
+// When sets expectation for the UseCase.ListCategories which will trigger the result defined by the following
+// Then helper
+func (mmListCategories *mUseCaseMockListCategories) When(ctx context.Context, parentID *uint64) *UseCaseMockListCategoriesExpectation {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("UseCaseMock.ListCategories mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockListCategoriesExpectation{
+		mock:               mmListCategories.mock,
+		params:             &UseCaseMockListCategoriesParams{ctx, parentID},
+		expectationOrigins: UseCaseMockListCategoriesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListCategories.expectations = append(mmListCategories.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ListCategories return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockListCategoriesExpectation) Then(cpa1 []*entity.Category, err error) *UseCaseMock {
+	e.results = &UseCaseMockListCategoriesResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ListCategories should be invoked
+func (mmListCategories *mUseCaseMockListCategories) Times(n uint64) *mUseCaseMockListCategories {
+	if n == 0 {
+		mmListCategories.mock.t.Fatalf("Times of UseCaseMock.ListCategories mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListCategories.expectedInvocations, n)
+	mmListCategories.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListCategories
+}
+
+func (mmListCategories *mUseCaseMockListCategories) invocationsDone() bool {
+	if len(mmListCategories.expectations) == 0 && mmListCategories.defaultExpectation == nil && mmListCategories.mock.funcListCategories == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListCategories.mock.afterListCategoriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListCategories.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListCategories implements mm_category.UseCase
+func (mmListCategories *UseCaseMock) ListCategories(ctx context.Context, parentID *uint64) (cpa1 []*entity.Category, err error) {
+	mm_atomic.AddUint64(&mmListCategories.beforeListCategoriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListCategories.afterListCategoriesCounter, 1)
+
+	mmListCategories.t.Helper()
+
+	if mmListCategories.inspectFuncListCategories != nil {
+		mmListCategories.inspectFuncListCategories(ctx, parentID)
+	}
+
+	mm_params := UseCaseMockListCategoriesParams{ctx, parentID}
+
+	// Record call args
+	mmListCategories.ListCategoriesMock.mutex.Lock()
+	mmListCategories.ListCategoriesMock.callArgs = append(mmListCategories.ListCategoriesMock.callArgs, &mm_params)
+	mmListCategories.ListCategoriesMock.mutex.Unlock()
+
+	for _, e := range mmListCategories.ListCategoriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListCategories.ListCategoriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListCategories.ListCategoriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListCategories.ListCategoriesMock.defaultExpectation.params
+		mm_want_ptrs := mmListCategories.ListCategoriesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockListCategoriesParams{ctx, parentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListCategories.t.Errorf("UseCaseMock.ListCategories got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCategories.ListCategoriesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmListCategories.t.Errorf("UseCaseMock.ListCategories got unexpected parameter parentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCategories.ListCategoriesMock.defaultExpectation.expectationOrigins.originParentID, *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListCategories.t.Errorf("UseCaseMock.ListCategories got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListCategories.ListCategoriesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListCategories.ListCategoriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListCategories.t.Fatal("No results are set for the UseCaseMock.ListCategories")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListCategories.funcListCategories != nil {
+		return mmListCategories.funcListCategories(ctx, parentID)
+	}
+	mmListCategories.t.Fatalf("Unexpected call to UseCaseMock.ListCategories. %v %v", ctx, parentID)
+	return
+}
+
+// ListCategoriesAfterCounter returns a count of finished UseCaseMock.ListCategories invocations
+func (mmListCategories *UseCaseMock) ListCategoriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCategories.afterListCategoriesCounter)
+}
+
+// ListCategoriesBeforeCounter returns a count of UseCaseMock.ListCategories invocations
+func (mmListCategories *UseCaseMock) ListCategoriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCategories.beforeListCategoriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ListCategories.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListCategories *mUseCaseMockListCategories) Calls() []*UseCaseMockListCategoriesParams {
+	mmListCategories.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockListCategoriesParams, len(mmListCategories.callArgs))
+	copy(argCopy, mmListCategories.callArgs)
+
+	mmListCategories.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListCategoriesDone returns true if the count of the ListCategories invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockListCategoriesDone() bool {
+	if m.ListCategoriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListCategoriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListCategoriesMock.invocationsDone()
+}
+
+// MinimockListCategoriesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockListCategoriesInspect() {
+	for _, e := range m.ListCategoriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ListCategories at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCategoriesCounter := mm_atomic.LoadUint64(&m.afterListCategoriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListCategoriesMock.defaultExpectation != nil && afterListCategoriesCounter < 1 {
+		if m.ListCategoriesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ListCategories at\n%s", m.ListCategoriesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ListCategories at\n%s with params: %#v", m.ListCategoriesMock.defaultExpectation.expectationOrigins.origin, *m.ListCategoriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListCategories != nil && afterListCategoriesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ListCategories at\n%s", m.funcListCategoriesOrigin)
+	}
+
+	if !m.ListCategoriesMock.invocationsDone() && afterListCategoriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ListCategories at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListCategoriesMock.expectedInvocations), m.ListCategoriesMock.expectedInvocationsOrigin, afterListCategoriesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetCategoryAttributesInspect()
+
+			m.MinimockGetCategoryTreeInspect()
+
+			m.MinimockListCategoriesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetCategoryAttributesDone() &&
+		m.MinimockGetCategoryTreeDone() &&
+		m.MinimockListCategoriesDone()
+}
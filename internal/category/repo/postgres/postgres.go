@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type dbManager interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	GetPool() *pgxpool.Pool
+}
+
+// categoryColumns содержит список полей категории для выборки
+const categoryColumns = `id, parent_id, name, slug, position, created_at`
+
+// Repository реализует интерфейс category.Repository
+type Repository struct {
+	db     dbManager
+	logger *logger.Logger
+}
+
+// New создает новый экземпляр репозитория
+func New(db dbManager, logger *logger.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// scanCategory сканирует строку базы данных в структуру Category
+func scanCategory(row pgx.Row) (*entity.Category, error) {
+	category := &entity.Category{}
+	var parentID pgtype.Int8
+	var createdAt pgtype.Timestamptz
+
+	err := row.Scan(
+		&category.ID,
+		&parentID,
+		&category.Name,
+		&category.Slug,
+		&category.Position,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		id := uint64(parentID.Int64)
+		category.ParentID = &id
+	}
+	category.CreatedAt = createdAt.Time
+
+	return category, nil
+}
+
+// queryCategories выполняет запрос и сканирует все найденные категории
+func (r *Repository) queryCategories(ctx context.Context, query string, args ...any) ([]*entity.Category, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении категорий", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении категорий")
+	}
+	defer rows.Close()
+
+	categories := make([]*entity.Category, 0)
+
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании категории", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении категорий")
+		}
+		categories = append(categories, category)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке категорий", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении категорий")
+	}
+
+	return categories, nil
+}
+
+// GetCategories возвращает все категории
+func (r *Repository) GetCategories(ctx context.Context) ([]*entity.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		ORDER BY position, name`
+
+	return r.queryCategories(ctx, query)
+}
+
+// GetCategoriesByParent возвращает дочерние категории. Если parentID не указан, возвращаются категории верхнего уровня
+func (r *Repository) GetCategoriesByParent(ctx context.Context, parentID *uint64) ([]*entity.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE parent_id IS NOT DISTINCT FROM $1
+		ORDER BY position, name`
+
+	return r.queryCategories(ctx, query, parentID)
+}
+
+// GetCategoryByID находит категорию по ID
+func (r *Repository) GetCategoryByID(ctx context.Context, id uint64) (*entity.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE id = $1`
+
+	category, err := scanCategory(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrCategoryNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при получении категории",
+			zap.Uint64("category_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении категории")
+	}
+
+	return category, nil
+}
+
+// GetCategoryAttributes возвращает атрибуты категории вместе с атрибутами всех ее родительских категорий.
+// Атрибуты родителей идут первыми
+func (r *Repository) GetCategoryAttributes(ctx context.Context, categoryID uint64) ([]*entity.CategoryAttribute, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth FROM categories WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1 FROM categories c JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT ca.id, ca.category_id, ca.key, ca.name, ca.type, ca.required,
+			ca.min_value, ca.max_value, ca.max_length, ca.options, ca.position
+		FROM category_attributes ca
+		JOIN ancestors a ON ca.category_id = a.id
+		ORDER BY a.depth DESC, ca.position, ca.key`
+
+	rows, err := r.db.Query(ctx, query, categoryID)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении атрибутов категории",
+			zap.Uint64("category_id", categoryID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении атрибутов категории")
+	}
+	defer rows.Close()
+
+	attributes := make([]*entity.CategoryAttribute, 0)
+
+	for rows.Next() {
+		attribute := &entity.CategoryAttribute{}
+		var minValue, maxValue pgtype.Float8
+		var maxLength pgtype.Int4
+
+		err := rows.Scan(
+			&attribute.ID,
+			&attribute.CategoryID,
+			&attribute.Key,
+			&attribute.Name,
+			&attribute.Type,
+			&attribute.Required,
+			&minValue,
+			&maxValue,
+			&maxLength,
+			&attribute.Options,
+			&attribute.Position,
+		)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании атрибута категории", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении атрибутов категории")
+		}
+
+		if minValue.Valid {
+			attribute.MinValue = &minValue.Float64
+		}
+		if maxValue.Valid {
+			attribute.MaxValue = &maxValue.Float64
+		}
+		if maxLength.Valid {
+			attribute.MaxLength = &maxLength.Int32
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке атрибутов категории", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении атрибутов категории")
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/category"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// UseCase реализует интерфейс category.UseCase
+type UseCase struct {
+	repo category.Repository
+	log  *logger.Logger
+}
+
+// New создает новый экземпляр UseCase
+func New(repo category.Repository, log *logger.Logger) *UseCase {
+	return &UseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// ListCategories возвращает дочерние категории или категории верхнего уровня
+func (uc *UseCase) ListCategories(ctx context.Context, parentID *uint64) ([]*entity.Category, error) {
+	if parentID != nil {
+		if _, err := uc.repo.GetCategoryByID(ctx, *parentID); err != nil {
+			uc.log.Warn(ctx, "Родительская категория не найдена",
+				zap.Uint64("parent_id", *parentID),
+				zap.Error(err))
+			return nil, err
+		}
+	}
+
+	categories, err := uc.repo.GetCategoriesByParent(ctx, parentID)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении списка категорий", zap.Error(err))
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// GetCategoryTree возвращает дерево категорий или поддерево с корнем rootID
+func (uc *UseCase) GetCategoryTree(ctx context.Context, rootID *uint64) ([]*entity.CategoryNode, error) {
+	if rootID != nil {
+		if _, err := uc.repo.GetCategoryByID(ctx, *rootID); err != nil {
+			uc.log.Warn(ctx, "Корневая категория не найдена",
+				zap.Uint64("root_id", *rootID),
+				zap.Error(err))
+			return nil, err
+		}
+	}
+
+	categories, err := uc.repo.GetCategories(ctx)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении дерева категорий", zap.Error(err))
+		return nil, err
+	}
+
+	return buildTree(categories, rootID), nil
+}
+
+// GetCategoryAttributes возвращает схему атрибутов объявлений категории с учетом унаследованных атрибутов
+func (uc *UseCase) GetCategoryAttributes(ctx context.Context, categoryID uint64) ([]*entity.CategoryAttribute, error) {
+	if _, err := uc.repo.GetCategoryByID(ctx, categoryID); err != nil {
+		uc.log.Warn(ctx, "Категория не найдена",
+			zap.Uint64("category_id", categoryID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	attributes, err := uc.repo.GetCategoryAttributes(ctx, categoryID)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении атрибутов категории",
+			zap.Uint64("category_id", categoryID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// buildTree строит дерево из плоского списка категорий, отсортированного по позиции
+func buildTree(categories []*entity.Category, rootID *uint64) []*entity.CategoryNode {
+	nodes := make(map[uint64]*entity.CategoryNode, len(categories))
+	for _, c := range categories {
+		nodes[c.ID] = &entity.CategoryNode{Category: c}
+	}
+
+	roots := make([]*entity.CategoryNode, 0)
+	for _, c := range categories {
+		node := nodes[c.ID]
+
+		if rootID != nil && c.ID == *rootID {
+			roots = append(roots, node)
+		} else if rootID == nil && c.ParentID == nil {
+			roots = append(roots, node)
+		}
+
+		if c.ParentID != nil {
+			if parent, ok := nodes[*c.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+			}
+		}
+	}
+
+	return roots
+}
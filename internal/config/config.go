@@ -2,11 +2,15 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
+// defaultAccessTokenDuration время жизни токена доступа, если оно не задано в конфигурации
+const defaultAccessTokenDuration = 15 * time.Minute
+
 type Config struct {
 	Environment string `yaml:"env" env:"ENV" env-default:"development"`
 	LogLevel    string `yaml:"log_level" env:"LOG_LEVEL" env-default:"info"`
@@ -27,13 +31,23 @@ type Config struct {
 	} `yaml:"gateway"`
 
 	JWT struct {
-		SecretKey     string        `yaml:"secret_key" env:"JWT_SECRET_KEY" env-default:"superpuper-secret-key"`
-		TokenDuration time.Duration `yaml:"token_duration" env:"JWT_TOKEN_DURATION" env-default:"24h"`
+		SecretKey string `yaml:"secret_key" env:"JWT_SECRET_KEY" env-default:"superpuper-secret-key"`
+		// AccessTokenDuration задает время жизни токена доступа, после истечения он обновляется по refresh-токену
+		AccessTokenDuration time.Duration `yaml:"access_token_duration" env:"JWT_ACCESS_TOKEN_DURATION"`
+		// TokenDuration устаревшее название AccessTokenDuration, учитывается, если новый параметр не задан
+		TokenDuration        time.Duration `yaml:"token_duration" env:"JWT_TOKEN_DURATION"`
+		RefreshTokenDuration time.Duration `yaml:"refresh_token_duration" env:"JWT_REFRESH_TOKEN_DURATION" env-default:"720h"`
+		// RefreshTokenPurgeInterval задает период удаления истекших refresh-токенов
+		RefreshTokenPurgeInterval time.Duration `yaml:"refresh_token_purge_interval" env:"JWT_REFRESH_TOKEN_PURGE_INTERVAL" env-default:"1h"`
 	} `yaml:"jwt"`
 
 	Swagger struct {
-		AuthPath     string `yaml:"auth_path" env:"SWAGGER_AUTH_PATH" env-default:"./pkg/api/auth/auth.swagger.json"`
-		ListingsPath string `yaml:"listings_path" env:"SWAGGER_LISTINGS_PATH" env-default:"./pkg/api/listings/listings.swagger.json"`
+		AuthPath          string `yaml:"auth_path" env:"SWAGGER_AUTH_PATH" env-default:"./pkg/api/auth/auth.swagger.json"`
+		ListingsPath      string `yaml:"listings_path" env:"SWAGGER_LISTINGS_PATH" env-default:"./pkg/api/listings/listings.swagger.json"`
+		CategoriesPath    string `yaml:"categories_path" env:"SWAGGER_CATEGORIES_PATH" env-default:"./pkg/api/categories/categories.swagger.json"`
+		NotificationsPath string `yaml:"notifications_path" env:"SWAGGER_NOTIFICATIONS_PATH" env-default:"./pkg/api/notifications/notifications.swagger.json"`
+		UsersPath         string `yaml:"users_path" env:"SWAGGER_USERS_PATH" env-default:"./pkg/api/users/users.swagger.json"`
+		UploadsPath       string `yaml:"uploads_path" env:"SWAGGER_UPLOADS_PATH" env-default:"./pkg/api/uploads/uploads.swagger.json"`
 	} `yaml:"swagger"`
 
 	Postgres struct {
@@ -48,6 +62,59 @@ type Config struct {
 		ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"POSTGRES_CONN_MAX_LIFETIME" env-default:"5m"`
 	} `yaml:"postgres"`
 
+	Listings struct {
+		DeletedRetention           time.Duration `yaml:"deleted_retention" env:"LISTINGS_DELETED_RETENTION" env-default:"720h"`
+		PurgeInterval              time.Duration `yaml:"purge_interval" env:"LISTINGS_PURGE_INTERVAL" env-default:"1h"`
+		SimilarityThreshold        float64       `yaml:"similarity_threshold" env:"LISTINGS_SIMILARITY_THRESHOLD" env-default:"0.3"`
+		SuggestionsRefreshInterval time.Duration `yaml:"suggestions_refresh_interval" env:"LISTINGS_SUGGESTIONS_REFRESH_INTERVAL" env-default:"1m"`
+		SuggestionsMaxTerms        int           `yaml:"suggestions_max_terms" env:"LISTINGS_SUGGESTIONS_MAX_TERMS" env-default:"10000"`
+		PriceBuckets               []float32     `yaml:"price_buckets" env:"LISTINGS_PRICE_BUCKETS" env-default:"1000,5000,10000,50000,100000"`
+		DefaultCurrency            string        `yaml:"default_currency" env:"LISTINGS_DEFAULT_CURRENCY" env-default:"RUB"`
+		MaxImages                  int           `yaml:"max_images" env:"LISTINGS_MAX_IMAGES" env-default:"10"`
+		ImportRemoteImages         bool          `yaml:"import_remote_images" env:"LISTINGS_IMPORT_REMOTE_IMAGES" env-default:"false"`
+		MaxSavedSearches           int           `yaml:"max_saved_searches" env:"LISTINGS_MAX_SAVED_SEARCHES" env-default:"20"`
+		SavedSearchesInterval      time.Duration `yaml:"saved_searches_interval" env:"LISTINGS_SAVED_SEARCHES_INTERVAL" env-default:"1m"`
+	} `yaml:"listings"`
+
+	Currency struct {
+		Provider        string             `yaml:"provider" env:"CURRENCY_PROVIDER" env-default:"static"`
+		RatesFile       string             `yaml:"rates_file" env:"CURRENCY_RATES_FILE" env-default:"./config/exchange_rates.json"`
+		Rates           map[string]float64 `yaml:"rates" env:"CURRENCY_RATES" env-default:"USD:90,EUR:98"`
+		RefreshInterval time.Duration      `yaml:"refresh_interval" env:"CURRENCY_REFRESH_INTERVAL" env-default:"1h"`
+	} `yaml:"currency"`
+
+	Storage struct {
+		Backend   string `yaml:"backend" env:"STORAGE_BACKEND" env-default:"local"`
+		PublicURL string `yaml:"public_url" env:"STORAGE_PUBLIC_URL" env-default:"http://localhost:8080/media"`
+		LocalDir  string `yaml:"local_dir" env:"STORAGE_LOCAL_DIR" env-default:"./uploads"`
+		S3        struct {
+			Endpoint  string        `yaml:"endpoint" env:"STORAGE_S3_ENDPOINT" env-default:"http://minio:9000"`
+			Region    string        `yaml:"region" env:"STORAGE_S3_REGION" env-default:"us-east-1"`
+			Bucket    string        `yaml:"bucket" env:"STORAGE_S3_BUCKET" env-default:"marketplace"`
+			AccessKey string        `yaml:"access_key" env:"STORAGE_S3_ACCESS_KEY" env-default:"minioadmin"`
+			SecretKey string        `yaml:"secret_key" env:"STORAGE_S3_SECRET_KEY" env-default:"minioadmin"`
+			Timeout   time.Duration `yaml:"timeout" env:"STORAGE_S3_TIMEOUT" env-default:"30s"`
+		} `yaml:"s3"`
+	} `yaml:"storage"`
+
+	Uploads struct {
+		MaxSize         int64         `yaml:"max_size" env:"UPLOADS_MAX_SIZE" env-default:"10485760"`
+		AllowedTypes    []string      `yaml:"allowed_types" env:"UPLOADS_ALLOWED_TYPES" env-default:"image/jpeg,image/png,image/webp,image/gif"`
+		Variants        []string      `yaml:"variants" env:"UPLOADS_VARIANTS" env-default:"thumb:200:jpeg,medium:800:jpeg"`
+		VariantQuality  int           `yaml:"variant_quality" env:"UPLOADS_VARIANT_QUALITY" env-default:"82"`
+		ProcessInterval time.Duration `yaml:"process_interval" env:"UPLOADS_PROCESS_INTERVAL" env-default:"2s"`
+		Import          struct {
+			Timeout      time.Duration `yaml:"timeout" env:"UPLOADS_IMPORT_TIMEOUT" env-default:"10s"`
+			MaxRedirects int           `yaml:"max_redirects" env:"UPLOADS_IMPORT_MAX_REDIRECTS" env-default:"3"`
+			AllowPrivate bool          `yaml:"allow_private" env:"UPLOADS_IMPORT_ALLOW_PRIVATE" env-default:"false"`
+		} `yaml:"import"`
+	} `yaml:"uploads"`
+
+	Notifications struct {
+		// Channels перечисляет каналы доставки уведомлений: inapp — лента в приложении, log — журнал
+		Channels []string `yaml:"channels" env:"NOTIFICATIONS_CHANNELS" env-default:"inapp,log"`
+	} `yaml:"notifications"`
+
 	Migrations struct {
 		Dir string `yaml:"dir" env:"MIGRATIONS_DIR" env-default:"./migrations"`
 	} `yaml:"migrations"`
@@ -61,9 +128,25 @@ func New() (*Config, error) {
 		return nil, err
 	}
 
+	cfg.applyDeprecated()
+
 	return cfg, nil
 }
 
+// applyDeprecated переносит значения устаревших параметров, если новые параметры не заданы.
+// Переменная окружения JWT_TOKEN_DURATION учитывается и тогда, когда access_token_duration задан в файле конфигурации,
+// так как переменные окружения имеют приоритет над файлом
+func (c *Config) applyDeprecated() {
+	_, accessFromEnv := os.LookupEnv("JWT_ACCESS_TOKEN_DURATION")
+	_, legacyFromEnv := os.LookupEnv("JWT_TOKEN_DURATION")
+	if c.JWT.AccessTokenDuration == 0 || (legacyFromEnv && !accessFromEnv) {
+		c.JWT.AccessTokenDuration = c.JWT.TokenDuration
+	}
+	if c.JWT.AccessTokenDuration == 0 {
+		c.JWT.AccessTokenDuration = defaultAccessTokenDuration
+	}
+}
+
 // GetPostgresDSN возвращает строку подключения к PostgreSQL
 func (c *Config) GetPostgresDSN() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
@@ -0,0 +1,21 @@
+package currency
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+type Repository interface {
+	GetRate(ctx context.Context, currency string) (*entity.ExchangeRate, error)
+	UpsertRates(ctx context.Context, rates []*entity.ExchangeRate) error
+}
+
+// RateProvider возвращает курсы валют относительно базовой валюты
+type RateProvider interface {
+	FetchRates(ctx context.Context) (map[string]float64, error)
+}
+
+type UseCase interface {
+	RefreshRates(ctx context.Context) error
+}
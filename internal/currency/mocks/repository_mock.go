@@ -0,0 +1,773 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/currency.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_currency.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetRate          func(ctx context.Context, currency string) (ep1 *entity.ExchangeRate, err error)
+	funcGetRateOrigin    string
+	inspectFuncGetRate   func(ctx context.Context, currency string)
+	afterGetRateCounter  uint64
+	beforeGetRateCounter uint64
+	GetRateMock          mRepositoryMockGetRate
+
+	funcUpsertRates          func(ctx context.Context, rates []*entity.ExchangeRate) (err error)
+	funcUpsertRatesOrigin    string
+	inspectFuncUpsertRates   func(ctx context.Context, rates []*entity.ExchangeRate)
+	afterUpsertRatesCounter  uint64
+	beforeUpsertRatesCounter uint64
+	UpsertRatesMock          mRepositoryMockUpsertRates
+}
+
+// NewRepositoryMock returns a mock for mm_currency.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetRateMock = mRepositoryMockGetRate{mock: m}
+	m.GetRateMock.callArgs = []*RepositoryMockGetRateParams{}
+
+	m.UpsertRatesMock = mRepositoryMockUpsertRates{mock: m}
+	m.UpsertRatesMock.callArgs = []*RepositoryMockUpsertRatesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetRate struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetRateExpectation
+	expectations       []*RepositoryMockGetRateExpectation
+
+	callArgs []*RepositoryMockGetRateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetRateExpectation specifies expectation struct of the Repository.GetRate
+type RepositoryMockGetRateExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetRateParams
+	paramPtrs          *RepositoryMockGetRateParamPtrs
+	expectationOrigins RepositoryMockGetRateExpectationOrigins
+	results            *RepositoryMockGetRateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetRateParams contains parameters of the Repository.GetRate
+type RepositoryMockGetRateParams struct {
+	ctx      context.Context
+	currency string
+}
+
+// RepositoryMockGetRateParamPtrs contains pointers to parameters of the Repository.GetRate
+type RepositoryMockGetRateParamPtrs struct {
+	ctx      *context.Context
+	currency *string
+}
+
+// RepositoryMockGetRateResults contains results of the Repository.GetRate
+type RepositoryMockGetRateResults struct {
+	ep1 *entity.ExchangeRate
+	err error
+}
+
+// RepositoryMockGetRateOrigins contains origins of expectations of the Repository.GetRate
+type RepositoryMockGetRateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originCurrency string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRate *mRepositoryMockGetRate) Optional() *mRepositoryMockGetRate {
+	mmGetRate.optional = true
+	return mmGetRate
+}
+
+// Expect sets up expected params for Repository.GetRate
+func (mmGetRate *mRepositoryMockGetRate) Expect(ctx context.Context, currency string) *mRepositoryMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &RepositoryMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by ExpectParams functions")
+	}
+
+	mmGetRate.defaultExpectation.params = &RepositoryMockGetRateParams{ctx, currency}
+	mmGetRate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRate.expectations {
+		if minimock.Equal(e.params, mmGetRate.defaultExpectation.params) {
+			mmGetRate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRate.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetRate
+func (mmGetRate *mRepositoryMockGetRate) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &RepositoryMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.params != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Expect")
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs == nil {
+		mmGetRate.defaultExpectation.paramPtrs = &RepositoryMockGetRateParamPtrs{}
+	}
+	mmGetRate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRate
+}
+
+// ExpectCurrencyParam2 sets up expected param currency for Repository.GetRate
+func (mmGetRate *mRepositoryMockGetRate) ExpectCurrencyParam2(currency string) *mRepositoryMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &RepositoryMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.params != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Expect")
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs == nil {
+		mmGetRate.defaultExpectation.paramPtrs = &RepositoryMockGetRateParamPtrs{}
+	}
+	mmGetRate.defaultExpectation.paramPtrs.currency = &currency
+	mmGetRate.defaultExpectation.expectationOrigins.originCurrency = minimock.CallerInfo(1)
+
+	return mmGetRate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetRate
+func (mmGetRate *mRepositoryMockGetRate) Inspect(f func(ctx context.Context, currency string)) *mRepositoryMockGetRate {
+	if mmGetRate.mock.inspectFuncGetRate != nil {
+		mmGetRate.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetRate")
+	}
+
+	mmGetRate.mock.inspectFuncGetRate = f
+
+	return mmGetRate
+}
+
+// Return sets up results that will be returned by Repository.GetRate
+func (mmGetRate *mRepositoryMockGetRate) Return(ep1 *entity.ExchangeRate, err error) *RepositoryMock {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &RepositoryMockGetRateExpectation{mock: mmGetRate.mock}
+	}
+	mmGetRate.defaultExpectation.results = &RepositoryMockGetRateResults{ep1, err}
+	mmGetRate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRate.mock
+}
+
+// Set uses given function f to mock the Repository.GetRate method
+func (mmGetRate *mRepositoryMockGetRate) Set(f func(ctx context.Context, currency string) (ep1 *entity.ExchangeRate, err error)) *RepositoryMock {
+	if mmGetRate.defaultExpectation != nil {
+		mmGetRate.mock.t.Fatalf("Default expectation is already set for the Repository.GetRate method")
+	}
+
+	if len(mmGetRate.expectations) > 0 {
+		mmGetRate.mock.t.Fatalf("Some expectations are already set for the Repository.GetRate method")
+	}
+
+	mmGetRate.mock.funcGetRate = f
+	mmGetRate.mock.funcGetRateOrigin = minimock.CallerInfo(1)
+	return mmGetRate.mock
+}
+
+// When sets expectation for the Repository.GetRate which will trigger the result defined by the following
+// Then helper
+func (mmGetRate *mRepositoryMockGetRate) When(ctx context.Context, currency string) *RepositoryMockGetRateExpectation {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("RepositoryMock.GetRate mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetRateExpectation{
+		mock:               mmGetRate.mock,
+		params:             &RepositoryMockGetRateParams{ctx, currency},
+		expectationOrigins: RepositoryMockGetRateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRate.expectations = append(mmGetRate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetRate return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetRateExpectation) Then(ep1 *entity.ExchangeRate, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetRateResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetRate should be invoked
+func (mmGetRate *mRepositoryMockGetRate) Times(n uint64) *mRepositoryMockGetRate {
+	if n == 0 {
+		mmGetRate.mock.t.Fatalf("Times of RepositoryMock.GetRate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRate.expectedInvocations, n)
+	mmGetRate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRate
+}
+
+func (mmGetRate *mRepositoryMockGetRate) invocationsDone() bool {
+	if len(mmGetRate.expectations) == 0 && mmGetRate.defaultExpectation == nil && mmGetRate.mock.funcGetRate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRate.mock.afterGetRateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRate implements mm_currency.Repository
+func (mmGetRate *RepositoryMock) GetRate(ctx context.Context, currency string) (ep1 *entity.ExchangeRate, err error) {
+	mm_atomic.AddUint64(&mmGetRate.beforeGetRateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRate.afterGetRateCounter, 1)
+
+	mmGetRate.t.Helper()
+
+	if mmGetRate.inspectFuncGetRate != nil {
+		mmGetRate.inspectFuncGetRate(ctx, currency)
+	}
+
+	mm_params := RepositoryMockGetRateParams{ctx, currency}
+
+	// Record call args
+	mmGetRate.GetRateMock.mutex.Lock()
+	mmGetRate.GetRateMock.callArgs = append(mmGetRate.GetRateMock.callArgs, &mm_params)
+	mmGetRate.GetRateMock.mutex.Unlock()
+
+	for _, e := range mmGetRate.GetRateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmGetRate.GetRateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRate.GetRateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRate.GetRateMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRate.GetRateMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetRateParams{ctx, currency}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRate.t.Errorf("RepositoryMock.GetRate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.currency != nil && !minimock.Equal(*mm_want_ptrs.currency, mm_got.currency) {
+				mmGetRate.t.Errorf("RepositoryMock.GetRate got unexpected parameter currency, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.originCurrency, *mm_want_ptrs.currency, mm_got.currency, minimock.Diff(*mm_want_ptrs.currency, mm_got.currency))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRate.t.Errorf("RepositoryMock.GetRate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRate.GetRateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRate.t.Fatal("No results are set for the RepositoryMock.GetRate")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmGetRate.funcGetRate != nil {
+		return mmGetRate.funcGetRate(ctx, currency)
+	}
+	mmGetRate.t.Fatalf("Unexpected call to RepositoryMock.GetRate. %v %v", ctx, currency)
+	return
+}
+
+// GetRateAfterCounter returns a count of finished RepositoryMock.GetRate invocations
+func (mmGetRate *RepositoryMock) GetRateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRate.afterGetRateCounter)
+}
+
+// GetRateBeforeCounter returns a count of RepositoryMock.GetRate invocations
+func (mmGetRate *RepositoryMock) GetRateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRate.beforeGetRateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetRate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRate *mRepositoryMockGetRate) Calls() []*RepositoryMockGetRateParams {
+	mmGetRate.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetRateParams, len(mmGetRate.callArgs))
+	copy(argCopy, mmGetRate.callArgs)
+
+	mmGetRate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRateDone returns true if the count of the GetRate invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetRateDone() bool {
+	if m.GetRateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRateMock.invocationsDone()
+}
+
+// MinimockGetRateInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetRateInspect() {
+	for _, e := range m.GetRateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetRate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRateCounter := mm_atomic.LoadUint64(&m.afterGetRateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRateMock.defaultExpectation != nil && afterGetRateCounter < 1 {
+		if m.GetRateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetRate at\n%s", m.GetRateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetRate at\n%s with params: %#v", m.GetRateMock.defaultExpectation.expectationOrigins.origin, *m.GetRateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRate != nil && afterGetRateCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetRate at\n%s", m.funcGetRateOrigin)
+	}
+
+	if !m.GetRateMock.invocationsDone() && afterGetRateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetRate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRateMock.expectedInvocations), m.GetRateMock.expectedInvocationsOrigin, afterGetRateCounter)
+	}
+}
+
+type mRepositoryMockUpsertRates struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpsertRatesExpectation
+	expectations       []*RepositoryMockUpsertRatesExpectation
+
+	callArgs []*RepositoryMockUpsertRatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpsertRatesExpectation specifies expectation struct of the Repository.UpsertRates
+type RepositoryMockUpsertRatesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpsertRatesParams
+	paramPtrs          *RepositoryMockUpsertRatesParamPtrs
+	expectationOrigins RepositoryMockUpsertRatesExpectationOrigins
+	results            *RepositoryMockUpsertRatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpsertRatesParams contains parameters of the Repository.UpsertRates
+type RepositoryMockUpsertRatesParams struct {
+	ctx   context.Context
+	rates []*entity.ExchangeRate
+}
+
+// RepositoryMockUpsertRatesParamPtrs contains pointers to parameters of the Repository.UpsertRates
+type RepositoryMockUpsertRatesParamPtrs struct {
+	ctx   *context.Context
+	rates *[]*entity.ExchangeRate
+}
+
+// RepositoryMockUpsertRatesResults contains results of the Repository.UpsertRates
+type RepositoryMockUpsertRatesResults struct {
+	err error
+}
+
+// RepositoryMockUpsertRatesOrigins contains origins of expectations of the Repository.UpsertRates
+type RepositoryMockUpsertRatesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originRates string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsertRates *mRepositoryMockUpsertRates) Optional() *mRepositoryMockUpsertRates {
+	mmUpsertRates.optional = true
+	return mmUpsertRates
+}
+
+// Expect sets up expected params for Repository.UpsertRates
+func (mmUpsertRates *mRepositoryMockUpsertRates) Expect(ctx context.Context, rates []*entity.ExchangeRate) *mRepositoryMockUpsertRates {
+	if mmUpsertRates.mock.funcUpsertRates != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Set")
+	}
+
+	if mmUpsertRates.defaultExpectation == nil {
+		mmUpsertRates.defaultExpectation = &RepositoryMockUpsertRatesExpectation{}
+	}
+
+	if mmUpsertRates.defaultExpectation.paramPtrs != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by ExpectParams functions")
+	}
+
+	mmUpsertRates.defaultExpectation.params = &RepositoryMockUpsertRatesParams{ctx, rates}
+	mmUpsertRates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsertRates.expectations {
+		if minimock.Equal(e.params, mmUpsertRates.defaultExpectation.params) {
+			mmUpsertRates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertRates.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsertRates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpsertRates
+func (mmUpsertRates *mRepositoryMockUpsertRates) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpsertRates {
+	if mmUpsertRates.mock.funcUpsertRates != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Set")
+	}
+
+	if mmUpsertRates.defaultExpectation == nil {
+		mmUpsertRates.defaultExpectation = &RepositoryMockUpsertRatesExpectation{}
+	}
+
+	if mmUpsertRates.defaultExpectation.params != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Expect")
+	}
+
+	if mmUpsertRates.defaultExpectation.paramPtrs == nil {
+		mmUpsertRates.defaultExpectation.paramPtrs = &RepositoryMockUpsertRatesParamPtrs{}
+	}
+	mmUpsertRates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsertRates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpsertRates
+}
+
+// ExpectRatesParam2 sets up expected param rates for Repository.UpsertRates
+func (mmUpsertRates *mRepositoryMockUpsertRates) ExpectRatesParam2(rates []*entity.ExchangeRate) *mRepositoryMockUpsertRates {
+	if mmUpsertRates.mock.funcUpsertRates != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Set")
+	}
+
+	if mmUpsertRates.defaultExpectation == nil {
+		mmUpsertRates.defaultExpectation = &RepositoryMockUpsertRatesExpectation{}
+	}
+
+	if mmUpsertRates.defaultExpectation.params != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Expect")
+	}
+
+	if mmUpsertRates.defaultExpectation.paramPtrs == nil {
+		mmUpsertRates.defaultExpectation.paramPtrs = &RepositoryMockUpsertRatesParamPtrs{}
+	}
+	mmUpsertRates.defaultExpectation.paramPtrs.rates = &rates
+	mmUpsertRates.defaultExpectation.expectationOrigins.originRates = minimock.CallerInfo(1)
+
+	return mmUpsertRates
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpsertRates
+func (mmUpsertRates *mRepositoryMockUpsertRates) Inspect(f func(ctx context.Context, rates []*entity.ExchangeRate)) *mRepositoryMockUpsertRates {
+	if mmUpsertRates.mock.inspectFuncUpsertRates != nil {
+		mmUpsertRates.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpsertRates")
+	}
+
+	mmUpsertRates.mock.inspectFuncUpsertRates = f
+
+	return mmUpsertRates
+}
+
+// Return sets up results that will be returned by Repository.UpsertRates
+func (mmUpsertRates *mRepositoryMockUpsertRates) Return(err error) *RepositoryMock {
+	if mmUpsertRates.mock.funcUpsertRates != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Set")
+	}
+
+	if mmUpsertRates.defaultExpectation == nil {
+		mmUpsertRates.defaultExpectation = &RepositoryMockUpsertRatesExpectation{mock: mmUpsertRates.mock}
+	}
+	mmUpsertRates.defaultExpectation.results = &RepositoryMockUpsertRatesResults{err}
+	mmUpsertRates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpsertRates.mock
+}
+
+// Set uses given function f to mock the Repository.UpsertRates method
+func (mmUpsertRates *mRepositoryMockUpsertRates) Set(f func(ctx context.Context, rates []*entity.ExchangeRate) (err error)) *RepositoryMock {
+	if mmUpsertRates.defaultExpectation != nil {
+		mmUpsertRates.mock.t.Fatalf("Default expectation is already set for the Repository.UpsertRates method")
+	}
+
+	if len(mmUpsertRates.expectations) > 0 {
+		mmUpsertRates.mock.t.Fatalf("Some expectations are already set for the Repository.UpsertRates method")
+	}
+
+	mmUpsertRates.mock.funcUpsertRates = f
+	mmUpsertRates.mock.funcUpsertRatesOrigin = minimock.CallerInfo(1)
+	return mmUpsertRates.mock
+}
+
+// When sets expectation for the Repository.UpsertRates which will trigger the result defined by the following
+// Then helper
+func (mmUpsertRates *mRepositoryMockUpsertRates) When(ctx context.Context, rates []*entity.ExchangeRate) *RepositoryMockUpsertRatesExpectation {
+	if mmUpsertRates.mock.funcUpsertRates != nil {
+		mmUpsertRates.mock.t.Fatalf("RepositoryMock.UpsertRates mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpsertRatesExpectation{
+		mock:               mmUpsertRates.mock,
+		params:             &RepositoryMockUpsertRatesParams{ctx, rates},
+		expectationOrigins: RepositoryMockUpsertRatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpsertRates.expectations = append(mmUpsertRates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpsertRates return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpsertRatesExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpsertRatesResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpsertRates should be invoked
+func (mmUpsertRates *mRepositoryMockUpsertRates) Times(n uint64) *mRepositoryMockUpsertRates {
+	if n == 0 {
+		mmUpsertRates.mock.t.Fatalf("Times of RepositoryMock.UpsertRates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpsertRates.expectedInvocations, n)
+	mmUpsertRates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpsertRates
+}
+
+func (mmUpsertRates *mRepositoryMockUpsertRates) invocationsDone() bool {
+	if len(mmUpsertRates.expectations) == 0 && mmUpsertRates.defaultExpectation == nil && mmUpsertRates.mock.funcUpsertRates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertRates.mock.afterUpsertRatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertRates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpsertRates implements mm_currency.Repository
+func (mmUpsertRates *RepositoryMock) UpsertRates(ctx context.Context, rates []*entity.ExchangeRate) (err error) {
+	mm_atomic.AddUint64(&mmUpsertRates.beforeUpsertRatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertRates.afterUpsertRatesCounter, 1)
+
+	mmUpsertRates.t.Helper()
+
+	if mmUpsertRates.inspectFuncUpsertRates != nil {
+		mmUpsertRates.inspectFuncUpsertRates(ctx, rates)
+	}
+
+	mm_params := RepositoryMockUpsertRatesParams{ctx, rates}
+
+	// Record call args
+	mmUpsertRates.UpsertRatesMock.mutex.Lock()
+	mmUpsertRates.UpsertRatesMock.callArgs = append(mmUpsertRates.UpsertRatesMock.callArgs, &mm_params)
+	mmUpsertRates.UpsertRatesMock.mutex.Unlock()
+
+	for _, e := range mmUpsertRates.UpsertRatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpsertRates.UpsertRatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertRates.UpsertRatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertRates.UpsertRatesMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertRates.UpsertRatesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpsertRatesParams{ctx, rates}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpsertRates.t.Errorf("RepositoryMock.UpsertRates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertRates.UpsertRatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rates != nil && !minimock.Equal(*mm_want_ptrs.rates, mm_got.rates) {
+				mmUpsertRates.t.Errorf("RepositoryMock.UpsertRates got unexpected parameter rates, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertRates.UpsertRatesMock.defaultExpectation.expectationOrigins.originRates, *mm_want_ptrs.rates, mm_got.rates, minimock.Diff(*mm_want_ptrs.rates, mm_got.rates))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpsertRates.t.Errorf("RepositoryMock.UpsertRates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpsertRates.UpsertRatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpsertRates.UpsertRatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpsertRates.t.Fatal("No results are set for the RepositoryMock.UpsertRates")
+		}
+		return (*mm_results).err
+	}
+	if mmUpsertRates.funcUpsertRates != nil {
+		return mmUpsertRates.funcUpsertRates(ctx, rates)
+	}
+	mmUpsertRates.t.Fatalf("Unexpected call to RepositoryMock.UpsertRates. %v %v", ctx, rates)
+	return
+}
+
+// UpsertRatesAfterCounter returns a count of finished RepositoryMock.UpsertRates invocations
+func (mmUpsertRates *RepositoryMock) UpsertRatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertRates.afterUpsertRatesCounter)
+}
+
+// UpsertRatesBeforeCounter returns a count of RepositoryMock.UpsertRates invocations
+func (mmUpsertRates *RepositoryMock) UpsertRatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertRates.beforeUpsertRatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.UpsertRates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpsertRates *mRepositoryMockUpsertRates) Calls() []*RepositoryMockUpsertRatesParams {
+	mmUpsertRates.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockUpsertRatesParams, len(mmUpsertRates.callArgs))
+	copy(argCopy, mmUpsertRates.callArgs)
+
+	mmUpsertRates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpsertRatesDone returns true if the count of the UpsertRates invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpsertRatesDone() bool {
+	if m.UpsertRatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpsertRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpsertRatesMock.invocationsDone()
+}
+
+// MinimockUpsertRatesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpsertRatesInspect() {
+	for _, e := range m.UpsertRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpsertRates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpsertRatesCounter := mm_atomic.LoadUint64(&m.afterUpsertRatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertRatesMock.defaultExpectation != nil && afterUpsertRatesCounter < 1 {
+		if m.UpsertRatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpsertRates at\n%s", m.UpsertRatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpsertRates at\n%s with params: %#v", m.UpsertRatesMock.defaultExpectation.expectationOrigins.origin, *m.UpsertRatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsertRates != nil && afterUpsertRatesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpsertRates at\n%s", m.funcUpsertRatesOrigin)
+	}
+
+	if !m.UpsertRatesMock.invocationsDone() && afterUpsertRatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpsertRates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertRatesMock.expectedInvocations), m.UpsertRatesMock.expectedInvocationsOrigin, afterUpsertRatesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetRateInspect()
+
+			m.MinimockUpsertRatesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetRateDone() &&
+		m.MinimockUpsertRatesDone()
+}
@@ -0,0 +1,385 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/currency.UseCase -o usecase_mock.go -n UseCaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UseCaseMock implements mm_currency.UseCase
+type UseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRefreshRates          func(ctx context.Context) (err error)
+	funcRefreshRatesOrigin    string
+	inspectFuncRefreshRates   func(ctx context.Context)
+	afterRefreshRatesCounter  uint64
+	beforeRefreshRatesCounter uint64
+	RefreshRatesMock          mUseCaseMockRefreshRates
+}
+
+// NewUseCaseMock returns a mock for mm_currency.UseCase
+func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
+	m := &UseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RefreshRatesMock = mUseCaseMockRefreshRates{mock: m}
+	m.RefreshRatesMock.callArgs = []*UseCaseMockRefreshRatesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUseCaseMockRefreshRates struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockRefreshRatesExpectation
+	expectations       []*UseCaseMockRefreshRatesExpectation
+
+	callArgs []*UseCaseMockRefreshRatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockRefreshRatesExpectation specifies expectation struct of the UseCase.RefreshRates
+type UseCaseMockRefreshRatesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockRefreshRatesParams
+	paramPtrs          *UseCaseMockRefreshRatesParamPtrs
+	expectationOrigins UseCaseMockRefreshRatesExpectationOrigins
+	results            *UseCaseMockRefreshRatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockRefreshRatesParams contains parameters of the UseCase.RefreshRates
+type UseCaseMockRefreshRatesParams struct {
+	ctx context.Context
+}
+
+// UseCaseMockRefreshRatesParamPtrs contains pointers to parameters of the UseCase.RefreshRates
+type UseCaseMockRefreshRatesParamPtrs struct {
+	ctx *context.Context
+}
+
+// UseCaseMockRefreshRatesResults contains results of the UseCase.RefreshRates
+type UseCaseMockRefreshRatesResults struct {
+	err error
+}
+
+// UseCaseMockRefreshRatesOrigins contains origins of expectations of the UseCase.RefreshRates
+type UseCaseMockRefreshRatesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefreshRates *mUseCaseMockRefreshRates) Optional() *mUseCaseMockRefreshRates {
+	mmRefreshRates.optional = true
+	return mmRefreshRates
+}
+
+// Expect sets up expected params for UseCase.RefreshRates
+func (mmRefreshRates *mUseCaseMockRefreshRates) Expect(ctx context.Context) *mUseCaseMockRefreshRates {
+	if mmRefreshRates.mock.funcRefreshRates != nil {
+		mmRefreshRates.mock.t.Fatalf("UseCaseMock.RefreshRates mock is already set by Set")
+	}
+
+	if mmRefreshRates.defaultExpectation == nil {
+		mmRefreshRates.defaultExpectation = &UseCaseMockRefreshRatesExpectation{}
+	}
+
+	if mmRefreshRates.defaultExpectation.paramPtrs != nil {
+		mmRefreshRates.mock.t.Fatalf("UseCaseMock.RefreshRates mock is already set by ExpectParams functions")
+	}
+
+	mmRefreshRates.defaultExpectation.params = &UseCaseMockRefreshRatesParams{ctx}
+	mmRefreshRates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRefreshRates.expectations {
+		if minimock.Equal(e.params, mmRefreshRates.defaultExpectation.params) {
+			mmRefreshRates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshRates.defaultExpectation.params)
+		}
+	}
+
+	return mmRefreshRates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.RefreshRates
+func (mmRefreshRates *mUseCaseMockRefreshRates) ExpectCtxParam1(ctx context.Context) *mUseCaseMockRefreshRates {
+	if mmRefreshRates.mock.funcRefreshRates != nil {
+		mmRefreshRates.mock.t.Fatalf("UseCaseMock.RefreshRates mock is already set by Set")
+	}
+
+	if mmRefreshRates.defaultExpectation == nil {
+		mmRefreshRates.defaultExpectation = &UseCaseMockRefreshRatesExpectation{}
+	}
+
+	if mmRefreshRates.defaultExpectation.params != nil {
+		mmRefreshRates.mock.t.Fatalf("UseCaseMock.RefreshRates mock is already set by Expect")
+	}
+
+	if mmRefreshRates.defaultExpectation.paramPtrs == nil {
+		mmRefreshRates.defaultExpectation.paramPtrs = &UseCaseMockRefreshRatesParamPtrs{}
+	}
+	mmRefreshRates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRefreshRates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRefreshRates
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.RefreshRates
+func (mmRefreshRates *mUseCaseMockRefreshRates) Inspect(f func(ctx context.Context)) *mUseCaseMockRefreshRates {
+	if mmRefreshRates.mock.inspectFuncRefreshRates != nil {
+		mmRefreshRates.mock.t.Fatalf("Inspect function is already set for UseCaseMock.RefreshRates")
+	}
+
+	mmRefreshRates.mock.inspectFuncRefreshRates = f
+
+	return mmRefreshRates
+}
+
+// Return sets up results that will be returned by UseCase.RefreshRates
+func (mmRefreshRates *mUseCaseMockRefreshRates) Return(err error) *UseCaseMock {
+	if mmRefreshRates.mock.funcRefreshRates != nil {
+		mmRefreshRates.mock.t.Fatalf("UseCaseMock.RefreshRates mock is already set by Set")
+	}
+
+	if mmRefreshRates.defaultExpectation == nil {
+		mmRefreshRates.defaultExpectation = &UseCaseMockRefreshRatesExpectation{mock: mmRefreshRates.mock}
+	}
+	mmRefreshRates.defaultExpectation.results = &UseCaseMockRefreshRatesResults{err}
+	mmRefreshRates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefreshRates.mock
+}
+
+// Set uses given function f to mock the UseCase.RefreshRates method
+func (mmRefreshRates *mUseCaseMockRefreshRates) Set(f func(ctx context.Context) (err error)) *UseCaseMock {
+	if mmRefreshRates.defaultExpectation != nil {
+		mmRefreshRates.mock.t.Fatalf("Default expectation is already set for the UseCase.RefreshRates method")
+	}
+
+	if len(mmRefreshRates.expectations) > 0 {
+		mmRefreshRates.mock.t.Fatalf("Some expectations are already set for the UseCase.RefreshRates method")
+	}
+
+	mmRefreshRates.mock.funcRefreshRates = f
+	mmRefreshRates.mock.funcRefreshRatesOrigin = minimock.CallerInfo(1)
+	return mmRefreshRates.mock
+}
+
+// When sets expectation for the UseCase.RefreshRates which will trigger the result defined by the following
+// Then helper
+func (mmRefreshRates *mUseCaseMockRefreshRates) When(ctx context.Context) *UseCaseMockRefreshRatesExpectation {
+	if mmRefreshRates.mock.funcRefreshRates != nil {
+		mmRefreshRates.mock.t.Fatalf("UseCaseMock.RefreshRates mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockRefreshRatesExpectation{
+		mock:               mmRefreshRates.mock,
+		params:             &UseCaseMockRefreshRatesParams{ctx},
+		expectationOrigins: UseCaseMockRefreshRatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRefreshRates.expectations = append(mmRefreshRates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.RefreshRates return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockRefreshRatesExpectation) Then(err error) *UseCaseMock {
+	e.results = &UseCaseMockRefreshRatesResults{err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.RefreshRates should be invoked
+func (mmRefreshRates *mUseCaseMockRefreshRates) Times(n uint64) *mUseCaseMockRefreshRates {
+	if n == 0 {
+		mmRefreshRates.mock.t.Fatalf("Times of UseCaseMock.RefreshRates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRefreshRates.expectedInvocations, n)
+	mmRefreshRates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefreshRates
+}
+
+func (mmRefreshRates *mUseCaseMockRefreshRates) invocationsDone() bool {
+	if len(mmRefreshRates.expectations) == 0 && mmRefreshRates.defaultExpectation == nil && mmRefreshRates.mock.funcRefreshRates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshRates.mock.afterRefreshRatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshRates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RefreshRates implements mm_currency.UseCase
+func (mmRefreshRates *UseCaseMock) RefreshRates(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmRefreshRates.beforeRefreshRatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshRates.afterRefreshRatesCounter, 1)
+
+	mmRefreshRates.t.Helper()
+
+	if mmRefreshRates.inspectFuncRefreshRates != nil {
+		mmRefreshRates.inspectFuncRefreshRates(ctx)
+	}
+
+	mm_params := UseCaseMockRefreshRatesParams{ctx}
+
+	// Record call args
+	mmRefreshRates.RefreshRatesMock.mutex.Lock()
+	mmRefreshRates.RefreshRatesMock.callArgs = append(mmRefreshRates.RefreshRatesMock.callArgs, &mm_params)
+	mmRefreshRates.RefreshRatesMock.mutex.Unlock()
+
+	for _, e := range mmRefreshRates.RefreshRatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRefreshRates.RefreshRatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshRates.RefreshRatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshRates.RefreshRatesMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshRates.RefreshRatesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockRefreshRatesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRefreshRates.t.Errorf("UseCaseMock.RefreshRates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshRates.RefreshRatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRefreshRates.t.Errorf("UseCaseMock.RefreshRates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRefreshRates.RefreshRatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRefreshRates.RefreshRatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefreshRates.t.Fatal("No results are set for the UseCaseMock.RefreshRates")
+		}
+		return (*mm_results).err
+	}
+	if mmRefreshRates.funcRefreshRates != nil {
+		return mmRefreshRates.funcRefreshRates(ctx)
+	}
+	mmRefreshRates.t.Fatalf("Unexpected call to UseCaseMock.RefreshRates. %v", ctx)
+	return
+}
+
+// RefreshRatesAfterCounter returns a count of finished UseCaseMock.RefreshRates invocations
+func (mmRefreshRates *UseCaseMock) RefreshRatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshRates.afterRefreshRatesCounter)
+}
+
+// RefreshRatesBeforeCounter returns a count of UseCaseMock.RefreshRates invocations
+func (mmRefreshRates *UseCaseMock) RefreshRatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshRates.beforeRefreshRatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.RefreshRates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRefreshRates *mUseCaseMockRefreshRates) Calls() []*UseCaseMockRefreshRatesParams {
+	mmRefreshRates.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockRefreshRatesParams, len(mmRefreshRates.callArgs))
+	copy(argCopy, mmRefreshRates.callArgs)
+
+	mmRefreshRates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRefreshRatesDone returns true if the count of the RefreshRates invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockRefreshRatesDone() bool {
+	if m.RefreshRatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RefreshRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RefreshRatesMock.invocationsDone()
+}
+
+// MinimockRefreshRatesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockRefreshRatesInspect() {
+	for _, e := range m.RefreshRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshRates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRefreshRatesCounter := mm_atomic.LoadUint64(&m.afterRefreshRatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefreshRatesMock.defaultExpectation != nil && afterRefreshRatesCounter < 1 {
+		if m.RefreshRatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshRates at\n%s", m.RefreshRatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshRates at\n%s with params: %#v", m.RefreshRatesMock.defaultExpectation.expectationOrigins.origin, *m.RefreshRatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefreshRates != nil && afterRefreshRatesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.RefreshRates at\n%s", m.funcRefreshRatesOrigin)
+	}
+
+	if !m.RefreshRatesMock.invocationsDone() && afterRefreshRatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.RefreshRates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshRatesMock.expectedInvocations), m.RefreshRatesMock.expectedInvocationsOrigin, afterRefreshRatesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRefreshRatesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRefreshRatesDone()
+}
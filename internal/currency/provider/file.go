@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// FileProvider читает курсы из JSON-файла при каждом обновлении, что позволяет менять их без перезапуска.
+// Формат файла: {"base": "RUB", "rates": {"USD": 90.5, "EUR": 98.1}}
+type FileProvider struct {
+	path         string
+	baseCurrency string
+}
+
+// NewFileProvider создает поставщика курсов из файла. Базовая валюта файла должна совпадать с baseCurrency
+func NewFileProvider(path, baseCurrency string) *FileProvider {
+	return &FileProvider{
+		path:         path,
+		baseCurrency: baseCurrency,
+	}
+}
+
+// FetchRates читает и разбирает файл курсов
+func (p *FileProvider) FetchRates(_ context.Context) (map[string]float64, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать файл курсов %s: %w", p.path, err)
+	}
+
+	var file struct {
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("не удалось разобрать файл курсов %s: %w", p.path, err)
+	}
+
+	if file.Base != p.baseCurrency {
+		return nil, fmt.Errorf("базовая валюта файла курсов %s не совпадает с %s", file.Base, p.baseCurrency)
+	}
+
+	return file.Rates, nil
+}
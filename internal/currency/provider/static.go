@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"context"
+	"maps"
+)
+
+// StaticProvider возвращает курсы, заданные в конфигурации
+type StaticProvider struct {
+	rates map[string]float64
+}
+
+// NewStaticProvider создает поставщика с фиксированными курсами
+func NewStaticProvider(rates map[string]float64) *StaticProvider {
+	return &StaticProvider{rates: maps.Clone(rates)}
+}
+
+// FetchRates возвращает копию заданных курсов
+func (p *StaticProvider) FetchRates(_ context.Context) (map[string]float64, error) {
+	return maps.Clone(p.rates), nil
+}
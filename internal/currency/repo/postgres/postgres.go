@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type dbManager interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	GetPool() *pgxpool.Pool
+}
+
+// Repository реализует интерфейс currency.Repository
+type Repository struct {
+	db     dbManager
+	logger *logger.Logger
+}
+
+// New создает новый экземпляр репозитория
+func New(db dbManager, logger *logger.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetRate возвращает курс валюты
+func (r *Repository) GetRate(ctx context.Context, currency string) (*entity.ExchangeRate, error) {
+	query := `
+		SELECT currency, rate::float8, updated_at
+		FROM exchange_rates
+		WHERE currency = $1`
+
+	rate := &entity.ExchangeRate{}
+	err := r.db.QueryRow(ctx, query, currency).Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrCurrencyNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при получении курса валюты",
+			zap.String("currency", currency),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении курса валюты")
+	}
+
+	return rate, nil
+}
+
+// UpsertRates сохраняет курсы валют одним запросом, заменяя предыдущие значения
+func (r *Repository) UpsertRates(ctx context.Context, rates []*entity.ExchangeRate) error {
+	currencies := make([]string, 0, len(rates))
+	values := make([]float64, 0, len(rates))
+	for _, rate := range rates {
+		currencies = append(currencies, rate.Currency)
+		values = append(values, rate.Rate)
+	}
+
+	query := `
+		INSERT INTO exchange_rates (currency, rate, updated_at)
+		SELECT currency, rate, NOW()
+		FROM unnest($1::text[], $2::numeric[]) AS r(currency, rate)
+		ON CONFLICT (currency) DO UPDATE
+		SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at`
+
+	if _, err := r.db.Exec(ctx, query, currencies, values); err != nil {
+		r.logger.Error(ctx, "Ошибка при сохранении курсов валют", zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при сохранении курсов валют")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/currency"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// currencyCodePattern проверяет код валюты в формате ISO 4217
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// UseCase реализует интерфейс currency.UseCase
+type UseCase struct {
+	repo         currency.Repository
+	provider     currency.RateProvider
+	baseCurrency string
+	log          *logger.Logger
+}
+
+// New создает новый экземпляр UseCase. Курсы поставщика задаются относительно baseCurrency
+func New(repo currency.Repository, provider currency.RateProvider, baseCurrency string, log *logger.Logger) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		provider:     provider,
+		baseCurrency: baseCurrency,
+		log:          log,
+	}
+}
+
+// RefreshRates загружает курсы у поставщика и сохраняет их.
+// Курс базовой валюты всегда равен 1, некорректные курсы пропускаются
+func (uc *UseCase) RefreshRates(ctx context.Context) error {
+	fetched, err := uc.provider.FetchRates(ctx)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении курсов валют у поставщика", zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при получении курсов валют")
+	}
+
+	rates := []*entity.ExchangeRate{{Currency: uc.baseCurrency, Rate: 1}}
+	for code, rate := range fetched {
+		if code == uc.baseCurrency {
+			continue
+		}
+		if !currencyCodePattern.MatchString(code) || rate <= 0 {
+			uc.log.Warn(ctx, "Пропущен некорректный курс валюты",
+				zap.String("currency", code),
+				zap.Float64("rate", rate))
+			continue
+		}
+		rates = append(rates, &entity.ExchangeRate{Currency: code, Rate: rate})
+	}
+
+	// Порядок строк в запросе не зависит от порядка обхода словаря
+	slices.SortFunc(rates, func(a, b *entity.ExchangeRate) int {
+		return strings.Compare(a.Currency, b.Currency)
+	})
+
+	if err := uc.repo.UpsertRates(ctx, rates); err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, "Курсы валют обновлены", zap.Int("count", len(rates)))
+	return nil
+}
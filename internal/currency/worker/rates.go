@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/currency"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// RatesRefresher периодически обновляет курсы валют
+type RatesRefresher struct {
+	currencyUC currency.UseCase
+	interval   time.Duration
+	log        *logger.Logger
+}
+
+// NewRatesRefresher создает новый экземпляр RatesRefresher
+func NewRatesRefresher(currencyUC currency.UseCase, interval time.Duration, log *logger.Logger) *RatesRefresher {
+	return &RatesRefresher{
+		currencyUC: currencyUC,
+		interval:   interval,
+		log:        log,
+	}
+}
+
+// Run обновляет курсы валют и блокируется до отмены контекста
+func (r *RatesRefresher) Run(ctx context.Context) {
+	r.log.Info(ctx, "Обновление курсов валют запущено", zap.Duration("interval", r.interval))
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			r.log.Info(ctx, "Обновление курсов валют остановлено")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *RatesRefresher) refresh(ctx context.Context) {
+	if err := r.currencyUC.RefreshRates(ctx); err != nil && ctx.Err() == nil {
+		r.log.Error(ctx, "Ошибка при обновлении курсов валют", zap.Error(err))
+	}
+}
@@ -4,6 +4,14 @@ import (
 	"time"
 )
 
+// UserRole представляет роль пользователя
+type UserRole string
+
+const (
+	// RoleUser — роль зарегистрированного пользователя
+	RoleUser UserRole = "user"
+)
+
 // User представляет модель пользователя
 type User struct {
 	ID        uint64    `json:"id"`
@@ -14,9 +22,22 @@ type User struct {
 
 // UserResponse представляет модель пользователя без чувствительных данных
 type UserResponse struct {
-	ID        uint64    `json:"id"`
-	Username  string    `json:"username"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        uint64     `json:"id"`
+	Username  string     `json:"username"`
+	CreatedAt time.Time  `json:"created_at"`
+	Roles     []UserRole `json:"roles"`
+}
+
+// TokenClaims содержит проверенные данные токена авторизации
+type TokenClaims struct {
+	UserID    uint64    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Roles возвращает роли пользователя. Отдельных ролей пока нет,
+// поэтому каждый зарегистрированный пользователь имеет роль user
+func (u *User) Roles() []UserRole {
+	return []UserRole{RoleUser}
 }
 
 // ToResponse преобразует User в UserResponse
@@ -25,5 +46,26 @@ func (u *User) ToResponse() *UserResponse {
 		ID:        u.ID,
 		Username:  u.Username,
 		CreatedAt: u.CreatedAt,
+		Roles:     u.Roles(),
 	}
 }
+
+// RefreshToken представляет сохраненный refresh-токен. Сам токен не хранится, только его хеш
+type RefreshToken struct {
+	ID        uint64     `json:"id"`
+	UserID    uint64     `json:"user_id"`
+	FamilyID  string     `json:"family_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// AuthTokens содержит пару токенов, выдаваемую при авторизации и обновлении
+type AuthTokens struct {
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
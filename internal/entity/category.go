@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+)
+
+// Category представляет категорию объявлений
+type Category struct {
+	ID        uint64    `json:"id"`
+	ParentID  *uint64   `json:"parent_id,omitempty"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CategoryNode представляет узел дерева категорий
+type CategoryNode struct {
+	Category *Category       `json:"category"`
+	Children []*CategoryNode `json:"children,omitempty"`
+}
+
+// AttributeType представляет тип значения атрибута категории
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	AttributeTypeNumber AttributeType = "number"
+	AttributeTypeEnum   AttributeType = "enum"
+	AttributeTypeBool   AttributeType = "bool"
+)
+
+// CategoryAttribute описывает атрибут объявлений категории и правила проверки его значения.
+// Атрибуты категории наследуются всеми ее подкатегориями
+type CategoryAttribute struct {
+	ID         uint64        `json:"id"`
+	CategoryID uint64        `json:"category_id"`
+	Key        string        `json:"key"`
+	Name       string        `json:"name"`
+	Type       AttributeType `json:"type"`
+	Required   bool          `json:"required"`
+	MinValue   *float64      `json:"min_value,omitempty"`
+	MaxValue   *float64      `json:"max_value,omitempty"`
+	MaxLength  *int32        `json:"max_length,omitempty"`
+	Options    []string      `json:"options,omitempty"`
+	Position   int32         `json:"position"`
+}
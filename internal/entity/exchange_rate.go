@@ -0,0 +1,12 @@
+package entity
+
+import (
+	"time"
+)
+
+// ExchangeRate представляет курс валюты как стоимость ее единицы в базовой валюте
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
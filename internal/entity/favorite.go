@@ -0,0 +1,17 @@
+package entity
+
+// FavoriteState описывает объявление в избранном пользователя после добавления или удаления
+type FavoriteState struct {
+	ListingID      uint64 `json:"listing_id"`
+	IsFavorite     bool   `json:"is_favorite"`
+	FavoritesCount uint32 `json:"favorites_count"`
+
+	// AlertPrice задает порог цены, при достижении которого пользователь получает уведомление
+	AlertPrice *Money `json:"alert_price,omitempty"`
+}
+
+// PriceWatcher представляет пользователя, добавившего объявление в избранное и ожидающего снижения цены
+type PriceWatcher struct {
+	UserID     uint64 `json:"user_id"`
+	AlertPrice *Money `json:"alert_price,omitempty"`
+}
@@ -4,36 +4,246 @@ import (
 	"time"
 )
 
+// ListingStatus представляет статус объявления
+type ListingStatus string
+
+const (
+	ListingStatusDraft    ListingStatus = "draft"
+	ListingStatusActive   ListingStatus = "active"
+	ListingStatusReserved ListingStatus = "reserved"
+	ListingStatusSold     ListingStatus = "sold"
+	ListingStatusArchived ListingStatus = "archived"
+)
+
 // Listing представляет модель объявления
 type Listing struct {
-	ID             uint64    `json:"id"`
-	Title          string    `json:"title"`
-	Description    string    `json:"description"`
-	ImageURL       string    `json:"image_url"`
-	Price          float32   `json:"price"`
-	AuthorID       uint64    `json:"author_id"`
-	AuthorUsername string    `json:"author_username"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             uint64        `json:"id"`
+	Title          string        `json:"title"`
+	Description    string        `json:"description"`
+	ImageURL       string        `json:"image_url"`
+	Price          Money         `json:"price"`
+	Status         ListingStatus `json:"status"`
+	CategoryID     uint64        `json:"category_id"`
+	AuthorID       uint64        `json:"author_id"`
+	AuthorUsername string        `json:"author_username"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+	Version        uint64        `json:"version"`
+	DeletedAt      *time.Time    `json:"deleted_at,omitempty"`
+
+	// Attributes содержит значения атрибутов категории: строки, числа (float64) и логические значения
+	Attributes map[string]any `json:"attributes"`
+
+	// Images содержит галерею объявления, заполняется только при получении одного объявления.
+	// ImageURL хранит обложку — первое изображение галереи
+	Images []*ListingImage `json:"images,omitempty"`
+
+	// CoverVariants содержит уменьшенные копии обложки, если она загружена через API загрузок
+	CoverVariants []*ImageVariant `json:"cover_variants,omitempty"`
+
+	// FavoritesCount содержит количество пользователей, добавивших объявление в избранное
+	FavoritesCount uint32 `json:"favorites_count"`
+
+	// IsFavorite показывает, добавил ли объявление в избранное текущий пользователь
+	IsFavorite bool `json:"is_favorite"`
+
+	// DisplayPrice содержит цену в валюте отображения, заполняется при конвертации ленты
+	DisplayPrice *Money `json:"display_price,omitempty"`
+
+	// Highlight заполняется при полнотекстовом поиске
+	Highlight *ListingHighlight `json:"highlight,omitempty"`
+}
+
+// ListingImage представляет изображение из галереи объявления
+type ListingImage struct {
+	ID        uint64    `json:"id"`
+	ListingID uint64    `json:"listing_id"`
+	URL       string    `json:"url"`
+	Position  uint32    `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Variants содержит уменьшенные копии изображения, если оно загружено через API загрузок
+	Variants []*ImageVariant `json:"variants,omitempty"`
+}
+
+// NewListingImages создает галерею из ссылок на изображения в порядке отображения
+func NewListingImages(urls []string) []*ListingImage {
+	images := make([]*ListingImage, 0, len(urls))
+	for i, url := range urls {
+		images = append(images, &ListingImage{URL: url, Position: uint32(i)})
+	}
+	return images
+}
+
+// ListingHighlight содержит фрагменты объявления с выделенными совпадениями поискового запроса
+type ListingHighlight struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// ListingUpdate представляет частичное обновление объявления.
+// Nil-поля не изменяются
+type ListingUpdate struct {
+	ID          uint64         `json:"id"`
+	Version     uint64         `json:"version"`
+	Title       *string        `json:"title,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	ImageURL    *string        `json:"image_url,omitempty"`
+	Price       *Money         `json:"price,omitempty"`
+	CategoryID  *uint64        `json:"category_id,omitempty"`
+	Attributes  map[string]any `json:"attributes,omitempty"`
+}
+
+// IsEmpty проверяет, что обновление не содержит изменяемых полей
+func (u *ListingUpdate) IsEmpty() bool {
+	return u.Title == nil && u.Description == nil && u.ImageURL == nil && u.Price == nil && u.CategoryID == nil && u.Attributes == nil
 }
 
 // ListingFilter представляет фильтр для поиска объявлений
 type ListingFilter struct {
-	Page     uint32   `json:"page"`
-	PerPage  uint32   `json:"per_page"`
-	SortBy   string   `json:"sort_by"`
-	SortDesc bool     `json:"sort_desc"`
-	MinPrice *float32 `json:"min_price,omitempty"`
-	MaxPrice *float32 `json:"max_price,omitempty"`
+	Page            uint32                    `json:"page"`
+	PerPage         uint32                    `json:"per_page"`
+	SortBy          string                    `json:"sort_by"`
+	SortDesc        bool                      `json:"sort_desc"`
+	MinPrice        *Money                    `json:"min_price,omitempty"`
+	MaxPrice        *Money                    `json:"max_price,omitempty"`
+	Status          ListingStatus             `json:"status"`
+	Query           string                    `json:"query,omitempty"`
+	CategoryID      *uint64                   `json:"category_id,omitempty"`
+	Attributes      map[string]string         `json:"attributes,omitempty"`
+	AttributeRanges map[string]AttributeRange `json:"attribute_ranges,omitempty"`
+	Cursor          *ListingCursor            `json:"cursor,omitempty"`
+	TotalMode       TotalMode                 `json:"total_mode"`
+
+	// DisplayCurrency задает валюту, в которую конвертируются цены для отображения, фильтрации и сортировки
+	DisplayCurrency string `json:"display_currency,omitempty"`
+
+	// ViewerID задает пользователя, для которого отмечаются избранные объявления, 0 для анонимных запросов
+	ViewerID uint64 `json:"viewer_id,omitempty"`
+
+	// PublishedAfter и PublishedUntil ограничивают выборку объявлениями, впервые ставшими активными
+	// в промежутке (PublishedAfter, PublishedUntil], нулевое время — без ограничения
+	PublishedAfter time.Time `json:"published_after,omitempty"`
+	PublishedUntil time.Time `json:"published_until,omitempty"`
+	// AuthorID ограничивает выборку объявлениями автора, 0 — без ограничения
+	AuthorID uint64 `json:"author_id,omitempty"`
+	// ExcludeAuthorID исключает из выборки объявления автора, 0 — без ограничения
+	ExcludeAuthorID uint64 `json:"exclude_author_id,omitempty"`
+}
+
+// TotalMode определяет способ подсчета общего количества объявлений в ленте
+type TotalMode string
+
+const (
+	TotalModeExact     TotalMode = "exact"
+	TotalModeEstimated TotalMode = "estimated"
+	TotalModeNone      TotalMode = "none"
+)
+
+// ListingCursor указывает позицию в ленте, после которой продолжается выборка.
+// Позиция задается значением поля сортировки и ID объявления
+type ListingCursor struct {
+	SortBy    string    `json:"sort_by"`
+	SortDesc  bool      `json:"sort_desc"`
+	CreatedAt time.Time `json:"created_at"`
+	Price     int64     `json:"price,omitempty"`
+	Currency  string    `json:"currency,omitempty"`
+	ID        uint64    `json:"id"`
+}
+
+// NewListingCursor создает курсор, указывающий на позицию сразу после объявления
+// При конвертации позиция задается ценой в валюте отображения
+func NewListingCursor(listing *Listing, sortBy string, sortDesc bool) *ListingCursor {
+	cursor := &ListingCursor{
+		SortBy:    sortBy,
+		SortDesc:  sortDesc,
+		CreatedAt: listing.CreatedAt,
+		Price:     listing.Price.Amount,
+		ID:        listing.ID,
+	}
+	if listing.DisplayPrice != nil {
+		cursor.Price = listing.DisplayPrice.Amount
+		cursor.Currency = listing.DisplayPrice.Currency
+	}
+	return cursor
+}
+
+// ListingPage представляет страницу ленты объявлений
+type ListingPage struct {
+	Listings []*Listing `json:"listings"`
+	Total    uint32     `json:"total"`
+	// NextCursor указывает на начало следующей страницы, nil на последней странице
+	NextCursor *ListingCursor `json:"next_cursor,omitempty"`
+}
+
+// AttributeRange задает границы значения числового атрибута
+type AttributeRange struct {
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+// ListingFacets содержит количество объявлений в разрезе категорий, статусов и диапазонов цен
+type ListingFacets struct {
+	Total          uint32           `json:"total"`
+	Categories     []*CategoryFacet `json:"categories"`
+	Statuses       []*StatusFacet   `json:"statuses"`
+	PriceHistogram []*PriceBucket   `json:"price_histogram"`
+}
+
+// CategoryFacet содержит количество объявлений в категории
+type CategoryFacet struct {
+	CategoryID uint64 `json:"category_id"`
+	Name       string `json:"name"`
+	Count      uint32 `json:"count"`
+}
+
+// StatusFacet содержит количество объявлений в статусе
+type StatusFacet struct {
+	Status ListingStatus `json:"status"`
+	Count  uint32        `json:"count"`
+}
+
+// PriceBucket содержит количество объявлений в диапазоне цен [From, To)
+type PriceBucket struct {
+	From  *Money `json:"from,omitempty"`
+	To    *Money `json:"to,omitempty"`
+	Count uint32 `json:"count"`
+}
+
+// ListingSearchFilter представляет параметры нечеткого поиска объявлений
+type ListingSearchFilter struct {
+	Query               string        `json:"query"`
+	Page                uint32        `json:"page"`
+	PerPage             uint32        `json:"per_page"`
+	Status              ListingStatus `json:"status"`
+	SimilarityThreshold float64       `json:"similarity_threshold"`
+}
+
+// ListingSearchResult представляет найденное объявление со степенью сходства с запросом
+type ListingSearchResult struct {
+	Listing    *Listing `json:"listing"`
+	Similarity float32  `json:"similarity"`
+}
+
+// Suggestion представляет подсказку для строки поиска
+type Suggestion struct {
+	Text          string `json:"text"`
+	ListingsCount uint32 `json:"listings_count"`
 }
 
 // NewListing создает новое объявление
-func NewListing(title, description, imageURL string, price float32, authorID uint64) *Listing {
+func NewListing(title, description, imageURL string, price Money, status ListingStatus, categoryID, authorID uint64) *Listing {
+	now := time.Now()
 	return &Listing{
 		Title:       title,
 		Description: description,
 		ImageURL:    imageURL,
 		Price:       price,
+		Status:      status,
+		CategoryID:  categoryID,
 		AuthorID:    authorID,
-		CreatedAt:   time.Now(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Version:     1,
 	}
 }
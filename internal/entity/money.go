@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+)
+
+// MoneyScale количество минимальных единиц валюты (копеек, центов) в одной основной
+const MoneyScale = 100
+
+// Money представляет денежную сумму в минимальных единицах валюты
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewMoneyFromFloat создает сумму из значения в основных единицах валюты с округлением до минимальных
+func NewMoneyFromFloat(value float64, currency string) Money {
+	return Money{
+		Amount:   int64(math.Round(value * MoneyScale)),
+		Currency: currency,
+	}
+}
+
+// String форматирует сумму в основных единицах валюты, например "75000.50 RUB"
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/MoneyScale, amount%MoneyScale, m.Currency)
+}
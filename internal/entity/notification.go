@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+)
+
+// NotificationType представляет тип уведомления
+type NotificationType string
+
+const (
+	// NotificationTypePriceDrop отправляется, когда цена объявления из избранного снизилась
+	NotificationTypePriceDrop NotificationType = "price_drop"
+	// NotificationTypePriceThreshold отправляется, когда цена объявления из избранного опустилась до порога пользователя
+	NotificationTypePriceThreshold NotificationType = "price_threshold"
+	// NotificationTypeSavedSearch отправляется, когда появились новые объявления, подходящие под сохраненный поиск
+	NotificationTypeSavedSearch NotificationType = "saved_search"
+)
+
+// Notification представляет уведомление пользователя
+type Notification struct {
+	ID        uint64           `json:"id"`
+	UserID    uint64           `json:"user_id"`
+	Type      NotificationType `json:"type"`
+	ListingID *uint64          `json:"listing_id,omitempty"`
+	Title     string           `json:"title"`
+	Message   string           `json:"message"`
+	CreatedAt time.Time        `json:"created_at"`
+
+	// Data содержит подробности уведомления, зависящие от типа, например старую и новую цену
+	Data map[string]any `json:"data"`
+}
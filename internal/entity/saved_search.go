@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"time"
+)
+
+// SearchFrequency определяет, как часто пользователь получает уведомления о новых объявлениях сохраненного поиска
+type SearchFrequency string
+
+const (
+	// SearchFrequencyInstant уведомляет о новых объявлениях при каждой проверке
+	SearchFrequencyInstant SearchFrequency = "instant"
+	// SearchFrequencyDaily собирает новые объявления в ежедневную сводку
+	SearchFrequencyDaily SearchFrequency = "daily"
+)
+
+// SavedSearchFilter содержит сохраненные условия ленты объявлений
+type SavedSearchFilter struct {
+	Query           string                    `json:"query,omitempty"`
+	CategoryID      *uint64                   `json:"category_id,omitempty"`
+	MinPrice        *Money                    `json:"min_price,omitempty"`
+	MaxPrice        *Money                    `json:"max_price,omitempty"`
+	Attributes      map[string]string         `json:"attributes,omitempty"`
+	AttributeRanges map[string]AttributeRange `json:"attribute_ranges,omitempty"`
+	SortBy          string                    `json:"sort_by,omitempty"`
+	SortDesc        bool                      `json:"sort_desc,omitempty"`
+	DisplayCurrency string                    `json:"display_currency,omitempty"`
+}
+
+// ListingFilter создает фильтр ленты активных объявлений по сохраненным условиям
+func (f *SavedSearchFilter) ListingFilter() *ListingFilter {
+	return &ListingFilter{
+		SortBy:          f.SortBy,
+		SortDesc:        f.SortDesc,
+		MinPrice:        f.MinPrice,
+		MaxPrice:        f.MaxPrice,
+		Status:          ListingStatusActive,
+		Query:           f.Query,
+		CategoryID:      f.CategoryID,
+		Attributes:      f.Attributes,
+		AttributeRanges: f.AttributeRanges,
+		DisplayCurrency: f.DisplayCurrency,
+	}
+}
+
+// SavedSearch представляет сохраненный поиск пользователя
+type SavedSearch struct {
+	ID        uint64            `json:"id"`
+	UserID    uint64            `json:"user_id"`
+	Name      string            `json:"name"`
+	Filter    SavedSearchFilter `json:"filter"`
+	Frequency SearchFrequency   `json:"frequency"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+
+	// LastPublishedAt содержит время публикации, до которого объявления уже проверены на соответствие поиску
+	LastPublishedAt time.Time `json:"last_published_at"`
+	// CheckedAt содержит время последней проверки новых объявлений
+	CheckedAt time.Time `json:"checked_at"`
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+)
+
+// VariantsStatus представляет состояние обработки уменьшенных копий загруженного изображения
+type VariantsStatus string
+
+const (
+	VariantsStatusPending    VariantsStatus = "pending"
+	VariantsStatusProcessing VariantsStatus = "processing"
+	VariantsStatusReady      VariantsStatus = "ready"
+	VariantsStatusFailed     VariantsStatus = "failed"
+)
+
+// Upload представляет файл, загруженный пользователем в хранилище маркетплейса
+type Upload struct {
+	ID             uint64         `json:"id"`
+	AuthorID       uint64         `json:"author_id"`
+	StorageKey     string         `json:"storage_key"`
+	URL            string         `json:"url"`
+	ContentType    string         `json:"content_type"`
+	Size           int64          `json:"size"`
+	VariantsStatus VariantsStatus `json:"variants_status"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// ImageVariant представляет уменьшенную копию загруженного изображения, например миниатюру для ленты
+type ImageVariant struct {
+	Name        string `json:"name"`
+	StorageKey  string `json:"storage_key"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Width       uint32 `json:"width"`
+	Height      uint32 `json:"height"`
+	Size        int64  `json:"size"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+)
+
+// UserProfile представляет публичный профиль пользователя маркетплейса
+type UserProfile struct {
+	ID                  uint64    `json:"id"`
+	Username            string    `json:"username"`
+	DisplayName         string    `json:"display_name"`
+	AvatarURL           string    `json:"avatar_url"`
+	Bio                 string    `json:"bio"`
+	City                string    `json:"city"`
+	CreatedAt           time.Time `json:"created_at"`
+	ActiveListingsCount uint32    `json:"active_listings_count"`
+	Rating              float64   `json:"rating"`
+	RatingsCount        uint32    `json:"ratings_count"`
+}
+
+// ProfileUpdate представляет частичное обновление профиля пользователя.
+// nil означает, что поле не изменяется, пустая строка очищает поле
+type ProfileUpdate struct {
+	DisplayName *string `json:"display_name,omitempty"`
+	AvatarURL   *string `json:"avatar_url,omitempty"`
+	Bio         *string `json:"bio,omitempty"`
+	City        *string `json:"city,omitempty"`
+}
+
+// IsEmpty сообщает, что обновление не затрагивает ни одного поля
+func (u *ProfileUpdate) IsEmpty() bool {
+	return u.DisplayName == nil && u.AvatarURL == nil && u.Bio == nil && u.City == nil
+}
@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
 	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/listing"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
@@ -36,89 +38,423 @@ func (h *Handler) CreateListing(ctx context.Context, req *listings_pb.CreateList
 		return nil, adapter.MapError(app_errors.ErrUnauthorized)
 	}
 
-	listing, err := h.listingUC.CreateListing(
-		ctx,
-		userID,
+	price, err := adapter.MapMoneyFromProto(req.Price)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректная цена объявления", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	listing := entity.NewListing(
 		req.Title,
 		req.Description,
 		req.ImageUrl,
-		req.Price,
+		*price,
+		adapter.MapListingStatusFromProto(req.Status),
+		req.CategoryId,
+		userID,
 	)
+	listing.Attributes = req.Attributes.AsMap()
+	listing.Images = entity.NewListingImages(append([]string{req.ImageUrl}, req.Images...))
+
+	listing, err = h.listingUC.CreateListing(ctx, listing)
 	if err != nil {
 		h.log.Error(ctx, "Ошибка при создании объявления", zap.Error(err))
 		return nil, adapter.MapError(err)
 	}
 
-	response := &listings_pb.ListingResponse{
-		Id:             listing.ID,
-		Title:          listing.Title,
-		Description:    listing.Description,
-		ImageUrl:       listing.ImageURL,
-		Price:          listing.Price,
-		AuthorUsername: listing.AuthorUsername,
-		CreatedAt:      timestamppb.New(listing.CreatedAt),
-		IsOwner:        true,
-	}
-
-	return response, nil
+	return adapter.MapListingToProto(listing, userID), nil
 }
 
 // GetListings обрабатывает запрос на получение списка объявлений
 func (h *Handler) GetListings(ctx context.Context, req *listings_pb.GetListingsRequest) (*listings_pb.ListingsResponse, error) {
 	userID, _ := middleware.GetUserID(ctx)
 
-	sortBy := "created_at"
-	if req.SortBy == listings_pb.SortField_SORT_FIELD_PRICE {
-		sortBy = "price"
+	filter, err := h.buildListingFilter(ctx, req)
+	if err != nil {
+		return nil, adapter.MapError(err)
 	}
+	filter.ViewerID = userID
 
-	sortDesc := true
-	if req.SortOrder == listings_pb.SortOrder_SORT_ORDER_ASC {
-		sortDesc = false
+	page, err := h.listingUC.GetListings(ctx, filter)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении списка объявлений", zap.Error(err))
+		return nil, adapter.MapError(err)
 	}
 
-	listings, total, err := h.listingUC.GetListings(
-		ctx,
-		req.Page,
-		req.PerPage,
-		sortBy,
-		sortDesc,
-		req.MinPrice,
-		req.MaxPrice,
-	)
+	return buildListingsResponse(page, filter, req.PerPage, userID), nil
+}
+
+// GetUserListings обрабатывает запрос на получение публичных объявлений пользователя
+func (h *Handler) GetUserListings(ctx context.Context, req *listings_pb.GetUserListingsRequest) (*listings_pb.ListingsResponse, error) {
+	userID, _ := middleware.GetUserID(ctx)
+
+	filter, err := h.buildListingFilter(ctx, req)
 	if err != nil {
-		h.log.Error(ctx, "Ошибка при получении списка объявлений", zap.Error(err))
 		return nil, adapter.MapError(err)
 	}
+	filter.ViewerID = userID
 
-	response := &listings_pb.ListingsResponse{
-		Listings:   make([]*listings_pb.ListingResponse, 0, len(listings)),
+	page, err := h.listingUC.GetUserListings(ctx, req.Username, filter)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении объявлений пользователя", zap.Error(err), zap.String("username", req.Username))
+		return nil, adapter.MapError(err)
+	}
+
+	return buildListingsResponse(page, filter, req.PerPage, userID), nil
+}
+
+// GetMyListings обрабатывает запрос на получение собственных объявлений пользователя во всех статусах
+func (h *Handler) GetMyListings(ctx context.Context, req *listings_pb.GetMyListingsRequest) (*listings_pb.ListingsResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка получить собственные объявления без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	filter, err := h.buildListingFilter(ctx, req)
+	if err != nil {
+		return nil, adapter.MapError(err)
+	}
+	filter.ViewerID = userID
+
+	page, err := h.listingUC.GetMyListings(ctx, userID, filter)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении собственных объявлений", zap.Error(err), zap.Uint64("user_id", userID))
+		return nil, adapter.MapError(err)
+	}
+
+	return buildListingsResponse(page, filter, req.PerPage, userID), nil
+}
+
+// GetListingFacets обрабатывает запрос на получение фасетов ленты объявлений
+func (h *Handler) GetListingFacets(ctx context.Context, req *listings_pb.GetListingFacetsRequest) (*listings_pb.ListingFacetsResponse, error) {
+	minPrice, maxPrice, err := buildPriceRange(req.MinPrice, req.MaxPrice)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректный фильтр по цене", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	filter := &entity.ListingFilter{
+		MinPrice:        minPrice,
+		MaxPrice:        maxPrice,
+		Status:          adapter.MapListingStatusFromProto(req.Status),
+		Query:           strings.TrimSpace(req.Query),
+		CategoryID:      req.CategoryId,
+		Attributes:      req.Attributes,
+		AttributeRanges: buildAttributeRanges(req.AttributesMin, req.AttributesMax),
+	}
+
+	facets, err := h.listingUC.GetListingFacets(ctx, filter, req.Currency, req.PriceBuckets)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении фасетов ленты", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingFacetsToProto(facets), nil
+}
+
+// SearchListings обрабатывает запрос на нечеткий поиск объявлений
+func (h *Handler) SearchListings(ctx context.Context, req *listings_pb.SearchListingsRequest) (*listings_pb.SearchListingsResponse, error) {
+	userID, _ := middleware.GetUserID(ctx)
+
+	results, total, err := h.listingUC.SearchListings(ctx, req.Query, req.Page, req.PerPage)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при нечетком поиске объявлений", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &listings_pb.SearchListingsResponse{
+		Results:    make([]*listings_pb.ListingSearchResult, 0, len(results)),
 		Total:      total,
 		Page:       req.Page,
 		PerPage:    req.PerPage,
 		TotalPages: calculateTotalPages(total, req.PerPage),
 	}
 
-	for _, listing := range listings {
-		isOwner := listing.AuthorID == userID
+	for _, result := range results {
+		response.Results = append(response.Results, &listings_pb.ListingSearchResult{
+			Listing:    adapter.MapListingToProto(result.Listing, userID),
+			Similarity: result.Similarity,
+		})
+	}
 
-		listingResponse := &listings_pb.ListingResponse{
-			Id:             listing.ID,
-			Title:          listing.Title,
-			Description:    listing.Description,
-			ImageUrl:       listing.ImageURL,
-			Price:          listing.Price,
-			AuthorUsername: listing.AuthorUsername,
-			CreatedAt:      timestamppb.New(listing.CreatedAt),
-			IsOwner:        isOwner,
-		}
+	return response, nil
+}
 
-		response.Listings = append(response.Listings, listingResponse)
+// SuggestListings обрабатывает запрос на получение подсказок для строки поиска
+func (h *Handler) SuggestListings(ctx context.Context, req *listings_pb.SuggestListingsRequest) (*listings_pb.SuggestListingsResponse, error) {
+	suggestions := h.listingUC.SuggestListings(ctx, req.Prefix, int(req.Limit))
+
+	response := &listings_pb.SuggestListingsResponse{
+		Suggestions: make([]*listings_pb.Suggestion, 0, len(suggestions)),
+	}
+
+	for _, suggestion := range suggestions {
+		response.Suggestions = append(response.Suggestions, &listings_pb.Suggestion{
+			Text:          suggestion.Text,
+			ListingsCount: suggestion.ListingsCount,
+		})
 	}
 
 	return response, nil
 }
 
+// GetListing обрабатывает запрос на получение объявления по ID
+func (h *Handler) GetListing(ctx context.Context, req *listings_pb.GetListingRequest) (*listings_pb.ListingResponse, error) {
+	userID, _ := middleware.GetUserID(ctx)
+
+	listing, err := h.listingUC.GetListing(ctx, userID, req.Id)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при получении объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// UpdateListing обрабатывает запрос на редактирование объявления
+func (h *Handler) UpdateListing(ctx context.Context, req *listings_pb.UpdateListingRequest) (*listings_pb.ListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка редактировать объявление без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	update, err := buildListingUpdate(req)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректная маска обновления объявления", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	listing, err := h.listingUC.UpdateListing(ctx, userID, update)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при обновлении объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// ChangeListingStatus обрабатывает запрос на изменение статуса объявления
+func (h *Handler) ChangeListingStatus(ctx context.Context, req *listings_pb.ChangeListingStatusRequest) (*listings_pb.ListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка изменить статус объявления без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	listing, err := h.listingUC.ChangeListingStatus(ctx, userID, req.Id, adapter.MapListingStatusFromProto(req.Status))
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при изменении статуса объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// DeleteListing обрабатывает запрос на удаление объявления
+func (h *Handler) DeleteListing(ctx context.Context, req *listings_pb.DeleteListingRequest) (*listings_pb.DeleteListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка удалить объявление без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	restoreUntil, err := h.listingUC.DeleteListing(ctx, userID, req.Id)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при удалении объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return &listings_pb.DeleteListingResponse{
+		RestoreUntil: timestamppb.New(restoreUntil),
+	}, nil
+}
+
+// RestoreListing обрабатывает запрос на восстановление удаленного объявления
+func (h *Handler) RestoreListing(ctx context.Context, req *listings_pb.RestoreListingRequest) (*listings_pb.ListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка восстановить объявление без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	listing, err := h.listingUC.RestoreListing(ctx, userID, req.Id)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при восстановлении объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// buildListingUpdate формирует частичное обновление объявления по update_mask
+func buildListingUpdate(req *listings_pb.UpdateListingRequest) (*entity.ListingUpdate, error) {
+	update := &entity.ListingUpdate{
+		ID:      req.Id,
+		Version: req.Version,
+	}
+
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "title":
+			if req.Title == "" {
+				return nil, app_errors.WrapError(app_errors.ErrValidation, "заголовок не может быть пустым")
+			}
+			update.Title = &req.Title
+		case "description":
+			if req.Description == "" {
+				return nil, app_errors.WrapError(app_errors.ErrValidation, "описание не может быть пустым")
+			}
+			update.Description = &req.Description
+		case "image_url":
+			if req.ImageUrl == "" {
+				return nil, app_errors.WrapError(app_errors.ErrValidation, "URL изображения не может быть пустым")
+			}
+			update.ImageURL = &req.ImageUrl
+		case "price":
+			price, err := adapter.MapMoneyFromProto(req.Price)
+			if err != nil {
+				return nil, err
+			}
+			if price == nil || price.Amount <= 0 {
+				return nil, app_errors.WrapError(app_errors.ErrValidation, "цена должна быть больше нуля")
+			}
+			update.Price = price
+		case "category_id":
+			if req.CategoryId == 0 {
+				return nil, app_errors.WrapError(app_errors.ErrValidation, "категория обязательна")
+			}
+			update.CategoryID = &req.CategoryId
+		case "attributes":
+			update.Attributes = req.Attributes.AsMap()
+		default:
+			return nil, app_errors.WrapError(app_errors.ErrValidation, "поле "+path+" не может быть обновлено")
+		}
+	}
+
+	return update, nil
+}
+
+// buildPriceRange преобразует границы фильтра по цене
+func buildPriceRange(minPrice, maxPrice *listings_pb.Money) (*entity.Money, *entity.Money, error) {
+	minValue, err := adapter.MapMoneyFromProto(minPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxValue, err := adapter.MapMoneyFromProto(maxPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return minValue, maxValue, nil
+}
+
+// buildAttributeRanges объединяет нижние и верхние границы числовых атрибутов
+func buildAttributeRanges(minValues, maxValues map[string]float64) map[string]entity.AttributeRange {
+	ranges := make(map[string]entity.AttributeRange, len(minValues)+len(maxValues))
+
+	for key, value := range minValues {
+		bounds := ranges[key]
+		bounds.Min = &value
+		ranges[key] = bounds
+	}
+
+	for key, value := range maxValues {
+		bounds := ranges[key]
+		bounds.Max = &value
+		ranges[key] = bounds
+	}
+
+	return ranges
+}
+
+// listingsQuery описывает общие параметры запросов ленты объявлений
+type listingsQuery interface {
+	GetPage() uint32
+	GetPerPage() uint32
+	GetSortBy() listings_pb.SortField
+	GetSortOrder() listings_pb.SortOrder
+	GetMinPrice() *listings_pb.Money
+	GetMaxPrice() *listings_pb.Money
+	GetStatus() listings_pb.ListingStatus
+	GetQuery() string
+	GetCategoryId() uint64
+	GetAttributes() map[string]string
+	GetAttributesMin() map[string]float64
+	GetAttributesMax() map[string]float64
+	GetPageToken() string
+	GetTotalMode() listings_pb.TotalMode
+	GetDisplayCurrency() string
+}
+
+// buildListingFilter собирает фильтр ленты объявлений из параметров запроса
+func (h *Handler) buildListingFilter(ctx context.Context, req listingsQuery) (*entity.ListingFilter, error) {
+	cursor, err := adapter.DecodeListingCursor(req.GetPageToken())
+	if err != nil {
+		h.log.Warn(ctx, "Некорректный токен страницы", zap.Error(err))
+		return nil, err
+	}
+
+	minPrice, maxPrice, err := buildPriceRange(req.GetMinPrice(), req.GetMaxPrice())
+	if err != nil {
+		h.log.Warn(ctx, "Некорректный фильтр по цене", zap.Error(err))
+		return nil, err
+	}
+
+	var categoryID *uint64
+	if id := req.GetCategoryId(); id != 0 {
+		categoryID = &id
+	}
+
+	return &entity.ListingFilter{
+		Page:            req.GetPage(),
+		PerPage:         req.GetPerPage(),
+		SortBy:          adapter.MapSortFieldFromProto(req.GetSortBy()),
+		SortDesc:        req.GetSortOrder() != listings_pb.SortOrder_SORT_ORDER_ASC,
+		MinPrice:        minPrice,
+		MaxPrice:        maxPrice,
+		Status:          adapter.MapListingStatusFromProto(req.GetStatus()),
+		Query:           strings.TrimSpace(req.GetQuery()),
+		CategoryID:      categoryID,
+		Attributes:      req.GetAttributes(),
+		AttributeRanges: buildAttributeRanges(req.GetAttributesMin(), req.GetAttributesMax()),
+		Cursor:          cursor,
+		TotalMode:       adapter.MapTotalModeFromProto(req.GetTotalMode()),
+		DisplayCurrency: req.GetDisplayCurrency(),
+	}, nil
+}
+
+// buildListingsResponse формирует ответ со страницей ленты объявлений
+func buildListingsResponse(page *entity.ListingPage, filter *entity.ListingFilter, perPage uint32, userID uint64) *listings_pb.ListingsResponse {
+	response := &listings_pb.ListingsResponse{
+		Listings:      make([]*listings_pb.ListingResponse, 0, len(page.Listings)),
+		Total:         page.Total,
+		Page:          filter.Page,
+		PerPage:       perPage,
+		TotalPages:    calculateTotalPages(page.Total, perPage),
+		NextPageToken: adapter.EncodeListingCursor(page.NextCursor),
+		TotalMode:     adapter.MapTotalModeToProto(filter.TotalMode),
+	}
+
+	for _, listing := range page.Listings {
+		response.Listings = append(response.Listings, adapter.MapListingToProto(listing, userID))
+	}
+
+	return response
+}
+
 func calculateTotalPages(total, perPage uint32) uint32 {
 	if perPage == 0 {
 		return 0
@@ -130,3 +466,275 @@ func calculateTotalPages(total, perPage uint32) uint32 {
 	}
 	return totalPages
 }
+
+// AddListingImages обрабатывает запрос на добавление изображений в галерею объявления
+func (h *Handler) AddListingImages(ctx context.Context, req *listings_pb.AddListingImagesRequest) (*listings_pb.ListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка добавить изображения без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	listing, err := h.listingUC.AddListingImages(ctx, userID, req.Id, req.Urls)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при добавлении изображений объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// RemoveListingImage обрабатывает запрос на удаление изображения из галереи объявления
+func (h *Handler) RemoveListingImage(ctx context.Context, req *listings_pb.RemoveListingImageRequest) (*listings_pb.ListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка удалить изображение без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	listing, err := h.listingUC.RemoveListingImage(ctx, userID, req.Id, req.ImageId)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при удалении изображения объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Uint64("image_id", req.ImageId),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// ReorderListingImages обрабатывает запрос на изменение порядка изображений галереи объявления
+func (h *Handler) ReorderListingImages(ctx context.Context, req *listings_pb.ReorderListingImagesRequest) (*listings_pb.ListingResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка изменить порядок изображений без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	listing, err := h.listingUC.ReorderListingImages(ctx, userID, req.Id, req.ImageIds)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при изменении порядка изображений объявления",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapListingToProto(listing, userID), nil
+}
+
+// AddFavorite обрабатывает запрос на добавление объявления в избранное
+func (h *Handler) AddFavorite(ctx context.Context, req *listings_pb.AddFavoriteRequest) (*listings_pb.FavoriteResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка добавить объявление в избранное без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	alertPrice, err := adapter.MapMoneyFromProto(req.AlertPrice)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректный порог цены", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	state, err := h.listingUC.AddFavorite(ctx, userID, req.Id, alertPrice)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при добавлении объявления в избранное",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapFavoriteStateToProto(state), nil
+}
+
+// RemoveFavorite обрабатывает запрос на удаление объявления из избранного
+func (h *Handler) RemoveFavorite(ctx context.Context, req *listings_pb.RemoveFavoriteRequest) (*listings_pb.FavoriteResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка удалить объявление из избранного без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	state, err := h.listingUC.RemoveFavorite(ctx, userID, req.Id)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при удалении объявления из избранного",
+			zap.Uint64("listing_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapFavoriteStateToProto(state), nil
+}
+
+// ListFavorites обрабатывает запрос на получение избранных объявлений
+func (h *Handler) ListFavorites(ctx context.Context, req *listings_pb.ListFavoritesRequest) (*listings_pb.ListFavoritesResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка получить избранное без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	page, err := h.listingUC.ListFavorites(ctx, userID, req.Page, req.PerPage)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении избранных объявлений", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &listings_pb.ListFavoritesResponse{
+		Listings:   make([]*listings_pb.ListingResponse, 0, len(page.Listings)),
+		Total:      page.Total,
+		Page:       max(req.Page, 1),
+		PerPage:    req.PerPage,
+		TotalPages: calculateTotalPages(page.Total, req.PerPage),
+	}
+
+	for _, listing := range page.Listings {
+		response.Listings = append(response.Listings, adapter.MapListingToProto(listing, userID))
+	}
+
+	return response, nil
+}
+
+// CreateSavedSearch обрабатывает запрос на сохранение поиска
+func (h *Handler) CreateSavedSearch(ctx context.Context, req *listings_pb.CreateSavedSearchRequest) (*listings_pb.SavedSearchResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка сохранить поиск без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	filter, err := buildSavedSearchFilter(req.Filter)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректные условия сохраненного поиска", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	search, err := h.listingUC.CreateSavedSearch(ctx, &entity.SavedSearch{
+		UserID:    userID,
+		Name:      req.Name,
+		Filter:    *filter,
+		Frequency: adapter.MapSearchFrequencyFromProto(req.Frequency),
+	})
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при сохранении поиска", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapSavedSearchToProto(search), nil
+}
+
+// ListSavedSearches обрабатывает запрос на получение сохраненных поисков
+func (h *Handler) ListSavedSearches(ctx context.Context, _ *listings_pb.ListSavedSearchesRequest) (*listings_pb.ListSavedSearchesResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка получить сохраненные поиски без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	searches, err := h.listingUC.ListSavedSearches(ctx, userID)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении сохраненных поисков", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &listings_pb.ListSavedSearchesResponse{
+		SavedSearches: make([]*listings_pb.SavedSearchResponse, 0, len(searches)),
+	}
+
+	for _, search := range searches {
+		response.SavedSearches = append(response.SavedSearches, adapter.MapSavedSearchToProto(search))
+	}
+
+	return response, nil
+}
+
+// GetSavedSearch обрабатывает запрос на получение сохраненного поиска
+func (h *Handler) GetSavedSearch(ctx context.Context, req *listings_pb.GetSavedSearchRequest) (*listings_pb.SavedSearchResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка получить сохраненный поиск без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	search, err := h.listingUC.GetSavedSearch(ctx, userID, req.Id)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при получении сохраненного поиска",
+			zap.Uint64("saved_search_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapSavedSearchToProto(search), nil
+}
+
+// UpdateSavedSearch обрабатывает запрос на изменение сохраненного поиска
+func (h *Handler) UpdateSavedSearch(ctx context.Context, req *listings_pb.UpdateSavedSearchRequest) (*listings_pb.SavedSearchResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка изменить сохраненный поиск без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	filter, err := buildSavedSearchFilter(req.Filter)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректные условия сохраненного поиска", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	search, err := h.listingUC.UpdateSavedSearch(ctx, &entity.SavedSearch{
+		ID:        req.Id,
+		UserID:    userID,
+		Name:      req.Name,
+		Filter:    *filter,
+		Frequency: adapter.MapSearchFrequencyFromProto(req.Frequency),
+	})
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при изменении сохраненного поиска",
+			zap.Uint64("saved_search_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapSavedSearchToProto(search), nil
+}
+
+// DeleteSavedSearch обрабатывает запрос на удаление сохраненного поиска
+func (h *Handler) DeleteSavedSearch(ctx context.Context, req *listings_pb.DeleteSavedSearchRequest) (*listings_pb.DeleteSavedSearchResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка удалить сохраненный поиск без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	if err := h.listingUC.DeleteSavedSearch(ctx, userID, req.Id); err != nil {
+		h.log.Warn(ctx, "Ошибка при удалении сохраненного поиска",
+			zap.Uint64("saved_search_id", req.Id),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return &listings_pb.DeleteSavedSearchResponse{}, nil
+}
+
+// buildSavedSearchFilter преобразует условия сохраненного поиска из запроса
+func buildSavedSearchFilter(filter *listings_pb.SavedSearchFilter) (*entity.SavedSearchFilter, error) {
+	minPrice, maxPrice, err := buildPriceRange(filter.MinPrice, filter.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SavedSearchFilter{
+		Query:           strings.TrimSpace(filter.Query),
+		CategoryID:      filter.CategoryId,
+		MinPrice:        minPrice,
+		MaxPrice:        maxPrice,
+		Attributes:      filter.Attributes,
+		AttributeRanges: buildAttributeRanges(filter.AttributesMin, filter.AttributesMax),
+		SortBy:          adapter.MapSortFieldFromProto(filter.SortBy),
+		SortDesc:        filter.SortOrder != listings_pb.SortOrder_SORT_ORDER_ASC,
+		DisplayCurrency: filter.DisplayCurrency,
+	}, nil
+}
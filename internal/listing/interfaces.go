@@ -2,16 +2,69 @@ package listing
 
 import (
 	"context"
+	"time"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
 )
 
 type Repository interface {
 	CreateListing(ctx context.Context, listing *entity.Listing) (*entity.Listing, error)
-	GetListings(ctx context.Context, filter *entity.ListingFilter) ([]*entity.Listing, uint32, error)
+	GetListings(ctx context.Context, filter *entity.ListingFilter) (*entity.ListingPage, error)
+	GetListingFacets(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) (*entity.ListingFacets, error)
+	SearchListings(ctx context.Context, filter *entity.ListingSearchFilter) ([]*entity.ListingSearchResult, uint32, error)
+	GetSuggestionTerms(ctx context.Context, limit int) ([]*entity.Suggestion, error)
+	GetListingByID(ctx context.Context, id uint64) (*entity.Listing, error)
+	UpdateListing(ctx context.Context, update *entity.ListingUpdate) (*entity.Listing, error)
+	UpdateListingStatus(ctx context.Context, id uint64, from, to entity.ListingStatus) (*entity.Listing, error)
+	GetDeletedListingByID(ctx context.Context, id uint64) (*entity.Listing, error)
+	DeleteListing(ctx context.Context, id uint64) (time.Time, error)
+	RestoreListing(ctx context.Context, id uint64) (*entity.Listing, error)
+	PurgeDeletedListings(ctx context.Context, deletedBefore time.Time) (int64, error)
+	GetListingImages(ctx context.Context, listingID uint64) ([]*entity.ListingImage, error)
+	AddListingImages(ctx context.Context, listingID uint64, urls []string, maxImages int) ([]*entity.ListingImage, error)
+	DeleteListingImage(ctx context.Context, listingID, imageID uint64) ([]*entity.ListingImage, error)
+	ReorderListingImages(ctx context.Context, listingID uint64, imageIDs []uint64) ([]*entity.ListingImage, error)
+	GetImageVariants(ctx context.Context, urls []string) (map[string][]*entity.ImageVariant, error)
+	AddFavorite(ctx context.Context, userID, listingID uint64, alertPrice *entity.Money) (*entity.FavoriteState, error)
+	RemoveFavorite(ctx context.Context, userID, listingID uint64) (*entity.FavoriteState, error)
+	ListFavorites(ctx context.Context, userID uint64, page, perPage uint32) (*entity.ListingPage, error)
+	GetFavoriteListingIDs(ctx context.Context, userID uint64, listingIDs []uint64) (map[uint64]bool, error)
+	GetPriceWatchers(ctx context.Context, listingID uint64) ([]*entity.PriceWatcher, error)
+	CreateSavedSearch(ctx context.Context, search *entity.SavedSearch, maxPerUser int) (*entity.SavedSearch, error)
+	GetSavedSearch(ctx context.Context, userID, id uint64) (*entity.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID uint64) ([]*entity.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id uint64) error
+	GetDueSavedSearches(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) ([]*entity.SavedSearch, error)
+	MarkSavedSearchChecked(ctx context.Context, id uint64, lastPublishedAt time.Time) error
+	GetPublicationWatermark(ctx context.Context, commitLag time.Duration) (time.Time, time.Time, error)
 }
 
 type UseCase interface {
-	CreateListing(ctx context.Context, authorID uint64, title, description, imageURL string, price float32) (*entity.Listing, error)
-	GetListings(ctx context.Context, page, perPage uint32, sortBy string, sortDesc bool, minPrice, maxPrice *float32) ([]*entity.Listing, uint32, error)
+	CreateListing(ctx context.Context, listing *entity.Listing) (*entity.Listing, error)
+	GetListings(ctx context.Context, filter *entity.ListingFilter) (*entity.ListingPage, error)
+	GetUserListings(ctx context.Context, username string, filter *entity.ListingFilter) (*entity.ListingPage, error)
+	GetMyListings(ctx context.Context, userID uint64, filter *entity.ListingFilter) (*entity.ListingPage, error)
+	GetListingFacets(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) (*entity.ListingFacets, error)
+	SearchListings(ctx context.Context, query string, page, perPage uint32) ([]*entity.ListingSearchResult, uint32, error)
+	SuggestListings(ctx context.Context, prefix string, limit int) []*entity.Suggestion
+	RefreshSuggestions(ctx context.Context) error
+	GetListing(ctx context.Context, userID, id uint64) (*entity.Listing, error)
+	UpdateListing(ctx context.Context, userID uint64, update *entity.ListingUpdate) (*entity.Listing, error)
+	ChangeListingStatus(ctx context.Context, userID, id uint64, status entity.ListingStatus) (*entity.Listing, error)
+	DeleteListing(ctx context.Context, userID, id uint64) (time.Time, error)
+	RestoreListing(ctx context.Context, userID, id uint64) (*entity.Listing, error)
+	PurgeDeletedListings(ctx context.Context) (int64, error)
+	AddListingImages(ctx context.Context, userID, id uint64, urls []string) (*entity.Listing, error)
+	RemoveListingImage(ctx context.Context, userID, id, imageID uint64) (*entity.Listing, error)
+	ReorderListingImages(ctx context.Context, userID, id uint64, imageIDs []uint64) (*entity.Listing, error)
+	AddFavorite(ctx context.Context, userID, id uint64, alertPrice *entity.Money) (*entity.FavoriteState, error)
+	RemoveFavorite(ctx context.Context, userID, id uint64) (*entity.FavoriteState, error)
+	ListFavorites(ctx context.Context, userID uint64, page, perPage uint32) (*entity.ListingPage, error)
+	CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	GetSavedSearch(ctx context.Context, userID, id uint64) (*entity.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID uint64) ([]*entity.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id uint64) error
+	MatchSavedSearches(ctx context.Context) (int, error)
 }
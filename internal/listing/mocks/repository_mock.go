@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
@@ -19,6 +20,20 @@ type RepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddFavorite          func(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money) (fp1 *entity.FavoriteState, err error)
+	funcAddFavoriteOrigin    string
+	inspectFuncAddFavorite   func(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money)
+	afterAddFavoriteCounter  uint64
+	beforeAddFavoriteCounter uint64
+	AddFavoriteMock          mRepositoryMockAddFavorite
+
+	funcAddListingImages          func(ctx context.Context, listingID uint64, urls []string, maxImages int) (lpa1 []*entity.ListingImage, err error)
+	funcAddListingImagesOrigin    string
+	inspectFuncAddListingImages   func(ctx context.Context, listingID uint64, urls []string, maxImages int)
+	afterAddListingImagesCounter  uint64
+	beforeAddListingImagesCounter uint64
+	AddListingImagesMock          mRepositoryMockAddListingImages
+
 	funcCreateListing          func(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error)
 	funcCreateListingOrigin    string
 	inspectFuncCreateListing   func(ctx context.Context, listing *entity.Listing)
@@ -26,12 +41,194 @@ type RepositoryMock struct {
 	beforeCreateListingCounter uint64
 	CreateListingMock          mRepositoryMockCreateListing
 
-	funcGetListings          func(ctx context.Context, filter *entity.ListingFilter) (lpa1 []*entity.Listing, u1 uint32, err error)
+	funcCreateSavedSearch          func(ctx context.Context, search *entity.SavedSearch, maxPerUser int) (sp1 *entity.SavedSearch, err error)
+	funcCreateSavedSearchOrigin    string
+	inspectFuncCreateSavedSearch   func(ctx context.Context, search *entity.SavedSearch, maxPerUser int)
+	afterCreateSavedSearchCounter  uint64
+	beforeCreateSavedSearchCounter uint64
+	CreateSavedSearchMock          mRepositoryMockCreateSavedSearch
+
+	funcDeleteListing          func(ctx context.Context, id uint64) (t1 time.Time, err error)
+	funcDeleteListingOrigin    string
+	inspectFuncDeleteListing   func(ctx context.Context, id uint64)
+	afterDeleteListingCounter  uint64
+	beforeDeleteListingCounter uint64
+	DeleteListingMock          mRepositoryMockDeleteListing
+
+	funcDeleteListingImage          func(ctx context.Context, listingID uint64, imageID uint64) (lpa1 []*entity.ListingImage, err error)
+	funcDeleteListingImageOrigin    string
+	inspectFuncDeleteListingImage   func(ctx context.Context, listingID uint64, imageID uint64)
+	afterDeleteListingImageCounter  uint64
+	beforeDeleteListingImageCounter uint64
+	DeleteListingImageMock          mRepositoryMockDeleteListingImage
+
+	funcDeleteSavedSearch          func(ctx context.Context, userID uint64, id uint64) (err error)
+	funcDeleteSavedSearchOrigin    string
+	inspectFuncDeleteSavedSearch   func(ctx context.Context, userID uint64, id uint64)
+	afterDeleteSavedSearchCounter  uint64
+	beforeDeleteSavedSearchCounter uint64
+	DeleteSavedSearchMock          mRepositoryMockDeleteSavedSearch
+
+	funcGetDeletedListingByID          func(ctx context.Context, id uint64) (lp1 *entity.Listing, err error)
+	funcGetDeletedListingByIDOrigin    string
+	inspectFuncGetDeletedListingByID   func(ctx context.Context, id uint64)
+	afterGetDeletedListingByIDCounter  uint64
+	beforeGetDeletedListingByIDCounter uint64
+	GetDeletedListingByIDMock          mRepositoryMockGetDeletedListingByID
+
+	funcGetDueSavedSearches          func(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) (spa1 []*entity.SavedSearch, err error)
+	funcGetDueSavedSearchesOrigin    string
+	inspectFuncGetDueSavedSearches   func(ctx context.Context, afterID uint64, digestBefore time.Time, limit int)
+	afterGetDueSavedSearchesCounter  uint64
+	beforeGetDueSavedSearchesCounter uint64
+	GetDueSavedSearchesMock          mRepositoryMockGetDueSavedSearches
+
+	funcGetFavoriteListingIDs          func(ctx context.Context, userID uint64, listingIDs []uint64) (m1 map[uint64]bool, err error)
+	funcGetFavoriteListingIDsOrigin    string
+	inspectFuncGetFavoriteListingIDs   func(ctx context.Context, userID uint64, listingIDs []uint64)
+	afterGetFavoriteListingIDsCounter  uint64
+	beforeGetFavoriteListingIDsCounter uint64
+	GetFavoriteListingIDsMock          mRepositoryMockGetFavoriteListingIDs
+
+	funcGetImageVariants          func(ctx context.Context, urls []string) (m1 map[string][]*entity.ImageVariant, err error)
+	funcGetImageVariantsOrigin    string
+	inspectFuncGetImageVariants   func(ctx context.Context, urls []string)
+	afterGetImageVariantsCounter  uint64
+	beforeGetImageVariantsCounter uint64
+	GetImageVariantsMock          mRepositoryMockGetImageVariants
+
+	funcGetListingByID          func(ctx context.Context, id uint64) (lp1 *entity.Listing, err error)
+	funcGetListingByIDOrigin    string
+	inspectFuncGetListingByID   func(ctx context.Context, id uint64)
+	afterGetListingByIDCounter  uint64
+	beforeGetListingByIDCounter uint64
+	GetListingByIDMock          mRepositoryMockGetListingByID
+
+	funcGetListingFacets          func(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) (lp1 *entity.ListingFacets, err error)
+	funcGetListingFacetsOrigin    string
+	inspectFuncGetListingFacets   func(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money)
+	afterGetListingFacetsCounter  uint64
+	beforeGetListingFacetsCounter uint64
+	GetListingFacetsMock          mRepositoryMockGetListingFacets
+
+	funcGetListingImages          func(ctx context.Context, listingID uint64) (lpa1 []*entity.ListingImage, err error)
+	funcGetListingImagesOrigin    string
+	inspectFuncGetListingImages   func(ctx context.Context, listingID uint64)
+	afterGetListingImagesCounter  uint64
+	beforeGetListingImagesCounter uint64
+	GetListingImagesMock          mRepositoryMockGetListingImages
+
+	funcGetListings          func(ctx context.Context, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)
 	funcGetListingsOrigin    string
 	inspectFuncGetListings   func(ctx context.Context, filter *entity.ListingFilter)
 	afterGetListingsCounter  uint64
 	beforeGetListingsCounter uint64
 	GetListingsMock          mRepositoryMockGetListings
+
+	funcGetPriceWatchers          func(ctx context.Context, listingID uint64) (ppa1 []*entity.PriceWatcher, err error)
+	funcGetPriceWatchersOrigin    string
+	inspectFuncGetPriceWatchers   func(ctx context.Context, listingID uint64)
+	afterGetPriceWatchersCounter  uint64
+	beforeGetPriceWatchersCounter uint64
+	GetPriceWatchersMock          mRepositoryMockGetPriceWatchers
+
+	funcGetPublicationWatermark          func(ctx context.Context, commitLag time.Duration) (t1 time.Time, t2 time.Time, err error)
+	funcGetPublicationWatermarkOrigin    string
+	inspectFuncGetPublicationWatermark   func(ctx context.Context, commitLag time.Duration)
+	afterGetPublicationWatermarkCounter  uint64
+	beforeGetPublicationWatermarkCounter uint64
+	GetPublicationWatermarkMock          mRepositoryMockGetPublicationWatermark
+
+	funcGetSavedSearch          func(ctx context.Context, userID uint64, id uint64) (sp1 *entity.SavedSearch, err error)
+	funcGetSavedSearchOrigin    string
+	inspectFuncGetSavedSearch   func(ctx context.Context, userID uint64, id uint64)
+	afterGetSavedSearchCounter  uint64
+	beforeGetSavedSearchCounter uint64
+	GetSavedSearchMock          mRepositoryMockGetSavedSearch
+
+	funcGetSuggestionTerms          func(ctx context.Context, limit int) (spa1 []*entity.Suggestion, err error)
+	funcGetSuggestionTermsOrigin    string
+	inspectFuncGetSuggestionTerms   func(ctx context.Context, limit int)
+	afterGetSuggestionTermsCounter  uint64
+	beforeGetSuggestionTermsCounter uint64
+	GetSuggestionTermsMock          mRepositoryMockGetSuggestionTerms
+
+	funcListFavorites          func(ctx context.Context, userID uint64, page uint32, perPage uint32) (lp1 *entity.ListingPage, err error)
+	funcListFavoritesOrigin    string
+	inspectFuncListFavorites   func(ctx context.Context, userID uint64, page uint32, perPage uint32)
+	afterListFavoritesCounter  uint64
+	beforeListFavoritesCounter uint64
+	ListFavoritesMock          mRepositoryMockListFavorites
+
+	funcListSavedSearches          func(ctx context.Context, userID uint64) (spa1 []*entity.SavedSearch, err error)
+	funcListSavedSearchesOrigin    string
+	inspectFuncListSavedSearches   func(ctx context.Context, userID uint64)
+	afterListSavedSearchesCounter  uint64
+	beforeListSavedSearchesCounter uint64
+	ListSavedSearchesMock          mRepositoryMockListSavedSearches
+
+	funcMarkSavedSearchChecked          func(ctx context.Context, id uint64, lastPublishedAt time.Time) (err error)
+	funcMarkSavedSearchCheckedOrigin    string
+	inspectFuncMarkSavedSearchChecked   func(ctx context.Context, id uint64, lastPublishedAt time.Time)
+	afterMarkSavedSearchCheckedCounter  uint64
+	beforeMarkSavedSearchCheckedCounter uint64
+	MarkSavedSearchCheckedMock          mRepositoryMockMarkSavedSearchChecked
+
+	funcPurgeDeletedListings          func(ctx context.Context, deletedBefore time.Time) (i1 int64, err error)
+	funcPurgeDeletedListingsOrigin    string
+	inspectFuncPurgeDeletedListings   func(ctx context.Context, deletedBefore time.Time)
+	afterPurgeDeletedListingsCounter  uint64
+	beforePurgeDeletedListingsCounter uint64
+	PurgeDeletedListingsMock          mRepositoryMockPurgeDeletedListings
+
+	funcRemoveFavorite          func(ctx context.Context, userID uint64, listingID uint64) (fp1 *entity.FavoriteState, err error)
+	funcRemoveFavoriteOrigin    string
+	inspectFuncRemoveFavorite   func(ctx context.Context, userID uint64, listingID uint64)
+	afterRemoveFavoriteCounter  uint64
+	beforeRemoveFavoriteCounter uint64
+	RemoveFavoriteMock          mRepositoryMockRemoveFavorite
+
+	funcReorderListingImages          func(ctx context.Context, listingID uint64, imageIDs []uint64) (lpa1 []*entity.ListingImage, err error)
+	funcReorderListingImagesOrigin    string
+	inspectFuncReorderListingImages   func(ctx context.Context, listingID uint64, imageIDs []uint64)
+	afterReorderListingImagesCounter  uint64
+	beforeReorderListingImagesCounter uint64
+	ReorderListingImagesMock          mRepositoryMockReorderListingImages
+
+	funcRestoreListing          func(ctx context.Context, id uint64) (lp1 *entity.Listing, err error)
+	funcRestoreListingOrigin    string
+	inspectFuncRestoreListing   func(ctx context.Context, id uint64)
+	afterRestoreListingCounter  uint64
+	beforeRestoreListingCounter uint64
+	RestoreListingMock          mRepositoryMockRestoreListing
+
+	funcSearchListings          func(ctx context.Context, filter *entity.ListingSearchFilter) (lpa1 []*entity.ListingSearchResult, u1 uint32, err error)
+	funcSearchListingsOrigin    string
+	inspectFuncSearchListings   func(ctx context.Context, filter *entity.ListingSearchFilter)
+	afterSearchListingsCounter  uint64
+	beforeSearchListingsCounter uint64
+	SearchListingsMock          mRepositoryMockSearchListings
+
+	funcUpdateListing          func(ctx context.Context, update *entity.ListingUpdate) (lp1 *entity.Listing, err error)
+	funcUpdateListingOrigin    string
+	inspectFuncUpdateListing   func(ctx context.Context, update *entity.ListingUpdate)
+	afterUpdateListingCounter  uint64
+	beforeUpdateListingCounter uint64
+	UpdateListingMock          mRepositoryMockUpdateListing
+
+	funcUpdateListingStatus          func(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus) (lp1 *entity.Listing, err error)
+	funcUpdateListingStatusOrigin    string
+	inspectFuncUpdateListingStatus   func(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus)
+	afterUpdateListingStatusCounter  uint64
+	beforeUpdateListingStatusCounter uint64
+	UpdateListingStatusMock          mRepositoryMockUpdateListingStatus
+
+	funcUpdateSavedSearch          func(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error)
+	funcUpdateSavedSearchOrigin    string
+	inspectFuncUpdateSavedSearch   func(ctx context.Context, search *entity.SavedSearch)
+	afterUpdateSavedSearchCounter  uint64
+	beforeUpdateSavedSearchCounter uint64
+	UpdateSavedSearchMock          mRepositoryMockUpdateSavedSearch
 }
 
 // NewRepositoryMock returns a mock for mm_listing.Repository
@@ -42,64 +239,154 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddFavoriteMock = mRepositoryMockAddFavorite{mock: m}
+	m.AddFavoriteMock.callArgs = []*RepositoryMockAddFavoriteParams{}
+
+	m.AddListingImagesMock = mRepositoryMockAddListingImages{mock: m}
+	m.AddListingImagesMock.callArgs = []*RepositoryMockAddListingImagesParams{}
+
 	m.CreateListingMock = mRepositoryMockCreateListing{mock: m}
 	m.CreateListingMock.callArgs = []*RepositoryMockCreateListingParams{}
 
+	m.CreateSavedSearchMock = mRepositoryMockCreateSavedSearch{mock: m}
+	m.CreateSavedSearchMock.callArgs = []*RepositoryMockCreateSavedSearchParams{}
+
+	m.DeleteListingMock = mRepositoryMockDeleteListing{mock: m}
+	m.DeleteListingMock.callArgs = []*RepositoryMockDeleteListingParams{}
+
+	m.DeleteListingImageMock = mRepositoryMockDeleteListingImage{mock: m}
+	m.DeleteListingImageMock.callArgs = []*RepositoryMockDeleteListingImageParams{}
+
+	m.DeleteSavedSearchMock = mRepositoryMockDeleteSavedSearch{mock: m}
+	m.DeleteSavedSearchMock.callArgs = []*RepositoryMockDeleteSavedSearchParams{}
+
+	m.GetDeletedListingByIDMock = mRepositoryMockGetDeletedListingByID{mock: m}
+	m.GetDeletedListingByIDMock.callArgs = []*RepositoryMockGetDeletedListingByIDParams{}
+
+	m.GetDueSavedSearchesMock = mRepositoryMockGetDueSavedSearches{mock: m}
+	m.GetDueSavedSearchesMock.callArgs = []*RepositoryMockGetDueSavedSearchesParams{}
+
+	m.GetFavoriteListingIDsMock = mRepositoryMockGetFavoriteListingIDs{mock: m}
+	m.GetFavoriteListingIDsMock.callArgs = []*RepositoryMockGetFavoriteListingIDsParams{}
+
+	m.GetImageVariantsMock = mRepositoryMockGetImageVariants{mock: m}
+	m.GetImageVariantsMock.callArgs = []*RepositoryMockGetImageVariantsParams{}
+
+	m.GetListingByIDMock = mRepositoryMockGetListingByID{mock: m}
+	m.GetListingByIDMock.callArgs = []*RepositoryMockGetListingByIDParams{}
+
+	m.GetListingFacetsMock = mRepositoryMockGetListingFacets{mock: m}
+	m.GetListingFacetsMock.callArgs = []*RepositoryMockGetListingFacetsParams{}
+
+	m.GetListingImagesMock = mRepositoryMockGetListingImages{mock: m}
+	m.GetListingImagesMock.callArgs = []*RepositoryMockGetListingImagesParams{}
+
 	m.GetListingsMock = mRepositoryMockGetListings{mock: m}
 	m.GetListingsMock.callArgs = []*RepositoryMockGetListingsParams{}
 
+	m.GetPriceWatchersMock = mRepositoryMockGetPriceWatchers{mock: m}
+	m.GetPriceWatchersMock.callArgs = []*RepositoryMockGetPriceWatchersParams{}
+
+	m.GetPublicationWatermarkMock = mRepositoryMockGetPublicationWatermark{mock: m}
+	m.GetPublicationWatermarkMock.callArgs = []*RepositoryMockGetPublicationWatermarkParams{}
+
+	m.GetSavedSearchMock = mRepositoryMockGetSavedSearch{mock: m}
+	m.GetSavedSearchMock.callArgs = []*RepositoryMockGetSavedSearchParams{}
+
+	m.GetSuggestionTermsMock = mRepositoryMockGetSuggestionTerms{mock: m}
+	m.GetSuggestionTermsMock.callArgs = []*RepositoryMockGetSuggestionTermsParams{}
+
+	m.ListFavoritesMock = mRepositoryMockListFavorites{mock: m}
+	m.ListFavoritesMock.callArgs = []*RepositoryMockListFavoritesParams{}
+
+	m.ListSavedSearchesMock = mRepositoryMockListSavedSearches{mock: m}
+	m.ListSavedSearchesMock.callArgs = []*RepositoryMockListSavedSearchesParams{}
+
+	m.MarkSavedSearchCheckedMock = mRepositoryMockMarkSavedSearchChecked{mock: m}
+	m.MarkSavedSearchCheckedMock.callArgs = []*RepositoryMockMarkSavedSearchCheckedParams{}
+
+	m.PurgeDeletedListingsMock = mRepositoryMockPurgeDeletedListings{mock: m}
+	m.PurgeDeletedListingsMock.callArgs = []*RepositoryMockPurgeDeletedListingsParams{}
+
+	m.RemoveFavoriteMock = mRepositoryMockRemoveFavorite{mock: m}
+	m.RemoveFavoriteMock.callArgs = []*RepositoryMockRemoveFavoriteParams{}
+
+	m.ReorderListingImagesMock = mRepositoryMockReorderListingImages{mock: m}
+	m.ReorderListingImagesMock.callArgs = []*RepositoryMockReorderListingImagesParams{}
+
+	m.RestoreListingMock = mRepositoryMockRestoreListing{mock: m}
+	m.RestoreListingMock.callArgs = []*RepositoryMockRestoreListingParams{}
+
+	m.SearchListingsMock = mRepositoryMockSearchListings{mock: m}
+	m.SearchListingsMock.callArgs = []*RepositoryMockSearchListingsParams{}
+
+	m.UpdateListingMock = mRepositoryMockUpdateListing{mock: m}
+	m.UpdateListingMock.callArgs = []*RepositoryMockUpdateListingParams{}
+
+	m.UpdateListingStatusMock = mRepositoryMockUpdateListingStatus{mock: m}
+	m.UpdateListingStatusMock.callArgs = []*RepositoryMockUpdateListingStatusParams{}
+
+	m.UpdateSavedSearchMock = mRepositoryMockUpdateSavedSearch{mock: m}
+	m.UpdateSavedSearchMock.callArgs = []*RepositoryMockUpdateSavedSearchParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mRepositoryMockCreateListing struct {
+type mRepositoryMockAddFavorite struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockCreateListingExpectation
-	expectations       []*RepositoryMockCreateListingExpectation
+	defaultExpectation *RepositoryMockAddFavoriteExpectation
+	expectations       []*RepositoryMockAddFavoriteExpectation
 
-	callArgs []*RepositoryMockCreateListingParams
+	callArgs []*RepositoryMockAddFavoriteParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockCreateListingExpectation specifies expectation struct of the Repository.CreateListing
-type RepositoryMockCreateListingExpectation struct {
+// RepositoryMockAddFavoriteExpectation specifies expectation struct of the Repository.AddFavorite
+type RepositoryMockAddFavoriteExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockCreateListingParams
-	paramPtrs          *RepositoryMockCreateListingParamPtrs
-	expectationOrigins RepositoryMockCreateListingExpectationOrigins
-	results            *RepositoryMockCreateListingResults
+	params             *RepositoryMockAddFavoriteParams
+	paramPtrs          *RepositoryMockAddFavoriteParamPtrs
+	expectationOrigins RepositoryMockAddFavoriteExpectationOrigins
+	results            *RepositoryMockAddFavoriteResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockCreateListingParams contains parameters of the Repository.CreateListing
-type RepositoryMockCreateListingParams struct {
-	ctx     context.Context
-	listing *entity.Listing
+// RepositoryMockAddFavoriteParams contains parameters of the Repository.AddFavorite
+type RepositoryMockAddFavoriteParams struct {
+	ctx        context.Context
+	userID     uint64
+	listingID  uint64
+	alertPrice *entity.Money
 }
 
-// RepositoryMockCreateListingParamPtrs contains pointers to parameters of the Repository.CreateListing
-type RepositoryMockCreateListingParamPtrs struct {
-	ctx     *context.Context
-	listing **entity.Listing
+// RepositoryMockAddFavoriteParamPtrs contains pointers to parameters of the Repository.AddFavorite
+type RepositoryMockAddFavoriteParamPtrs struct {
+	ctx        *context.Context
+	userID     *uint64
+	listingID  *uint64
+	alertPrice **entity.Money
 }
 
-// RepositoryMockCreateListingResults contains results of the Repository.CreateListing
-type RepositoryMockCreateListingResults struct {
-	lp1 *entity.Listing
+// RepositoryMockAddFavoriteResults contains results of the Repository.AddFavorite
+type RepositoryMockAddFavoriteResults struct {
+	fp1 *entity.FavoriteState
 	err error
 }
 
-// RepositoryMockCreateListingOrigins contains origins of expectations of the Repository.CreateListing
-type RepositoryMockCreateListingExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originListing string
+// RepositoryMockAddFavoriteOrigins contains origins of expectations of the Repository.AddFavorite
+type RepositoryMockAddFavoriteExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originListingID  string
+	originAlertPrice string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -107,343 +394,10529 @@ type RepositoryMockCreateListingExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateListing *mRepositoryMockCreateListing) Optional() *mRepositoryMockCreateListing {
-	mmCreateListing.optional = true
-	return mmCreateListing
+func (mmAddFavorite *mRepositoryMockAddFavorite) Optional() *mRepositoryMockAddFavorite {
+	mmAddFavorite.optional = true
+	return mmAddFavorite
 }
 
-// Expect sets up expected params for Repository.CreateListing
-func (mmCreateListing *mRepositoryMockCreateListing) Expect(ctx context.Context, listing *entity.Listing) *mRepositoryMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+// Expect sets up expected params for Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) Expect(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money) *mRepositoryMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &RepositoryMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by ExpectParams functions")
+	if mmAddFavorite.defaultExpectation.paramPtrs != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by ExpectParams functions")
 	}
 
-	mmCreateListing.defaultExpectation.params = &RepositoryMockCreateListingParams{ctx, listing}
-	mmCreateListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateListing.expectations {
-		if minimock.Equal(e.params, mmCreateListing.defaultExpectation.params) {
-			mmCreateListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateListing.defaultExpectation.params)
+	mmAddFavorite.defaultExpectation.params = &RepositoryMockAddFavoriteParams{ctx, userID, listingID, alertPrice}
+	mmAddFavorite.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddFavorite.expectations {
+		if minimock.Equal(e.params, mmAddFavorite.defaultExpectation.params) {
+			mmAddFavorite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddFavorite.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.CreateListing
-func (mmCreateListing *mRepositoryMockCreateListing) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &RepositoryMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Expect")
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Expect")
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &RepositoryMockCreateListingParamPtrs{}
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &RepositoryMockAddFavoriteParamPtrs{}
 	}
-	mmCreateListing.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddFavorite.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddFavorite.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// ExpectListingParam2 sets up expected param listing for Repository.CreateListing
-func (mmCreateListing *mRepositoryMockCreateListing) ExpectListingParam2(listing *entity.Listing) *mRepositoryMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) ExpectUserIDParam2(userID uint64) *mRepositoryMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &RepositoryMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Expect")
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Expect")
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &RepositoryMockCreateListingParamPtrs{}
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &RepositoryMockAddFavoriteParamPtrs{}
 	}
-	mmCreateListing.defaultExpectation.paramPtrs.listing = &listing
-	mmCreateListing.defaultExpectation.expectationOrigins.originListing = minimock.CallerInfo(1)
+	mmAddFavorite.defaultExpectation.paramPtrs.userID = &userID
+	mmAddFavorite.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.CreateListing
-func (mmCreateListing *mRepositoryMockCreateListing) Inspect(f func(ctx context.Context, listing *entity.Listing)) *mRepositoryMockCreateListing {
-	if mmCreateListing.mock.inspectFuncCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateListing")
+// ExpectListingIDParam3 sets up expected param listingID for Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) ExpectListingIDParam3(listingID uint64) *mRepositoryMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	mmCreateListing.mock.inspectFuncCreateListing = f
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &RepositoryMockAddFavoriteExpectation{}
+	}
 
-	return mmCreateListing
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Expect")
+	}
+
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &RepositoryMockAddFavoriteParamPtrs{}
+	}
+	mmAddFavorite.defaultExpectation.paramPtrs.listingID = &listingID
+	mmAddFavorite.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmAddFavorite
 }
 
-// Return sets up results that will be returned by Repository.CreateListing
-func (mmCreateListing *mRepositoryMockCreateListing) Return(lp1 *entity.Listing, err error) *RepositoryMock {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+// ExpectAlertPriceParam4 sets up expected param alertPrice for Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) ExpectAlertPriceParam4(alertPrice *entity.Money) *mRepositoryMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{mock: mmCreateListing.mock}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &RepositoryMockAddFavoriteExpectation{}
 	}
-	mmCreateListing.defaultExpectation.results = &RepositoryMockCreateListingResults{lp1, err}
-	mmCreateListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateListing.mock
+
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Expect")
+	}
+
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &RepositoryMockAddFavoriteParamPtrs{}
+	}
+	mmAddFavorite.defaultExpectation.paramPtrs.alertPrice = &alertPrice
+	mmAddFavorite.defaultExpectation.expectationOrigins.originAlertPrice = minimock.CallerInfo(1)
+
+	return mmAddFavorite
 }
 
-// Set uses given function f to mock the Repository.CreateListing method
-func (mmCreateListing *mRepositoryMockCreateListing) Set(f func(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error)) *RepositoryMock {
-	if mmCreateListing.defaultExpectation != nil {
-		mmCreateListing.mock.t.Fatalf("Default expectation is already set for the Repository.CreateListing method")
+// Inspect accepts an inspector function that has same arguments as the Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) Inspect(f func(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money)) *mRepositoryMockAddFavorite {
+	if mmAddFavorite.mock.inspectFuncAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("Inspect function is already set for RepositoryMock.AddFavorite")
 	}
 
-	if len(mmCreateListing.expectations) > 0 {
-		mmCreateListing.mock.t.Fatalf("Some expectations are already set for the Repository.CreateListing method")
+	mmAddFavorite.mock.inspectFuncAddFavorite = f
+
+	return mmAddFavorite
+}
+
+// Return sets up results that will be returned by Repository.AddFavorite
+func (mmAddFavorite *mRepositoryMockAddFavorite) Return(fp1 *entity.FavoriteState, err error) *RepositoryMock {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	mmCreateListing.mock.funcCreateListing = f
-	mmCreateListing.mock.funcCreateListingOrigin = minimock.CallerInfo(1)
-	return mmCreateListing.mock
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &RepositoryMockAddFavoriteExpectation{mock: mmAddFavorite.mock}
+	}
+	mmAddFavorite.defaultExpectation.results = &RepositoryMockAddFavoriteResults{fp1, err}
+	mmAddFavorite.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddFavorite.mock
 }
 
-// When sets expectation for the Repository.CreateListing which will trigger the result defined by the following
+// Set uses given function f to mock the Repository.AddFavorite method
+func (mmAddFavorite *mRepositoryMockAddFavorite) Set(f func(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money) (fp1 *entity.FavoriteState, err error)) *RepositoryMock {
+	if mmAddFavorite.defaultExpectation != nil {
+		mmAddFavorite.mock.t.Fatalf("Default expectation is already set for the Repository.AddFavorite method")
+	}
+
+	if len(mmAddFavorite.expectations) > 0 {
+		mmAddFavorite.mock.t.Fatalf("Some expectations are already set for the Repository.AddFavorite method")
+	}
+
+	mmAddFavorite.mock.funcAddFavorite = f
+	mmAddFavorite.mock.funcAddFavoriteOrigin = minimock.CallerInfo(1)
+	return mmAddFavorite.mock
+}
+
+// When sets expectation for the Repository.AddFavorite which will trigger the result defined by the following
 // Then helper
-func (mmCreateListing *mRepositoryMockCreateListing) When(ctx context.Context, listing *entity.Listing) *RepositoryMockCreateListingExpectation {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+func (mmAddFavorite *mRepositoryMockAddFavorite) When(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money) *RepositoryMockAddFavoriteExpectation {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("RepositoryMock.AddFavorite mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockCreateListingExpectation{
-		mock:               mmCreateListing.mock,
-		params:             &RepositoryMockCreateListingParams{ctx, listing},
-		expectationOrigins: RepositoryMockCreateListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockAddFavoriteExpectation{
+		mock:               mmAddFavorite.mock,
+		params:             &RepositoryMockAddFavoriteParams{ctx, userID, listingID, alertPrice},
+		expectationOrigins: RepositoryMockAddFavoriteExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateListing.expectations = append(mmCreateListing.expectations, expectation)
+	mmAddFavorite.expectations = append(mmAddFavorite.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.CreateListing return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockCreateListingExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
-	e.results = &RepositoryMockCreateListingResults{lp1, err}
+// Then sets up Repository.AddFavorite return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddFavoriteExpectation) Then(fp1 *entity.FavoriteState, err error) *RepositoryMock {
+	e.results = &RepositoryMockAddFavoriteResults{fp1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.CreateListing should be invoked
-func (mmCreateListing *mRepositoryMockCreateListing) Times(n uint64) *mRepositoryMockCreateListing {
+// Times sets number of times Repository.AddFavorite should be invoked
+func (mmAddFavorite *mRepositoryMockAddFavorite) Times(n uint64) *mRepositoryMockAddFavorite {
 	if n == 0 {
-		mmCreateListing.mock.t.Fatalf("Times of RepositoryMock.CreateListing mock can not be zero")
+		mmAddFavorite.mock.t.Fatalf("Times of RepositoryMock.AddFavorite mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateListing.expectedInvocations, n)
-	mmCreateListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateListing
+	mm_atomic.StoreUint64(&mmAddFavorite.expectedInvocations, n)
+	mmAddFavorite.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddFavorite
 }
 
-func (mmCreateListing *mRepositoryMockCreateListing) invocationsDone() bool {
-	if len(mmCreateListing.expectations) == 0 && mmCreateListing.defaultExpectation == nil && mmCreateListing.mock.funcCreateListing == nil {
+func (mmAddFavorite *mRepositoryMockAddFavorite) invocationsDone() bool {
+	if len(mmAddFavorite.expectations) == 0 && mmAddFavorite.defaultExpectation == nil && mmAddFavorite.mock.funcAddFavorite == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateListing.mock.afterCreateListingCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateListing.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddFavorite.mock.afterAddFavoriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddFavorite.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateListing implements mm_listing.Repository
-func (mmCreateListing *RepositoryMock) CreateListing(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error) {
-	mm_atomic.AddUint64(&mmCreateListing.beforeCreateListingCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateListing.afterCreateListingCounter, 1)
+// AddFavorite implements mm_listing.Repository
+func (mmAddFavorite *RepositoryMock) AddFavorite(ctx context.Context, userID uint64, listingID uint64, alertPrice *entity.Money) (fp1 *entity.FavoriteState, err error) {
+	mm_atomic.AddUint64(&mmAddFavorite.beforeAddFavoriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddFavorite.afterAddFavoriteCounter, 1)
 
-	mmCreateListing.t.Helper()
+	mmAddFavorite.t.Helper()
+
+	if mmAddFavorite.inspectFuncAddFavorite != nil {
+		mmAddFavorite.inspectFuncAddFavorite(ctx, userID, listingID, alertPrice)
+	}
+
+	mm_params := RepositoryMockAddFavoriteParams{ctx, userID, listingID, alertPrice}
+
+	// Record call args
+	mmAddFavorite.AddFavoriteMock.mutex.Lock()
+	mmAddFavorite.AddFavoriteMock.callArgs = append(mmAddFavorite.AddFavoriteMock.callArgs, &mm_params)
+	mmAddFavorite.AddFavoriteMock.mutex.Unlock()
+
+	for _, e := range mmAddFavorite.AddFavoriteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmAddFavorite.AddFavoriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddFavorite.AddFavoriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddFavorite.AddFavoriteMock.defaultExpectation.params
+		mm_want_ptrs := mmAddFavorite.AddFavoriteMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAddFavoriteParams{ctx, userID, listingID, alertPrice}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddFavorite.t.Errorf("RepositoryMock.AddFavorite got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddFavorite.t.Errorf("RepositoryMock.AddFavorite got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmAddFavorite.t.Errorf("RepositoryMock.AddFavorite got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+			if mm_want_ptrs.alertPrice != nil && !minimock.Equal(*mm_want_ptrs.alertPrice, mm_got.alertPrice) {
+				mmAddFavorite.t.Errorf("RepositoryMock.AddFavorite got unexpected parameter alertPrice, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originAlertPrice, *mm_want_ptrs.alertPrice, mm_got.alertPrice, minimock.Diff(*mm_want_ptrs.alertPrice, mm_got.alertPrice))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddFavorite.t.Errorf("RepositoryMock.AddFavorite got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddFavorite.AddFavoriteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddFavorite.t.Fatal("No results are set for the RepositoryMock.AddFavorite")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmAddFavorite.funcAddFavorite != nil {
+		return mmAddFavorite.funcAddFavorite(ctx, userID, listingID, alertPrice)
+	}
+	mmAddFavorite.t.Fatalf("Unexpected call to RepositoryMock.AddFavorite. %v %v %v %v", ctx, userID, listingID, alertPrice)
+	return
+}
+
+// AddFavoriteAfterCounter returns a count of finished RepositoryMock.AddFavorite invocations
+func (mmAddFavorite *RepositoryMock) AddFavoriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddFavorite.afterAddFavoriteCounter)
+}
+
+// AddFavoriteBeforeCounter returns a count of RepositoryMock.AddFavorite invocations
+func (mmAddFavorite *RepositoryMock) AddFavoriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddFavorite.beforeAddFavoriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.AddFavorite.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddFavorite *mRepositoryMockAddFavorite) Calls() []*RepositoryMockAddFavoriteParams {
+	mmAddFavorite.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAddFavoriteParams, len(mmAddFavorite.callArgs))
+	copy(argCopy, mmAddFavorite.callArgs)
+
+	mmAddFavorite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddFavoriteDone returns true if the count of the AddFavorite invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAddFavoriteDone() bool {
+	if m.AddFavoriteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddFavoriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddFavoriteMock.invocationsDone()
+}
+
+// MinimockAddFavoriteInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAddFavoriteInspect() {
+	for _, e := range m.AddFavoriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.AddFavorite at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddFavoriteCounter := mm_atomic.LoadUint64(&m.afterAddFavoriteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddFavoriteMock.defaultExpectation != nil && afterAddFavoriteCounter < 1 {
+		if m.AddFavoriteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.AddFavorite at\n%s", m.AddFavoriteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.AddFavorite at\n%s with params: %#v", m.AddFavoriteMock.defaultExpectation.expectationOrigins.origin, *m.AddFavoriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddFavorite != nil && afterAddFavoriteCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.AddFavorite at\n%s", m.funcAddFavoriteOrigin)
+	}
+
+	if !m.AddFavoriteMock.invocationsDone() && afterAddFavoriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.AddFavorite at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddFavoriteMock.expectedInvocations), m.AddFavoriteMock.expectedInvocationsOrigin, afterAddFavoriteCounter)
+	}
+}
+
+type mRepositoryMockAddListingImages struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAddListingImagesExpectation
+	expectations       []*RepositoryMockAddListingImagesExpectation
+
+	callArgs []*RepositoryMockAddListingImagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAddListingImagesExpectation specifies expectation struct of the Repository.AddListingImages
+type RepositoryMockAddListingImagesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAddListingImagesParams
+	paramPtrs          *RepositoryMockAddListingImagesParamPtrs
+	expectationOrigins RepositoryMockAddListingImagesExpectationOrigins
+	results            *RepositoryMockAddListingImagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAddListingImagesParams contains parameters of the Repository.AddListingImages
+type RepositoryMockAddListingImagesParams struct {
+	ctx       context.Context
+	listingID uint64
+	urls      []string
+	maxImages int
+}
+
+// RepositoryMockAddListingImagesParamPtrs contains pointers to parameters of the Repository.AddListingImages
+type RepositoryMockAddListingImagesParamPtrs struct {
+	ctx       *context.Context
+	listingID *uint64
+	urls      *[]string
+	maxImages *int
+}
+
+// RepositoryMockAddListingImagesResults contains results of the Repository.AddListingImages
+type RepositoryMockAddListingImagesResults struct {
+	lpa1 []*entity.ListingImage
+	err  error
+}
+
+// RepositoryMockAddListingImagesOrigins contains origins of expectations of the Repository.AddListingImages
+type RepositoryMockAddListingImagesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originListingID string
+	originUrls      string
+	originMaxImages string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddListingImages *mRepositoryMockAddListingImages) Optional() *mRepositoryMockAddListingImages {
+	mmAddListingImages.optional = true
+	return mmAddListingImages
+}
+
+// Expect sets up expected params for Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) Expect(ctx context.Context, listingID uint64, urls []string, maxImages int) *mRepositoryMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &RepositoryMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by ExpectParams functions")
+	}
+
+	mmAddListingImages.defaultExpectation.params = &RepositoryMockAddListingImagesParams{ctx, listingID, urls, maxImages}
+	mmAddListingImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddListingImages.expectations {
+		if minimock.Equal(e.params, mmAddListingImages.defaultExpectation.params) {
+			mmAddListingImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddListingImages.defaultExpectation.params)
+		}
+	}
+
+	return mmAddListingImages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &RepositoryMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &RepositoryMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddListingImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// ExpectListingIDParam2 sets up expected param listingID for Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) ExpectListingIDParam2(listingID uint64) *mRepositoryMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &RepositoryMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &RepositoryMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.listingID = &listingID
+	mmAddListingImages.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// ExpectUrlsParam3 sets up expected param urls for Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) ExpectUrlsParam3(urls []string) *mRepositoryMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &RepositoryMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &RepositoryMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.urls = &urls
+	mmAddListingImages.defaultExpectation.expectationOrigins.originUrls = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// ExpectMaxImagesParam4 sets up expected param maxImages for Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) ExpectMaxImagesParam4(maxImages int) *mRepositoryMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &RepositoryMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &RepositoryMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.maxImages = &maxImages
+	mmAddListingImages.defaultExpectation.expectationOrigins.originMaxImages = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) Inspect(f func(ctx context.Context, listingID uint64, urls []string, maxImages int)) *mRepositoryMockAddListingImages {
+	if mmAddListingImages.mock.inspectFuncAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("Inspect function is already set for RepositoryMock.AddListingImages")
+	}
+
+	mmAddListingImages.mock.inspectFuncAddListingImages = f
+
+	return mmAddListingImages
+}
+
+// Return sets up results that will be returned by Repository.AddListingImages
+func (mmAddListingImages *mRepositoryMockAddListingImages) Return(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &RepositoryMockAddListingImagesExpectation{mock: mmAddListingImages.mock}
+	}
+	mmAddListingImages.defaultExpectation.results = &RepositoryMockAddListingImagesResults{lpa1, err}
+	mmAddListingImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddListingImages.mock
+}
+
+// Set uses given function f to mock the Repository.AddListingImages method
+func (mmAddListingImages *mRepositoryMockAddListingImages) Set(f func(ctx context.Context, listingID uint64, urls []string, maxImages int) (lpa1 []*entity.ListingImage, err error)) *RepositoryMock {
+	if mmAddListingImages.defaultExpectation != nil {
+		mmAddListingImages.mock.t.Fatalf("Default expectation is already set for the Repository.AddListingImages method")
+	}
+
+	if len(mmAddListingImages.expectations) > 0 {
+		mmAddListingImages.mock.t.Fatalf("Some expectations are already set for the Repository.AddListingImages method")
+	}
+
+	mmAddListingImages.mock.funcAddListingImages = f
+	mmAddListingImages.mock.funcAddListingImagesOrigin = minimock.CallerInfo(1)
+	return mmAddListingImages.mock
+}
+
+// When sets expectation for the Repository.AddListingImages which will trigger the result defined by the following
+// Then helper
+func (mmAddListingImages *mRepositoryMockAddListingImages) When(ctx context.Context, listingID uint64, urls []string, maxImages int) *RepositoryMockAddListingImagesExpectation {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("RepositoryMock.AddListingImages mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAddListingImagesExpectation{
+		mock:               mmAddListingImages.mock,
+		params:             &RepositoryMockAddListingImagesParams{ctx, listingID, urls, maxImages},
+		expectationOrigins: RepositoryMockAddListingImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddListingImages.expectations = append(mmAddListingImages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.AddListingImages return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddListingImagesExpectation) Then(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	e.results = &RepositoryMockAddListingImagesResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.AddListingImages should be invoked
+func (mmAddListingImages *mRepositoryMockAddListingImages) Times(n uint64) *mRepositoryMockAddListingImages {
+	if n == 0 {
+		mmAddListingImages.mock.t.Fatalf("Times of RepositoryMock.AddListingImages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddListingImages.expectedInvocations, n)
+	mmAddListingImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddListingImages
+}
+
+func (mmAddListingImages *mRepositoryMockAddListingImages) invocationsDone() bool {
+	if len(mmAddListingImages.expectations) == 0 && mmAddListingImages.defaultExpectation == nil && mmAddListingImages.mock.funcAddListingImages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddListingImages.mock.afterAddListingImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddListingImages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddListingImages implements mm_listing.Repository
+func (mmAddListingImages *RepositoryMock) AddListingImages(ctx context.Context, listingID uint64, urls []string, maxImages int) (lpa1 []*entity.ListingImage, err error) {
+	mm_atomic.AddUint64(&mmAddListingImages.beforeAddListingImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddListingImages.afterAddListingImagesCounter, 1)
+
+	mmAddListingImages.t.Helper()
+
+	if mmAddListingImages.inspectFuncAddListingImages != nil {
+		mmAddListingImages.inspectFuncAddListingImages(ctx, listingID, urls, maxImages)
+	}
+
+	mm_params := RepositoryMockAddListingImagesParams{ctx, listingID, urls, maxImages}
+
+	// Record call args
+	mmAddListingImages.AddListingImagesMock.mutex.Lock()
+	mmAddListingImages.AddListingImagesMock.callArgs = append(mmAddListingImages.AddListingImagesMock.callArgs, &mm_params)
+	mmAddListingImages.AddListingImagesMock.mutex.Unlock()
+
+	for _, e := range mmAddListingImages.AddListingImagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmAddListingImages.AddListingImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddListingImages.AddListingImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddListingImages.AddListingImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmAddListingImages.AddListingImagesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAddListingImagesParams{ctx, listingID, urls, maxImages}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddListingImages.t.Errorf("RepositoryMock.AddListingImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmAddListingImages.t.Errorf("RepositoryMock.AddListingImages got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+			if mm_want_ptrs.urls != nil && !minimock.Equal(*mm_want_ptrs.urls, mm_got.urls) {
+				mmAddListingImages.t.Errorf("RepositoryMock.AddListingImages got unexpected parameter urls, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originUrls, *mm_want_ptrs.urls, mm_got.urls, minimock.Diff(*mm_want_ptrs.urls, mm_got.urls))
+			}
+
+			if mm_want_ptrs.maxImages != nil && !minimock.Equal(*mm_want_ptrs.maxImages, mm_got.maxImages) {
+				mmAddListingImages.t.Errorf("RepositoryMock.AddListingImages got unexpected parameter maxImages, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originMaxImages, *mm_want_ptrs.maxImages, mm_got.maxImages, minimock.Diff(*mm_want_ptrs.maxImages, mm_got.maxImages))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddListingImages.t.Errorf("RepositoryMock.AddListingImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddListingImages.AddListingImagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddListingImages.t.Fatal("No results are set for the RepositoryMock.AddListingImages")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmAddListingImages.funcAddListingImages != nil {
+		return mmAddListingImages.funcAddListingImages(ctx, listingID, urls, maxImages)
+	}
+	mmAddListingImages.t.Fatalf("Unexpected call to RepositoryMock.AddListingImages. %v %v %v %v", ctx, listingID, urls, maxImages)
+	return
+}
+
+// AddListingImagesAfterCounter returns a count of finished RepositoryMock.AddListingImages invocations
+func (mmAddListingImages *RepositoryMock) AddListingImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddListingImages.afterAddListingImagesCounter)
+}
+
+// AddListingImagesBeforeCounter returns a count of RepositoryMock.AddListingImages invocations
+func (mmAddListingImages *RepositoryMock) AddListingImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddListingImages.beforeAddListingImagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.AddListingImages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddListingImages *mRepositoryMockAddListingImages) Calls() []*RepositoryMockAddListingImagesParams {
+	mmAddListingImages.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAddListingImagesParams, len(mmAddListingImages.callArgs))
+	copy(argCopy, mmAddListingImages.callArgs)
+
+	mmAddListingImages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddListingImagesDone returns true if the count of the AddListingImages invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAddListingImagesDone() bool {
+	if m.AddListingImagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddListingImagesMock.invocationsDone()
+}
+
+// MinimockAddListingImagesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAddListingImagesInspect() {
+	for _, e := range m.AddListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.AddListingImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddListingImagesCounter := mm_atomic.LoadUint64(&m.afterAddListingImagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddListingImagesMock.defaultExpectation != nil && afterAddListingImagesCounter < 1 {
+		if m.AddListingImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.AddListingImages at\n%s", m.AddListingImagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.AddListingImages at\n%s with params: %#v", m.AddListingImagesMock.defaultExpectation.expectationOrigins.origin, *m.AddListingImagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddListingImages != nil && afterAddListingImagesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.AddListingImages at\n%s", m.funcAddListingImagesOrigin)
+	}
+
+	if !m.AddListingImagesMock.invocationsDone() && afterAddListingImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.AddListingImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddListingImagesMock.expectedInvocations), m.AddListingImagesMock.expectedInvocationsOrigin, afterAddListingImagesCounter)
+	}
+}
+
+type mRepositoryMockCreateListing struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateListingExpectation
+	expectations       []*RepositoryMockCreateListingExpectation
+
+	callArgs []*RepositoryMockCreateListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateListingExpectation specifies expectation struct of the Repository.CreateListing
+type RepositoryMockCreateListingExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateListingParams
+	paramPtrs          *RepositoryMockCreateListingParamPtrs
+	expectationOrigins RepositoryMockCreateListingExpectationOrigins
+	results            *RepositoryMockCreateListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateListingParams contains parameters of the Repository.CreateListing
+type RepositoryMockCreateListingParams struct {
+	ctx     context.Context
+	listing *entity.Listing
+}
+
+// RepositoryMockCreateListingParamPtrs contains pointers to parameters of the Repository.CreateListing
+type RepositoryMockCreateListingParamPtrs struct {
+	ctx     *context.Context
+	listing **entity.Listing
+}
+
+// RepositoryMockCreateListingResults contains results of the Repository.CreateListing
+type RepositoryMockCreateListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// RepositoryMockCreateListingOrigins contains origins of expectations of the Repository.CreateListing
+type RepositoryMockCreateListingExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originListing string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateListing *mRepositoryMockCreateListing) Optional() *mRepositoryMockCreateListing {
+	mmCreateListing.optional = true
+	return mmCreateListing
+}
+
+// Expect sets up expected params for Repository.CreateListing
+func (mmCreateListing *mRepositoryMockCreateListing) Expect(ctx context.Context, listing *entity.Listing) *mRepositoryMockCreateListing {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{}
+	}
+
+	if mmCreateListing.defaultExpectation.paramPtrs != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by ExpectParams functions")
+	}
+
+	mmCreateListing.defaultExpectation.params = &RepositoryMockCreateListingParams{ctx, listing}
+	mmCreateListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateListing.expectations {
+		if minimock.Equal(e.params, mmCreateListing.defaultExpectation.params) {
+			mmCreateListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateListing.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateListing
+func (mmCreateListing *mRepositoryMockCreateListing) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateListing {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{}
+	}
+
+	if mmCreateListing.defaultExpectation.params != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Expect")
+	}
+
+	if mmCreateListing.defaultExpectation.paramPtrs == nil {
+		mmCreateListing.defaultExpectation.paramPtrs = &RepositoryMockCreateListingParamPtrs{}
+	}
+	mmCreateListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateListing
+}
+
+// ExpectListingParam2 sets up expected param listing for Repository.CreateListing
+func (mmCreateListing *mRepositoryMockCreateListing) ExpectListingParam2(listing *entity.Listing) *mRepositoryMockCreateListing {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{}
+	}
+
+	if mmCreateListing.defaultExpectation.params != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Expect")
+	}
+
+	if mmCreateListing.defaultExpectation.paramPtrs == nil {
+		mmCreateListing.defaultExpectation.paramPtrs = &RepositoryMockCreateListingParamPtrs{}
+	}
+	mmCreateListing.defaultExpectation.paramPtrs.listing = &listing
+	mmCreateListing.defaultExpectation.expectationOrigins.originListing = minimock.CallerInfo(1)
+
+	return mmCreateListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateListing
+func (mmCreateListing *mRepositoryMockCreateListing) Inspect(f func(ctx context.Context, listing *entity.Listing)) *mRepositoryMockCreateListing {
+	if mmCreateListing.mock.inspectFuncCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateListing")
+	}
+
+	mmCreateListing.mock.inspectFuncCreateListing = f
+
+	return mmCreateListing
+}
+
+// Return sets up results that will be returned by Repository.CreateListing
+func (mmCreateListing *mRepositoryMockCreateListing) Return(lp1 *entity.Listing, err error) *RepositoryMock {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &RepositoryMockCreateListingExpectation{mock: mmCreateListing.mock}
+	}
+	mmCreateListing.defaultExpectation.results = &RepositoryMockCreateListingResults{lp1, err}
+	mmCreateListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateListing.mock
+}
+
+// Set uses given function f to mock the Repository.CreateListing method
+func (mmCreateListing *mRepositoryMockCreateListing) Set(f func(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error)) *RepositoryMock {
+	if mmCreateListing.defaultExpectation != nil {
+		mmCreateListing.mock.t.Fatalf("Default expectation is already set for the Repository.CreateListing method")
+	}
+
+	if len(mmCreateListing.expectations) > 0 {
+		mmCreateListing.mock.t.Fatalf("Some expectations are already set for the Repository.CreateListing method")
+	}
+
+	mmCreateListing.mock.funcCreateListing = f
+	mmCreateListing.mock.funcCreateListingOrigin = minimock.CallerInfo(1)
+	return mmCreateListing.mock
+}
+
+// When sets expectation for the Repository.CreateListing which will trigger the result defined by the following
+// Then helper
+func (mmCreateListing *mRepositoryMockCreateListing) When(ctx context.Context, listing *entity.Listing) *RepositoryMockCreateListingExpectation {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("RepositoryMock.CreateListing mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateListingExpectation{
+		mock:               mmCreateListing.mock,
+		params:             &RepositoryMockCreateListingParams{ctx, listing},
+		expectationOrigins: RepositoryMockCreateListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateListing.expectations = append(mmCreateListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreateListing return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateListingExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreateListing should be invoked
+func (mmCreateListing *mRepositoryMockCreateListing) Times(n uint64) *mRepositoryMockCreateListing {
+	if n == 0 {
+		mmCreateListing.mock.t.Fatalf("Times of RepositoryMock.CreateListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateListing.expectedInvocations, n)
+	mmCreateListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateListing
+}
+
+func (mmCreateListing *mRepositoryMockCreateListing) invocationsDone() bool {
+	if len(mmCreateListing.expectations) == 0 && mmCreateListing.defaultExpectation == nil && mmCreateListing.mock.funcCreateListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateListing.mock.afterCreateListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateListing implements mm_listing.Repository
+func (mmCreateListing *RepositoryMock) CreateListing(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmCreateListing.beforeCreateListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateListing.afterCreateListingCounter, 1)
+
+	mmCreateListing.t.Helper()
+
+	if mmCreateListing.inspectFuncCreateListing != nil {
+		mmCreateListing.inspectFuncCreateListing(ctx, listing)
+	}
+
+	mm_params := RepositoryMockCreateListingParams{ctx, listing}
+
+	// Record call args
+	mmCreateListing.CreateListingMock.mutex.Lock()
+	mmCreateListing.CreateListingMock.callArgs = append(mmCreateListing.CreateListingMock.callArgs, &mm_params)
+	mmCreateListing.CreateListingMock.mutex.Unlock()
+
+	for _, e := range mmCreateListing.CreateListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmCreateListing.CreateListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateListing.CreateListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateListing.CreateListingMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateListing.CreateListingMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateListingParams{ctx, listing}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateListing.t.Errorf("RepositoryMock.CreateListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listing != nil && !minimock.Equal(*mm_want_ptrs.listing, mm_got.listing) {
+				mmCreateListing.t.Errorf("RepositoryMock.CreateListing got unexpected parameter listing, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originListing, *mm_want_ptrs.listing, mm_got.listing, minimock.Diff(*mm_want_ptrs.listing, mm_got.listing))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateListing.t.Errorf("RepositoryMock.CreateListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateListing.CreateListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateListing.t.Fatal("No results are set for the RepositoryMock.CreateListing")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmCreateListing.funcCreateListing != nil {
+		return mmCreateListing.funcCreateListing(ctx, listing)
+	}
+	mmCreateListing.t.Fatalf("Unexpected call to RepositoryMock.CreateListing. %v %v", ctx, listing)
+	return
+}
+
+// CreateListingAfterCounter returns a count of finished RepositoryMock.CreateListing invocations
+func (mmCreateListing *RepositoryMock) CreateListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateListing.afterCreateListingCounter)
+}
+
+// CreateListingBeforeCounter returns a count of RepositoryMock.CreateListing invocations
+func (mmCreateListing *RepositoryMock) CreateListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateListing.beforeCreateListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateListing *mRepositoryMockCreateListing) Calls() []*RepositoryMockCreateListingParams {
+	mmCreateListing.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateListingParams, len(mmCreateListing.callArgs))
+	copy(argCopy, mmCreateListing.callArgs)
+
+	mmCreateListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateListingDone returns true if the count of the CreateListing invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateListingDone() bool {
+	if m.CreateListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateListingMock.invocationsDone()
+}
+
+// MinimockCreateListingInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateListingInspect() {
+	for _, e := range m.CreateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateListingCounter := mm_atomic.LoadUint64(&m.afterCreateListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateListingMock.defaultExpectation != nil && afterCreateListingCounter < 1 {
+		if m.CreateListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s", m.CreateListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s with params: %#v", m.CreateListingMock.defaultExpectation.expectationOrigins.origin, *m.CreateListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateListing != nil && afterCreateListingCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s", m.funcCreateListingOrigin)
+	}
+
+	if !m.CreateListingMock.invocationsDone() && afterCreateListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateListingMock.expectedInvocations), m.CreateListingMock.expectedInvocationsOrigin, afterCreateListingCounter)
+	}
+}
+
+type mRepositoryMockCreateSavedSearch struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateSavedSearchExpectation
+	expectations       []*RepositoryMockCreateSavedSearchExpectation
+
+	callArgs []*RepositoryMockCreateSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateSavedSearchExpectation specifies expectation struct of the Repository.CreateSavedSearch
+type RepositoryMockCreateSavedSearchExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateSavedSearchParams
+	paramPtrs          *RepositoryMockCreateSavedSearchParamPtrs
+	expectationOrigins RepositoryMockCreateSavedSearchExpectationOrigins
+	results            *RepositoryMockCreateSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateSavedSearchParams contains parameters of the Repository.CreateSavedSearch
+type RepositoryMockCreateSavedSearchParams struct {
+	ctx        context.Context
+	search     *entity.SavedSearch
+	maxPerUser int
+}
+
+// RepositoryMockCreateSavedSearchParamPtrs contains pointers to parameters of the Repository.CreateSavedSearch
+type RepositoryMockCreateSavedSearchParamPtrs struct {
+	ctx        *context.Context
+	search     **entity.SavedSearch
+	maxPerUser *int
+}
+
+// RepositoryMockCreateSavedSearchResults contains results of the Repository.CreateSavedSearch
+type RepositoryMockCreateSavedSearchResults struct {
+	sp1 *entity.SavedSearch
+	err error
+}
+
+// RepositoryMockCreateSavedSearchOrigins contains origins of expectations of the Repository.CreateSavedSearch
+type RepositoryMockCreateSavedSearchExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originSearch     string
+	originMaxPerUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Optional() *mRepositoryMockCreateSavedSearch {
+	mmCreateSavedSearch.optional = true
+	return mmCreateSavedSearch
+}
+
+// Expect sets up expected params for Repository.CreateSavedSearch
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Expect(ctx context.Context, search *entity.SavedSearch, maxPerUser int) *mRepositoryMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &RepositoryMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSavedSearch.defaultExpectation.params = &RepositoryMockCreateSavedSearchParams{ctx, search, maxPerUser}
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSavedSearch.expectations {
+		if minimock.Equal(e.params, mmCreateSavedSearch.defaultExpectation.params) {
+			mmCreateSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSavedSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSavedSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateSavedSearch
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &RepositoryMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.params != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Expect")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmCreateSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockCreateSavedSearchParamPtrs{}
+	}
+	mmCreateSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSavedSearch
+}
+
+// ExpectSearchParam2 sets up expected param search for Repository.CreateSavedSearch
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) ExpectSearchParam2(search *entity.SavedSearch) *mRepositoryMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &RepositoryMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.params != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Expect")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmCreateSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockCreateSavedSearchParamPtrs{}
+	}
+	mmCreateSavedSearch.defaultExpectation.paramPtrs.search = &search
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.originSearch = minimock.CallerInfo(1)
+
+	return mmCreateSavedSearch
+}
+
+// ExpectMaxPerUserParam3 sets up expected param maxPerUser for Repository.CreateSavedSearch
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) ExpectMaxPerUserParam3(maxPerUser int) *mRepositoryMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &RepositoryMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.params != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Expect")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmCreateSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockCreateSavedSearchParamPtrs{}
+	}
+	mmCreateSavedSearch.defaultExpectation.paramPtrs.maxPerUser = &maxPerUser
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.originMaxPerUser = minimock.CallerInfo(1)
+
+	return mmCreateSavedSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateSavedSearch
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Inspect(f func(ctx context.Context, search *entity.SavedSearch, maxPerUser int)) *mRepositoryMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.inspectFuncCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateSavedSearch")
+	}
+
+	mmCreateSavedSearch.mock.inspectFuncCreateSavedSearch = f
+
+	return mmCreateSavedSearch
+}
+
+// Return sets up results that will be returned by Repository.CreateSavedSearch
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Return(sp1 *entity.SavedSearch, err error) *RepositoryMock {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &RepositoryMockCreateSavedSearchExpectation{mock: mmCreateSavedSearch.mock}
+	}
+	mmCreateSavedSearch.defaultExpectation.results = &RepositoryMockCreateSavedSearchResults{sp1, err}
+	mmCreateSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSavedSearch.mock
+}
+
+// Set uses given function f to mock the Repository.CreateSavedSearch method
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Set(f func(ctx context.Context, search *entity.SavedSearch, maxPerUser int) (sp1 *entity.SavedSearch, err error)) *RepositoryMock {
+	if mmCreateSavedSearch.defaultExpectation != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("Default expectation is already set for the Repository.CreateSavedSearch method")
+	}
+
+	if len(mmCreateSavedSearch.expectations) > 0 {
+		mmCreateSavedSearch.mock.t.Fatalf("Some expectations are already set for the Repository.CreateSavedSearch method")
+	}
+
+	mmCreateSavedSearch.mock.funcCreateSavedSearch = f
+	mmCreateSavedSearch.mock.funcCreateSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmCreateSavedSearch.mock
+}
+
+// When sets expectation for the Repository.CreateSavedSearch which will trigger the result defined by the following
+// Then helper
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) When(ctx context.Context, search *entity.SavedSearch, maxPerUser int) *RepositoryMockCreateSavedSearchExpectation {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("RepositoryMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateSavedSearchExpectation{
+		mock:               mmCreateSavedSearch.mock,
+		params:             &RepositoryMockCreateSavedSearchParams{ctx, search, maxPerUser},
+		expectationOrigins: RepositoryMockCreateSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSavedSearch.expectations = append(mmCreateSavedSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreateSavedSearch return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateSavedSearchExpectation) Then(sp1 *entity.SavedSearch, err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateSavedSearchResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreateSavedSearch should be invoked
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Times(n uint64) *mRepositoryMockCreateSavedSearch {
+	if n == 0 {
+		mmCreateSavedSearch.mock.t.Fatalf("Times of RepositoryMock.CreateSavedSearch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSavedSearch.expectedInvocations, n)
+	mmCreateSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSavedSearch
+}
+
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) invocationsDone() bool {
+	if len(mmCreateSavedSearch.expectations) == 0 && mmCreateSavedSearch.defaultExpectation == nil && mmCreateSavedSearch.mock.funcCreateSavedSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSavedSearch.mock.afterCreateSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSavedSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSavedSearch implements mm_listing.Repository
+func (mmCreateSavedSearch *RepositoryMock) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch, maxPerUser int) (sp1 *entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmCreateSavedSearch.beforeCreateSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSavedSearch.afterCreateSavedSearchCounter, 1)
+
+	mmCreateSavedSearch.t.Helper()
+
+	if mmCreateSavedSearch.inspectFuncCreateSavedSearch != nil {
+		mmCreateSavedSearch.inspectFuncCreateSavedSearch(ctx, search, maxPerUser)
+	}
+
+	mm_params := RepositoryMockCreateSavedSearchParams{ctx, search, maxPerUser}
+
+	// Record call args
+	mmCreateSavedSearch.CreateSavedSearchMock.mutex.Lock()
+	mmCreateSavedSearch.CreateSavedSearchMock.callArgs = append(mmCreateSavedSearch.CreateSavedSearchMock.callArgs, &mm_params)
+	mmCreateSavedSearch.CreateSavedSearchMock.mutex.Unlock()
+
+	for _, e := range mmCreateSavedSearch.CreateSavedSearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateSavedSearchParams{ctx, search, maxPerUser}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSavedSearch.t.Errorf("RepositoryMock.CreateSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.search != nil && !minimock.Equal(*mm_want_ptrs.search, mm_got.search) {
+				mmCreateSavedSearch.t.Errorf("RepositoryMock.CreateSavedSearch got unexpected parameter search, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.originSearch, *mm_want_ptrs.search, mm_got.search, minimock.Diff(*mm_want_ptrs.search, mm_got.search))
+			}
+
+			if mm_want_ptrs.maxPerUser != nil && !minimock.Equal(*mm_want_ptrs.maxPerUser, mm_got.maxPerUser) {
+				mmCreateSavedSearch.t.Errorf("RepositoryMock.CreateSavedSearch got unexpected parameter maxPerUser, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.originMaxPerUser, *mm_want_ptrs.maxPerUser, mm_got.maxPerUser, minimock.Diff(*mm_want_ptrs.maxPerUser, mm_got.maxPerUser))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSavedSearch.t.Errorf("RepositoryMock.CreateSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSavedSearch.t.Fatal("No results are set for the RepositoryMock.CreateSavedSearch")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmCreateSavedSearch.funcCreateSavedSearch != nil {
+		return mmCreateSavedSearch.funcCreateSavedSearch(ctx, search, maxPerUser)
+	}
+	mmCreateSavedSearch.t.Fatalf("Unexpected call to RepositoryMock.CreateSavedSearch. %v %v %v", ctx, search, maxPerUser)
+	return
+}
+
+// CreateSavedSearchAfterCounter returns a count of finished RepositoryMock.CreateSavedSearch invocations
+func (mmCreateSavedSearch *RepositoryMock) CreateSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSavedSearch.afterCreateSavedSearchCounter)
+}
+
+// CreateSavedSearchBeforeCounter returns a count of RepositoryMock.CreateSavedSearch invocations
+func (mmCreateSavedSearch *RepositoryMock) CreateSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSavedSearch.beforeCreateSavedSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateSavedSearch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSavedSearch *mRepositoryMockCreateSavedSearch) Calls() []*RepositoryMockCreateSavedSearchParams {
+	mmCreateSavedSearch.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateSavedSearchParams, len(mmCreateSavedSearch.callArgs))
+	copy(argCopy, mmCreateSavedSearch.callArgs)
+
+	mmCreateSavedSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSavedSearchDone returns true if the count of the CreateSavedSearch invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateSavedSearchDone() bool {
+	if m.CreateSavedSearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSavedSearchMock.invocationsDone()
+}
+
+// MinimockCreateSavedSearchInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateSavedSearchInspect() {
+	for _, e := range m.CreateSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreateSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSavedSearchCounter := mm_atomic.LoadUint64(&m.afterCreateSavedSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSavedSearchMock.defaultExpectation != nil && afterCreateSavedSearchCounter < 1 {
+		if m.CreateSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateSavedSearch at\n%s", m.CreateSavedSearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreateSavedSearch at\n%s with params: %#v", m.CreateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.CreateSavedSearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSavedSearch != nil && afterCreateSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateSavedSearch at\n%s", m.funcCreateSavedSearchOrigin)
+	}
+
+	if !m.CreateSavedSearchMock.invocationsDone() && afterCreateSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSavedSearchMock.expectedInvocations), m.CreateSavedSearchMock.expectedInvocationsOrigin, afterCreateSavedSearchCounter)
+	}
+}
+
+type mRepositoryMockDeleteListing struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteListingExpectation
+	expectations       []*RepositoryMockDeleteListingExpectation
+
+	callArgs []*RepositoryMockDeleteListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteListingExpectation specifies expectation struct of the Repository.DeleteListing
+type RepositoryMockDeleteListingExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteListingParams
+	paramPtrs          *RepositoryMockDeleteListingParamPtrs
+	expectationOrigins RepositoryMockDeleteListingExpectationOrigins
+	results            *RepositoryMockDeleteListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteListingParams contains parameters of the Repository.DeleteListing
+type RepositoryMockDeleteListingParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryMockDeleteListingParamPtrs contains pointers to parameters of the Repository.DeleteListing
+type RepositoryMockDeleteListingParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryMockDeleteListingResults contains results of the Repository.DeleteListing
+type RepositoryMockDeleteListingResults struct {
+	t1  time.Time
+	err error
+}
+
+// RepositoryMockDeleteListingOrigins contains origins of expectations of the Repository.DeleteListing
+type RepositoryMockDeleteListingExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteListing *mRepositoryMockDeleteListing) Optional() *mRepositoryMockDeleteListing {
+	mmDeleteListing.optional = true
+	return mmDeleteListing
+}
+
+// Expect sets up expected params for Repository.DeleteListing
+func (mmDeleteListing *mRepositoryMockDeleteListing) Expect(ctx context.Context, id uint64) *mRepositoryMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &RepositoryMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteListing.defaultExpectation.params = &RepositoryMockDeleteListingParams{ctx, id}
+	mmDeleteListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteListing.expectations {
+		if minimock.Equal(e.params, mmDeleteListing.defaultExpectation.params) {
+			mmDeleteListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteListing.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteListing
+func (mmDeleteListing *mRepositoryMockDeleteListing) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &RepositoryMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.params != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Expect")
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs == nil {
+		mmDeleteListing.defaultExpectation.paramPtrs = &RepositoryMockDeleteListingParamPtrs{}
+	}
+	mmDeleteListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteListing
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.DeleteListing
+func (mmDeleteListing *mRepositoryMockDeleteListing) ExpectIdParam2(id uint64) *mRepositoryMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &RepositoryMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.params != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Expect")
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs == nil {
+		mmDeleteListing.defaultExpectation.paramPtrs = &RepositoryMockDeleteListingParamPtrs{}
+	}
+	mmDeleteListing.defaultExpectation.paramPtrs.id = &id
+	mmDeleteListing.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteListing
+func (mmDeleteListing *mRepositoryMockDeleteListing) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryMockDeleteListing {
+	if mmDeleteListing.mock.inspectFuncDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteListing")
+	}
+
+	mmDeleteListing.mock.inspectFuncDeleteListing = f
+
+	return mmDeleteListing
+}
+
+// Return sets up results that will be returned by Repository.DeleteListing
+func (mmDeleteListing *mRepositoryMockDeleteListing) Return(t1 time.Time, err error) *RepositoryMock {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &RepositoryMockDeleteListingExpectation{mock: mmDeleteListing.mock}
+	}
+	mmDeleteListing.defaultExpectation.results = &RepositoryMockDeleteListingResults{t1, err}
+	mmDeleteListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteListing.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteListing method
+func (mmDeleteListing *mRepositoryMockDeleteListing) Set(f func(ctx context.Context, id uint64) (t1 time.Time, err error)) *RepositoryMock {
+	if mmDeleteListing.defaultExpectation != nil {
+		mmDeleteListing.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteListing method")
+	}
+
+	if len(mmDeleteListing.expectations) > 0 {
+		mmDeleteListing.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteListing method")
+	}
+
+	mmDeleteListing.mock.funcDeleteListing = f
+	mmDeleteListing.mock.funcDeleteListingOrigin = minimock.CallerInfo(1)
+	return mmDeleteListing.mock
+}
+
+// When sets expectation for the Repository.DeleteListing which will trigger the result defined by the following
+// Then helper
+func (mmDeleteListing *mRepositoryMockDeleteListing) When(ctx context.Context, id uint64) *RepositoryMockDeleteListingExpectation {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("RepositoryMock.DeleteListing mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteListingExpectation{
+		mock:               mmDeleteListing.mock,
+		params:             &RepositoryMockDeleteListingParams{ctx, id},
+		expectationOrigins: RepositoryMockDeleteListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteListing.expectations = append(mmDeleteListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteListing return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteListingExpectation) Then(t1 time.Time, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteListingResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteListing should be invoked
+func (mmDeleteListing *mRepositoryMockDeleteListing) Times(n uint64) *mRepositoryMockDeleteListing {
+	if n == 0 {
+		mmDeleteListing.mock.t.Fatalf("Times of RepositoryMock.DeleteListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteListing.expectedInvocations, n)
+	mmDeleteListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteListing
+}
+
+func (mmDeleteListing *mRepositoryMockDeleteListing) invocationsDone() bool {
+	if len(mmDeleteListing.expectations) == 0 && mmDeleteListing.defaultExpectation == nil && mmDeleteListing.mock.funcDeleteListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteListing.mock.afterDeleteListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteListing implements mm_listing.Repository
+func (mmDeleteListing *RepositoryMock) DeleteListing(ctx context.Context, id uint64) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmDeleteListing.beforeDeleteListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteListing.afterDeleteListingCounter, 1)
+
+	mmDeleteListing.t.Helper()
+
+	if mmDeleteListing.inspectFuncDeleteListing != nil {
+		mmDeleteListing.inspectFuncDeleteListing(ctx, id)
+	}
+
+	mm_params := RepositoryMockDeleteListingParams{ctx, id}
+
+	// Record call args
+	mmDeleteListing.DeleteListingMock.mutex.Lock()
+	mmDeleteListing.DeleteListingMock.callArgs = append(mmDeleteListing.DeleteListingMock.callArgs, &mm_params)
+	mmDeleteListing.DeleteListingMock.mutex.Unlock()
+
+	for _, e := range mmDeleteListing.DeleteListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmDeleteListing.DeleteListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteListing.DeleteListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteListing.DeleteListingMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteListing.DeleteListingMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteListingParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteListing.t.Errorf("RepositoryMock.DeleteListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteListing.t.Errorf("RepositoryMock.DeleteListing got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteListing.t.Errorf("RepositoryMock.DeleteListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteListing.DeleteListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteListing.t.Fatal("No results are set for the RepositoryMock.DeleteListing")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmDeleteListing.funcDeleteListing != nil {
+		return mmDeleteListing.funcDeleteListing(ctx, id)
+	}
+	mmDeleteListing.t.Fatalf("Unexpected call to RepositoryMock.DeleteListing. %v %v", ctx, id)
+	return
+}
+
+// DeleteListingAfterCounter returns a count of finished RepositoryMock.DeleteListing invocations
+func (mmDeleteListing *RepositoryMock) DeleteListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteListing.afterDeleteListingCounter)
+}
+
+// DeleteListingBeforeCounter returns a count of RepositoryMock.DeleteListing invocations
+func (mmDeleteListing *RepositoryMock) DeleteListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteListing.beforeDeleteListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteListing *mRepositoryMockDeleteListing) Calls() []*RepositoryMockDeleteListingParams {
+	mmDeleteListing.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteListingParams, len(mmDeleteListing.callArgs))
+	copy(argCopy, mmDeleteListing.callArgs)
+
+	mmDeleteListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteListingDone returns true if the count of the DeleteListing invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteListingDone() bool {
+	if m.DeleteListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteListingMock.invocationsDone()
+}
+
+// MinimockDeleteListingInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteListingInspect() {
+	for _, e := range m.DeleteListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteListingCounter := mm_atomic.LoadUint64(&m.afterDeleteListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteListingMock.defaultExpectation != nil && afterDeleteListingCounter < 1 {
+		if m.DeleteListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteListing at\n%s", m.DeleteListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteListing at\n%s with params: %#v", m.DeleteListingMock.defaultExpectation.expectationOrigins.origin, *m.DeleteListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteListing != nil && afterDeleteListingCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteListing at\n%s", m.funcDeleteListingOrigin)
+	}
+
+	if !m.DeleteListingMock.invocationsDone() && afterDeleteListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteListingMock.expectedInvocations), m.DeleteListingMock.expectedInvocationsOrigin, afterDeleteListingCounter)
+	}
+}
+
+type mRepositoryMockDeleteListingImage struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteListingImageExpectation
+	expectations       []*RepositoryMockDeleteListingImageExpectation
+
+	callArgs []*RepositoryMockDeleteListingImageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteListingImageExpectation specifies expectation struct of the Repository.DeleteListingImage
+type RepositoryMockDeleteListingImageExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteListingImageParams
+	paramPtrs          *RepositoryMockDeleteListingImageParamPtrs
+	expectationOrigins RepositoryMockDeleteListingImageExpectationOrigins
+	results            *RepositoryMockDeleteListingImageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteListingImageParams contains parameters of the Repository.DeleteListingImage
+type RepositoryMockDeleteListingImageParams struct {
+	ctx       context.Context
+	listingID uint64
+	imageID   uint64
+}
+
+// RepositoryMockDeleteListingImageParamPtrs contains pointers to parameters of the Repository.DeleteListingImage
+type RepositoryMockDeleteListingImageParamPtrs struct {
+	ctx       *context.Context
+	listingID *uint64
+	imageID   *uint64
+}
+
+// RepositoryMockDeleteListingImageResults contains results of the Repository.DeleteListingImage
+type RepositoryMockDeleteListingImageResults struct {
+	lpa1 []*entity.ListingImage
+	err  error
+}
+
+// RepositoryMockDeleteListingImageOrigins contains origins of expectations of the Repository.DeleteListingImage
+type RepositoryMockDeleteListingImageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originListingID string
+	originImageID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Optional() *mRepositoryMockDeleteListingImage {
+	mmDeleteListingImage.optional = true
+	return mmDeleteListingImage
+}
+
+// Expect sets up expected params for Repository.DeleteListingImage
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Expect(ctx context.Context, listingID uint64, imageID uint64) *mRepositoryMockDeleteListingImage {
+	if mmDeleteListingImage.mock.funcDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Set")
+	}
+
+	if mmDeleteListingImage.defaultExpectation == nil {
+		mmDeleteListingImage.defaultExpectation = &RepositoryMockDeleteListingImageExpectation{}
+	}
+
+	if mmDeleteListingImage.defaultExpectation.paramPtrs != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteListingImage.defaultExpectation.params = &RepositoryMockDeleteListingImageParams{ctx, listingID, imageID}
+	mmDeleteListingImage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteListingImage.expectations {
+		if minimock.Equal(e.params, mmDeleteListingImage.defaultExpectation.params) {
+			mmDeleteListingImage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteListingImage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteListingImage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteListingImage
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteListingImage {
+	if mmDeleteListingImage.mock.funcDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Set")
+	}
+
+	if mmDeleteListingImage.defaultExpectation == nil {
+		mmDeleteListingImage.defaultExpectation = &RepositoryMockDeleteListingImageExpectation{}
+	}
+
+	if mmDeleteListingImage.defaultExpectation.params != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Expect")
+	}
+
+	if mmDeleteListingImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteListingImage.defaultExpectation.paramPtrs = &RepositoryMockDeleteListingImageParamPtrs{}
+	}
+	mmDeleteListingImage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteListingImage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteListingImage
+}
+
+// ExpectListingIDParam2 sets up expected param listingID for Repository.DeleteListingImage
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) ExpectListingIDParam2(listingID uint64) *mRepositoryMockDeleteListingImage {
+	if mmDeleteListingImage.mock.funcDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Set")
+	}
+
+	if mmDeleteListingImage.defaultExpectation == nil {
+		mmDeleteListingImage.defaultExpectation = &RepositoryMockDeleteListingImageExpectation{}
+	}
+
+	if mmDeleteListingImage.defaultExpectation.params != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Expect")
+	}
+
+	if mmDeleteListingImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteListingImage.defaultExpectation.paramPtrs = &RepositoryMockDeleteListingImageParamPtrs{}
+	}
+	mmDeleteListingImage.defaultExpectation.paramPtrs.listingID = &listingID
+	mmDeleteListingImage.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmDeleteListingImage
+}
+
+// ExpectImageIDParam3 sets up expected param imageID for Repository.DeleteListingImage
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) ExpectImageIDParam3(imageID uint64) *mRepositoryMockDeleteListingImage {
+	if mmDeleteListingImage.mock.funcDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Set")
+	}
+
+	if mmDeleteListingImage.defaultExpectation == nil {
+		mmDeleteListingImage.defaultExpectation = &RepositoryMockDeleteListingImageExpectation{}
+	}
+
+	if mmDeleteListingImage.defaultExpectation.params != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Expect")
+	}
+
+	if mmDeleteListingImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteListingImage.defaultExpectation.paramPtrs = &RepositoryMockDeleteListingImageParamPtrs{}
+	}
+	mmDeleteListingImage.defaultExpectation.paramPtrs.imageID = &imageID
+	mmDeleteListingImage.defaultExpectation.expectationOrigins.originImageID = minimock.CallerInfo(1)
+
+	return mmDeleteListingImage
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteListingImage
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Inspect(f func(ctx context.Context, listingID uint64, imageID uint64)) *mRepositoryMockDeleteListingImage {
+	if mmDeleteListingImage.mock.inspectFuncDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteListingImage")
+	}
+
+	mmDeleteListingImage.mock.inspectFuncDeleteListingImage = f
+
+	return mmDeleteListingImage
+}
+
+// Return sets up results that will be returned by Repository.DeleteListingImage
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Return(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	if mmDeleteListingImage.mock.funcDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Set")
+	}
+
+	if mmDeleteListingImage.defaultExpectation == nil {
+		mmDeleteListingImage.defaultExpectation = &RepositoryMockDeleteListingImageExpectation{mock: mmDeleteListingImage.mock}
+	}
+	mmDeleteListingImage.defaultExpectation.results = &RepositoryMockDeleteListingImageResults{lpa1, err}
+	mmDeleteListingImage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteListingImage.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteListingImage method
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Set(f func(ctx context.Context, listingID uint64, imageID uint64) (lpa1 []*entity.ListingImage, err error)) *RepositoryMock {
+	if mmDeleteListingImage.defaultExpectation != nil {
+		mmDeleteListingImage.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteListingImage method")
+	}
+
+	if len(mmDeleteListingImage.expectations) > 0 {
+		mmDeleteListingImage.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteListingImage method")
+	}
+
+	mmDeleteListingImage.mock.funcDeleteListingImage = f
+	mmDeleteListingImage.mock.funcDeleteListingImageOrigin = minimock.CallerInfo(1)
+	return mmDeleteListingImage.mock
+}
+
+// When sets expectation for the Repository.DeleteListingImage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) When(ctx context.Context, listingID uint64, imageID uint64) *RepositoryMockDeleteListingImageExpectation {
+	if mmDeleteListingImage.mock.funcDeleteListingImage != nil {
+		mmDeleteListingImage.mock.t.Fatalf("RepositoryMock.DeleteListingImage mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteListingImageExpectation{
+		mock:               mmDeleteListingImage.mock,
+		params:             &RepositoryMockDeleteListingImageParams{ctx, listingID, imageID},
+		expectationOrigins: RepositoryMockDeleteListingImageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteListingImage.expectations = append(mmDeleteListingImage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteListingImage return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteListingImageExpectation) Then(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteListingImageResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteListingImage should be invoked
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Times(n uint64) *mRepositoryMockDeleteListingImage {
+	if n == 0 {
+		mmDeleteListingImage.mock.t.Fatalf("Times of RepositoryMock.DeleteListingImage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteListingImage.expectedInvocations, n)
+	mmDeleteListingImage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteListingImage
+}
+
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) invocationsDone() bool {
+	if len(mmDeleteListingImage.expectations) == 0 && mmDeleteListingImage.defaultExpectation == nil && mmDeleteListingImage.mock.funcDeleteListingImage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteListingImage.mock.afterDeleteListingImageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteListingImage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteListingImage implements mm_listing.Repository
+func (mmDeleteListingImage *RepositoryMock) DeleteListingImage(ctx context.Context, listingID uint64, imageID uint64) (lpa1 []*entity.ListingImage, err error) {
+	mm_atomic.AddUint64(&mmDeleteListingImage.beforeDeleteListingImageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteListingImage.afterDeleteListingImageCounter, 1)
+
+	mmDeleteListingImage.t.Helper()
+
+	if mmDeleteListingImage.inspectFuncDeleteListingImage != nil {
+		mmDeleteListingImage.inspectFuncDeleteListingImage(ctx, listingID, imageID)
+	}
+
+	mm_params := RepositoryMockDeleteListingImageParams{ctx, listingID, imageID}
+
+	// Record call args
+	mmDeleteListingImage.DeleteListingImageMock.mutex.Lock()
+	mmDeleteListingImage.DeleteListingImageMock.callArgs = append(mmDeleteListingImage.DeleteListingImageMock.callArgs, &mm_params)
+	mmDeleteListingImage.DeleteListingImageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteListingImage.DeleteListingImageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmDeleteListingImage.DeleteListingImageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteListingImageParams{ctx, listingID, imageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteListingImage.t.Errorf("RepositoryMock.DeleteListingImage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmDeleteListingImage.t.Errorf("RepositoryMock.DeleteListingImage got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+			if mm_want_ptrs.imageID != nil && !minimock.Equal(*mm_want_ptrs.imageID, mm_got.imageID) {
+				mmDeleteListingImage.t.Errorf("RepositoryMock.DeleteListingImage got unexpected parameter imageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.expectationOrigins.originImageID, *mm_want_ptrs.imageID, mm_got.imageID, minimock.Diff(*mm_want_ptrs.imageID, mm_got.imageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteListingImage.t.Errorf("RepositoryMock.DeleteListingImage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteListingImage.DeleteListingImageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteListingImage.t.Fatal("No results are set for the RepositoryMock.DeleteListingImage")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmDeleteListingImage.funcDeleteListingImage != nil {
+		return mmDeleteListingImage.funcDeleteListingImage(ctx, listingID, imageID)
+	}
+	mmDeleteListingImage.t.Fatalf("Unexpected call to RepositoryMock.DeleteListingImage. %v %v %v", ctx, listingID, imageID)
+	return
+}
+
+// DeleteListingImageAfterCounter returns a count of finished RepositoryMock.DeleteListingImage invocations
+func (mmDeleteListingImage *RepositoryMock) DeleteListingImageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteListingImage.afterDeleteListingImageCounter)
+}
+
+// DeleteListingImageBeforeCounter returns a count of RepositoryMock.DeleteListingImage invocations
+func (mmDeleteListingImage *RepositoryMock) DeleteListingImageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteListingImage.beforeDeleteListingImageCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteListingImage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteListingImage *mRepositoryMockDeleteListingImage) Calls() []*RepositoryMockDeleteListingImageParams {
+	mmDeleteListingImage.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteListingImageParams, len(mmDeleteListingImage.callArgs))
+	copy(argCopy, mmDeleteListingImage.callArgs)
+
+	mmDeleteListingImage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteListingImageDone returns true if the count of the DeleteListingImage invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteListingImageDone() bool {
+	if m.DeleteListingImageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteListingImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteListingImageMock.invocationsDone()
+}
+
+// MinimockDeleteListingImageInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteListingImageInspect() {
+	for _, e := range m.DeleteListingImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteListingImage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteListingImageCounter := mm_atomic.LoadUint64(&m.afterDeleteListingImageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteListingImageMock.defaultExpectation != nil && afterDeleteListingImageCounter < 1 {
+		if m.DeleteListingImageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteListingImage at\n%s", m.DeleteListingImageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteListingImage at\n%s with params: %#v", m.DeleteListingImageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteListingImageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteListingImage != nil && afterDeleteListingImageCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteListingImage at\n%s", m.funcDeleteListingImageOrigin)
+	}
+
+	if !m.DeleteListingImageMock.invocationsDone() && afterDeleteListingImageCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteListingImage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteListingImageMock.expectedInvocations), m.DeleteListingImageMock.expectedInvocationsOrigin, afterDeleteListingImageCounter)
+	}
+}
+
+type mRepositoryMockDeleteSavedSearch struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteSavedSearchExpectation
+	expectations       []*RepositoryMockDeleteSavedSearchExpectation
+
+	callArgs []*RepositoryMockDeleteSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteSavedSearchExpectation specifies expectation struct of the Repository.DeleteSavedSearch
+type RepositoryMockDeleteSavedSearchExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteSavedSearchParams
+	paramPtrs          *RepositoryMockDeleteSavedSearchParamPtrs
+	expectationOrigins RepositoryMockDeleteSavedSearchExpectationOrigins
+	results            *RepositoryMockDeleteSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteSavedSearchParams contains parameters of the Repository.DeleteSavedSearch
+type RepositoryMockDeleteSavedSearchParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// RepositoryMockDeleteSavedSearchParamPtrs contains pointers to parameters of the Repository.DeleteSavedSearch
+type RepositoryMockDeleteSavedSearchParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// RepositoryMockDeleteSavedSearchResults contains results of the Repository.DeleteSavedSearch
+type RepositoryMockDeleteSavedSearchResults struct {
+	err error
+}
+
+// RepositoryMockDeleteSavedSearchOrigins contains origins of expectations of the Repository.DeleteSavedSearch
+type RepositoryMockDeleteSavedSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Optional() *mRepositoryMockDeleteSavedSearch {
+	mmDeleteSavedSearch.optional = true
+	return mmDeleteSavedSearch
+}
+
+// Expect sets up expected params for Repository.DeleteSavedSearch
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Expect(ctx context.Context, userID uint64, id uint64) *mRepositoryMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &RepositoryMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSavedSearch.defaultExpectation.params = &RepositoryMockDeleteSavedSearchParams{ctx, userID, id}
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSavedSearch.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedSearch.defaultExpectation.params) {
+			mmDeleteSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSavedSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteSavedSearch
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &RepositoryMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.params != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Expect")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockDeleteSavedSearchParamPtrs{}
+	}
+	mmDeleteSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSavedSearch
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteSavedSearch
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) ExpectUserIDParam2(userID uint64) *mRepositoryMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &RepositoryMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.params != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Expect")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockDeleteSavedSearchParamPtrs{}
+	}
+	mmDeleteSavedSearch.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedSearch
+}
+
+// ExpectIdParam3 sets up expected param id for Repository.DeleteSavedSearch
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) ExpectIdParam3(id uint64) *mRepositoryMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &RepositoryMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.params != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Expect")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockDeleteSavedSearchParamPtrs{}
+	}
+	mmDeleteSavedSearch.defaultExpectation.paramPtrs.id = &id
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteSavedSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteSavedSearch
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mRepositoryMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.inspectFuncDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteSavedSearch")
+	}
+
+	mmDeleteSavedSearch.mock.inspectFuncDeleteSavedSearch = f
+
+	return mmDeleteSavedSearch
+}
+
+// Return sets up results that will be returned by Repository.DeleteSavedSearch
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Return(err error) *RepositoryMock {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &RepositoryMockDeleteSavedSearchExpectation{mock: mmDeleteSavedSearch.mock}
+	}
+	mmDeleteSavedSearch.defaultExpectation.results = &RepositoryMockDeleteSavedSearchResults{err}
+	mmDeleteSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedSearch.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteSavedSearch method
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Set(f func(ctx context.Context, userID uint64, id uint64) (err error)) *RepositoryMock {
+	if mmDeleteSavedSearch.defaultExpectation != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteSavedSearch method")
+	}
+
+	if len(mmDeleteSavedSearch.expectations) > 0 {
+		mmDeleteSavedSearch.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteSavedSearch method")
+	}
+
+	mmDeleteSavedSearch.mock.funcDeleteSavedSearch = f
+	mmDeleteSavedSearch.mock.funcDeleteSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedSearch.mock
+}
+
+// When sets expectation for the Repository.DeleteSavedSearch which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) When(ctx context.Context, userID uint64, id uint64) *RepositoryMockDeleteSavedSearchExpectation {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("RepositoryMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteSavedSearchExpectation{
+		mock:               mmDeleteSavedSearch.mock,
+		params:             &RepositoryMockDeleteSavedSearchParams{ctx, userID, id},
+		expectationOrigins: RepositoryMockDeleteSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSavedSearch.expectations = append(mmDeleteSavedSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteSavedSearch return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteSavedSearchExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteSavedSearchResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteSavedSearch should be invoked
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Times(n uint64) *mRepositoryMockDeleteSavedSearch {
+	if n == 0 {
+		mmDeleteSavedSearch.mock.t.Fatalf("Times of RepositoryMock.DeleteSavedSearch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSavedSearch.expectedInvocations, n)
+	mmDeleteSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedSearch
+}
+
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) invocationsDone() bool {
+	if len(mmDeleteSavedSearch.expectations) == 0 && mmDeleteSavedSearch.defaultExpectation == nil && mmDeleteSavedSearch.mock.funcDeleteSavedSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSavedSearch.mock.afterDeleteSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSavedSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSavedSearch implements mm_listing.Repository
+func (mmDeleteSavedSearch *RepositoryMock) DeleteSavedSearch(ctx context.Context, userID uint64, id uint64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedSearch.beforeDeleteSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedSearch.afterDeleteSavedSearchCounter, 1)
+
+	mmDeleteSavedSearch.t.Helper()
+
+	if mmDeleteSavedSearch.inspectFuncDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.inspectFuncDeleteSavedSearch(ctx, userID, id)
+	}
+
+	mm_params := RepositoryMockDeleteSavedSearchParams{ctx, userID, id}
+
+	// Record call args
+	mmDeleteSavedSearch.DeleteSavedSearchMock.mutex.Lock()
+	mmDeleteSavedSearch.DeleteSavedSearchMock.callArgs = append(mmDeleteSavedSearch.DeleteSavedSearchMock.callArgs, &mm_params)
+	mmDeleteSavedSearch.DeleteSavedSearchMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedSearch.DeleteSavedSearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteSavedSearchParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSavedSearch.t.Errorf("RepositoryMock.DeleteSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSavedSearch.t.Errorf("RepositoryMock.DeleteSavedSearch got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteSavedSearch.t.Errorf("RepositoryMock.DeleteSavedSearch got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedSearch.t.Errorf("RepositoryMock.DeleteSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedSearch.t.Fatal("No results are set for the RepositoryMock.DeleteSavedSearch")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedSearch.funcDeleteSavedSearch != nil {
+		return mmDeleteSavedSearch.funcDeleteSavedSearch(ctx, userID, id)
+	}
+	mmDeleteSavedSearch.t.Fatalf("Unexpected call to RepositoryMock.DeleteSavedSearch. %v %v %v", ctx, userID, id)
+	return
+}
+
+// DeleteSavedSearchAfterCounter returns a count of finished RepositoryMock.DeleteSavedSearch invocations
+func (mmDeleteSavedSearch *RepositoryMock) DeleteSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedSearch.afterDeleteSavedSearchCounter)
+}
+
+// DeleteSavedSearchBeforeCounter returns a count of RepositoryMock.DeleteSavedSearch invocations
+func (mmDeleteSavedSearch *RepositoryMock) DeleteSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedSearch.beforeDeleteSavedSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteSavedSearch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedSearch *mRepositoryMockDeleteSavedSearch) Calls() []*RepositoryMockDeleteSavedSearchParams {
+	mmDeleteSavedSearch.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteSavedSearchParams, len(mmDeleteSavedSearch.callArgs))
+	copy(argCopy, mmDeleteSavedSearch.callArgs)
+
+	mmDeleteSavedSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedSearchDone returns true if the count of the DeleteSavedSearch invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteSavedSearchDone() bool {
+	if m.DeleteSavedSearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSavedSearchMock.invocationsDone()
+}
+
+// MinimockDeleteSavedSearchInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteSavedSearchInspect() {
+	for _, e := range m.DeleteSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSavedSearchCounter := mm_atomic.LoadUint64(&m.afterDeleteSavedSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedSearchMock.defaultExpectation != nil && afterDeleteSavedSearchCounter < 1 {
+		if m.DeleteSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSavedSearch at\n%s", m.DeleteSavedSearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSavedSearch at\n%s with params: %#v", m.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSavedSearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedSearch != nil && afterDeleteSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteSavedSearch at\n%s", m.funcDeleteSavedSearchOrigin)
+	}
+
+	if !m.DeleteSavedSearchMock.invocationsDone() && afterDeleteSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSavedSearchMock.expectedInvocations), m.DeleteSavedSearchMock.expectedInvocationsOrigin, afterDeleteSavedSearchCounter)
+	}
+}
+
+type mRepositoryMockGetDeletedListingByID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetDeletedListingByIDExpectation
+	expectations       []*RepositoryMockGetDeletedListingByIDExpectation
+
+	callArgs []*RepositoryMockGetDeletedListingByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetDeletedListingByIDExpectation specifies expectation struct of the Repository.GetDeletedListingByID
+type RepositoryMockGetDeletedListingByIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetDeletedListingByIDParams
+	paramPtrs          *RepositoryMockGetDeletedListingByIDParamPtrs
+	expectationOrigins RepositoryMockGetDeletedListingByIDExpectationOrigins
+	results            *RepositoryMockGetDeletedListingByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetDeletedListingByIDParams contains parameters of the Repository.GetDeletedListingByID
+type RepositoryMockGetDeletedListingByIDParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryMockGetDeletedListingByIDParamPtrs contains pointers to parameters of the Repository.GetDeletedListingByID
+type RepositoryMockGetDeletedListingByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryMockGetDeletedListingByIDResults contains results of the Repository.GetDeletedListingByID
+type RepositoryMockGetDeletedListingByIDResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// RepositoryMockGetDeletedListingByIDOrigins contains origins of expectations of the Repository.GetDeletedListingByID
+type RepositoryMockGetDeletedListingByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Optional() *mRepositoryMockGetDeletedListingByID {
+	mmGetDeletedListingByID.optional = true
+	return mmGetDeletedListingByID
+}
+
+// Expect sets up expected params for Repository.GetDeletedListingByID
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Expect(ctx context.Context, id uint64) *mRepositoryMockGetDeletedListingByID {
+	if mmGetDeletedListingByID.mock.funcGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Set")
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation == nil {
+		mmGetDeletedListingByID.defaultExpectation = &RepositoryMockGetDeletedListingByIDExpectation{}
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation.paramPtrs != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetDeletedListingByID.defaultExpectation.params = &RepositoryMockGetDeletedListingByIDParams{ctx, id}
+	mmGetDeletedListingByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDeletedListingByID.expectations {
+		if minimock.Equal(e.params, mmGetDeletedListingByID.defaultExpectation.params) {
+			mmGetDeletedListingByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeletedListingByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeletedListingByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetDeletedListingByID
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetDeletedListingByID {
+	if mmGetDeletedListingByID.mock.funcGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Set")
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation == nil {
+		mmGetDeletedListingByID.defaultExpectation = &RepositoryMockGetDeletedListingByIDExpectation{}
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation.params != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Expect")
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedListingByID.defaultExpectation.paramPtrs = &RepositoryMockGetDeletedListingByIDParamPtrs{}
+	}
+	mmGetDeletedListingByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDeletedListingByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDeletedListingByID
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.GetDeletedListingByID
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) ExpectIdParam2(id uint64) *mRepositoryMockGetDeletedListingByID {
+	if mmGetDeletedListingByID.mock.funcGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Set")
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation == nil {
+		mmGetDeletedListingByID.defaultExpectation = &RepositoryMockGetDeletedListingByIDExpectation{}
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation.params != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Expect")
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation.paramPtrs == nil {
+		mmGetDeletedListingByID.defaultExpectation.paramPtrs = &RepositoryMockGetDeletedListingByIDParamPtrs{}
+	}
+	mmGetDeletedListingByID.defaultExpectation.paramPtrs.id = &id
+	mmGetDeletedListingByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetDeletedListingByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetDeletedListingByID
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryMockGetDeletedListingByID {
+	if mmGetDeletedListingByID.mock.inspectFuncGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetDeletedListingByID")
+	}
+
+	mmGetDeletedListingByID.mock.inspectFuncGetDeletedListingByID = f
+
+	return mmGetDeletedListingByID
+}
+
+// Return sets up results that will be returned by Repository.GetDeletedListingByID
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Return(lp1 *entity.Listing, err error) *RepositoryMock {
+	if mmGetDeletedListingByID.mock.funcGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Set")
+	}
+
+	if mmGetDeletedListingByID.defaultExpectation == nil {
+		mmGetDeletedListingByID.defaultExpectation = &RepositoryMockGetDeletedListingByIDExpectation{mock: mmGetDeletedListingByID.mock}
+	}
+	mmGetDeletedListingByID.defaultExpectation.results = &RepositoryMockGetDeletedListingByIDResults{lp1, err}
+	mmGetDeletedListingByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedListingByID.mock
+}
+
+// Set uses given function f to mock the Repository.GetDeletedListingByID method
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Set(f func(ctx context.Context, id uint64) (lp1 *entity.Listing, err error)) *RepositoryMock {
+	if mmGetDeletedListingByID.defaultExpectation != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("Default expectation is already set for the Repository.GetDeletedListingByID method")
+	}
+
+	if len(mmGetDeletedListingByID.expectations) > 0 {
+		mmGetDeletedListingByID.mock.t.Fatalf("Some expectations are already set for the Repository.GetDeletedListingByID method")
+	}
+
+	mmGetDeletedListingByID.mock.funcGetDeletedListingByID = f
+	mmGetDeletedListingByID.mock.funcGetDeletedListingByIDOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedListingByID.mock
+}
+
+// When sets expectation for the Repository.GetDeletedListingByID which will trigger the result defined by the following
+// Then helper
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) When(ctx context.Context, id uint64) *RepositoryMockGetDeletedListingByIDExpectation {
+	if mmGetDeletedListingByID.mock.funcGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.mock.t.Fatalf("RepositoryMock.GetDeletedListingByID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetDeletedListingByIDExpectation{
+		mock:               mmGetDeletedListingByID.mock,
+		params:             &RepositoryMockGetDeletedListingByIDParams{ctx, id},
+		expectationOrigins: RepositoryMockGetDeletedListingByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDeletedListingByID.expectations = append(mmGetDeletedListingByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetDeletedListingByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetDeletedListingByIDExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetDeletedListingByIDResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetDeletedListingByID should be invoked
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Times(n uint64) *mRepositoryMockGetDeletedListingByID {
+	if n == 0 {
+		mmGetDeletedListingByID.mock.t.Fatalf("Times of RepositoryMock.GetDeletedListingByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDeletedListingByID.expectedInvocations, n)
+	mmGetDeletedListingByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDeletedListingByID
+}
+
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) invocationsDone() bool {
+	if len(mmGetDeletedListingByID.expectations) == 0 && mmGetDeletedListingByID.defaultExpectation == nil && mmGetDeletedListingByID.mock.funcGetDeletedListingByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDeletedListingByID.mock.afterGetDeletedListingByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDeletedListingByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDeletedListingByID implements mm_listing.Repository
+func (mmGetDeletedListingByID *RepositoryMock) GetDeletedListingByID(ctx context.Context, id uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmGetDeletedListingByID.beforeGetDeletedListingByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeletedListingByID.afterGetDeletedListingByIDCounter, 1)
+
+	mmGetDeletedListingByID.t.Helper()
+
+	if mmGetDeletedListingByID.inspectFuncGetDeletedListingByID != nil {
+		mmGetDeletedListingByID.inspectFuncGetDeletedListingByID(ctx, id)
+	}
+
+	mm_params := RepositoryMockGetDeletedListingByIDParams{ctx, id}
+
+	// Record call args
+	mmGetDeletedListingByID.GetDeletedListingByIDMock.mutex.Lock()
+	mmGetDeletedListingByID.GetDeletedListingByIDMock.callArgs = append(mmGetDeletedListingByID.GetDeletedListingByIDMock.callArgs, &mm_params)
+	mmGetDeletedListingByID.GetDeletedListingByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetDeletedListingByID.GetDeletedListingByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetDeletedListingByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDeletedListingByID.t.Errorf("RepositoryMock.GetDeletedListingByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetDeletedListingByID.t.Errorf("RepositoryMock.GetDeletedListingByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeletedListingByID.t.Errorf("RepositoryMock.GetDeletedListingByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeletedListingByID.GetDeletedListingByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeletedListingByID.t.Fatal("No results are set for the RepositoryMock.GetDeletedListingByID")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetDeletedListingByID.funcGetDeletedListingByID != nil {
+		return mmGetDeletedListingByID.funcGetDeletedListingByID(ctx, id)
+	}
+	mmGetDeletedListingByID.t.Fatalf("Unexpected call to RepositoryMock.GetDeletedListingByID. %v %v", ctx, id)
+	return
+}
+
+// GetDeletedListingByIDAfterCounter returns a count of finished RepositoryMock.GetDeletedListingByID invocations
+func (mmGetDeletedListingByID *RepositoryMock) GetDeletedListingByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeletedListingByID.afterGetDeletedListingByIDCounter)
+}
+
+// GetDeletedListingByIDBeforeCounter returns a count of RepositoryMock.GetDeletedListingByID invocations
+func (mmGetDeletedListingByID *RepositoryMock) GetDeletedListingByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeletedListingByID.beforeGetDeletedListingByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetDeletedListingByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeletedListingByID *mRepositoryMockGetDeletedListingByID) Calls() []*RepositoryMockGetDeletedListingByIDParams {
+	mmGetDeletedListingByID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetDeletedListingByIDParams, len(mmGetDeletedListingByID.callArgs))
+	copy(argCopy, mmGetDeletedListingByID.callArgs)
+
+	mmGetDeletedListingByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeletedListingByIDDone returns true if the count of the GetDeletedListingByID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetDeletedListingByIDDone() bool {
+	if m.GetDeletedListingByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDeletedListingByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDeletedListingByIDMock.invocationsDone()
+}
+
+// MinimockGetDeletedListingByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetDeletedListingByIDInspect() {
+	for _, e := range m.GetDeletedListingByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetDeletedListingByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDeletedListingByIDCounter := mm_atomic.LoadUint64(&m.afterGetDeletedListingByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeletedListingByIDMock.defaultExpectation != nil && afterGetDeletedListingByIDCounter < 1 {
+		if m.GetDeletedListingByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetDeletedListingByID at\n%s", m.GetDeletedListingByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetDeletedListingByID at\n%s with params: %#v", m.GetDeletedListingByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetDeletedListingByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeletedListingByID != nil && afterGetDeletedListingByIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetDeletedListingByID at\n%s", m.funcGetDeletedListingByIDOrigin)
+	}
+
+	if !m.GetDeletedListingByIDMock.invocationsDone() && afterGetDeletedListingByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetDeletedListingByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDeletedListingByIDMock.expectedInvocations), m.GetDeletedListingByIDMock.expectedInvocationsOrigin, afterGetDeletedListingByIDCounter)
+	}
+}
+
+type mRepositoryMockGetDueSavedSearches struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetDueSavedSearchesExpectation
+	expectations       []*RepositoryMockGetDueSavedSearchesExpectation
+
+	callArgs []*RepositoryMockGetDueSavedSearchesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetDueSavedSearchesExpectation specifies expectation struct of the Repository.GetDueSavedSearches
+type RepositoryMockGetDueSavedSearchesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetDueSavedSearchesParams
+	paramPtrs          *RepositoryMockGetDueSavedSearchesParamPtrs
+	expectationOrigins RepositoryMockGetDueSavedSearchesExpectationOrigins
+	results            *RepositoryMockGetDueSavedSearchesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetDueSavedSearchesParams contains parameters of the Repository.GetDueSavedSearches
+type RepositoryMockGetDueSavedSearchesParams struct {
+	ctx          context.Context
+	afterID      uint64
+	digestBefore time.Time
+	limit        int
+}
+
+// RepositoryMockGetDueSavedSearchesParamPtrs contains pointers to parameters of the Repository.GetDueSavedSearches
+type RepositoryMockGetDueSavedSearchesParamPtrs struct {
+	ctx          *context.Context
+	afterID      *uint64
+	digestBefore *time.Time
+	limit        *int
+}
+
+// RepositoryMockGetDueSavedSearchesResults contains results of the Repository.GetDueSavedSearches
+type RepositoryMockGetDueSavedSearchesResults struct {
+	spa1 []*entity.SavedSearch
+	err  error
+}
+
+// RepositoryMockGetDueSavedSearchesOrigins contains origins of expectations of the Repository.GetDueSavedSearches
+type RepositoryMockGetDueSavedSearchesExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originAfterID      string
+	originDigestBefore string
+	originLimit        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Optional() *mRepositoryMockGetDueSavedSearches {
+	mmGetDueSavedSearches.optional = true
+	return mmGetDueSavedSearches
+}
+
+// Expect sets up expected params for Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Expect(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) *mRepositoryMockGetDueSavedSearches {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation == nil {
+		mmGetDueSavedSearches.defaultExpectation = &RepositoryMockGetDueSavedSearchesExpectation{}
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.paramPtrs != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by ExpectParams functions")
+	}
+
+	mmGetDueSavedSearches.defaultExpectation.params = &RepositoryMockGetDueSavedSearchesParams{ctx, afterID, digestBefore, limit}
+	mmGetDueSavedSearches.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDueSavedSearches.expectations {
+		if minimock.Equal(e.params, mmGetDueSavedSearches.defaultExpectation.params) {
+			mmGetDueSavedSearches.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDueSavedSearches.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDueSavedSearches
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetDueSavedSearches {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation == nil {
+		mmGetDueSavedSearches.defaultExpectation = &RepositoryMockGetDueSavedSearchesExpectation{}
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.params != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Expect")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmGetDueSavedSearches.defaultExpectation.paramPtrs = &RepositoryMockGetDueSavedSearchesParamPtrs{}
+	}
+	mmGetDueSavedSearches.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDueSavedSearches.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDueSavedSearches
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) ExpectAfterIDParam2(afterID uint64) *mRepositoryMockGetDueSavedSearches {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation == nil {
+		mmGetDueSavedSearches.defaultExpectation = &RepositoryMockGetDueSavedSearchesExpectation{}
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.params != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Expect")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmGetDueSavedSearches.defaultExpectation.paramPtrs = &RepositoryMockGetDueSavedSearchesParamPtrs{}
+	}
+	mmGetDueSavedSearches.defaultExpectation.paramPtrs.afterID = &afterID
+	mmGetDueSavedSearches.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmGetDueSavedSearches
+}
+
+// ExpectDigestBeforeParam3 sets up expected param digestBefore for Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) ExpectDigestBeforeParam3(digestBefore time.Time) *mRepositoryMockGetDueSavedSearches {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation == nil {
+		mmGetDueSavedSearches.defaultExpectation = &RepositoryMockGetDueSavedSearchesExpectation{}
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.params != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Expect")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmGetDueSavedSearches.defaultExpectation.paramPtrs = &RepositoryMockGetDueSavedSearchesParamPtrs{}
+	}
+	mmGetDueSavedSearches.defaultExpectation.paramPtrs.digestBefore = &digestBefore
+	mmGetDueSavedSearches.defaultExpectation.expectationOrigins.originDigestBefore = minimock.CallerInfo(1)
+
+	return mmGetDueSavedSearches
+}
+
+// ExpectLimitParam4 sets up expected param limit for Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) ExpectLimitParam4(limit int) *mRepositoryMockGetDueSavedSearches {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation == nil {
+		mmGetDueSavedSearches.defaultExpectation = &RepositoryMockGetDueSavedSearchesExpectation{}
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.params != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Expect")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmGetDueSavedSearches.defaultExpectation.paramPtrs = &RepositoryMockGetDueSavedSearchesParamPtrs{}
+	}
+	mmGetDueSavedSearches.defaultExpectation.paramPtrs.limit = &limit
+	mmGetDueSavedSearches.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetDueSavedSearches
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Inspect(f func(ctx context.Context, afterID uint64, digestBefore time.Time, limit int)) *mRepositoryMockGetDueSavedSearches {
+	if mmGetDueSavedSearches.mock.inspectFuncGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetDueSavedSearches")
+	}
+
+	mmGetDueSavedSearches.mock.inspectFuncGetDueSavedSearches = f
+
+	return mmGetDueSavedSearches
+}
+
+// Return sets up results that will be returned by Repository.GetDueSavedSearches
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Return(spa1 []*entity.SavedSearch, err error) *RepositoryMock {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	if mmGetDueSavedSearches.defaultExpectation == nil {
+		mmGetDueSavedSearches.defaultExpectation = &RepositoryMockGetDueSavedSearchesExpectation{mock: mmGetDueSavedSearches.mock}
+	}
+	mmGetDueSavedSearches.defaultExpectation.results = &RepositoryMockGetDueSavedSearchesResults{spa1, err}
+	mmGetDueSavedSearches.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDueSavedSearches.mock
+}
+
+// Set uses given function f to mock the Repository.GetDueSavedSearches method
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Set(f func(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) (spa1 []*entity.SavedSearch, err error)) *RepositoryMock {
+	if mmGetDueSavedSearches.defaultExpectation != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("Default expectation is already set for the Repository.GetDueSavedSearches method")
+	}
+
+	if len(mmGetDueSavedSearches.expectations) > 0 {
+		mmGetDueSavedSearches.mock.t.Fatalf("Some expectations are already set for the Repository.GetDueSavedSearches method")
+	}
+
+	mmGetDueSavedSearches.mock.funcGetDueSavedSearches = f
+	mmGetDueSavedSearches.mock.funcGetDueSavedSearchesOrigin = minimock.CallerInfo(1)
+	return mmGetDueSavedSearches.mock
+}
+
+// When sets expectation for the Repository.GetDueSavedSearches which will trigger the result defined by the following
+// Then helper
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) When(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) *RepositoryMockGetDueSavedSearchesExpectation {
+	if mmGetDueSavedSearches.mock.funcGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.mock.t.Fatalf("RepositoryMock.GetDueSavedSearches mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetDueSavedSearchesExpectation{
+		mock:               mmGetDueSavedSearches.mock,
+		params:             &RepositoryMockGetDueSavedSearchesParams{ctx, afterID, digestBefore, limit},
+		expectationOrigins: RepositoryMockGetDueSavedSearchesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDueSavedSearches.expectations = append(mmGetDueSavedSearches.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetDueSavedSearches return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetDueSavedSearchesExpectation) Then(spa1 []*entity.SavedSearch, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetDueSavedSearchesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetDueSavedSearches should be invoked
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Times(n uint64) *mRepositoryMockGetDueSavedSearches {
+	if n == 0 {
+		mmGetDueSavedSearches.mock.t.Fatalf("Times of RepositoryMock.GetDueSavedSearches mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDueSavedSearches.expectedInvocations, n)
+	mmGetDueSavedSearches.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDueSavedSearches
+}
+
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) invocationsDone() bool {
+	if len(mmGetDueSavedSearches.expectations) == 0 && mmGetDueSavedSearches.defaultExpectation == nil && mmGetDueSavedSearches.mock.funcGetDueSavedSearches == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDueSavedSearches.mock.afterGetDueSavedSearchesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDueSavedSearches.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDueSavedSearches implements mm_listing.Repository
+func (mmGetDueSavedSearches *RepositoryMock) GetDueSavedSearches(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) (spa1 []*entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmGetDueSavedSearches.beforeGetDueSavedSearchesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDueSavedSearches.afterGetDueSavedSearchesCounter, 1)
+
+	mmGetDueSavedSearches.t.Helper()
+
+	if mmGetDueSavedSearches.inspectFuncGetDueSavedSearches != nil {
+		mmGetDueSavedSearches.inspectFuncGetDueSavedSearches(ctx, afterID, digestBefore, limit)
+	}
+
+	mm_params := RepositoryMockGetDueSavedSearchesParams{ctx, afterID, digestBefore, limit}
+
+	// Record call args
+	mmGetDueSavedSearches.GetDueSavedSearchesMock.mutex.Lock()
+	mmGetDueSavedSearches.GetDueSavedSearchesMock.callArgs = append(mmGetDueSavedSearches.GetDueSavedSearchesMock.callArgs, &mm_params)
+	mmGetDueSavedSearches.GetDueSavedSearchesMock.mutex.Unlock()
+
+	for _, e := range mmGetDueSavedSearches.GetDueSavedSearchesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetDueSavedSearchesParams{ctx, afterID, digestBefore, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDueSavedSearches.t.Errorf("RepositoryMock.GetDueSavedSearches got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmGetDueSavedSearches.t.Errorf("RepositoryMock.GetDueSavedSearches got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.digestBefore != nil && !minimock.Equal(*mm_want_ptrs.digestBefore, mm_got.digestBefore) {
+				mmGetDueSavedSearches.t.Errorf("RepositoryMock.GetDueSavedSearches got unexpected parameter digestBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.expectationOrigins.originDigestBefore, *mm_want_ptrs.digestBefore, mm_got.digestBefore, minimock.Diff(*mm_want_ptrs.digestBefore, mm_got.digestBefore))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetDueSavedSearches.t.Errorf("RepositoryMock.GetDueSavedSearches got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDueSavedSearches.t.Errorf("RepositoryMock.GetDueSavedSearches got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDueSavedSearches.GetDueSavedSearchesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDueSavedSearches.t.Fatal("No results are set for the RepositoryMock.GetDueSavedSearches")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmGetDueSavedSearches.funcGetDueSavedSearches != nil {
+		return mmGetDueSavedSearches.funcGetDueSavedSearches(ctx, afterID, digestBefore, limit)
+	}
+	mmGetDueSavedSearches.t.Fatalf("Unexpected call to RepositoryMock.GetDueSavedSearches. %v %v %v %v", ctx, afterID, digestBefore, limit)
+	return
+}
+
+// GetDueSavedSearchesAfterCounter returns a count of finished RepositoryMock.GetDueSavedSearches invocations
+func (mmGetDueSavedSearches *RepositoryMock) GetDueSavedSearchesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDueSavedSearches.afterGetDueSavedSearchesCounter)
+}
+
+// GetDueSavedSearchesBeforeCounter returns a count of RepositoryMock.GetDueSavedSearches invocations
+func (mmGetDueSavedSearches *RepositoryMock) GetDueSavedSearchesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDueSavedSearches.beforeGetDueSavedSearchesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetDueSavedSearches.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDueSavedSearches *mRepositoryMockGetDueSavedSearches) Calls() []*RepositoryMockGetDueSavedSearchesParams {
+	mmGetDueSavedSearches.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetDueSavedSearchesParams, len(mmGetDueSavedSearches.callArgs))
+	copy(argCopy, mmGetDueSavedSearches.callArgs)
+
+	mmGetDueSavedSearches.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDueSavedSearchesDone returns true if the count of the GetDueSavedSearches invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetDueSavedSearchesDone() bool {
+	if m.GetDueSavedSearchesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDueSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDueSavedSearchesMock.invocationsDone()
+}
+
+// MinimockGetDueSavedSearchesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetDueSavedSearchesInspect() {
+	for _, e := range m.GetDueSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetDueSavedSearches at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDueSavedSearchesCounter := mm_atomic.LoadUint64(&m.afterGetDueSavedSearchesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDueSavedSearchesMock.defaultExpectation != nil && afterGetDueSavedSearchesCounter < 1 {
+		if m.GetDueSavedSearchesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetDueSavedSearches at\n%s", m.GetDueSavedSearchesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetDueSavedSearches at\n%s with params: %#v", m.GetDueSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *m.GetDueSavedSearchesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDueSavedSearches != nil && afterGetDueSavedSearchesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetDueSavedSearches at\n%s", m.funcGetDueSavedSearchesOrigin)
+	}
+
+	if !m.GetDueSavedSearchesMock.invocationsDone() && afterGetDueSavedSearchesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetDueSavedSearches at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDueSavedSearchesMock.expectedInvocations), m.GetDueSavedSearchesMock.expectedInvocationsOrigin, afterGetDueSavedSearchesCounter)
+	}
+}
+
+type mRepositoryMockGetFavoriteListingIDs struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetFavoriteListingIDsExpectation
+	expectations       []*RepositoryMockGetFavoriteListingIDsExpectation
+
+	callArgs []*RepositoryMockGetFavoriteListingIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetFavoriteListingIDsExpectation specifies expectation struct of the Repository.GetFavoriteListingIDs
+type RepositoryMockGetFavoriteListingIDsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetFavoriteListingIDsParams
+	paramPtrs          *RepositoryMockGetFavoriteListingIDsParamPtrs
+	expectationOrigins RepositoryMockGetFavoriteListingIDsExpectationOrigins
+	results            *RepositoryMockGetFavoriteListingIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetFavoriteListingIDsParams contains parameters of the Repository.GetFavoriteListingIDs
+type RepositoryMockGetFavoriteListingIDsParams struct {
+	ctx        context.Context
+	userID     uint64
+	listingIDs []uint64
+}
+
+// RepositoryMockGetFavoriteListingIDsParamPtrs contains pointers to parameters of the Repository.GetFavoriteListingIDs
+type RepositoryMockGetFavoriteListingIDsParamPtrs struct {
+	ctx        *context.Context
+	userID     *uint64
+	listingIDs *[]uint64
+}
+
+// RepositoryMockGetFavoriteListingIDsResults contains results of the Repository.GetFavoriteListingIDs
+type RepositoryMockGetFavoriteListingIDsResults struct {
+	m1  map[uint64]bool
+	err error
+}
+
+// RepositoryMockGetFavoriteListingIDsOrigins contains origins of expectations of the Repository.GetFavoriteListingIDs
+type RepositoryMockGetFavoriteListingIDsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originListingIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Optional() *mRepositoryMockGetFavoriteListingIDs {
+	mmGetFavoriteListingIDs.optional = true
+	return mmGetFavoriteListingIDs
+}
+
+// Expect sets up expected params for Repository.GetFavoriteListingIDs
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Expect(ctx context.Context, userID uint64, listingIDs []uint64) *mRepositoryMockGetFavoriteListingIDs {
+	if mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Set")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation == nil {
+		mmGetFavoriteListingIDs.defaultExpectation = &RepositoryMockGetFavoriteListingIDsExpectation{}
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.paramPtrs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by ExpectParams functions")
+	}
+
+	mmGetFavoriteListingIDs.defaultExpectation.params = &RepositoryMockGetFavoriteListingIDsParams{ctx, userID, listingIDs}
+	mmGetFavoriteListingIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetFavoriteListingIDs.expectations {
+		if minimock.Equal(e.params, mmGetFavoriteListingIDs.defaultExpectation.params) {
+			mmGetFavoriteListingIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFavoriteListingIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFavoriteListingIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetFavoriteListingIDs
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetFavoriteListingIDs {
+	if mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Set")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation == nil {
+		mmGetFavoriteListingIDs.defaultExpectation = &RepositoryMockGetFavoriteListingIDsExpectation{}
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.params != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Expect")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.paramPtrs == nil {
+		mmGetFavoriteListingIDs.defaultExpectation.paramPtrs = &RepositoryMockGetFavoriteListingIDsParamPtrs{}
+	}
+	mmGetFavoriteListingIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetFavoriteListingIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetFavoriteListingIDs
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetFavoriteListingIDs
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) ExpectUserIDParam2(userID uint64) *mRepositoryMockGetFavoriteListingIDs {
+	if mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Set")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation == nil {
+		mmGetFavoriteListingIDs.defaultExpectation = &RepositoryMockGetFavoriteListingIDsExpectation{}
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.params != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Expect")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.paramPtrs == nil {
+		mmGetFavoriteListingIDs.defaultExpectation.paramPtrs = &RepositoryMockGetFavoriteListingIDsParamPtrs{}
+	}
+	mmGetFavoriteListingIDs.defaultExpectation.paramPtrs.userID = &userID
+	mmGetFavoriteListingIDs.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetFavoriteListingIDs
+}
+
+// ExpectListingIDsParam3 sets up expected param listingIDs for Repository.GetFavoriteListingIDs
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) ExpectListingIDsParam3(listingIDs []uint64) *mRepositoryMockGetFavoriteListingIDs {
+	if mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Set")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation == nil {
+		mmGetFavoriteListingIDs.defaultExpectation = &RepositoryMockGetFavoriteListingIDsExpectation{}
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.params != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Expect")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation.paramPtrs == nil {
+		mmGetFavoriteListingIDs.defaultExpectation.paramPtrs = &RepositoryMockGetFavoriteListingIDsParamPtrs{}
+	}
+	mmGetFavoriteListingIDs.defaultExpectation.paramPtrs.listingIDs = &listingIDs
+	mmGetFavoriteListingIDs.defaultExpectation.expectationOrigins.originListingIDs = minimock.CallerInfo(1)
+
+	return mmGetFavoriteListingIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetFavoriteListingIDs
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Inspect(f func(ctx context.Context, userID uint64, listingIDs []uint64)) *mRepositoryMockGetFavoriteListingIDs {
+	if mmGetFavoriteListingIDs.mock.inspectFuncGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetFavoriteListingIDs")
+	}
+
+	mmGetFavoriteListingIDs.mock.inspectFuncGetFavoriteListingIDs = f
+
+	return mmGetFavoriteListingIDs
+}
+
+// Return sets up results that will be returned by Repository.GetFavoriteListingIDs
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Return(m1 map[uint64]bool, err error) *RepositoryMock {
+	if mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Set")
+	}
+
+	if mmGetFavoriteListingIDs.defaultExpectation == nil {
+		mmGetFavoriteListingIDs.defaultExpectation = &RepositoryMockGetFavoriteListingIDsExpectation{mock: mmGetFavoriteListingIDs.mock}
+	}
+	mmGetFavoriteListingIDs.defaultExpectation.results = &RepositoryMockGetFavoriteListingIDsResults{m1, err}
+	mmGetFavoriteListingIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetFavoriteListingIDs.mock
+}
+
+// Set uses given function f to mock the Repository.GetFavoriteListingIDs method
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Set(f func(ctx context.Context, userID uint64, listingIDs []uint64) (m1 map[uint64]bool, err error)) *RepositoryMock {
+	if mmGetFavoriteListingIDs.defaultExpectation != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("Default expectation is already set for the Repository.GetFavoriteListingIDs method")
+	}
+
+	if len(mmGetFavoriteListingIDs.expectations) > 0 {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("Some expectations are already set for the Repository.GetFavoriteListingIDs method")
+	}
+
+	mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs = f
+	mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDsOrigin = minimock.CallerInfo(1)
+	return mmGetFavoriteListingIDs.mock
+}
+
+// When sets expectation for the Repository.GetFavoriteListingIDs which will trigger the result defined by the following
+// Then helper
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) When(ctx context.Context, userID uint64, listingIDs []uint64) *RepositoryMockGetFavoriteListingIDsExpectation {
+	if mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("RepositoryMock.GetFavoriteListingIDs mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetFavoriteListingIDsExpectation{
+		mock:               mmGetFavoriteListingIDs.mock,
+		params:             &RepositoryMockGetFavoriteListingIDsParams{ctx, userID, listingIDs},
+		expectationOrigins: RepositoryMockGetFavoriteListingIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetFavoriteListingIDs.expectations = append(mmGetFavoriteListingIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetFavoriteListingIDs return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetFavoriteListingIDsExpectation) Then(m1 map[uint64]bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetFavoriteListingIDsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetFavoriteListingIDs should be invoked
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Times(n uint64) *mRepositoryMockGetFavoriteListingIDs {
+	if n == 0 {
+		mmGetFavoriteListingIDs.mock.t.Fatalf("Times of RepositoryMock.GetFavoriteListingIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFavoriteListingIDs.expectedInvocations, n)
+	mmGetFavoriteListingIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetFavoriteListingIDs
+}
+
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) invocationsDone() bool {
+	if len(mmGetFavoriteListingIDs.expectations) == 0 && mmGetFavoriteListingIDs.defaultExpectation == nil && mmGetFavoriteListingIDs.mock.funcGetFavoriteListingIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFavoriteListingIDs.mock.afterGetFavoriteListingIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFavoriteListingIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFavoriteListingIDs implements mm_listing.Repository
+func (mmGetFavoriteListingIDs *RepositoryMock) GetFavoriteListingIDs(ctx context.Context, userID uint64, listingIDs []uint64) (m1 map[uint64]bool, err error) {
+	mm_atomic.AddUint64(&mmGetFavoriteListingIDs.beforeGetFavoriteListingIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFavoriteListingIDs.afterGetFavoriteListingIDsCounter, 1)
+
+	mmGetFavoriteListingIDs.t.Helper()
+
+	if mmGetFavoriteListingIDs.inspectFuncGetFavoriteListingIDs != nil {
+		mmGetFavoriteListingIDs.inspectFuncGetFavoriteListingIDs(ctx, userID, listingIDs)
+	}
+
+	mm_params := RepositoryMockGetFavoriteListingIDsParams{ctx, userID, listingIDs}
+
+	// Record call args
+	mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.mutex.Lock()
+	mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.callArgs = append(mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.callArgs, &mm_params)
+	mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.mutex.Unlock()
+
+	for _, e := range mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetFavoriteListingIDsParams{ctx, userID, listingIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFavoriteListingIDs.t.Errorf("RepositoryMock.GetFavoriteListingIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetFavoriteListingIDs.t.Errorf("RepositoryMock.GetFavoriteListingIDs got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.listingIDs != nil && !minimock.Equal(*mm_want_ptrs.listingIDs, mm_got.listingIDs) {
+				mmGetFavoriteListingIDs.t.Errorf("RepositoryMock.GetFavoriteListingIDs got unexpected parameter listingIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.expectationOrigins.originListingIDs, *mm_want_ptrs.listingIDs, mm_got.listingIDs, minimock.Diff(*mm_want_ptrs.listingIDs, mm_got.listingIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFavoriteListingIDs.t.Errorf("RepositoryMock.GetFavoriteListingIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFavoriteListingIDs.GetFavoriteListingIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFavoriteListingIDs.t.Fatal("No results are set for the RepositoryMock.GetFavoriteListingIDs")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetFavoriteListingIDs.funcGetFavoriteListingIDs != nil {
+		return mmGetFavoriteListingIDs.funcGetFavoriteListingIDs(ctx, userID, listingIDs)
+	}
+	mmGetFavoriteListingIDs.t.Fatalf("Unexpected call to RepositoryMock.GetFavoriteListingIDs. %v %v %v", ctx, userID, listingIDs)
+	return
+}
+
+// GetFavoriteListingIDsAfterCounter returns a count of finished RepositoryMock.GetFavoriteListingIDs invocations
+func (mmGetFavoriteListingIDs *RepositoryMock) GetFavoriteListingIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFavoriteListingIDs.afterGetFavoriteListingIDsCounter)
+}
+
+// GetFavoriteListingIDsBeforeCounter returns a count of RepositoryMock.GetFavoriteListingIDs invocations
+func (mmGetFavoriteListingIDs *RepositoryMock) GetFavoriteListingIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFavoriteListingIDs.beforeGetFavoriteListingIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetFavoriteListingIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFavoriteListingIDs *mRepositoryMockGetFavoriteListingIDs) Calls() []*RepositoryMockGetFavoriteListingIDsParams {
+	mmGetFavoriteListingIDs.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetFavoriteListingIDsParams, len(mmGetFavoriteListingIDs.callArgs))
+	copy(argCopy, mmGetFavoriteListingIDs.callArgs)
+
+	mmGetFavoriteListingIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFavoriteListingIDsDone returns true if the count of the GetFavoriteListingIDs invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetFavoriteListingIDsDone() bool {
+	if m.GetFavoriteListingIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFavoriteListingIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFavoriteListingIDsMock.invocationsDone()
+}
+
+// MinimockGetFavoriteListingIDsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetFavoriteListingIDsInspect() {
+	for _, e := range m.GetFavoriteListingIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetFavoriteListingIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetFavoriteListingIDsCounter := mm_atomic.LoadUint64(&m.afterGetFavoriteListingIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFavoriteListingIDsMock.defaultExpectation != nil && afterGetFavoriteListingIDsCounter < 1 {
+		if m.GetFavoriteListingIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetFavoriteListingIDs at\n%s", m.GetFavoriteListingIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetFavoriteListingIDs at\n%s with params: %#v", m.GetFavoriteListingIDsMock.defaultExpectation.expectationOrigins.origin, *m.GetFavoriteListingIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFavoriteListingIDs != nil && afterGetFavoriteListingIDsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetFavoriteListingIDs at\n%s", m.funcGetFavoriteListingIDsOrigin)
+	}
+
+	if !m.GetFavoriteListingIDsMock.invocationsDone() && afterGetFavoriteListingIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetFavoriteListingIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFavoriteListingIDsMock.expectedInvocations), m.GetFavoriteListingIDsMock.expectedInvocationsOrigin, afterGetFavoriteListingIDsCounter)
+	}
+}
+
+type mRepositoryMockGetImageVariants struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetImageVariantsExpectation
+	expectations       []*RepositoryMockGetImageVariantsExpectation
+
+	callArgs []*RepositoryMockGetImageVariantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetImageVariantsExpectation specifies expectation struct of the Repository.GetImageVariants
+type RepositoryMockGetImageVariantsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetImageVariantsParams
+	paramPtrs          *RepositoryMockGetImageVariantsParamPtrs
+	expectationOrigins RepositoryMockGetImageVariantsExpectationOrigins
+	results            *RepositoryMockGetImageVariantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetImageVariantsParams contains parameters of the Repository.GetImageVariants
+type RepositoryMockGetImageVariantsParams struct {
+	ctx  context.Context
+	urls []string
+}
+
+// RepositoryMockGetImageVariantsParamPtrs contains pointers to parameters of the Repository.GetImageVariants
+type RepositoryMockGetImageVariantsParamPtrs struct {
+	ctx  *context.Context
+	urls *[]string
+}
+
+// RepositoryMockGetImageVariantsResults contains results of the Repository.GetImageVariants
+type RepositoryMockGetImageVariantsResults struct {
+	m1  map[string][]*entity.ImageVariant
+	err error
+}
+
+// RepositoryMockGetImageVariantsOrigins contains origins of expectations of the Repository.GetImageVariants
+type RepositoryMockGetImageVariantsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUrls string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Optional() *mRepositoryMockGetImageVariants {
+	mmGetImageVariants.optional = true
+	return mmGetImageVariants
+}
+
+// Expect sets up expected params for Repository.GetImageVariants
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Expect(ctx context.Context, urls []string) *mRepositoryMockGetImageVariants {
+	if mmGetImageVariants.mock.funcGetImageVariants != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Set")
+	}
+
+	if mmGetImageVariants.defaultExpectation == nil {
+		mmGetImageVariants.defaultExpectation = &RepositoryMockGetImageVariantsExpectation{}
+	}
+
+	if mmGetImageVariants.defaultExpectation.paramPtrs != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by ExpectParams functions")
+	}
+
+	mmGetImageVariants.defaultExpectation.params = &RepositoryMockGetImageVariantsParams{ctx, urls}
+	mmGetImageVariants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetImageVariants.expectations {
+		if minimock.Equal(e.params, mmGetImageVariants.defaultExpectation.params) {
+			mmGetImageVariants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetImageVariants.defaultExpectation.params)
+		}
+	}
+
+	return mmGetImageVariants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetImageVariants
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetImageVariants {
+	if mmGetImageVariants.mock.funcGetImageVariants != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Set")
+	}
+
+	if mmGetImageVariants.defaultExpectation == nil {
+		mmGetImageVariants.defaultExpectation = &RepositoryMockGetImageVariantsExpectation{}
+	}
+
+	if mmGetImageVariants.defaultExpectation.params != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Expect")
+	}
+
+	if mmGetImageVariants.defaultExpectation.paramPtrs == nil {
+		mmGetImageVariants.defaultExpectation.paramPtrs = &RepositoryMockGetImageVariantsParamPtrs{}
+	}
+	mmGetImageVariants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetImageVariants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetImageVariants
+}
+
+// ExpectUrlsParam2 sets up expected param urls for Repository.GetImageVariants
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) ExpectUrlsParam2(urls []string) *mRepositoryMockGetImageVariants {
+	if mmGetImageVariants.mock.funcGetImageVariants != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Set")
+	}
+
+	if mmGetImageVariants.defaultExpectation == nil {
+		mmGetImageVariants.defaultExpectation = &RepositoryMockGetImageVariantsExpectation{}
+	}
+
+	if mmGetImageVariants.defaultExpectation.params != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Expect")
+	}
+
+	if mmGetImageVariants.defaultExpectation.paramPtrs == nil {
+		mmGetImageVariants.defaultExpectation.paramPtrs = &RepositoryMockGetImageVariantsParamPtrs{}
+	}
+	mmGetImageVariants.defaultExpectation.paramPtrs.urls = &urls
+	mmGetImageVariants.defaultExpectation.expectationOrigins.originUrls = minimock.CallerInfo(1)
+
+	return mmGetImageVariants
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetImageVariants
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Inspect(f func(ctx context.Context, urls []string)) *mRepositoryMockGetImageVariants {
+	if mmGetImageVariants.mock.inspectFuncGetImageVariants != nil {
+		mmGetImageVariants.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetImageVariants")
+	}
+
+	mmGetImageVariants.mock.inspectFuncGetImageVariants = f
+
+	return mmGetImageVariants
+}
+
+// Return sets up results that will be returned by Repository.GetImageVariants
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Return(m1 map[string][]*entity.ImageVariant, err error) *RepositoryMock {
+	if mmGetImageVariants.mock.funcGetImageVariants != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Set")
+	}
+
+	if mmGetImageVariants.defaultExpectation == nil {
+		mmGetImageVariants.defaultExpectation = &RepositoryMockGetImageVariantsExpectation{mock: mmGetImageVariants.mock}
+	}
+	mmGetImageVariants.defaultExpectation.results = &RepositoryMockGetImageVariantsResults{m1, err}
+	mmGetImageVariants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetImageVariants.mock
+}
+
+// Set uses given function f to mock the Repository.GetImageVariants method
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Set(f func(ctx context.Context, urls []string) (m1 map[string][]*entity.ImageVariant, err error)) *RepositoryMock {
+	if mmGetImageVariants.defaultExpectation != nil {
+		mmGetImageVariants.mock.t.Fatalf("Default expectation is already set for the Repository.GetImageVariants method")
+	}
+
+	if len(mmGetImageVariants.expectations) > 0 {
+		mmGetImageVariants.mock.t.Fatalf("Some expectations are already set for the Repository.GetImageVariants method")
+	}
+
+	mmGetImageVariants.mock.funcGetImageVariants = f
+	mmGetImageVariants.mock.funcGetImageVariantsOrigin = minimock.CallerInfo(1)
+	return mmGetImageVariants.mock
+}
+
+// When sets expectation for the Repository.GetImageVariants which will trigger the result defined by the following
+// Then helper
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) When(ctx context.Context, urls []string) *RepositoryMockGetImageVariantsExpectation {
+	if mmGetImageVariants.mock.funcGetImageVariants != nil {
+		mmGetImageVariants.mock.t.Fatalf("RepositoryMock.GetImageVariants mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetImageVariantsExpectation{
+		mock:               mmGetImageVariants.mock,
+		params:             &RepositoryMockGetImageVariantsParams{ctx, urls},
+		expectationOrigins: RepositoryMockGetImageVariantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetImageVariants.expectations = append(mmGetImageVariants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetImageVariants return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetImageVariantsExpectation) Then(m1 map[string][]*entity.ImageVariant, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetImageVariantsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetImageVariants should be invoked
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Times(n uint64) *mRepositoryMockGetImageVariants {
+	if n == 0 {
+		mmGetImageVariants.mock.t.Fatalf("Times of RepositoryMock.GetImageVariants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetImageVariants.expectedInvocations, n)
+	mmGetImageVariants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetImageVariants
+}
+
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) invocationsDone() bool {
+	if len(mmGetImageVariants.expectations) == 0 && mmGetImageVariants.defaultExpectation == nil && mmGetImageVariants.mock.funcGetImageVariants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetImageVariants.mock.afterGetImageVariantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetImageVariants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetImageVariants implements mm_listing.Repository
+func (mmGetImageVariants *RepositoryMock) GetImageVariants(ctx context.Context, urls []string) (m1 map[string][]*entity.ImageVariant, err error) {
+	mm_atomic.AddUint64(&mmGetImageVariants.beforeGetImageVariantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetImageVariants.afterGetImageVariantsCounter, 1)
+
+	mmGetImageVariants.t.Helper()
+
+	if mmGetImageVariants.inspectFuncGetImageVariants != nil {
+		mmGetImageVariants.inspectFuncGetImageVariants(ctx, urls)
+	}
+
+	mm_params := RepositoryMockGetImageVariantsParams{ctx, urls}
+
+	// Record call args
+	mmGetImageVariants.GetImageVariantsMock.mutex.Lock()
+	mmGetImageVariants.GetImageVariantsMock.callArgs = append(mmGetImageVariants.GetImageVariantsMock.callArgs, &mm_params)
+	mmGetImageVariants.GetImageVariantsMock.mutex.Unlock()
+
+	for _, e := range mmGetImageVariants.GetImageVariantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetImageVariants.GetImageVariantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetImageVariants.GetImageVariantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetImageVariants.GetImageVariantsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetImageVariants.GetImageVariantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetImageVariantsParams{ctx, urls}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetImageVariants.t.Errorf("RepositoryMock.GetImageVariants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetImageVariants.GetImageVariantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.urls != nil && !minimock.Equal(*mm_want_ptrs.urls, mm_got.urls) {
+				mmGetImageVariants.t.Errorf("RepositoryMock.GetImageVariants got unexpected parameter urls, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetImageVariants.GetImageVariantsMock.defaultExpectation.expectationOrigins.originUrls, *mm_want_ptrs.urls, mm_got.urls, minimock.Diff(*mm_want_ptrs.urls, mm_got.urls))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetImageVariants.t.Errorf("RepositoryMock.GetImageVariants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetImageVariants.GetImageVariantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetImageVariants.GetImageVariantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetImageVariants.t.Fatal("No results are set for the RepositoryMock.GetImageVariants")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetImageVariants.funcGetImageVariants != nil {
+		return mmGetImageVariants.funcGetImageVariants(ctx, urls)
+	}
+	mmGetImageVariants.t.Fatalf("Unexpected call to RepositoryMock.GetImageVariants. %v %v", ctx, urls)
+	return
+}
+
+// GetImageVariantsAfterCounter returns a count of finished RepositoryMock.GetImageVariants invocations
+func (mmGetImageVariants *RepositoryMock) GetImageVariantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetImageVariants.afterGetImageVariantsCounter)
+}
+
+// GetImageVariantsBeforeCounter returns a count of RepositoryMock.GetImageVariants invocations
+func (mmGetImageVariants *RepositoryMock) GetImageVariantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetImageVariants.beforeGetImageVariantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetImageVariants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetImageVariants *mRepositoryMockGetImageVariants) Calls() []*RepositoryMockGetImageVariantsParams {
+	mmGetImageVariants.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetImageVariantsParams, len(mmGetImageVariants.callArgs))
+	copy(argCopy, mmGetImageVariants.callArgs)
+
+	mmGetImageVariants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetImageVariantsDone returns true if the count of the GetImageVariants invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetImageVariantsDone() bool {
+	if m.GetImageVariantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetImageVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetImageVariantsMock.invocationsDone()
+}
+
+// MinimockGetImageVariantsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetImageVariantsInspect() {
+	for _, e := range m.GetImageVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetImageVariants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetImageVariantsCounter := mm_atomic.LoadUint64(&m.afterGetImageVariantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetImageVariantsMock.defaultExpectation != nil && afterGetImageVariantsCounter < 1 {
+		if m.GetImageVariantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetImageVariants at\n%s", m.GetImageVariantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetImageVariants at\n%s with params: %#v", m.GetImageVariantsMock.defaultExpectation.expectationOrigins.origin, *m.GetImageVariantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetImageVariants != nil && afterGetImageVariantsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetImageVariants at\n%s", m.funcGetImageVariantsOrigin)
+	}
+
+	if !m.GetImageVariantsMock.invocationsDone() && afterGetImageVariantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetImageVariants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetImageVariantsMock.expectedInvocations), m.GetImageVariantsMock.expectedInvocationsOrigin, afterGetImageVariantsCounter)
+	}
+}
+
+type mRepositoryMockGetListingByID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetListingByIDExpectation
+	expectations       []*RepositoryMockGetListingByIDExpectation
+
+	callArgs []*RepositoryMockGetListingByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetListingByIDExpectation specifies expectation struct of the Repository.GetListingByID
+type RepositoryMockGetListingByIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetListingByIDParams
+	paramPtrs          *RepositoryMockGetListingByIDParamPtrs
+	expectationOrigins RepositoryMockGetListingByIDExpectationOrigins
+	results            *RepositoryMockGetListingByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetListingByIDParams contains parameters of the Repository.GetListingByID
+type RepositoryMockGetListingByIDParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryMockGetListingByIDParamPtrs contains pointers to parameters of the Repository.GetListingByID
+type RepositoryMockGetListingByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryMockGetListingByIDResults contains results of the Repository.GetListingByID
+type RepositoryMockGetListingByIDResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// RepositoryMockGetListingByIDOrigins contains origins of expectations of the Repository.GetListingByID
+type RepositoryMockGetListingByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListingByID *mRepositoryMockGetListingByID) Optional() *mRepositoryMockGetListingByID {
+	mmGetListingByID.optional = true
+	return mmGetListingByID
+}
+
+// Expect sets up expected params for Repository.GetListingByID
+func (mmGetListingByID *mRepositoryMockGetListingByID) Expect(ctx context.Context, id uint64) *mRepositoryMockGetListingByID {
+	if mmGetListingByID.mock.funcGetListingByID != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Set")
+	}
+
+	if mmGetListingByID.defaultExpectation == nil {
+		mmGetListingByID.defaultExpectation = &RepositoryMockGetListingByIDExpectation{}
+	}
+
+	if mmGetListingByID.defaultExpectation.paramPtrs != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetListingByID.defaultExpectation.params = &RepositoryMockGetListingByIDParams{ctx, id}
+	mmGetListingByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListingByID.expectations {
+		if minimock.Equal(e.params, mmGetListingByID.defaultExpectation.params) {
+			mmGetListingByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListingByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListingByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetListingByID
+func (mmGetListingByID *mRepositoryMockGetListingByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetListingByID {
+	if mmGetListingByID.mock.funcGetListingByID != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Set")
+	}
+
+	if mmGetListingByID.defaultExpectation == nil {
+		mmGetListingByID.defaultExpectation = &RepositoryMockGetListingByIDExpectation{}
+	}
+
+	if mmGetListingByID.defaultExpectation.params != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Expect")
+	}
+
+	if mmGetListingByID.defaultExpectation.paramPtrs == nil {
+		mmGetListingByID.defaultExpectation.paramPtrs = &RepositoryMockGetListingByIDParamPtrs{}
+	}
+	mmGetListingByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListingByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListingByID
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.GetListingByID
+func (mmGetListingByID *mRepositoryMockGetListingByID) ExpectIdParam2(id uint64) *mRepositoryMockGetListingByID {
+	if mmGetListingByID.mock.funcGetListingByID != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Set")
+	}
+
+	if mmGetListingByID.defaultExpectation == nil {
+		mmGetListingByID.defaultExpectation = &RepositoryMockGetListingByIDExpectation{}
+	}
+
+	if mmGetListingByID.defaultExpectation.params != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Expect")
+	}
+
+	if mmGetListingByID.defaultExpectation.paramPtrs == nil {
+		mmGetListingByID.defaultExpectation.paramPtrs = &RepositoryMockGetListingByIDParamPtrs{}
+	}
+	mmGetListingByID.defaultExpectation.paramPtrs.id = &id
+	mmGetListingByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetListingByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetListingByID
+func (mmGetListingByID *mRepositoryMockGetListingByID) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryMockGetListingByID {
+	if mmGetListingByID.mock.inspectFuncGetListingByID != nil {
+		mmGetListingByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetListingByID")
+	}
+
+	mmGetListingByID.mock.inspectFuncGetListingByID = f
+
+	return mmGetListingByID
+}
+
+// Return sets up results that will be returned by Repository.GetListingByID
+func (mmGetListingByID *mRepositoryMockGetListingByID) Return(lp1 *entity.Listing, err error) *RepositoryMock {
+	if mmGetListingByID.mock.funcGetListingByID != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Set")
+	}
+
+	if mmGetListingByID.defaultExpectation == nil {
+		mmGetListingByID.defaultExpectation = &RepositoryMockGetListingByIDExpectation{mock: mmGetListingByID.mock}
+	}
+	mmGetListingByID.defaultExpectation.results = &RepositoryMockGetListingByIDResults{lp1, err}
+	mmGetListingByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListingByID.mock
+}
+
+// Set uses given function f to mock the Repository.GetListingByID method
+func (mmGetListingByID *mRepositoryMockGetListingByID) Set(f func(ctx context.Context, id uint64) (lp1 *entity.Listing, err error)) *RepositoryMock {
+	if mmGetListingByID.defaultExpectation != nil {
+		mmGetListingByID.mock.t.Fatalf("Default expectation is already set for the Repository.GetListingByID method")
+	}
+
+	if len(mmGetListingByID.expectations) > 0 {
+		mmGetListingByID.mock.t.Fatalf("Some expectations are already set for the Repository.GetListingByID method")
+	}
+
+	mmGetListingByID.mock.funcGetListingByID = f
+	mmGetListingByID.mock.funcGetListingByIDOrigin = minimock.CallerInfo(1)
+	return mmGetListingByID.mock
+}
+
+// When sets expectation for the Repository.GetListingByID which will trigger the result defined by the following
+// Then helper
+func (mmGetListingByID *mRepositoryMockGetListingByID) When(ctx context.Context, id uint64) *RepositoryMockGetListingByIDExpectation {
+	if mmGetListingByID.mock.funcGetListingByID != nil {
+		mmGetListingByID.mock.t.Fatalf("RepositoryMock.GetListingByID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetListingByIDExpectation{
+		mock:               mmGetListingByID.mock,
+		params:             &RepositoryMockGetListingByIDParams{ctx, id},
+		expectationOrigins: RepositoryMockGetListingByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListingByID.expectations = append(mmGetListingByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetListingByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetListingByIDExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetListingByIDResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetListingByID should be invoked
+func (mmGetListingByID *mRepositoryMockGetListingByID) Times(n uint64) *mRepositoryMockGetListingByID {
+	if n == 0 {
+		mmGetListingByID.mock.t.Fatalf("Times of RepositoryMock.GetListingByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListingByID.expectedInvocations, n)
+	mmGetListingByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListingByID
+}
+
+func (mmGetListingByID *mRepositoryMockGetListingByID) invocationsDone() bool {
+	if len(mmGetListingByID.expectations) == 0 && mmGetListingByID.defaultExpectation == nil && mmGetListingByID.mock.funcGetListingByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListingByID.mock.afterGetListingByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListingByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListingByID implements mm_listing.Repository
+func (mmGetListingByID *RepositoryMock) GetListingByID(ctx context.Context, id uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmGetListingByID.beforeGetListingByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListingByID.afterGetListingByIDCounter, 1)
+
+	mmGetListingByID.t.Helper()
+
+	if mmGetListingByID.inspectFuncGetListingByID != nil {
+		mmGetListingByID.inspectFuncGetListingByID(ctx, id)
+	}
+
+	mm_params := RepositoryMockGetListingByIDParams{ctx, id}
+
+	// Record call args
+	mmGetListingByID.GetListingByIDMock.mutex.Lock()
+	mmGetListingByID.GetListingByIDMock.callArgs = append(mmGetListingByID.GetListingByIDMock.callArgs, &mm_params)
+	mmGetListingByID.GetListingByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetListingByID.GetListingByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetListingByID.GetListingByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListingByID.GetListingByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListingByID.GetListingByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListingByID.GetListingByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetListingByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListingByID.t.Errorf("RepositoryMock.GetListingByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingByID.GetListingByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetListingByID.t.Errorf("RepositoryMock.GetListingByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingByID.GetListingByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListingByID.t.Errorf("RepositoryMock.GetListingByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListingByID.GetListingByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListingByID.GetListingByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListingByID.t.Fatal("No results are set for the RepositoryMock.GetListingByID")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetListingByID.funcGetListingByID != nil {
+		return mmGetListingByID.funcGetListingByID(ctx, id)
+	}
+	mmGetListingByID.t.Fatalf("Unexpected call to RepositoryMock.GetListingByID. %v %v", ctx, id)
+	return
+}
+
+// GetListingByIDAfterCounter returns a count of finished RepositoryMock.GetListingByID invocations
+func (mmGetListingByID *RepositoryMock) GetListingByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingByID.afterGetListingByIDCounter)
+}
+
+// GetListingByIDBeforeCounter returns a count of RepositoryMock.GetListingByID invocations
+func (mmGetListingByID *RepositoryMock) GetListingByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingByID.beforeGetListingByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetListingByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListingByID *mRepositoryMockGetListingByID) Calls() []*RepositoryMockGetListingByIDParams {
+	mmGetListingByID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetListingByIDParams, len(mmGetListingByID.callArgs))
+	copy(argCopy, mmGetListingByID.callArgs)
+
+	mmGetListingByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingByIDDone returns true if the count of the GetListingByID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetListingByIDDone() bool {
+	if m.GetListingByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingByIDMock.invocationsDone()
+}
+
+// MinimockGetListingByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetListingByIDInspect() {
+	for _, e := range m.GetListingByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingByIDCounter := mm_atomic.LoadUint64(&m.afterGetListingByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingByIDMock.defaultExpectation != nil && afterGetListingByIDCounter < 1 {
+		if m.GetListingByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingByID at\n%s", m.GetListingByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingByID at\n%s with params: %#v", m.GetListingByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetListingByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListingByID != nil && afterGetListingByIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetListingByID at\n%s", m.funcGetListingByIDOrigin)
+	}
+
+	if !m.GetListingByIDMock.invocationsDone() && afterGetListingByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetListingByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingByIDMock.expectedInvocations), m.GetListingByIDMock.expectedInvocationsOrigin, afterGetListingByIDCounter)
+	}
+}
+
+type mRepositoryMockGetListingFacets struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetListingFacetsExpectation
+	expectations       []*RepositoryMockGetListingFacetsExpectation
+
+	callArgs []*RepositoryMockGetListingFacetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetListingFacetsExpectation specifies expectation struct of the Repository.GetListingFacets
+type RepositoryMockGetListingFacetsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetListingFacetsParams
+	paramPtrs          *RepositoryMockGetListingFacetsParamPtrs
+	expectationOrigins RepositoryMockGetListingFacetsExpectationOrigins
+	results            *RepositoryMockGetListingFacetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetListingFacetsParams contains parameters of the Repository.GetListingFacets
+type RepositoryMockGetListingFacetsParams struct {
+	ctx          context.Context
+	filter       *entity.ListingFilter
+	priceBuckets []entity.Money
+}
+
+// RepositoryMockGetListingFacetsParamPtrs contains pointers to parameters of the Repository.GetListingFacets
+type RepositoryMockGetListingFacetsParamPtrs struct {
+	ctx          *context.Context
+	filter       **entity.ListingFilter
+	priceBuckets *[]entity.Money
+}
+
+// RepositoryMockGetListingFacetsResults contains results of the Repository.GetListingFacets
+type RepositoryMockGetListingFacetsResults struct {
+	lp1 *entity.ListingFacets
+	err error
+}
+
+// RepositoryMockGetListingFacetsOrigins contains origins of expectations of the Repository.GetListingFacets
+type RepositoryMockGetListingFacetsExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originFilter       string
+	originPriceBuckets string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Optional() *mRepositoryMockGetListingFacets {
+	mmGetListingFacets.optional = true
+	return mmGetListingFacets
+}
+
+// Expect sets up expected params for Repository.GetListingFacets
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Expect(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) *mRepositoryMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &RepositoryMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by ExpectParams functions")
+	}
+
+	mmGetListingFacets.defaultExpectation.params = &RepositoryMockGetListingFacetsParams{ctx, filter, priceBuckets}
+	mmGetListingFacets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListingFacets.expectations {
+		if minimock.Equal(e.params, mmGetListingFacets.defaultExpectation.params) {
+			mmGetListingFacets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListingFacets.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListingFacets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetListingFacets
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &RepositoryMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &RepositoryMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// ExpectFilterParam2 sets up expected param filter for Repository.GetListingFacets
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) ExpectFilterParam2(filter *entity.ListingFilter) *mRepositoryMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &RepositoryMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &RepositoryMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.filter = &filter
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// ExpectPriceBucketsParam3 sets up expected param priceBuckets for Repository.GetListingFacets
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) ExpectPriceBucketsParam3(priceBuckets []entity.Money) *mRepositoryMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &RepositoryMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &RepositoryMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.priceBuckets = &priceBuckets
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originPriceBuckets = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetListingFacets
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Inspect(f func(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money)) *mRepositoryMockGetListingFacets {
+	if mmGetListingFacets.mock.inspectFuncGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetListingFacets")
+	}
+
+	mmGetListingFacets.mock.inspectFuncGetListingFacets = f
+
+	return mmGetListingFacets
+}
+
+// Return sets up results that will be returned by Repository.GetListingFacets
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Return(lp1 *entity.ListingFacets, err error) *RepositoryMock {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &RepositoryMockGetListingFacetsExpectation{mock: mmGetListingFacets.mock}
+	}
+	mmGetListingFacets.defaultExpectation.results = &RepositoryMockGetListingFacetsResults{lp1, err}
+	mmGetListingFacets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListingFacets.mock
+}
+
+// Set uses given function f to mock the Repository.GetListingFacets method
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Set(f func(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) (lp1 *entity.ListingFacets, err error)) *RepositoryMock {
+	if mmGetListingFacets.defaultExpectation != nil {
+		mmGetListingFacets.mock.t.Fatalf("Default expectation is already set for the Repository.GetListingFacets method")
+	}
+
+	if len(mmGetListingFacets.expectations) > 0 {
+		mmGetListingFacets.mock.t.Fatalf("Some expectations are already set for the Repository.GetListingFacets method")
+	}
+
+	mmGetListingFacets.mock.funcGetListingFacets = f
+	mmGetListingFacets.mock.funcGetListingFacetsOrigin = minimock.CallerInfo(1)
+	return mmGetListingFacets.mock
+}
+
+// When sets expectation for the Repository.GetListingFacets which will trigger the result defined by the following
+// Then helper
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) When(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) *RepositoryMockGetListingFacetsExpectation {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("RepositoryMock.GetListingFacets mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetListingFacetsExpectation{
+		mock:               mmGetListingFacets.mock,
+		params:             &RepositoryMockGetListingFacetsParams{ctx, filter, priceBuckets},
+		expectationOrigins: RepositoryMockGetListingFacetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListingFacets.expectations = append(mmGetListingFacets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetListingFacets return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetListingFacetsExpectation) Then(lp1 *entity.ListingFacets, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetListingFacetsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetListingFacets should be invoked
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Times(n uint64) *mRepositoryMockGetListingFacets {
+	if n == 0 {
+		mmGetListingFacets.mock.t.Fatalf("Times of RepositoryMock.GetListingFacets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListingFacets.expectedInvocations, n)
+	mmGetListingFacets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListingFacets
+}
+
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) invocationsDone() bool {
+	if len(mmGetListingFacets.expectations) == 0 && mmGetListingFacets.defaultExpectation == nil && mmGetListingFacets.mock.funcGetListingFacets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListingFacets.mock.afterGetListingFacetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListingFacets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListingFacets implements mm_listing.Repository
+func (mmGetListingFacets *RepositoryMock) GetListingFacets(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) (lp1 *entity.ListingFacets, err error) {
+	mm_atomic.AddUint64(&mmGetListingFacets.beforeGetListingFacetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListingFacets.afterGetListingFacetsCounter, 1)
+
+	mmGetListingFacets.t.Helper()
+
+	if mmGetListingFacets.inspectFuncGetListingFacets != nil {
+		mmGetListingFacets.inspectFuncGetListingFacets(ctx, filter, priceBuckets)
+	}
+
+	mm_params := RepositoryMockGetListingFacetsParams{ctx, filter, priceBuckets}
+
+	// Record call args
+	mmGetListingFacets.GetListingFacetsMock.mutex.Lock()
+	mmGetListingFacets.GetListingFacetsMock.callArgs = append(mmGetListingFacets.GetListingFacetsMock.callArgs, &mm_params)
+	mmGetListingFacets.GetListingFacetsMock.mutex.Unlock()
+
+	for _, e := range mmGetListingFacets.GetListingFacetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetListingFacets.GetListingFacetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListingFacets.GetListingFacetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListingFacets.GetListingFacetsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListingFacets.GetListingFacetsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetListingFacetsParams{ctx, filter, priceBuckets}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListingFacets.t.Errorf("RepositoryMock.GetListingFacets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetListingFacets.t.Errorf("RepositoryMock.GetListingFacets got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.priceBuckets != nil && !minimock.Equal(*mm_want_ptrs.priceBuckets, mm_got.priceBuckets) {
+				mmGetListingFacets.t.Errorf("RepositoryMock.GetListingFacets got unexpected parameter priceBuckets, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originPriceBuckets, *mm_want_ptrs.priceBuckets, mm_got.priceBuckets, minimock.Diff(*mm_want_ptrs.priceBuckets, mm_got.priceBuckets))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListingFacets.t.Errorf("RepositoryMock.GetListingFacets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListingFacets.GetListingFacetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListingFacets.t.Fatal("No results are set for the RepositoryMock.GetListingFacets")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetListingFacets.funcGetListingFacets != nil {
+		return mmGetListingFacets.funcGetListingFacets(ctx, filter, priceBuckets)
+	}
+	mmGetListingFacets.t.Fatalf("Unexpected call to RepositoryMock.GetListingFacets. %v %v %v", ctx, filter, priceBuckets)
+	return
+}
+
+// GetListingFacetsAfterCounter returns a count of finished RepositoryMock.GetListingFacets invocations
+func (mmGetListingFacets *RepositoryMock) GetListingFacetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingFacets.afterGetListingFacetsCounter)
+}
+
+// GetListingFacetsBeforeCounter returns a count of RepositoryMock.GetListingFacets invocations
+func (mmGetListingFacets *RepositoryMock) GetListingFacetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingFacets.beforeGetListingFacetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetListingFacets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListingFacets *mRepositoryMockGetListingFacets) Calls() []*RepositoryMockGetListingFacetsParams {
+	mmGetListingFacets.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetListingFacetsParams, len(mmGetListingFacets.callArgs))
+	copy(argCopy, mmGetListingFacets.callArgs)
+
+	mmGetListingFacets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingFacetsDone returns true if the count of the GetListingFacets invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetListingFacetsDone() bool {
+	if m.GetListingFacetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingFacetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingFacetsMock.invocationsDone()
+}
+
+// MinimockGetListingFacetsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetListingFacetsInspect() {
+	for _, e := range m.GetListingFacetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingFacets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingFacetsCounter := mm_atomic.LoadUint64(&m.afterGetListingFacetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingFacetsMock.defaultExpectation != nil && afterGetListingFacetsCounter < 1 {
+		if m.GetListingFacetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingFacets at\n%s", m.GetListingFacetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingFacets at\n%s with params: %#v", m.GetListingFacetsMock.defaultExpectation.expectationOrigins.origin, *m.GetListingFacetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListingFacets != nil && afterGetListingFacetsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetListingFacets at\n%s", m.funcGetListingFacetsOrigin)
+	}
+
+	if !m.GetListingFacetsMock.invocationsDone() && afterGetListingFacetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetListingFacets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingFacetsMock.expectedInvocations), m.GetListingFacetsMock.expectedInvocationsOrigin, afterGetListingFacetsCounter)
+	}
+}
+
+type mRepositoryMockGetListingImages struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetListingImagesExpectation
+	expectations       []*RepositoryMockGetListingImagesExpectation
+
+	callArgs []*RepositoryMockGetListingImagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetListingImagesExpectation specifies expectation struct of the Repository.GetListingImages
+type RepositoryMockGetListingImagesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetListingImagesParams
+	paramPtrs          *RepositoryMockGetListingImagesParamPtrs
+	expectationOrigins RepositoryMockGetListingImagesExpectationOrigins
+	results            *RepositoryMockGetListingImagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetListingImagesParams contains parameters of the Repository.GetListingImages
+type RepositoryMockGetListingImagesParams struct {
+	ctx       context.Context
+	listingID uint64
+}
+
+// RepositoryMockGetListingImagesParamPtrs contains pointers to parameters of the Repository.GetListingImages
+type RepositoryMockGetListingImagesParamPtrs struct {
+	ctx       *context.Context
+	listingID *uint64
+}
+
+// RepositoryMockGetListingImagesResults contains results of the Repository.GetListingImages
+type RepositoryMockGetListingImagesResults struct {
+	lpa1 []*entity.ListingImage
+	err  error
+}
+
+// RepositoryMockGetListingImagesOrigins contains origins of expectations of the Repository.GetListingImages
+type RepositoryMockGetListingImagesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originListingID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListingImages *mRepositoryMockGetListingImages) Optional() *mRepositoryMockGetListingImages {
+	mmGetListingImages.optional = true
+	return mmGetListingImages
+}
+
+// Expect sets up expected params for Repository.GetListingImages
+func (mmGetListingImages *mRepositoryMockGetListingImages) Expect(ctx context.Context, listingID uint64) *mRepositoryMockGetListingImages {
+	if mmGetListingImages.mock.funcGetListingImages != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Set")
+	}
+
+	if mmGetListingImages.defaultExpectation == nil {
+		mmGetListingImages.defaultExpectation = &RepositoryMockGetListingImagesExpectation{}
+	}
+
+	if mmGetListingImages.defaultExpectation.paramPtrs != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by ExpectParams functions")
+	}
+
+	mmGetListingImages.defaultExpectation.params = &RepositoryMockGetListingImagesParams{ctx, listingID}
+	mmGetListingImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListingImages.expectations {
+		if minimock.Equal(e.params, mmGetListingImages.defaultExpectation.params) {
+			mmGetListingImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListingImages.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListingImages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetListingImages
+func (mmGetListingImages *mRepositoryMockGetListingImages) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetListingImages {
+	if mmGetListingImages.mock.funcGetListingImages != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Set")
+	}
+
+	if mmGetListingImages.defaultExpectation == nil {
+		mmGetListingImages.defaultExpectation = &RepositoryMockGetListingImagesExpectation{}
+	}
+
+	if mmGetListingImages.defaultExpectation.params != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Expect")
+	}
+
+	if mmGetListingImages.defaultExpectation.paramPtrs == nil {
+		mmGetListingImages.defaultExpectation.paramPtrs = &RepositoryMockGetListingImagesParamPtrs{}
+	}
+	mmGetListingImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListingImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListingImages
+}
+
+// ExpectListingIDParam2 sets up expected param listingID for Repository.GetListingImages
+func (mmGetListingImages *mRepositoryMockGetListingImages) ExpectListingIDParam2(listingID uint64) *mRepositoryMockGetListingImages {
+	if mmGetListingImages.mock.funcGetListingImages != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Set")
+	}
+
+	if mmGetListingImages.defaultExpectation == nil {
+		mmGetListingImages.defaultExpectation = &RepositoryMockGetListingImagesExpectation{}
+	}
+
+	if mmGetListingImages.defaultExpectation.params != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Expect")
+	}
+
+	if mmGetListingImages.defaultExpectation.paramPtrs == nil {
+		mmGetListingImages.defaultExpectation.paramPtrs = &RepositoryMockGetListingImagesParamPtrs{}
+	}
+	mmGetListingImages.defaultExpectation.paramPtrs.listingID = &listingID
+	mmGetListingImages.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmGetListingImages
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetListingImages
+func (mmGetListingImages *mRepositoryMockGetListingImages) Inspect(f func(ctx context.Context, listingID uint64)) *mRepositoryMockGetListingImages {
+	if mmGetListingImages.mock.inspectFuncGetListingImages != nil {
+		mmGetListingImages.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetListingImages")
+	}
+
+	mmGetListingImages.mock.inspectFuncGetListingImages = f
+
+	return mmGetListingImages
+}
+
+// Return sets up results that will be returned by Repository.GetListingImages
+func (mmGetListingImages *mRepositoryMockGetListingImages) Return(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	if mmGetListingImages.mock.funcGetListingImages != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Set")
+	}
+
+	if mmGetListingImages.defaultExpectation == nil {
+		mmGetListingImages.defaultExpectation = &RepositoryMockGetListingImagesExpectation{mock: mmGetListingImages.mock}
+	}
+	mmGetListingImages.defaultExpectation.results = &RepositoryMockGetListingImagesResults{lpa1, err}
+	mmGetListingImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListingImages.mock
+}
+
+// Set uses given function f to mock the Repository.GetListingImages method
+func (mmGetListingImages *mRepositoryMockGetListingImages) Set(f func(ctx context.Context, listingID uint64) (lpa1 []*entity.ListingImage, err error)) *RepositoryMock {
+	if mmGetListingImages.defaultExpectation != nil {
+		mmGetListingImages.mock.t.Fatalf("Default expectation is already set for the Repository.GetListingImages method")
+	}
+
+	if len(mmGetListingImages.expectations) > 0 {
+		mmGetListingImages.mock.t.Fatalf("Some expectations are already set for the Repository.GetListingImages method")
+	}
+
+	mmGetListingImages.mock.funcGetListingImages = f
+	mmGetListingImages.mock.funcGetListingImagesOrigin = minimock.CallerInfo(1)
+	return mmGetListingImages.mock
+}
+
+// When sets expectation for the Repository.GetListingImages which will trigger the result defined by the following
+// Then helper
+func (mmGetListingImages *mRepositoryMockGetListingImages) When(ctx context.Context, listingID uint64) *RepositoryMockGetListingImagesExpectation {
+	if mmGetListingImages.mock.funcGetListingImages != nil {
+		mmGetListingImages.mock.t.Fatalf("RepositoryMock.GetListingImages mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetListingImagesExpectation{
+		mock:               mmGetListingImages.mock,
+		params:             &RepositoryMockGetListingImagesParams{ctx, listingID},
+		expectationOrigins: RepositoryMockGetListingImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListingImages.expectations = append(mmGetListingImages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetListingImages return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetListingImagesExpectation) Then(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetListingImagesResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetListingImages should be invoked
+func (mmGetListingImages *mRepositoryMockGetListingImages) Times(n uint64) *mRepositoryMockGetListingImages {
+	if n == 0 {
+		mmGetListingImages.mock.t.Fatalf("Times of RepositoryMock.GetListingImages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListingImages.expectedInvocations, n)
+	mmGetListingImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListingImages
+}
+
+func (mmGetListingImages *mRepositoryMockGetListingImages) invocationsDone() bool {
+	if len(mmGetListingImages.expectations) == 0 && mmGetListingImages.defaultExpectation == nil && mmGetListingImages.mock.funcGetListingImages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListingImages.mock.afterGetListingImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListingImages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListingImages implements mm_listing.Repository
+func (mmGetListingImages *RepositoryMock) GetListingImages(ctx context.Context, listingID uint64) (lpa1 []*entity.ListingImage, err error) {
+	mm_atomic.AddUint64(&mmGetListingImages.beforeGetListingImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListingImages.afterGetListingImagesCounter, 1)
+
+	mmGetListingImages.t.Helper()
+
+	if mmGetListingImages.inspectFuncGetListingImages != nil {
+		mmGetListingImages.inspectFuncGetListingImages(ctx, listingID)
+	}
+
+	mm_params := RepositoryMockGetListingImagesParams{ctx, listingID}
+
+	// Record call args
+	mmGetListingImages.GetListingImagesMock.mutex.Lock()
+	mmGetListingImages.GetListingImagesMock.callArgs = append(mmGetListingImages.GetListingImagesMock.callArgs, &mm_params)
+	mmGetListingImages.GetListingImagesMock.mutex.Unlock()
+
+	for _, e := range mmGetListingImages.GetListingImagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmGetListingImages.GetListingImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListingImages.GetListingImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListingImages.GetListingImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListingImages.GetListingImagesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetListingImagesParams{ctx, listingID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListingImages.t.Errorf("RepositoryMock.GetListingImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingImages.GetListingImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmGetListingImages.t.Errorf("RepositoryMock.GetListingImages got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingImages.GetListingImagesMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListingImages.t.Errorf("RepositoryMock.GetListingImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListingImages.GetListingImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListingImages.GetListingImagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListingImages.t.Fatal("No results are set for the RepositoryMock.GetListingImages")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmGetListingImages.funcGetListingImages != nil {
+		return mmGetListingImages.funcGetListingImages(ctx, listingID)
+	}
+	mmGetListingImages.t.Fatalf("Unexpected call to RepositoryMock.GetListingImages. %v %v", ctx, listingID)
+	return
+}
+
+// GetListingImagesAfterCounter returns a count of finished RepositoryMock.GetListingImages invocations
+func (mmGetListingImages *RepositoryMock) GetListingImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingImages.afterGetListingImagesCounter)
+}
+
+// GetListingImagesBeforeCounter returns a count of RepositoryMock.GetListingImages invocations
+func (mmGetListingImages *RepositoryMock) GetListingImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingImages.beforeGetListingImagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetListingImages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListingImages *mRepositoryMockGetListingImages) Calls() []*RepositoryMockGetListingImagesParams {
+	mmGetListingImages.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetListingImagesParams, len(mmGetListingImages.callArgs))
+	copy(argCopy, mmGetListingImages.callArgs)
+
+	mmGetListingImages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingImagesDone returns true if the count of the GetListingImages invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetListingImagesDone() bool {
+	if m.GetListingImagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingImagesMock.invocationsDone()
+}
+
+// MinimockGetListingImagesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetListingImagesInspect() {
+	for _, e := range m.GetListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingImagesCounter := mm_atomic.LoadUint64(&m.afterGetListingImagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingImagesMock.defaultExpectation != nil && afterGetListingImagesCounter < 1 {
+		if m.GetListingImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingImages at\n%s", m.GetListingImagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetListingImages at\n%s with params: %#v", m.GetListingImagesMock.defaultExpectation.expectationOrigins.origin, *m.GetListingImagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListingImages != nil && afterGetListingImagesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetListingImages at\n%s", m.funcGetListingImagesOrigin)
+	}
+
+	if !m.GetListingImagesMock.invocationsDone() && afterGetListingImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetListingImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingImagesMock.expectedInvocations), m.GetListingImagesMock.expectedInvocationsOrigin, afterGetListingImagesCounter)
+	}
+}
+
+type mRepositoryMockGetListings struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetListingsExpectation
+	expectations       []*RepositoryMockGetListingsExpectation
+
+	callArgs []*RepositoryMockGetListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetListingsExpectation specifies expectation struct of the Repository.GetListings
+type RepositoryMockGetListingsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetListingsParams
+	paramPtrs          *RepositoryMockGetListingsParamPtrs
+	expectationOrigins RepositoryMockGetListingsExpectationOrigins
+	results            *RepositoryMockGetListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetListingsParams contains parameters of the Repository.GetListings
+type RepositoryMockGetListingsParams struct {
+	ctx    context.Context
+	filter *entity.ListingFilter
+}
+
+// RepositoryMockGetListingsParamPtrs contains pointers to parameters of the Repository.GetListings
+type RepositoryMockGetListingsParamPtrs struct {
+	ctx    *context.Context
+	filter **entity.ListingFilter
+}
+
+// RepositoryMockGetListingsResults contains results of the Repository.GetListings
+type RepositoryMockGetListingsResults struct {
+	lp1 *entity.ListingPage
+	err error
+}
+
+// RepositoryMockGetListingsOrigins contains origins of expectations of the Repository.GetListings
+type RepositoryMockGetListingsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListings *mRepositoryMockGetListings) Optional() *mRepositoryMockGetListings {
+	mmGetListings.optional = true
+	return mmGetListings
+}
+
+// Expect sets up expected params for Repository.GetListings
+func (mmGetListings *mRepositoryMockGetListings) Expect(ctx context.Context, filter *entity.ListingFilter) *mRepositoryMockGetListings {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{}
+	}
+
+	if mmGetListings.defaultExpectation.paramPtrs != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by ExpectParams functions")
+	}
+
+	mmGetListings.defaultExpectation.params = &RepositoryMockGetListingsParams{ctx, filter}
+	mmGetListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListings.expectations {
+		if minimock.Equal(e.params, mmGetListings.defaultExpectation.params) {
+			mmGetListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListings.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetListings
+func (mmGetListings *mRepositoryMockGetListings) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetListings {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{}
+	}
+
+	if mmGetListings.defaultExpectation.params != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Expect")
+	}
+
+	if mmGetListings.defaultExpectation.paramPtrs == nil {
+		mmGetListings.defaultExpectation.paramPtrs = &RepositoryMockGetListingsParamPtrs{}
+	}
+	mmGetListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListings
+}
+
+// ExpectFilterParam2 sets up expected param filter for Repository.GetListings
+func (mmGetListings *mRepositoryMockGetListings) ExpectFilterParam2(filter *entity.ListingFilter) *mRepositoryMockGetListings {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{}
+	}
+
+	if mmGetListings.defaultExpectation.params != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Expect")
+	}
+
+	if mmGetListings.defaultExpectation.paramPtrs == nil {
+		mmGetListings.defaultExpectation.paramPtrs = &RepositoryMockGetListingsParamPtrs{}
+	}
+	mmGetListings.defaultExpectation.paramPtrs.filter = &filter
+	mmGetListings.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetListings
+func (mmGetListings *mRepositoryMockGetListings) Inspect(f func(ctx context.Context, filter *entity.ListingFilter)) *mRepositoryMockGetListings {
+	if mmGetListings.mock.inspectFuncGetListings != nil {
+		mmGetListings.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetListings")
+	}
+
+	mmGetListings.mock.inspectFuncGetListings = f
+
+	return mmGetListings
+}
+
+// Return sets up results that will be returned by Repository.GetListings
+func (mmGetListings *mRepositoryMockGetListings) Return(lp1 *entity.ListingPage, err error) *RepositoryMock {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{mock: mmGetListings.mock}
+	}
+	mmGetListings.defaultExpectation.results = &RepositoryMockGetListingsResults{lp1, err}
+	mmGetListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListings.mock
+}
+
+// Set uses given function f to mock the Repository.GetListings method
+func (mmGetListings *mRepositoryMockGetListings) Set(f func(ctx context.Context, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)) *RepositoryMock {
+	if mmGetListings.defaultExpectation != nil {
+		mmGetListings.mock.t.Fatalf("Default expectation is already set for the Repository.GetListings method")
+	}
+
+	if len(mmGetListings.expectations) > 0 {
+		mmGetListings.mock.t.Fatalf("Some expectations are already set for the Repository.GetListings method")
+	}
+
+	mmGetListings.mock.funcGetListings = f
+	mmGetListings.mock.funcGetListingsOrigin = minimock.CallerInfo(1)
+	return mmGetListings.mock
+}
+
+// When sets expectation for the Repository.GetListings which will trigger the result defined by the following
+// Then helper
+func (mmGetListings *mRepositoryMockGetListings) When(ctx context.Context, filter *entity.ListingFilter) *RepositoryMockGetListingsExpectation {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetListingsExpectation{
+		mock:               mmGetListings.mock,
+		params:             &RepositoryMockGetListingsParams{ctx, filter},
+		expectationOrigins: RepositoryMockGetListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListings.expectations = append(mmGetListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetListings return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetListingsExpectation) Then(lp1 *entity.ListingPage, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetListingsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetListings should be invoked
+func (mmGetListings *mRepositoryMockGetListings) Times(n uint64) *mRepositoryMockGetListings {
+	if n == 0 {
+		mmGetListings.mock.t.Fatalf("Times of RepositoryMock.GetListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListings.expectedInvocations, n)
+	mmGetListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListings
+}
+
+func (mmGetListings *mRepositoryMockGetListings) invocationsDone() bool {
+	if len(mmGetListings.expectations) == 0 && mmGetListings.defaultExpectation == nil && mmGetListings.mock.funcGetListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListings.mock.afterGetListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListings implements mm_listing.Repository
+func (mmGetListings *RepositoryMock) GetListings(ctx context.Context, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error) {
+	mm_atomic.AddUint64(&mmGetListings.beforeGetListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListings.afterGetListingsCounter, 1)
+
+	mmGetListings.t.Helper()
+
+	if mmGetListings.inspectFuncGetListings != nil {
+		mmGetListings.inspectFuncGetListings(ctx, filter)
+	}
+
+	mm_params := RepositoryMockGetListingsParams{ctx, filter}
+
+	// Record call args
+	mmGetListings.GetListingsMock.mutex.Lock()
+	mmGetListings.GetListingsMock.callArgs = append(mmGetListings.GetListingsMock.callArgs, &mm_params)
+	mmGetListings.GetListingsMock.mutex.Unlock()
+
+	for _, e := range mmGetListings.GetListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetListings.GetListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListings.GetListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListings.GetListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListings.GetListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetListingsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListings.t.Errorf("RepositoryMock.GetListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetListings.t.Errorf("RepositoryMock.GetListings got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListings.t.Errorf("RepositoryMock.GetListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListings.GetListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListings.t.Fatal("No results are set for the RepositoryMock.GetListings")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetListings.funcGetListings != nil {
+		return mmGetListings.funcGetListings(ctx, filter)
+	}
+	mmGetListings.t.Fatalf("Unexpected call to RepositoryMock.GetListings. %v %v", ctx, filter)
+	return
+}
+
+// GetListingsAfterCounter returns a count of finished RepositoryMock.GetListings invocations
+func (mmGetListings *RepositoryMock) GetListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListings.afterGetListingsCounter)
+}
+
+// GetListingsBeforeCounter returns a count of RepositoryMock.GetListings invocations
+func (mmGetListings *RepositoryMock) GetListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListings.beforeGetListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListings *mRepositoryMockGetListings) Calls() []*RepositoryMockGetListingsParams {
+	mmGetListings.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetListingsParams, len(mmGetListings.callArgs))
+	copy(argCopy, mmGetListings.callArgs)
+
+	mmGetListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingsDone returns true if the count of the GetListings invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetListingsDone() bool {
+	if m.GetListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingsMock.invocationsDone()
+}
+
+// MinimockGetListingsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetListingsInspect() {
+	for _, e := range m.GetListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingsCounter := mm_atomic.LoadUint64(&m.afterGetListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingsMock.defaultExpectation != nil && afterGetListingsCounter < 1 {
+		if m.GetListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s", m.GetListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s with params: %#v", m.GetListingsMock.defaultExpectation.expectationOrigins.origin, *m.GetListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListings != nil && afterGetListingsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s", m.funcGetListingsOrigin)
+	}
+
+	if !m.GetListingsMock.invocationsDone() && afterGetListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingsMock.expectedInvocations), m.GetListingsMock.expectedInvocationsOrigin, afterGetListingsCounter)
+	}
+}
+
+type mRepositoryMockGetPriceWatchers struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPriceWatchersExpectation
+	expectations       []*RepositoryMockGetPriceWatchersExpectation
+
+	callArgs []*RepositoryMockGetPriceWatchersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPriceWatchersExpectation specifies expectation struct of the Repository.GetPriceWatchers
+type RepositoryMockGetPriceWatchersExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPriceWatchersParams
+	paramPtrs          *RepositoryMockGetPriceWatchersParamPtrs
+	expectationOrigins RepositoryMockGetPriceWatchersExpectationOrigins
+	results            *RepositoryMockGetPriceWatchersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPriceWatchersParams contains parameters of the Repository.GetPriceWatchers
+type RepositoryMockGetPriceWatchersParams struct {
+	ctx       context.Context
+	listingID uint64
+}
+
+// RepositoryMockGetPriceWatchersParamPtrs contains pointers to parameters of the Repository.GetPriceWatchers
+type RepositoryMockGetPriceWatchersParamPtrs struct {
+	ctx       *context.Context
+	listingID *uint64
+}
+
+// RepositoryMockGetPriceWatchersResults contains results of the Repository.GetPriceWatchers
+type RepositoryMockGetPriceWatchersResults struct {
+	ppa1 []*entity.PriceWatcher
+	err  error
+}
+
+// RepositoryMockGetPriceWatchersOrigins contains origins of expectations of the Repository.GetPriceWatchers
+type RepositoryMockGetPriceWatchersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originListingID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Optional() *mRepositoryMockGetPriceWatchers {
+	mmGetPriceWatchers.optional = true
+	return mmGetPriceWatchers
+}
+
+// Expect sets up expected params for Repository.GetPriceWatchers
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Expect(ctx context.Context, listingID uint64) *mRepositoryMockGetPriceWatchers {
+	if mmGetPriceWatchers.mock.funcGetPriceWatchers != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Set")
+	}
+
+	if mmGetPriceWatchers.defaultExpectation == nil {
+		mmGetPriceWatchers.defaultExpectation = &RepositoryMockGetPriceWatchersExpectation{}
+	}
+
+	if mmGetPriceWatchers.defaultExpectation.paramPtrs != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by ExpectParams functions")
+	}
+
+	mmGetPriceWatchers.defaultExpectation.params = &RepositoryMockGetPriceWatchersParams{ctx, listingID}
+	mmGetPriceWatchers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPriceWatchers.expectations {
+		if minimock.Equal(e.params, mmGetPriceWatchers.defaultExpectation.params) {
+			mmGetPriceWatchers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPriceWatchers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPriceWatchers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPriceWatchers
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPriceWatchers {
+	if mmGetPriceWatchers.mock.funcGetPriceWatchers != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Set")
+	}
+
+	if mmGetPriceWatchers.defaultExpectation == nil {
+		mmGetPriceWatchers.defaultExpectation = &RepositoryMockGetPriceWatchersExpectation{}
+	}
+
+	if mmGetPriceWatchers.defaultExpectation.params != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Expect")
+	}
+
+	if mmGetPriceWatchers.defaultExpectation.paramPtrs == nil {
+		mmGetPriceWatchers.defaultExpectation.paramPtrs = &RepositoryMockGetPriceWatchersParamPtrs{}
+	}
+	mmGetPriceWatchers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPriceWatchers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPriceWatchers
+}
+
+// ExpectListingIDParam2 sets up expected param listingID for Repository.GetPriceWatchers
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) ExpectListingIDParam2(listingID uint64) *mRepositoryMockGetPriceWatchers {
+	if mmGetPriceWatchers.mock.funcGetPriceWatchers != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Set")
+	}
+
+	if mmGetPriceWatchers.defaultExpectation == nil {
+		mmGetPriceWatchers.defaultExpectation = &RepositoryMockGetPriceWatchersExpectation{}
+	}
+
+	if mmGetPriceWatchers.defaultExpectation.params != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Expect")
+	}
+
+	if mmGetPriceWatchers.defaultExpectation.paramPtrs == nil {
+		mmGetPriceWatchers.defaultExpectation.paramPtrs = &RepositoryMockGetPriceWatchersParamPtrs{}
+	}
+	mmGetPriceWatchers.defaultExpectation.paramPtrs.listingID = &listingID
+	mmGetPriceWatchers.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmGetPriceWatchers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPriceWatchers
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Inspect(f func(ctx context.Context, listingID uint64)) *mRepositoryMockGetPriceWatchers {
+	if mmGetPriceWatchers.mock.inspectFuncGetPriceWatchers != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPriceWatchers")
+	}
+
+	mmGetPriceWatchers.mock.inspectFuncGetPriceWatchers = f
+
+	return mmGetPriceWatchers
+}
+
+// Return sets up results that will be returned by Repository.GetPriceWatchers
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Return(ppa1 []*entity.PriceWatcher, err error) *RepositoryMock {
+	if mmGetPriceWatchers.mock.funcGetPriceWatchers != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Set")
+	}
+
+	if mmGetPriceWatchers.defaultExpectation == nil {
+		mmGetPriceWatchers.defaultExpectation = &RepositoryMockGetPriceWatchersExpectation{mock: mmGetPriceWatchers.mock}
+	}
+	mmGetPriceWatchers.defaultExpectation.results = &RepositoryMockGetPriceWatchersResults{ppa1, err}
+	mmGetPriceWatchers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPriceWatchers.mock
+}
+
+// Set uses given function f to mock the Repository.GetPriceWatchers method
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Set(f func(ctx context.Context, listingID uint64) (ppa1 []*entity.PriceWatcher, err error)) *RepositoryMock {
+	if mmGetPriceWatchers.defaultExpectation != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("Default expectation is already set for the Repository.GetPriceWatchers method")
+	}
+
+	if len(mmGetPriceWatchers.expectations) > 0 {
+		mmGetPriceWatchers.mock.t.Fatalf("Some expectations are already set for the Repository.GetPriceWatchers method")
+	}
+
+	mmGetPriceWatchers.mock.funcGetPriceWatchers = f
+	mmGetPriceWatchers.mock.funcGetPriceWatchersOrigin = minimock.CallerInfo(1)
+	return mmGetPriceWatchers.mock
+}
+
+// When sets expectation for the Repository.GetPriceWatchers which will trigger the result defined by the following
+// Then helper
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) When(ctx context.Context, listingID uint64) *RepositoryMockGetPriceWatchersExpectation {
+	if mmGetPriceWatchers.mock.funcGetPriceWatchers != nil {
+		mmGetPriceWatchers.mock.t.Fatalf("RepositoryMock.GetPriceWatchers mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPriceWatchersExpectation{
+		mock:               mmGetPriceWatchers.mock,
+		params:             &RepositoryMockGetPriceWatchersParams{ctx, listingID},
+		expectationOrigins: RepositoryMockGetPriceWatchersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPriceWatchers.expectations = append(mmGetPriceWatchers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPriceWatchers return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPriceWatchersExpectation) Then(ppa1 []*entity.PriceWatcher, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPriceWatchersResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPriceWatchers should be invoked
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Times(n uint64) *mRepositoryMockGetPriceWatchers {
+	if n == 0 {
+		mmGetPriceWatchers.mock.t.Fatalf("Times of RepositoryMock.GetPriceWatchers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPriceWatchers.expectedInvocations, n)
+	mmGetPriceWatchers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPriceWatchers
+}
+
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) invocationsDone() bool {
+	if len(mmGetPriceWatchers.expectations) == 0 && mmGetPriceWatchers.defaultExpectation == nil && mmGetPriceWatchers.mock.funcGetPriceWatchers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPriceWatchers.mock.afterGetPriceWatchersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPriceWatchers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPriceWatchers implements mm_listing.Repository
+func (mmGetPriceWatchers *RepositoryMock) GetPriceWatchers(ctx context.Context, listingID uint64) (ppa1 []*entity.PriceWatcher, err error) {
+	mm_atomic.AddUint64(&mmGetPriceWatchers.beforeGetPriceWatchersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPriceWatchers.afterGetPriceWatchersCounter, 1)
+
+	mmGetPriceWatchers.t.Helper()
+
+	if mmGetPriceWatchers.inspectFuncGetPriceWatchers != nil {
+		mmGetPriceWatchers.inspectFuncGetPriceWatchers(ctx, listingID)
+	}
+
+	mm_params := RepositoryMockGetPriceWatchersParams{ctx, listingID}
+
+	// Record call args
+	mmGetPriceWatchers.GetPriceWatchersMock.mutex.Lock()
+	mmGetPriceWatchers.GetPriceWatchersMock.callArgs = append(mmGetPriceWatchers.GetPriceWatchersMock.callArgs, &mm_params)
+	mmGetPriceWatchers.GetPriceWatchersMock.mutex.Unlock()
+
+	for _, e := range mmGetPriceWatchers.GetPriceWatchersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPriceWatchersParams{ctx, listingID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPriceWatchers.t.Errorf("RepositoryMock.GetPriceWatchers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmGetPriceWatchers.t.Errorf("RepositoryMock.GetPriceWatchers got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPriceWatchers.t.Errorf("RepositoryMock.GetPriceWatchers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPriceWatchers.GetPriceWatchersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPriceWatchers.t.Fatal("No results are set for the RepositoryMock.GetPriceWatchers")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmGetPriceWatchers.funcGetPriceWatchers != nil {
+		return mmGetPriceWatchers.funcGetPriceWatchers(ctx, listingID)
+	}
+	mmGetPriceWatchers.t.Fatalf("Unexpected call to RepositoryMock.GetPriceWatchers. %v %v", ctx, listingID)
+	return
+}
+
+// GetPriceWatchersAfterCounter returns a count of finished RepositoryMock.GetPriceWatchers invocations
+func (mmGetPriceWatchers *RepositoryMock) GetPriceWatchersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceWatchers.afterGetPriceWatchersCounter)
+}
+
+// GetPriceWatchersBeforeCounter returns a count of RepositoryMock.GetPriceWatchers invocations
+func (mmGetPriceWatchers *RepositoryMock) GetPriceWatchersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceWatchers.beforeGetPriceWatchersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPriceWatchers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPriceWatchers *mRepositoryMockGetPriceWatchers) Calls() []*RepositoryMockGetPriceWatchersParams {
+	mmGetPriceWatchers.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPriceWatchersParams, len(mmGetPriceWatchers.callArgs))
+	copy(argCopy, mmGetPriceWatchers.callArgs)
+
+	mmGetPriceWatchers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPriceWatchersDone returns true if the count of the GetPriceWatchers invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPriceWatchersDone() bool {
+	if m.GetPriceWatchersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPriceWatchersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPriceWatchersMock.invocationsDone()
+}
+
+// MinimockGetPriceWatchersInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPriceWatchersInspect() {
+	for _, e := range m.GetPriceWatchersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPriceWatchers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPriceWatchersCounter := mm_atomic.LoadUint64(&m.afterGetPriceWatchersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPriceWatchersMock.defaultExpectation != nil && afterGetPriceWatchersCounter < 1 {
+		if m.GetPriceWatchersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPriceWatchers at\n%s", m.GetPriceWatchersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPriceWatchers at\n%s with params: %#v", m.GetPriceWatchersMock.defaultExpectation.expectationOrigins.origin, *m.GetPriceWatchersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPriceWatchers != nil && afterGetPriceWatchersCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPriceWatchers at\n%s", m.funcGetPriceWatchersOrigin)
+	}
+
+	if !m.GetPriceWatchersMock.invocationsDone() && afterGetPriceWatchersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPriceWatchers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPriceWatchersMock.expectedInvocations), m.GetPriceWatchersMock.expectedInvocationsOrigin, afterGetPriceWatchersCounter)
+	}
+}
+
+type mRepositoryMockGetPublicationWatermark struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPublicationWatermarkExpectation
+	expectations       []*RepositoryMockGetPublicationWatermarkExpectation
+
+	callArgs []*RepositoryMockGetPublicationWatermarkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPublicationWatermarkExpectation specifies expectation struct of the Repository.GetPublicationWatermark
+type RepositoryMockGetPublicationWatermarkExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPublicationWatermarkParams
+	paramPtrs          *RepositoryMockGetPublicationWatermarkParamPtrs
+	expectationOrigins RepositoryMockGetPublicationWatermarkExpectationOrigins
+	results            *RepositoryMockGetPublicationWatermarkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPublicationWatermarkParams contains parameters of the Repository.GetPublicationWatermark
+type RepositoryMockGetPublicationWatermarkParams struct {
+	ctx       context.Context
+	commitLag time.Duration
+}
+
+// RepositoryMockGetPublicationWatermarkParamPtrs contains pointers to parameters of the Repository.GetPublicationWatermark
+type RepositoryMockGetPublicationWatermarkParamPtrs struct {
+	ctx       *context.Context
+	commitLag *time.Duration
+}
+
+// RepositoryMockGetPublicationWatermarkResults contains results of the Repository.GetPublicationWatermark
+type RepositoryMockGetPublicationWatermarkResults struct {
+	t1  time.Time
+	t2  time.Time
+	err error
+}
+
+// RepositoryMockGetPublicationWatermarkOrigins contains origins of expectations of the Repository.GetPublicationWatermark
+type RepositoryMockGetPublicationWatermarkExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originCommitLag string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Optional() *mRepositoryMockGetPublicationWatermark {
+	mmGetPublicationWatermark.optional = true
+	return mmGetPublicationWatermark
+}
+
+// Expect sets up expected params for Repository.GetPublicationWatermark
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Expect(ctx context.Context, commitLag time.Duration) *mRepositoryMockGetPublicationWatermark {
+	if mmGetPublicationWatermark.mock.funcGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Set")
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation == nil {
+		mmGetPublicationWatermark.defaultExpectation = &RepositoryMockGetPublicationWatermarkExpectation{}
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation.paramPtrs != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by ExpectParams functions")
+	}
+
+	mmGetPublicationWatermark.defaultExpectation.params = &RepositoryMockGetPublicationWatermarkParams{ctx, commitLag}
+	mmGetPublicationWatermark.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPublicationWatermark.expectations {
+		if minimock.Equal(e.params, mmGetPublicationWatermark.defaultExpectation.params) {
+			mmGetPublicationWatermark.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPublicationWatermark.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPublicationWatermark
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPublicationWatermark
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPublicationWatermark {
+	if mmGetPublicationWatermark.mock.funcGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Set")
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation == nil {
+		mmGetPublicationWatermark.defaultExpectation = &RepositoryMockGetPublicationWatermarkExpectation{}
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation.params != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Expect")
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation.paramPtrs == nil {
+		mmGetPublicationWatermark.defaultExpectation.paramPtrs = &RepositoryMockGetPublicationWatermarkParamPtrs{}
+	}
+	mmGetPublicationWatermark.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPublicationWatermark.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPublicationWatermark
+}
+
+// ExpectCommitLagParam2 sets up expected param commitLag for Repository.GetPublicationWatermark
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) ExpectCommitLagParam2(commitLag time.Duration) *mRepositoryMockGetPublicationWatermark {
+	if mmGetPublicationWatermark.mock.funcGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Set")
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation == nil {
+		mmGetPublicationWatermark.defaultExpectation = &RepositoryMockGetPublicationWatermarkExpectation{}
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation.params != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Expect")
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation.paramPtrs == nil {
+		mmGetPublicationWatermark.defaultExpectation.paramPtrs = &RepositoryMockGetPublicationWatermarkParamPtrs{}
+	}
+	mmGetPublicationWatermark.defaultExpectation.paramPtrs.commitLag = &commitLag
+	mmGetPublicationWatermark.defaultExpectation.expectationOrigins.originCommitLag = minimock.CallerInfo(1)
+
+	return mmGetPublicationWatermark
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPublicationWatermark
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Inspect(f func(ctx context.Context, commitLag time.Duration)) *mRepositoryMockGetPublicationWatermark {
+	if mmGetPublicationWatermark.mock.inspectFuncGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPublicationWatermark")
+	}
+
+	mmGetPublicationWatermark.mock.inspectFuncGetPublicationWatermark = f
+
+	return mmGetPublicationWatermark
+}
+
+// Return sets up results that will be returned by Repository.GetPublicationWatermark
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Return(t1 time.Time, t2 time.Time, err error) *RepositoryMock {
+	if mmGetPublicationWatermark.mock.funcGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Set")
+	}
+
+	if mmGetPublicationWatermark.defaultExpectation == nil {
+		mmGetPublicationWatermark.defaultExpectation = &RepositoryMockGetPublicationWatermarkExpectation{mock: mmGetPublicationWatermark.mock}
+	}
+	mmGetPublicationWatermark.defaultExpectation.results = &RepositoryMockGetPublicationWatermarkResults{t1, t2, err}
+	mmGetPublicationWatermark.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPublicationWatermark.mock
+}
+
+// Set uses given function f to mock the Repository.GetPublicationWatermark method
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Set(f func(ctx context.Context, commitLag time.Duration) (t1 time.Time, t2 time.Time, err error)) *RepositoryMock {
+	if mmGetPublicationWatermark.defaultExpectation != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("Default expectation is already set for the Repository.GetPublicationWatermark method")
+	}
+
+	if len(mmGetPublicationWatermark.expectations) > 0 {
+		mmGetPublicationWatermark.mock.t.Fatalf("Some expectations are already set for the Repository.GetPublicationWatermark method")
+	}
+
+	mmGetPublicationWatermark.mock.funcGetPublicationWatermark = f
+	mmGetPublicationWatermark.mock.funcGetPublicationWatermarkOrigin = minimock.CallerInfo(1)
+	return mmGetPublicationWatermark.mock
+}
+
+// When sets expectation for the Repository.GetPublicationWatermark which will trigger the result defined by the following
+// Then helper
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) When(ctx context.Context, commitLag time.Duration) *RepositoryMockGetPublicationWatermarkExpectation {
+	if mmGetPublicationWatermark.mock.funcGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.mock.t.Fatalf("RepositoryMock.GetPublicationWatermark mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPublicationWatermarkExpectation{
+		mock:               mmGetPublicationWatermark.mock,
+		params:             &RepositoryMockGetPublicationWatermarkParams{ctx, commitLag},
+		expectationOrigins: RepositoryMockGetPublicationWatermarkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPublicationWatermark.expectations = append(mmGetPublicationWatermark.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPublicationWatermark return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPublicationWatermarkExpectation) Then(t1 time.Time, t2 time.Time, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPublicationWatermarkResults{t1, t2, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPublicationWatermark should be invoked
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Times(n uint64) *mRepositoryMockGetPublicationWatermark {
+	if n == 0 {
+		mmGetPublicationWatermark.mock.t.Fatalf("Times of RepositoryMock.GetPublicationWatermark mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPublicationWatermark.expectedInvocations, n)
+	mmGetPublicationWatermark.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPublicationWatermark
+}
+
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) invocationsDone() bool {
+	if len(mmGetPublicationWatermark.expectations) == 0 && mmGetPublicationWatermark.defaultExpectation == nil && mmGetPublicationWatermark.mock.funcGetPublicationWatermark == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPublicationWatermark.mock.afterGetPublicationWatermarkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPublicationWatermark.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPublicationWatermark implements mm_listing.Repository
+func (mmGetPublicationWatermark *RepositoryMock) GetPublicationWatermark(ctx context.Context, commitLag time.Duration) (t1 time.Time, t2 time.Time, err error) {
+	mm_atomic.AddUint64(&mmGetPublicationWatermark.beforeGetPublicationWatermarkCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPublicationWatermark.afterGetPublicationWatermarkCounter, 1)
+
+	mmGetPublicationWatermark.t.Helper()
+
+	if mmGetPublicationWatermark.inspectFuncGetPublicationWatermark != nil {
+		mmGetPublicationWatermark.inspectFuncGetPublicationWatermark(ctx, commitLag)
+	}
+
+	mm_params := RepositoryMockGetPublicationWatermarkParams{ctx, commitLag}
+
+	// Record call args
+	mmGetPublicationWatermark.GetPublicationWatermarkMock.mutex.Lock()
+	mmGetPublicationWatermark.GetPublicationWatermarkMock.callArgs = append(mmGetPublicationWatermark.GetPublicationWatermarkMock.callArgs, &mm_params)
+	mmGetPublicationWatermark.GetPublicationWatermarkMock.mutex.Unlock()
+
+	for _, e := range mmGetPublicationWatermark.GetPublicationWatermarkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.t2, e.results.err
+		}
+	}
+
+	if mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPublicationWatermarkParams{ctx, commitLag}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPublicationWatermark.t.Errorf("RepositoryMock.GetPublicationWatermark got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.commitLag != nil && !minimock.Equal(*mm_want_ptrs.commitLag, mm_got.commitLag) {
+				mmGetPublicationWatermark.t.Errorf("RepositoryMock.GetPublicationWatermark got unexpected parameter commitLag, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.expectationOrigins.originCommitLag, *mm_want_ptrs.commitLag, mm_got.commitLag, minimock.Diff(*mm_want_ptrs.commitLag, mm_got.commitLag))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPublicationWatermark.t.Errorf("RepositoryMock.GetPublicationWatermark got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPublicationWatermark.GetPublicationWatermarkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPublicationWatermark.t.Fatal("No results are set for the RepositoryMock.GetPublicationWatermark")
+		}
+		return (*mm_results).t1, (*mm_results).t2, (*mm_results).err
+	}
+	if mmGetPublicationWatermark.funcGetPublicationWatermark != nil {
+		return mmGetPublicationWatermark.funcGetPublicationWatermark(ctx, commitLag)
+	}
+	mmGetPublicationWatermark.t.Fatalf("Unexpected call to RepositoryMock.GetPublicationWatermark. %v %v", ctx, commitLag)
+	return
+}
+
+// GetPublicationWatermarkAfterCounter returns a count of finished RepositoryMock.GetPublicationWatermark invocations
+func (mmGetPublicationWatermark *RepositoryMock) GetPublicationWatermarkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublicationWatermark.afterGetPublicationWatermarkCounter)
+}
+
+// GetPublicationWatermarkBeforeCounter returns a count of RepositoryMock.GetPublicationWatermark invocations
+func (mmGetPublicationWatermark *RepositoryMock) GetPublicationWatermarkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublicationWatermark.beforeGetPublicationWatermarkCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPublicationWatermark.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPublicationWatermark *mRepositoryMockGetPublicationWatermark) Calls() []*RepositoryMockGetPublicationWatermarkParams {
+	mmGetPublicationWatermark.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPublicationWatermarkParams, len(mmGetPublicationWatermark.callArgs))
+	copy(argCopy, mmGetPublicationWatermark.callArgs)
+
+	mmGetPublicationWatermark.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPublicationWatermarkDone returns true if the count of the GetPublicationWatermark invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPublicationWatermarkDone() bool {
+	if m.GetPublicationWatermarkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPublicationWatermarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPublicationWatermarkMock.invocationsDone()
+}
+
+// MinimockGetPublicationWatermarkInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPublicationWatermarkInspect() {
+	for _, e := range m.GetPublicationWatermarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPublicationWatermark at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPublicationWatermarkCounter := mm_atomic.LoadUint64(&m.afterGetPublicationWatermarkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPublicationWatermarkMock.defaultExpectation != nil && afterGetPublicationWatermarkCounter < 1 {
+		if m.GetPublicationWatermarkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPublicationWatermark at\n%s", m.GetPublicationWatermarkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPublicationWatermark at\n%s with params: %#v", m.GetPublicationWatermarkMock.defaultExpectation.expectationOrigins.origin, *m.GetPublicationWatermarkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPublicationWatermark != nil && afterGetPublicationWatermarkCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPublicationWatermark at\n%s", m.funcGetPublicationWatermarkOrigin)
+	}
+
+	if !m.GetPublicationWatermarkMock.invocationsDone() && afterGetPublicationWatermarkCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPublicationWatermark at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPublicationWatermarkMock.expectedInvocations), m.GetPublicationWatermarkMock.expectedInvocationsOrigin, afterGetPublicationWatermarkCounter)
+	}
+}
+
+type mRepositoryMockGetSavedSearch struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetSavedSearchExpectation
+	expectations       []*RepositoryMockGetSavedSearchExpectation
+
+	callArgs []*RepositoryMockGetSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetSavedSearchExpectation specifies expectation struct of the Repository.GetSavedSearch
+type RepositoryMockGetSavedSearchExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetSavedSearchParams
+	paramPtrs          *RepositoryMockGetSavedSearchParamPtrs
+	expectationOrigins RepositoryMockGetSavedSearchExpectationOrigins
+	results            *RepositoryMockGetSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetSavedSearchParams contains parameters of the Repository.GetSavedSearch
+type RepositoryMockGetSavedSearchParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// RepositoryMockGetSavedSearchParamPtrs contains pointers to parameters of the Repository.GetSavedSearch
+type RepositoryMockGetSavedSearchParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// RepositoryMockGetSavedSearchResults contains results of the Repository.GetSavedSearch
+type RepositoryMockGetSavedSearchResults struct {
+	sp1 *entity.SavedSearch
+	err error
+}
+
+// RepositoryMockGetSavedSearchOrigins contains origins of expectations of the Repository.GetSavedSearch
+type RepositoryMockGetSavedSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Optional() *mRepositoryMockGetSavedSearch {
+	mmGetSavedSearch.optional = true
+	return mmGetSavedSearch
+}
+
+// Expect sets up expected params for Repository.GetSavedSearch
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Expect(ctx context.Context, userID uint64, id uint64) *mRepositoryMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &RepositoryMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by ExpectParams functions")
+	}
+
+	mmGetSavedSearch.defaultExpectation.params = &RepositoryMockGetSavedSearchParams{ctx, userID, id}
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSavedSearch.expectations {
+		if minimock.Equal(e.params, mmGetSavedSearch.defaultExpectation.params) {
+			mmGetSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetSavedSearch
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &RepositoryMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.params != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Expect")
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmGetSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockGetSavedSearchParamPtrs{}
+	}
+	mmGetSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSavedSearch
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetSavedSearch
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) ExpectUserIDParam2(userID uint64) *mRepositoryMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &RepositoryMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.params != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Expect")
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmGetSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockGetSavedSearchParamPtrs{}
+	}
+	mmGetSavedSearch.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSavedSearch
+}
+
+// ExpectIdParam3 sets up expected param id for Repository.GetSavedSearch
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) ExpectIdParam3(id uint64) *mRepositoryMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &RepositoryMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.params != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Expect")
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmGetSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockGetSavedSearchParamPtrs{}
+	}
+	mmGetSavedSearch.defaultExpectation.paramPtrs.id = &id
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetSavedSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetSavedSearch
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mRepositoryMockGetSavedSearch {
+	if mmGetSavedSearch.mock.inspectFuncGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetSavedSearch")
+	}
+
+	mmGetSavedSearch.mock.inspectFuncGetSavedSearch = f
+
+	return mmGetSavedSearch
+}
+
+// Return sets up results that will be returned by Repository.GetSavedSearch
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Return(sp1 *entity.SavedSearch, err error) *RepositoryMock {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &RepositoryMockGetSavedSearchExpectation{mock: mmGetSavedSearch.mock}
+	}
+	mmGetSavedSearch.defaultExpectation.results = &RepositoryMockGetSavedSearchResults{sp1, err}
+	mmGetSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSavedSearch.mock
+}
+
+// Set uses given function f to mock the Repository.GetSavedSearch method
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Set(f func(ctx context.Context, userID uint64, id uint64) (sp1 *entity.SavedSearch, err error)) *RepositoryMock {
+	if mmGetSavedSearch.defaultExpectation != nil {
+		mmGetSavedSearch.mock.t.Fatalf("Default expectation is already set for the Repository.GetSavedSearch method")
+	}
+
+	if len(mmGetSavedSearch.expectations) > 0 {
+		mmGetSavedSearch.mock.t.Fatalf("Some expectations are already set for the Repository.GetSavedSearch method")
+	}
+
+	mmGetSavedSearch.mock.funcGetSavedSearch = f
+	mmGetSavedSearch.mock.funcGetSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmGetSavedSearch.mock
+}
+
+// When sets expectation for the Repository.GetSavedSearch which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) When(ctx context.Context, userID uint64, id uint64) *RepositoryMockGetSavedSearchExpectation {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("RepositoryMock.GetSavedSearch mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetSavedSearchExpectation{
+		mock:               mmGetSavedSearch.mock,
+		params:             &RepositoryMockGetSavedSearchParams{ctx, userID, id},
+		expectationOrigins: RepositoryMockGetSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSavedSearch.expectations = append(mmGetSavedSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetSavedSearch return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetSavedSearchExpectation) Then(sp1 *entity.SavedSearch, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetSavedSearchResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetSavedSearch should be invoked
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Times(n uint64) *mRepositoryMockGetSavedSearch {
+	if n == 0 {
+		mmGetSavedSearch.mock.t.Fatalf("Times of RepositoryMock.GetSavedSearch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSavedSearch.expectedInvocations, n)
+	mmGetSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedSearch
+}
+
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) invocationsDone() bool {
+	if len(mmGetSavedSearch.expectations) == 0 && mmGetSavedSearch.defaultExpectation == nil && mmGetSavedSearch.mock.funcGetSavedSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSavedSearch.mock.afterGetSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSavedSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSavedSearch implements mm_listing.Repository
+func (mmGetSavedSearch *RepositoryMock) GetSavedSearch(ctx context.Context, userID uint64, id uint64) (sp1 *entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmGetSavedSearch.beforeGetSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedSearch.afterGetSavedSearchCounter, 1)
+
+	mmGetSavedSearch.t.Helper()
+
+	if mmGetSavedSearch.inspectFuncGetSavedSearch != nil {
+		mmGetSavedSearch.inspectFuncGetSavedSearch(ctx, userID, id)
+	}
+
+	mm_params := RepositoryMockGetSavedSearchParams{ctx, userID, id}
+
+	// Record call args
+	mmGetSavedSearch.GetSavedSearchMock.mutex.Lock()
+	mmGetSavedSearch.GetSavedSearchMock.callArgs = append(mmGetSavedSearch.GetSavedSearchMock.callArgs, &mm_params)
+	mmGetSavedSearch.GetSavedSearchMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedSearch.GetSavedSearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetSavedSearch.GetSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetSavedSearchParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSavedSearch.t.Errorf("RepositoryMock.GetSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSavedSearch.t.Errorf("RepositoryMock.GetSavedSearch got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetSavedSearch.t.Errorf("RepositoryMock.GetSavedSearch got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedSearch.t.Errorf("RepositoryMock.GetSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedSearch.t.Fatal("No results are set for the RepositoryMock.GetSavedSearch")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetSavedSearch.funcGetSavedSearch != nil {
+		return mmGetSavedSearch.funcGetSavedSearch(ctx, userID, id)
+	}
+	mmGetSavedSearch.t.Fatalf("Unexpected call to RepositoryMock.GetSavedSearch. %v %v %v", ctx, userID, id)
+	return
+}
+
+// GetSavedSearchAfterCounter returns a count of finished RepositoryMock.GetSavedSearch invocations
+func (mmGetSavedSearch *RepositoryMock) GetSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedSearch.afterGetSavedSearchCounter)
+}
+
+// GetSavedSearchBeforeCounter returns a count of RepositoryMock.GetSavedSearch invocations
+func (mmGetSavedSearch *RepositoryMock) GetSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedSearch.beforeGetSavedSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetSavedSearch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedSearch *mRepositoryMockGetSavedSearch) Calls() []*RepositoryMockGetSavedSearchParams {
+	mmGetSavedSearch.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetSavedSearchParams, len(mmGetSavedSearch.callArgs))
+	copy(argCopy, mmGetSavedSearch.callArgs)
+
+	mmGetSavedSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedSearchDone returns true if the count of the GetSavedSearch invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetSavedSearchDone() bool {
+	if m.GetSavedSearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSavedSearchMock.invocationsDone()
+}
+
+// MinimockGetSavedSearchInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetSavedSearchInspect() {
+	for _, e := range m.GetSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSavedSearchCounter := mm_atomic.LoadUint64(&m.afterGetSavedSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedSearchMock.defaultExpectation != nil && afterGetSavedSearchCounter < 1 {
+		if m.GetSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetSavedSearch at\n%s", m.GetSavedSearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetSavedSearch at\n%s with params: %#v", m.GetSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.GetSavedSearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedSearch != nil && afterGetSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetSavedSearch at\n%s", m.funcGetSavedSearchOrigin)
+	}
+
+	if !m.GetSavedSearchMock.invocationsDone() && afterGetSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSavedSearchMock.expectedInvocations), m.GetSavedSearchMock.expectedInvocationsOrigin, afterGetSavedSearchCounter)
+	}
+}
+
+type mRepositoryMockGetSuggestionTerms struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetSuggestionTermsExpectation
+	expectations       []*RepositoryMockGetSuggestionTermsExpectation
+
+	callArgs []*RepositoryMockGetSuggestionTermsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetSuggestionTermsExpectation specifies expectation struct of the Repository.GetSuggestionTerms
+type RepositoryMockGetSuggestionTermsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetSuggestionTermsParams
+	paramPtrs          *RepositoryMockGetSuggestionTermsParamPtrs
+	expectationOrigins RepositoryMockGetSuggestionTermsExpectationOrigins
+	results            *RepositoryMockGetSuggestionTermsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetSuggestionTermsParams contains parameters of the Repository.GetSuggestionTerms
+type RepositoryMockGetSuggestionTermsParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// RepositoryMockGetSuggestionTermsParamPtrs contains pointers to parameters of the Repository.GetSuggestionTerms
+type RepositoryMockGetSuggestionTermsParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// RepositoryMockGetSuggestionTermsResults contains results of the Repository.GetSuggestionTerms
+type RepositoryMockGetSuggestionTermsResults struct {
+	spa1 []*entity.Suggestion
+	err  error
+}
+
+// RepositoryMockGetSuggestionTermsOrigins contains origins of expectations of the Repository.GetSuggestionTerms
+type RepositoryMockGetSuggestionTermsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Optional() *mRepositoryMockGetSuggestionTerms {
+	mmGetSuggestionTerms.optional = true
+	return mmGetSuggestionTerms
+}
+
+// Expect sets up expected params for Repository.GetSuggestionTerms
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Expect(ctx context.Context, limit int) *mRepositoryMockGetSuggestionTerms {
+	if mmGetSuggestionTerms.mock.funcGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Set")
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation == nil {
+		mmGetSuggestionTerms.defaultExpectation = &RepositoryMockGetSuggestionTermsExpectation{}
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation.paramPtrs != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by ExpectParams functions")
+	}
+
+	mmGetSuggestionTerms.defaultExpectation.params = &RepositoryMockGetSuggestionTermsParams{ctx, limit}
+	mmGetSuggestionTerms.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSuggestionTerms.expectations {
+		if minimock.Equal(e.params, mmGetSuggestionTerms.defaultExpectation.params) {
+			mmGetSuggestionTerms.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSuggestionTerms.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSuggestionTerms
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetSuggestionTerms
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetSuggestionTerms {
+	if mmGetSuggestionTerms.mock.funcGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Set")
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation == nil {
+		mmGetSuggestionTerms.defaultExpectation = &RepositoryMockGetSuggestionTermsExpectation{}
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation.params != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Expect")
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation.paramPtrs == nil {
+		mmGetSuggestionTerms.defaultExpectation.paramPtrs = &RepositoryMockGetSuggestionTermsParamPtrs{}
+	}
+	mmGetSuggestionTerms.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSuggestionTerms.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSuggestionTerms
+}
+
+// ExpectLimitParam2 sets up expected param limit for Repository.GetSuggestionTerms
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) ExpectLimitParam2(limit int) *mRepositoryMockGetSuggestionTerms {
+	if mmGetSuggestionTerms.mock.funcGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Set")
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation == nil {
+		mmGetSuggestionTerms.defaultExpectation = &RepositoryMockGetSuggestionTermsExpectation{}
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation.params != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Expect")
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation.paramPtrs == nil {
+		mmGetSuggestionTerms.defaultExpectation.paramPtrs = &RepositoryMockGetSuggestionTermsParamPtrs{}
+	}
+	mmGetSuggestionTerms.defaultExpectation.paramPtrs.limit = &limit
+	mmGetSuggestionTerms.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetSuggestionTerms
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetSuggestionTerms
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Inspect(f func(ctx context.Context, limit int)) *mRepositoryMockGetSuggestionTerms {
+	if mmGetSuggestionTerms.mock.inspectFuncGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetSuggestionTerms")
+	}
+
+	mmGetSuggestionTerms.mock.inspectFuncGetSuggestionTerms = f
+
+	return mmGetSuggestionTerms
+}
+
+// Return sets up results that will be returned by Repository.GetSuggestionTerms
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Return(spa1 []*entity.Suggestion, err error) *RepositoryMock {
+	if mmGetSuggestionTerms.mock.funcGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Set")
+	}
+
+	if mmGetSuggestionTerms.defaultExpectation == nil {
+		mmGetSuggestionTerms.defaultExpectation = &RepositoryMockGetSuggestionTermsExpectation{mock: mmGetSuggestionTerms.mock}
+	}
+	mmGetSuggestionTerms.defaultExpectation.results = &RepositoryMockGetSuggestionTermsResults{spa1, err}
+	mmGetSuggestionTerms.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSuggestionTerms.mock
+}
+
+// Set uses given function f to mock the Repository.GetSuggestionTerms method
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Set(f func(ctx context.Context, limit int) (spa1 []*entity.Suggestion, err error)) *RepositoryMock {
+	if mmGetSuggestionTerms.defaultExpectation != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("Default expectation is already set for the Repository.GetSuggestionTerms method")
+	}
+
+	if len(mmGetSuggestionTerms.expectations) > 0 {
+		mmGetSuggestionTerms.mock.t.Fatalf("Some expectations are already set for the Repository.GetSuggestionTerms method")
+	}
+
+	mmGetSuggestionTerms.mock.funcGetSuggestionTerms = f
+	mmGetSuggestionTerms.mock.funcGetSuggestionTermsOrigin = minimock.CallerInfo(1)
+	return mmGetSuggestionTerms.mock
+}
+
+// When sets expectation for the Repository.GetSuggestionTerms which will trigger the result defined by the following
+// Then helper
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) When(ctx context.Context, limit int) *RepositoryMockGetSuggestionTermsExpectation {
+	if mmGetSuggestionTerms.mock.funcGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.mock.t.Fatalf("RepositoryMock.GetSuggestionTerms mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetSuggestionTermsExpectation{
+		mock:               mmGetSuggestionTerms.mock,
+		params:             &RepositoryMockGetSuggestionTermsParams{ctx, limit},
+		expectationOrigins: RepositoryMockGetSuggestionTermsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSuggestionTerms.expectations = append(mmGetSuggestionTerms.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetSuggestionTerms return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetSuggestionTermsExpectation) Then(spa1 []*entity.Suggestion, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetSuggestionTermsResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetSuggestionTerms should be invoked
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Times(n uint64) *mRepositoryMockGetSuggestionTerms {
+	if n == 0 {
+		mmGetSuggestionTerms.mock.t.Fatalf("Times of RepositoryMock.GetSuggestionTerms mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSuggestionTerms.expectedInvocations, n)
+	mmGetSuggestionTerms.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSuggestionTerms
+}
+
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) invocationsDone() bool {
+	if len(mmGetSuggestionTerms.expectations) == 0 && mmGetSuggestionTerms.defaultExpectation == nil && mmGetSuggestionTerms.mock.funcGetSuggestionTerms == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSuggestionTerms.mock.afterGetSuggestionTermsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSuggestionTerms.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSuggestionTerms implements mm_listing.Repository
+func (mmGetSuggestionTerms *RepositoryMock) GetSuggestionTerms(ctx context.Context, limit int) (spa1 []*entity.Suggestion, err error) {
+	mm_atomic.AddUint64(&mmGetSuggestionTerms.beforeGetSuggestionTermsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSuggestionTerms.afterGetSuggestionTermsCounter, 1)
+
+	mmGetSuggestionTerms.t.Helper()
+
+	if mmGetSuggestionTerms.inspectFuncGetSuggestionTerms != nil {
+		mmGetSuggestionTerms.inspectFuncGetSuggestionTerms(ctx, limit)
+	}
+
+	mm_params := RepositoryMockGetSuggestionTermsParams{ctx, limit}
+
+	// Record call args
+	mmGetSuggestionTerms.GetSuggestionTermsMock.mutex.Lock()
+	mmGetSuggestionTerms.GetSuggestionTermsMock.callArgs = append(mmGetSuggestionTerms.GetSuggestionTermsMock.callArgs, &mm_params)
+	mmGetSuggestionTerms.GetSuggestionTermsMock.mutex.Unlock()
+
+	for _, e := range mmGetSuggestionTerms.GetSuggestionTermsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetSuggestionTermsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSuggestionTerms.t.Errorf("RepositoryMock.GetSuggestionTerms got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetSuggestionTerms.t.Errorf("RepositoryMock.GetSuggestionTerms got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSuggestionTerms.t.Errorf("RepositoryMock.GetSuggestionTerms got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSuggestionTerms.GetSuggestionTermsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSuggestionTerms.t.Fatal("No results are set for the RepositoryMock.GetSuggestionTerms")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmGetSuggestionTerms.funcGetSuggestionTerms != nil {
+		return mmGetSuggestionTerms.funcGetSuggestionTerms(ctx, limit)
+	}
+	mmGetSuggestionTerms.t.Fatalf("Unexpected call to RepositoryMock.GetSuggestionTerms. %v %v", ctx, limit)
+	return
+}
+
+// GetSuggestionTermsAfterCounter returns a count of finished RepositoryMock.GetSuggestionTerms invocations
+func (mmGetSuggestionTerms *RepositoryMock) GetSuggestionTermsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSuggestionTerms.afterGetSuggestionTermsCounter)
+}
+
+// GetSuggestionTermsBeforeCounter returns a count of RepositoryMock.GetSuggestionTerms invocations
+func (mmGetSuggestionTerms *RepositoryMock) GetSuggestionTermsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSuggestionTerms.beforeGetSuggestionTermsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetSuggestionTerms.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSuggestionTerms *mRepositoryMockGetSuggestionTerms) Calls() []*RepositoryMockGetSuggestionTermsParams {
+	mmGetSuggestionTerms.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetSuggestionTermsParams, len(mmGetSuggestionTerms.callArgs))
+	copy(argCopy, mmGetSuggestionTerms.callArgs)
+
+	mmGetSuggestionTerms.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSuggestionTermsDone returns true if the count of the GetSuggestionTerms invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetSuggestionTermsDone() bool {
+	if m.GetSuggestionTermsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSuggestionTermsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSuggestionTermsMock.invocationsDone()
+}
+
+// MinimockGetSuggestionTermsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetSuggestionTermsInspect() {
+	for _, e := range m.GetSuggestionTermsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetSuggestionTerms at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSuggestionTermsCounter := mm_atomic.LoadUint64(&m.afterGetSuggestionTermsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSuggestionTermsMock.defaultExpectation != nil && afterGetSuggestionTermsCounter < 1 {
+		if m.GetSuggestionTermsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetSuggestionTerms at\n%s", m.GetSuggestionTermsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetSuggestionTerms at\n%s with params: %#v", m.GetSuggestionTermsMock.defaultExpectation.expectationOrigins.origin, *m.GetSuggestionTermsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSuggestionTerms != nil && afterGetSuggestionTermsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetSuggestionTerms at\n%s", m.funcGetSuggestionTermsOrigin)
+	}
+
+	if !m.GetSuggestionTermsMock.invocationsDone() && afterGetSuggestionTermsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetSuggestionTerms at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSuggestionTermsMock.expectedInvocations), m.GetSuggestionTermsMock.expectedInvocationsOrigin, afterGetSuggestionTermsCounter)
+	}
+}
+
+type mRepositoryMockListFavorites struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListFavoritesExpectation
+	expectations       []*RepositoryMockListFavoritesExpectation
+
+	callArgs []*RepositoryMockListFavoritesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListFavoritesExpectation specifies expectation struct of the Repository.ListFavorites
+type RepositoryMockListFavoritesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListFavoritesParams
+	paramPtrs          *RepositoryMockListFavoritesParamPtrs
+	expectationOrigins RepositoryMockListFavoritesExpectationOrigins
+	results            *RepositoryMockListFavoritesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListFavoritesParams contains parameters of the Repository.ListFavorites
+type RepositoryMockListFavoritesParams struct {
+	ctx     context.Context
+	userID  uint64
+	page    uint32
+	perPage uint32
+}
+
+// RepositoryMockListFavoritesParamPtrs contains pointers to parameters of the Repository.ListFavorites
+type RepositoryMockListFavoritesParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	page    *uint32
+	perPage *uint32
+}
+
+// RepositoryMockListFavoritesResults contains results of the Repository.ListFavorites
+type RepositoryMockListFavoritesResults struct {
+	lp1 *entity.ListingPage
+	err error
+}
+
+// RepositoryMockListFavoritesOrigins contains origins of expectations of the Repository.ListFavorites
+type RepositoryMockListFavoritesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originPage    string
+	originPerPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListFavorites *mRepositoryMockListFavorites) Optional() *mRepositoryMockListFavorites {
+	mmListFavorites.optional = true
+	return mmListFavorites
+}
+
+// Expect sets up expected params for Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) Expect(ctx context.Context, userID uint64, page uint32, perPage uint32) *mRepositoryMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &RepositoryMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by ExpectParams functions")
+	}
+
+	mmListFavorites.defaultExpectation.params = &RepositoryMockListFavoritesParams{ctx, userID, page, perPage}
+	mmListFavorites.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListFavorites.expectations {
+		if minimock.Equal(e.params, mmListFavorites.defaultExpectation.params) {
+			mmListFavorites.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListFavorites.defaultExpectation.params)
+		}
+	}
+
+	return mmListFavorites
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &RepositoryMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &RepositoryMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListFavorites.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) ExpectUserIDParam2(userID uint64) *mRepositoryMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &RepositoryMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &RepositoryMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.userID = &userID
+	mmListFavorites.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// ExpectPageParam3 sets up expected param page for Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) ExpectPageParam3(page uint32) *mRepositoryMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &RepositoryMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &RepositoryMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.page = &page
+	mmListFavorites.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// ExpectPerPageParam4 sets up expected param perPage for Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) ExpectPerPageParam4(perPage uint32) *mRepositoryMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &RepositoryMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &RepositoryMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.perPage = &perPage
+	mmListFavorites.defaultExpectation.expectationOrigins.originPerPage = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) Inspect(f func(ctx context.Context, userID uint64, page uint32, perPage uint32)) *mRepositoryMockListFavorites {
+	if mmListFavorites.mock.inspectFuncListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListFavorites")
+	}
+
+	mmListFavorites.mock.inspectFuncListFavorites = f
+
+	return mmListFavorites
+}
+
+// Return sets up results that will be returned by Repository.ListFavorites
+func (mmListFavorites *mRepositoryMockListFavorites) Return(lp1 *entity.ListingPage, err error) *RepositoryMock {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &RepositoryMockListFavoritesExpectation{mock: mmListFavorites.mock}
+	}
+	mmListFavorites.defaultExpectation.results = &RepositoryMockListFavoritesResults{lp1, err}
+	mmListFavorites.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListFavorites.mock
+}
+
+// Set uses given function f to mock the Repository.ListFavorites method
+func (mmListFavorites *mRepositoryMockListFavorites) Set(f func(ctx context.Context, userID uint64, page uint32, perPage uint32) (lp1 *entity.ListingPage, err error)) *RepositoryMock {
+	if mmListFavorites.defaultExpectation != nil {
+		mmListFavorites.mock.t.Fatalf("Default expectation is already set for the Repository.ListFavorites method")
+	}
+
+	if len(mmListFavorites.expectations) > 0 {
+		mmListFavorites.mock.t.Fatalf("Some expectations are already set for the Repository.ListFavorites method")
+	}
+
+	mmListFavorites.mock.funcListFavorites = f
+	mmListFavorites.mock.funcListFavoritesOrigin = minimock.CallerInfo(1)
+	return mmListFavorites.mock
+}
+
+// When sets expectation for the Repository.ListFavorites which will trigger the result defined by the following
+// Then helper
+func (mmListFavorites *mRepositoryMockListFavorites) When(ctx context.Context, userID uint64, page uint32, perPage uint32) *RepositoryMockListFavoritesExpectation {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("RepositoryMock.ListFavorites mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListFavoritesExpectation{
+		mock:               mmListFavorites.mock,
+		params:             &RepositoryMockListFavoritesParams{ctx, userID, page, perPage},
+		expectationOrigins: RepositoryMockListFavoritesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListFavorites.expectations = append(mmListFavorites.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListFavorites return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListFavoritesExpectation) Then(lp1 *entity.ListingPage, err error) *RepositoryMock {
+	e.results = &RepositoryMockListFavoritesResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListFavorites should be invoked
+func (mmListFavorites *mRepositoryMockListFavorites) Times(n uint64) *mRepositoryMockListFavorites {
+	if n == 0 {
+		mmListFavorites.mock.t.Fatalf("Times of RepositoryMock.ListFavorites mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListFavorites.expectedInvocations, n)
+	mmListFavorites.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListFavorites
+}
+
+func (mmListFavorites *mRepositoryMockListFavorites) invocationsDone() bool {
+	if len(mmListFavorites.expectations) == 0 && mmListFavorites.defaultExpectation == nil && mmListFavorites.mock.funcListFavorites == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListFavorites.mock.afterListFavoritesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListFavorites.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListFavorites implements mm_listing.Repository
+func (mmListFavorites *RepositoryMock) ListFavorites(ctx context.Context, userID uint64, page uint32, perPage uint32) (lp1 *entity.ListingPage, err error) {
+	mm_atomic.AddUint64(&mmListFavorites.beforeListFavoritesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListFavorites.afterListFavoritesCounter, 1)
+
+	mmListFavorites.t.Helper()
+
+	if mmListFavorites.inspectFuncListFavorites != nil {
+		mmListFavorites.inspectFuncListFavorites(ctx, userID, page, perPage)
+	}
+
+	mm_params := RepositoryMockListFavoritesParams{ctx, userID, page, perPage}
+
+	// Record call args
+	mmListFavorites.ListFavoritesMock.mutex.Lock()
+	mmListFavorites.ListFavoritesMock.callArgs = append(mmListFavorites.ListFavoritesMock.callArgs, &mm_params)
+	mmListFavorites.ListFavoritesMock.mutex.Unlock()
+
+	for _, e := range mmListFavorites.ListFavoritesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListFavorites.ListFavoritesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListFavorites.ListFavoritesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListFavorites.ListFavoritesMock.defaultExpectation.params
+		mm_want_ptrs := mmListFavorites.ListFavoritesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListFavoritesParams{ctx, userID, page, perPage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListFavorites.t.Errorf("RepositoryMock.ListFavorites got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListFavorites.t.Errorf("RepositoryMock.ListFavorites got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListFavorites.t.Errorf("RepositoryMock.ListFavorites got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+			if mm_want_ptrs.perPage != nil && !minimock.Equal(*mm_want_ptrs.perPage, mm_got.perPage) {
+				mmListFavorites.t.Errorf("RepositoryMock.ListFavorites got unexpected parameter perPage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originPerPage, *mm_want_ptrs.perPage, mm_got.perPage, minimock.Diff(*mm_want_ptrs.perPage, mm_got.perPage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListFavorites.t.Errorf("RepositoryMock.ListFavorites got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListFavorites.ListFavoritesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListFavorites.t.Fatal("No results are set for the RepositoryMock.ListFavorites")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListFavorites.funcListFavorites != nil {
+		return mmListFavorites.funcListFavorites(ctx, userID, page, perPage)
+	}
+	mmListFavorites.t.Fatalf("Unexpected call to RepositoryMock.ListFavorites. %v %v %v %v", ctx, userID, page, perPage)
+	return
+}
+
+// ListFavoritesAfterCounter returns a count of finished RepositoryMock.ListFavorites invocations
+func (mmListFavorites *RepositoryMock) ListFavoritesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFavorites.afterListFavoritesCounter)
+}
+
+// ListFavoritesBeforeCounter returns a count of RepositoryMock.ListFavorites invocations
+func (mmListFavorites *RepositoryMock) ListFavoritesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFavorites.beforeListFavoritesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListFavorites.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListFavorites *mRepositoryMockListFavorites) Calls() []*RepositoryMockListFavoritesParams {
+	mmListFavorites.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListFavoritesParams, len(mmListFavorites.callArgs))
+	copy(argCopy, mmListFavorites.callArgs)
+
+	mmListFavorites.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListFavoritesDone returns true if the count of the ListFavorites invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListFavoritesDone() bool {
+	if m.ListFavoritesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListFavoritesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListFavoritesMock.invocationsDone()
+}
+
+// MinimockListFavoritesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListFavoritesInspect() {
+	for _, e := range m.ListFavoritesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListFavorites at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListFavoritesCounter := mm_atomic.LoadUint64(&m.afterListFavoritesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListFavoritesMock.defaultExpectation != nil && afterListFavoritesCounter < 1 {
+		if m.ListFavoritesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListFavorites at\n%s", m.ListFavoritesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListFavorites at\n%s with params: %#v", m.ListFavoritesMock.defaultExpectation.expectationOrigins.origin, *m.ListFavoritesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListFavorites != nil && afterListFavoritesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListFavorites at\n%s", m.funcListFavoritesOrigin)
+	}
+
+	if !m.ListFavoritesMock.invocationsDone() && afterListFavoritesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListFavorites at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListFavoritesMock.expectedInvocations), m.ListFavoritesMock.expectedInvocationsOrigin, afterListFavoritesCounter)
+	}
+}
+
+type mRepositoryMockListSavedSearches struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListSavedSearchesExpectation
+	expectations       []*RepositoryMockListSavedSearchesExpectation
+
+	callArgs []*RepositoryMockListSavedSearchesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListSavedSearchesExpectation specifies expectation struct of the Repository.ListSavedSearches
+type RepositoryMockListSavedSearchesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListSavedSearchesParams
+	paramPtrs          *RepositoryMockListSavedSearchesParamPtrs
+	expectationOrigins RepositoryMockListSavedSearchesExpectationOrigins
+	results            *RepositoryMockListSavedSearchesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListSavedSearchesParams contains parameters of the Repository.ListSavedSearches
+type RepositoryMockListSavedSearchesParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// RepositoryMockListSavedSearchesParamPtrs contains pointers to parameters of the Repository.ListSavedSearches
+type RepositoryMockListSavedSearchesParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// RepositoryMockListSavedSearchesResults contains results of the Repository.ListSavedSearches
+type RepositoryMockListSavedSearchesResults struct {
+	spa1 []*entity.SavedSearch
+	err  error
+}
+
+// RepositoryMockListSavedSearchesOrigins contains origins of expectations of the Repository.ListSavedSearches
+type RepositoryMockListSavedSearchesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Optional() *mRepositoryMockListSavedSearches {
+	mmListSavedSearches.optional = true
+	return mmListSavedSearches
+}
+
+// Expect sets up expected params for Repository.ListSavedSearches
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Expect(ctx context.Context, userID uint64) *mRepositoryMockListSavedSearches {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &RepositoryMockListSavedSearchesExpectation{}
+	}
+
+	if mmListSavedSearches.defaultExpectation.paramPtrs != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by ExpectParams functions")
+	}
+
+	mmListSavedSearches.defaultExpectation.params = &RepositoryMockListSavedSearchesParams{ctx, userID}
+	mmListSavedSearches.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSavedSearches.expectations {
+		if minimock.Equal(e.params, mmListSavedSearches.defaultExpectation.params) {
+			mmListSavedSearches.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSavedSearches.defaultExpectation.params)
+		}
+	}
+
+	return mmListSavedSearches
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListSavedSearches
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListSavedSearches {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &RepositoryMockListSavedSearchesExpectation{}
+	}
+
+	if mmListSavedSearches.defaultExpectation.params != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Expect")
+	}
+
+	if mmListSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmListSavedSearches.defaultExpectation.paramPtrs = &RepositoryMockListSavedSearchesParamPtrs{}
+	}
+	mmListSavedSearches.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSavedSearches.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSavedSearches
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.ListSavedSearches
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) ExpectUserIDParam2(userID uint64) *mRepositoryMockListSavedSearches {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &RepositoryMockListSavedSearchesExpectation{}
+	}
+
+	if mmListSavedSearches.defaultExpectation.params != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Expect")
+	}
+
+	if mmListSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmListSavedSearches.defaultExpectation.paramPtrs = &RepositoryMockListSavedSearchesParamPtrs{}
+	}
+	mmListSavedSearches.defaultExpectation.paramPtrs.userID = &userID
+	mmListSavedSearches.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSavedSearches
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListSavedSearches
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryMockListSavedSearches {
+	if mmListSavedSearches.mock.inspectFuncListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListSavedSearches")
+	}
+
+	mmListSavedSearches.mock.inspectFuncListSavedSearches = f
+
+	return mmListSavedSearches
+}
+
+// Return sets up results that will be returned by Repository.ListSavedSearches
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Return(spa1 []*entity.SavedSearch, err error) *RepositoryMock {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &RepositoryMockListSavedSearchesExpectation{mock: mmListSavedSearches.mock}
+	}
+	mmListSavedSearches.defaultExpectation.results = &RepositoryMockListSavedSearchesResults{spa1, err}
+	mmListSavedSearches.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSavedSearches.mock
+}
+
+// Set uses given function f to mock the Repository.ListSavedSearches method
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Set(f func(ctx context.Context, userID uint64) (spa1 []*entity.SavedSearch, err error)) *RepositoryMock {
+	if mmListSavedSearches.defaultExpectation != nil {
+		mmListSavedSearches.mock.t.Fatalf("Default expectation is already set for the Repository.ListSavedSearches method")
+	}
+
+	if len(mmListSavedSearches.expectations) > 0 {
+		mmListSavedSearches.mock.t.Fatalf("Some expectations are already set for the Repository.ListSavedSearches method")
+	}
+
+	mmListSavedSearches.mock.funcListSavedSearches = f
+	mmListSavedSearches.mock.funcListSavedSearchesOrigin = minimock.CallerInfo(1)
+	return mmListSavedSearches.mock
+}
+
+// When sets expectation for the Repository.ListSavedSearches which will trigger the result defined by the following
+// Then helper
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) When(ctx context.Context, userID uint64) *RepositoryMockListSavedSearchesExpectation {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("RepositoryMock.ListSavedSearches mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListSavedSearchesExpectation{
+		mock:               mmListSavedSearches.mock,
+		params:             &RepositoryMockListSavedSearchesParams{ctx, userID},
+		expectationOrigins: RepositoryMockListSavedSearchesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSavedSearches.expectations = append(mmListSavedSearches.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListSavedSearches return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListSavedSearchesExpectation) Then(spa1 []*entity.SavedSearch, err error) *RepositoryMock {
+	e.results = &RepositoryMockListSavedSearchesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListSavedSearches should be invoked
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Times(n uint64) *mRepositoryMockListSavedSearches {
+	if n == 0 {
+		mmListSavedSearches.mock.t.Fatalf("Times of RepositoryMock.ListSavedSearches mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSavedSearches.expectedInvocations, n)
+	mmListSavedSearches.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSavedSearches
+}
+
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) invocationsDone() bool {
+	if len(mmListSavedSearches.expectations) == 0 && mmListSavedSearches.defaultExpectation == nil && mmListSavedSearches.mock.funcListSavedSearches == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSavedSearches.mock.afterListSavedSearchesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSavedSearches.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSavedSearches implements mm_listing.Repository
+func (mmListSavedSearches *RepositoryMock) ListSavedSearches(ctx context.Context, userID uint64) (spa1 []*entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmListSavedSearches.beforeListSavedSearchesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSavedSearches.afterListSavedSearchesCounter, 1)
+
+	mmListSavedSearches.t.Helper()
+
+	if mmListSavedSearches.inspectFuncListSavedSearches != nil {
+		mmListSavedSearches.inspectFuncListSavedSearches(ctx, userID)
+	}
+
+	mm_params := RepositoryMockListSavedSearchesParams{ctx, userID}
+
+	// Record call args
+	mmListSavedSearches.ListSavedSearchesMock.mutex.Lock()
+	mmListSavedSearches.ListSavedSearchesMock.callArgs = append(mmListSavedSearches.ListSavedSearchesMock.callArgs, &mm_params)
+	mmListSavedSearches.ListSavedSearchesMock.mutex.Unlock()
+
+	for _, e := range mmListSavedSearches.ListSavedSearchesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListSavedSearches.ListSavedSearchesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.params
+		mm_want_ptrs := mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListSavedSearchesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSavedSearches.t.Errorf("RepositoryMock.ListSavedSearches got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSavedSearches.t.Errorf("RepositoryMock.ListSavedSearches got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSavedSearches.t.Errorf("RepositoryMock.ListSavedSearches got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSavedSearches.t.Fatal("No results are set for the RepositoryMock.ListSavedSearches")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListSavedSearches.funcListSavedSearches != nil {
+		return mmListSavedSearches.funcListSavedSearches(ctx, userID)
+	}
+	mmListSavedSearches.t.Fatalf("Unexpected call to RepositoryMock.ListSavedSearches. %v %v", ctx, userID)
+	return
+}
+
+// ListSavedSearchesAfterCounter returns a count of finished RepositoryMock.ListSavedSearches invocations
+func (mmListSavedSearches *RepositoryMock) ListSavedSearchesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSavedSearches.afterListSavedSearchesCounter)
+}
+
+// ListSavedSearchesBeforeCounter returns a count of RepositoryMock.ListSavedSearches invocations
+func (mmListSavedSearches *RepositoryMock) ListSavedSearchesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSavedSearches.beforeListSavedSearchesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListSavedSearches.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSavedSearches *mRepositoryMockListSavedSearches) Calls() []*RepositoryMockListSavedSearchesParams {
+	mmListSavedSearches.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListSavedSearchesParams, len(mmListSavedSearches.callArgs))
+	copy(argCopy, mmListSavedSearches.callArgs)
+
+	mmListSavedSearches.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSavedSearchesDone returns true if the count of the ListSavedSearches invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListSavedSearchesDone() bool {
+	if m.ListSavedSearchesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSavedSearchesMock.invocationsDone()
+}
+
+// MinimockListSavedSearchesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListSavedSearchesInspect() {
+	for _, e := range m.ListSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListSavedSearches at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSavedSearchesCounter := mm_atomic.LoadUint64(&m.afterListSavedSearchesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSavedSearchesMock.defaultExpectation != nil && afterListSavedSearchesCounter < 1 {
+		if m.ListSavedSearchesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListSavedSearches at\n%s", m.ListSavedSearchesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListSavedSearches at\n%s with params: %#v", m.ListSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *m.ListSavedSearchesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSavedSearches != nil && afterListSavedSearchesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListSavedSearches at\n%s", m.funcListSavedSearchesOrigin)
+	}
+
+	if !m.ListSavedSearchesMock.invocationsDone() && afterListSavedSearchesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListSavedSearches at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSavedSearchesMock.expectedInvocations), m.ListSavedSearchesMock.expectedInvocationsOrigin, afterListSavedSearchesCounter)
+	}
+}
+
+type mRepositoryMockMarkSavedSearchChecked struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockMarkSavedSearchCheckedExpectation
+	expectations       []*RepositoryMockMarkSavedSearchCheckedExpectation
+
+	callArgs []*RepositoryMockMarkSavedSearchCheckedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockMarkSavedSearchCheckedExpectation specifies expectation struct of the Repository.MarkSavedSearchChecked
+type RepositoryMockMarkSavedSearchCheckedExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockMarkSavedSearchCheckedParams
+	paramPtrs          *RepositoryMockMarkSavedSearchCheckedParamPtrs
+	expectationOrigins RepositoryMockMarkSavedSearchCheckedExpectationOrigins
+	results            *RepositoryMockMarkSavedSearchCheckedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockMarkSavedSearchCheckedParams contains parameters of the Repository.MarkSavedSearchChecked
+type RepositoryMockMarkSavedSearchCheckedParams struct {
+	ctx             context.Context
+	id              uint64
+	lastPublishedAt time.Time
+}
+
+// RepositoryMockMarkSavedSearchCheckedParamPtrs contains pointers to parameters of the Repository.MarkSavedSearchChecked
+type RepositoryMockMarkSavedSearchCheckedParamPtrs struct {
+	ctx             *context.Context
+	id              *uint64
+	lastPublishedAt *time.Time
+}
+
+// RepositoryMockMarkSavedSearchCheckedResults contains results of the Repository.MarkSavedSearchChecked
+type RepositoryMockMarkSavedSearchCheckedResults struct {
+	err error
+}
+
+// RepositoryMockMarkSavedSearchCheckedOrigins contains origins of expectations of the Repository.MarkSavedSearchChecked
+type RepositoryMockMarkSavedSearchCheckedExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originId              string
+	originLastPublishedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Optional() *mRepositoryMockMarkSavedSearchChecked {
+	mmMarkSavedSearchChecked.optional = true
+	return mmMarkSavedSearchChecked
+}
+
+// Expect sets up expected params for Repository.MarkSavedSearchChecked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Expect(ctx context.Context, id uint64, lastPublishedAt time.Time) *mRepositoryMockMarkSavedSearchChecked {
+	if mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Set")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation == nil {
+		mmMarkSavedSearchChecked.defaultExpectation = &RepositoryMockMarkSavedSearchCheckedExpectation{}
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.paramPtrs != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by ExpectParams functions")
+	}
+
+	mmMarkSavedSearchChecked.defaultExpectation.params = &RepositoryMockMarkSavedSearchCheckedParams{ctx, id, lastPublishedAt}
+	mmMarkSavedSearchChecked.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkSavedSearchChecked.expectations {
+		if minimock.Equal(e.params, mmMarkSavedSearchChecked.defaultExpectation.params) {
+			mmMarkSavedSearchChecked.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkSavedSearchChecked.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkSavedSearchChecked
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.MarkSavedSearchChecked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) ExpectCtxParam1(ctx context.Context) *mRepositoryMockMarkSavedSearchChecked {
+	if mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Set")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation == nil {
+		mmMarkSavedSearchChecked.defaultExpectation = &RepositoryMockMarkSavedSearchCheckedExpectation{}
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.params != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Expect")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.paramPtrs == nil {
+		mmMarkSavedSearchChecked.defaultExpectation.paramPtrs = &RepositoryMockMarkSavedSearchCheckedParamPtrs{}
+	}
+	mmMarkSavedSearchChecked.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkSavedSearchChecked.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkSavedSearchChecked
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.MarkSavedSearchChecked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) ExpectIdParam2(id uint64) *mRepositoryMockMarkSavedSearchChecked {
+	if mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Set")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation == nil {
+		mmMarkSavedSearchChecked.defaultExpectation = &RepositoryMockMarkSavedSearchCheckedExpectation{}
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.params != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Expect")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.paramPtrs == nil {
+		mmMarkSavedSearchChecked.defaultExpectation.paramPtrs = &RepositoryMockMarkSavedSearchCheckedParamPtrs{}
+	}
+	mmMarkSavedSearchChecked.defaultExpectation.paramPtrs.id = &id
+	mmMarkSavedSearchChecked.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkSavedSearchChecked
+}
+
+// ExpectLastPublishedAtParam3 sets up expected param lastPublishedAt for Repository.MarkSavedSearchChecked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) ExpectLastPublishedAtParam3(lastPublishedAt time.Time) *mRepositoryMockMarkSavedSearchChecked {
+	if mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Set")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation == nil {
+		mmMarkSavedSearchChecked.defaultExpectation = &RepositoryMockMarkSavedSearchCheckedExpectation{}
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.params != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Expect")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation.paramPtrs == nil {
+		mmMarkSavedSearchChecked.defaultExpectation.paramPtrs = &RepositoryMockMarkSavedSearchCheckedParamPtrs{}
+	}
+	mmMarkSavedSearchChecked.defaultExpectation.paramPtrs.lastPublishedAt = &lastPublishedAt
+	mmMarkSavedSearchChecked.defaultExpectation.expectationOrigins.originLastPublishedAt = minimock.CallerInfo(1)
+
+	return mmMarkSavedSearchChecked
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.MarkSavedSearchChecked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Inspect(f func(ctx context.Context, id uint64, lastPublishedAt time.Time)) *mRepositoryMockMarkSavedSearchChecked {
+	if mmMarkSavedSearchChecked.mock.inspectFuncMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("Inspect function is already set for RepositoryMock.MarkSavedSearchChecked")
+	}
+
+	mmMarkSavedSearchChecked.mock.inspectFuncMarkSavedSearchChecked = f
+
+	return mmMarkSavedSearchChecked
+}
+
+// Return sets up results that will be returned by Repository.MarkSavedSearchChecked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Return(err error) *RepositoryMock {
+	if mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Set")
+	}
+
+	if mmMarkSavedSearchChecked.defaultExpectation == nil {
+		mmMarkSavedSearchChecked.defaultExpectation = &RepositoryMockMarkSavedSearchCheckedExpectation{mock: mmMarkSavedSearchChecked.mock}
+	}
+	mmMarkSavedSearchChecked.defaultExpectation.results = &RepositoryMockMarkSavedSearchCheckedResults{err}
+	mmMarkSavedSearchChecked.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkSavedSearchChecked.mock
+}
+
+// Set uses given function f to mock the Repository.MarkSavedSearchChecked method
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Set(f func(ctx context.Context, id uint64, lastPublishedAt time.Time) (err error)) *RepositoryMock {
+	if mmMarkSavedSearchChecked.defaultExpectation != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("Default expectation is already set for the Repository.MarkSavedSearchChecked method")
+	}
+
+	if len(mmMarkSavedSearchChecked.expectations) > 0 {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("Some expectations are already set for the Repository.MarkSavedSearchChecked method")
+	}
+
+	mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked = f
+	mmMarkSavedSearchChecked.mock.funcMarkSavedSearchCheckedOrigin = minimock.CallerInfo(1)
+	return mmMarkSavedSearchChecked.mock
+}
+
+// When sets expectation for the Repository.MarkSavedSearchChecked which will trigger the result defined by the following
+// Then helper
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) When(ctx context.Context, id uint64, lastPublishedAt time.Time) *RepositoryMockMarkSavedSearchCheckedExpectation {
+	if mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("RepositoryMock.MarkSavedSearchChecked mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockMarkSavedSearchCheckedExpectation{
+		mock:               mmMarkSavedSearchChecked.mock,
+		params:             &RepositoryMockMarkSavedSearchCheckedParams{ctx, id, lastPublishedAt},
+		expectationOrigins: RepositoryMockMarkSavedSearchCheckedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkSavedSearchChecked.expectations = append(mmMarkSavedSearchChecked.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.MarkSavedSearchChecked return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockMarkSavedSearchCheckedExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockMarkSavedSearchCheckedResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.MarkSavedSearchChecked should be invoked
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Times(n uint64) *mRepositoryMockMarkSavedSearchChecked {
+	if n == 0 {
+		mmMarkSavedSearchChecked.mock.t.Fatalf("Times of RepositoryMock.MarkSavedSearchChecked mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkSavedSearchChecked.expectedInvocations, n)
+	mmMarkSavedSearchChecked.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkSavedSearchChecked
+}
+
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) invocationsDone() bool {
+	if len(mmMarkSavedSearchChecked.expectations) == 0 && mmMarkSavedSearchChecked.defaultExpectation == nil && mmMarkSavedSearchChecked.mock.funcMarkSavedSearchChecked == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkSavedSearchChecked.mock.afterMarkSavedSearchCheckedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkSavedSearchChecked.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkSavedSearchChecked implements mm_listing.Repository
+func (mmMarkSavedSearchChecked *RepositoryMock) MarkSavedSearchChecked(ctx context.Context, id uint64, lastPublishedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkSavedSearchChecked.beforeMarkSavedSearchCheckedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkSavedSearchChecked.afterMarkSavedSearchCheckedCounter, 1)
+
+	mmMarkSavedSearchChecked.t.Helper()
+
+	if mmMarkSavedSearchChecked.inspectFuncMarkSavedSearchChecked != nil {
+		mmMarkSavedSearchChecked.inspectFuncMarkSavedSearchChecked(ctx, id, lastPublishedAt)
+	}
+
+	mm_params := RepositoryMockMarkSavedSearchCheckedParams{ctx, id, lastPublishedAt}
+
+	// Record call args
+	mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.mutex.Lock()
+	mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.callArgs = append(mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.callArgs, &mm_params)
+	mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.mutex.Unlock()
+
+	for _, e := range mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockMarkSavedSearchCheckedParams{ctx, id, lastPublishedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkSavedSearchChecked.t.Errorf("RepositoryMock.MarkSavedSearchChecked got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkSavedSearchChecked.t.Errorf("RepositoryMock.MarkSavedSearchChecked got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.lastPublishedAt != nil && !minimock.Equal(*mm_want_ptrs.lastPublishedAt, mm_got.lastPublishedAt) {
+				mmMarkSavedSearchChecked.t.Errorf("RepositoryMock.MarkSavedSearchChecked got unexpected parameter lastPublishedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.expectationOrigins.originLastPublishedAt, *mm_want_ptrs.lastPublishedAt, mm_got.lastPublishedAt, minimock.Diff(*mm_want_ptrs.lastPublishedAt, mm_got.lastPublishedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkSavedSearchChecked.t.Errorf("RepositoryMock.MarkSavedSearchChecked got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkSavedSearchChecked.MarkSavedSearchCheckedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkSavedSearchChecked.t.Fatal("No results are set for the RepositoryMock.MarkSavedSearchChecked")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkSavedSearchChecked.funcMarkSavedSearchChecked != nil {
+		return mmMarkSavedSearchChecked.funcMarkSavedSearchChecked(ctx, id, lastPublishedAt)
+	}
+	mmMarkSavedSearchChecked.t.Fatalf("Unexpected call to RepositoryMock.MarkSavedSearchChecked. %v %v %v", ctx, id, lastPublishedAt)
+	return
+}
+
+// MarkSavedSearchCheckedAfterCounter returns a count of finished RepositoryMock.MarkSavedSearchChecked invocations
+func (mmMarkSavedSearchChecked *RepositoryMock) MarkSavedSearchCheckedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkSavedSearchChecked.afterMarkSavedSearchCheckedCounter)
+}
+
+// MarkSavedSearchCheckedBeforeCounter returns a count of RepositoryMock.MarkSavedSearchChecked invocations
+func (mmMarkSavedSearchChecked *RepositoryMock) MarkSavedSearchCheckedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkSavedSearchChecked.beforeMarkSavedSearchCheckedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.MarkSavedSearchChecked.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkSavedSearchChecked *mRepositoryMockMarkSavedSearchChecked) Calls() []*RepositoryMockMarkSavedSearchCheckedParams {
+	mmMarkSavedSearchChecked.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockMarkSavedSearchCheckedParams, len(mmMarkSavedSearchChecked.callArgs))
+	copy(argCopy, mmMarkSavedSearchChecked.callArgs)
+
+	mmMarkSavedSearchChecked.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkSavedSearchCheckedDone returns true if the count of the MarkSavedSearchChecked invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockMarkSavedSearchCheckedDone() bool {
+	if m.MarkSavedSearchCheckedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkSavedSearchCheckedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkSavedSearchCheckedMock.invocationsDone()
+}
+
+// MinimockMarkSavedSearchCheckedInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockMarkSavedSearchCheckedInspect() {
+	for _, e := range m.MarkSavedSearchCheckedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.MarkSavedSearchChecked at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkSavedSearchCheckedCounter := mm_atomic.LoadUint64(&m.afterMarkSavedSearchCheckedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkSavedSearchCheckedMock.defaultExpectation != nil && afterMarkSavedSearchCheckedCounter < 1 {
+		if m.MarkSavedSearchCheckedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.MarkSavedSearchChecked at\n%s", m.MarkSavedSearchCheckedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.MarkSavedSearchChecked at\n%s with params: %#v", m.MarkSavedSearchCheckedMock.defaultExpectation.expectationOrigins.origin, *m.MarkSavedSearchCheckedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkSavedSearchChecked != nil && afterMarkSavedSearchCheckedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.MarkSavedSearchChecked at\n%s", m.funcMarkSavedSearchCheckedOrigin)
+	}
+
+	if !m.MarkSavedSearchCheckedMock.invocationsDone() && afterMarkSavedSearchCheckedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.MarkSavedSearchChecked at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkSavedSearchCheckedMock.expectedInvocations), m.MarkSavedSearchCheckedMock.expectedInvocationsOrigin, afterMarkSavedSearchCheckedCounter)
+	}
+}
+
+type mRepositoryMockPurgeDeletedListings struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockPurgeDeletedListingsExpectation
+	expectations       []*RepositoryMockPurgeDeletedListingsExpectation
+
+	callArgs []*RepositoryMockPurgeDeletedListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockPurgeDeletedListingsExpectation specifies expectation struct of the Repository.PurgeDeletedListings
+type RepositoryMockPurgeDeletedListingsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockPurgeDeletedListingsParams
+	paramPtrs          *RepositoryMockPurgeDeletedListingsParamPtrs
+	expectationOrigins RepositoryMockPurgeDeletedListingsExpectationOrigins
+	results            *RepositoryMockPurgeDeletedListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockPurgeDeletedListingsParams contains parameters of the Repository.PurgeDeletedListings
+type RepositoryMockPurgeDeletedListingsParams struct {
+	ctx           context.Context
+	deletedBefore time.Time
+}
+
+// RepositoryMockPurgeDeletedListingsParamPtrs contains pointers to parameters of the Repository.PurgeDeletedListings
+type RepositoryMockPurgeDeletedListingsParamPtrs struct {
+	ctx           *context.Context
+	deletedBefore *time.Time
+}
+
+// RepositoryMockPurgeDeletedListingsResults contains results of the Repository.PurgeDeletedListings
+type RepositoryMockPurgeDeletedListingsResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryMockPurgeDeletedListingsOrigins contains origins of expectations of the Repository.PurgeDeletedListings
+type RepositoryMockPurgeDeletedListingsExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originDeletedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Optional() *mRepositoryMockPurgeDeletedListings {
+	mmPurgeDeletedListings.optional = true
+	return mmPurgeDeletedListings
+}
+
+// Expect sets up expected params for Repository.PurgeDeletedListings
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Expect(ctx context.Context, deletedBefore time.Time) *mRepositoryMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &RepositoryMockPurgeDeletedListingsExpectation{}
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedListings.defaultExpectation.params = &RepositoryMockPurgeDeletedListingsParams{ctx, deletedBefore}
+	mmPurgeDeletedListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedListings.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedListings.defaultExpectation.params) {
+			mmPurgeDeletedListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedListings.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.PurgeDeletedListings
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) ExpectCtxParam1(ctx context.Context) *mRepositoryMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &RepositoryMockPurgeDeletedListingsExpectation{}
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.params != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedListings.defaultExpectation.paramPtrs = &RepositoryMockPurgeDeletedListingsParamPtrs{}
+	}
+	mmPurgeDeletedListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedListings
+}
+
+// ExpectDeletedBeforeParam2 sets up expected param deletedBefore for Repository.PurgeDeletedListings
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) ExpectDeletedBeforeParam2(deletedBefore time.Time) *mRepositoryMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &RepositoryMockPurgeDeletedListingsExpectation{}
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.params != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedListings.defaultExpectation.paramPtrs = &RepositoryMockPurgeDeletedListingsParamPtrs{}
+	}
+	mmPurgeDeletedListings.defaultExpectation.paramPtrs.deletedBefore = &deletedBefore
+	mmPurgeDeletedListings.defaultExpectation.expectationOrigins.originDeletedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.PurgeDeletedListings
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Inspect(f func(ctx context.Context, deletedBefore time.Time)) *mRepositoryMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.inspectFuncPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("Inspect function is already set for RepositoryMock.PurgeDeletedListings")
+	}
+
+	mmPurgeDeletedListings.mock.inspectFuncPurgeDeletedListings = f
+
+	return mmPurgeDeletedListings
+}
+
+// Return sets up results that will be returned by Repository.PurgeDeletedListings
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Return(i1 int64, err error) *RepositoryMock {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &RepositoryMockPurgeDeletedListingsExpectation{mock: mmPurgeDeletedListings.mock}
+	}
+	mmPurgeDeletedListings.defaultExpectation.results = &RepositoryMockPurgeDeletedListingsResults{i1, err}
+	mmPurgeDeletedListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedListings.mock
+}
+
+// Set uses given function f to mock the Repository.PurgeDeletedListings method
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Set(f func(ctx context.Context, deletedBefore time.Time) (i1 int64, err error)) *RepositoryMock {
+	if mmPurgeDeletedListings.defaultExpectation != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("Default expectation is already set for the Repository.PurgeDeletedListings method")
+	}
+
+	if len(mmPurgeDeletedListings.expectations) > 0 {
+		mmPurgeDeletedListings.mock.t.Fatalf("Some expectations are already set for the Repository.PurgeDeletedListings method")
+	}
+
+	mmPurgeDeletedListings.mock.funcPurgeDeletedListings = f
+	mmPurgeDeletedListings.mock.funcPurgeDeletedListingsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedListings.mock
+}
+
+// When sets expectation for the Repository.PurgeDeletedListings which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) When(ctx context.Context, deletedBefore time.Time) *RepositoryMockPurgeDeletedListingsExpectation {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("RepositoryMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockPurgeDeletedListingsExpectation{
+		mock:               mmPurgeDeletedListings.mock,
+		params:             &RepositoryMockPurgeDeletedListingsParams{ctx, deletedBefore},
+		expectationOrigins: RepositoryMockPurgeDeletedListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedListings.expectations = append(mmPurgeDeletedListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.PurgeDeletedListings return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockPurgeDeletedListingsExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockPurgeDeletedListingsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.PurgeDeletedListings should be invoked
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Times(n uint64) *mRepositoryMockPurgeDeletedListings {
+	if n == 0 {
+		mmPurgeDeletedListings.mock.t.Fatalf("Times of RepositoryMock.PurgeDeletedListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedListings.expectedInvocations, n)
+	mmPurgeDeletedListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedListings
+}
+
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) invocationsDone() bool {
+	if len(mmPurgeDeletedListings.expectations) == 0 && mmPurgeDeletedListings.defaultExpectation == nil && mmPurgeDeletedListings.mock.funcPurgeDeletedListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedListings.mock.afterPurgeDeletedListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedListings implements mm_listing.Repository
+func (mmPurgeDeletedListings *RepositoryMock) PurgeDeletedListings(ctx context.Context, deletedBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedListings.beforePurgeDeletedListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedListings.afterPurgeDeletedListingsCounter, 1)
+
+	mmPurgeDeletedListings.t.Helper()
+
+	if mmPurgeDeletedListings.inspectFuncPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.inspectFuncPurgeDeletedListings(ctx, deletedBefore)
+	}
+
+	mm_params := RepositoryMockPurgeDeletedListingsParams{ctx, deletedBefore}
+
+	// Record call args
+	mmPurgeDeletedListings.PurgeDeletedListingsMock.mutex.Lock()
+	mmPurgeDeletedListings.PurgeDeletedListingsMock.callArgs = append(mmPurgeDeletedListings.PurgeDeletedListingsMock.callArgs, &mm_params)
+	mmPurgeDeletedListings.PurgeDeletedListingsMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedListings.PurgeDeletedListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockPurgeDeletedListingsParams{ctx, deletedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedListings.t.Errorf("RepositoryMock.PurgeDeletedListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deletedBefore != nil && !minimock.Equal(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore) {
+				mmPurgeDeletedListings.t.Errorf("RepositoryMock.PurgeDeletedListings got unexpected parameter deletedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.originDeletedBefore, *mm_want_ptrs.deletedBefore, mm_got.deletedBefore, minimock.Diff(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedListings.t.Errorf("RepositoryMock.PurgeDeletedListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedListings.t.Fatal("No results are set for the RepositoryMock.PurgeDeletedListings")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeletedListings.funcPurgeDeletedListings != nil {
+		return mmPurgeDeletedListings.funcPurgeDeletedListings(ctx, deletedBefore)
+	}
+	mmPurgeDeletedListings.t.Fatalf("Unexpected call to RepositoryMock.PurgeDeletedListings. %v %v", ctx, deletedBefore)
+	return
+}
+
+// PurgeDeletedListingsAfterCounter returns a count of finished RepositoryMock.PurgeDeletedListings invocations
+func (mmPurgeDeletedListings *RepositoryMock) PurgeDeletedListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedListings.afterPurgeDeletedListingsCounter)
+}
+
+// PurgeDeletedListingsBeforeCounter returns a count of RepositoryMock.PurgeDeletedListings invocations
+func (mmPurgeDeletedListings *RepositoryMock) PurgeDeletedListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedListings.beforePurgeDeletedListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.PurgeDeletedListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedListings *mRepositoryMockPurgeDeletedListings) Calls() []*RepositoryMockPurgeDeletedListingsParams {
+	mmPurgeDeletedListings.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockPurgeDeletedListingsParams, len(mmPurgeDeletedListings.callArgs))
+	copy(argCopy, mmPurgeDeletedListings.callArgs)
+
+	mmPurgeDeletedListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedListingsDone returns true if the count of the PurgeDeletedListings invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockPurgeDeletedListingsDone() bool {
+	if m.PurgeDeletedListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedListingsMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedListingsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockPurgeDeletedListingsInspect() {
+	for _, e := range m.PurgeDeletedListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.PurgeDeletedListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedListingsCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedListingsMock.defaultExpectation != nil && afterPurgeDeletedListingsCounter < 1 {
+		if m.PurgeDeletedListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.PurgeDeletedListings at\n%s", m.PurgeDeletedListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.PurgeDeletedListings at\n%s with params: %#v", m.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedListings != nil && afterPurgeDeletedListingsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.PurgeDeletedListings at\n%s", m.funcPurgeDeletedListingsOrigin)
+	}
+
+	if !m.PurgeDeletedListingsMock.invocationsDone() && afterPurgeDeletedListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.PurgeDeletedListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedListingsMock.expectedInvocations), m.PurgeDeletedListingsMock.expectedInvocationsOrigin, afterPurgeDeletedListingsCounter)
+	}
+}
+
+type mRepositoryMockRemoveFavorite struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockRemoveFavoriteExpectation
+	expectations       []*RepositoryMockRemoveFavoriteExpectation
+
+	callArgs []*RepositoryMockRemoveFavoriteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockRemoveFavoriteExpectation specifies expectation struct of the Repository.RemoveFavorite
+type RepositoryMockRemoveFavoriteExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockRemoveFavoriteParams
+	paramPtrs          *RepositoryMockRemoveFavoriteParamPtrs
+	expectationOrigins RepositoryMockRemoveFavoriteExpectationOrigins
+	results            *RepositoryMockRemoveFavoriteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockRemoveFavoriteParams contains parameters of the Repository.RemoveFavorite
+type RepositoryMockRemoveFavoriteParams struct {
+	ctx       context.Context
+	userID    uint64
+	listingID uint64
+}
+
+// RepositoryMockRemoveFavoriteParamPtrs contains pointers to parameters of the Repository.RemoveFavorite
+type RepositoryMockRemoveFavoriteParamPtrs struct {
+	ctx       *context.Context
+	userID    *uint64
+	listingID *uint64
+}
+
+// RepositoryMockRemoveFavoriteResults contains results of the Repository.RemoveFavorite
+type RepositoryMockRemoveFavoriteResults struct {
+	fp1 *entity.FavoriteState
+	err error
+}
+
+// RepositoryMockRemoveFavoriteOrigins contains origins of expectations of the Repository.RemoveFavorite
+type RepositoryMockRemoveFavoriteExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originListingID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Optional() *mRepositoryMockRemoveFavorite {
+	mmRemoveFavorite.optional = true
+	return mmRemoveFavorite
+}
+
+// Expect sets up expected params for Repository.RemoveFavorite
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Expect(ctx context.Context, userID uint64, listingID uint64) *mRepositoryMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &RepositoryMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveFavorite.defaultExpectation.params = &RepositoryMockRemoveFavoriteParams{ctx, userID, listingID}
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveFavorite.expectations {
+		if minimock.Equal(e.params, mmRemoveFavorite.defaultExpectation.params) {
+			mmRemoveFavorite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFavorite.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveFavorite
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.RemoveFavorite
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) ExpectCtxParam1(ctx context.Context) *mRepositoryMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &RepositoryMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.params != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Expect")
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs == nil {
+		mmRemoveFavorite.defaultExpectation.paramPtrs = &RepositoryMockRemoveFavoriteParamPtrs{}
+	}
+	mmRemoveFavorite.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveFavorite
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.RemoveFavorite
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) ExpectUserIDParam2(userID uint64) *mRepositoryMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &RepositoryMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.params != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Expect")
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs == nil {
+		mmRemoveFavorite.defaultExpectation.paramPtrs = &RepositoryMockRemoveFavoriteParamPtrs{}
+	}
+	mmRemoveFavorite.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveFavorite
+}
+
+// ExpectListingIDParam3 sets up expected param listingID for Repository.RemoveFavorite
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) ExpectListingIDParam3(listingID uint64) *mRepositoryMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &RepositoryMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.params != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Expect")
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs == nil {
+		mmRemoveFavorite.defaultExpectation.paramPtrs = &RepositoryMockRemoveFavoriteParamPtrs{}
+	}
+	mmRemoveFavorite.defaultExpectation.paramPtrs.listingID = &listingID
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmRemoveFavorite
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.RemoveFavorite
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Inspect(f func(ctx context.Context, userID uint64, listingID uint64)) *mRepositoryMockRemoveFavorite {
+	if mmRemoveFavorite.mock.inspectFuncRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("Inspect function is already set for RepositoryMock.RemoveFavorite")
+	}
+
+	mmRemoveFavorite.mock.inspectFuncRemoveFavorite = f
+
+	return mmRemoveFavorite
+}
+
+// Return sets up results that will be returned by Repository.RemoveFavorite
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Return(fp1 *entity.FavoriteState, err error) *RepositoryMock {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &RepositoryMockRemoveFavoriteExpectation{mock: mmRemoveFavorite.mock}
+	}
+	mmRemoveFavorite.defaultExpectation.results = &RepositoryMockRemoveFavoriteResults{fp1, err}
+	mmRemoveFavorite.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveFavorite.mock
+}
+
+// Set uses given function f to mock the Repository.RemoveFavorite method
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Set(f func(ctx context.Context, userID uint64, listingID uint64) (fp1 *entity.FavoriteState, err error)) *RepositoryMock {
+	if mmRemoveFavorite.defaultExpectation != nil {
+		mmRemoveFavorite.mock.t.Fatalf("Default expectation is already set for the Repository.RemoveFavorite method")
+	}
+
+	if len(mmRemoveFavorite.expectations) > 0 {
+		mmRemoveFavorite.mock.t.Fatalf("Some expectations are already set for the Repository.RemoveFavorite method")
+	}
+
+	mmRemoveFavorite.mock.funcRemoveFavorite = f
+	mmRemoveFavorite.mock.funcRemoveFavoriteOrigin = minimock.CallerInfo(1)
+	return mmRemoveFavorite.mock
+}
+
+// When sets expectation for the Repository.RemoveFavorite which will trigger the result defined by the following
+// Then helper
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) When(ctx context.Context, userID uint64, listingID uint64) *RepositoryMockRemoveFavoriteExpectation {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("RepositoryMock.RemoveFavorite mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockRemoveFavoriteExpectation{
+		mock:               mmRemoveFavorite.mock,
+		params:             &RepositoryMockRemoveFavoriteParams{ctx, userID, listingID},
+		expectationOrigins: RepositoryMockRemoveFavoriteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveFavorite.expectations = append(mmRemoveFavorite.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.RemoveFavorite return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockRemoveFavoriteExpectation) Then(fp1 *entity.FavoriteState, err error) *RepositoryMock {
+	e.results = &RepositoryMockRemoveFavoriteResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.RemoveFavorite should be invoked
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Times(n uint64) *mRepositoryMockRemoveFavorite {
+	if n == 0 {
+		mmRemoveFavorite.mock.t.Fatalf("Times of RepositoryMock.RemoveFavorite mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveFavorite.expectedInvocations, n)
+	mmRemoveFavorite.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveFavorite
+}
+
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) invocationsDone() bool {
+	if len(mmRemoveFavorite.expectations) == 0 && mmRemoveFavorite.defaultExpectation == nil && mmRemoveFavorite.mock.funcRemoveFavorite == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveFavorite.mock.afterRemoveFavoriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveFavorite.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveFavorite implements mm_listing.Repository
+func (mmRemoveFavorite *RepositoryMock) RemoveFavorite(ctx context.Context, userID uint64, listingID uint64) (fp1 *entity.FavoriteState, err error) {
+	mm_atomic.AddUint64(&mmRemoveFavorite.beforeRemoveFavoriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveFavorite.afterRemoveFavoriteCounter, 1)
+
+	mmRemoveFavorite.t.Helper()
+
+	if mmRemoveFavorite.inspectFuncRemoveFavorite != nil {
+		mmRemoveFavorite.inspectFuncRemoveFavorite(ctx, userID, listingID)
+	}
+
+	mm_params := RepositoryMockRemoveFavoriteParams{ctx, userID, listingID}
+
+	// Record call args
+	mmRemoveFavorite.RemoveFavoriteMock.mutex.Lock()
+	mmRemoveFavorite.RemoveFavoriteMock.callArgs = append(mmRemoveFavorite.RemoveFavoriteMock.callArgs, &mm_params)
+	mmRemoveFavorite.RemoveFavoriteMock.mutex.Unlock()
+
+	for _, e := range mmRemoveFavorite.RemoveFavoriteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockRemoveFavoriteParams{ctx, userID, listingID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveFavorite.t.Errorf("RepositoryMock.RemoveFavorite got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveFavorite.t.Errorf("RepositoryMock.RemoveFavorite got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmRemoveFavorite.t.Errorf("RepositoryMock.RemoveFavorite got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveFavorite.t.Errorf("RepositoryMock.RemoveFavorite got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveFavorite.t.Fatal("No results are set for the RepositoryMock.RemoveFavorite")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmRemoveFavorite.funcRemoveFavorite != nil {
+		return mmRemoveFavorite.funcRemoveFavorite(ctx, userID, listingID)
+	}
+	mmRemoveFavorite.t.Fatalf("Unexpected call to RepositoryMock.RemoveFavorite. %v %v %v", ctx, userID, listingID)
+	return
+}
+
+// RemoveFavoriteAfterCounter returns a count of finished RepositoryMock.RemoveFavorite invocations
+func (mmRemoveFavorite *RepositoryMock) RemoveFavoriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFavorite.afterRemoveFavoriteCounter)
+}
+
+// RemoveFavoriteBeforeCounter returns a count of RepositoryMock.RemoveFavorite invocations
+func (mmRemoveFavorite *RepositoryMock) RemoveFavoriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFavorite.beforeRemoveFavoriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.RemoveFavorite.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveFavorite *mRepositoryMockRemoveFavorite) Calls() []*RepositoryMockRemoveFavoriteParams {
+	mmRemoveFavorite.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockRemoveFavoriteParams, len(mmRemoveFavorite.callArgs))
+	copy(argCopy, mmRemoveFavorite.callArgs)
+
+	mmRemoveFavorite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveFavoriteDone returns true if the count of the RemoveFavorite invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockRemoveFavoriteDone() bool {
+	if m.RemoveFavoriteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveFavoriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveFavoriteMock.invocationsDone()
+}
+
+// MinimockRemoveFavoriteInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockRemoveFavoriteInspect() {
+	for _, e := range m.RemoveFavoriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.RemoveFavorite at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveFavoriteCounter := mm_atomic.LoadUint64(&m.afterRemoveFavoriteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveFavoriteMock.defaultExpectation != nil && afterRemoveFavoriteCounter < 1 {
+		if m.RemoveFavoriteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.RemoveFavorite at\n%s", m.RemoveFavoriteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.RemoveFavorite at\n%s with params: %#v", m.RemoveFavoriteMock.defaultExpectation.expectationOrigins.origin, *m.RemoveFavoriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveFavorite != nil && afterRemoveFavoriteCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.RemoveFavorite at\n%s", m.funcRemoveFavoriteOrigin)
+	}
+
+	if !m.RemoveFavoriteMock.invocationsDone() && afterRemoveFavoriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.RemoveFavorite at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveFavoriteMock.expectedInvocations), m.RemoveFavoriteMock.expectedInvocationsOrigin, afterRemoveFavoriteCounter)
+	}
+}
+
+type mRepositoryMockReorderListingImages struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockReorderListingImagesExpectation
+	expectations       []*RepositoryMockReorderListingImagesExpectation
+
+	callArgs []*RepositoryMockReorderListingImagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockReorderListingImagesExpectation specifies expectation struct of the Repository.ReorderListingImages
+type RepositoryMockReorderListingImagesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockReorderListingImagesParams
+	paramPtrs          *RepositoryMockReorderListingImagesParamPtrs
+	expectationOrigins RepositoryMockReorderListingImagesExpectationOrigins
+	results            *RepositoryMockReorderListingImagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockReorderListingImagesParams contains parameters of the Repository.ReorderListingImages
+type RepositoryMockReorderListingImagesParams struct {
+	ctx       context.Context
+	listingID uint64
+	imageIDs  []uint64
+}
+
+// RepositoryMockReorderListingImagesParamPtrs contains pointers to parameters of the Repository.ReorderListingImages
+type RepositoryMockReorderListingImagesParamPtrs struct {
+	ctx       *context.Context
+	listingID *uint64
+	imageIDs  *[]uint64
+}
+
+// RepositoryMockReorderListingImagesResults contains results of the Repository.ReorderListingImages
+type RepositoryMockReorderListingImagesResults struct {
+	lpa1 []*entity.ListingImage
+	err  error
+}
+
+// RepositoryMockReorderListingImagesOrigins contains origins of expectations of the Repository.ReorderListingImages
+type RepositoryMockReorderListingImagesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originListingID string
+	originImageIDs  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Optional() *mRepositoryMockReorderListingImages {
+	mmReorderListingImages.optional = true
+	return mmReorderListingImages
+}
+
+// Expect sets up expected params for Repository.ReorderListingImages
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Expect(ctx context.Context, listingID uint64, imageIDs []uint64) *mRepositoryMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &RepositoryMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by ExpectParams functions")
+	}
+
+	mmReorderListingImages.defaultExpectation.params = &RepositoryMockReorderListingImagesParams{ctx, listingID, imageIDs}
+	mmReorderListingImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReorderListingImages.expectations {
+		if minimock.Equal(e.params, mmReorderListingImages.defaultExpectation.params) {
+			mmReorderListingImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReorderListingImages.defaultExpectation.params)
+		}
+	}
+
+	return mmReorderListingImages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ReorderListingImages
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) ExpectCtxParam1(ctx context.Context) *mRepositoryMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &RepositoryMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &RepositoryMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// ExpectListingIDParam2 sets up expected param listingID for Repository.ReorderListingImages
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) ExpectListingIDParam2(listingID uint64) *mRepositoryMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &RepositoryMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &RepositoryMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.listingID = &listingID
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originListingID = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// ExpectImageIDsParam3 sets up expected param imageIDs for Repository.ReorderListingImages
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) ExpectImageIDsParam3(imageIDs []uint64) *mRepositoryMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &RepositoryMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &RepositoryMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.imageIDs = &imageIDs
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originImageIDs = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ReorderListingImages
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Inspect(f func(ctx context.Context, listingID uint64, imageIDs []uint64)) *mRepositoryMockReorderListingImages {
+	if mmReorderListingImages.mock.inspectFuncReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ReorderListingImages")
+	}
+
+	mmReorderListingImages.mock.inspectFuncReorderListingImages = f
+
+	return mmReorderListingImages
+}
+
+// Return sets up results that will be returned by Repository.ReorderListingImages
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Return(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &RepositoryMockReorderListingImagesExpectation{mock: mmReorderListingImages.mock}
+	}
+	mmReorderListingImages.defaultExpectation.results = &RepositoryMockReorderListingImagesResults{lpa1, err}
+	mmReorderListingImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReorderListingImages.mock
+}
+
+// Set uses given function f to mock the Repository.ReorderListingImages method
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Set(f func(ctx context.Context, listingID uint64, imageIDs []uint64) (lpa1 []*entity.ListingImage, err error)) *RepositoryMock {
+	if mmReorderListingImages.defaultExpectation != nil {
+		mmReorderListingImages.mock.t.Fatalf("Default expectation is already set for the Repository.ReorderListingImages method")
+	}
+
+	if len(mmReorderListingImages.expectations) > 0 {
+		mmReorderListingImages.mock.t.Fatalf("Some expectations are already set for the Repository.ReorderListingImages method")
+	}
+
+	mmReorderListingImages.mock.funcReorderListingImages = f
+	mmReorderListingImages.mock.funcReorderListingImagesOrigin = minimock.CallerInfo(1)
+	return mmReorderListingImages.mock
+}
+
+// When sets expectation for the Repository.ReorderListingImages which will trigger the result defined by the following
+// Then helper
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) When(ctx context.Context, listingID uint64, imageIDs []uint64) *RepositoryMockReorderListingImagesExpectation {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("RepositoryMock.ReorderListingImages mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockReorderListingImagesExpectation{
+		mock:               mmReorderListingImages.mock,
+		params:             &RepositoryMockReorderListingImagesParams{ctx, listingID, imageIDs},
+		expectationOrigins: RepositoryMockReorderListingImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReorderListingImages.expectations = append(mmReorderListingImages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ReorderListingImages return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockReorderListingImagesExpectation) Then(lpa1 []*entity.ListingImage, err error) *RepositoryMock {
+	e.results = &RepositoryMockReorderListingImagesResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ReorderListingImages should be invoked
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Times(n uint64) *mRepositoryMockReorderListingImages {
+	if n == 0 {
+		mmReorderListingImages.mock.t.Fatalf("Times of RepositoryMock.ReorderListingImages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReorderListingImages.expectedInvocations, n)
+	mmReorderListingImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReorderListingImages
+}
+
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) invocationsDone() bool {
+	if len(mmReorderListingImages.expectations) == 0 && mmReorderListingImages.defaultExpectation == nil && mmReorderListingImages.mock.funcReorderListingImages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReorderListingImages.mock.afterReorderListingImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReorderListingImages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReorderListingImages implements mm_listing.Repository
+func (mmReorderListingImages *RepositoryMock) ReorderListingImages(ctx context.Context, listingID uint64, imageIDs []uint64) (lpa1 []*entity.ListingImage, err error) {
+	mm_atomic.AddUint64(&mmReorderListingImages.beforeReorderListingImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReorderListingImages.afterReorderListingImagesCounter, 1)
+
+	mmReorderListingImages.t.Helper()
+
+	if mmReorderListingImages.inspectFuncReorderListingImages != nil {
+		mmReorderListingImages.inspectFuncReorderListingImages(ctx, listingID, imageIDs)
+	}
+
+	mm_params := RepositoryMockReorderListingImagesParams{ctx, listingID, imageIDs}
+
+	// Record call args
+	mmReorderListingImages.ReorderListingImagesMock.mutex.Lock()
+	mmReorderListingImages.ReorderListingImagesMock.callArgs = append(mmReorderListingImages.ReorderListingImagesMock.callArgs, &mm_params)
+	mmReorderListingImages.ReorderListingImagesMock.mutex.Unlock()
+
+	for _, e := range mmReorderListingImages.ReorderListingImagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmReorderListingImages.ReorderListingImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockReorderListingImagesParams{ctx, listingID, imageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReorderListingImages.t.Errorf("RepositoryMock.ReorderListingImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listingID != nil && !minimock.Equal(*mm_want_ptrs.listingID, mm_got.listingID) {
+				mmReorderListingImages.t.Errorf("RepositoryMock.ReorderListingImages got unexpected parameter listingID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originListingID, *mm_want_ptrs.listingID, mm_got.listingID, minimock.Diff(*mm_want_ptrs.listingID, mm_got.listingID))
+			}
+
+			if mm_want_ptrs.imageIDs != nil && !minimock.Equal(*mm_want_ptrs.imageIDs, mm_got.imageIDs) {
+				mmReorderListingImages.t.Errorf("RepositoryMock.ReorderListingImages got unexpected parameter imageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originImageIDs, *mm_want_ptrs.imageIDs, mm_got.imageIDs, minimock.Diff(*mm_want_ptrs.imageIDs, mm_got.imageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReorderListingImages.t.Errorf("RepositoryMock.ReorderListingImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReorderListingImages.t.Fatal("No results are set for the RepositoryMock.ReorderListingImages")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmReorderListingImages.funcReorderListingImages != nil {
+		return mmReorderListingImages.funcReorderListingImages(ctx, listingID, imageIDs)
+	}
+	mmReorderListingImages.t.Fatalf("Unexpected call to RepositoryMock.ReorderListingImages. %v %v %v", ctx, listingID, imageIDs)
+	return
+}
+
+// ReorderListingImagesAfterCounter returns a count of finished RepositoryMock.ReorderListingImages invocations
+func (mmReorderListingImages *RepositoryMock) ReorderListingImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReorderListingImages.afterReorderListingImagesCounter)
+}
+
+// ReorderListingImagesBeforeCounter returns a count of RepositoryMock.ReorderListingImages invocations
+func (mmReorderListingImages *RepositoryMock) ReorderListingImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReorderListingImages.beforeReorderListingImagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ReorderListingImages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReorderListingImages *mRepositoryMockReorderListingImages) Calls() []*RepositoryMockReorderListingImagesParams {
+	mmReorderListingImages.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockReorderListingImagesParams, len(mmReorderListingImages.callArgs))
+	copy(argCopy, mmReorderListingImages.callArgs)
+
+	mmReorderListingImages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReorderListingImagesDone returns true if the count of the ReorderListingImages invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockReorderListingImagesDone() bool {
+	if m.ReorderListingImagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReorderListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReorderListingImagesMock.invocationsDone()
+}
+
+// MinimockReorderListingImagesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockReorderListingImagesInspect() {
+	for _, e := range m.ReorderListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ReorderListingImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReorderListingImagesCounter := mm_atomic.LoadUint64(&m.afterReorderListingImagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReorderListingImagesMock.defaultExpectation != nil && afterReorderListingImagesCounter < 1 {
+		if m.ReorderListingImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ReorderListingImages at\n%s", m.ReorderListingImagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ReorderListingImages at\n%s with params: %#v", m.ReorderListingImagesMock.defaultExpectation.expectationOrigins.origin, *m.ReorderListingImagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReorderListingImages != nil && afterReorderListingImagesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ReorderListingImages at\n%s", m.funcReorderListingImagesOrigin)
+	}
+
+	if !m.ReorderListingImagesMock.invocationsDone() && afterReorderListingImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ReorderListingImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReorderListingImagesMock.expectedInvocations), m.ReorderListingImagesMock.expectedInvocationsOrigin, afterReorderListingImagesCounter)
+	}
+}
+
+type mRepositoryMockRestoreListing struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockRestoreListingExpectation
+	expectations       []*RepositoryMockRestoreListingExpectation
+
+	callArgs []*RepositoryMockRestoreListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockRestoreListingExpectation specifies expectation struct of the Repository.RestoreListing
+type RepositoryMockRestoreListingExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockRestoreListingParams
+	paramPtrs          *RepositoryMockRestoreListingParamPtrs
+	expectationOrigins RepositoryMockRestoreListingExpectationOrigins
+	results            *RepositoryMockRestoreListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockRestoreListingParams contains parameters of the Repository.RestoreListing
+type RepositoryMockRestoreListingParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryMockRestoreListingParamPtrs contains pointers to parameters of the Repository.RestoreListing
+type RepositoryMockRestoreListingParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryMockRestoreListingResults contains results of the Repository.RestoreListing
+type RepositoryMockRestoreListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// RepositoryMockRestoreListingOrigins contains origins of expectations of the Repository.RestoreListing
+type RepositoryMockRestoreListingExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreListing *mRepositoryMockRestoreListing) Optional() *mRepositoryMockRestoreListing {
+	mmRestoreListing.optional = true
+	return mmRestoreListing
+}
+
+// Expect sets up expected params for Repository.RestoreListing
+func (mmRestoreListing *mRepositoryMockRestoreListing) Expect(ctx context.Context, id uint64) *mRepositoryMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &RepositoryMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreListing.defaultExpectation.params = &RepositoryMockRestoreListingParams{ctx, id}
+	mmRestoreListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreListing.expectations {
+		if minimock.Equal(e.params, mmRestoreListing.defaultExpectation.params) {
+			mmRestoreListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreListing.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.RestoreListing
+func (mmRestoreListing *mRepositoryMockRestoreListing) ExpectCtxParam1(ctx context.Context) *mRepositoryMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &RepositoryMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.params != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Expect")
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs == nil {
+		mmRestoreListing.defaultExpectation.paramPtrs = &RepositoryMockRestoreListingParamPtrs{}
+	}
+	mmRestoreListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreListing
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.RestoreListing
+func (mmRestoreListing *mRepositoryMockRestoreListing) ExpectIdParam2(id uint64) *mRepositoryMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &RepositoryMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.params != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Expect")
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs == nil {
+		mmRestoreListing.defaultExpectation.paramPtrs = &RepositoryMockRestoreListingParamPtrs{}
+	}
+	mmRestoreListing.defaultExpectation.paramPtrs.id = &id
+	mmRestoreListing.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestoreListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.RestoreListing
+func (mmRestoreListing *mRepositoryMockRestoreListing) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryMockRestoreListing {
+	if mmRestoreListing.mock.inspectFuncRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("Inspect function is already set for RepositoryMock.RestoreListing")
+	}
+
+	mmRestoreListing.mock.inspectFuncRestoreListing = f
+
+	return mmRestoreListing
+}
+
+// Return sets up results that will be returned by Repository.RestoreListing
+func (mmRestoreListing *mRepositoryMockRestoreListing) Return(lp1 *entity.Listing, err error) *RepositoryMock {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &RepositoryMockRestoreListingExpectation{mock: mmRestoreListing.mock}
+	}
+	mmRestoreListing.defaultExpectation.results = &RepositoryMockRestoreListingResults{lp1, err}
+	mmRestoreListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreListing.mock
+}
+
+// Set uses given function f to mock the Repository.RestoreListing method
+func (mmRestoreListing *mRepositoryMockRestoreListing) Set(f func(ctx context.Context, id uint64) (lp1 *entity.Listing, err error)) *RepositoryMock {
+	if mmRestoreListing.defaultExpectation != nil {
+		mmRestoreListing.mock.t.Fatalf("Default expectation is already set for the Repository.RestoreListing method")
+	}
+
+	if len(mmRestoreListing.expectations) > 0 {
+		mmRestoreListing.mock.t.Fatalf("Some expectations are already set for the Repository.RestoreListing method")
+	}
+
+	mmRestoreListing.mock.funcRestoreListing = f
+	mmRestoreListing.mock.funcRestoreListingOrigin = minimock.CallerInfo(1)
+	return mmRestoreListing.mock
+}
+
+// When sets expectation for the Repository.RestoreListing which will trigger the result defined by the following
+// Then helper
+func (mmRestoreListing *mRepositoryMockRestoreListing) When(ctx context.Context, id uint64) *RepositoryMockRestoreListingExpectation {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("RepositoryMock.RestoreListing mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockRestoreListingExpectation{
+		mock:               mmRestoreListing.mock,
+		params:             &RepositoryMockRestoreListingParams{ctx, id},
+		expectationOrigins: RepositoryMockRestoreListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreListing.expectations = append(mmRestoreListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.RestoreListing return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockRestoreListingExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
+	e.results = &RepositoryMockRestoreListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.RestoreListing should be invoked
+func (mmRestoreListing *mRepositoryMockRestoreListing) Times(n uint64) *mRepositoryMockRestoreListing {
+	if n == 0 {
+		mmRestoreListing.mock.t.Fatalf("Times of RepositoryMock.RestoreListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreListing.expectedInvocations, n)
+	mmRestoreListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreListing
+}
+
+func (mmRestoreListing *mRepositoryMockRestoreListing) invocationsDone() bool {
+	if len(mmRestoreListing.expectations) == 0 && mmRestoreListing.defaultExpectation == nil && mmRestoreListing.mock.funcRestoreListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreListing.mock.afterRestoreListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreListing implements mm_listing.Repository
+func (mmRestoreListing *RepositoryMock) RestoreListing(ctx context.Context, id uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmRestoreListing.beforeRestoreListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreListing.afterRestoreListingCounter, 1)
+
+	mmRestoreListing.t.Helper()
+
+	if mmRestoreListing.inspectFuncRestoreListing != nil {
+		mmRestoreListing.inspectFuncRestoreListing(ctx, id)
+	}
+
+	mm_params := RepositoryMockRestoreListingParams{ctx, id}
+
+	// Record call args
+	mmRestoreListing.RestoreListingMock.mutex.Lock()
+	mmRestoreListing.RestoreListingMock.callArgs = append(mmRestoreListing.RestoreListingMock.callArgs, &mm_params)
+	mmRestoreListing.RestoreListingMock.mutex.Unlock()
+
+	for _, e := range mmRestoreListing.RestoreListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmRestoreListing.RestoreListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreListing.RestoreListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreListing.RestoreListingMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreListing.RestoreListingMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockRestoreListingParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreListing.t.Errorf("RepositoryMock.RestoreListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestoreListing.t.Errorf("RepositoryMock.RestoreListing got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreListing.t.Errorf("RepositoryMock.RestoreListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreListing.RestoreListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreListing.t.Fatal("No results are set for the RepositoryMock.RestoreListing")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmRestoreListing.funcRestoreListing != nil {
+		return mmRestoreListing.funcRestoreListing(ctx, id)
+	}
+	mmRestoreListing.t.Fatalf("Unexpected call to RepositoryMock.RestoreListing. %v %v", ctx, id)
+	return
+}
+
+// RestoreListingAfterCounter returns a count of finished RepositoryMock.RestoreListing invocations
+func (mmRestoreListing *RepositoryMock) RestoreListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreListing.afterRestoreListingCounter)
+}
+
+// RestoreListingBeforeCounter returns a count of RepositoryMock.RestoreListing invocations
+func (mmRestoreListing *RepositoryMock) RestoreListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreListing.beforeRestoreListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.RestoreListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreListing *mRepositoryMockRestoreListing) Calls() []*RepositoryMockRestoreListingParams {
+	mmRestoreListing.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockRestoreListingParams, len(mmRestoreListing.callArgs))
+	copy(argCopy, mmRestoreListing.callArgs)
+
+	mmRestoreListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreListingDone returns true if the count of the RestoreListing invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockRestoreListingDone() bool {
+	if m.RestoreListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreListingMock.invocationsDone()
+}
+
+// MinimockRestoreListingInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockRestoreListingInspect() {
+	for _, e := range m.RestoreListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.RestoreListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreListingCounter := mm_atomic.LoadUint64(&m.afterRestoreListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreListingMock.defaultExpectation != nil && afterRestoreListingCounter < 1 {
+		if m.RestoreListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.RestoreListing at\n%s", m.RestoreListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.RestoreListing at\n%s with params: %#v", m.RestoreListingMock.defaultExpectation.expectationOrigins.origin, *m.RestoreListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreListing != nil && afterRestoreListingCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.RestoreListing at\n%s", m.funcRestoreListingOrigin)
+	}
+
+	if !m.RestoreListingMock.invocationsDone() && afterRestoreListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.RestoreListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreListingMock.expectedInvocations), m.RestoreListingMock.expectedInvocationsOrigin, afterRestoreListingCounter)
+	}
+}
+
+type mRepositoryMockSearchListings struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSearchListingsExpectation
+	expectations       []*RepositoryMockSearchListingsExpectation
+
+	callArgs []*RepositoryMockSearchListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSearchListingsExpectation specifies expectation struct of the Repository.SearchListings
+type RepositoryMockSearchListingsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSearchListingsParams
+	paramPtrs          *RepositoryMockSearchListingsParamPtrs
+	expectationOrigins RepositoryMockSearchListingsExpectationOrigins
+	results            *RepositoryMockSearchListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSearchListingsParams contains parameters of the Repository.SearchListings
+type RepositoryMockSearchListingsParams struct {
+	ctx    context.Context
+	filter *entity.ListingSearchFilter
+}
+
+// RepositoryMockSearchListingsParamPtrs contains pointers to parameters of the Repository.SearchListings
+type RepositoryMockSearchListingsParamPtrs struct {
+	ctx    *context.Context
+	filter **entity.ListingSearchFilter
+}
+
+// RepositoryMockSearchListingsResults contains results of the Repository.SearchListings
+type RepositoryMockSearchListingsResults struct {
+	lpa1 []*entity.ListingSearchResult
+	u1   uint32
+	err  error
+}
+
+// RepositoryMockSearchListingsOrigins contains origins of expectations of the Repository.SearchListings
+type RepositoryMockSearchListingsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchListings *mRepositoryMockSearchListings) Optional() *mRepositoryMockSearchListings {
+	mmSearchListings.optional = true
+	return mmSearchListings
+}
+
+// Expect sets up expected params for Repository.SearchListings
+func (mmSearchListings *mRepositoryMockSearchListings) Expect(ctx context.Context, filter *entity.ListingSearchFilter) *mRepositoryMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &RepositoryMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by ExpectParams functions")
+	}
+
+	mmSearchListings.defaultExpectation.params = &RepositoryMockSearchListingsParams{ctx, filter}
+	mmSearchListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchListings.expectations {
+		if minimock.Equal(e.params, mmSearchListings.defaultExpectation.params) {
+			mmSearchListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchListings.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SearchListings
+func (mmSearchListings *mRepositoryMockSearchListings) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &RepositoryMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.params != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Expect")
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs == nil {
+		mmSearchListings.defaultExpectation.paramPtrs = &RepositoryMockSearchListingsParamPtrs{}
+	}
+	mmSearchListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchListings
+}
+
+// ExpectFilterParam2 sets up expected param filter for Repository.SearchListings
+func (mmSearchListings *mRepositoryMockSearchListings) ExpectFilterParam2(filter *entity.ListingSearchFilter) *mRepositoryMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &RepositoryMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.params != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Expect")
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs == nil {
+		mmSearchListings.defaultExpectation.paramPtrs = &RepositoryMockSearchListingsParamPtrs{}
+	}
+	mmSearchListings.defaultExpectation.paramPtrs.filter = &filter
+	mmSearchListings.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmSearchListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SearchListings
+func (mmSearchListings *mRepositoryMockSearchListings) Inspect(f func(ctx context.Context, filter *entity.ListingSearchFilter)) *mRepositoryMockSearchListings {
+	if mmSearchListings.mock.inspectFuncSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SearchListings")
+	}
+
+	mmSearchListings.mock.inspectFuncSearchListings = f
+
+	return mmSearchListings
+}
+
+// Return sets up results that will be returned by Repository.SearchListings
+func (mmSearchListings *mRepositoryMockSearchListings) Return(lpa1 []*entity.ListingSearchResult, u1 uint32, err error) *RepositoryMock {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &RepositoryMockSearchListingsExpectation{mock: mmSearchListings.mock}
+	}
+	mmSearchListings.defaultExpectation.results = &RepositoryMockSearchListingsResults{lpa1, u1, err}
+	mmSearchListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchListings.mock
+}
+
+// Set uses given function f to mock the Repository.SearchListings method
+func (mmSearchListings *mRepositoryMockSearchListings) Set(f func(ctx context.Context, filter *entity.ListingSearchFilter) (lpa1 []*entity.ListingSearchResult, u1 uint32, err error)) *RepositoryMock {
+	if mmSearchListings.defaultExpectation != nil {
+		mmSearchListings.mock.t.Fatalf("Default expectation is already set for the Repository.SearchListings method")
+	}
+
+	if len(mmSearchListings.expectations) > 0 {
+		mmSearchListings.mock.t.Fatalf("Some expectations are already set for the Repository.SearchListings method")
+	}
+
+	mmSearchListings.mock.funcSearchListings = f
+	mmSearchListings.mock.funcSearchListingsOrigin = minimock.CallerInfo(1)
+	return mmSearchListings.mock
+}
+
+// When sets expectation for the Repository.SearchListings which will trigger the result defined by the following
+// Then helper
+func (mmSearchListings *mRepositoryMockSearchListings) When(ctx context.Context, filter *entity.ListingSearchFilter) *RepositoryMockSearchListingsExpectation {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("RepositoryMock.SearchListings mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSearchListingsExpectation{
+		mock:               mmSearchListings.mock,
+		params:             &RepositoryMockSearchListingsParams{ctx, filter},
+		expectationOrigins: RepositoryMockSearchListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchListings.expectations = append(mmSearchListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SearchListings return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSearchListingsExpectation) Then(lpa1 []*entity.ListingSearchResult, u1 uint32, err error) *RepositoryMock {
+	e.results = &RepositoryMockSearchListingsResults{lpa1, u1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.SearchListings should be invoked
+func (mmSearchListings *mRepositoryMockSearchListings) Times(n uint64) *mRepositoryMockSearchListings {
+	if n == 0 {
+		mmSearchListings.mock.t.Fatalf("Times of RepositoryMock.SearchListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchListings.expectedInvocations, n)
+	mmSearchListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchListings
+}
+
+func (mmSearchListings *mRepositoryMockSearchListings) invocationsDone() bool {
+	if len(mmSearchListings.expectations) == 0 && mmSearchListings.defaultExpectation == nil && mmSearchListings.mock.funcSearchListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchListings.mock.afterSearchListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchListings implements mm_listing.Repository
+func (mmSearchListings *RepositoryMock) SearchListings(ctx context.Context, filter *entity.ListingSearchFilter) (lpa1 []*entity.ListingSearchResult, u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmSearchListings.beforeSearchListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchListings.afterSearchListingsCounter, 1)
+
+	mmSearchListings.t.Helper()
+
+	if mmSearchListings.inspectFuncSearchListings != nil {
+		mmSearchListings.inspectFuncSearchListings(ctx, filter)
+	}
+
+	mm_params := RepositoryMockSearchListingsParams{ctx, filter}
+
+	// Record call args
+	mmSearchListings.SearchListingsMock.mutex.Lock()
+	mmSearchListings.SearchListingsMock.callArgs = append(mmSearchListings.SearchListingsMock.callArgs, &mm_params)
+	mmSearchListings.SearchListingsMock.mutex.Unlock()
+
+	for _, e := range mmSearchListings.SearchListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.u1, e.results.err
+		}
+	}
+
+	if mmSearchListings.SearchListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchListings.SearchListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchListings.SearchListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchListings.SearchListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSearchListingsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchListings.t.Errorf("RepositoryMock.SearchListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmSearchListings.t.Errorf("RepositoryMock.SearchListings got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchListings.t.Errorf("RepositoryMock.SearchListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchListings.SearchListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchListings.t.Fatal("No results are set for the RepositoryMock.SearchListings")
+		}
+		return (*mm_results).lpa1, (*mm_results).u1, (*mm_results).err
+	}
+	if mmSearchListings.funcSearchListings != nil {
+		return mmSearchListings.funcSearchListings(ctx, filter)
+	}
+	mmSearchListings.t.Fatalf("Unexpected call to RepositoryMock.SearchListings. %v %v", ctx, filter)
+	return
+}
+
+// SearchListingsAfterCounter returns a count of finished RepositoryMock.SearchListings invocations
+func (mmSearchListings *RepositoryMock) SearchListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchListings.afterSearchListingsCounter)
+}
+
+// SearchListingsBeforeCounter returns a count of RepositoryMock.SearchListings invocations
+func (mmSearchListings *RepositoryMock) SearchListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchListings.beforeSearchListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SearchListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchListings *mRepositoryMockSearchListings) Calls() []*RepositoryMockSearchListingsParams {
+	mmSearchListings.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSearchListingsParams, len(mmSearchListings.callArgs))
+	copy(argCopy, mmSearchListings.callArgs)
+
+	mmSearchListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchListingsDone returns true if the count of the SearchListings invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSearchListingsDone() bool {
+	if m.SearchListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchListingsMock.invocationsDone()
+}
+
+// MinimockSearchListingsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSearchListingsInspect() {
+	for _, e := range m.SearchListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SearchListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchListingsCounter := mm_atomic.LoadUint64(&m.afterSearchListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchListingsMock.defaultExpectation != nil && afterSearchListingsCounter < 1 {
+		if m.SearchListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SearchListings at\n%s", m.SearchListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SearchListings at\n%s with params: %#v", m.SearchListingsMock.defaultExpectation.expectationOrigins.origin, *m.SearchListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchListings != nil && afterSearchListingsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SearchListings at\n%s", m.funcSearchListingsOrigin)
+	}
+
+	if !m.SearchListingsMock.invocationsDone() && afterSearchListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SearchListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchListingsMock.expectedInvocations), m.SearchListingsMock.expectedInvocationsOrigin, afterSearchListingsCounter)
+	}
+}
+
+type mRepositoryMockUpdateListing struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateListingExpectation
+	expectations       []*RepositoryMockUpdateListingExpectation
+
+	callArgs []*RepositoryMockUpdateListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdateListingExpectation specifies expectation struct of the Repository.UpdateListing
+type RepositoryMockUpdateListingExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdateListingParams
+	paramPtrs          *RepositoryMockUpdateListingParamPtrs
+	expectationOrigins RepositoryMockUpdateListingExpectationOrigins
+	results            *RepositoryMockUpdateListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdateListingParams contains parameters of the Repository.UpdateListing
+type RepositoryMockUpdateListingParams struct {
+	ctx    context.Context
+	update *entity.ListingUpdate
+}
+
+// RepositoryMockUpdateListingParamPtrs contains pointers to parameters of the Repository.UpdateListing
+type RepositoryMockUpdateListingParamPtrs struct {
+	ctx    *context.Context
+	update **entity.ListingUpdate
+}
+
+// RepositoryMockUpdateListingResults contains results of the Repository.UpdateListing
+type RepositoryMockUpdateListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// RepositoryMockUpdateListingOrigins contains origins of expectations of the Repository.UpdateListing
+type RepositoryMockUpdateListingExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateListing *mRepositoryMockUpdateListing) Optional() *mRepositoryMockUpdateListing {
+	mmUpdateListing.optional = true
+	return mmUpdateListing
+}
+
+// Expect sets up expected params for Repository.UpdateListing
+func (mmUpdateListing *mRepositoryMockUpdateListing) Expect(ctx context.Context, update *entity.ListingUpdate) *mRepositoryMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &RepositoryMockUpdateListingExpectation{}
+	}
+
+	if mmUpdateListing.defaultExpectation.paramPtrs != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateListing.defaultExpectation.params = &RepositoryMockUpdateListingParams{ctx, update}
+	mmUpdateListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateListing.expectations {
+		if minimock.Equal(e.params, mmUpdateListing.defaultExpectation.params) {
+			mmUpdateListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateListing.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateListing
+func (mmUpdateListing *mRepositoryMockUpdateListing) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &RepositoryMockUpdateListingExpectation{}
+	}
+
+	if mmUpdateListing.defaultExpectation.params != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Expect")
+	}
+
+	if mmUpdateListing.defaultExpectation.paramPtrs == nil {
+		mmUpdateListing.defaultExpectation.paramPtrs = &RepositoryMockUpdateListingParamPtrs{}
+	}
+	mmUpdateListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateListing
+}
+
+// ExpectUpdateParam2 sets up expected param update for Repository.UpdateListing
+func (mmUpdateListing *mRepositoryMockUpdateListing) ExpectUpdateParam2(update *entity.ListingUpdate) *mRepositoryMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &RepositoryMockUpdateListingExpectation{}
+	}
+
+	if mmUpdateListing.defaultExpectation.params != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Expect")
+	}
+
+	if mmUpdateListing.defaultExpectation.paramPtrs == nil {
+		mmUpdateListing.defaultExpectation.paramPtrs = &RepositoryMockUpdateListingParamPtrs{}
+	}
+	mmUpdateListing.defaultExpectation.paramPtrs.update = &update
+	mmUpdateListing.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateListing
+func (mmUpdateListing *mRepositoryMockUpdateListing) Inspect(f func(ctx context.Context, update *entity.ListingUpdate)) *mRepositoryMockUpdateListing {
+	if mmUpdateListing.mock.inspectFuncUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateListing")
+	}
+
+	mmUpdateListing.mock.inspectFuncUpdateListing = f
+
+	return mmUpdateListing
+}
+
+// Return sets up results that will be returned by Repository.UpdateListing
+func (mmUpdateListing *mRepositoryMockUpdateListing) Return(lp1 *entity.Listing, err error) *RepositoryMock {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &RepositoryMockUpdateListingExpectation{mock: mmUpdateListing.mock}
+	}
+	mmUpdateListing.defaultExpectation.results = &RepositoryMockUpdateListingResults{lp1, err}
+	mmUpdateListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateListing.mock
+}
+
+// Set uses given function f to mock the Repository.UpdateListing method
+func (mmUpdateListing *mRepositoryMockUpdateListing) Set(f func(ctx context.Context, update *entity.ListingUpdate) (lp1 *entity.Listing, err error)) *RepositoryMock {
+	if mmUpdateListing.defaultExpectation != nil {
+		mmUpdateListing.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateListing method")
+	}
+
+	if len(mmUpdateListing.expectations) > 0 {
+		mmUpdateListing.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateListing method")
+	}
+
+	mmUpdateListing.mock.funcUpdateListing = f
+	mmUpdateListing.mock.funcUpdateListingOrigin = minimock.CallerInfo(1)
+	return mmUpdateListing.mock
+}
+
+// When sets expectation for the Repository.UpdateListing which will trigger the result defined by the following
+// Then helper
+func (mmUpdateListing *mRepositoryMockUpdateListing) When(ctx context.Context, update *entity.ListingUpdate) *RepositoryMockUpdateListingExpectation {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("RepositoryMock.UpdateListing mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpdateListingExpectation{
+		mock:               mmUpdateListing.mock,
+		params:             &RepositoryMockUpdateListingParams{ctx, update},
+		expectationOrigins: RepositoryMockUpdateListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateListing.expectations = append(mmUpdateListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpdateListing return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateListingExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpdateListing should be invoked
+func (mmUpdateListing *mRepositoryMockUpdateListing) Times(n uint64) *mRepositoryMockUpdateListing {
+	if n == 0 {
+		mmUpdateListing.mock.t.Fatalf("Times of RepositoryMock.UpdateListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateListing.expectedInvocations, n)
+	mmUpdateListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateListing
+}
+
+func (mmUpdateListing *mRepositoryMockUpdateListing) invocationsDone() bool {
+	if len(mmUpdateListing.expectations) == 0 && mmUpdateListing.defaultExpectation == nil && mmUpdateListing.mock.funcUpdateListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateListing.mock.afterUpdateListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateListing implements mm_listing.Repository
+func (mmUpdateListing *RepositoryMock) UpdateListing(ctx context.Context, update *entity.ListingUpdate) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmUpdateListing.beforeUpdateListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateListing.afterUpdateListingCounter, 1)
+
+	mmUpdateListing.t.Helper()
+
+	if mmUpdateListing.inspectFuncUpdateListing != nil {
+		mmUpdateListing.inspectFuncUpdateListing(ctx, update)
+	}
+
+	mm_params := RepositoryMockUpdateListingParams{ctx, update}
+
+	// Record call args
+	mmUpdateListing.UpdateListingMock.mutex.Lock()
+	mmUpdateListing.UpdateListingMock.callArgs = append(mmUpdateListing.UpdateListingMock.callArgs, &mm_params)
+	mmUpdateListing.UpdateListingMock.mutex.Unlock()
+
+	for _, e := range mmUpdateListing.UpdateListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmUpdateListing.UpdateListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateListing.UpdateListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateListing.UpdateListingMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateListing.UpdateListingMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpdateListingParams{ctx, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateListing.t.Errorf("RepositoryMock.UpdateListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateListing.t.Errorf("RepositoryMock.UpdateListing got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateListing.t.Errorf("RepositoryMock.UpdateListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateListing.UpdateListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateListing.t.Fatal("No results are set for the RepositoryMock.UpdateListing")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmUpdateListing.funcUpdateListing != nil {
+		return mmUpdateListing.funcUpdateListing(ctx, update)
+	}
+	mmUpdateListing.t.Fatalf("Unexpected call to RepositoryMock.UpdateListing. %v %v", ctx, update)
+	return
+}
+
+// UpdateListingAfterCounter returns a count of finished RepositoryMock.UpdateListing invocations
+func (mmUpdateListing *RepositoryMock) UpdateListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateListing.afterUpdateListingCounter)
+}
+
+// UpdateListingBeforeCounter returns a count of RepositoryMock.UpdateListing invocations
+func (mmUpdateListing *RepositoryMock) UpdateListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateListing.beforeUpdateListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateListing *mRepositoryMockUpdateListing) Calls() []*RepositoryMockUpdateListingParams {
+	mmUpdateListing.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockUpdateListingParams, len(mmUpdateListing.callArgs))
+	copy(argCopy, mmUpdateListing.callArgs)
+
+	mmUpdateListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateListingDone returns true if the count of the UpdateListing invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpdateListingDone() bool {
+	if m.UpdateListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateListingMock.invocationsDone()
+}
+
+// MinimockUpdateListingInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateListingInspect() {
+	for _, e := range m.UpdateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateListingCounter := mm_atomic.LoadUint64(&m.afterUpdateListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateListingMock.defaultExpectation != nil && afterUpdateListingCounter < 1 {
+		if m.UpdateListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateListing at\n%s", m.UpdateListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateListing at\n%s with params: %#v", m.UpdateListingMock.defaultExpectation.expectationOrigins.origin, *m.UpdateListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateListing != nil && afterUpdateListingCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdateListing at\n%s", m.funcUpdateListingOrigin)
+	}
+
+	if !m.UpdateListingMock.invocationsDone() && afterUpdateListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateListingMock.expectedInvocations), m.UpdateListingMock.expectedInvocationsOrigin, afterUpdateListingCounter)
+	}
+}
+
+type mRepositoryMockUpdateListingStatus struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateListingStatusExpectation
+	expectations       []*RepositoryMockUpdateListingStatusExpectation
+
+	callArgs []*RepositoryMockUpdateListingStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdateListingStatusExpectation specifies expectation struct of the Repository.UpdateListingStatus
+type RepositoryMockUpdateListingStatusExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdateListingStatusParams
+	paramPtrs          *RepositoryMockUpdateListingStatusParamPtrs
+	expectationOrigins RepositoryMockUpdateListingStatusExpectationOrigins
+	results            *RepositoryMockUpdateListingStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdateListingStatusParams contains parameters of the Repository.UpdateListingStatus
+type RepositoryMockUpdateListingStatusParams struct {
+	ctx  context.Context
+	id   uint64
+	from entity.ListingStatus
+	to   entity.ListingStatus
+}
+
+// RepositoryMockUpdateListingStatusParamPtrs contains pointers to parameters of the Repository.UpdateListingStatus
+type RepositoryMockUpdateListingStatusParamPtrs struct {
+	ctx  *context.Context
+	id   *uint64
+	from *entity.ListingStatus
+	to   *entity.ListingStatus
+}
+
+// RepositoryMockUpdateListingStatusResults contains results of the Repository.UpdateListingStatus
+type RepositoryMockUpdateListingStatusResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// RepositoryMockUpdateListingStatusOrigins contains origins of expectations of the Repository.UpdateListingStatus
+type RepositoryMockUpdateListingStatusExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originFrom string
+	originTo   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Optional() *mRepositoryMockUpdateListingStatus {
+	mmUpdateListingStatus.optional = true
+	return mmUpdateListingStatus
+}
+
+// Expect sets up expected params for Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Expect(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus) *mRepositoryMockUpdateListingStatus {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation == nil {
+		mmUpdateListingStatus.defaultExpectation = &RepositoryMockUpdateListingStatusExpectation{}
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.paramPtrs != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateListingStatus.defaultExpectation.params = &RepositoryMockUpdateListingStatusParams{ctx, id, from, to}
+	mmUpdateListingStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateListingStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateListingStatus.defaultExpectation.params) {
+			mmUpdateListingStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateListingStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateListingStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateListingStatus {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation == nil {
+		mmUpdateListingStatus.defaultExpectation = &RepositoryMockUpdateListingStatusExpectation{}
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.params != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Expect")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateListingStatus.defaultExpectation.paramPtrs = &RepositoryMockUpdateListingStatusParamPtrs{}
+	}
+	mmUpdateListingStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateListingStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateListingStatus
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) ExpectIdParam2(id uint64) *mRepositoryMockUpdateListingStatus {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation == nil {
+		mmUpdateListingStatus.defaultExpectation = &RepositoryMockUpdateListingStatusExpectation{}
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.params != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Expect")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateListingStatus.defaultExpectation.paramPtrs = &RepositoryMockUpdateListingStatusParamPtrs{}
+	}
+	mmUpdateListingStatus.defaultExpectation.paramPtrs.id = &id
+	mmUpdateListingStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateListingStatus
+}
+
+// ExpectFromParam3 sets up expected param from for Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) ExpectFromParam3(from entity.ListingStatus) *mRepositoryMockUpdateListingStatus {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation == nil {
+		mmUpdateListingStatus.defaultExpectation = &RepositoryMockUpdateListingStatusExpectation{}
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.params != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Expect")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateListingStatus.defaultExpectation.paramPtrs = &RepositoryMockUpdateListingStatusParamPtrs{}
+	}
+	mmUpdateListingStatus.defaultExpectation.paramPtrs.from = &from
+	mmUpdateListingStatus.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmUpdateListingStatus
+}
+
+// ExpectToParam4 sets up expected param to for Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) ExpectToParam4(to entity.ListingStatus) *mRepositoryMockUpdateListingStatus {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation == nil {
+		mmUpdateListingStatus.defaultExpectation = &RepositoryMockUpdateListingStatusExpectation{}
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.params != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Expect")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateListingStatus.defaultExpectation.paramPtrs = &RepositoryMockUpdateListingStatusParamPtrs{}
+	}
+	mmUpdateListingStatus.defaultExpectation.paramPtrs.to = &to
+	mmUpdateListingStatus.defaultExpectation.expectationOrigins.originTo = minimock.CallerInfo(1)
+
+	return mmUpdateListingStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Inspect(f func(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus)) *mRepositoryMockUpdateListingStatus {
+	if mmUpdateListingStatus.mock.inspectFuncUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateListingStatus")
+	}
+
+	mmUpdateListingStatus.mock.inspectFuncUpdateListingStatus = f
+
+	return mmUpdateListingStatus
+}
+
+// Return sets up results that will be returned by Repository.UpdateListingStatus
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Return(lp1 *entity.Listing, err error) *RepositoryMock {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	if mmUpdateListingStatus.defaultExpectation == nil {
+		mmUpdateListingStatus.defaultExpectation = &RepositoryMockUpdateListingStatusExpectation{mock: mmUpdateListingStatus.mock}
+	}
+	mmUpdateListingStatus.defaultExpectation.results = &RepositoryMockUpdateListingStatusResults{lp1, err}
+	mmUpdateListingStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateListingStatus.mock
+}
+
+// Set uses given function f to mock the Repository.UpdateListingStatus method
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Set(f func(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus) (lp1 *entity.Listing, err error)) *RepositoryMock {
+	if mmUpdateListingStatus.defaultExpectation != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateListingStatus method")
+	}
+
+	if len(mmUpdateListingStatus.expectations) > 0 {
+		mmUpdateListingStatus.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateListingStatus method")
+	}
+
+	mmUpdateListingStatus.mock.funcUpdateListingStatus = f
+	mmUpdateListingStatus.mock.funcUpdateListingStatusOrigin = minimock.CallerInfo(1)
+	return mmUpdateListingStatus.mock
+}
+
+// When sets expectation for the Repository.UpdateListingStatus which will trigger the result defined by the following
+// Then helper
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) When(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus) *RepositoryMockUpdateListingStatusExpectation {
+	if mmUpdateListingStatus.mock.funcUpdateListingStatus != nil {
+		mmUpdateListingStatus.mock.t.Fatalf("RepositoryMock.UpdateListingStatus mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpdateListingStatusExpectation{
+		mock:               mmUpdateListingStatus.mock,
+		params:             &RepositoryMockUpdateListingStatusParams{ctx, id, from, to},
+		expectationOrigins: RepositoryMockUpdateListingStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateListingStatus.expectations = append(mmUpdateListingStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpdateListingStatus return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateListingStatusExpectation) Then(lp1 *entity.Listing, err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateListingStatusResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpdateListingStatus should be invoked
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Times(n uint64) *mRepositoryMockUpdateListingStatus {
+	if n == 0 {
+		mmUpdateListingStatus.mock.t.Fatalf("Times of RepositoryMock.UpdateListingStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateListingStatus.expectedInvocations, n)
+	mmUpdateListingStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateListingStatus
+}
+
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) invocationsDone() bool {
+	if len(mmUpdateListingStatus.expectations) == 0 && mmUpdateListingStatus.defaultExpectation == nil && mmUpdateListingStatus.mock.funcUpdateListingStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateListingStatus.mock.afterUpdateListingStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateListingStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateListingStatus implements mm_listing.Repository
+func (mmUpdateListingStatus *RepositoryMock) UpdateListingStatus(ctx context.Context, id uint64, from entity.ListingStatus, to entity.ListingStatus) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmUpdateListingStatus.beforeUpdateListingStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateListingStatus.afterUpdateListingStatusCounter, 1)
+
+	mmUpdateListingStatus.t.Helper()
 
-	if mmCreateListing.inspectFuncCreateListing != nil {
-		mmCreateListing.inspectFuncCreateListing(ctx, listing)
+	if mmUpdateListingStatus.inspectFuncUpdateListingStatus != nil {
+		mmUpdateListingStatus.inspectFuncUpdateListingStatus(ctx, id, from, to)
 	}
 
-	mm_params := RepositoryMockCreateListingParams{ctx, listing}
+	mm_params := RepositoryMockUpdateListingStatusParams{ctx, id, from, to}
 
 	// Record call args
-	mmCreateListing.CreateListingMock.mutex.Lock()
-	mmCreateListing.CreateListingMock.callArgs = append(mmCreateListing.CreateListingMock.callArgs, &mm_params)
-	mmCreateListing.CreateListingMock.mutex.Unlock()
+	mmUpdateListingStatus.UpdateListingStatusMock.mutex.Lock()
+	mmUpdateListingStatus.UpdateListingStatusMock.callArgs = append(mmUpdateListingStatus.UpdateListingStatusMock.callArgs, &mm_params)
+	mmUpdateListingStatus.UpdateListingStatusMock.mutex.Unlock()
 
-	for _, e := range mmCreateListing.CreateListingMock.expectations {
+	for _, e := range mmUpdateListingStatus.UpdateListingStatusMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.lp1, e.results.err
 		}
 	}
 
-	if mmCreateListing.CreateListingMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateListing.CreateListingMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateListing.CreateListingMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateListing.CreateListingMock.defaultExpectation.paramPtrs
+	if mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockCreateListingParams{ctx, listing}
+		mm_got := RepositoryMockUpdateListingStatusParams{ctx, id, from, to}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateListing.t.Errorf("RepositoryMock.CreateListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateListingStatus.t.Errorf("RepositoryMock.UpdateListingStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.listing != nil && !minimock.Equal(*mm_want_ptrs.listing, mm_got.listing) {
-				mmCreateListing.t.Errorf("RepositoryMock.CreateListing got unexpected parameter listing, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originListing, *mm_want_ptrs.listing, mm_got.listing, minimock.Diff(*mm_want_ptrs.listing, mm_got.listing))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateListingStatus.t.Errorf("RepositoryMock.UpdateListingStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmUpdateListingStatus.t.Errorf("RepositoryMock.UpdateListingStatus got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmUpdateListingStatus.t.Errorf("RepositoryMock.UpdateListingStatus got unexpected parameter to, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.expectationOrigins.originTo, *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateListing.t.Errorf("RepositoryMock.CreateListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateListingStatus.t.Errorf("RepositoryMock.UpdateListingStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateListing.CreateListingMock.defaultExpectation.results
+		mm_results := mmUpdateListingStatus.UpdateListingStatusMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateListing.t.Fatal("No results are set for the RepositoryMock.CreateListing")
+			mmUpdateListingStatus.t.Fatal("No results are set for the RepositoryMock.UpdateListingStatus")
 		}
 		return (*mm_results).lp1, (*mm_results).err
 	}
-	if mmCreateListing.funcCreateListing != nil {
-		return mmCreateListing.funcCreateListing(ctx, listing)
+	if mmUpdateListingStatus.funcUpdateListingStatus != nil {
+		return mmUpdateListingStatus.funcUpdateListingStatus(ctx, id, from, to)
 	}
-	mmCreateListing.t.Fatalf("Unexpected call to RepositoryMock.CreateListing. %v %v", ctx, listing)
+	mmUpdateListingStatus.t.Fatalf("Unexpected call to RepositoryMock.UpdateListingStatus. %v %v %v %v", ctx, id, from, to)
 	return
 }
 
-// CreateListingAfterCounter returns a count of finished RepositoryMock.CreateListing invocations
-func (mmCreateListing *RepositoryMock) CreateListingAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateListing.afterCreateListingCounter)
+// UpdateListingStatusAfterCounter returns a count of finished RepositoryMock.UpdateListingStatus invocations
+func (mmUpdateListingStatus *RepositoryMock) UpdateListingStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateListingStatus.afterUpdateListingStatusCounter)
 }
 
-// CreateListingBeforeCounter returns a count of RepositoryMock.CreateListing invocations
-func (mmCreateListing *RepositoryMock) CreateListingBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateListing.beforeCreateListingCounter)
+// UpdateListingStatusBeforeCounter returns a count of RepositoryMock.UpdateListingStatus invocations
+func (mmUpdateListingStatus *RepositoryMock) UpdateListingStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateListingStatus.beforeUpdateListingStatusCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.CreateListing.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateListingStatus.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateListing *mRepositoryMockCreateListing) Calls() []*RepositoryMockCreateListingParams {
-	mmCreateListing.mutex.RLock()
+func (mmUpdateListingStatus *mRepositoryMockUpdateListingStatus) Calls() []*RepositoryMockUpdateListingStatusParams {
+	mmUpdateListingStatus.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockCreateListingParams, len(mmCreateListing.callArgs))
-	copy(argCopy, mmCreateListing.callArgs)
+	argCopy := make([]*RepositoryMockUpdateListingStatusParams, len(mmUpdateListingStatus.callArgs))
+	copy(argCopy, mmUpdateListingStatus.callArgs)
 
-	mmCreateListing.mutex.RUnlock()
+	mmUpdateListingStatus.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateListingDone returns true if the count of the CreateListing invocations corresponds
+// MinimockUpdateListingStatusDone returns true if the count of the UpdateListingStatus invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockCreateListingDone() bool {
-	if m.CreateListingMock.optional {
+func (m *RepositoryMock) MinimockUpdateListingStatusDone() bool {
+	if m.UpdateListingStatusMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateListingMock.expectations {
+	for _, e := range m.UpdateListingStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateListingMock.invocationsDone()
+	return m.UpdateListingStatusMock.invocationsDone()
 }
 
-// MinimockCreateListingInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockCreateListingInspect() {
-	for _, e := range m.CreateListingMock.expectations {
+// MinimockUpdateListingStatusInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateListingStatusInspect() {
+	for _, e := range m.UpdateListingStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateListingStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateListingCounter := mm_atomic.LoadUint64(&m.afterCreateListingCounter)
+	afterUpdateListingStatusCounter := mm_atomic.LoadUint64(&m.afterUpdateListingStatusCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateListingMock.defaultExpectation != nil && afterCreateListingCounter < 1 {
-		if m.CreateListingMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s", m.CreateListingMock.defaultExpectation.returnOrigin)
+	if m.UpdateListingStatusMock.defaultExpectation != nil && afterUpdateListingStatusCounter < 1 {
+		if m.UpdateListingStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateListingStatus at\n%s", m.UpdateListingStatusMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s with params: %#v", m.CreateListingMock.defaultExpectation.expectationOrigins.origin, *m.CreateListingMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateListingStatus at\n%s with params: %#v", m.UpdateListingStatusMock.defaultExpectation.expectationOrigins.origin, *m.UpdateListingStatusMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateListing != nil && afterCreateListingCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.CreateListing at\n%s", m.funcCreateListingOrigin)
+	if m.funcUpdateListingStatus != nil && afterUpdateListingStatusCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdateListingStatus at\n%s", m.funcUpdateListingStatusOrigin)
 	}
 
-	if !m.CreateListingMock.invocationsDone() && afterCreateListingCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.CreateListing at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateListingMock.expectedInvocations), m.CreateListingMock.expectedInvocationsOrigin, afterCreateListingCounter)
+	if !m.UpdateListingStatusMock.invocationsDone() && afterUpdateListingStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateListingStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateListingStatusMock.expectedInvocations), m.UpdateListingStatusMock.expectedInvocationsOrigin, afterUpdateListingStatusCounter)
 	}
 }
 
-type mRepositoryMockGetListings struct {
+type mRepositoryMockUpdateSavedSearch struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockGetListingsExpectation
-	expectations       []*RepositoryMockGetListingsExpectation
+	defaultExpectation *RepositoryMockUpdateSavedSearchExpectation
+	expectations       []*RepositoryMockUpdateSavedSearchExpectation
 
-	callArgs []*RepositoryMockGetListingsParams
+	callArgs []*RepositoryMockUpdateSavedSearchParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockGetListingsExpectation specifies expectation struct of the Repository.GetListings
-type RepositoryMockGetListingsExpectation struct {
+// RepositoryMockUpdateSavedSearchExpectation specifies expectation struct of the Repository.UpdateSavedSearch
+type RepositoryMockUpdateSavedSearchExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockGetListingsParams
-	paramPtrs          *RepositoryMockGetListingsParamPtrs
-	expectationOrigins RepositoryMockGetListingsExpectationOrigins
-	results            *RepositoryMockGetListingsResults
+	params             *RepositoryMockUpdateSavedSearchParams
+	paramPtrs          *RepositoryMockUpdateSavedSearchParamPtrs
+	expectationOrigins RepositoryMockUpdateSavedSearchExpectationOrigins
+	results            *RepositoryMockUpdateSavedSearchResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockGetListingsParams contains parameters of the Repository.GetListings
-type RepositoryMockGetListingsParams struct {
+// RepositoryMockUpdateSavedSearchParams contains parameters of the Repository.UpdateSavedSearch
+type RepositoryMockUpdateSavedSearchParams struct {
 	ctx    context.Context
-	filter *entity.ListingFilter
+	search *entity.SavedSearch
 }
 
-// RepositoryMockGetListingsParamPtrs contains pointers to parameters of the Repository.GetListings
-type RepositoryMockGetListingsParamPtrs struct {
+// RepositoryMockUpdateSavedSearchParamPtrs contains pointers to parameters of the Repository.UpdateSavedSearch
+type RepositoryMockUpdateSavedSearchParamPtrs struct {
 	ctx    *context.Context
-	filter **entity.ListingFilter
+	search **entity.SavedSearch
 }
 
-// RepositoryMockGetListingsResults contains results of the Repository.GetListings
-type RepositoryMockGetListingsResults struct {
-	lpa1 []*entity.Listing
-	u1   uint32
-	err  error
+// RepositoryMockUpdateSavedSearchResults contains results of the Repository.UpdateSavedSearch
+type RepositoryMockUpdateSavedSearchResults struct {
+	sp1 *entity.SavedSearch
+	err error
 }
 
-// RepositoryMockGetListingsOrigins contains origins of expectations of the Repository.GetListings
-type RepositoryMockGetListingsExpectationOrigins struct {
+// RepositoryMockUpdateSavedSearchOrigins contains origins of expectations of the Repository.UpdateSavedSearch
+type RepositoryMockUpdateSavedSearchExpectationOrigins struct {
 	origin       string
 	originCtx    string
-	originFilter string
+	originSearch string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -451,292 +10924,292 @@ type RepositoryMockGetListingsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetListings *mRepositoryMockGetListings) Optional() *mRepositoryMockGetListings {
-	mmGetListings.optional = true
-	return mmGetListings
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Optional() *mRepositoryMockUpdateSavedSearch {
+	mmUpdateSavedSearch.optional = true
+	return mmUpdateSavedSearch
 }
 
-// Expect sets up expected params for Repository.GetListings
-func (mmGetListings *mRepositoryMockGetListings) Expect(ctx context.Context, filter *entity.ListingFilter) *mRepositoryMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+// Expect sets up expected params for Repository.UpdateSavedSearch
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Expect(ctx context.Context, search *entity.SavedSearch) *mRepositoryMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &RepositoryMockUpdateSavedSearchExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by ExpectParams functions")
+	if mmUpdateSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by ExpectParams functions")
 	}
 
-	mmGetListings.defaultExpectation.params = &RepositoryMockGetListingsParams{ctx, filter}
-	mmGetListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetListings.expectations {
-		if minimock.Equal(e.params, mmGetListings.defaultExpectation.params) {
-			mmGetListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListings.defaultExpectation.params)
+	mmUpdateSavedSearch.defaultExpectation.params = &RepositoryMockUpdateSavedSearchParams{ctx, search}
+	mmUpdateSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSavedSearch.expectations {
+		if minimock.Equal(e.params, mmUpdateSavedSearch.defaultExpectation.params) {
+			mmUpdateSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSavedSearch.defaultExpectation.params)
 		}
 	}
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.GetListings
-func (mmGetListings *mRepositoryMockGetListings) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateSavedSearch
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &RepositoryMockUpdateSavedSearchExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Expect")
+	if mmUpdateSavedSearch.defaultExpectation.params != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Expect")
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &RepositoryMockGetListingsParamPtrs{}
+	if mmUpdateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmUpdateSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockUpdateSavedSearchParamPtrs{}
 	}
-	mmGetListings.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// ExpectFilterParam2 sets up expected param filter for Repository.GetListings
-func (mmGetListings *mRepositoryMockGetListings) ExpectFilterParam2(filter *entity.ListingFilter) *mRepositoryMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+// ExpectSearchParam2 sets up expected param search for Repository.UpdateSavedSearch
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) ExpectSearchParam2(search *entity.SavedSearch) *mRepositoryMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &RepositoryMockUpdateSavedSearchExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Expect")
+	if mmUpdateSavedSearch.defaultExpectation.params != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Expect")
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &RepositoryMockGetListingsParamPtrs{}
+	if mmUpdateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmUpdateSavedSearch.defaultExpectation.paramPtrs = &RepositoryMockUpdateSavedSearchParamPtrs{}
 	}
-	mmGetListings.defaultExpectation.paramPtrs.filter = &filter
-	mmGetListings.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+	mmUpdateSavedSearch.defaultExpectation.paramPtrs.search = &search
+	mmUpdateSavedSearch.defaultExpectation.expectationOrigins.originSearch = minimock.CallerInfo(1)
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.GetListings
-func (mmGetListings *mRepositoryMockGetListings) Inspect(f func(ctx context.Context, filter *entity.ListingFilter)) *mRepositoryMockGetListings {
-	if mmGetListings.mock.inspectFuncGetListings != nil {
-		mmGetListings.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetListings")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateSavedSearch
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Inspect(f func(ctx context.Context, search *entity.SavedSearch)) *mRepositoryMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.inspectFuncUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateSavedSearch")
 	}
 
-	mmGetListings.mock.inspectFuncGetListings = f
+	mmUpdateSavedSearch.mock.inspectFuncUpdateSavedSearch = f
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// Return sets up results that will be returned by Repository.GetListings
-func (mmGetListings *mRepositoryMockGetListings) Return(lpa1 []*entity.Listing, u1 uint32, err error) *RepositoryMock {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdateSavedSearch
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Return(sp1 *entity.SavedSearch, err error) *RepositoryMock {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &RepositoryMockGetListingsExpectation{mock: mmGetListings.mock}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &RepositoryMockUpdateSavedSearchExpectation{mock: mmUpdateSavedSearch.mock}
 	}
-	mmGetListings.defaultExpectation.results = &RepositoryMockGetListingsResults{lpa1, u1, err}
-	mmGetListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetListings.mock
+	mmUpdateSavedSearch.defaultExpectation.results = &RepositoryMockUpdateSavedSearchResults{sp1, err}
+	mmUpdateSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSavedSearch.mock
 }
 
-// Set uses given function f to mock the Repository.GetListings method
-func (mmGetListings *mRepositoryMockGetListings) Set(f func(ctx context.Context, filter *entity.ListingFilter) (lpa1 []*entity.Listing, u1 uint32, err error)) *RepositoryMock {
-	if mmGetListings.defaultExpectation != nil {
-		mmGetListings.mock.t.Fatalf("Default expectation is already set for the Repository.GetListings method")
+// Set uses given function f to mock the Repository.UpdateSavedSearch method
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Set(f func(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error)) *RepositoryMock {
+	if mmUpdateSavedSearch.defaultExpectation != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateSavedSearch method")
 	}
 
-	if len(mmGetListings.expectations) > 0 {
-		mmGetListings.mock.t.Fatalf("Some expectations are already set for the Repository.GetListings method")
+	if len(mmUpdateSavedSearch.expectations) > 0 {
+		mmUpdateSavedSearch.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateSavedSearch method")
 	}
 
-	mmGetListings.mock.funcGetListings = f
-	mmGetListings.mock.funcGetListingsOrigin = minimock.CallerInfo(1)
-	return mmGetListings.mock
+	mmUpdateSavedSearch.mock.funcUpdateSavedSearch = f
+	mmUpdateSavedSearch.mock.funcUpdateSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmUpdateSavedSearch.mock
 }
 
-// When sets expectation for the Repository.GetListings which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdateSavedSearch which will trigger the result defined by the following
 // Then helper
-func (mmGetListings *mRepositoryMockGetListings) When(ctx context.Context, filter *entity.ListingFilter) *RepositoryMockGetListingsExpectation {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("RepositoryMock.GetListings mock is already set by Set")
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) When(ctx context.Context, search *entity.SavedSearch) *RepositoryMockUpdateSavedSearchExpectation {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("RepositoryMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockGetListingsExpectation{
-		mock:               mmGetListings.mock,
-		params:             &RepositoryMockGetListingsParams{ctx, filter},
-		expectationOrigins: RepositoryMockGetListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockUpdateSavedSearchExpectation{
+		mock:               mmUpdateSavedSearch.mock,
+		params:             &RepositoryMockUpdateSavedSearchParams{ctx, search},
+		expectationOrigins: RepositoryMockUpdateSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetListings.expectations = append(mmGetListings.expectations, expectation)
+	mmUpdateSavedSearch.expectations = append(mmUpdateSavedSearch.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.GetListings return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockGetListingsExpectation) Then(lpa1 []*entity.Listing, u1 uint32, err error) *RepositoryMock {
-	e.results = &RepositoryMockGetListingsResults{lpa1, u1, err}
+// Then sets up Repository.UpdateSavedSearch return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateSavedSearchExpectation) Then(sp1 *entity.SavedSearch, err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateSavedSearchResults{sp1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.GetListings should be invoked
-func (mmGetListings *mRepositoryMockGetListings) Times(n uint64) *mRepositoryMockGetListings {
+// Times sets number of times Repository.UpdateSavedSearch should be invoked
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Times(n uint64) *mRepositoryMockUpdateSavedSearch {
 	if n == 0 {
-		mmGetListings.mock.t.Fatalf("Times of RepositoryMock.GetListings mock can not be zero")
+		mmUpdateSavedSearch.mock.t.Fatalf("Times of RepositoryMock.UpdateSavedSearch mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetListings.expectedInvocations, n)
-	mmGetListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetListings
+	mm_atomic.StoreUint64(&mmUpdateSavedSearch.expectedInvocations, n)
+	mmUpdateSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSavedSearch
 }
 
-func (mmGetListings *mRepositoryMockGetListings) invocationsDone() bool {
-	if len(mmGetListings.expectations) == 0 && mmGetListings.defaultExpectation == nil && mmGetListings.mock.funcGetListings == nil {
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) invocationsDone() bool {
+	if len(mmUpdateSavedSearch.expectations) == 0 && mmUpdateSavedSearch.defaultExpectation == nil && mmUpdateSavedSearch.mock.funcUpdateSavedSearch == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetListings.mock.afterGetListingsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetListings.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSavedSearch.mock.afterUpdateSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSavedSearch.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetListings implements mm_listing.Repository
-func (mmGetListings *RepositoryMock) GetListings(ctx context.Context, filter *entity.ListingFilter) (lpa1 []*entity.Listing, u1 uint32, err error) {
-	mm_atomic.AddUint64(&mmGetListings.beforeGetListingsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetListings.afterGetListingsCounter, 1)
+// UpdateSavedSearch implements mm_listing.Repository
+func (mmUpdateSavedSearch *RepositoryMock) UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmUpdateSavedSearch.beforeUpdateSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSavedSearch.afterUpdateSavedSearchCounter, 1)
 
-	mmGetListings.t.Helper()
+	mmUpdateSavedSearch.t.Helper()
 
-	if mmGetListings.inspectFuncGetListings != nil {
-		mmGetListings.inspectFuncGetListings(ctx, filter)
+	if mmUpdateSavedSearch.inspectFuncUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.inspectFuncUpdateSavedSearch(ctx, search)
 	}
 
-	mm_params := RepositoryMockGetListingsParams{ctx, filter}
+	mm_params := RepositoryMockUpdateSavedSearchParams{ctx, search}
 
 	// Record call args
-	mmGetListings.GetListingsMock.mutex.Lock()
-	mmGetListings.GetListingsMock.callArgs = append(mmGetListings.GetListingsMock.callArgs, &mm_params)
-	mmGetListings.GetListingsMock.mutex.Unlock()
+	mmUpdateSavedSearch.UpdateSavedSearchMock.mutex.Lock()
+	mmUpdateSavedSearch.UpdateSavedSearchMock.callArgs = append(mmUpdateSavedSearch.UpdateSavedSearchMock.callArgs, &mm_params)
+	mmUpdateSavedSearch.UpdateSavedSearchMock.mutex.Unlock()
 
-	for _, e := range mmGetListings.GetListingsMock.expectations {
+	for _, e := range mmUpdateSavedSearch.UpdateSavedSearchMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.lpa1, e.results.u1, e.results.err
+			return e.results.sp1, e.results.err
 		}
 	}
 
-	if mmGetListings.GetListingsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetListings.GetListingsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetListings.GetListingsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetListings.GetListingsMock.defaultExpectation.paramPtrs
+	if mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockGetListingsParams{ctx, filter}
+		mm_got := RepositoryMockUpdateSavedSearchParams{ctx, search}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetListings.t.Errorf("RepositoryMock.GetListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateSavedSearch.t.Errorf("RepositoryMock.UpdateSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmGetListings.t.Errorf("RepositoryMock.GetListings got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			if mm_want_ptrs.search != nil && !minimock.Equal(*mm_want_ptrs.search, mm_got.search) {
+				mmUpdateSavedSearch.t.Errorf("RepositoryMock.UpdateSavedSearch got unexpected parameter search, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.originSearch, *mm_want_ptrs.search, mm_got.search, minimock.Diff(*mm_want_ptrs.search, mm_got.search))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetListings.t.Errorf("RepositoryMock.GetListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateSavedSearch.t.Errorf("RepositoryMock.UpdateSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetListings.GetListingsMock.defaultExpectation.results
+		mm_results := mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetListings.t.Fatal("No results are set for the RepositoryMock.GetListings")
+			mmUpdateSavedSearch.t.Fatal("No results are set for the RepositoryMock.UpdateSavedSearch")
 		}
-		return (*mm_results).lpa1, (*mm_results).u1, (*mm_results).err
+		return (*mm_results).sp1, (*mm_results).err
 	}
-	if mmGetListings.funcGetListings != nil {
-		return mmGetListings.funcGetListings(ctx, filter)
+	if mmUpdateSavedSearch.funcUpdateSavedSearch != nil {
+		return mmUpdateSavedSearch.funcUpdateSavedSearch(ctx, search)
 	}
-	mmGetListings.t.Fatalf("Unexpected call to RepositoryMock.GetListings. %v %v", ctx, filter)
+	mmUpdateSavedSearch.t.Fatalf("Unexpected call to RepositoryMock.UpdateSavedSearch. %v %v", ctx, search)
 	return
 }
 
-// GetListingsAfterCounter returns a count of finished RepositoryMock.GetListings invocations
-func (mmGetListings *RepositoryMock) GetListingsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetListings.afterGetListingsCounter)
+// UpdateSavedSearchAfterCounter returns a count of finished RepositoryMock.UpdateSavedSearch invocations
+func (mmUpdateSavedSearch *RepositoryMock) UpdateSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSavedSearch.afterUpdateSavedSearchCounter)
 }
 
-// GetListingsBeforeCounter returns a count of RepositoryMock.GetListings invocations
-func (mmGetListings *RepositoryMock) GetListingsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetListings.beforeGetListingsCounter)
+// UpdateSavedSearchBeforeCounter returns a count of RepositoryMock.UpdateSavedSearch invocations
+func (mmUpdateSavedSearch *RepositoryMock) UpdateSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSavedSearch.beforeUpdateSavedSearchCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.GetListings.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateSavedSearch.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetListings *mRepositoryMockGetListings) Calls() []*RepositoryMockGetListingsParams {
-	mmGetListings.mutex.RLock()
+func (mmUpdateSavedSearch *mRepositoryMockUpdateSavedSearch) Calls() []*RepositoryMockUpdateSavedSearchParams {
+	mmUpdateSavedSearch.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockGetListingsParams, len(mmGetListings.callArgs))
-	copy(argCopy, mmGetListings.callArgs)
+	argCopy := make([]*RepositoryMockUpdateSavedSearchParams, len(mmUpdateSavedSearch.callArgs))
+	copy(argCopy, mmUpdateSavedSearch.callArgs)
 
-	mmGetListings.mutex.RUnlock()
+	mmUpdateSavedSearch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetListingsDone returns true if the count of the GetListings invocations corresponds
+// MinimockUpdateSavedSearchDone returns true if the count of the UpdateSavedSearch invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockGetListingsDone() bool {
-	if m.GetListingsMock.optional {
+func (m *RepositoryMock) MinimockUpdateSavedSearchDone() bool {
+	if m.UpdateSavedSearchMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetListingsMock.expectations {
+	for _, e := range m.UpdateSavedSearchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetListingsMock.invocationsDone()
+	return m.UpdateSavedSearchMock.invocationsDone()
 }
 
-// MinimockGetListingsInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockGetListingsInspect() {
-	for _, e := range m.GetListingsMock.expectations {
+// MinimockUpdateSavedSearchInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateSavedSearchInspect() {
+	for _, e := range m.UpdateSavedSearchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetListingsCounter := mm_atomic.LoadUint64(&m.afterGetListingsCounter)
+	afterUpdateSavedSearchCounter := mm_atomic.LoadUint64(&m.afterUpdateSavedSearchCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetListingsMock.defaultExpectation != nil && afterGetListingsCounter < 1 {
-		if m.GetListingsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s", m.GetListingsMock.defaultExpectation.returnOrigin)
+	if m.UpdateSavedSearchMock.defaultExpectation != nil && afterUpdateSavedSearchCounter < 1 {
+		if m.UpdateSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateSavedSearch at\n%s", m.UpdateSavedSearchMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s with params: %#v", m.GetListingsMock.defaultExpectation.expectationOrigins.origin, *m.GetListingsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateSavedSearch at\n%s with params: %#v", m.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSavedSearchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetListings != nil && afterGetListingsCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.GetListings at\n%s", m.funcGetListingsOrigin)
+	if m.funcUpdateSavedSearch != nil && afterUpdateSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdateSavedSearch at\n%s", m.funcUpdateSavedSearchOrigin)
 	}
 
-	if !m.GetListingsMock.invocationsDone() && afterGetListingsCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.GetListings at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetListingsMock.expectedInvocations), m.GetListingsMock.expectedInvocationsOrigin, afterGetListingsCounter)
+	if !m.UpdateSavedSearchMock.invocationsDone() && afterUpdateSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSavedSearchMock.expectedInvocations), m.UpdateSavedSearchMock.expectedInvocationsOrigin, afterUpdateSavedSearchCounter)
 	}
 }
 
@@ -744,9 +11217,65 @@ func (m *RepositoryMock) MinimockGetListingsInspect() {
 func (m *RepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddFavoriteInspect()
+
+			m.MinimockAddListingImagesInspect()
+
 			m.MinimockCreateListingInspect()
 
+			m.MinimockCreateSavedSearchInspect()
+
+			m.MinimockDeleteListingInspect()
+
+			m.MinimockDeleteListingImageInspect()
+
+			m.MinimockDeleteSavedSearchInspect()
+
+			m.MinimockGetDeletedListingByIDInspect()
+
+			m.MinimockGetDueSavedSearchesInspect()
+
+			m.MinimockGetFavoriteListingIDsInspect()
+
+			m.MinimockGetImageVariantsInspect()
+
+			m.MinimockGetListingByIDInspect()
+
+			m.MinimockGetListingFacetsInspect()
+
+			m.MinimockGetListingImagesInspect()
+
 			m.MinimockGetListingsInspect()
+
+			m.MinimockGetPriceWatchersInspect()
+
+			m.MinimockGetPublicationWatermarkInspect()
+
+			m.MinimockGetSavedSearchInspect()
+
+			m.MinimockGetSuggestionTermsInspect()
+
+			m.MinimockListFavoritesInspect()
+
+			m.MinimockListSavedSearchesInspect()
+
+			m.MinimockMarkSavedSearchCheckedInspect()
+
+			m.MinimockPurgeDeletedListingsInspect()
+
+			m.MinimockRemoveFavoriteInspect()
+
+			m.MinimockReorderListingImagesInspect()
+
+			m.MinimockRestoreListingInspect()
+
+			m.MinimockSearchListingsInspect()
+
+			m.MinimockUpdateListingInspect()
+
+			m.MinimockUpdateListingStatusInspect()
+
+			m.MinimockUpdateSavedSearchInspect()
 		}
 	})
 }
@@ -770,6 +11299,34 @@ func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddFavoriteDone() &&
+		m.MinimockAddListingImagesDone() &&
 		m.MinimockCreateListingDone() &&
-		m.MinimockGetListingsDone()
+		m.MinimockCreateSavedSearchDone() &&
+		m.MinimockDeleteListingDone() &&
+		m.MinimockDeleteListingImageDone() &&
+		m.MinimockDeleteSavedSearchDone() &&
+		m.MinimockGetDeletedListingByIDDone() &&
+		m.MinimockGetDueSavedSearchesDone() &&
+		m.MinimockGetFavoriteListingIDsDone() &&
+		m.MinimockGetImageVariantsDone() &&
+		m.MinimockGetListingByIDDone() &&
+		m.MinimockGetListingFacetsDone() &&
+		m.MinimockGetListingImagesDone() &&
+		m.MinimockGetListingsDone() &&
+		m.MinimockGetPriceWatchersDone() &&
+		m.MinimockGetPublicationWatermarkDone() &&
+		m.MinimockGetSavedSearchDone() &&
+		m.MinimockGetSuggestionTermsDone() &&
+		m.MinimockListFavoritesDone() &&
+		m.MinimockListSavedSearchesDone() &&
+		m.MinimockMarkSavedSearchCheckedDone() &&
+		m.MinimockPurgeDeletedListingsDone() &&
+		m.MinimockRemoveFavoriteDone() &&
+		m.MinimockReorderListingImagesDone() &&
+		m.MinimockRestoreListingDone() &&
+		m.MinimockSearchListingsDone() &&
+		m.MinimockUpdateListingDone() &&
+		m.MinimockUpdateListingStatusDone() &&
+		m.MinimockUpdateSavedSearchDone()
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
@@ -19,19 +20,187 @@ type UseCaseMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreateListing          func(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32) (lp1 *entity.Listing, err error)
+	funcAddFavorite          func(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money) (fp1 *entity.FavoriteState, err error)
+	funcAddFavoriteOrigin    string
+	inspectFuncAddFavorite   func(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money)
+	afterAddFavoriteCounter  uint64
+	beforeAddFavoriteCounter uint64
+	AddFavoriteMock          mUseCaseMockAddFavorite
+
+	funcAddListingImages          func(ctx context.Context, userID uint64, id uint64, urls []string) (lp1 *entity.Listing, err error)
+	funcAddListingImagesOrigin    string
+	inspectFuncAddListingImages   func(ctx context.Context, userID uint64, id uint64, urls []string)
+	afterAddListingImagesCounter  uint64
+	beforeAddListingImagesCounter uint64
+	AddListingImagesMock          mUseCaseMockAddListingImages
+
+	funcChangeListingStatus          func(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus) (lp1 *entity.Listing, err error)
+	funcChangeListingStatusOrigin    string
+	inspectFuncChangeListingStatus   func(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus)
+	afterChangeListingStatusCounter  uint64
+	beforeChangeListingStatusCounter uint64
+	ChangeListingStatusMock          mUseCaseMockChangeListingStatus
+
+	funcCreateListing          func(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error)
 	funcCreateListingOrigin    string
-	inspectFuncCreateListing   func(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32)
+	inspectFuncCreateListing   func(ctx context.Context, listing *entity.Listing)
 	afterCreateListingCounter  uint64
 	beforeCreateListingCounter uint64
 	CreateListingMock          mUseCaseMockCreateListing
 
-	funcGetListings          func(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32) (lpa1 []*entity.Listing, u1 uint32, err error)
+	funcCreateSavedSearch          func(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error)
+	funcCreateSavedSearchOrigin    string
+	inspectFuncCreateSavedSearch   func(ctx context.Context, search *entity.SavedSearch)
+	afterCreateSavedSearchCounter  uint64
+	beforeCreateSavedSearchCounter uint64
+	CreateSavedSearchMock          mUseCaseMockCreateSavedSearch
+
+	funcDeleteListing          func(ctx context.Context, userID uint64, id uint64) (t1 time.Time, err error)
+	funcDeleteListingOrigin    string
+	inspectFuncDeleteListing   func(ctx context.Context, userID uint64, id uint64)
+	afterDeleteListingCounter  uint64
+	beforeDeleteListingCounter uint64
+	DeleteListingMock          mUseCaseMockDeleteListing
+
+	funcDeleteSavedSearch          func(ctx context.Context, userID uint64, id uint64) (err error)
+	funcDeleteSavedSearchOrigin    string
+	inspectFuncDeleteSavedSearch   func(ctx context.Context, userID uint64, id uint64)
+	afterDeleteSavedSearchCounter  uint64
+	beforeDeleteSavedSearchCounter uint64
+	DeleteSavedSearchMock          mUseCaseMockDeleteSavedSearch
+
+	funcGetListing          func(ctx context.Context, userID uint64, id uint64) (lp1 *entity.Listing, err error)
+	funcGetListingOrigin    string
+	inspectFuncGetListing   func(ctx context.Context, userID uint64, id uint64)
+	afterGetListingCounter  uint64
+	beforeGetListingCounter uint64
+	GetListingMock          mUseCaseMockGetListing
+
+	funcGetListingFacets          func(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) (lp1 *entity.ListingFacets, err error)
+	funcGetListingFacetsOrigin    string
+	inspectFuncGetListingFacets   func(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32)
+	afterGetListingFacetsCounter  uint64
+	beforeGetListingFacetsCounter uint64
+	GetListingFacetsMock          mUseCaseMockGetListingFacets
+
+	funcGetListings          func(ctx context.Context, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)
 	funcGetListingsOrigin    string
-	inspectFuncGetListings   func(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32)
+	inspectFuncGetListings   func(ctx context.Context, filter *entity.ListingFilter)
 	afterGetListingsCounter  uint64
 	beforeGetListingsCounter uint64
 	GetListingsMock          mUseCaseMockGetListings
+
+	funcGetMyListings          func(ctx context.Context, userID uint64, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)
+	funcGetMyListingsOrigin    string
+	inspectFuncGetMyListings   func(ctx context.Context, userID uint64, filter *entity.ListingFilter)
+	afterGetMyListingsCounter  uint64
+	beforeGetMyListingsCounter uint64
+	GetMyListingsMock          mUseCaseMockGetMyListings
+
+	funcGetSavedSearch          func(ctx context.Context, userID uint64, id uint64) (sp1 *entity.SavedSearch, err error)
+	funcGetSavedSearchOrigin    string
+	inspectFuncGetSavedSearch   func(ctx context.Context, userID uint64, id uint64)
+	afterGetSavedSearchCounter  uint64
+	beforeGetSavedSearchCounter uint64
+	GetSavedSearchMock          mUseCaseMockGetSavedSearch
+
+	funcGetUserListings          func(ctx context.Context, username string, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)
+	funcGetUserListingsOrigin    string
+	inspectFuncGetUserListings   func(ctx context.Context, username string, filter *entity.ListingFilter)
+	afterGetUserListingsCounter  uint64
+	beforeGetUserListingsCounter uint64
+	GetUserListingsMock          mUseCaseMockGetUserListings
+
+	funcListFavorites          func(ctx context.Context, userID uint64, page uint32, perPage uint32) (lp1 *entity.ListingPage, err error)
+	funcListFavoritesOrigin    string
+	inspectFuncListFavorites   func(ctx context.Context, userID uint64, page uint32, perPage uint32)
+	afterListFavoritesCounter  uint64
+	beforeListFavoritesCounter uint64
+	ListFavoritesMock          mUseCaseMockListFavorites
+
+	funcListSavedSearches          func(ctx context.Context, userID uint64) (spa1 []*entity.SavedSearch, err error)
+	funcListSavedSearchesOrigin    string
+	inspectFuncListSavedSearches   func(ctx context.Context, userID uint64)
+	afterListSavedSearchesCounter  uint64
+	beforeListSavedSearchesCounter uint64
+	ListSavedSearchesMock          mUseCaseMockListSavedSearches
+
+	funcMatchSavedSearches          func(ctx context.Context) (i1 int, err error)
+	funcMatchSavedSearchesOrigin    string
+	inspectFuncMatchSavedSearches   func(ctx context.Context)
+	afterMatchSavedSearchesCounter  uint64
+	beforeMatchSavedSearchesCounter uint64
+	MatchSavedSearchesMock          mUseCaseMockMatchSavedSearches
+
+	funcPurgeDeletedListings          func(ctx context.Context) (i1 int64, err error)
+	funcPurgeDeletedListingsOrigin    string
+	inspectFuncPurgeDeletedListings   func(ctx context.Context)
+	afterPurgeDeletedListingsCounter  uint64
+	beforePurgeDeletedListingsCounter uint64
+	PurgeDeletedListingsMock          mUseCaseMockPurgeDeletedListings
+
+	funcRefreshSuggestions          func(ctx context.Context) (err error)
+	funcRefreshSuggestionsOrigin    string
+	inspectFuncRefreshSuggestions   func(ctx context.Context)
+	afterRefreshSuggestionsCounter  uint64
+	beforeRefreshSuggestionsCounter uint64
+	RefreshSuggestionsMock          mUseCaseMockRefreshSuggestions
+
+	funcRemoveFavorite          func(ctx context.Context, userID uint64, id uint64) (fp1 *entity.FavoriteState, err error)
+	funcRemoveFavoriteOrigin    string
+	inspectFuncRemoveFavorite   func(ctx context.Context, userID uint64, id uint64)
+	afterRemoveFavoriteCounter  uint64
+	beforeRemoveFavoriteCounter uint64
+	RemoveFavoriteMock          mUseCaseMockRemoveFavorite
+
+	funcRemoveListingImage          func(ctx context.Context, userID uint64, id uint64, imageID uint64) (lp1 *entity.Listing, err error)
+	funcRemoveListingImageOrigin    string
+	inspectFuncRemoveListingImage   func(ctx context.Context, userID uint64, id uint64, imageID uint64)
+	afterRemoveListingImageCounter  uint64
+	beforeRemoveListingImageCounter uint64
+	RemoveListingImageMock          mUseCaseMockRemoveListingImage
+
+	funcReorderListingImages          func(ctx context.Context, userID uint64, id uint64, imageIDs []uint64) (lp1 *entity.Listing, err error)
+	funcReorderListingImagesOrigin    string
+	inspectFuncReorderListingImages   func(ctx context.Context, userID uint64, id uint64, imageIDs []uint64)
+	afterReorderListingImagesCounter  uint64
+	beforeReorderListingImagesCounter uint64
+	ReorderListingImagesMock          mUseCaseMockReorderListingImages
+
+	funcRestoreListing          func(ctx context.Context, userID uint64, id uint64) (lp1 *entity.Listing, err error)
+	funcRestoreListingOrigin    string
+	inspectFuncRestoreListing   func(ctx context.Context, userID uint64, id uint64)
+	afterRestoreListingCounter  uint64
+	beforeRestoreListingCounter uint64
+	RestoreListingMock          mUseCaseMockRestoreListing
+
+	funcSearchListings          func(ctx context.Context, query string, page uint32, perPage uint32) (lpa1 []*entity.ListingSearchResult, u1 uint32, err error)
+	funcSearchListingsOrigin    string
+	inspectFuncSearchListings   func(ctx context.Context, query string, page uint32, perPage uint32)
+	afterSearchListingsCounter  uint64
+	beforeSearchListingsCounter uint64
+	SearchListingsMock          mUseCaseMockSearchListings
+
+	funcSuggestListings          func(ctx context.Context, prefix string, limit int) (spa1 []*entity.Suggestion)
+	funcSuggestListingsOrigin    string
+	inspectFuncSuggestListings   func(ctx context.Context, prefix string, limit int)
+	afterSuggestListingsCounter  uint64
+	beforeSuggestListingsCounter uint64
+	SuggestListingsMock          mUseCaseMockSuggestListings
+
+	funcUpdateListing          func(ctx context.Context, userID uint64, update *entity.ListingUpdate) (lp1 *entity.Listing, err error)
+	funcUpdateListingOrigin    string
+	inspectFuncUpdateListing   func(ctx context.Context, userID uint64, update *entity.ListingUpdate)
+	afterUpdateListingCounter  uint64
+	beforeUpdateListingCounter uint64
+	UpdateListingMock          mUseCaseMockUpdateListing
+
+	funcUpdateSavedSearch          func(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error)
+	funcUpdateSavedSearchOrigin    string
+	inspectFuncUpdateSavedSearch   func(ctx context.Context, search *entity.SavedSearch)
+	afterUpdateSavedSearchCounter  uint64
+	beforeUpdateSavedSearchCounter uint64
+	UpdateSavedSearchMock          mUseCaseMockUpdateSavedSearch
 }
 
 // NewUseCaseMock returns a mock for mm_listing.UseCase
@@ -42,76 +211,142 @@ func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddFavoriteMock = mUseCaseMockAddFavorite{mock: m}
+	m.AddFavoriteMock.callArgs = []*UseCaseMockAddFavoriteParams{}
+
+	m.AddListingImagesMock = mUseCaseMockAddListingImages{mock: m}
+	m.AddListingImagesMock.callArgs = []*UseCaseMockAddListingImagesParams{}
+
+	m.ChangeListingStatusMock = mUseCaseMockChangeListingStatus{mock: m}
+	m.ChangeListingStatusMock.callArgs = []*UseCaseMockChangeListingStatusParams{}
+
 	m.CreateListingMock = mUseCaseMockCreateListing{mock: m}
 	m.CreateListingMock.callArgs = []*UseCaseMockCreateListingParams{}
 
+	m.CreateSavedSearchMock = mUseCaseMockCreateSavedSearch{mock: m}
+	m.CreateSavedSearchMock.callArgs = []*UseCaseMockCreateSavedSearchParams{}
+
+	m.DeleteListingMock = mUseCaseMockDeleteListing{mock: m}
+	m.DeleteListingMock.callArgs = []*UseCaseMockDeleteListingParams{}
+
+	m.DeleteSavedSearchMock = mUseCaseMockDeleteSavedSearch{mock: m}
+	m.DeleteSavedSearchMock.callArgs = []*UseCaseMockDeleteSavedSearchParams{}
+
+	m.GetListingMock = mUseCaseMockGetListing{mock: m}
+	m.GetListingMock.callArgs = []*UseCaseMockGetListingParams{}
+
+	m.GetListingFacetsMock = mUseCaseMockGetListingFacets{mock: m}
+	m.GetListingFacetsMock.callArgs = []*UseCaseMockGetListingFacetsParams{}
+
 	m.GetListingsMock = mUseCaseMockGetListings{mock: m}
 	m.GetListingsMock.callArgs = []*UseCaseMockGetListingsParams{}
 
+	m.GetMyListingsMock = mUseCaseMockGetMyListings{mock: m}
+	m.GetMyListingsMock.callArgs = []*UseCaseMockGetMyListingsParams{}
+
+	m.GetSavedSearchMock = mUseCaseMockGetSavedSearch{mock: m}
+	m.GetSavedSearchMock.callArgs = []*UseCaseMockGetSavedSearchParams{}
+
+	m.GetUserListingsMock = mUseCaseMockGetUserListings{mock: m}
+	m.GetUserListingsMock.callArgs = []*UseCaseMockGetUserListingsParams{}
+
+	m.ListFavoritesMock = mUseCaseMockListFavorites{mock: m}
+	m.ListFavoritesMock.callArgs = []*UseCaseMockListFavoritesParams{}
+
+	m.ListSavedSearchesMock = mUseCaseMockListSavedSearches{mock: m}
+	m.ListSavedSearchesMock.callArgs = []*UseCaseMockListSavedSearchesParams{}
+
+	m.MatchSavedSearchesMock = mUseCaseMockMatchSavedSearches{mock: m}
+	m.MatchSavedSearchesMock.callArgs = []*UseCaseMockMatchSavedSearchesParams{}
+
+	m.PurgeDeletedListingsMock = mUseCaseMockPurgeDeletedListings{mock: m}
+	m.PurgeDeletedListingsMock.callArgs = []*UseCaseMockPurgeDeletedListingsParams{}
+
+	m.RefreshSuggestionsMock = mUseCaseMockRefreshSuggestions{mock: m}
+	m.RefreshSuggestionsMock.callArgs = []*UseCaseMockRefreshSuggestionsParams{}
+
+	m.RemoveFavoriteMock = mUseCaseMockRemoveFavorite{mock: m}
+	m.RemoveFavoriteMock.callArgs = []*UseCaseMockRemoveFavoriteParams{}
+
+	m.RemoveListingImageMock = mUseCaseMockRemoveListingImage{mock: m}
+	m.RemoveListingImageMock.callArgs = []*UseCaseMockRemoveListingImageParams{}
+
+	m.ReorderListingImagesMock = mUseCaseMockReorderListingImages{mock: m}
+	m.ReorderListingImagesMock.callArgs = []*UseCaseMockReorderListingImagesParams{}
+
+	m.RestoreListingMock = mUseCaseMockRestoreListing{mock: m}
+	m.RestoreListingMock.callArgs = []*UseCaseMockRestoreListingParams{}
+
+	m.SearchListingsMock = mUseCaseMockSearchListings{mock: m}
+	m.SearchListingsMock.callArgs = []*UseCaseMockSearchListingsParams{}
+
+	m.SuggestListingsMock = mUseCaseMockSuggestListings{mock: m}
+	m.SuggestListingsMock.callArgs = []*UseCaseMockSuggestListingsParams{}
+
+	m.UpdateListingMock = mUseCaseMockUpdateListing{mock: m}
+	m.UpdateListingMock.callArgs = []*UseCaseMockUpdateListingParams{}
+
+	m.UpdateSavedSearchMock = mUseCaseMockUpdateSavedSearch{mock: m}
+	m.UpdateSavedSearchMock.callArgs = []*UseCaseMockUpdateSavedSearchParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUseCaseMockCreateListing struct {
+type mUseCaseMockAddFavorite struct {
 	optional           bool
 	mock               *UseCaseMock
-	defaultExpectation *UseCaseMockCreateListingExpectation
-	expectations       []*UseCaseMockCreateListingExpectation
+	defaultExpectation *UseCaseMockAddFavoriteExpectation
+	expectations       []*UseCaseMockAddFavoriteExpectation
 
-	callArgs []*UseCaseMockCreateListingParams
+	callArgs []*UseCaseMockAddFavoriteParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UseCaseMockCreateListingExpectation specifies expectation struct of the UseCase.CreateListing
-type UseCaseMockCreateListingExpectation struct {
+// UseCaseMockAddFavoriteExpectation specifies expectation struct of the UseCase.AddFavorite
+type UseCaseMockAddFavoriteExpectation struct {
 	mock               *UseCaseMock
-	params             *UseCaseMockCreateListingParams
-	paramPtrs          *UseCaseMockCreateListingParamPtrs
-	expectationOrigins UseCaseMockCreateListingExpectationOrigins
-	results            *UseCaseMockCreateListingResults
+	params             *UseCaseMockAddFavoriteParams
+	paramPtrs          *UseCaseMockAddFavoriteParamPtrs
+	expectationOrigins UseCaseMockAddFavoriteExpectationOrigins
+	results            *UseCaseMockAddFavoriteResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UseCaseMockCreateListingParams contains parameters of the UseCase.CreateListing
-type UseCaseMockCreateListingParams struct {
-	ctx         context.Context
-	authorID    uint64
-	title       string
-	description string
-	imageURL    string
-	price       float32
+// UseCaseMockAddFavoriteParams contains parameters of the UseCase.AddFavorite
+type UseCaseMockAddFavoriteParams struct {
+	ctx        context.Context
+	userID     uint64
+	id         uint64
+	alertPrice *entity.Money
 }
 
-// UseCaseMockCreateListingParamPtrs contains pointers to parameters of the UseCase.CreateListing
-type UseCaseMockCreateListingParamPtrs struct {
-	ctx         *context.Context
-	authorID    *uint64
-	title       *string
-	description *string
-	imageURL    *string
-	price       *float32
+// UseCaseMockAddFavoriteParamPtrs contains pointers to parameters of the UseCase.AddFavorite
+type UseCaseMockAddFavoriteParamPtrs struct {
+	ctx        *context.Context
+	userID     *uint64
+	id         *uint64
+	alertPrice **entity.Money
 }
 
-// UseCaseMockCreateListingResults contains results of the UseCase.CreateListing
-type UseCaseMockCreateListingResults struct {
-	lp1 *entity.Listing
+// UseCaseMockAddFavoriteResults contains results of the UseCase.AddFavorite
+type UseCaseMockAddFavoriteResults struct {
+	fp1 *entity.FavoriteState
 	err error
 }
 
-// UseCaseMockCreateListingOrigins contains origins of expectations of the UseCase.CreateListing
-type UseCaseMockCreateListingExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originAuthorID    string
-	originTitle       string
-	originDescription string
-	originImageURL    string
-	originPrice       string
+// UseCaseMockAddFavoriteOrigins contains origins of expectations of the UseCase.AddFavorite
+type UseCaseMockAddFavoriteExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originId         string
+	originAlertPrice string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -119,470 +354,8908 @@ type UseCaseMockCreateListingExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateListing *mUseCaseMockCreateListing) Optional() *mUseCaseMockCreateListing {
-	mmCreateListing.optional = true
-	return mmCreateListing
+func (mmAddFavorite *mUseCaseMockAddFavorite) Optional() *mUseCaseMockAddFavorite {
+	mmAddFavorite.optional = true
+	return mmAddFavorite
 }
 
-// Expect sets up expected params for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) Expect(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+// Expect sets up expected params for UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) Expect(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money) *mUseCaseMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &UseCaseMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by ExpectParams functions")
+	if mmAddFavorite.defaultExpectation.paramPtrs != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by ExpectParams functions")
 	}
 
-	mmCreateListing.defaultExpectation.params = &UseCaseMockCreateListingParams{ctx, authorID, title, description, imageURL, price}
-	mmCreateListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateListing.expectations {
-		if minimock.Equal(e.params, mmCreateListing.defaultExpectation.params) {
-			mmCreateListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateListing.defaultExpectation.params)
+	mmAddFavorite.defaultExpectation.params = &UseCaseMockAddFavoriteParams{ctx, userID, id, alertPrice}
+	mmAddFavorite.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddFavorite.expectations {
+		if minimock.Equal(e.params, mmAddFavorite.defaultExpectation.params) {
+			mmAddFavorite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddFavorite.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateListing
-}
-
-// ExpectCtxParam1 sets up expected param ctx for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) ExpectCtxParam1(ctx context.Context) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
-	}
-
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
-	}
-
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
-	}
-
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
-	}
-	mmCreateListing.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmCreateListing
-}
-
-// ExpectAuthorIDParam2 sets up expected param authorID for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) ExpectAuthorIDParam2(authorID uint64) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
-	}
-
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
-	}
-
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
-	}
-
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
-	}
-	mmCreateListing.defaultExpectation.paramPtrs.authorID = &authorID
-	mmCreateListing.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
-
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// ExpectTitleParam3 sets up expected param title for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) ExpectTitleParam3(title string) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) ExpectCtxParam1(ctx context.Context) *mUseCaseMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &UseCaseMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Expect")
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &UseCaseMockAddFavoriteParamPtrs{}
 	}
-	mmCreateListing.defaultExpectation.paramPtrs.title = &title
-	mmCreateListing.defaultExpectation.expectationOrigins.originTitle = minimock.CallerInfo(1)
+	mmAddFavorite.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddFavorite.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// ExpectDescriptionParam4 sets up expected param description for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) ExpectDescriptionParam4(description string) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) ExpectUserIDParam2(userID uint64) *mUseCaseMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &UseCaseMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Expect")
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &UseCaseMockAddFavoriteParamPtrs{}
 	}
-	mmCreateListing.defaultExpectation.paramPtrs.description = &description
-	mmCreateListing.defaultExpectation.expectationOrigins.originDescription = minimock.CallerInfo(1)
+	mmAddFavorite.defaultExpectation.paramPtrs.userID = &userID
+	mmAddFavorite.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// ExpectImageURLParam5 sets up expected param imageURL for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) ExpectImageURLParam5(imageURL string) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+// ExpectIdParam3 sets up expected param id for UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) ExpectIdParam3(id uint64) *mUseCaseMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &UseCaseMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Expect")
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &UseCaseMockAddFavoriteParamPtrs{}
 	}
-	mmCreateListing.defaultExpectation.paramPtrs.imageURL = &imageURL
-	mmCreateListing.defaultExpectation.expectationOrigins.originImageURL = minimock.CallerInfo(1)
+	mmAddFavorite.defaultExpectation.paramPtrs.id = &id
+	mmAddFavorite.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// ExpectPriceParam6 sets up expected param price for UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) ExpectPriceParam6(price float32) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+// ExpectAlertPriceParam4 sets up expected param alertPrice for UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) ExpectAlertPriceParam4(alertPrice *entity.Money) *mUseCaseMockAddFavorite {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &UseCaseMockAddFavoriteExpectation{}
 	}
 
-	if mmCreateListing.defaultExpectation.params != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
+	if mmAddFavorite.defaultExpectation.params != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Expect")
 	}
 
-	if mmCreateListing.defaultExpectation.paramPtrs == nil {
-		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
+	if mmAddFavorite.defaultExpectation.paramPtrs == nil {
+		mmAddFavorite.defaultExpectation.paramPtrs = &UseCaseMockAddFavoriteParamPtrs{}
 	}
-	mmCreateListing.defaultExpectation.paramPtrs.price = &price
-	mmCreateListing.defaultExpectation.expectationOrigins.originPrice = minimock.CallerInfo(1)
+	mmAddFavorite.defaultExpectation.paramPtrs.alertPrice = &alertPrice
+	mmAddFavorite.defaultExpectation.expectationOrigins.originAlertPrice = minimock.CallerInfo(1)
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// Inspect accepts an inspector function that has same arguments as the UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) Inspect(f func(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32)) *mUseCaseMockCreateListing {
-	if mmCreateListing.mock.inspectFuncCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("Inspect function is already set for UseCaseMock.CreateListing")
+// Inspect accepts an inspector function that has same arguments as the UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) Inspect(f func(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money)) *mUseCaseMockAddFavorite {
+	if mmAddFavorite.mock.inspectFuncAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("Inspect function is already set for UseCaseMock.AddFavorite")
 	}
 
-	mmCreateListing.mock.inspectFuncCreateListing = f
+	mmAddFavorite.mock.inspectFuncAddFavorite = f
 
-	return mmCreateListing
+	return mmAddFavorite
 }
 
-// Return sets up results that will be returned by UseCase.CreateListing
-func (mmCreateListing *mUseCaseMockCreateListing) Return(lp1 *entity.Listing, err error) *UseCaseMock {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+// Return sets up results that will be returned by UseCase.AddFavorite
+func (mmAddFavorite *mUseCaseMockAddFavorite) Return(fp1 *entity.FavoriteState, err error) *UseCaseMock {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	if mmCreateListing.defaultExpectation == nil {
-		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{mock: mmCreateListing.mock}
+	if mmAddFavorite.defaultExpectation == nil {
+		mmAddFavorite.defaultExpectation = &UseCaseMockAddFavoriteExpectation{mock: mmAddFavorite.mock}
 	}
-	mmCreateListing.defaultExpectation.results = &UseCaseMockCreateListingResults{lp1, err}
-	mmCreateListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateListing.mock
+	mmAddFavorite.defaultExpectation.results = &UseCaseMockAddFavoriteResults{fp1, err}
+	mmAddFavorite.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddFavorite.mock
 }
 
-// Set uses given function f to mock the UseCase.CreateListing method
-func (mmCreateListing *mUseCaseMockCreateListing) Set(f func(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32) (lp1 *entity.Listing, err error)) *UseCaseMock {
-	if mmCreateListing.defaultExpectation != nil {
-		mmCreateListing.mock.t.Fatalf("Default expectation is already set for the UseCase.CreateListing method")
+// Set uses given function f to mock the UseCase.AddFavorite method
+func (mmAddFavorite *mUseCaseMockAddFavorite) Set(f func(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money) (fp1 *entity.FavoriteState, err error)) *UseCaseMock {
+	if mmAddFavorite.defaultExpectation != nil {
+		mmAddFavorite.mock.t.Fatalf("Default expectation is already set for the UseCase.AddFavorite method")
 	}
 
-	if len(mmCreateListing.expectations) > 0 {
-		mmCreateListing.mock.t.Fatalf("Some expectations are already set for the UseCase.CreateListing method")
+	if len(mmAddFavorite.expectations) > 0 {
+		mmAddFavorite.mock.t.Fatalf("Some expectations are already set for the UseCase.AddFavorite method")
 	}
 
-	mmCreateListing.mock.funcCreateListing = f
-	mmCreateListing.mock.funcCreateListingOrigin = minimock.CallerInfo(1)
-	return mmCreateListing.mock
+	mmAddFavorite.mock.funcAddFavorite = f
+	mmAddFavorite.mock.funcAddFavoriteOrigin = minimock.CallerInfo(1)
+	return mmAddFavorite.mock
 }
 
-// When sets expectation for the UseCase.CreateListing which will trigger the result defined by the following
+// When sets expectation for the UseCase.AddFavorite which will trigger the result defined by the following
 // Then helper
-func (mmCreateListing *mUseCaseMockCreateListing) When(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32) *UseCaseMockCreateListingExpectation {
-	if mmCreateListing.mock.funcCreateListing != nil {
-		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+func (mmAddFavorite *mUseCaseMockAddFavorite) When(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money) *UseCaseMockAddFavoriteExpectation {
+	if mmAddFavorite.mock.funcAddFavorite != nil {
+		mmAddFavorite.mock.t.Fatalf("UseCaseMock.AddFavorite mock is already set by Set")
 	}
 
-	expectation := &UseCaseMockCreateListingExpectation{
-		mock:               mmCreateListing.mock,
-		params:             &UseCaseMockCreateListingParams{ctx, authorID, title, description, imageURL, price},
-		expectationOrigins: UseCaseMockCreateListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UseCaseMockAddFavoriteExpectation{
+		mock:               mmAddFavorite.mock,
+		params:             &UseCaseMockAddFavoriteParams{ctx, userID, id, alertPrice},
+		expectationOrigins: UseCaseMockAddFavoriteExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateListing.expectations = append(mmCreateListing.expectations, expectation)
+	mmAddFavorite.expectations = append(mmAddFavorite.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UseCase.CreateListing return parameters for the expectation previously defined by the When method
-func (e *UseCaseMockCreateListingExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
-	e.results = &UseCaseMockCreateListingResults{lp1, err}
+// Then sets up UseCase.AddFavorite return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockAddFavoriteExpectation) Then(fp1 *entity.FavoriteState, err error) *UseCaseMock {
+	e.results = &UseCaseMockAddFavoriteResults{fp1, err}
 	return e.mock
 }
 
-// Times sets number of times UseCase.CreateListing should be invoked
-func (mmCreateListing *mUseCaseMockCreateListing) Times(n uint64) *mUseCaseMockCreateListing {
+// Times sets number of times UseCase.AddFavorite should be invoked
+func (mmAddFavorite *mUseCaseMockAddFavorite) Times(n uint64) *mUseCaseMockAddFavorite {
 	if n == 0 {
-		mmCreateListing.mock.t.Fatalf("Times of UseCaseMock.CreateListing mock can not be zero")
+		mmAddFavorite.mock.t.Fatalf("Times of UseCaseMock.AddFavorite mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateListing.expectedInvocations, n)
-	mmCreateListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateListing
+	mm_atomic.StoreUint64(&mmAddFavorite.expectedInvocations, n)
+	mmAddFavorite.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddFavorite
 }
 
-func (mmCreateListing *mUseCaseMockCreateListing) invocationsDone() bool {
-	if len(mmCreateListing.expectations) == 0 && mmCreateListing.defaultExpectation == nil && mmCreateListing.mock.funcCreateListing == nil {
+func (mmAddFavorite *mUseCaseMockAddFavorite) invocationsDone() bool {
+	if len(mmAddFavorite.expectations) == 0 && mmAddFavorite.defaultExpectation == nil && mmAddFavorite.mock.funcAddFavorite == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateListing.mock.afterCreateListingCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateListing.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddFavorite.mock.afterAddFavoriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddFavorite.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateListing implements mm_listing.UseCase
-func (mmCreateListing *UseCaseMock) CreateListing(ctx context.Context, authorID uint64, title string, description string, imageURL string, price float32) (lp1 *entity.Listing, err error) {
-	mm_atomic.AddUint64(&mmCreateListing.beforeCreateListingCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateListing.afterCreateListingCounter, 1)
+// AddFavorite implements mm_listing.UseCase
+func (mmAddFavorite *UseCaseMock) AddFavorite(ctx context.Context, userID uint64, id uint64, alertPrice *entity.Money) (fp1 *entity.FavoriteState, err error) {
+	mm_atomic.AddUint64(&mmAddFavorite.beforeAddFavoriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddFavorite.afterAddFavoriteCounter, 1)
 
-	mmCreateListing.t.Helper()
+	mmAddFavorite.t.Helper()
 
-	if mmCreateListing.inspectFuncCreateListing != nil {
-		mmCreateListing.inspectFuncCreateListing(ctx, authorID, title, description, imageURL, price)
+	if mmAddFavorite.inspectFuncAddFavorite != nil {
+		mmAddFavorite.inspectFuncAddFavorite(ctx, userID, id, alertPrice)
 	}
 
-	mm_params := UseCaseMockCreateListingParams{ctx, authorID, title, description, imageURL, price}
+	mm_params := UseCaseMockAddFavoriteParams{ctx, userID, id, alertPrice}
 
 	// Record call args
-	mmCreateListing.CreateListingMock.mutex.Lock()
-	mmCreateListing.CreateListingMock.callArgs = append(mmCreateListing.CreateListingMock.callArgs, &mm_params)
-	mmCreateListing.CreateListingMock.mutex.Unlock()
+	mmAddFavorite.AddFavoriteMock.mutex.Lock()
+	mmAddFavorite.AddFavoriteMock.callArgs = append(mmAddFavorite.AddFavoriteMock.callArgs, &mm_params)
+	mmAddFavorite.AddFavoriteMock.mutex.Unlock()
 
-	for _, e := range mmCreateListing.CreateListingMock.expectations {
+	for _, e := range mmAddFavorite.AddFavoriteMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.lp1, e.results.err
+			return e.results.fp1, e.results.err
 		}
 	}
 
-	if mmCreateListing.CreateListingMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateListing.CreateListingMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateListing.CreateListingMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateListing.CreateListingMock.defaultExpectation.paramPtrs
+	if mmAddFavorite.AddFavoriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddFavorite.AddFavoriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddFavorite.AddFavoriteMock.defaultExpectation.params
+		mm_want_ptrs := mmAddFavorite.AddFavoriteMock.defaultExpectation.paramPtrs
 
-		mm_got := UseCaseMockCreateListingParams{ctx, authorID, title, description, imageURL, price}
+		mm_got := UseCaseMockAddFavoriteParams{ctx, userID, id, alertPrice}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
-				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
-			}
-
-			if mm_want_ptrs.title != nil && !minimock.Equal(*mm_want_ptrs.title, mm_got.title) {
-				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter title, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originTitle, *mm_want_ptrs.title, mm_got.title, minimock.Diff(*mm_want_ptrs.title, mm_got.title))
+				mmAddFavorite.t.Errorf("UseCaseMock.AddFavorite got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.description != nil && !minimock.Equal(*mm_want_ptrs.description, mm_got.description) {
-				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter description, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originDescription, *mm_want_ptrs.description, mm_got.description, minimock.Diff(*mm_want_ptrs.description, mm_got.description))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddFavorite.t.Errorf("UseCaseMock.AddFavorite got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
-			if mm_want_ptrs.imageURL != nil && !minimock.Equal(*mm_want_ptrs.imageURL, mm_got.imageURL) {
-				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter imageURL, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originImageURL, *mm_want_ptrs.imageURL, mm_got.imageURL, minimock.Diff(*mm_want_ptrs.imageURL, mm_got.imageURL))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmAddFavorite.t.Errorf("UseCaseMock.AddFavorite got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.price != nil && !minimock.Equal(*mm_want_ptrs.price, mm_got.price) {
-				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter price, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
+			if mm_want_ptrs.alertPrice != nil && !minimock.Equal(*mm_want_ptrs.alertPrice, mm_got.alertPrice) {
+				mmAddFavorite.t.Errorf("UseCaseMock.AddFavorite got unexpected parameter alertPrice, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.originAlertPrice, *mm_want_ptrs.alertPrice, mm_got.alertPrice, minimock.Diff(*mm_want_ptrs.alertPrice, mm_got.alertPrice))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddFavorite.t.Errorf("UseCaseMock.AddFavorite got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddFavorite.AddFavoriteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateListing.CreateListingMock.defaultExpectation.results
+		mm_results := mmAddFavorite.AddFavoriteMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateListing.t.Fatal("No results are set for the UseCaseMock.CreateListing")
+			mmAddFavorite.t.Fatal("No results are set for the UseCaseMock.AddFavorite")
 		}
-		return (*mm_results).lp1, (*mm_results).err
+		return (*mm_results).fp1, (*mm_results).err
 	}
-	if mmCreateListing.funcCreateListing != nil {
-		return mmCreateListing.funcCreateListing(ctx, authorID, title, description, imageURL, price)
+	if mmAddFavorite.funcAddFavorite != nil {
+		return mmAddFavorite.funcAddFavorite(ctx, userID, id, alertPrice)
 	}
-	mmCreateListing.t.Fatalf("Unexpected call to UseCaseMock.CreateListing. %v %v %v %v %v %v", ctx, authorID, title, description, imageURL, price)
+	mmAddFavorite.t.Fatalf("Unexpected call to UseCaseMock.AddFavorite. %v %v %v %v", ctx, userID, id, alertPrice)
 	return
 }
 
-// CreateListingAfterCounter returns a count of finished UseCaseMock.CreateListing invocations
-func (mmCreateListing *UseCaseMock) CreateListingAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateListing.afterCreateListingCounter)
+// AddFavoriteAfterCounter returns a count of finished UseCaseMock.AddFavorite invocations
+func (mmAddFavorite *UseCaseMock) AddFavoriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddFavorite.afterAddFavoriteCounter)
 }
 
-// CreateListingBeforeCounter returns a count of UseCaseMock.CreateListing invocations
-func (mmCreateListing *UseCaseMock) CreateListingBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateListing.beforeCreateListingCounter)
+// AddFavoriteBeforeCounter returns a count of UseCaseMock.AddFavorite invocations
+func (mmAddFavorite *UseCaseMock) AddFavoriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddFavorite.beforeAddFavoriteCounter)
 }
 
-// Calls returns a list of arguments used in each call to UseCaseMock.CreateListing.
+// Calls returns a list of arguments used in each call to UseCaseMock.AddFavorite.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateListing *mUseCaseMockCreateListing) Calls() []*UseCaseMockCreateListingParams {
-	mmCreateListing.mutex.RLock()
+func (mmAddFavorite *mUseCaseMockAddFavorite) Calls() []*UseCaseMockAddFavoriteParams {
+	mmAddFavorite.mutex.RLock()
 
-	argCopy := make([]*UseCaseMockCreateListingParams, len(mmCreateListing.callArgs))
-	copy(argCopy, mmCreateListing.callArgs)
+	argCopy := make([]*UseCaseMockAddFavoriteParams, len(mmAddFavorite.callArgs))
+	copy(argCopy, mmAddFavorite.callArgs)
 
-	mmCreateListing.mutex.RUnlock()
+	mmAddFavorite.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateListingDone returns true if the count of the CreateListing invocations corresponds
+// MinimockAddFavoriteDone returns true if the count of the AddFavorite invocations corresponds
 // the number of defined expectations
-func (m *UseCaseMock) MinimockCreateListingDone() bool {
-	if m.CreateListingMock.optional {
+func (m *UseCaseMock) MinimockAddFavoriteDone() bool {
+	if m.AddFavoriteMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateListingMock.expectations {
+	for _, e := range m.AddFavoriteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateListingMock.invocationsDone()
+	return m.AddFavoriteMock.invocationsDone()
 }
 
-// MinimockCreateListingInspect logs each unmet expectation
-func (m *UseCaseMock) MinimockCreateListingInspect() {
-	for _, e := range m.CreateListingMock.expectations {
+// MinimockAddFavoriteInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockAddFavoriteInspect() {
+	for _, e := range m.AddFavoriteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UseCaseMock.AddFavorite at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateListingCounter := mm_atomic.LoadUint64(&m.afterCreateListingCounter)
+	afterAddFavoriteCounter := mm_atomic.LoadUint64(&m.afterAddFavoriteCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateListingMock.defaultExpectation != nil && afterCreateListingCounter < 1 {
-		if m.CreateListingMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s", m.CreateListingMock.defaultExpectation.returnOrigin)
+	if m.AddFavoriteMock.defaultExpectation != nil && afterAddFavoriteCounter < 1 {
+		if m.AddFavoriteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.AddFavorite at\n%s", m.AddFavoriteMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s with params: %#v", m.CreateListingMock.defaultExpectation.expectationOrigins.origin, *m.CreateListingMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UseCaseMock.AddFavorite at\n%s with params: %#v", m.AddFavoriteMock.defaultExpectation.expectationOrigins.origin, *m.AddFavoriteMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateListing != nil && afterCreateListingCounter < 1 {
-		m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s", m.funcCreateListingOrigin)
+	if m.funcAddFavorite != nil && afterAddFavoriteCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.AddFavorite at\n%s", m.funcAddFavoriteOrigin)
 	}
 
-	if !m.CreateListingMock.invocationsDone() && afterCreateListingCounter > 0 {
-		m.t.Errorf("Expected %d calls to UseCaseMock.CreateListing at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateListingMock.expectedInvocations), m.CreateListingMock.expectedInvocationsOrigin, afterCreateListingCounter)
+	if !m.AddFavoriteMock.invocationsDone() && afterAddFavoriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.AddFavorite at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddFavoriteMock.expectedInvocations), m.AddFavoriteMock.expectedInvocationsOrigin, afterAddFavoriteCounter)
 	}
 }
 
-type mUseCaseMockGetListings struct {
+type mUseCaseMockAddListingImages struct {
 	optional           bool
 	mock               *UseCaseMock
-	defaultExpectation *UseCaseMockGetListingsExpectation
-	expectations       []*UseCaseMockGetListingsExpectation
+	defaultExpectation *UseCaseMockAddListingImagesExpectation
+	expectations       []*UseCaseMockAddListingImagesExpectation
 
-	callArgs []*UseCaseMockGetListingsParams
+	callArgs []*UseCaseMockAddListingImagesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UseCaseMockGetListingsExpectation specifies expectation struct of the UseCase.GetListings
-type UseCaseMockGetListingsExpectation struct {
+// UseCaseMockAddListingImagesExpectation specifies expectation struct of the UseCase.AddListingImages
+type UseCaseMockAddListingImagesExpectation struct {
 	mock               *UseCaseMock
-	params             *UseCaseMockGetListingsParams
-	paramPtrs          *UseCaseMockGetListingsParamPtrs
-	expectationOrigins UseCaseMockGetListingsExpectationOrigins
-	results            *UseCaseMockGetListingsResults
+	params             *UseCaseMockAddListingImagesParams
+	paramPtrs          *UseCaseMockAddListingImagesParamPtrs
+	expectationOrigins UseCaseMockAddListingImagesExpectationOrigins
+	results            *UseCaseMockAddListingImagesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UseCaseMockGetListingsParams contains parameters of the UseCase.GetListings
-type UseCaseMockGetListingsParams struct {
-	ctx      context.Context
-	page     uint32
-	perPage  uint32
-	sortBy   string
-	sortDesc bool
-	minPrice *float32
-	maxPrice *float32
+// UseCaseMockAddListingImagesParams contains parameters of the UseCase.AddListingImages
+type UseCaseMockAddListingImagesParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+	urls   []string
 }
 
-// UseCaseMockGetListingsParamPtrs contains pointers to parameters of the UseCase.GetListings
-type UseCaseMockGetListingsParamPtrs struct {
-	ctx      *context.Context
-	page     *uint32
-	perPage  *uint32
-	sortBy   *string
-	sortDesc *bool
-	minPrice **float32
-	maxPrice **float32
+// UseCaseMockAddListingImagesParamPtrs contains pointers to parameters of the UseCase.AddListingImages
+type UseCaseMockAddListingImagesParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+	urls   *[]string
 }
 
-// UseCaseMockGetListingsResults contains results of the UseCase.GetListings
-type UseCaseMockGetListingsResults struct {
-	lpa1 []*entity.Listing
-	u1   uint32
-	err  error
+// UseCaseMockAddListingImagesResults contains results of the UseCase.AddListingImages
+type UseCaseMockAddListingImagesResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockAddListingImagesOrigins contains origins of expectations of the UseCase.AddListingImages
+type UseCaseMockAddListingImagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+	originUrls   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddListingImages *mUseCaseMockAddListingImages) Optional() *mUseCaseMockAddListingImages {
+	mmAddListingImages.optional = true
+	return mmAddListingImages
+}
+
+// Expect sets up expected params for UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) Expect(ctx context.Context, userID uint64, id uint64, urls []string) *mUseCaseMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &UseCaseMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by ExpectParams functions")
+	}
+
+	mmAddListingImages.defaultExpectation.params = &UseCaseMockAddListingImagesParams{ctx, userID, id, urls}
+	mmAddListingImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddListingImages.expectations {
+		if minimock.Equal(e.params, mmAddListingImages.defaultExpectation.params) {
+			mmAddListingImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddListingImages.defaultExpectation.params)
+		}
+	}
+
+	return mmAddListingImages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) ExpectCtxParam1(ctx context.Context) *mUseCaseMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &UseCaseMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &UseCaseMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddListingImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) ExpectUserIDParam2(userID uint64) *mUseCaseMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &UseCaseMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &UseCaseMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.userID = &userID
+	mmAddListingImages.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) ExpectIdParam3(id uint64) *mUseCaseMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &UseCaseMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &UseCaseMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.id = &id
+	mmAddListingImages.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// ExpectUrlsParam4 sets up expected param urls for UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) ExpectUrlsParam4(urls []string) *mUseCaseMockAddListingImages {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &UseCaseMockAddListingImagesExpectation{}
+	}
+
+	if mmAddListingImages.defaultExpectation.params != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Expect")
+	}
+
+	if mmAddListingImages.defaultExpectation.paramPtrs == nil {
+		mmAddListingImages.defaultExpectation.paramPtrs = &UseCaseMockAddListingImagesParamPtrs{}
+	}
+	mmAddListingImages.defaultExpectation.paramPtrs.urls = &urls
+	mmAddListingImages.defaultExpectation.expectationOrigins.originUrls = minimock.CallerInfo(1)
+
+	return mmAddListingImages
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) Inspect(f func(ctx context.Context, userID uint64, id uint64, urls []string)) *mUseCaseMockAddListingImages {
+	if mmAddListingImages.mock.inspectFuncAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("Inspect function is already set for UseCaseMock.AddListingImages")
+	}
+
+	mmAddListingImages.mock.inspectFuncAddListingImages = f
+
+	return mmAddListingImages
+}
+
+// Return sets up results that will be returned by UseCase.AddListingImages
+func (mmAddListingImages *mUseCaseMockAddListingImages) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	if mmAddListingImages.defaultExpectation == nil {
+		mmAddListingImages.defaultExpectation = &UseCaseMockAddListingImagesExpectation{mock: mmAddListingImages.mock}
+	}
+	mmAddListingImages.defaultExpectation.results = &UseCaseMockAddListingImagesResults{lp1, err}
+	mmAddListingImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddListingImages.mock
+}
+
+// Set uses given function f to mock the UseCase.AddListingImages method
+func (mmAddListingImages *mUseCaseMockAddListingImages) Set(f func(ctx context.Context, userID uint64, id uint64, urls []string) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmAddListingImages.defaultExpectation != nil {
+		mmAddListingImages.mock.t.Fatalf("Default expectation is already set for the UseCase.AddListingImages method")
+	}
+
+	if len(mmAddListingImages.expectations) > 0 {
+		mmAddListingImages.mock.t.Fatalf("Some expectations are already set for the UseCase.AddListingImages method")
+	}
+
+	mmAddListingImages.mock.funcAddListingImages = f
+	mmAddListingImages.mock.funcAddListingImagesOrigin = minimock.CallerInfo(1)
+	return mmAddListingImages.mock
+}
+
+// When sets expectation for the UseCase.AddListingImages which will trigger the result defined by the following
+// Then helper
+func (mmAddListingImages *mUseCaseMockAddListingImages) When(ctx context.Context, userID uint64, id uint64, urls []string) *UseCaseMockAddListingImagesExpectation {
+	if mmAddListingImages.mock.funcAddListingImages != nil {
+		mmAddListingImages.mock.t.Fatalf("UseCaseMock.AddListingImages mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockAddListingImagesExpectation{
+		mock:               mmAddListingImages.mock,
+		params:             &UseCaseMockAddListingImagesParams{ctx, userID, id, urls},
+		expectationOrigins: UseCaseMockAddListingImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddListingImages.expectations = append(mmAddListingImages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.AddListingImages return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockAddListingImagesExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockAddListingImagesResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.AddListingImages should be invoked
+func (mmAddListingImages *mUseCaseMockAddListingImages) Times(n uint64) *mUseCaseMockAddListingImages {
+	if n == 0 {
+		mmAddListingImages.mock.t.Fatalf("Times of UseCaseMock.AddListingImages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddListingImages.expectedInvocations, n)
+	mmAddListingImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddListingImages
+}
+
+func (mmAddListingImages *mUseCaseMockAddListingImages) invocationsDone() bool {
+	if len(mmAddListingImages.expectations) == 0 && mmAddListingImages.defaultExpectation == nil && mmAddListingImages.mock.funcAddListingImages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddListingImages.mock.afterAddListingImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddListingImages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddListingImages implements mm_listing.UseCase
+func (mmAddListingImages *UseCaseMock) AddListingImages(ctx context.Context, userID uint64, id uint64, urls []string) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmAddListingImages.beforeAddListingImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddListingImages.afterAddListingImagesCounter, 1)
+
+	mmAddListingImages.t.Helper()
+
+	if mmAddListingImages.inspectFuncAddListingImages != nil {
+		mmAddListingImages.inspectFuncAddListingImages(ctx, userID, id, urls)
+	}
+
+	mm_params := UseCaseMockAddListingImagesParams{ctx, userID, id, urls}
+
+	// Record call args
+	mmAddListingImages.AddListingImagesMock.mutex.Lock()
+	mmAddListingImages.AddListingImagesMock.callArgs = append(mmAddListingImages.AddListingImagesMock.callArgs, &mm_params)
+	mmAddListingImages.AddListingImagesMock.mutex.Unlock()
+
+	for _, e := range mmAddListingImages.AddListingImagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmAddListingImages.AddListingImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddListingImages.AddListingImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddListingImages.AddListingImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmAddListingImages.AddListingImagesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockAddListingImagesParams{ctx, userID, id, urls}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddListingImages.t.Errorf("UseCaseMock.AddListingImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddListingImages.t.Errorf("UseCaseMock.AddListingImages got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmAddListingImages.t.Errorf("UseCaseMock.AddListingImages got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.urls != nil && !minimock.Equal(*mm_want_ptrs.urls, mm_got.urls) {
+				mmAddListingImages.t.Errorf("UseCaseMock.AddListingImages got unexpected parameter urls, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.originUrls, *mm_want_ptrs.urls, mm_got.urls, minimock.Diff(*mm_want_ptrs.urls, mm_got.urls))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddListingImages.t.Errorf("UseCaseMock.AddListingImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddListingImages.AddListingImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddListingImages.AddListingImagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddListingImages.t.Fatal("No results are set for the UseCaseMock.AddListingImages")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmAddListingImages.funcAddListingImages != nil {
+		return mmAddListingImages.funcAddListingImages(ctx, userID, id, urls)
+	}
+	mmAddListingImages.t.Fatalf("Unexpected call to UseCaseMock.AddListingImages. %v %v %v %v", ctx, userID, id, urls)
+	return
+}
+
+// AddListingImagesAfterCounter returns a count of finished UseCaseMock.AddListingImages invocations
+func (mmAddListingImages *UseCaseMock) AddListingImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddListingImages.afterAddListingImagesCounter)
+}
+
+// AddListingImagesBeforeCounter returns a count of UseCaseMock.AddListingImages invocations
+func (mmAddListingImages *UseCaseMock) AddListingImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddListingImages.beforeAddListingImagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.AddListingImages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddListingImages *mUseCaseMockAddListingImages) Calls() []*UseCaseMockAddListingImagesParams {
+	mmAddListingImages.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockAddListingImagesParams, len(mmAddListingImages.callArgs))
+	copy(argCopy, mmAddListingImages.callArgs)
+
+	mmAddListingImages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddListingImagesDone returns true if the count of the AddListingImages invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockAddListingImagesDone() bool {
+	if m.AddListingImagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddListingImagesMock.invocationsDone()
+}
+
+// MinimockAddListingImagesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockAddListingImagesInspect() {
+	for _, e := range m.AddListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.AddListingImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddListingImagesCounter := mm_atomic.LoadUint64(&m.afterAddListingImagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddListingImagesMock.defaultExpectation != nil && afterAddListingImagesCounter < 1 {
+		if m.AddListingImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.AddListingImages at\n%s", m.AddListingImagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.AddListingImages at\n%s with params: %#v", m.AddListingImagesMock.defaultExpectation.expectationOrigins.origin, *m.AddListingImagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddListingImages != nil && afterAddListingImagesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.AddListingImages at\n%s", m.funcAddListingImagesOrigin)
+	}
+
+	if !m.AddListingImagesMock.invocationsDone() && afterAddListingImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.AddListingImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddListingImagesMock.expectedInvocations), m.AddListingImagesMock.expectedInvocationsOrigin, afterAddListingImagesCounter)
+	}
+}
+
+type mUseCaseMockChangeListingStatus struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockChangeListingStatusExpectation
+	expectations       []*UseCaseMockChangeListingStatusExpectation
+
+	callArgs []*UseCaseMockChangeListingStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockChangeListingStatusExpectation specifies expectation struct of the UseCase.ChangeListingStatus
+type UseCaseMockChangeListingStatusExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockChangeListingStatusParams
+	paramPtrs          *UseCaseMockChangeListingStatusParamPtrs
+	expectationOrigins UseCaseMockChangeListingStatusExpectationOrigins
+	results            *UseCaseMockChangeListingStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockChangeListingStatusParams contains parameters of the UseCase.ChangeListingStatus
+type UseCaseMockChangeListingStatusParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+	status entity.ListingStatus
+}
+
+// UseCaseMockChangeListingStatusParamPtrs contains pointers to parameters of the UseCase.ChangeListingStatus
+type UseCaseMockChangeListingStatusParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+	status *entity.ListingStatus
+}
+
+// UseCaseMockChangeListingStatusResults contains results of the UseCase.ChangeListingStatus
+type UseCaseMockChangeListingStatusResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockChangeListingStatusOrigins contains origins of expectations of the UseCase.ChangeListingStatus
+type UseCaseMockChangeListingStatusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+	originStatus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Optional() *mUseCaseMockChangeListingStatus {
+	mmChangeListingStatus.optional = true
+	return mmChangeListingStatus
+}
+
+// Expect sets up expected params for UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Expect(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus) *mUseCaseMockChangeListingStatus {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	if mmChangeListingStatus.defaultExpectation == nil {
+		mmChangeListingStatus.defaultExpectation = &UseCaseMockChangeListingStatusExpectation{}
+	}
+
+	if mmChangeListingStatus.defaultExpectation.paramPtrs != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by ExpectParams functions")
+	}
+
+	mmChangeListingStatus.defaultExpectation.params = &UseCaseMockChangeListingStatusParams{ctx, userID, id, status}
+	mmChangeListingStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangeListingStatus.expectations {
+		if minimock.Equal(e.params, mmChangeListingStatus.defaultExpectation.params) {
+			mmChangeListingStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangeListingStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmChangeListingStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) ExpectCtxParam1(ctx context.Context) *mUseCaseMockChangeListingStatus {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	if mmChangeListingStatus.defaultExpectation == nil {
+		mmChangeListingStatus.defaultExpectation = &UseCaseMockChangeListingStatusExpectation{}
+	}
+
+	if mmChangeListingStatus.defaultExpectation.params != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Expect")
+	}
+
+	if mmChangeListingStatus.defaultExpectation.paramPtrs == nil {
+		mmChangeListingStatus.defaultExpectation.paramPtrs = &UseCaseMockChangeListingStatusParamPtrs{}
+	}
+	mmChangeListingStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangeListingStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmChangeListingStatus
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) ExpectUserIDParam2(userID uint64) *mUseCaseMockChangeListingStatus {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	if mmChangeListingStatus.defaultExpectation == nil {
+		mmChangeListingStatus.defaultExpectation = &UseCaseMockChangeListingStatusExpectation{}
+	}
+
+	if mmChangeListingStatus.defaultExpectation.params != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Expect")
+	}
+
+	if mmChangeListingStatus.defaultExpectation.paramPtrs == nil {
+		mmChangeListingStatus.defaultExpectation.paramPtrs = &UseCaseMockChangeListingStatusParamPtrs{}
+	}
+	mmChangeListingStatus.defaultExpectation.paramPtrs.userID = &userID
+	mmChangeListingStatus.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmChangeListingStatus
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) ExpectIdParam3(id uint64) *mUseCaseMockChangeListingStatus {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	if mmChangeListingStatus.defaultExpectation == nil {
+		mmChangeListingStatus.defaultExpectation = &UseCaseMockChangeListingStatusExpectation{}
+	}
+
+	if mmChangeListingStatus.defaultExpectation.params != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Expect")
+	}
+
+	if mmChangeListingStatus.defaultExpectation.paramPtrs == nil {
+		mmChangeListingStatus.defaultExpectation.paramPtrs = &UseCaseMockChangeListingStatusParamPtrs{}
+	}
+	mmChangeListingStatus.defaultExpectation.paramPtrs.id = &id
+	mmChangeListingStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmChangeListingStatus
+}
+
+// ExpectStatusParam4 sets up expected param status for UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) ExpectStatusParam4(status entity.ListingStatus) *mUseCaseMockChangeListingStatus {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	if mmChangeListingStatus.defaultExpectation == nil {
+		mmChangeListingStatus.defaultExpectation = &UseCaseMockChangeListingStatusExpectation{}
+	}
+
+	if mmChangeListingStatus.defaultExpectation.params != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Expect")
+	}
+
+	if mmChangeListingStatus.defaultExpectation.paramPtrs == nil {
+		mmChangeListingStatus.defaultExpectation.paramPtrs = &UseCaseMockChangeListingStatusParamPtrs{}
+	}
+	mmChangeListingStatus.defaultExpectation.paramPtrs.status = &status
+	mmChangeListingStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmChangeListingStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Inspect(f func(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus)) *mUseCaseMockChangeListingStatus {
+	if mmChangeListingStatus.mock.inspectFuncChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ChangeListingStatus")
+	}
+
+	mmChangeListingStatus.mock.inspectFuncChangeListingStatus = f
+
+	return mmChangeListingStatus
+}
+
+// Return sets up results that will be returned by UseCase.ChangeListingStatus
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	if mmChangeListingStatus.defaultExpectation == nil {
+		mmChangeListingStatus.defaultExpectation = &UseCaseMockChangeListingStatusExpectation{mock: mmChangeListingStatus.mock}
+	}
+	mmChangeListingStatus.defaultExpectation.results = &UseCaseMockChangeListingStatusResults{lp1, err}
+	mmChangeListingStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangeListingStatus.mock
+}
+
+// Set uses given function f to mock the UseCase.ChangeListingStatus method
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Set(f func(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmChangeListingStatus.defaultExpectation != nil {
+		mmChangeListingStatus.mock.t.Fatalf("Default expectation is already set for the UseCase.ChangeListingStatus method")
+	}
+
+	if len(mmChangeListingStatus.expectations) > 0 {
+		mmChangeListingStatus.mock.t.Fatalf("Some expectations are already set for the UseCase.ChangeListingStatus method")
+	}
+
+	mmChangeListingStatus.mock.funcChangeListingStatus = f
+	mmChangeListingStatus.mock.funcChangeListingStatusOrigin = minimock.CallerInfo(1)
+	return mmChangeListingStatus.mock
+}
+
+// When sets expectation for the UseCase.ChangeListingStatus which will trigger the result defined by the following
+// Then helper
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) When(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus) *UseCaseMockChangeListingStatusExpectation {
+	if mmChangeListingStatus.mock.funcChangeListingStatus != nil {
+		mmChangeListingStatus.mock.t.Fatalf("UseCaseMock.ChangeListingStatus mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockChangeListingStatusExpectation{
+		mock:               mmChangeListingStatus.mock,
+		params:             &UseCaseMockChangeListingStatusParams{ctx, userID, id, status},
+		expectationOrigins: UseCaseMockChangeListingStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmChangeListingStatus.expectations = append(mmChangeListingStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ChangeListingStatus return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockChangeListingStatusExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockChangeListingStatusResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ChangeListingStatus should be invoked
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Times(n uint64) *mUseCaseMockChangeListingStatus {
+	if n == 0 {
+		mmChangeListingStatus.mock.t.Fatalf("Times of UseCaseMock.ChangeListingStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmChangeListingStatus.expectedInvocations, n)
+	mmChangeListingStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangeListingStatus
+}
+
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) invocationsDone() bool {
+	if len(mmChangeListingStatus.expectations) == 0 && mmChangeListingStatus.defaultExpectation == nil && mmChangeListingStatus.mock.funcChangeListingStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmChangeListingStatus.mock.afterChangeListingStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangeListingStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ChangeListingStatus implements mm_listing.UseCase
+func (mmChangeListingStatus *UseCaseMock) ChangeListingStatus(ctx context.Context, userID uint64, id uint64, status entity.ListingStatus) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmChangeListingStatus.beforeChangeListingStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangeListingStatus.afterChangeListingStatusCounter, 1)
+
+	mmChangeListingStatus.t.Helper()
+
+	if mmChangeListingStatus.inspectFuncChangeListingStatus != nil {
+		mmChangeListingStatus.inspectFuncChangeListingStatus(ctx, userID, id, status)
+	}
+
+	mm_params := UseCaseMockChangeListingStatusParams{ctx, userID, id, status}
+
+	// Record call args
+	mmChangeListingStatus.ChangeListingStatusMock.mutex.Lock()
+	mmChangeListingStatus.ChangeListingStatusMock.callArgs = append(mmChangeListingStatus.ChangeListingStatusMock.callArgs, &mm_params)
+	mmChangeListingStatus.ChangeListingStatusMock.mutex.Unlock()
+
+	for _, e := range mmChangeListingStatus.ChangeListingStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockChangeListingStatusParams{ctx, userID, id, status}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmChangeListingStatus.t.Errorf("UseCaseMock.ChangeListingStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmChangeListingStatus.t.Errorf("UseCaseMock.ChangeListingStatus got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmChangeListingStatus.t.Errorf("UseCaseMock.ChangeListingStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmChangeListingStatus.t.Errorf("UseCaseMock.ChangeListingStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmChangeListingStatus.t.Errorf("UseCaseMock.ChangeListingStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmChangeListingStatus.ChangeListingStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmChangeListingStatus.t.Fatal("No results are set for the UseCaseMock.ChangeListingStatus")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmChangeListingStatus.funcChangeListingStatus != nil {
+		return mmChangeListingStatus.funcChangeListingStatus(ctx, userID, id, status)
+	}
+	mmChangeListingStatus.t.Fatalf("Unexpected call to UseCaseMock.ChangeListingStatus. %v %v %v %v", ctx, userID, id, status)
+	return
+}
+
+// ChangeListingStatusAfterCounter returns a count of finished UseCaseMock.ChangeListingStatus invocations
+func (mmChangeListingStatus *UseCaseMock) ChangeListingStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeListingStatus.afterChangeListingStatusCounter)
+}
+
+// ChangeListingStatusBeforeCounter returns a count of UseCaseMock.ChangeListingStatus invocations
+func (mmChangeListingStatus *UseCaseMock) ChangeListingStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeListingStatus.beforeChangeListingStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ChangeListingStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmChangeListingStatus *mUseCaseMockChangeListingStatus) Calls() []*UseCaseMockChangeListingStatusParams {
+	mmChangeListingStatus.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockChangeListingStatusParams, len(mmChangeListingStatus.callArgs))
+	copy(argCopy, mmChangeListingStatus.callArgs)
+
+	mmChangeListingStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockChangeListingStatusDone returns true if the count of the ChangeListingStatus invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockChangeListingStatusDone() bool {
+	if m.ChangeListingStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ChangeListingStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ChangeListingStatusMock.invocationsDone()
+}
+
+// MinimockChangeListingStatusInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockChangeListingStatusInspect() {
+	for _, e := range m.ChangeListingStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ChangeListingStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterChangeListingStatusCounter := mm_atomic.LoadUint64(&m.afterChangeListingStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChangeListingStatusMock.defaultExpectation != nil && afterChangeListingStatusCounter < 1 {
+		if m.ChangeListingStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ChangeListingStatus at\n%s", m.ChangeListingStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ChangeListingStatus at\n%s with params: %#v", m.ChangeListingStatusMock.defaultExpectation.expectationOrigins.origin, *m.ChangeListingStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChangeListingStatus != nil && afterChangeListingStatusCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ChangeListingStatus at\n%s", m.funcChangeListingStatusOrigin)
+	}
+
+	if !m.ChangeListingStatusMock.invocationsDone() && afterChangeListingStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ChangeListingStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangeListingStatusMock.expectedInvocations), m.ChangeListingStatusMock.expectedInvocationsOrigin, afterChangeListingStatusCounter)
+	}
+}
+
+type mUseCaseMockCreateListing struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockCreateListingExpectation
+	expectations       []*UseCaseMockCreateListingExpectation
+
+	callArgs []*UseCaseMockCreateListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockCreateListingExpectation specifies expectation struct of the UseCase.CreateListing
+type UseCaseMockCreateListingExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockCreateListingParams
+	paramPtrs          *UseCaseMockCreateListingParamPtrs
+	expectationOrigins UseCaseMockCreateListingExpectationOrigins
+	results            *UseCaseMockCreateListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockCreateListingParams contains parameters of the UseCase.CreateListing
+type UseCaseMockCreateListingParams struct {
+	ctx     context.Context
+	listing *entity.Listing
+}
+
+// UseCaseMockCreateListingParamPtrs contains pointers to parameters of the UseCase.CreateListing
+type UseCaseMockCreateListingParamPtrs struct {
+	ctx     *context.Context
+	listing **entity.Listing
+}
+
+// UseCaseMockCreateListingResults contains results of the UseCase.CreateListing
+type UseCaseMockCreateListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockCreateListingOrigins contains origins of expectations of the UseCase.CreateListing
+type UseCaseMockCreateListingExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originListing string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateListing *mUseCaseMockCreateListing) Optional() *mUseCaseMockCreateListing {
+	mmCreateListing.optional = true
+	return mmCreateListing
+}
+
+// Expect sets up expected params for UseCase.CreateListing
+func (mmCreateListing *mUseCaseMockCreateListing) Expect(ctx context.Context, listing *entity.Listing) *mUseCaseMockCreateListing {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	}
+
+	if mmCreateListing.defaultExpectation.paramPtrs != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by ExpectParams functions")
+	}
+
+	mmCreateListing.defaultExpectation.params = &UseCaseMockCreateListingParams{ctx, listing}
+	mmCreateListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateListing.expectations {
+		if minimock.Equal(e.params, mmCreateListing.defaultExpectation.params) {
+			mmCreateListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateListing.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.CreateListing
+func (mmCreateListing *mUseCaseMockCreateListing) ExpectCtxParam1(ctx context.Context) *mUseCaseMockCreateListing {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	}
+
+	if mmCreateListing.defaultExpectation.params != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
+	}
+
+	if mmCreateListing.defaultExpectation.paramPtrs == nil {
+		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
+	}
+	mmCreateListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateListing
+}
+
+// ExpectListingParam2 sets up expected param listing for UseCase.CreateListing
+func (mmCreateListing *mUseCaseMockCreateListing) ExpectListingParam2(listing *entity.Listing) *mUseCaseMockCreateListing {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{}
+	}
+
+	if mmCreateListing.defaultExpectation.params != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Expect")
+	}
+
+	if mmCreateListing.defaultExpectation.paramPtrs == nil {
+		mmCreateListing.defaultExpectation.paramPtrs = &UseCaseMockCreateListingParamPtrs{}
+	}
+	mmCreateListing.defaultExpectation.paramPtrs.listing = &listing
+	mmCreateListing.defaultExpectation.expectationOrigins.originListing = minimock.CallerInfo(1)
+
+	return mmCreateListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.CreateListing
+func (mmCreateListing *mUseCaseMockCreateListing) Inspect(f func(ctx context.Context, listing *entity.Listing)) *mUseCaseMockCreateListing {
+	if mmCreateListing.mock.inspectFuncCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("Inspect function is already set for UseCaseMock.CreateListing")
+	}
+
+	mmCreateListing.mock.inspectFuncCreateListing = f
+
+	return mmCreateListing
+}
+
+// Return sets up results that will be returned by UseCase.CreateListing
+func (mmCreateListing *mUseCaseMockCreateListing) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+	}
+
+	if mmCreateListing.defaultExpectation == nil {
+		mmCreateListing.defaultExpectation = &UseCaseMockCreateListingExpectation{mock: mmCreateListing.mock}
+	}
+	mmCreateListing.defaultExpectation.results = &UseCaseMockCreateListingResults{lp1, err}
+	mmCreateListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateListing.mock
+}
+
+// Set uses given function f to mock the UseCase.CreateListing method
+func (mmCreateListing *mUseCaseMockCreateListing) Set(f func(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmCreateListing.defaultExpectation != nil {
+		mmCreateListing.mock.t.Fatalf("Default expectation is already set for the UseCase.CreateListing method")
+	}
+
+	if len(mmCreateListing.expectations) > 0 {
+		mmCreateListing.mock.t.Fatalf("Some expectations are already set for the UseCase.CreateListing method")
+	}
+
+	mmCreateListing.mock.funcCreateListing = f
+	mmCreateListing.mock.funcCreateListingOrigin = minimock.CallerInfo(1)
+	return mmCreateListing.mock
+}
+
+// When sets expectation for the UseCase.CreateListing which will trigger the result defined by the following
+// Then helper
+func (mmCreateListing *mUseCaseMockCreateListing) When(ctx context.Context, listing *entity.Listing) *UseCaseMockCreateListingExpectation {
+	if mmCreateListing.mock.funcCreateListing != nil {
+		mmCreateListing.mock.t.Fatalf("UseCaseMock.CreateListing mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockCreateListingExpectation{
+		mock:               mmCreateListing.mock,
+		params:             &UseCaseMockCreateListingParams{ctx, listing},
+		expectationOrigins: UseCaseMockCreateListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateListing.expectations = append(mmCreateListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.CreateListing return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockCreateListingExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockCreateListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.CreateListing should be invoked
+func (mmCreateListing *mUseCaseMockCreateListing) Times(n uint64) *mUseCaseMockCreateListing {
+	if n == 0 {
+		mmCreateListing.mock.t.Fatalf("Times of UseCaseMock.CreateListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateListing.expectedInvocations, n)
+	mmCreateListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateListing
+}
+
+func (mmCreateListing *mUseCaseMockCreateListing) invocationsDone() bool {
+	if len(mmCreateListing.expectations) == 0 && mmCreateListing.defaultExpectation == nil && mmCreateListing.mock.funcCreateListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateListing.mock.afterCreateListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateListing implements mm_listing.UseCase
+func (mmCreateListing *UseCaseMock) CreateListing(ctx context.Context, listing *entity.Listing) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmCreateListing.beforeCreateListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateListing.afterCreateListingCounter, 1)
+
+	mmCreateListing.t.Helper()
+
+	if mmCreateListing.inspectFuncCreateListing != nil {
+		mmCreateListing.inspectFuncCreateListing(ctx, listing)
+	}
+
+	mm_params := UseCaseMockCreateListingParams{ctx, listing}
+
+	// Record call args
+	mmCreateListing.CreateListingMock.mutex.Lock()
+	mmCreateListing.CreateListingMock.callArgs = append(mmCreateListing.CreateListingMock.callArgs, &mm_params)
+	mmCreateListing.CreateListingMock.mutex.Unlock()
+
+	for _, e := range mmCreateListing.CreateListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmCreateListing.CreateListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateListing.CreateListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateListing.CreateListingMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateListing.CreateListingMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockCreateListingParams{ctx, listing}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listing != nil && !minimock.Equal(*mm_want_ptrs.listing, mm_got.listing) {
+				mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameter listing, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.originListing, *mm_want_ptrs.listing, mm_got.listing, minimock.Diff(*mm_want_ptrs.listing, mm_got.listing))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateListing.t.Errorf("UseCaseMock.CreateListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateListing.CreateListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateListing.CreateListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateListing.t.Fatal("No results are set for the UseCaseMock.CreateListing")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmCreateListing.funcCreateListing != nil {
+		return mmCreateListing.funcCreateListing(ctx, listing)
+	}
+	mmCreateListing.t.Fatalf("Unexpected call to UseCaseMock.CreateListing. %v %v", ctx, listing)
+	return
+}
+
+// CreateListingAfterCounter returns a count of finished UseCaseMock.CreateListing invocations
+func (mmCreateListing *UseCaseMock) CreateListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateListing.afterCreateListingCounter)
+}
+
+// CreateListingBeforeCounter returns a count of UseCaseMock.CreateListing invocations
+func (mmCreateListing *UseCaseMock) CreateListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateListing.beforeCreateListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.CreateListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateListing *mUseCaseMockCreateListing) Calls() []*UseCaseMockCreateListingParams {
+	mmCreateListing.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockCreateListingParams, len(mmCreateListing.callArgs))
+	copy(argCopy, mmCreateListing.callArgs)
+
+	mmCreateListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateListingDone returns true if the count of the CreateListing invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockCreateListingDone() bool {
+	if m.CreateListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateListingMock.invocationsDone()
+}
+
+// MinimockCreateListingInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockCreateListingInspect() {
+	for _, e := range m.CreateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateListingCounter := mm_atomic.LoadUint64(&m.afterCreateListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateListingMock.defaultExpectation != nil && afterCreateListingCounter < 1 {
+		if m.CreateListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s", m.CreateListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s with params: %#v", m.CreateListingMock.defaultExpectation.expectationOrigins.origin, *m.CreateListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateListing != nil && afterCreateListingCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.CreateListing at\n%s", m.funcCreateListingOrigin)
+	}
+
+	if !m.CreateListingMock.invocationsDone() && afterCreateListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.CreateListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateListingMock.expectedInvocations), m.CreateListingMock.expectedInvocationsOrigin, afterCreateListingCounter)
+	}
+}
+
+type mUseCaseMockCreateSavedSearch struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockCreateSavedSearchExpectation
+	expectations       []*UseCaseMockCreateSavedSearchExpectation
+
+	callArgs []*UseCaseMockCreateSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockCreateSavedSearchExpectation specifies expectation struct of the UseCase.CreateSavedSearch
+type UseCaseMockCreateSavedSearchExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockCreateSavedSearchParams
+	paramPtrs          *UseCaseMockCreateSavedSearchParamPtrs
+	expectationOrigins UseCaseMockCreateSavedSearchExpectationOrigins
+	results            *UseCaseMockCreateSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockCreateSavedSearchParams contains parameters of the UseCase.CreateSavedSearch
+type UseCaseMockCreateSavedSearchParams struct {
+	ctx    context.Context
+	search *entity.SavedSearch
+}
+
+// UseCaseMockCreateSavedSearchParamPtrs contains pointers to parameters of the UseCase.CreateSavedSearch
+type UseCaseMockCreateSavedSearchParamPtrs struct {
+	ctx    *context.Context
+	search **entity.SavedSearch
+}
+
+// UseCaseMockCreateSavedSearchResults contains results of the UseCase.CreateSavedSearch
+type UseCaseMockCreateSavedSearchResults struct {
+	sp1 *entity.SavedSearch
+	err error
+}
+
+// UseCaseMockCreateSavedSearchOrigins contains origins of expectations of the UseCase.CreateSavedSearch
+type UseCaseMockCreateSavedSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSearch string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Optional() *mUseCaseMockCreateSavedSearch {
+	mmCreateSavedSearch.optional = true
+	return mmCreateSavedSearch
+}
+
+// Expect sets up expected params for UseCase.CreateSavedSearch
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Expect(ctx context.Context, search *entity.SavedSearch) *mUseCaseMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &UseCaseMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSavedSearch.defaultExpectation.params = &UseCaseMockCreateSavedSearchParams{ctx, search}
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSavedSearch.expectations {
+		if minimock.Equal(e.params, mmCreateSavedSearch.defaultExpectation.params) {
+			mmCreateSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSavedSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSavedSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.CreateSavedSearch
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) ExpectCtxParam1(ctx context.Context) *mUseCaseMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &UseCaseMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.params != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Expect")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmCreateSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockCreateSavedSearchParamPtrs{}
+	}
+	mmCreateSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSavedSearch
+}
+
+// ExpectSearchParam2 sets up expected param search for UseCase.CreateSavedSearch
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) ExpectSearchParam2(search *entity.SavedSearch) *mUseCaseMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &UseCaseMockCreateSavedSearchExpectation{}
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.params != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Expect")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmCreateSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockCreateSavedSearchParamPtrs{}
+	}
+	mmCreateSavedSearch.defaultExpectation.paramPtrs.search = &search
+	mmCreateSavedSearch.defaultExpectation.expectationOrigins.originSearch = minimock.CallerInfo(1)
+
+	return mmCreateSavedSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.CreateSavedSearch
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Inspect(f func(ctx context.Context, search *entity.SavedSearch)) *mUseCaseMockCreateSavedSearch {
+	if mmCreateSavedSearch.mock.inspectFuncCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("Inspect function is already set for UseCaseMock.CreateSavedSearch")
+	}
+
+	mmCreateSavedSearch.mock.inspectFuncCreateSavedSearch = f
+
+	return mmCreateSavedSearch
+}
+
+// Return sets up results that will be returned by UseCase.CreateSavedSearch
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Return(sp1 *entity.SavedSearch, err error) *UseCaseMock {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	if mmCreateSavedSearch.defaultExpectation == nil {
+		mmCreateSavedSearch.defaultExpectation = &UseCaseMockCreateSavedSearchExpectation{mock: mmCreateSavedSearch.mock}
+	}
+	mmCreateSavedSearch.defaultExpectation.results = &UseCaseMockCreateSavedSearchResults{sp1, err}
+	mmCreateSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSavedSearch.mock
+}
+
+// Set uses given function f to mock the UseCase.CreateSavedSearch method
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Set(f func(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error)) *UseCaseMock {
+	if mmCreateSavedSearch.defaultExpectation != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("Default expectation is already set for the UseCase.CreateSavedSearch method")
+	}
+
+	if len(mmCreateSavedSearch.expectations) > 0 {
+		mmCreateSavedSearch.mock.t.Fatalf("Some expectations are already set for the UseCase.CreateSavedSearch method")
+	}
+
+	mmCreateSavedSearch.mock.funcCreateSavedSearch = f
+	mmCreateSavedSearch.mock.funcCreateSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmCreateSavedSearch.mock
+}
+
+// When sets expectation for the UseCase.CreateSavedSearch which will trigger the result defined by the following
+// Then helper
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) When(ctx context.Context, search *entity.SavedSearch) *UseCaseMockCreateSavedSearchExpectation {
+	if mmCreateSavedSearch.mock.funcCreateSavedSearch != nil {
+		mmCreateSavedSearch.mock.t.Fatalf("UseCaseMock.CreateSavedSearch mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockCreateSavedSearchExpectation{
+		mock:               mmCreateSavedSearch.mock,
+		params:             &UseCaseMockCreateSavedSearchParams{ctx, search},
+		expectationOrigins: UseCaseMockCreateSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSavedSearch.expectations = append(mmCreateSavedSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.CreateSavedSearch return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockCreateSavedSearchExpectation) Then(sp1 *entity.SavedSearch, err error) *UseCaseMock {
+	e.results = &UseCaseMockCreateSavedSearchResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.CreateSavedSearch should be invoked
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Times(n uint64) *mUseCaseMockCreateSavedSearch {
+	if n == 0 {
+		mmCreateSavedSearch.mock.t.Fatalf("Times of UseCaseMock.CreateSavedSearch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSavedSearch.expectedInvocations, n)
+	mmCreateSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSavedSearch
+}
+
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) invocationsDone() bool {
+	if len(mmCreateSavedSearch.expectations) == 0 && mmCreateSavedSearch.defaultExpectation == nil && mmCreateSavedSearch.mock.funcCreateSavedSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSavedSearch.mock.afterCreateSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSavedSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSavedSearch implements mm_listing.UseCase
+func (mmCreateSavedSearch *UseCaseMock) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmCreateSavedSearch.beforeCreateSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSavedSearch.afterCreateSavedSearchCounter, 1)
+
+	mmCreateSavedSearch.t.Helper()
+
+	if mmCreateSavedSearch.inspectFuncCreateSavedSearch != nil {
+		mmCreateSavedSearch.inspectFuncCreateSavedSearch(ctx, search)
+	}
+
+	mm_params := UseCaseMockCreateSavedSearchParams{ctx, search}
+
+	// Record call args
+	mmCreateSavedSearch.CreateSavedSearchMock.mutex.Lock()
+	mmCreateSavedSearch.CreateSavedSearchMock.callArgs = append(mmCreateSavedSearch.CreateSavedSearchMock.callArgs, &mm_params)
+	mmCreateSavedSearch.CreateSavedSearchMock.mutex.Unlock()
+
+	for _, e := range mmCreateSavedSearch.CreateSavedSearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockCreateSavedSearchParams{ctx, search}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSavedSearch.t.Errorf("UseCaseMock.CreateSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.search != nil && !minimock.Equal(*mm_want_ptrs.search, mm_got.search) {
+				mmCreateSavedSearch.t.Errorf("UseCaseMock.CreateSavedSearch got unexpected parameter search, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.originSearch, *mm_want_ptrs.search, mm_got.search, minimock.Diff(*mm_want_ptrs.search, mm_got.search))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSavedSearch.t.Errorf("UseCaseMock.CreateSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSavedSearch.CreateSavedSearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSavedSearch.t.Fatal("No results are set for the UseCaseMock.CreateSavedSearch")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmCreateSavedSearch.funcCreateSavedSearch != nil {
+		return mmCreateSavedSearch.funcCreateSavedSearch(ctx, search)
+	}
+	mmCreateSavedSearch.t.Fatalf("Unexpected call to UseCaseMock.CreateSavedSearch. %v %v", ctx, search)
+	return
+}
+
+// CreateSavedSearchAfterCounter returns a count of finished UseCaseMock.CreateSavedSearch invocations
+func (mmCreateSavedSearch *UseCaseMock) CreateSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSavedSearch.afterCreateSavedSearchCounter)
+}
+
+// CreateSavedSearchBeforeCounter returns a count of UseCaseMock.CreateSavedSearch invocations
+func (mmCreateSavedSearch *UseCaseMock) CreateSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSavedSearch.beforeCreateSavedSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.CreateSavedSearch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSavedSearch *mUseCaseMockCreateSavedSearch) Calls() []*UseCaseMockCreateSavedSearchParams {
+	mmCreateSavedSearch.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockCreateSavedSearchParams, len(mmCreateSavedSearch.callArgs))
+	copy(argCopy, mmCreateSavedSearch.callArgs)
+
+	mmCreateSavedSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSavedSearchDone returns true if the count of the CreateSavedSearch invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockCreateSavedSearchDone() bool {
+	if m.CreateSavedSearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSavedSearchMock.invocationsDone()
+}
+
+// MinimockCreateSavedSearchInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockCreateSavedSearchInspect() {
+	for _, e := range m.CreateSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.CreateSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSavedSearchCounter := mm_atomic.LoadUint64(&m.afterCreateSavedSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSavedSearchMock.defaultExpectation != nil && afterCreateSavedSearchCounter < 1 {
+		if m.CreateSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.CreateSavedSearch at\n%s", m.CreateSavedSearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.CreateSavedSearch at\n%s with params: %#v", m.CreateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.CreateSavedSearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSavedSearch != nil && afterCreateSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.CreateSavedSearch at\n%s", m.funcCreateSavedSearchOrigin)
+	}
+
+	if !m.CreateSavedSearchMock.invocationsDone() && afterCreateSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.CreateSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSavedSearchMock.expectedInvocations), m.CreateSavedSearchMock.expectedInvocationsOrigin, afterCreateSavedSearchCounter)
+	}
+}
+
+type mUseCaseMockDeleteListing struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockDeleteListingExpectation
+	expectations       []*UseCaseMockDeleteListingExpectation
+
+	callArgs []*UseCaseMockDeleteListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockDeleteListingExpectation specifies expectation struct of the UseCase.DeleteListing
+type UseCaseMockDeleteListingExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockDeleteListingParams
+	paramPtrs          *UseCaseMockDeleteListingParamPtrs
+	expectationOrigins UseCaseMockDeleteListingExpectationOrigins
+	results            *UseCaseMockDeleteListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockDeleteListingParams contains parameters of the UseCase.DeleteListing
+type UseCaseMockDeleteListingParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// UseCaseMockDeleteListingParamPtrs contains pointers to parameters of the UseCase.DeleteListing
+type UseCaseMockDeleteListingParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// UseCaseMockDeleteListingResults contains results of the UseCase.DeleteListing
+type UseCaseMockDeleteListingResults struct {
+	t1  time.Time
+	err error
+}
+
+// UseCaseMockDeleteListingOrigins contains origins of expectations of the UseCase.DeleteListing
+type UseCaseMockDeleteListingExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteListing *mUseCaseMockDeleteListing) Optional() *mUseCaseMockDeleteListing {
+	mmDeleteListing.optional = true
+	return mmDeleteListing
+}
+
+// Expect sets up expected params for UseCase.DeleteListing
+func (mmDeleteListing *mUseCaseMockDeleteListing) Expect(ctx context.Context, userID uint64, id uint64) *mUseCaseMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &UseCaseMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteListing.defaultExpectation.params = &UseCaseMockDeleteListingParams{ctx, userID, id}
+	mmDeleteListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteListing.expectations {
+		if minimock.Equal(e.params, mmDeleteListing.defaultExpectation.params) {
+			mmDeleteListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteListing.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.DeleteListing
+func (mmDeleteListing *mUseCaseMockDeleteListing) ExpectCtxParam1(ctx context.Context) *mUseCaseMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &UseCaseMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.params != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Expect")
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs == nil {
+		mmDeleteListing.defaultExpectation.paramPtrs = &UseCaseMockDeleteListingParamPtrs{}
+	}
+	mmDeleteListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteListing
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.DeleteListing
+func (mmDeleteListing *mUseCaseMockDeleteListing) ExpectUserIDParam2(userID uint64) *mUseCaseMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &UseCaseMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.params != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Expect")
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs == nil {
+		mmDeleteListing.defaultExpectation.paramPtrs = &UseCaseMockDeleteListingParamPtrs{}
+	}
+	mmDeleteListing.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteListing.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteListing
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.DeleteListing
+func (mmDeleteListing *mUseCaseMockDeleteListing) ExpectIdParam3(id uint64) *mUseCaseMockDeleteListing {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &UseCaseMockDeleteListingExpectation{}
+	}
+
+	if mmDeleteListing.defaultExpectation.params != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Expect")
+	}
+
+	if mmDeleteListing.defaultExpectation.paramPtrs == nil {
+		mmDeleteListing.defaultExpectation.paramPtrs = &UseCaseMockDeleteListingParamPtrs{}
+	}
+	mmDeleteListing.defaultExpectation.paramPtrs.id = &id
+	mmDeleteListing.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.DeleteListing
+func (mmDeleteListing *mUseCaseMockDeleteListing) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mUseCaseMockDeleteListing {
+	if mmDeleteListing.mock.inspectFuncDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("Inspect function is already set for UseCaseMock.DeleteListing")
+	}
+
+	mmDeleteListing.mock.inspectFuncDeleteListing = f
+
+	return mmDeleteListing
+}
+
+// Return sets up results that will be returned by UseCase.DeleteListing
+func (mmDeleteListing *mUseCaseMockDeleteListing) Return(t1 time.Time, err error) *UseCaseMock {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Set")
+	}
+
+	if mmDeleteListing.defaultExpectation == nil {
+		mmDeleteListing.defaultExpectation = &UseCaseMockDeleteListingExpectation{mock: mmDeleteListing.mock}
+	}
+	mmDeleteListing.defaultExpectation.results = &UseCaseMockDeleteListingResults{t1, err}
+	mmDeleteListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteListing.mock
+}
+
+// Set uses given function f to mock the UseCase.DeleteListing method
+func (mmDeleteListing *mUseCaseMockDeleteListing) Set(f func(ctx context.Context, userID uint64, id uint64) (t1 time.Time, err error)) *UseCaseMock {
+	if mmDeleteListing.defaultExpectation != nil {
+		mmDeleteListing.mock.t.Fatalf("Default expectation is already set for the UseCase.DeleteListing method")
+	}
+
+	if len(mmDeleteListing.expectations) > 0 {
+		mmDeleteListing.mock.t.Fatalf("Some expectations are already set for the UseCase.DeleteListing method")
+	}
+
+	mmDeleteListing.mock.funcDeleteListing = f
+	mmDeleteListing.mock.funcDeleteListingOrigin = minimock.CallerInfo(1)
+	return mmDeleteListing.mock
+}
+
+// When sets expectation for the UseCase.DeleteListing which will trigger the result defined by the following
+// Then helper
+func (mmDeleteListing *mUseCaseMockDeleteListing) When(ctx context.Context, userID uint64, id uint64) *UseCaseMockDeleteListingExpectation {
+	if mmDeleteListing.mock.funcDeleteListing != nil {
+		mmDeleteListing.mock.t.Fatalf("UseCaseMock.DeleteListing mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockDeleteListingExpectation{
+		mock:               mmDeleteListing.mock,
+		params:             &UseCaseMockDeleteListingParams{ctx, userID, id},
+		expectationOrigins: UseCaseMockDeleteListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteListing.expectations = append(mmDeleteListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.DeleteListing return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockDeleteListingExpectation) Then(t1 time.Time, err error) *UseCaseMock {
+	e.results = &UseCaseMockDeleteListingResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.DeleteListing should be invoked
+func (mmDeleteListing *mUseCaseMockDeleteListing) Times(n uint64) *mUseCaseMockDeleteListing {
+	if n == 0 {
+		mmDeleteListing.mock.t.Fatalf("Times of UseCaseMock.DeleteListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteListing.expectedInvocations, n)
+	mmDeleteListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteListing
+}
+
+func (mmDeleteListing *mUseCaseMockDeleteListing) invocationsDone() bool {
+	if len(mmDeleteListing.expectations) == 0 && mmDeleteListing.defaultExpectation == nil && mmDeleteListing.mock.funcDeleteListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteListing.mock.afterDeleteListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteListing implements mm_listing.UseCase
+func (mmDeleteListing *UseCaseMock) DeleteListing(ctx context.Context, userID uint64, id uint64) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmDeleteListing.beforeDeleteListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteListing.afterDeleteListingCounter, 1)
+
+	mmDeleteListing.t.Helper()
+
+	if mmDeleteListing.inspectFuncDeleteListing != nil {
+		mmDeleteListing.inspectFuncDeleteListing(ctx, userID, id)
+	}
+
+	mm_params := UseCaseMockDeleteListingParams{ctx, userID, id}
+
+	// Record call args
+	mmDeleteListing.DeleteListingMock.mutex.Lock()
+	mmDeleteListing.DeleteListingMock.callArgs = append(mmDeleteListing.DeleteListingMock.callArgs, &mm_params)
+	mmDeleteListing.DeleteListingMock.mutex.Unlock()
+
+	for _, e := range mmDeleteListing.DeleteListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmDeleteListing.DeleteListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteListing.DeleteListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteListing.DeleteListingMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteListing.DeleteListingMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockDeleteListingParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteListing.t.Errorf("UseCaseMock.DeleteListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteListing.t.Errorf("UseCaseMock.DeleteListing got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteListing.t.Errorf("UseCaseMock.DeleteListing got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteListing.t.Errorf("UseCaseMock.DeleteListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteListing.DeleteListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteListing.DeleteListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteListing.t.Fatal("No results are set for the UseCaseMock.DeleteListing")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmDeleteListing.funcDeleteListing != nil {
+		return mmDeleteListing.funcDeleteListing(ctx, userID, id)
+	}
+	mmDeleteListing.t.Fatalf("Unexpected call to UseCaseMock.DeleteListing. %v %v %v", ctx, userID, id)
+	return
+}
+
+// DeleteListingAfterCounter returns a count of finished UseCaseMock.DeleteListing invocations
+func (mmDeleteListing *UseCaseMock) DeleteListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteListing.afterDeleteListingCounter)
+}
+
+// DeleteListingBeforeCounter returns a count of UseCaseMock.DeleteListing invocations
+func (mmDeleteListing *UseCaseMock) DeleteListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteListing.beforeDeleteListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.DeleteListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteListing *mUseCaseMockDeleteListing) Calls() []*UseCaseMockDeleteListingParams {
+	mmDeleteListing.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockDeleteListingParams, len(mmDeleteListing.callArgs))
+	copy(argCopy, mmDeleteListing.callArgs)
+
+	mmDeleteListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteListingDone returns true if the count of the DeleteListing invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockDeleteListingDone() bool {
+	if m.DeleteListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteListingMock.invocationsDone()
+}
+
+// MinimockDeleteListingInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockDeleteListingInspect() {
+	for _, e := range m.DeleteListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.DeleteListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteListingCounter := mm_atomic.LoadUint64(&m.afterDeleteListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteListingMock.defaultExpectation != nil && afterDeleteListingCounter < 1 {
+		if m.DeleteListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.DeleteListing at\n%s", m.DeleteListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.DeleteListing at\n%s with params: %#v", m.DeleteListingMock.defaultExpectation.expectationOrigins.origin, *m.DeleteListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteListing != nil && afterDeleteListingCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.DeleteListing at\n%s", m.funcDeleteListingOrigin)
+	}
+
+	if !m.DeleteListingMock.invocationsDone() && afterDeleteListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.DeleteListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteListingMock.expectedInvocations), m.DeleteListingMock.expectedInvocationsOrigin, afterDeleteListingCounter)
+	}
+}
+
+type mUseCaseMockDeleteSavedSearch struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockDeleteSavedSearchExpectation
+	expectations       []*UseCaseMockDeleteSavedSearchExpectation
+
+	callArgs []*UseCaseMockDeleteSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockDeleteSavedSearchExpectation specifies expectation struct of the UseCase.DeleteSavedSearch
+type UseCaseMockDeleteSavedSearchExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockDeleteSavedSearchParams
+	paramPtrs          *UseCaseMockDeleteSavedSearchParamPtrs
+	expectationOrigins UseCaseMockDeleteSavedSearchExpectationOrigins
+	results            *UseCaseMockDeleteSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockDeleteSavedSearchParams contains parameters of the UseCase.DeleteSavedSearch
+type UseCaseMockDeleteSavedSearchParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// UseCaseMockDeleteSavedSearchParamPtrs contains pointers to parameters of the UseCase.DeleteSavedSearch
+type UseCaseMockDeleteSavedSearchParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// UseCaseMockDeleteSavedSearchResults contains results of the UseCase.DeleteSavedSearch
+type UseCaseMockDeleteSavedSearchResults struct {
+	err error
+}
+
+// UseCaseMockDeleteSavedSearchOrigins contains origins of expectations of the UseCase.DeleteSavedSearch
+type UseCaseMockDeleteSavedSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Optional() *mUseCaseMockDeleteSavedSearch {
+	mmDeleteSavedSearch.optional = true
+	return mmDeleteSavedSearch
+}
+
+// Expect sets up expected params for UseCase.DeleteSavedSearch
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Expect(ctx context.Context, userID uint64, id uint64) *mUseCaseMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &UseCaseMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSavedSearch.defaultExpectation.params = &UseCaseMockDeleteSavedSearchParams{ctx, userID, id}
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSavedSearch.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedSearch.defaultExpectation.params) {
+			mmDeleteSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSavedSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.DeleteSavedSearch
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) ExpectCtxParam1(ctx context.Context) *mUseCaseMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &UseCaseMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.params != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Expect")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockDeleteSavedSearchParamPtrs{}
+	}
+	mmDeleteSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSavedSearch
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.DeleteSavedSearch
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) ExpectUserIDParam2(userID uint64) *mUseCaseMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &UseCaseMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.params != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Expect")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockDeleteSavedSearchParamPtrs{}
+	}
+	mmDeleteSavedSearch.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedSearch
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.DeleteSavedSearch
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) ExpectIdParam3(id uint64) *mUseCaseMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &UseCaseMockDeleteSavedSearchExpectation{}
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.params != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Expect")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockDeleteSavedSearchParamPtrs{}
+	}
+	mmDeleteSavedSearch.defaultExpectation.paramPtrs.id = &id
+	mmDeleteSavedSearch.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteSavedSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.DeleteSavedSearch
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mUseCaseMockDeleteSavedSearch {
+	if mmDeleteSavedSearch.mock.inspectFuncDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("Inspect function is already set for UseCaseMock.DeleteSavedSearch")
+	}
+
+	mmDeleteSavedSearch.mock.inspectFuncDeleteSavedSearch = f
+
+	return mmDeleteSavedSearch
+}
+
+// Return sets up results that will be returned by UseCase.DeleteSavedSearch
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Return(err error) *UseCaseMock {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	if mmDeleteSavedSearch.defaultExpectation == nil {
+		mmDeleteSavedSearch.defaultExpectation = &UseCaseMockDeleteSavedSearchExpectation{mock: mmDeleteSavedSearch.mock}
+	}
+	mmDeleteSavedSearch.defaultExpectation.results = &UseCaseMockDeleteSavedSearchResults{err}
+	mmDeleteSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedSearch.mock
+}
+
+// Set uses given function f to mock the UseCase.DeleteSavedSearch method
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Set(f func(ctx context.Context, userID uint64, id uint64) (err error)) *UseCaseMock {
+	if mmDeleteSavedSearch.defaultExpectation != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("Default expectation is already set for the UseCase.DeleteSavedSearch method")
+	}
+
+	if len(mmDeleteSavedSearch.expectations) > 0 {
+		mmDeleteSavedSearch.mock.t.Fatalf("Some expectations are already set for the UseCase.DeleteSavedSearch method")
+	}
+
+	mmDeleteSavedSearch.mock.funcDeleteSavedSearch = f
+	mmDeleteSavedSearch.mock.funcDeleteSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedSearch.mock
+}
+
+// When sets expectation for the UseCase.DeleteSavedSearch which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) When(ctx context.Context, userID uint64, id uint64) *UseCaseMockDeleteSavedSearchExpectation {
+	if mmDeleteSavedSearch.mock.funcDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.mock.t.Fatalf("UseCaseMock.DeleteSavedSearch mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockDeleteSavedSearchExpectation{
+		mock:               mmDeleteSavedSearch.mock,
+		params:             &UseCaseMockDeleteSavedSearchParams{ctx, userID, id},
+		expectationOrigins: UseCaseMockDeleteSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSavedSearch.expectations = append(mmDeleteSavedSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.DeleteSavedSearch return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockDeleteSavedSearchExpectation) Then(err error) *UseCaseMock {
+	e.results = &UseCaseMockDeleteSavedSearchResults{err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.DeleteSavedSearch should be invoked
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Times(n uint64) *mUseCaseMockDeleteSavedSearch {
+	if n == 0 {
+		mmDeleteSavedSearch.mock.t.Fatalf("Times of UseCaseMock.DeleteSavedSearch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSavedSearch.expectedInvocations, n)
+	mmDeleteSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedSearch
+}
+
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) invocationsDone() bool {
+	if len(mmDeleteSavedSearch.expectations) == 0 && mmDeleteSavedSearch.defaultExpectation == nil && mmDeleteSavedSearch.mock.funcDeleteSavedSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSavedSearch.mock.afterDeleteSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSavedSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSavedSearch implements mm_listing.UseCase
+func (mmDeleteSavedSearch *UseCaseMock) DeleteSavedSearch(ctx context.Context, userID uint64, id uint64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedSearch.beforeDeleteSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedSearch.afterDeleteSavedSearchCounter, 1)
+
+	mmDeleteSavedSearch.t.Helper()
+
+	if mmDeleteSavedSearch.inspectFuncDeleteSavedSearch != nil {
+		mmDeleteSavedSearch.inspectFuncDeleteSavedSearch(ctx, userID, id)
+	}
+
+	mm_params := UseCaseMockDeleteSavedSearchParams{ctx, userID, id}
+
+	// Record call args
+	mmDeleteSavedSearch.DeleteSavedSearchMock.mutex.Lock()
+	mmDeleteSavedSearch.DeleteSavedSearchMock.callArgs = append(mmDeleteSavedSearch.DeleteSavedSearchMock.callArgs, &mm_params)
+	mmDeleteSavedSearch.DeleteSavedSearchMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedSearch.DeleteSavedSearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockDeleteSavedSearchParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSavedSearch.t.Errorf("UseCaseMock.DeleteSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSavedSearch.t.Errorf("UseCaseMock.DeleteSavedSearch got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteSavedSearch.t.Errorf("UseCaseMock.DeleteSavedSearch got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedSearch.t.Errorf("UseCaseMock.DeleteSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedSearch.DeleteSavedSearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedSearch.t.Fatal("No results are set for the UseCaseMock.DeleteSavedSearch")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedSearch.funcDeleteSavedSearch != nil {
+		return mmDeleteSavedSearch.funcDeleteSavedSearch(ctx, userID, id)
+	}
+	mmDeleteSavedSearch.t.Fatalf("Unexpected call to UseCaseMock.DeleteSavedSearch. %v %v %v", ctx, userID, id)
+	return
+}
+
+// DeleteSavedSearchAfterCounter returns a count of finished UseCaseMock.DeleteSavedSearch invocations
+func (mmDeleteSavedSearch *UseCaseMock) DeleteSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedSearch.afterDeleteSavedSearchCounter)
+}
+
+// DeleteSavedSearchBeforeCounter returns a count of UseCaseMock.DeleteSavedSearch invocations
+func (mmDeleteSavedSearch *UseCaseMock) DeleteSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedSearch.beforeDeleteSavedSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.DeleteSavedSearch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedSearch *mUseCaseMockDeleteSavedSearch) Calls() []*UseCaseMockDeleteSavedSearchParams {
+	mmDeleteSavedSearch.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockDeleteSavedSearchParams, len(mmDeleteSavedSearch.callArgs))
+	copy(argCopy, mmDeleteSavedSearch.callArgs)
+
+	mmDeleteSavedSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedSearchDone returns true if the count of the DeleteSavedSearch invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockDeleteSavedSearchDone() bool {
+	if m.DeleteSavedSearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSavedSearchMock.invocationsDone()
+}
+
+// MinimockDeleteSavedSearchInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockDeleteSavedSearchInspect() {
+	for _, e := range m.DeleteSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.DeleteSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSavedSearchCounter := mm_atomic.LoadUint64(&m.afterDeleteSavedSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedSearchMock.defaultExpectation != nil && afterDeleteSavedSearchCounter < 1 {
+		if m.DeleteSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.DeleteSavedSearch at\n%s", m.DeleteSavedSearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.DeleteSavedSearch at\n%s with params: %#v", m.DeleteSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSavedSearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedSearch != nil && afterDeleteSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.DeleteSavedSearch at\n%s", m.funcDeleteSavedSearchOrigin)
+	}
+
+	if !m.DeleteSavedSearchMock.invocationsDone() && afterDeleteSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.DeleteSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSavedSearchMock.expectedInvocations), m.DeleteSavedSearchMock.expectedInvocationsOrigin, afterDeleteSavedSearchCounter)
+	}
+}
+
+type mUseCaseMockGetListing struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetListingExpectation
+	expectations       []*UseCaseMockGetListingExpectation
+
+	callArgs []*UseCaseMockGetListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetListingExpectation specifies expectation struct of the UseCase.GetListing
+type UseCaseMockGetListingExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetListingParams
+	paramPtrs          *UseCaseMockGetListingParamPtrs
+	expectationOrigins UseCaseMockGetListingExpectationOrigins
+	results            *UseCaseMockGetListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetListingParams contains parameters of the UseCase.GetListing
+type UseCaseMockGetListingParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// UseCaseMockGetListingParamPtrs contains pointers to parameters of the UseCase.GetListing
+type UseCaseMockGetListingParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// UseCaseMockGetListingResults contains results of the UseCase.GetListing
+type UseCaseMockGetListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockGetListingOrigins contains origins of expectations of the UseCase.GetListing
+type UseCaseMockGetListingExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListing *mUseCaseMockGetListing) Optional() *mUseCaseMockGetListing {
+	mmGetListing.optional = true
+	return mmGetListing
+}
+
+// Expect sets up expected params for UseCase.GetListing
+func (mmGetListing *mUseCaseMockGetListing) Expect(ctx context.Context, userID uint64, id uint64) *mUseCaseMockGetListing {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &UseCaseMockGetListingExpectation{}
+	}
+
+	if mmGetListing.defaultExpectation.paramPtrs != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by ExpectParams functions")
+	}
+
+	mmGetListing.defaultExpectation.params = &UseCaseMockGetListingParams{ctx, userID, id}
+	mmGetListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListing.expectations {
+		if minimock.Equal(e.params, mmGetListing.defaultExpectation.params) {
+			mmGetListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListing.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetListing
+func (mmGetListing *mUseCaseMockGetListing) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetListing {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &UseCaseMockGetListingExpectation{}
+	}
+
+	if mmGetListing.defaultExpectation.params != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Expect")
+	}
+
+	if mmGetListing.defaultExpectation.paramPtrs == nil {
+		mmGetListing.defaultExpectation.paramPtrs = &UseCaseMockGetListingParamPtrs{}
+	}
+	mmGetListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListing
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.GetListing
+func (mmGetListing *mUseCaseMockGetListing) ExpectUserIDParam2(userID uint64) *mUseCaseMockGetListing {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &UseCaseMockGetListingExpectation{}
+	}
+
+	if mmGetListing.defaultExpectation.params != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Expect")
+	}
+
+	if mmGetListing.defaultExpectation.paramPtrs == nil {
+		mmGetListing.defaultExpectation.paramPtrs = &UseCaseMockGetListingParamPtrs{}
+	}
+	mmGetListing.defaultExpectation.paramPtrs.userID = &userID
+	mmGetListing.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetListing
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.GetListing
+func (mmGetListing *mUseCaseMockGetListing) ExpectIdParam3(id uint64) *mUseCaseMockGetListing {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &UseCaseMockGetListingExpectation{}
+	}
+
+	if mmGetListing.defaultExpectation.params != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Expect")
+	}
+
+	if mmGetListing.defaultExpectation.paramPtrs == nil {
+		mmGetListing.defaultExpectation.paramPtrs = &UseCaseMockGetListingParamPtrs{}
+	}
+	mmGetListing.defaultExpectation.paramPtrs.id = &id
+	mmGetListing.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetListing
+func (mmGetListing *mUseCaseMockGetListing) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mUseCaseMockGetListing {
+	if mmGetListing.mock.inspectFuncGetListing != nil {
+		mmGetListing.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetListing")
+	}
+
+	mmGetListing.mock.inspectFuncGetListing = f
+
+	return mmGetListing
+}
+
+// Return sets up results that will be returned by UseCase.GetListing
+func (mmGetListing *mUseCaseMockGetListing) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &UseCaseMockGetListingExpectation{mock: mmGetListing.mock}
+	}
+	mmGetListing.defaultExpectation.results = &UseCaseMockGetListingResults{lp1, err}
+	mmGetListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListing.mock
+}
+
+// Set uses given function f to mock the UseCase.GetListing method
+func (mmGetListing *mUseCaseMockGetListing) Set(f func(ctx context.Context, userID uint64, id uint64) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmGetListing.defaultExpectation != nil {
+		mmGetListing.mock.t.Fatalf("Default expectation is already set for the UseCase.GetListing method")
+	}
+
+	if len(mmGetListing.expectations) > 0 {
+		mmGetListing.mock.t.Fatalf("Some expectations are already set for the UseCase.GetListing method")
+	}
+
+	mmGetListing.mock.funcGetListing = f
+	mmGetListing.mock.funcGetListingOrigin = minimock.CallerInfo(1)
+	return mmGetListing.mock
+}
+
+// When sets expectation for the UseCase.GetListing which will trigger the result defined by the following
+// Then helper
+func (mmGetListing *mUseCaseMockGetListing) When(ctx context.Context, userID uint64, id uint64) *UseCaseMockGetListingExpectation {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("UseCaseMock.GetListing mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetListingExpectation{
+		mock:               mmGetListing.mock,
+		params:             &UseCaseMockGetListingParams{ctx, userID, id},
+		expectationOrigins: UseCaseMockGetListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListing.expectations = append(mmGetListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetListing return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetListingExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetListing should be invoked
+func (mmGetListing *mUseCaseMockGetListing) Times(n uint64) *mUseCaseMockGetListing {
+	if n == 0 {
+		mmGetListing.mock.t.Fatalf("Times of UseCaseMock.GetListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListing.expectedInvocations, n)
+	mmGetListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListing
+}
+
+func (mmGetListing *mUseCaseMockGetListing) invocationsDone() bool {
+	if len(mmGetListing.expectations) == 0 && mmGetListing.defaultExpectation == nil && mmGetListing.mock.funcGetListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListing.mock.afterGetListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListing implements mm_listing.UseCase
+func (mmGetListing *UseCaseMock) GetListing(ctx context.Context, userID uint64, id uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmGetListing.beforeGetListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListing.afterGetListingCounter, 1)
+
+	mmGetListing.t.Helper()
+
+	if mmGetListing.inspectFuncGetListing != nil {
+		mmGetListing.inspectFuncGetListing(ctx, userID, id)
+	}
+
+	mm_params := UseCaseMockGetListingParams{ctx, userID, id}
+
+	// Record call args
+	mmGetListing.GetListingMock.mutex.Lock()
+	mmGetListing.GetListingMock.callArgs = append(mmGetListing.GetListingMock.callArgs, &mm_params)
+	mmGetListing.GetListingMock.mutex.Unlock()
+
+	for _, e := range mmGetListing.GetListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetListing.GetListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListing.GetListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListing.GetListingMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListing.GetListingMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetListingParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListing.t.Errorf("UseCaseMock.GetListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListing.GetListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetListing.t.Errorf("UseCaseMock.GetListing got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListing.GetListingMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetListing.t.Errorf("UseCaseMock.GetListing got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListing.GetListingMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListing.t.Errorf("UseCaseMock.GetListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListing.GetListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListing.GetListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListing.t.Fatal("No results are set for the UseCaseMock.GetListing")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetListing.funcGetListing != nil {
+		return mmGetListing.funcGetListing(ctx, userID, id)
+	}
+	mmGetListing.t.Fatalf("Unexpected call to UseCaseMock.GetListing. %v %v %v", ctx, userID, id)
+	return
+}
+
+// GetListingAfterCounter returns a count of finished UseCaseMock.GetListing invocations
+func (mmGetListing *UseCaseMock) GetListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListing.afterGetListingCounter)
+}
+
+// GetListingBeforeCounter returns a count of UseCaseMock.GetListing invocations
+func (mmGetListing *UseCaseMock) GetListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListing.beforeGetListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListing *mUseCaseMockGetListing) Calls() []*UseCaseMockGetListingParams {
+	mmGetListing.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetListingParams, len(mmGetListing.callArgs))
+	copy(argCopy, mmGetListing.callArgs)
+
+	mmGetListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingDone returns true if the count of the GetListing invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetListingDone() bool {
+	if m.GetListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingMock.invocationsDone()
+}
+
+// MinimockGetListingInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetListingInspect() {
+	for _, e := range m.GetListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingCounter := mm_atomic.LoadUint64(&m.afterGetListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingMock.defaultExpectation != nil && afterGetListingCounter < 1 {
+		if m.GetListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetListing at\n%s", m.GetListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetListing at\n%s with params: %#v", m.GetListingMock.defaultExpectation.expectationOrigins.origin, *m.GetListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListing != nil && afterGetListingCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetListing at\n%s", m.funcGetListingOrigin)
+	}
+
+	if !m.GetListingMock.invocationsDone() && afterGetListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingMock.expectedInvocations), m.GetListingMock.expectedInvocationsOrigin, afterGetListingCounter)
+	}
+}
+
+type mUseCaseMockGetListingFacets struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetListingFacetsExpectation
+	expectations       []*UseCaseMockGetListingFacetsExpectation
+
+	callArgs []*UseCaseMockGetListingFacetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetListingFacetsExpectation specifies expectation struct of the UseCase.GetListingFacets
+type UseCaseMockGetListingFacetsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetListingFacetsParams
+	paramPtrs          *UseCaseMockGetListingFacetsParamPtrs
+	expectationOrigins UseCaseMockGetListingFacetsExpectationOrigins
+	results            *UseCaseMockGetListingFacetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetListingFacetsParams contains parameters of the UseCase.GetListingFacets
+type UseCaseMockGetListingFacetsParams struct {
+	ctx          context.Context
+	filter       *entity.ListingFilter
+	currency     string
+	priceBuckets []float32
+}
+
+// UseCaseMockGetListingFacetsParamPtrs contains pointers to parameters of the UseCase.GetListingFacets
+type UseCaseMockGetListingFacetsParamPtrs struct {
+	ctx          *context.Context
+	filter       **entity.ListingFilter
+	currency     *string
+	priceBuckets *[]float32
+}
+
+// UseCaseMockGetListingFacetsResults contains results of the UseCase.GetListingFacets
+type UseCaseMockGetListingFacetsResults struct {
+	lp1 *entity.ListingFacets
+	err error
+}
+
+// UseCaseMockGetListingFacetsOrigins contains origins of expectations of the UseCase.GetListingFacets
+type UseCaseMockGetListingFacetsExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originFilter       string
+	originCurrency     string
+	originPriceBuckets string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Optional() *mUseCaseMockGetListingFacets {
+	mmGetListingFacets.optional = true
+	return mmGetListingFacets
+}
+
+// Expect sets up expected params for UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Expect(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) *mUseCaseMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &UseCaseMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by ExpectParams functions")
+	}
+
+	mmGetListingFacets.defaultExpectation.params = &UseCaseMockGetListingFacetsParams{ctx, filter, currency, priceBuckets}
+	mmGetListingFacets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListingFacets.expectations {
+		if minimock.Equal(e.params, mmGetListingFacets.defaultExpectation.params) {
+			mmGetListingFacets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListingFacets.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListingFacets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &UseCaseMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &UseCaseMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// ExpectFilterParam2 sets up expected param filter for UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) ExpectFilterParam2(filter *entity.ListingFilter) *mUseCaseMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &UseCaseMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &UseCaseMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.filter = &filter
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// ExpectCurrencyParam3 sets up expected param currency for UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) ExpectCurrencyParam3(currency string) *mUseCaseMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &UseCaseMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &UseCaseMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.currency = &currency
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originCurrency = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// ExpectPriceBucketsParam4 sets up expected param priceBuckets for UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) ExpectPriceBucketsParam4(priceBuckets []float32) *mUseCaseMockGetListingFacets {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &UseCaseMockGetListingFacetsExpectation{}
+	}
+
+	if mmGetListingFacets.defaultExpectation.params != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Expect")
+	}
+
+	if mmGetListingFacets.defaultExpectation.paramPtrs == nil {
+		mmGetListingFacets.defaultExpectation.paramPtrs = &UseCaseMockGetListingFacetsParamPtrs{}
+	}
+	mmGetListingFacets.defaultExpectation.paramPtrs.priceBuckets = &priceBuckets
+	mmGetListingFacets.defaultExpectation.expectationOrigins.originPriceBuckets = minimock.CallerInfo(1)
+
+	return mmGetListingFacets
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Inspect(f func(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32)) *mUseCaseMockGetListingFacets {
+	if mmGetListingFacets.mock.inspectFuncGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetListingFacets")
+	}
+
+	mmGetListingFacets.mock.inspectFuncGetListingFacets = f
+
+	return mmGetListingFacets
+}
+
+// Return sets up results that will be returned by UseCase.GetListingFacets
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Return(lp1 *entity.ListingFacets, err error) *UseCaseMock {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	if mmGetListingFacets.defaultExpectation == nil {
+		mmGetListingFacets.defaultExpectation = &UseCaseMockGetListingFacetsExpectation{mock: mmGetListingFacets.mock}
+	}
+	mmGetListingFacets.defaultExpectation.results = &UseCaseMockGetListingFacetsResults{lp1, err}
+	mmGetListingFacets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListingFacets.mock
+}
+
+// Set uses given function f to mock the UseCase.GetListingFacets method
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Set(f func(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) (lp1 *entity.ListingFacets, err error)) *UseCaseMock {
+	if mmGetListingFacets.defaultExpectation != nil {
+		mmGetListingFacets.mock.t.Fatalf("Default expectation is already set for the UseCase.GetListingFacets method")
+	}
+
+	if len(mmGetListingFacets.expectations) > 0 {
+		mmGetListingFacets.mock.t.Fatalf("Some expectations are already set for the UseCase.GetListingFacets method")
+	}
+
+	mmGetListingFacets.mock.funcGetListingFacets = f
+	mmGetListingFacets.mock.funcGetListingFacetsOrigin = minimock.CallerInfo(1)
+	return mmGetListingFacets.mock
+}
+
+// When sets expectation for the UseCase.GetListingFacets which will trigger the result defined by the following
+// Then helper
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) When(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) *UseCaseMockGetListingFacetsExpectation {
+	if mmGetListingFacets.mock.funcGetListingFacets != nil {
+		mmGetListingFacets.mock.t.Fatalf("UseCaseMock.GetListingFacets mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetListingFacetsExpectation{
+		mock:               mmGetListingFacets.mock,
+		params:             &UseCaseMockGetListingFacetsParams{ctx, filter, currency, priceBuckets},
+		expectationOrigins: UseCaseMockGetListingFacetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListingFacets.expectations = append(mmGetListingFacets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetListingFacets return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetListingFacetsExpectation) Then(lp1 *entity.ListingFacets, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetListingFacetsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetListingFacets should be invoked
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Times(n uint64) *mUseCaseMockGetListingFacets {
+	if n == 0 {
+		mmGetListingFacets.mock.t.Fatalf("Times of UseCaseMock.GetListingFacets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListingFacets.expectedInvocations, n)
+	mmGetListingFacets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListingFacets
+}
+
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) invocationsDone() bool {
+	if len(mmGetListingFacets.expectations) == 0 && mmGetListingFacets.defaultExpectation == nil && mmGetListingFacets.mock.funcGetListingFacets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListingFacets.mock.afterGetListingFacetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListingFacets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListingFacets implements mm_listing.UseCase
+func (mmGetListingFacets *UseCaseMock) GetListingFacets(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) (lp1 *entity.ListingFacets, err error) {
+	mm_atomic.AddUint64(&mmGetListingFacets.beforeGetListingFacetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListingFacets.afterGetListingFacetsCounter, 1)
+
+	mmGetListingFacets.t.Helper()
+
+	if mmGetListingFacets.inspectFuncGetListingFacets != nil {
+		mmGetListingFacets.inspectFuncGetListingFacets(ctx, filter, currency, priceBuckets)
+	}
+
+	mm_params := UseCaseMockGetListingFacetsParams{ctx, filter, currency, priceBuckets}
+
+	// Record call args
+	mmGetListingFacets.GetListingFacetsMock.mutex.Lock()
+	mmGetListingFacets.GetListingFacetsMock.callArgs = append(mmGetListingFacets.GetListingFacetsMock.callArgs, &mm_params)
+	mmGetListingFacets.GetListingFacetsMock.mutex.Unlock()
+
+	for _, e := range mmGetListingFacets.GetListingFacetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetListingFacets.GetListingFacetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListingFacets.GetListingFacetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListingFacets.GetListingFacetsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListingFacets.GetListingFacetsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetListingFacetsParams{ctx, filter, currency, priceBuckets}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListingFacets.t.Errorf("UseCaseMock.GetListingFacets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetListingFacets.t.Errorf("UseCaseMock.GetListingFacets got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.currency != nil && !minimock.Equal(*mm_want_ptrs.currency, mm_got.currency) {
+				mmGetListingFacets.t.Errorf("UseCaseMock.GetListingFacets got unexpected parameter currency, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originCurrency, *mm_want_ptrs.currency, mm_got.currency, minimock.Diff(*mm_want_ptrs.currency, mm_got.currency))
+			}
+
+			if mm_want_ptrs.priceBuckets != nil && !minimock.Equal(*mm_want_ptrs.priceBuckets, mm_got.priceBuckets) {
+				mmGetListingFacets.t.Errorf("UseCaseMock.GetListingFacets got unexpected parameter priceBuckets, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.originPriceBuckets, *mm_want_ptrs.priceBuckets, mm_got.priceBuckets, minimock.Diff(*mm_want_ptrs.priceBuckets, mm_got.priceBuckets))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListingFacets.t.Errorf("UseCaseMock.GetListingFacets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListingFacets.GetListingFacetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListingFacets.GetListingFacetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListingFacets.t.Fatal("No results are set for the UseCaseMock.GetListingFacets")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetListingFacets.funcGetListingFacets != nil {
+		return mmGetListingFacets.funcGetListingFacets(ctx, filter, currency, priceBuckets)
+	}
+	mmGetListingFacets.t.Fatalf("Unexpected call to UseCaseMock.GetListingFacets. %v %v %v %v", ctx, filter, currency, priceBuckets)
+	return
+}
+
+// GetListingFacetsAfterCounter returns a count of finished UseCaseMock.GetListingFacets invocations
+func (mmGetListingFacets *UseCaseMock) GetListingFacetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingFacets.afterGetListingFacetsCounter)
+}
+
+// GetListingFacetsBeforeCounter returns a count of UseCaseMock.GetListingFacets invocations
+func (mmGetListingFacets *UseCaseMock) GetListingFacetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListingFacets.beforeGetListingFacetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetListingFacets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListingFacets *mUseCaseMockGetListingFacets) Calls() []*UseCaseMockGetListingFacetsParams {
+	mmGetListingFacets.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetListingFacetsParams, len(mmGetListingFacets.callArgs))
+	copy(argCopy, mmGetListingFacets.callArgs)
+
+	mmGetListingFacets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingFacetsDone returns true if the count of the GetListingFacets invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetListingFacetsDone() bool {
+	if m.GetListingFacetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingFacetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingFacetsMock.invocationsDone()
+}
+
+// MinimockGetListingFacetsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetListingFacetsInspect() {
+	for _, e := range m.GetListingFacetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetListingFacets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingFacetsCounter := mm_atomic.LoadUint64(&m.afterGetListingFacetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingFacetsMock.defaultExpectation != nil && afterGetListingFacetsCounter < 1 {
+		if m.GetListingFacetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetListingFacets at\n%s", m.GetListingFacetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetListingFacets at\n%s with params: %#v", m.GetListingFacetsMock.defaultExpectation.expectationOrigins.origin, *m.GetListingFacetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListingFacets != nil && afterGetListingFacetsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetListingFacets at\n%s", m.funcGetListingFacetsOrigin)
+	}
+
+	if !m.GetListingFacetsMock.invocationsDone() && afterGetListingFacetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetListingFacets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingFacetsMock.expectedInvocations), m.GetListingFacetsMock.expectedInvocationsOrigin, afterGetListingFacetsCounter)
+	}
+}
+
+type mUseCaseMockGetListings struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetListingsExpectation
+	expectations       []*UseCaseMockGetListingsExpectation
+
+	callArgs []*UseCaseMockGetListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetListingsExpectation specifies expectation struct of the UseCase.GetListings
+type UseCaseMockGetListingsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetListingsParams
+	paramPtrs          *UseCaseMockGetListingsParamPtrs
+	expectationOrigins UseCaseMockGetListingsExpectationOrigins
+	results            *UseCaseMockGetListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetListingsParams contains parameters of the UseCase.GetListings
+type UseCaseMockGetListingsParams struct {
+	ctx    context.Context
+	filter *entity.ListingFilter
+}
+
+// UseCaseMockGetListingsParamPtrs contains pointers to parameters of the UseCase.GetListings
+type UseCaseMockGetListingsParamPtrs struct {
+	ctx    *context.Context
+	filter **entity.ListingFilter
+}
+
+// UseCaseMockGetListingsResults contains results of the UseCase.GetListings
+type UseCaseMockGetListingsResults struct {
+	lp1 *entity.ListingPage
+	err error
+}
+
+// UseCaseMockGetListingsOrigins contains origins of expectations of the UseCase.GetListings
+type UseCaseMockGetListingsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListings *mUseCaseMockGetListings) Optional() *mUseCaseMockGetListings {
+	mmGetListings.optional = true
+	return mmGetListings
+}
+
+// Expect sets up expected params for UseCase.GetListings
+func (mmGetListings *mUseCaseMockGetListings) Expect(ctx context.Context, filter *entity.ListingFilter) *mUseCaseMockGetListings {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	}
+
+	if mmGetListings.defaultExpectation.paramPtrs != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by ExpectParams functions")
+	}
+
+	mmGetListings.defaultExpectation.params = &UseCaseMockGetListingsParams{ctx, filter}
+	mmGetListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListings.expectations {
+		if minimock.Equal(e.params, mmGetListings.defaultExpectation.params) {
+			mmGetListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListings.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetListings
+func (mmGetListings *mUseCaseMockGetListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetListings {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	}
+
+	if mmGetListings.defaultExpectation.params != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+	}
+
+	if mmGetListings.defaultExpectation.paramPtrs == nil {
+		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	}
+	mmGetListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListings
+}
+
+// ExpectFilterParam2 sets up expected param filter for UseCase.GetListings
+func (mmGetListings *mUseCaseMockGetListings) ExpectFilterParam2(filter *entity.ListingFilter) *mUseCaseMockGetListings {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	}
+
+	if mmGetListings.defaultExpectation.params != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+	}
+
+	if mmGetListings.defaultExpectation.paramPtrs == nil {
+		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	}
+	mmGetListings.defaultExpectation.paramPtrs.filter = &filter
+	mmGetListings.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetListings
+func (mmGetListings *mUseCaseMockGetListings) Inspect(f func(ctx context.Context, filter *entity.ListingFilter)) *mUseCaseMockGetListings {
+	if mmGetListings.mock.inspectFuncGetListings != nil {
+		mmGetListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetListings")
+	}
+
+	mmGetListings.mock.inspectFuncGetListings = f
+
+	return mmGetListings
+}
+
+// Return sets up results that will be returned by UseCase.GetListings
+func (mmGetListings *mUseCaseMockGetListings) Return(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+	}
+
+	if mmGetListings.defaultExpectation == nil {
+		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{mock: mmGetListings.mock}
+	}
+	mmGetListings.defaultExpectation.results = &UseCaseMockGetListingsResults{lp1, err}
+	mmGetListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListings.mock
+}
+
+// Set uses given function f to mock the UseCase.GetListings method
+func (mmGetListings *mUseCaseMockGetListings) Set(f func(ctx context.Context, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)) *UseCaseMock {
+	if mmGetListings.defaultExpectation != nil {
+		mmGetListings.mock.t.Fatalf("Default expectation is already set for the UseCase.GetListings method")
+	}
+
+	if len(mmGetListings.expectations) > 0 {
+		mmGetListings.mock.t.Fatalf("Some expectations are already set for the UseCase.GetListings method")
+	}
+
+	mmGetListings.mock.funcGetListings = f
+	mmGetListings.mock.funcGetListingsOrigin = minimock.CallerInfo(1)
+	return mmGetListings.mock
+}
+
+// When sets expectation for the UseCase.GetListings which will trigger the result defined by the following
+// Then helper
+func (mmGetListings *mUseCaseMockGetListings) When(ctx context.Context, filter *entity.ListingFilter) *UseCaseMockGetListingsExpectation {
+	if mmGetListings.mock.funcGetListings != nil {
+		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetListingsExpectation{
+		mock:               mmGetListings.mock,
+		params:             &UseCaseMockGetListingsParams{ctx, filter},
+		expectationOrigins: UseCaseMockGetListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListings.expectations = append(mmGetListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetListings return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetListingsExpectation) Then(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetListingsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetListings should be invoked
+func (mmGetListings *mUseCaseMockGetListings) Times(n uint64) *mUseCaseMockGetListings {
+	if n == 0 {
+		mmGetListings.mock.t.Fatalf("Times of UseCaseMock.GetListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListings.expectedInvocations, n)
+	mmGetListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListings
+}
+
+func (mmGetListings *mUseCaseMockGetListings) invocationsDone() bool {
+	if len(mmGetListings.expectations) == 0 && mmGetListings.defaultExpectation == nil && mmGetListings.mock.funcGetListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListings.mock.afterGetListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListings implements mm_listing.UseCase
+func (mmGetListings *UseCaseMock) GetListings(ctx context.Context, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error) {
+	mm_atomic.AddUint64(&mmGetListings.beforeGetListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListings.afterGetListingsCounter, 1)
+
+	mmGetListings.t.Helper()
+
+	if mmGetListings.inspectFuncGetListings != nil {
+		mmGetListings.inspectFuncGetListings(ctx, filter)
+	}
+
+	mm_params := UseCaseMockGetListingsParams{ctx, filter}
+
+	// Record call args
+	mmGetListings.GetListingsMock.mutex.Lock()
+	mmGetListings.GetListingsMock.callArgs = append(mmGetListings.GetListingsMock.callArgs, &mm_params)
+	mmGetListings.GetListingsMock.mutex.Unlock()
+
+	for _, e := range mmGetListings.GetListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetListings.GetListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListings.GetListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListings.GetListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListings.GetListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetListingsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListings.GetListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListings.t.Fatal("No results are set for the UseCaseMock.GetListings")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetListings.funcGetListings != nil {
+		return mmGetListings.funcGetListings(ctx, filter)
+	}
+	mmGetListings.t.Fatalf("Unexpected call to UseCaseMock.GetListings. %v %v", ctx, filter)
+	return
+}
+
+// GetListingsAfterCounter returns a count of finished UseCaseMock.GetListings invocations
+func (mmGetListings *UseCaseMock) GetListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListings.afterGetListingsCounter)
+}
+
+// GetListingsBeforeCounter returns a count of UseCaseMock.GetListings invocations
+func (mmGetListings *UseCaseMock) GetListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListings.beforeGetListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListings *mUseCaseMockGetListings) Calls() []*UseCaseMockGetListingsParams {
+	mmGetListings.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetListingsParams, len(mmGetListings.callArgs))
+	copy(argCopy, mmGetListings.callArgs)
+
+	mmGetListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingsDone returns true if the count of the GetListings invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetListingsDone() bool {
+	if m.GetListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingsMock.invocationsDone()
+}
+
+// MinimockGetListingsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetListingsInspect() {
+	for _, e := range m.GetListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingsCounter := mm_atomic.LoadUint64(&m.afterGetListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingsMock.defaultExpectation != nil && afterGetListingsCounter < 1 {
+		if m.GetListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s", m.GetListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s with params: %#v", m.GetListingsMock.defaultExpectation.expectationOrigins.origin, *m.GetListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListings != nil && afterGetListingsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s", m.funcGetListingsOrigin)
+	}
+
+	if !m.GetListingsMock.invocationsDone() && afterGetListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingsMock.expectedInvocations), m.GetListingsMock.expectedInvocationsOrigin, afterGetListingsCounter)
+	}
+}
+
+type mUseCaseMockGetMyListings struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetMyListingsExpectation
+	expectations       []*UseCaseMockGetMyListingsExpectation
+
+	callArgs []*UseCaseMockGetMyListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetMyListingsExpectation specifies expectation struct of the UseCase.GetMyListings
+type UseCaseMockGetMyListingsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetMyListingsParams
+	paramPtrs          *UseCaseMockGetMyListingsParamPtrs
+	expectationOrigins UseCaseMockGetMyListingsExpectationOrigins
+	results            *UseCaseMockGetMyListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetMyListingsParams contains parameters of the UseCase.GetMyListings
+type UseCaseMockGetMyListingsParams struct {
+	ctx    context.Context
+	userID uint64
+	filter *entity.ListingFilter
+}
+
+// UseCaseMockGetMyListingsParamPtrs contains pointers to parameters of the UseCase.GetMyListings
+type UseCaseMockGetMyListingsParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	filter **entity.ListingFilter
+}
+
+// UseCaseMockGetMyListingsResults contains results of the UseCase.GetMyListings
+type UseCaseMockGetMyListingsResults struct {
+	lp1 *entity.ListingPage
+	err error
+}
+
+// UseCaseMockGetMyListingsOrigins contains origins of expectations of the UseCase.GetMyListings
+type UseCaseMockGetMyListingsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMyListings *mUseCaseMockGetMyListings) Optional() *mUseCaseMockGetMyListings {
+	mmGetMyListings.optional = true
+	return mmGetMyListings
+}
+
+// Expect sets up expected params for UseCase.GetMyListings
+func (mmGetMyListings *mUseCaseMockGetMyListings) Expect(ctx context.Context, userID uint64, filter *entity.ListingFilter) *mUseCaseMockGetMyListings {
+	if mmGetMyListings.mock.funcGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Set")
+	}
+
+	if mmGetMyListings.defaultExpectation == nil {
+		mmGetMyListings.defaultExpectation = &UseCaseMockGetMyListingsExpectation{}
+	}
+
+	if mmGetMyListings.defaultExpectation.paramPtrs != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by ExpectParams functions")
+	}
+
+	mmGetMyListings.defaultExpectation.params = &UseCaseMockGetMyListingsParams{ctx, userID, filter}
+	mmGetMyListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMyListings.expectations {
+		if minimock.Equal(e.params, mmGetMyListings.defaultExpectation.params) {
+			mmGetMyListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMyListings.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMyListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetMyListings
+func (mmGetMyListings *mUseCaseMockGetMyListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetMyListings {
+	if mmGetMyListings.mock.funcGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Set")
+	}
+
+	if mmGetMyListings.defaultExpectation == nil {
+		mmGetMyListings.defaultExpectation = &UseCaseMockGetMyListingsExpectation{}
+	}
+
+	if mmGetMyListings.defaultExpectation.params != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Expect")
+	}
+
+	if mmGetMyListings.defaultExpectation.paramPtrs == nil {
+		mmGetMyListings.defaultExpectation.paramPtrs = &UseCaseMockGetMyListingsParamPtrs{}
+	}
+	mmGetMyListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMyListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMyListings
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.GetMyListings
+func (mmGetMyListings *mUseCaseMockGetMyListings) ExpectUserIDParam2(userID uint64) *mUseCaseMockGetMyListings {
+	if mmGetMyListings.mock.funcGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Set")
+	}
+
+	if mmGetMyListings.defaultExpectation == nil {
+		mmGetMyListings.defaultExpectation = &UseCaseMockGetMyListingsExpectation{}
+	}
+
+	if mmGetMyListings.defaultExpectation.params != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Expect")
+	}
+
+	if mmGetMyListings.defaultExpectation.paramPtrs == nil {
+		mmGetMyListings.defaultExpectation.paramPtrs = &UseCaseMockGetMyListingsParamPtrs{}
+	}
+	mmGetMyListings.defaultExpectation.paramPtrs.userID = &userID
+	mmGetMyListings.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetMyListings
+}
+
+// ExpectFilterParam3 sets up expected param filter for UseCase.GetMyListings
+func (mmGetMyListings *mUseCaseMockGetMyListings) ExpectFilterParam3(filter *entity.ListingFilter) *mUseCaseMockGetMyListings {
+	if mmGetMyListings.mock.funcGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Set")
+	}
+
+	if mmGetMyListings.defaultExpectation == nil {
+		mmGetMyListings.defaultExpectation = &UseCaseMockGetMyListingsExpectation{}
+	}
+
+	if mmGetMyListings.defaultExpectation.params != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Expect")
+	}
+
+	if mmGetMyListings.defaultExpectation.paramPtrs == nil {
+		mmGetMyListings.defaultExpectation.paramPtrs = &UseCaseMockGetMyListingsParamPtrs{}
+	}
+	mmGetMyListings.defaultExpectation.paramPtrs.filter = &filter
+	mmGetMyListings.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetMyListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetMyListings
+func (mmGetMyListings *mUseCaseMockGetMyListings) Inspect(f func(ctx context.Context, userID uint64, filter *entity.ListingFilter)) *mUseCaseMockGetMyListings {
+	if mmGetMyListings.mock.inspectFuncGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetMyListings")
+	}
+
+	mmGetMyListings.mock.inspectFuncGetMyListings = f
+
+	return mmGetMyListings
+}
+
+// Return sets up results that will be returned by UseCase.GetMyListings
+func (mmGetMyListings *mUseCaseMockGetMyListings) Return(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	if mmGetMyListings.mock.funcGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Set")
+	}
+
+	if mmGetMyListings.defaultExpectation == nil {
+		mmGetMyListings.defaultExpectation = &UseCaseMockGetMyListingsExpectation{mock: mmGetMyListings.mock}
+	}
+	mmGetMyListings.defaultExpectation.results = &UseCaseMockGetMyListingsResults{lp1, err}
+	mmGetMyListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMyListings.mock
+}
+
+// Set uses given function f to mock the UseCase.GetMyListings method
+func (mmGetMyListings *mUseCaseMockGetMyListings) Set(f func(ctx context.Context, userID uint64, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)) *UseCaseMock {
+	if mmGetMyListings.defaultExpectation != nil {
+		mmGetMyListings.mock.t.Fatalf("Default expectation is already set for the UseCase.GetMyListings method")
+	}
+
+	if len(mmGetMyListings.expectations) > 0 {
+		mmGetMyListings.mock.t.Fatalf("Some expectations are already set for the UseCase.GetMyListings method")
+	}
+
+	mmGetMyListings.mock.funcGetMyListings = f
+	mmGetMyListings.mock.funcGetMyListingsOrigin = minimock.CallerInfo(1)
+	return mmGetMyListings.mock
+}
+
+// When sets expectation for the UseCase.GetMyListings which will trigger the result defined by the following
+// Then helper
+func (mmGetMyListings *mUseCaseMockGetMyListings) When(ctx context.Context, userID uint64, filter *entity.ListingFilter) *UseCaseMockGetMyListingsExpectation {
+	if mmGetMyListings.mock.funcGetMyListings != nil {
+		mmGetMyListings.mock.t.Fatalf("UseCaseMock.GetMyListings mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetMyListingsExpectation{
+		mock:               mmGetMyListings.mock,
+		params:             &UseCaseMockGetMyListingsParams{ctx, userID, filter},
+		expectationOrigins: UseCaseMockGetMyListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMyListings.expectations = append(mmGetMyListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetMyListings return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetMyListingsExpectation) Then(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetMyListingsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetMyListings should be invoked
+func (mmGetMyListings *mUseCaseMockGetMyListings) Times(n uint64) *mUseCaseMockGetMyListings {
+	if n == 0 {
+		mmGetMyListings.mock.t.Fatalf("Times of UseCaseMock.GetMyListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMyListings.expectedInvocations, n)
+	mmGetMyListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMyListings
+}
+
+func (mmGetMyListings *mUseCaseMockGetMyListings) invocationsDone() bool {
+	if len(mmGetMyListings.expectations) == 0 && mmGetMyListings.defaultExpectation == nil && mmGetMyListings.mock.funcGetMyListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMyListings.mock.afterGetMyListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMyListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMyListings implements mm_listing.UseCase
+func (mmGetMyListings *UseCaseMock) GetMyListings(ctx context.Context, userID uint64, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error) {
+	mm_atomic.AddUint64(&mmGetMyListings.beforeGetMyListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMyListings.afterGetMyListingsCounter, 1)
+
+	mmGetMyListings.t.Helper()
+
+	if mmGetMyListings.inspectFuncGetMyListings != nil {
+		mmGetMyListings.inspectFuncGetMyListings(ctx, userID, filter)
+	}
+
+	mm_params := UseCaseMockGetMyListingsParams{ctx, userID, filter}
+
+	// Record call args
+	mmGetMyListings.GetMyListingsMock.mutex.Lock()
+	mmGetMyListings.GetMyListingsMock.callArgs = append(mmGetMyListings.GetMyListingsMock.callArgs, &mm_params)
+	mmGetMyListings.GetMyListingsMock.mutex.Unlock()
+
+	for _, e := range mmGetMyListings.GetMyListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetMyListings.GetMyListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMyListings.GetMyListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMyListings.GetMyListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMyListings.GetMyListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetMyListingsParams{ctx, userID, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMyListings.t.Errorf("UseCaseMock.GetMyListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMyListings.GetMyListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetMyListings.t.Errorf("UseCaseMock.GetMyListings got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMyListings.GetMyListingsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetMyListings.t.Errorf("UseCaseMock.GetMyListings got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMyListings.GetMyListingsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMyListings.t.Errorf("UseCaseMock.GetMyListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMyListings.GetMyListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMyListings.GetMyListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMyListings.t.Fatal("No results are set for the UseCaseMock.GetMyListings")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetMyListings.funcGetMyListings != nil {
+		return mmGetMyListings.funcGetMyListings(ctx, userID, filter)
+	}
+	mmGetMyListings.t.Fatalf("Unexpected call to UseCaseMock.GetMyListings. %v %v %v", ctx, userID, filter)
+	return
+}
+
+// GetMyListingsAfterCounter returns a count of finished UseCaseMock.GetMyListings invocations
+func (mmGetMyListings *UseCaseMock) GetMyListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMyListings.afterGetMyListingsCounter)
+}
+
+// GetMyListingsBeforeCounter returns a count of UseCaseMock.GetMyListings invocations
+func (mmGetMyListings *UseCaseMock) GetMyListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMyListings.beforeGetMyListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetMyListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMyListings *mUseCaseMockGetMyListings) Calls() []*UseCaseMockGetMyListingsParams {
+	mmGetMyListings.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetMyListingsParams, len(mmGetMyListings.callArgs))
+	copy(argCopy, mmGetMyListings.callArgs)
+
+	mmGetMyListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMyListingsDone returns true if the count of the GetMyListings invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetMyListingsDone() bool {
+	if m.GetMyListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMyListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMyListingsMock.invocationsDone()
+}
+
+// MinimockGetMyListingsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetMyListingsInspect() {
+	for _, e := range m.GetMyListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetMyListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMyListingsCounter := mm_atomic.LoadUint64(&m.afterGetMyListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMyListingsMock.defaultExpectation != nil && afterGetMyListingsCounter < 1 {
+		if m.GetMyListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetMyListings at\n%s", m.GetMyListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetMyListings at\n%s with params: %#v", m.GetMyListingsMock.defaultExpectation.expectationOrigins.origin, *m.GetMyListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMyListings != nil && afterGetMyListingsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetMyListings at\n%s", m.funcGetMyListingsOrigin)
+	}
+
+	if !m.GetMyListingsMock.invocationsDone() && afterGetMyListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetMyListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMyListingsMock.expectedInvocations), m.GetMyListingsMock.expectedInvocationsOrigin, afterGetMyListingsCounter)
+	}
+}
+
+type mUseCaseMockGetSavedSearch struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetSavedSearchExpectation
+	expectations       []*UseCaseMockGetSavedSearchExpectation
+
+	callArgs []*UseCaseMockGetSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetSavedSearchExpectation specifies expectation struct of the UseCase.GetSavedSearch
+type UseCaseMockGetSavedSearchExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetSavedSearchParams
+	paramPtrs          *UseCaseMockGetSavedSearchParamPtrs
+	expectationOrigins UseCaseMockGetSavedSearchExpectationOrigins
+	results            *UseCaseMockGetSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetSavedSearchParams contains parameters of the UseCase.GetSavedSearch
+type UseCaseMockGetSavedSearchParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// UseCaseMockGetSavedSearchParamPtrs contains pointers to parameters of the UseCase.GetSavedSearch
+type UseCaseMockGetSavedSearchParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// UseCaseMockGetSavedSearchResults contains results of the UseCase.GetSavedSearch
+type UseCaseMockGetSavedSearchResults struct {
+	sp1 *entity.SavedSearch
+	err error
+}
+
+// UseCaseMockGetSavedSearchOrigins contains origins of expectations of the UseCase.GetSavedSearch
+type UseCaseMockGetSavedSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Optional() *mUseCaseMockGetSavedSearch {
+	mmGetSavedSearch.optional = true
+	return mmGetSavedSearch
+}
+
+// Expect sets up expected params for UseCase.GetSavedSearch
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Expect(ctx context.Context, userID uint64, id uint64) *mUseCaseMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &UseCaseMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by ExpectParams functions")
+	}
+
+	mmGetSavedSearch.defaultExpectation.params = &UseCaseMockGetSavedSearchParams{ctx, userID, id}
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSavedSearch.expectations {
+		if minimock.Equal(e.params, mmGetSavedSearch.defaultExpectation.params) {
+			mmGetSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetSavedSearch
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &UseCaseMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.params != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Expect")
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmGetSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockGetSavedSearchParamPtrs{}
+	}
+	mmGetSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSavedSearch
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.GetSavedSearch
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) ExpectUserIDParam2(userID uint64) *mUseCaseMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &UseCaseMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.params != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Expect")
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmGetSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockGetSavedSearchParamPtrs{}
+	}
+	mmGetSavedSearch.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSavedSearch
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.GetSavedSearch
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) ExpectIdParam3(id uint64) *mUseCaseMockGetSavedSearch {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &UseCaseMockGetSavedSearchExpectation{}
+	}
+
+	if mmGetSavedSearch.defaultExpectation.params != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Expect")
+	}
+
+	if mmGetSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmGetSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockGetSavedSearchParamPtrs{}
+	}
+	mmGetSavedSearch.defaultExpectation.paramPtrs.id = &id
+	mmGetSavedSearch.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetSavedSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetSavedSearch
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mUseCaseMockGetSavedSearch {
+	if mmGetSavedSearch.mock.inspectFuncGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetSavedSearch")
+	}
+
+	mmGetSavedSearch.mock.inspectFuncGetSavedSearch = f
+
+	return mmGetSavedSearch
+}
+
+// Return sets up results that will be returned by UseCase.GetSavedSearch
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Return(sp1 *entity.SavedSearch, err error) *UseCaseMock {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Set")
+	}
+
+	if mmGetSavedSearch.defaultExpectation == nil {
+		mmGetSavedSearch.defaultExpectation = &UseCaseMockGetSavedSearchExpectation{mock: mmGetSavedSearch.mock}
+	}
+	mmGetSavedSearch.defaultExpectation.results = &UseCaseMockGetSavedSearchResults{sp1, err}
+	mmGetSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSavedSearch.mock
+}
+
+// Set uses given function f to mock the UseCase.GetSavedSearch method
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Set(f func(ctx context.Context, userID uint64, id uint64) (sp1 *entity.SavedSearch, err error)) *UseCaseMock {
+	if mmGetSavedSearch.defaultExpectation != nil {
+		mmGetSavedSearch.mock.t.Fatalf("Default expectation is already set for the UseCase.GetSavedSearch method")
+	}
+
+	if len(mmGetSavedSearch.expectations) > 0 {
+		mmGetSavedSearch.mock.t.Fatalf("Some expectations are already set for the UseCase.GetSavedSearch method")
+	}
+
+	mmGetSavedSearch.mock.funcGetSavedSearch = f
+	mmGetSavedSearch.mock.funcGetSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmGetSavedSearch.mock
+}
+
+// When sets expectation for the UseCase.GetSavedSearch which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) When(ctx context.Context, userID uint64, id uint64) *UseCaseMockGetSavedSearchExpectation {
+	if mmGetSavedSearch.mock.funcGetSavedSearch != nil {
+		mmGetSavedSearch.mock.t.Fatalf("UseCaseMock.GetSavedSearch mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetSavedSearchExpectation{
+		mock:               mmGetSavedSearch.mock,
+		params:             &UseCaseMockGetSavedSearchParams{ctx, userID, id},
+		expectationOrigins: UseCaseMockGetSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSavedSearch.expectations = append(mmGetSavedSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetSavedSearch return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetSavedSearchExpectation) Then(sp1 *entity.SavedSearch, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetSavedSearchResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetSavedSearch should be invoked
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Times(n uint64) *mUseCaseMockGetSavedSearch {
+	if n == 0 {
+		mmGetSavedSearch.mock.t.Fatalf("Times of UseCaseMock.GetSavedSearch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSavedSearch.expectedInvocations, n)
+	mmGetSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedSearch
+}
+
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) invocationsDone() bool {
+	if len(mmGetSavedSearch.expectations) == 0 && mmGetSavedSearch.defaultExpectation == nil && mmGetSavedSearch.mock.funcGetSavedSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSavedSearch.mock.afterGetSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSavedSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSavedSearch implements mm_listing.UseCase
+func (mmGetSavedSearch *UseCaseMock) GetSavedSearch(ctx context.Context, userID uint64, id uint64) (sp1 *entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmGetSavedSearch.beforeGetSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedSearch.afterGetSavedSearchCounter, 1)
+
+	mmGetSavedSearch.t.Helper()
+
+	if mmGetSavedSearch.inspectFuncGetSavedSearch != nil {
+		mmGetSavedSearch.inspectFuncGetSavedSearch(ctx, userID, id)
+	}
+
+	mm_params := UseCaseMockGetSavedSearchParams{ctx, userID, id}
+
+	// Record call args
+	mmGetSavedSearch.GetSavedSearchMock.mutex.Lock()
+	mmGetSavedSearch.GetSavedSearchMock.callArgs = append(mmGetSavedSearch.GetSavedSearchMock.callArgs, &mm_params)
+	mmGetSavedSearch.GetSavedSearchMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedSearch.GetSavedSearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetSavedSearch.GetSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetSavedSearchParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSavedSearch.t.Errorf("UseCaseMock.GetSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSavedSearch.t.Errorf("UseCaseMock.GetSavedSearch got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetSavedSearch.t.Errorf("UseCaseMock.GetSavedSearch got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedSearch.t.Errorf("UseCaseMock.GetSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedSearch.GetSavedSearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedSearch.t.Fatal("No results are set for the UseCaseMock.GetSavedSearch")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetSavedSearch.funcGetSavedSearch != nil {
+		return mmGetSavedSearch.funcGetSavedSearch(ctx, userID, id)
+	}
+	mmGetSavedSearch.t.Fatalf("Unexpected call to UseCaseMock.GetSavedSearch. %v %v %v", ctx, userID, id)
+	return
+}
+
+// GetSavedSearchAfterCounter returns a count of finished UseCaseMock.GetSavedSearch invocations
+func (mmGetSavedSearch *UseCaseMock) GetSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedSearch.afterGetSavedSearchCounter)
+}
+
+// GetSavedSearchBeforeCounter returns a count of UseCaseMock.GetSavedSearch invocations
+func (mmGetSavedSearch *UseCaseMock) GetSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedSearch.beforeGetSavedSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetSavedSearch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedSearch *mUseCaseMockGetSavedSearch) Calls() []*UseCaseMockGetSavedSearchParams {
+	mmGetSavedSearch.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetSavedSearchParams, len(mmGetSavedSearch.callArgs))
+	copy(argCopy, mmGetSavedSearch.callArgs)
+
+	mmGetSavedSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedSearchDone returns true if the count of the GetSavedSearch invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetSavedSearchDone() bool {
+	if m.GetSavedSearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSavedSearchMock.invocationsDone()
+}
+
+// MinimockGetSavedSearchInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetSavedSearchInspect() {
+	for _, e := range m.GetSavedSearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSavedSearchCounter := mm_atomic.LoadUint64(&m.afterGetSavedSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedSearchMock.defaultExpectation != nil && afterGetSavedSearchCounter < 1 {
+		if m.GetSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetSavedSearch at\n%s", m.GetSavedSearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetSavedSearch at\n%s with params: %#v", m.GetSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.GetSavedSearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedSearch != nil && afterGetSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetSavedSearch at\n%s", m.funcGetSavedSearchOrigin)
+	}
+
+	if !m.GetSavedSearchMock.invocationsDone() && afterGetSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSavedSearchMock.expectedInvocations), m.GetSavedSearchMock.expectedInvocationsOrigin, afterGetSavedSearchCounter)
+	}
+}
+
+type mUseCaseMockGetUserListings struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetUserListingsExpectation
+	expectations       []*UseCaseMockGetUserListingsExpectation
+
+	callArgs []*UseCaseMockGetUserListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetUserListingsExpectation specifies expectation struct of the UseCase.GetUserListings
+type UseCaseMockGetUserListingsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetUserListingsParams
+	paramPtrs          *UseCaseMockGetUserListingsParamPtrs
+	expectationOrigins UseCaseMockGetUserListingsExpectationOrigins
+	results            *UseCaseMockGetUserListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetUserListingsParams contains parameters of the UseCase.GetUserListings
+type UseCaseMockGetUserListingsParams struct {
+	ctx      context.Context
+	username string
+	filter   *entity.ListingFilter
+}
+
+// UseCaseMockGetUserListingsParamPtrs contains pointers to parameters of the UseCase.GetUserListings
+type UseCaseMockGetUserListingsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	filter   **entity.ListingFilter
+}
+
+// UseCaseMockGetUserListingsResults contains results of the UseCase.GetUserListings
+type UseCaseMockGetUserListingsResults struct {
+	lp1 *entity.ListingPage
+	err error
+}
+
+// UseCaseMockGetUserListingsOrigins contains origins of expectations of the UseCase.GetUserListings
+type UseCaseMockGetUserListingsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originFilter   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserListings *mUseCaseMockGetUserListings) Optional() *mUseCaseMockGetUserListings {
+	mmGetUserListings.optional = true
+	return mmGetUserListings
+}
+
+// Expect sets up expected params for UseCase.GetUserListings
+func (mmGetUserListings *mUseCaseMockGetUserListings) Expect(ctx context.Context, username string, filter *entity.ListingFilter) *mUseCaseMockGetUserListings {
+	if mmGetUserListings.mock.funcGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Set")
+	}
+
+	if mmGetUserListings.defaultExpectation == nil {
+		mmGetUserListings.defaultExpectation = &UseCaseMockGetUserListingsExpectation{}
+	}
+
+	if mmGetUserListings.defaultExpectation.paramPtrs != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserListings.defaultExpectation.params = &UseCaseMockGetUserListingsParams{ctx, username, filter}
+	mmGetUserListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserListings.expectations {
+		if minimock.Equal(e.params, mmGetUserListings.defaultExpectation.params) {
+			mmGetUserListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserListings.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetUserListings
+func (mmGetUserListings *mUseCaseMockGetUserListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetUserListings {
+	if mmGetUserListings.mock.funcGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Set")
+	}
+
+	if mmGetUserListings.defaultExpectation == nil {
+		mmGetUserListings.defaultExpectation = &UseCaseMockGetUserListingsExpectation{}
+	}
+
+	if mmGetUserListings.defaultExpectation.params != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Expect")
+	}
+
+	if mmGetUserListings.defaultExpectation.paramPtrs == nil {
+		mmGetUserListings.defaultExpectation.paramPtrs = &UseCaseMockGetUserListingsParamPtrs{}
+	}
+	mmGetUserListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserListings
+}
+
+// ExpectUsernameParam2 sets up expected param username for UseCase.GetUserListings
+func (mmGetUserListings *mUseCaseMockGetUserListings) ExpectUsernameParam2(username string) *mUseCaseMockGetUserListings {
+	if mmGetUserListings.mock.funcGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Set")
+	}
+
+	if mmGetUserListings.defaultExpectation == nil {
+		mmGetUserListings.defaultExpectation = &UseCaseMockGetUserListingsExpectation{}
+	}
+
+	if mmGetUserListings.defaultExpectation.params != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Expect")
+	}
+
+	if mmGetUserListings.defaultExpectation.paramPtrs == nil {
+		mmGetUserListings.defaultExpectation.paramPtrs = &UseCaseMockGetUserListingsParamPtrs{}
+	}
+	mmGetUserListings.defaultExpectation.paramPtrs.username = &username
+	mmGetUserListings.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserListings
+}
+
+// ExpectFilterParam3 sets up expected param filter for UseCase.GetUserListings
+func (mmGetUserListings *mUseCaseMockGetUserListings) ExpectFilterParam3(filter *entity.ListingFilter) *mUseCaseMockGetUserListings {
+	if mmGetUserListings.mock.funcGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Set")
+	}
+
+	if mmGetUserListings.defaultExpectation == nil {
+		mmGetUserListings.defaultExpectation = &UseCaseMockGetUserListingsExpectation{}
+	}
+
+	if mmGetUserListings.defaultExpectation.params != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Expect")
+	}
+
+	if mmGetUserListings.defaultExpectation.paramPtrs == nil {
+		mmGetUserListings.defaultExpectation.paramPtrs = &UseCaseMockGetUserListingsParamPtrs{}
+	}
+	mmGetUserListings.defaultExpectation.paramPtrs.filter = &filter
+	mmGetUserListings.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetUserListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetUserListings
+func (mmGetUserListings *mUseCaseMockGetUserListings) Inspect(f func(ctx context.Context, username string, filter *entity.ListingFilter)) *mUseCaseMockGetUserListings {
+	if mmGetUserListings.mock.inspectFuncGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetUserListings")
+	}
+
+	mmGetUserListings.mock.inspectFuncGetUserListings = f
+
+	return mmGetUserListings
+}
+
+// Return sets up results that will be returned by UseCase.GetUserListings
+func (mmGetUserListings *mUseCaseMockGetUserListings) Return(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	if mmGetUserListings.mock.funcGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Set")
+	}
+
+	if mmGetUserListings.defaultExpectation == nil {
+		mmGetUserListings.defaultExpectation = &UseCaseMockGetUserListingsExpectation{mock: mmGetUserListings.mock}
+	}
+	mmGetUserListings.defaultExpectation.results = &UseCaseMockGetUserListingsResults{lp1, err}
+	mmGetUserListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserListings.mock
+}
+
+// Set uses given function f to mock the UseCase.GetUserListings method
+func (mmGetUserListings *mUseCaseMockGetUserListings) Set(f func(ctx context.Context, username string, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error)) *UseCaseMock {
+	if mmGetUserListings.defaultExpectation != nil {
+		mmGetUserListings.mock.t.Fatalf("Default expectation is already set for the UseCase.GetUserListings method")
+	}
+
+	if len(mmGetUserListings.expectations) > 0 {
+		mmGetUserListings.mock.t.Fatalf("Some expectations are already set for the UseCase.GetUserListings method")
+	}
+
+	mmGetUserListings.mock.funcGetUserListings = f
+	mmGetUserListings.mock.funcGetUserListingsOrigin = minimock.CallerInfo(1)
+	return mmGetUserListings.mock
+}
+
+// When sets expectation for the UseCase.GetUserListings which will trigger the result defined by the following
+// Then helper
+func (mmGetUserListings *mUseCaseMockGetUserListings) When(ctx context.Context, username string, filter *entity.ListingFilter) *UseCaseMockGetUserListingsExpectation {
+	if mmGetUserListings.mock.funcGetUserListings != nil {
+		mmGetUserListings.mock.t.Fatalf("UseCaseMock.GetUserListings mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetUserListingsExpectation{
+		mock:               mmGetUserListings.mock,
+		params:             &UseCaseMockGetUserListingsParams{ctx, username, filter},
+		expectationOrigins: UseCaseMockGetUserListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserListings.expectations = append(mmGetUserListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetUserListings return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetUserListingsExpectation) Then(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetUserListingsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetUserListings should be invoked
+func (mmGetUserListings *mUseCaseMockGetUserListings) Times(n uint64) *mUseCaseMockGetUserListings {
+	if n == 0 {
+		mmGetUserListings.mock.t.Fatalf("Times of UseCaseMock.GetUserListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserListings.expectedInvocations, n)
+	mmGetUserListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserListings
+}
+
+func (mmGetUserListings *mUseCaseMockGetUserListings) invocationsDone() bool {
+	if len(mmGetUserListings.expectations) == 0 && mmGetUserListings.defaultExpectation == nil && mmGetUserListings.mock.funcGetUserListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserListings.mock.afterGetUserListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserListings implements mm_listing.UseCase
+func (mmGetUserListings *UseCaseMock) GetUserListings(ctx context.Context, username string, filter *entity.ListingFilter) (lp1 *entity.ListingPage, err error) {
+	mm_atomic.AddUint64(&mmGetUserListings.beforeGetUserListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserListings.afterGetUserListingsCounter, 1)
+
+	mmGetUserListings.t.Helper()
+
+	if mmGetUserListings.inspectFuncGetUserListings != nil {
+		mmGetUserListings.inspectFuncGetUserListings(ctx, username, filter)
+	}
+
+	mm_params := UseCaseMockGetUserListingsParams{ctx, username, filter}
+
+	// Record call args
+	mmGetUserListings.GetUserListingsMock.mutex.Lock()
+	mmGetUserListings.GetUserListingsMock.callArgs = append(mmGetUserListings.GetUserListingsMock.callArgs, &mm_params)
+	mmGetUserListings.GetUserListingsMock.mutex.Unlock()
+
+	for _, e := range mmGetUserListings.GetUserListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmGetUserListings.GetUserListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserListings.GetUserListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserListings.GetUserListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserListings.GetUserListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetUserListingsParams{ctx, username, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserListings.t.Errorf("UseCaseMock.GetUserListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserListings.GetUserListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserListings.t.Errorf("UseCaseMock.GetUserListings got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserListings.GetUserListingsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetUserListings.t.Errorf("UseCaseMock.GetUserListings got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserListings.GetUserListingsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserListings.t.Errorf("UseCaseMock.GetUserListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserListings.GetUserListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserListings.GetUserListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserListings.t.Fatal("No results are set for the UseCaseMock.GetUserListings")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmGetUserListings.funcGetUserListings != nil {
+		return mmGetUserListings.funcGetUserListings(ctx, username, filter)
+	}
+	mmGetUserListings.t.Fatalf("Unexpected call to UseCaseMock.GetUserListings. %v %v %v", ctx, username, filter)
+	return
+}
+
+// GetUserListingsAfterCounter returns a count of finished UseCaseMock.GetUserListings invocations
+func (mmGetUserListings *UseCaseMock) GetUserListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserListings.afterGetUserListingsCounter)
+}
+
+// GetUserListingsBeforeCounter returns a count of UseCaseMock.GetUserListings invocations
+func (mmGetUserListings *UseCaseMock) GetUserListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserListings.beforeGetUserListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetUserListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserListings *mUseCaseMockGetUserListings) Calls() []*UseCaseMockGetUserListingsParams {
+	mmGetUserListings.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetUserListingsParams, len(mmGetUserListings.callArgs))
+	copy(argCopy, mmGetUserListings.callArgs)
+
+	mmGetUserListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserListingsDone returns true if the count of the GetUserListings invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetUserListingsDone() bool {
+	if m.GetUserListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserListingsMock.invocationsDone()
+}
+
+// MinimockGetUserListingsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetUserListingsInspect() {
+	for _, e := range m.GetUserListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetUserListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserListingsCounter := mm_atomic.LoadUint64(&m.afterGetUserListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserListingsMock.defaultExpectation != nil && afterGetUserListingsCounter < 1 {
+		if m.GetUserListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetUserListings at\n%s", m.GetUserListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetUserListings at\n%s with params: %#v", m.GetUserListingsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserListings != nil && afterGetUserListingsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetUserListings at\n%s", m.funcGetUserListingsOrigin)
+	}
+
+	if !m.GetUserListingsMock.invocationsDone() && afterGetUserListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetUserListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserListingsMock.expectedInvocations), m.GetUserListingsMock.expectedInvocationsOrigin, afterGetUserListingsCounter)
+	}
+}
+
+type mUseCaseMockListFavorites struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockListFavoritesExpectation
+	expectations       []*UseCaseMockListFavoritesExpectation
+
+	callArgs []*UseCaseMockListFavoritesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockListFavoritesExpectation specifies expectation struct of the UseCase.ListFavorites
+type UseCaseMockListFavoritesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockListFavoritesParams
+	paramPtrs          *UseCaseMockListFavoritesParamPtrs
+	expectationOrigins UseCaseMockListFavoritesExpectationOrigins
+	results            *UseCaseMockListFavoritesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockListFavoritesParams contains parameters of the UseCase.ListFavorites
+type UseCaseMockListFavoritesParams struct {
+	ctx     context.Context
+	userID  uint64
+	page    uint32
+	perPage uint32
+}
+
+// UseCaseMockListFavoritesParamPtrs contains pointers to parameters of the UseCase.ListFavorites
+type UseCaseMockListFavoritesParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	page    *uint32
+	perPage *uint32
+}
+
+// UseCaseMockListFavoritesResults contains results of the UseCase.ListFavorites
+type UseCaseMockListFavoritesResults struct {
+	lp1 *entity.ListingPage
+	err error
+}
+
+// UseCaseMockListFavoritesOrigins contains origins of expectations of the UseCase.ListFavorites
+type UseCaseMockListFavoritesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originPage    string
+	originPerPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListFavorites *mUseCaseMockListFavorites) Optional() *mUseCaseMockListFavorites {
+	mmListFavorites.optional = true
+	return mmListFavorites
+}
+
+// Expect sets up expected params for UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) Expect(ctx context.Context, userID uint64, page uint32, perPage uint32) *mUseCaseMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &UseCaseMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by ExpectParams functions")
+	}
+
+	mmListFavorites.defaultExpectation.params = &UseCaseMockListFavoritesParams{ctx, userID, page, perPage}
+	mmListFavorites.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListFavorites.expectations {
+		if minimock.Equal(e.params, mmListFavorites.defaultExpectation.params) {
+			mmListFavorites.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListFavorites.defaultExpectation.params)
+		}
+	}
+
+	return mmListFavorites
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) ExpectCtxParam1(ctx context.Context) *mUseCaseMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &UseCaseMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &UseCaseMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListFavorites.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) ExpectUserIDParam2(userID uint64) *mUseCaseMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &UseCaseMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &UseCaseMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.userID = &userID
+	mmListFavorites.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// ExpectPageParam3 sets up expected param page for UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) ExpectPageParam3(page uint32) *mUseCaseMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &UseCaseMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &UseCaseMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.page = &page
+	mmListFavorites.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// ExpectPerPageParam4 sets up expected param perPage for UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) ExpectPerPageParam4(perPage uint32) *mUseCaseMockListFavorites {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &UseCaseMockListFavoritesExpectation{}
+	}
+
+	if mmListFavorites.defaultExpectation.params != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Expect")
+	}
+
+	if mmListFavorites.defaultExpectation.paramPtrs == nil {
+		mmListFavorites.defaultExpectation.paramPtrs = &UseCaseMockListFavoritesParamPtrs{}
+	}
+	mmListFavorites.defaultExpectation.paramPtrs.perPage = &perPage
+	mmListFavorites.defaultExpectation.expectationOrigins.originPerPage = minimock.CallerInfo(1)
+
+	return mmListFavorites
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) Inspect(f func(ctx context.Context, userID uint64, page uint32, perPage uint32)) *mUseCaseMockListFavorites {
+	if mmListFavorites.mock.inspectFuncListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ListFavorites")
+	}
+
+	mmListFavorites.mock.inspectFuncListFavorites = f
+
+	return mmListFavorites
+}
+
+// Return sets up results that will be returned by UseCase.ListFavorites
+func (mmListFavorites *mUseCaseMockListFavorites) Return(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	if mmListFavorites.defaultExpectation == nil {
+		mmListFavorites.defaultExpectation = &UseCaseMockListFavoritesExpectation{mock: mmListFavorites.mock}
+	}
+	mmListFavorites.defaultExpectation.results = &UseCaseMockListFavoritesResults{lp1, err}
+	mmListFavorites.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListFavorites.mock
+}
+
+// Set uses given function f to mock the UseCase.ListFavorites method
+func (mmListFavorites *mUseCaseMockListFavorites) Set(f func(ctx context.Context, userID uint64, page uint32, perPage uint32) (lp1 *entity.ListingPage, err error)) *UseCaseMock {
+	if mmListFavorites.defaultExpectation != nil {
+		mmListFavorites.mock.t.Fatalf("Default expectation is already set for the UseCase.ListFavorites method")
+	}
+
+	if len(mmListFavorites.expectations) > 0 {
+		mmListFavorites.mock.t.Fatalf("Some expectations are already set for the UseCase.ListFavorites method")
+	}
+
+	mmListFavorites.mock.funcListFavorites = f
+	mmListFavorites.mock.funcListFavoritesOrigin = minimock.CallerInfo(1)
+	return mmListFavorites.mock
+}
+
+// When sets expectation for the UseCase.ListFavorites which will trigger the result defined by the following
+// Then helper
+func (mmListFavorites *mUseCaseMockListFavorites) When(ctx context.Context, userID uint64, page uint32, perPage uint32) *UseCaseMockListFavoritesExpectation {
+	if mmListFavorites.mock.funcListFavorites != nil {
+		mmListFavorites.mock.t.Fatalf("UseCaseMock.ListFavorites mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockListFavoritesExpectation{
+		mock:               mmListFavorites.mock,
+		params:             &UseCaseMockListFavoritesParams{ctx, userID, page, perPage},
+		expectationOrigins: UseCaseMockListFavoritesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListFavorites.expectations = append(mmListFavorites.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ListFavorites return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockListFavoritesExpectation) Then(lp1 *entity.ListingPage, err error) *UseCaseMock {
+	e.results = &UseCaseMockListFavoritesResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ListFavorites should be invoked
+func (mmListFavorites *mUseCaseMockListFavorites) Times(n uint64) *mUseCaseMockListFavorites {
+	if n == 0 {
+		mmListFavorites.mock.t.Fatalf("Times of UseCaseMock.ListFavorites mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListFavorites.expectedInvocations, n)
+	mmListFavorites.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListFavorites
+}
+
+func (mmListFavorites *mUseCaseMockListFavorites) invocationsDone() bool {
+	if len(mmListFavorites.expectations) == 0 && mmListFavorites.defaultExpectation == nil && mmListFavorites.mock.funcListFavorites == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListFavorites.mock.afterListFavoritesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListFavorites.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListFavorites implements mm_listing.UseCase
+func (mmListFavorites *UseCaseMock) ListFavorites(ctx context.Context, userID uint64, page uint32, perPage uint32) (lp1 *entity.ListingPage, err error) {
+	mm_atomic.AddUint64(&mmListFavorites.beforeListFavoritesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListFavorites.afterListFavoritesCounter, 1)
+
+	mmListFavorites.t.Helper()
+
+	if mmListFavorites.inspectFuncListFavorites != nil {
+		mmListFavorites.inspectFuncListFavorites(ctx, userID, page, perPage)
+	}
+
+	mm_params := UseCaseMockListFavoritesParams{ctx, userID, page, perPage}
+
+	// Record call args
+	mmListFavorites.ListFavoritesMock.mutex.Lock()
+	mmListFavorites.ListFavoritesMock.callArgs = append(mmListFavorites.ListFavoritesMock.callArgs, &mm_params)
+	mmListFavorites.ListFavoritesMock.mutex.Unlock()
+
+	for _, e := range mmListFavorites.ListFavoritesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListFavorites.ListFavoritesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListFavorites.ListFavoritesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListFavorites.ListFavoritesMock.defaultExpectation.params
+		mm_want_ptrs := mmListFavorites.ListFavoritesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockListFavoritesParams{ctx, userID, page, perPage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListFavorites.t.Errorf("UseCaseMock.ListFavorites got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListFavorites.t.Errorf("UseCaseMock.ListFavorites got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListFavorites.t.Errorf("UseCaseMock.ListFavorites got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+			if mm_want_ptrs.perPage != nil && !minimock.Equal(*mm_want_ptrs.perPage, mm_got.perPage) {
+				mmListFavorites.t.Errorf("UseCaseMock.ListFavorites got unexpected parameter perPage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.originPerPage, *mm_want_ptrs.perPage, mm_got.perPage, minimock.Diff(*mm_want_ptrs.perPage, mm_got.perPage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListFavorites.t.Errorf("UseCaseMock.ListFavorites got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListFavorites.ListFavoritesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListFavorites.ListFavoritesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListFavorites.t.Fatal("No results are set for the UseCaseMock.ListFavorites")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListFavorites.funcListFavorites != nil {
+		return mmListFavorites.funcListFavorites(ctx, userID, page, perPage)
+	}
+	mmListFavorites.t.Fatalf("Unexpected call to UseCaseMock.ListFavorites. %v %v %v %v", ctx, userID, page, perPage)
+	return
+}
+
+// ListFavoritesAfterCounter returns a count of finished UseCaseMock.ListFavorites invocations
+func (mmListFavorites *UseCaseMock) ListFavoritesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFavorites.afterListFavoritesCounter)
+}
+
+// ListFavoritesBeforeCounter returns a count of UseCaseMock.ListFavorites invocations
+func (mmListFavorites *UseCaseMock) ListFavoritesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFavorites.beforeListFavoritesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ListFavorites.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListFavorites *mUseCaseMockListFavorites) Calls() []*UseCaseMockListFavoritesParams {
+	mmListFavorites.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockListFavoritesParams, len(mmListFavorites.callArgs))
+	copy(argCopy, mmListFavorites.callArgs)
+
+	mmListFavorites.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListFavoritesDone returns true if the count of the ListFavorites invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockListFavoritesDone() bool {
+	if m.ListFavoritesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListFavoritesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListFavoritesMock.invocationsDone()
+}
+
+// MinimockListFavoritesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockListFavoritesInspect() {
+	for _, e := range m.ListFavoritesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ListFavorites at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListFavoritesCounter := mm_atomic.LoadUint64(&m.afterListFavoritesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListFavoritesMock.defaultExpectation != nil && afterListFavoritesCounter < 1 {
+		if m.ListFavoritesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ListFavorites at\n%s", m.ListFavoritesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ListFavorites at\n%s with params: %#v", m.ListFavoritesMock.defaultExpectation.expectationOrigins.origin, *m.ListFavoritesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListFavorites != nil && afterListFavoritesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ListFavorites at\n%s", m.funcListFavoritesOrigin)
+	}
+
+	if !m.ListFavoritesMock.invocationsDone() && afterListFavoritesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ListFavorites at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListFavoritesMock.expectedInvocations), m.ListFavoritesMock.expectedInvocationsOrigin, afterListFavoritesCounter)
+	}
+}
+
+type mUseCaseMockListSavedSearches struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockListSavedSearchesExpectation
+	expectations       []*UseCaseMockListSavedSearchesExpectation
+
+	callArgs []*UseCaseMockListSavedSearchesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockListSavedSearchesExpectation specifies expectation struct of the UseCase.ListSavedSearches
+type UseCaseMockListSavedSearchesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockListSavedSearchesParams
+	paramPtrs          *UseCaseMockListSavedSearchesParamPtrs
+	expectationOrigins UseCaseMockListSavedSearchesExpectationOrigins
+	results            *UseCaseMockListSavedSearchesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockListSavedSearchesParams contains parameters of the UseCase.ListSavedSearches
+type UseCaseMockListSavedSearchesParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// UseCaseMockListSavedSearchesParamPtrs contains pointers to parameters of the UseCase.ListSavedSearches
+type UseCaseMockListSavedSearchesParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// UseCaseMockListSavedSearchesResults contains results of the UseCase.ListSavedSearches
+type UseCaseMockListSavedSearchesResults struct {
+	spa1 []*entity.SavedSearch
+	err  error
+}
+
+// UseCaseMockListSavedSearchesOrigins contains origins of expectations of the UseCase.ListSavedSearches
+type UseCaseMockListSavedSearchesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Optional() *mUseCaseMockListSavedSearches {
+	mmListSavedSearches.optional = true
+	return mmListSavedSearches
+}
+
+// Expect sets up expected params for UseCase.ListSavedSearches
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Expect(ctx context.Context, userID uint64) *mUseCaseMockListSavedSearches {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &UseCaseMockListSavedSearchesExpectation{}
+	}
+
+	if mmListSavedSearches.defaultExpectation.paramPtrs != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by ExpectParams functions")
+	}
+
+	mmListSavedSearches.defaultExpectation.params = &UseCaseMockListSavedSearchesParams{ctx, userID}
+	mmListSavedSearches.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSavedSearches.expectations {
+		if minimock.Equal(e.params, mmListSavedSearches.defaultExpectation.params) {
+			mmListSavedSearches.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSavedSearches.defaultExpectation.params)
+		}
+	}
+
+	return mmListSavedSearches
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ListSavedSearches
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) ExpectCtxParam1(ctx context.Context) *mUseCaseMockListSavedSearches {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &UseCaseMockListSavedSearchesExpectation{}
+	}
+
+	if mmListSavedSearches.defaultExpectation.params != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Expect")
+	}
+
+	if mmListSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmListSavedSearches.defaultExpectation.paramPtrs = &UseCaseMockListSavedSearchesParamPtrs{}
+	}
+	mmListSavedSearches.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSavedSearches.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSavedSearches
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.ListSavedSearches
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) ExpectUserIDParam2(userID uint64) *mUseCaseMockListSavedSearches {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &UseCaseMockListSavedSearchesExpectation{}
+	}
+
+	if mmListSavedSearches.defaultExpectation.params != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Expect")
+	}
+
+	if mmListSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmListSavedSearches.defaultExpectation.paramPtrs = &UseCaseMockListSavedSearchesParamPtrs{}
+	}
+	mmListSavedSearches.defaultExpectation.paramPtrs.userID = &userID
+	mmListSavedSearches.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSavedSearches
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ListSavedSearches
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Inspect(f func(ctx context.Context, userID uint64)) *mUseCaseMockListSavedSearches {
+	if mmListSavedSearches.mock.inspectFuncListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ListSavedSearches")
+	}
+
+	mmListSavedSearches.mock.inspectFuncListSavedSearches = f
+
+	return mmListSavedSearches
+}
+
+// Return sets up results that will be returned by UseCase.ListSavedSearches
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Return(spa1 []*entity.SavedSearch, err error) *UseCaseMock {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Set")
+	}
+
+	if mmListSavedSearches.defaultExpectation == nil {
+		mmListSavedSearches.defaultExpectation = &UseCaseMockListSavedSearchesExpectation{mock: mmListSavedSearches.mock}
+	}
+	mmListSavedSearches.defaultExpectation.results = &UseCaseMockListSavedSearchesResults{spa1, err}
+	mmListSavedSearches.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSavedSearches.mock
+}
+
+// Set uses given function f to mock the UseCase.ListSavedSearches method
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Set(f func(ctx context.Context, userID uint64) (spa1 []*entity.SavedSearch, err error)) *UseCaseMock {
+	if mmListSavedSearches.defaultExpectation != nil {
+		mmListSavedSearches.mock.t.Fatalf("Default expectation is already set for the UseCase.ListSavedSearches method")
+	}
+
+	if len(mmListSavedSearches.expectations) > 0 {
+		mmListSavedSearches.mock.t.Fatalf("Some expectations are already set for the UseCase.ListSavedSearches method")
+	}
+
+	mmListSavedSearches.mock.funcListSavedSearches = f
+	mmListSavedSearches.mock.funcListSavedSearchesOrigin = minimock.CallerInfo(1)
+	return mmListSavedSearches.mock
+}
+
+// When sets expectation for the UseCase.ListSavedSearches which will trigger the result defined by the following
+// Then helper
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) When(ctx context.Context, userID uint64) *UseCaseMockListSavedSearchesExpectation {
+	if mmListSavedSearches.mock.funcListSavedSearches != nil {
+		mmListSavedSearches.mock.t.Fatalf("UseCaseMock.ListSavedSearches mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockListSavedSearchesExpectation{
+		mock:               mmListSavedSearches.mock,
+		params:             &UseCaseMockListSavedSearchesParams{ctx, userID},
+		expectationOrigins: UseCaseMockListSavedSearchesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSavedSearches.expectations = append(mmListSavedSearches.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ListSavedSearches return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockListSavedSearchesExpectation) Then(spa1 []*entity.SavedSearch, err error) *UseCaseMock {
+	e.results = &UseCaseMockListSavedSearchesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ListSavedSearches should be invoked
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Times(n uint64) *mUseCaseMockListSavedSearches {
+	if n == 0 {
+		mmListSavedSearches.mock.t.Fatalf("Times of UseCaseMock.ListSavedSearches mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSavedSearches.expectedInvocations, n)
+	mmListSavedSearches.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSavedSearches
+}
+
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) invocationsDone() bool {
+	if len(mmListSavedSearches.expectations) == 0 && mmListSavedSearches.defaultExpectation == nil && mmListSavedSearches.mock.funcListSavedSearches == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSavedSearches.mock.afterListSavedSearchesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSavedSearches.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSavedSearches implements mm_listing.UseCase
+func (mmListSavedSearches *UseCaseMock) ListSavedSearches(ctx context.Context, userID uint64) (spa1 []*entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmListSavedSearches.beforeListSavedSearchesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSavedSearches.afterListSavedSearchesCounter, 1)
+
+	mmListSavedSearches.t.Helper()
+
+	if mmListSavedSearches.inspectFuncListSavedSearches != nil {
+		mmListSavedSearches.inspectFuncListSavedSearches(ctx, userID)
+	}
+
+	mm_params := UseCaseMockListSavedSearchesParams{ctx, userID}
+
+	// Record call args
+	mmListSavedSearches.ListSavedSearchesMock.mutex.Lock()
+	mmListSavedSearches.ListSavedSearchesMock.callArgs = append(mmListSavedSearches.ListSavedSearchesMock.callArgs, &mm_params)
+	mmListSavedSearches.ListSavedSearchesMock.mutex.Unlock()
+
+	for _, e := range mmListSavedSearches.ListSavedSearchesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListSavedSearches.ListSavedSearchesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.params
+		mm_want_ptrs := mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockListSavedSearchesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSavedSearches.t.Errorf("UseCaseMock.ListSavedSearches got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSavedSearches.t.Errorf("UseCaseMock.ListSavedSearches got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSavedSearches.t.Errorf("UseCaseMock.ListSavedSearches got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSavedSearches.ListSavedSearchesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSavedSearches.t.Fatal("No results are set for the UseCaseMock.ListSavedSearches")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListSavedSearches.funcListSavedSearches != nil {
+		return mmListSavedSearches.funcListSavedSearches(ctx, userID)
+	}
+	mmListSavedSearches.t.Fatalf("Unexpected call to UseCaseMock.ListSavedSearches. %v %v", ctx, userID)
+	return
+}
+
+// ListSavedSearchesAfterCounter returns a count of finished UseCaseMock.ListSavedSearches invocations
+func (mmListSavedSearches *UseCaseMock) ListSavedSearchesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSavedSearches.afterListSavedSearchesCounter)
+}
+
+// ListSavedSearchesBeforeCounter returns a count of UseCaseMock.ListSavedSearches invocations
+func (mmListSavedSearches *UseCaseMock) ListSavedSearchesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSavedSearches.beforeListSavedSearchesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ListSavedSearches.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSavedSearches *mUseCaseMockListSavedSearches) Calls() []*UseCaseMockListSavedSearchesParams {
+	mmListSavedSearches.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockListSavedSearchesParams, len(mmListSavedSearches.callArgs))
+	copy(argCopy, mmListSavedSearches.callArgs)
+
+	mmListSavedSearches.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSavedSearchesDone returns true if the count of the ListSavedSearches invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockListSavedSearchesDone() bool {
+	if m.ListSavedSearchesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSavedSearchesMock.invocationsDone()
+}
+
+// MinimockListSavedSearchesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockListSavedSearchesInspect() {
+	for _, e := range m.ListSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ListSavedSearches at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSavedSearchesCounter := mm_atomic.LoadUint64(&m.afterListSavedSearchesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSavedSearchesMock.defaultExpectation != nil && afterListSavedSearchesCounter < 1 {
+		if m.ListSavedSearchesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ListSavedSearches at\n%s", m.ListSavedSearchesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ListSavedSearches at\n%s with params: %#v", m.ListSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *m.ListSavedSearchesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSavedSearches != nil && afterListSavedSearchesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ListSavedSearches at\n%s", m.funcListSavedSearchesOrigin)
+	}
+
+	if !m.ListSavedSearchesMock.invocationsDone() && afterListSavedSearchesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ListSavedSearches at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSavedSearchesMock.expectedInvocations), m.ListSavedSearchesMock.expectedInvocationsOrigin, afterListSavedSearchesCounter)
+	}
+}
+
+type mUseCaseMockMatchSavedSearches struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockMatchSavedSearchesExpectation
+	expectations       []*UseCaseMockMatchSavedSearchesExpectation
+
+	callArgs []*UseCaseMockMatchSavedSearchesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockMatchSavedSearchesExpectation specifies expectation struct of the UseCase.MatchSavedSearches
+type UseCaseMockMatchSavedSearchesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockMatchSavedSearchesParams
+	paramPtrs          *UseCaseMockMatchSavedSearchesParamPtrs
+	expectationOrigins UseCaseMockMatchSavedSearchesExpectationOrigins
+	results            *UseCaseMockMatchSavedSearchesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockMatchSavedSearchesParams contains parameters of the UseCase.MatchSavedSearches
+type UseCaseMockMatchSavedSearchesParams struct {
+	ctx context.Context
+}
+
+// UseCaseMockMatchSavedSearchesParamPtrs contains pointers to parameters of the UseCase.MatchSavedSearches
+type UseCaseMockMatchSavedSearchesParamPtrs struct {
+	ctx *context.Context
+}
+
+// UseCaseMockMatchSavedSearchesResults contains results of the UseCase.MatchSavedSearches
+type UseCaseMockMatchSavedSearchesResults struct {
+	i1  int
+	err error
+}
+
+// UseCaseMockMatchSavedSearchesOrigins contains origins of expectations of the UseCase.MatchSavedSearches
+type UseCaseMockMatchSavedSearchesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Optional() *mUseCaseMockMatchSavedSearches {
+	mmMatchSavedSearches.optional = true
+	return mmMatchSavedSearches
+}
+
+// Expect sets up expected params for UseCase.MatchSavedSearches
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Expect(ctx context.Context) *mUseCaseMockMatchSavedSearches {
+	if mmMatchSavedSearches.mock.funcMatchSavedSearches != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("UseCaseMock.MatchSavedSearches mock is already set by Set")
+	}
+
+	if mmMatchSavedSearches.defaultExpectation == nil {
+		mmMatchSavedSearches.defaultExpectation = &UseCaseMockMatchSavedSearchesExpectation{}
+	}
+
+	if mmMatchSavedSearches.defaultExpectation.paramPtrs != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("UseCaseMock.MatchSavedSearches mock is already set by ExpectParams functions")
+	}
+
+	mmMatchSavedSearches.defaultExpectation.params = &UseCaseMockMatchSavedSearchesParams{ctx}
+	mmMatchSavedSearches.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMatchSavedSearches.expectations {
+		if minimock.Equal(e.params, mmMatchSavedSearches.defaultExpectation.params) {
+			mmMatchSavedSearches.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMatchSavedSearches.defaultExpectation.params)
+		}
+	}
+
+	return mmMatchSavedSearches
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.MatchSavedSearches
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) ExpectCtxParam1(ctx context.Context) *mUseCaseMockMatchSavedSearches {
+	if mmMatchSavedSearches.mock.funcMatchSavedSearches != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("UseCaseMock.MatchSavedSearches mock is already set by Set")
+	}
+
+	if mmMatchSavedSearches.defaultExpectation == nil {
+		mmMatchSavedSearches.defaultExpectation = &UseCaseMockMatchSavedSearchesExpectation{}
+	}
+
+	if mmMatchSavedSearches.defaultExpectation.params != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("UseCaseMock.MatchSavedSearches mock is already set by Expect")
+	}
+
+	if mmMatchSavedSearches.defaultExpectation.paramPtrs == nil {
+		mmMatchSavedSearches.defaultExpectation.paramPtrs = &UseCaseMockMatchSavedSearchesParamPtrs{}
+	}
+	mmMatchSavedSearches.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMatchSavedSearches.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMatchSavedSearches
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.MatchSavedSearches
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Inspect(f func(ctx context.Context)) *mUseCaseMockMatchSavedSearches {
+	if mmMatchSavedSearches.mock.inspectFuncMatchSavedSearches != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("Inspect function is already set for UseCaseMock.MatchSavedSearches")
+	}
+
+	mmMatchSavedSearches.mock.inspectFuncMatchSavedSearches = f
+
+	return mmMatchSavedSearches
+}
+
+// Return sets up results that will be returned by UseCase.MatchSavedSearches
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Return(i1 int, err error) *UseCaseMock {
+	if mmMatchSavedSearches.mock.funcMatchSavedSearches != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("UseCaseMock.MatchSavedSearches mock is already set by Set")
+	}
+
+	if mmMatchSavedSearches.defaultExpectation == nil {
+		mmMatchSavedSearches.defaultExpectation = &UseCaseMockMatchSavedSearchesExpectation{mock: mmMatchSavedSearches.mock}
+	}
+	mmMatchSavedSearches.defaultExpectation.results = &UseCaseMockMatchSavedSearchesResults{i1, err}
+	mmMatchSavedSearches.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMatchSavedSearches.mock
+}
+
+// Set uses given function f to mock the UseCase.MatchSavedSearches method
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Set(f func(ctx context.Context) (i1 int, err error)) *UseCaseMock {
+	if mmMatchSavedSearches.defaultExpectation != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("Default expectation is already set for the UseCase.MatchSavedSearches method")
+	}
+
+	if len(mmMatchSavedSearches.expectations) > 0 {
+		mmMatchSavedSearches.mock.t.Fatalf("Some expectations are already set for the UseCase.MatchSavedSearches method")
+	}
+
+	mmMatchSavedSearches.mock.funcMatchSavedSearches = f
+	mmMatchSavedSearches.mock.funcMatchSavedSearchesOrigin = minimock.CallerInfo(1)
+	return mmMatchSavedSearches.mock
+}
+
+// When sets expectation for the UseCase.MatchSavedSearches which will trigger the result defined by the following
+// Then helper
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) When(ctx context.Context) *UseCaseMockMatchSavedSearchesExpectation {
+	if mmMatchSavedSearches.mock.funcMatchSavedSearches != nil {
+		mmMatchSavedSearches.mock.t.Fatalf("UseCaseMock.MatchSavedSearches mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockMatchSavedSearchesExpectation{
+		mock:               mmMatchSavedSearches.mock,
+		params:             &UseCaseMockMatchSavedSearchesParams{ctx},
+		expectationOrigins: UseCaseMockMatchSavedSearchesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMatchSavedSearches.expectations = append(mmMatchSavedSearches.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.MatchSavedSearches return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockMatchSavedSearchesExpectation) Then(i1 int, err error) *UseCaseMock {
+	e.results = &UseCaseMockMatchSavedSearchesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.MatchSavedSearches should be invoked
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Times(n uint64) *mUseCaseMockMatchSavedSearches {
+	if n == 0 {
+		mmMatchSavedSearches.mock.t.Fatalf("Times of UseCaseMock.MatchSavedSearches mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMatchSavedSearches.expectedInvocations, n)
+	mmMatchSavedSearches.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMatchSavedSearches
+}
+
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) invocationsDone() bool {
+	if len(mmMatchSavedSearches.expectations) == 0 && mmMatchSavedSearches.defaultExpectation == nil && mmMatchSavedSearches.mock.funcMatchSavedSearches == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMatchSavedSearches.mock.afterMatchSavedSearchesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMatchSavedSearches.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MatchSavedSearches implements mm_listing.UseCase
+func (mmMatchSavedSearches *UseCaseMock) MatchSavedSearches(ctx context.Context) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmMatchSavedSearches.beforeMatchSavedSearchesCounter, 1)
+	defer mm_atomic.AddUint64(&mmMatchSavedSearches.afterMatchSavedSearchesCounter, 1)
+
+	mmMatchSavedSearches.t.Helper()
+
+	if mmMatchSavedSearches.inspectFuncMatchSavedSearches != nil {
+		mmMatchSavedSearches.inspectFuncMatchSavedSearches(ctx)
+	}
+
+	mm_params := UseCaseMockMatchSavedSearchesParams{ctx}
+
+	// Record call args
+	mmMatchSavedSearches.MatchSavedSearchesMock.mutex.Lock()
+	mmMatchSavedSearches.MatchSavedSearchesMock.callArgs = append(mmMatchSavedSearches.MatchSavedSearchesMock.callArgs, &mm_params)
+	mmMatchSavedSearches.MatchSavedSearchesMock.mutex.Unlock()
+
+	for _, e := range mmMatchSavedSearches.MatchSavedSearchesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation.Counter, 1)
+		mm_want := mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation.params
+		mm_want_ptrs := mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockMatchSavedSearchesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMatchSavedSearches.t.Errorf("UseCaseMock.MatchSavedSearches got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMatchSavedSearches.t.Errorf("UseCaseMock.MatchSavedSearches got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMatchSavedSearches.MatchSavedSearchesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMatchSavedSearches.t.Fatal("No results are set for the UseCaseMock.MatchSavedSearches")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmMatchSavedSearches.funcMatchSavedSearches != nil {
+		return mmMatchSavedSearches.funcMatchSavedSearches(ctx)
+	}
+	mmMatchSavedSearches.t.Fatalf("Unexpected call to UseCaseMock.MatchSavedSearches. %v", ctx)
+	return
+}
+
+// MatchSavedSearchesAfterCounter returns a count of finished UseCaseMock.MatchSavedSearches invocations
+func (mmMatchSavedSearches *UseCaseMock) MatchSavedSearchesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMatchSavedSearches.afterMatchSavedSearchesCounter)
+}
+
+// MatchSavedSearchesBeforeCounter returns a count of UseCaseMock.MatchSavedSearches invocations
+func (mmMatchSavedSearches *UseCaseMock) MatchSavedSearchesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMatchSavedSearches.beforeMatchSavedSearchesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.MatchSavedSearches.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMatchSavedSearches *mUseCaseMockMatchSavedSearches) Calls() []*UseCaseMockMatchSavedSearchesParams {
+	mmMatchSavedSearches.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockMatchSavedSearchesParams, len(mmMatchSavedSearches.callArgs))
+	copy(argCopy, mmMatchSavedSearches.callArgs)
+
+	mmMatchSavedSearches.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMatchSavedSearchesDone returns true if the count of the MatchSavedSearches invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockMatchSavedSearchesDone() bool {
+	if m.MatchSavedSearchesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MatchSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MatchSavedSearchesMock.invocationsDone()
+}
+
+// MinimockMatchSavedSearchesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockMatchSavedSearchesInspect() {
+	for _, e := range m.MatchSavedSearchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.MatchSavedSearches at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMatchSavedSearchesCounter := mm_atomic.LoadUint64(&m.afterMatchSavedSearchesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MatchSavedSearchesMock.defaultExpectation != nil && afterMatchSavedSearchesCounter < 1 {
+		if m.MatchSavedSearchesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.MatchSavedSearches at\n%s", m.MatchSavedSearchesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.MatchSavedSearches at\n%s with params: %#v", m.MatchSavedSearchesMock.defaultExpectation.expectationOrigins.origin, *m.MatchSavedSearchesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMatchSavedSearches != nil && afterMatchSavedSearchesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.MatchSavedSearches at\n%s", m.funcMatchSavedSearchesOrigin)
+	}
+
+	if !m.MatchSavedSearchesMock.invocationsDone() && afterMatchSavedSearchesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.MatchSavedSearches at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MatchSavedSearchesMock.expectedInvocations), m.MatchSavedSearchesMock.expectedInvocationsOrigin, afterMatchSavedSearchesCounter)
+	}
+}
+
+type mUseCaseMockPurgeDeletedListings struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockPurgeDeletedListingsExpectation
+	expectations       []*UseCaseMockPurgeDeletedListingsExpectation
+
+	callArgs []*UseCaseMockPurgeDeletedListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockPurgeDeletedListingsExpectation specifies expectation struct of the UseCase.PurgeDeletedListings
+type UseCaseMockPurgeDeletedListingsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockPurgeDeletedListingsParams
+	paramPtrs          *UseCaseMockPurgeDeletedListingsParamPtrs
+	expectationOrigins UseCaseMockPurgeDeletedListingsExpectationOrigins
+	results            *UseCaseMockPurgeDeletedListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockPurgeDeletedListingsParams contains parameters of the UseCase.PurgeDeletedListings
+type UseCaseMockPurgeDeletedListingsParams struct {
+	ctx context.Context
+}
+
+// UseCaseMockPurgeDeletedListingsParamPtrs contains pointers to parameters of the UseCase.PurgeDeletedListings
+type UseCaseMockPurgeDeletedListingsParamPtrs struct {
+	ctx *context.Context
+}
+
+// UseCaseMockPurgeDeletedListingsResults contains results of the UseCase.PurgeDeletedListings
+type UseCaseMockPurgeDeletedListingsResults struct {
+	i1  int64
+	err error
+}
+
+// UseCaseMockPurgeDeletedListingsOrigins contains origins of expectations of the UseCase.PurgeDeletedListings
+type UseCaseMockPurgeDeletedListingsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Optional() *mUseCaseMockPurgeDeletedListings {
+	mmPurgeDeletedListings.optional = true
+	return mmPurgeDeletedListings
+}
+
+// Expect sets up expected params for UseCase.PurgeDeletedListings
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Expect(ctx context.Context) *mUseCaseMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("UseCaseMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &UseCaseMockPurgeDeletedListingsExpectation{}
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("UseCaseMock.PurgeDeletedListings mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedListings.defaultExpectation.params = &UseCaseMockPurgeDeletedListingsParams{ctx}
+	mmPurgeDeletedListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedListings.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedListings.defaultExpectation.params) {
+			mmPurgeDeletedListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedListings.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.PurgeDeletedListings
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("UseCaseMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &UseCaseMockPurgeDeletedListingsExpectation{}
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.params != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("UseCaseMock.PurgeDeletedListings mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedListings.defaultExpectation.paramPtrs = &UseCaseMockPurgeDeletedListingsParamPtrs{}
+	}
+	mmPurgeDeletedListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.PurgeDeletedListings
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Inspect(f func(ctx context.Context)) *mUseCaseMockPurgeDeletedListings {
+	if mmPurgeDeletedListings.mock.inspectFuncPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.PurgeDeletedListings")
+	}
+
+	mmPurgeDeletedListings.mock.inspectFuncPurgeDeletedListings = f
+
+	return mmPurgeDeletedListings
+}
+
+// Return sets up results that will be returned by UseCase.PurgeDeletedListings
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Return(i1 int64, err error) *UseCaseMock {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("UseCaseMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	if mmPurgeDeletedListings.defaultExpectation == nil {
+		mmPurgeDeletedListings.defaultExpectation = &UseCaseMockPurgeDeletedListingsExpectation{mock: mmPurgeDeletedListings.mock}
+	}
+	mmPurgeDeletedListings.defaultExpectation.results = &UseCaseMockPurgeDeletedListingsResults{i1, err}
+	mmPurgeDeletedListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedListings.mock
+}
+
+// Set uses given function f to mock the UseCase.PurgeDeletedListings method
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Set(f func(ctx context.Context) (i1 int64, err error)) *UseCaseMock {
+	if mmPurgeDeletedListings.defaultExpectation != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("Default expectation is already set for the UseCase.PurgeDeletedListings method")
+	}
+
+	if len(mmPurgeDeletedListings.expectations) > 0 {
+		mmPurgeDeletedListings.mock.t.Fatalf("Some expectations are already set for the UseCase.PurgeDeletedListings method")
+	}
+
+	mmPurgeDeletedListings.mock.funcPurgeDeletedListings = f
+	mmPurgeDeletedListings.mock.funcPurgeDeletedListingsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedListings.mock
+}
+
+// When sets expectation for the UseCase.PurgeDeletedListings which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) When(ctx context.Context) *UseCaseMockPurgeDeletedListingsExpectation {
+	if mmPurgeDeletedListings.mock.funcPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.mock.t.Fatalf("UseCaseMock.PurgeDeletedListings mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockPurgeDeletedListingsExpectation{
+		mock:               mmPurgeDeletedListings.mock,
+		params:             &UseCaseMockPurgeDeletedListingsParams{ctx},
+		expectationOrigins: UseCaseMockPurgeDeletedListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedListings.expectations = append(mmPurgeDeletedListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.PurgeDeletedListings return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockPurgeDeletedListingsExpectation) Then(i1 int64, err error) *UseCaseMock {
+	e.results = &UseCaseMockPurgeDeletedListingsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.PurgeDeletedListings should be invoked
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Times(n uint64) *mUseCaseMockPurgeDeletedListings {
+	if n == 0 {
+		mmPurgeDeletedListings.mock.t.Fatalf("Times of UseCaseMock.PurgeDeletedListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedListings.expectedInvocations, n)
+	mmPurgeDeletedListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedListings
+}
+
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) invocationsDone() bool {
+	if len(mmPurgeDeletedListings.expectations) == 0 && mmPurgeDeletedListings.defaultExpectation == nil && mmPurgeDeletedListings.mock.funcPurgeDeletedListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedListings.mock.afterPurgeDeletedListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedListings implements mm_listing.UseCase
+func (mmPurgeDeletedListings *UseCaseMock) PurgeDeletedListings(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedListings.beforePurgeDeletedListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedListings.afterPurgeDeletedListingsCounter, 1)
+
+	mmPurgeDeletedListings.t.Helper()
+
+	if mmPurgeDeletedListings.inspectFuncPurgeDeletedListings != nil {
+		mmPurgeDeletedListings.inspectFuncPurgeDeletedListings(ctx)
+	}
+
+	mm_params := UseCaseMockPurgeDeletedListingsParams{ctx}
+
+	// Record call args
+	mmPurgeDeletedListings.PurgeDeletedListingsMock.mutex.Lock()
+	mmPurgeDeletedListings.PurgeDeletedListingsMock.callArgs = append(mmPurgeDeletedListings.PurgeDeletedListingsMock.callArgs, &mm_params)
+	mmPurgeDeletedListings.PurgeDeletedListingsMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedListings.PurgeDeletedListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockPurgeDeletedListingsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedListings.t.Errorf("UseCaseMock.PurgeDeletedListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedListings.t.Errorf("UseCaseMock.PurgeDeletedListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedListings.PurgeDeletedListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedListings.t.Fatal("No results are set for the UseCaseMock.PurgeDeletedListings")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeletedListings.funcPurgeDeletedListings != nil {
+		return mmPurgeDeletedListings.funcPurgeDeletedListings(ctx)
+	}
+	mmPurgeDeletedListings.t.Fatalf("Unexpected call to UseCaseMock.PurgeDeletedListings. %v", ctx)
+	return
+}
+
+// PurgeDeletedListingsAfterCounter returns a count of finished UseCaseMock.PurgeDeletedListings invocations
+func (mmPurgeDeletedListings *UseCaseMock) PurgeDeletedListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedListings.afterPurgeDeletedListingsCounter)
+}
+
+// PurgeDeletedListingsBeforeCounter returns a count of UseCaseMock.PurgeDeletedListings invocations
+func (mmPurgeDeletedListings *UseCaseMock) PurgeDeletedListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedListings.beforePurgeDeletedListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.PurgeDeletedListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedListings *mUseCaseMockPurgeDeletedListings) Calls() []*UseCaseMockPurgeDeletedListingsParams {
+	mmPurgeDeletedListings.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockPurgeDeletedListingsParams, len(mmPurgeDeletedListings.callArgs))
+	copy(argCopy, mmPurgeDeletedListings.callArgs)
+
+	mmPurgeDeletedListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedListingsDone returns true if the count of the PurgeDeletedListings invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockPurgeDeletedListingsDone() bool {
+	if m.PurgeDeletedListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedListingsMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedListingsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockPurgeDeletedListingsInspect() {
+	for _, e := range m.PurgeDeletedListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.PurgeDeletedListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedListingsCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedListingsMock.defaultExpectation != nil && afterPurgeDeletedListingsCounter < 1 {
+		if m.PurgeDeletedListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.PurgeDeletedListings at\n%s", m.PurgeDeletedListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.PurgeDeletedListings at\n%s with params: %#v", m.PurgeDeletedListingsMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedListings != nil && afterPurgeDeletedListingsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.PurgeDeletedListings at\n%s", m.funcPurgeDeletedListingsOrigin)
+	}
+
+	if !m.PurgeDeletedListingsMock.invocationsDone() && afterPurgeDeletedListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.PurgeDeletedListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedListingsMock.expectedInvocations), m.PurgeDeletedListingsMock.expectedInvocationsOrigin, afterPurgeDeletedListingsCounter)
+	}
+}
+
+type mUseCaseMockRefreshSuggestions struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockRefreshSuggestionsExpectation
+	expectations       []*UseCaseMockRefreshSuggestionsExpectation
+
+	callArgs []*UseCaseMockRefreshSuggestionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockRefreshSuggestionsExpectation specifies expectation struct of the UseCase.RefreshSuggestions
+type UseCaseMockRefreshSuggestionsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockRefreshSuggestionsParams
+	paramPtrs          *UseCaseMockRefreshSuggestionsParamPtrs
+	expectationOrigins UseCaseMockRefreshSuggestionsExpectationOrigins
+	results            *UseCaseMockRefreshSuggestionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockRefreshSuggestionsParams contains parameters of the UseCase.RefreshSuggestions
+type UseCaseMockRefreshSuggestionsParams struct {
+	ctx context.Context
+}
+
+// UseCaseMockRefreshSuggestionsParamPtrs contains pointers to parameters of the UseCase.RefreshSuggestions
+type UseCaseMockRefreshSuggestionsParamPtrs struct {
+	ctx *context.Context
+}
+
+// UseCaseMockRefreshSuggestionsResults contains results of the UseCase.RefreshSuggestions
+type UseCaseMockRefreshSuggestionsResults struct {
+	err error
+}
+
+// UseCaseMockRefreshSuggestionsOrigins contains origins of expectations of the UseCase.RefreshSuggestions
+type UseCaseMockRefreshSuggestionsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Optional() *mUseCaseMockRefreshSuggestions {
+	mmRefreshSuggestions.optional = true
+	return mmRefreshSuggestions
+}
+
+// Expect sets up expected params for UseCase.RefreshSuggestions
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Expect(ctx context.Context) *mUseCaseMockRefreshSuggestions {
+	if mmRefreshSuggestions.mock.funcRefreshSuggestions != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("UseCaseMock.RefreshSuggestions mock is already set by Set")
+	}
+
+	if mmRefreshSuggestions.defaultExpectation == nil {
+		mmRefreshSuggestions.defaultExpectation = &UseCaseMockRefreshSuggestionsExpectation{}
+	}
+
+	if mmRefreshSuggestions.defaultExpectation.paramPtrs != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("UseCaseMock.RefreshSuggestions mock is already set by ExpectParams functions")
+	}
+
+	mmRefreshSuggestions.defaultExpectation.params = &UseCaseMockRefreshSuggestionsParams{ctx}
+	mmRefreshSuggestions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRefreshSuggestions.expectations {
+		if minimock.Equal(e.params, mmRefreshSuggestions.defaultExpectation.params) {
+			mmRefreshSuggestions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshSuggestions.defaultExpectation.params)
+		}
+	}
+
+	return mmRefreshSuggestions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.RefreshSuggestions
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) ExpectCtxParam1(ctx context.Context) *mUseCaseMockRefreshSuggestions {
+	if mmRefreshSuggestions.mock.funcRefreshSuggestions != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("UseCaseMock.RefreshSuggestions mock is already set by Set")
+	}
+
+	if mmRefreshSuggestions.defaultExpectation == nil {
+		mmRefreshSuggestions.defaultExpectation = &UseCaseMockRefreshSuggestionsExpectation{}
+	}
+
+	if mmRefreshSuggestions.defaultExpectation.params != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("UseCaseMock.RefreshSuggestions mock is already set by Expect")
+	}
+
+	if mmRefreshSuggestions.defaultExpectation.paramPtrs == nil {
+		mmRefreshSuggestions.defaultExpectation.paramPtrs = &UseCaseMockRefreshSuggestionsParamPtrs{}
+	}
+	mmRefreshSuggestions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRefreshSuggestions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRefreshSuggestions
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.RefreshSuggestions
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Inspect(f func(ctx context.Context)) *mUseCaseMockRefreshSuggestions {
+	if mmRefreshSuggestions.mock.inspectFuncRefreshSuggestions != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("Inspect function is already set for UseCaseMock.RefreshSuggestions")
+	}
+
+	mmRefreshSuggestions.mock.inspectFuncRefreshSuggestions = f
+
+	return mmRefreshSuggestions
+}
+
+// Return sets up results that will be returned by UseCase.RefreshSuggestions
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Return(err error) *UseCaseMock {
+	if mmRefreshSuggestions.mock.funcRefreshSuggestions != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("UseCaseMock.RefreshSuggestions mock is already set by Set")
+	}
+
+	if mmRefreshSuggestions.defaultExpectation == nil {
+		mmRefreshSuggestions.defaultExpectation = &UseCaseMockRefreshSuggestionsExpectation{mock: mmRefreshSuggestions.mock}
+	}
+	mmRefreshSuggestions.defaultExpectation.results = &UseCaseMockRefreshSuggestionsResults{err}
+	mmRefreshSuggestions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefreshSuggestions.mock
+}
+
+// Set uses given function f to mock the UseCase.RefreshSuggestions method
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Set(f func(ctx context.Context) (err error)) *UseCaseMock {
+	if mmRefreshSuggestions.defaultExpectation != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("Default expectation is already set for the UseCase.RefreshSuggestions method")
+	}
+
+	if len(mmRefreshSuggestions.expectations) > 0 {
+		mmRefreshSuggestions.mock.t.Fatalf("Some expectations are already set for the UseCase.RefreshSuggestions method")
+	}
+
+	mmRefreshSuggestions.mock.funcRefreshSuggestions = f
+	mmRefreshSuggestions.mock.funcRefreshSuggestionsOrigin = minimock.CallerInfo(1)
+	return mmRefreshSuggestions.mock
+}
+
+// When sets expectation for the UseCase.RefreshSuggestions which will trigger the result defined by the following
+// Then helper
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) When(ctx context.Context) *UseCaseMockRefreshSuggestionsExpectation {
+	if mmRefreshSuggestions.mock.funcRefreshSuggestions != nil {
+		mmRefreshSuggestions.mock.t.Fatalf("UseCaseMock.RefreshSuggestions mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockRefreshSuggestionsExpectation{
+		mock:               mmRefreshSuggestions.mock,
+		params:             &UseCaseMockRefreshSuggestionsParams{ctx},
+		expectationOrigins: UseCaseMockRefreshSuggestionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRefreshSuggestions.expectations = append(mmRefreshSuggestions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.RefreshSuggestions return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockRefreshSuggestionsExpectation) Then(err error) *UseCaseMock {
+	e.results = &UseCaseMockRefreshSuggestionsResults{err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.RefreshSuggestions should be invoked
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Times(n uint64) *mUseCaseMockRefreshSuggestions {
+	if n == 0 {
+		mmRefreshSuggestions.mock.t.Fatalf("Times of UseCaseMock.RefreshSuggestions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRefreshSuggestions.expectedInvocations, n)
+	mmRefreshSuggestions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefreshSuggestions
+}
+
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) invocationsDone() bool {
+	if len(mmRefreshSuggestions.expectations) == 0 && mmRefreshSuggestions.defaultExpectation == nil && mmRefreshSuggestions.mock.funcRefreshSuggestions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshSuggestions.mock.afterRefreshSuggestionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshSuggestions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RefreshSuggestions implements mm_listing.UseCase
+func (mmRefreshSuggestions *UseCaseMock) RefreshSuggestions(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmRefreshSuggestions.beforeRefreshSuggestionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshSuggestions.afterRefreshSuggestionsCounter, 1)
+
+	mmRefreshSuggestions.t.Helper()
+
+	if mmRefreshSuggestions.inspectFuncRefreshSuggestions != nil {
+		mmRefreshSuggestions.inspectFuncRefreshSuggestions(ctx)
+	}
+
+	mm_params := UseCaseMockRefreshSuggestionsParams{ctx}
+
+	// Record call args
+	mmRefreshSuggestions.RefreshSuggestionsMock.mutex.Lock()
+	mmRefreshSuggestions.RefreshSuggestionsMock.callArgs = append(mmRefreshSuggestions.RefreshSuggestionsMock.callArgs, &mm_params)
+	mmRefreshSuggestions.RefreshSuggestionsMock.mutex.Unlock()
+
+	for _, e := range mmRefreshSuggestions.RefreshSuggestionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockRefreshSuggestionsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRefreshSuggestions.t.Errorf("UseCaseMock.RefreshSuggestions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRefreshSuggestions.t.Errorf("UseCaseMock.RefreshSuggestions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRefreshSuggestions.RefreshSuggestionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefreshSuggestions.t.Fatal("No results are set for the UseCaseMock.RefreshSuggestions")
+		}
+		return (*mm_results).err
+	}
+	if mmRefreshSuggestions.funcRefreshSuggestions != nil {
+		return mmRefreshSuggestions.funcRefreshSuggestions(ctx)
+	}
+	mmRefreshSuggestions.t.Fatalf("Unexpected call to UseCaseMock.RefreshSuggestions. %v", ctx)
+	return
+}
+
+// RefreshSuggestionsAfterCounter returns a count of finished UseCaseMock.RefreshSuggestions invocations
+func (mmRefreshSuggestions *UseCaseMock) RefreshSuggestionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshSuggestions.afterRefreshSuggestionsCounter)
+}
+
+// RefreshSuggestionsBeforeCounter returns a count of UseCaseMock.RefreshSuggestions invocations
+func (mmRefreshSuggestions *UseCaseMock) RefreshSuggestionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshSuggestions.beforeRefreshSuggestionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.RefreshSuggestions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRefreshSuggestions *mUseCaseMockRefreshSuggestions) Calls() []*UseCaseMockRefreshSuggestionsParams {
+	mmRefreshSuggestions.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockRefreshSuggestionsParams, len(mmRefreshSuggestions.callArgs))
+	copy(argCopy, mmRefreshSuggestions.callArgs)
+
+	mmRefreshSuggestions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRefreshSuggestionsDone returns true if the count of the RefreshSuggestions invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockRefreshSuggestionsDone() bool {
+	if m.RefreshSuggestionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RefreshSuggestionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RefreshSuggestionsMock.invocationsDone()
+}
+
+// MinimockRefreshSuggestionsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockRefreshSuggestionsInspect() {
+	for _, e := range m.RefreshSuggestionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshSuggestions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRefreshSuggestionsCounter := mm_atomic.LoadUint64(&m.afterRefreshSuggestionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefreshSuggestionsMock.defaultExpectation != nil && afterRefreshSuggestionsCounter < 1 {
+		if m.RefreshSuggestionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshSuggestions at\n%s", m.RefreshSuggestionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.RefreshSuggestions at\n%s with params: %#v", m.RefreshSuggestionsMock.defaultExpectation.expectationOrigins.origin, *m.RefreshSuggestionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefreshSuggestions != nil && afterRefreshSuggestionsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.RefreshSuggestions at\n%s", m.funcRefreshSuggestionsOrigin)
+	}
+
+	if !m.RefreshSuggestionsMock.invocationsDone() && afterRefreshSuggestionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.RefreshSuggestions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshSuggestionsMock.expectedInvocations), m.RefreshSuggestionsMock.expectedInvocationsOrigin, afterRefreshSuggestionsCounter)
+	}
+}
+
+type mUseCaseMockRemoveFavorite struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockRemoveFavoriteExpectation
+	expectations       []*UseCaseMockRemoveFavoriteExpectation
+
+	callArgs []*UseCaseMockRemoveFavoriteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockRemoveFavoriteExpectation specifies expectation struct of the UseCase.RemoveFavorite
+type UseCaseMockRemoveFavoriteExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockRemoveFavoriteParams
+	paramPtrs          *UseCaseMockRemoveFavoriteParamPtrs
+	expectationOrigins UseCaseMockRemoveFavoriteExpectationOrigins
+	results            *UseCaseMockRemoveFavoriteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockRemoveFavoriteParams contains parameters of the UseCase.RemoveFavorite
+type UseCaseMockRemoveFavoriteParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// UseCaseMockRemoveFavoriteParamPtrs contains pointers to parameters of the UseCase.RemoveFavorite
+type UseCaseMockRemoveFavoriteParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// UseCaseMockRemoveFavoriteResults contains results of the UseCase.RemoveFavorite
+type UseCaseMockRemoveFavoriteResults struct {
+	fp1 *entity.FavoriteState
+	err error
+}
+
+// UseCaseMockRemoveFavoriteOrigins contains origins of expectations of the UseCase.RemoveFavorite
+type UseCaseMockRemoveFavoriteExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Optional() *mUseCaseMockRemoveFavorite {
+	mmRemoveFavorite.optional = true
+	return mmRemoveFavorite
+}
+
+// Expect sets up expected params for UseCase.RemoveFavorite
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Expect(ctx context.Context, userID uint64, id uint64) *mUseCaseMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &UseCaseMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveFavorite.defaultExpectation.params = &UseCaseMockRemoveFavoriteParams{ctx, userID, id}
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveFavorite.expectations {
+		if minimock.Equal(e.params, mmRemoveFavorite.defaultExpectation.params) {
+			mmRemoveFavorite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFavorite.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveFavorite
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.RemoveFavorite
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) ExpectCtxParam1(ctx context.Context) *mUseCaseMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &UseCaseMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.params != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Expect")
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs == nil {
+		mmRemoveFavorite.defaultExpectation.paramPtrs = &UseCaseMockRemoveFavoriteParamPtrs{}
+	}
+	mmRemoveFavorite.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveFavorite
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.RemoveFavorite
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) ExpectUserIDParam2(userID uint64) *mUseCaseMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &UseCaseMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.params != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Expect")
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs == nil {
+		mmRemoveFavorite.defaultExpectation.paramPtrs = &UseCaseMockRemoveFavoriteParamPtrs{}
+	}
+	mmRemoveFavorite.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveFavorite
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.RemoveFavorite
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) ExpectIdParam3(id uint64) *mUseCaseMockRemoveFavorite {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &UseCaseMockRemoveFavoriteExpectation{}
+	}
+
+	if mmRemoveFavorite.defaultExpectation.params != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Expect")
+	}
+
+	if mmRemoveFavorite.defaultExpectation.paramPtrs == nil {
+		mmRemoveFavorite.defaultExpectation.paramPtrs = &UseCaseMockRemoveFavoriteParamPtrs{}
+	}
+	mmRemoveFavorite.defaultExpectation.paramPtrs.id = &id
+	mmRemoveFavorite.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRemoveFavorite
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.RemoveFavorite
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mUseCaseMockRemoveFavorite {
+	if mmRemoveFavorite.mock.inspectFuncRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("Inspect function is already set for UseCaseMock.RemoveFavorite")
+	}
+
+	mmRemoveFavorite.mock.inspectFuncRemoveFavorite = f
+
+	return mmRemoveFavorite
+}
+
+// Return sets up results that will be returned by UseCase.RemoveFavorite
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Return(fp1 *entity.FavoriteState, err error) *UseCaseMock {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Set")
+	}
+
+	if mmRemoveFavorite.defaultExpectation == nil {
+		mmRemoveFavorite.defaultExpectation = &UseCaseMockRemoveFavoriteExpectation{mock: mmRemoveFavorite.mock}
+	}
+	mmRemoveFavorite.defaultExpectation.results = &UseCaseMockRemoveFavoriteResults{fp1, err}
+	mmRemoveFavorite.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveFavorite.mock
+}
+
+// Set uses given function f to mock the UseCase.RemoveFavorite method
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Set(f func(ctx context.Context, userID uint64, id uint64) (fp1 *entity.FavoriteState, err error)) *UseCaseMock {
+	if mmRemoveFavorite.defaultExpectation != nil {
+		mmRemoveFavorite.mock.t.Fatalf("Default expectation is already set for the UseCase.RemoveFavorite method")
+	}
+
+	if len(mmRemoveFavorite.expectations) > 0 {
+		mmRemoveFavorite.mock.t.Fatalf("Some expectations are already set for the UseCase.RemoveFavorite method")
+	}
+
+	mmRemoveFavorite.mock.funcRemoveFavorite = f
+	mmRemoveFavorite.mock.funcRemoveFavoriteOrigin = minimock.CallerInfo(1)
+	return mmRemoveFavorite.mock
+}
+
+// When sets expectation for the UseCase.RemoveFavorite which will trigger the result defined by the following
+// Then helper
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) When(ctx context.Context, userID uint64, id uint64) *UseCaseMockRemoveFavoriteExpectation {
+	if mmRemoveFavorite.mock.funcRemoveFavorite != nil {
+		mmRemoveFavorite.mock.t.Fatalf("UseCaseMock.RemoveFavorite mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockRemoveFavoriteExpectation{
+		mock:               mmRemoveFavorite.mock,
+		params:             &UseCaseMockRemoveFavoriteParams{ctx, userID, id},
+		expectationOrigins: UseCaseMockRemoveFavoriteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveFavorite.expectations = append(mmRemoveFavorite.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.RemoveFavorite return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockRemoveFavoriteExpectation) Then(fp1 *entity.FavoriteState, err error) *UseCaseMock {
+	e.results = &UseCaseMockRemoveFavoriteResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.RemoveFavorite should be invoked
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Times(n uint64) *mUseCaseMockRemoveFavorite {
+	if n == 0 {
+		mmRemoveFavorite.mock.t.Fatalf("Times of UseCaseMock.RemoveFavorite mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveFavorite.expectedInvocations, n)
+	mmRemoveFavorite.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveFavorite
+}
+
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) invocationsDone() bool {
+	if len(mmRemoveFavorite.expectations) == 0 && mmRemoveFavorite.defaultExpectation == nil && mmRemoveFavorite.mock.funcRemoveFavorite == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveFavorite.mock.afterRemoveFavoriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveFavorite.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveFavorite implements mm_listing.UseCase
+func (mmRemoveFavorite *UseCaseMock) RemoveFavorite(ctx context.Context, userID uint64, id uint64) (fp1 *entity.FavoriteState, err error) {
+	mm_atomic.AddUint64(&mmRemoveFavorite.beforeRemoveFavoriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveFavorite.afterRemoveFavoriteCounter, 1)
+
+	mmRemoveFavorite.t.Helper()
+
+	if mmRemoveFavorite.inspectFuncRemoveFavorite != nil {
+		mmRemoveFavorite.inspectFuncRemoveFavorite(ctx, userID, id)
+	}
+
+	mm_params := UseCaseMockRemoveFavoriteParams{ctx, userID, id}
+
+	// Record call args
+	mmRemoveFavorite.RemoveFavoriteMock.mutex.Lock()
+	mmRemoveFavorite.RemoveFavoriteMock.callArgs = append(mmRemoveFavorite.RemoveFavoriteMock.callArgs, &mm_params)
+	mmRemoveFavorite.RemoveFavoriteMock.mutex.Unlock()
+
+	for _, e := range mmRemoveFavorite.RemoveFavoriteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockRemoveFavoriteParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveFavorite.t.Errorf("UseCaseMock.RemoveFavorite got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveFavorite.t.Errorf("UseCaseMock.RemoveFavorite got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRemoveFavorite.t.Errorf("UseCaseMock.RemoveFavorite got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveFavorite.t.Errorf("UseCaseMock.RemoveFavorite got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveFavorite.RemoveFavoriteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveFavorite.t.Fatal("No results are set for the UseCaseMock.RemoveFavorite")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmRemoveFavorite.funcRemoveFavorite != nil {
+		return mmRemoveFavorite.funcRemoveFavorite(ctx, userID, id)
+	}
+	mmRemoveFavorite.t.Fatalf("Unexpected call to UseCaseMock.RemoveFavorite. %v %v %v", ctx, userID, id)
+	return
+}
+
+// RemoveFavoriteAfterCounter returns a count of finished UseCaseMock.RemoveFavorite invocations
+func (mmRemoveFavorite *UseCaseMock) RemoveFavoriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFavorite.afterRemoveFavoriteCounter)
+}
+
+// RemoveFavoriteBeforeCounter returns a count of UseCaseMock.RemoveFavorite invocations
+func (mmRemoveFavorite *UseCaseMock) RemoveFavoriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFavorite.beforeRemoveFavoriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.RemoveFavorite.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveFavorite *mUseCaseMockRemoveFavorite) Calls() []*UseCaseMockRemoveFavoriteParams {
+	mmRemoveFavorite.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockRemoveFavoriteParams, len(mmRemoveFavorite.callArgs))
+	copy(argCopy, mmRemoveFavorite.callArgs)
+
+	mmRemoveFavorite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveFavoriteDone returns true if the count of the RemoveFavorite invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockRemoveFavoriteDone() bool {
+	if m.RemoveFavoriteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveFavoriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveFavoriteMock.invocationsDone()
+}
+
+// MinimockRemoveFavoriteInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockRemoveFavoriteInspect() {
+	for _, e := range m.RemoveFavoriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.RemoveFavorite at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveFavoriteCounter := mm_atomic.LoadUint64(&m.afterRemoveFavoriteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveFavoriteMock.defaultExpectation != nil && afterRemoveFavoriteCounter < 1 {
+		if m.RemoveFavoriteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.RemoveFavorite at\n%s", m.RemoveFavoriteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.RemoveFavorite at\n%s with params: %#v", m.RemoveFavoriteMock.defaultExpectation.expectationOrigins.origin, *m.RemoveFavoriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveFavorite != nil && afterRemoveFavoriteCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.RemoveFavorite at\n%s", m.funcRemoveFavoriteOrigin)
+	}
+
+	if !m.RemoveFavoriteMock.invocationsDone() && afterRemoveFavoriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.RemoveFavorite at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveFavoriteMock.expectedInvocations), m.RemoveFavoriteMock.expectedInvocationsOrigin, afterRemoveFavoriteCounter)
+	}
+}
+
+type mUseCaseMockRemoveListingImage struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockRemoveListingImageExpectation
+	expectations       []*UseCaseMockRemoveListingImageExpectation
+
+	callArgs []*UseCaseMockRemoveListingImageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockRemoveListingImageExpectation specifies expectation struct of the UseCase.RemoveListingImage
+type UseCaseMockRemoveListingImageExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockRemoveListingImageParams
+	paramPtrs          *UseCaseMockRemoveListingImageParamPtrs
+	expectationOrigins UseCaseMockRemoveListingImageExpectationOrigins
+	results            *UseCaseMockRemoveListingImageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockRemoveListingImageParams contains parameters of the UseCase.RemoveListingImage
+type UseCaseMockRemoveListingImageParams struct {
+	ctx     context.Context
+	userID  uint64
+	id      uint64
+	imageID uint64
+}
+
+// UseCaseMockRemoveListingImageParamPtrs contains pointers to parameters of the UseCase.RemoveListingImage
+type UseCaseMockRemoveListingImageParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	id      *uint64
+	imageID *uint64
+}
+
+// UseCaseMockRemoveListingImageResults contains results of the UseCase.RemoveListingImage
+type UseCaseMockRemoveListingImageResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockRemoveListingImageOrigins contains origins of expectations of the UseCase.RemoveListingImage
+type UseCaseMockRemoveListingImageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originId      string
+	originImageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Optional() *mUseCaseMockRemoveListingImage {
+	mmRemoveListingImage.optional = true
+	return mmRemoveListingImage
+}
+
+// Expect sets up expected params for UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Expect(ctx context.Context, userID uint64, id uint64, imageID uint64) *mUseCaseMockRemoveListingImage {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	if mmRemoveListingImage.defaultExpectation == nil {
+		mmRemoveListingImage.defaultExpectation = &UseCaseMockRemoveListingImageExpectation{}
+	}
+
+	if mmRemoveListingImage.defaultExpectation.paramPtrs != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveListingImage.defaultExpectation.params = &UseCaseMockRemoveListingImageParams{ctx, userID, id, imageID}
+	mmRemoveListingImage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveListingImage.expectations {
+		if minimock.Equal(e.params, mmRemoveListingImage.defaultExpectation.params) {
+			mmRemoveListingImage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveListingImage.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveListingImage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) ExpectCtxParam1(ctx context.Context) *mUseCaseMockRemoveListingImage {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	if mmRemoveListingImage.defaultExpectation == nil {
+		mmRemoveListingImage.defaultExpectation = &UseCaseMockRemoveListingImageExpectation{}
+	}
+
+	if mmRemoveListingImage.defaultExpectation.params != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Expect")
+	}
+
+	if mmRemoveListingImage.defaultExpectation.paramPtrs == nil {
+		mmRemoveListingImage.defaultExpectation.paramPtrs = &UseCaseMockRemoveListingImageParamPtrs{}
+	}
+	mmRemoveListingImage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveListingImage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveListingImage
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) ExpectUserIDParam2(userID uint64) *mUseCaseMockRemoveListingImage {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	if mmRemoveListingImage.defaultExpectation == nil {
+		mmRemoveListingImage.defaultExpectation = &UseCaseMockRemoveListingImageExpectation{}
+	}
+
+	if mmRemoveListingImage.defaultExpectation.params != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Expect")
+	}
+
+	if mmRemoveListingImage.defaultExpectation.paramPtrs == nil {
+		mmRemoveListingImage.defaultExpectation.paramPtrs = &UseCaseMockRemoveListingImageParamPtrs{}
+	}
+	mmRemoveListingImage.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveListingImage.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveListingImage
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) ExpectIdParam3(id uint64) *mUseCaseMockRemoveListingImage {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	if mmRemoveListingImage.defaultExpectation == nil {
+		mmRemoveListingImage.defaultExpectation = &UseCaseMockRemoveListingImageExpectation{}
+	}
+
+	if mmRemoveListingImage.defaultExpectation.params != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Expect")
+	}
+
+	if mmRemoveListingImage.defaultExpectation.paramPtrs == nil {
+		mmRemoveListingImage.defaultExpectation.paramPtrs = &UseCaseMockRemoveListingImageParamPtrs{}
+	}
+	mmRemoveListingImage.defaultExpectation.paramPtrs.id = &id
+	mmRemoveListingImage.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRemoveListingImage
+}
+
+// ExpectImageIDParam4 sets up expected param imageID for UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) ExpectImageIDParam4(imageID uint64) *mUseCaseMockRemoveListingImage {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	if mmRemoveListingImage.defaultExpectation == nil {
+		mmRemoveListingImage.defaultExpectation = &UseCaseMockRemoveListingImageExpectation{}
+	}
+
+	if mmRemoveListingImage.defaultExpectation.params != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Expect")
+	}
+
+	if mmRemoveListingImage.defaultExpectation.paramPtrs == nil {
+		mmRemoveListingImage.defaultExpectation.paramPtrs = &UseCaseMockRemoveListingImageParamPtrs{}
+	}
+	mmRemoveListingImage.defaultExpectation.paramPtrs.imageID = &imageID
+	mmRemoveListingImage.defaultExpectation.expectationOrigins.originImageID = minimock.CallerInfo(1)
+
+	return mmRemoveListingImage
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Inspect(f func(ctx context.Context, userID uint64, id uint64, imageID uint64)) *mUseCaseMockRemoveListingImage {
+	if mmRemoveListingImage.mock.inspectFuncRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("Inspect function is already set for UseCaseMock.RemoveListingImage")
+	}
+
+	mmRemoveListingImage.mock.inspectFuncRemoveListingImage = f
+
+	return mmRemoveListingImage
+}
+
+// Return sets up results that will be returned by UseCase.RemoveListingImage
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	if mmRemoveListingImage.defaultExpectation == nil {
+		mmRemoveListingImage.defaultExpectation = &UseCaseMockRemoveListingImageExpectation{mock: mmRemoveListingImage.mock}
+	}
+	mmRemoveListingImage.defaultExpectation.results = &UseCaseMockRemoveListingImageResults{lp1, err}
+	mmRemoveListingImage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveListingImage.mock
+}
+
+// Set uses given function f to mock the UseCase.RemoveListingImage method
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Set(f func(ctx context.Context, userID uint64, id uint64, imageID uint64) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmRemoveListingImage.defaultExpectation != nil {
+		mmRemoveListingImage.mock.t.Fatalf("Default expectation is already set for the UseCase.RemoveListingImage method")
+	}
+
+	if len(mmRemoveListingImage.expectations) > 0 {
+		mmRemoveListingImage.mock.t.Fatalf("Some expectations are already set for the UseCase.RemoveListingImage method")
+	}
+
+	mmRemoveListingImage.mock.funcRemoveListingImage = f
+	mmRemoveListingImage.mock.funcRemoveListingImageOrigin = minimock.CallerInfo(1)
+	return mmRemoveListingImage.mock
+}
+
+// When sets expectation for the UseCase.RemoveListingImage which will trigger the result defined by the following
+// Then helper
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) When(ctx context.Context, userID uint64, id uint64, imageID uint64) *UseCaseMockRemoveListingImageExpectation {
+	if mmRemoveListingImage.mock.funcRemoveListingImage != nil {
+		mmRemoveListingImage.mock.t.Fatalf("UseCaseMock.RemoveListingImage mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockRemoveListingImageExpectation{
+		mock:               mmRemoveListingImage.mock,
+		params:             &UseCaseMockRemoveListingImageParams{ctx, userID, id, imageID},
+		expectationOrigins: UseCaseMockRemoveListingImageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveListingImage.expectations = append(mmRemoveListingImage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.RemoveListingImage return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockRemoveListingImageExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockRemoveListingImageResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.RemoveListingImage should be invoked
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Times(n uint64) *mUseCaseMockRemoveListingImage {
+	if n == 0 {
+		mmRemoveListingImage.mock.t.Fatalf("Times of UseCaseMock.RemoveListingImage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveListingImage.expectedInvocations, n)
+	mmRemoveListingImage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveListingImage
+}
+
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) invocationsDone() bool {
+	if len(mmRemoveListingImage.expectations) == 0 && mmRemoveListingImage.defaultExpectation == nil && mmRemoveListingImage.mock.funcRemoveListingImage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveListingImage.mock.afterRemoveListingImageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveListingImage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveListingImage implements mm_listing.UseCase
+func (mmRemoveListingImage *UseCaseMock) RemoveListingImage(ctx context.Context, userID uint64, id uint64, imageID uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmRemoveListingImage.beforeRemoveListingImageCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveListingImage.afterRemoveListingImageCounter, 1)
+
+	mmRemoveListingImage.t.Helper()
+
+	if mmRemoveListingImage.inspectFuncRemoveListingImage != nil {
+		mmRemoveListingImage.inspectFuncRemoveListingImage(ctx, userID, id, imageID)
+	}
+
+	mm_params := UseCaseMockRemoveListingImageParams{ctx, userID, id, imageID}
+
+	// Record call args
+	mmRemoveListingImage.RemoveListingImageMock.mutex.Lock()
+	mmRemoveListingImage.RemoveListingImageMock.callArgs = append(mmRemoveListingImage.RemoveListingImageMock.callArgs, &mm_params)
+	mmRemoveListingImage.RemoveListingImageMock.mutex.Unlock()
+
+	for _, e := range mmRemoveListingImage.RemoveListingImageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmRemoveListingImage.RemoveListingImageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockRemoveListingImageParams{ctx, userID, id, imageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveListingImage.t.Errorf("UseCaseMock.RemoveListingImage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveListingImage.t.Errorf("UseCaseMock.RemoveListingImage got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRemoveListingImage.t.Errorf("UseCaseMock.RemoveListingImage got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.imageID != nil && !minimock.Equal(*mm_want_ptrs.imageID, mm_got.imageID) {
+				mmRemoveListingImage.t.Errorf("UseCaseMock.RemoveListingImage got unexpected parameter imageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.expectationOrigins.originImageID, *mm_want_ptrs.imageID, mm_got.imageID, minimock.Diff(*mm_want_ptrs.imageID, mm_got.imageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveListingImage.t.Errorf("UseCaseMock.RemoveListingImage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveListingImage.RemoveListingImageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveListingImage.t.Fatal("No results are set for the UseCaseMock.RemoveListingImage")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmRemoveListingImage.funcRemoveListingImage != nil {
+		return mmRemoveListingImage.funcRemoveListingImage(ctx, userID, id, imageID)
+	}
+	mmRemoveListingImage.t.Fatalf("Unexpected call to UseCaseMock.RemoveListingImage. %v %v %v %v", ctx, userID, id, imageID)
+	return
+}
+
+// RemoveListingImageAfterCounter returns a count of finished UseCaseMock.RemoveListingImage invocations
+func (mmRemoveListingImage *UseCaseMock) RemoveListingImageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveListingImage.afterRemoveListingImageCounter)
+}
+
+// RemoveListingImageBeforeCounter returns a count of UseCaseMock.RemoveListingImage invocations
+func (mmRemoveListingImage *UseCaseMock) RemoveListingImageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveListingImage.beforeRemoveListingImageCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.RemoveListingImage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveListingImage *mUseCaseMockRemoveListingImage) Calls() []*UseCaseMockRemoveListingImageParams {
+	mmRemoveListingImage.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockRemoveListingImageParams, len(mmRemoveListingImage.callArgs))
+	copy(argCopy, mmRemoveListingImage.callArgs)
+
+	mmRemoveListingImage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveListingImageDone returns true if the count of the RemoveListingImage invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockRemoveListingImageDone() bool {
+	if m.RemoveListingImageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveListingImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveListingImageMock.invocationsDone()
+}
+
+// MinimockRemoveListingImageInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockRemoveListingImageInspect() {
+	for _, e := range m.RemoveListingImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.RemoveListingImage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveListingImageCounter := mm_atomic.LoadUint64(&m.afterRemoveListingImageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveListingImageMock.defaultExpectation != nil && afterRemoveListingImageCounter < 1 {
+		if m.RemoveListingImageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.RemoveListingImage at\n%s", m.RemoveListingImageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.RemoveListingImage at\n%s with params: %#v", m.RemoveListingImageMock.defaultExpectation.expectationOrigins.origin, *m.RemoveListingImageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveListingImage != nil && afterRemoveListingImageCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.RemoveListingImage at\n%s", m.funcRemoveListingImageOrigin)
+	}
+
+	if !m.RemoveListingImageMock.invocationsDone() && afterRemoveListingImageCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.RemoveListingImage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveListingImageMock.expectedInvocations), m.RemoveListingImageMock.expectedInvocationsOrigin, afterRemoveListingImageCounter)
+	}
+}
+
+type mUseCaseMockReorderListingImages struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockReorderListingImagesExpectation
+	expectations       []*UseCaseMockReorderListingImagesExpectation
+
+	callArgs []*UseCaseMockReorderListingImagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockReorderListingImagesExpectation specifies expectation struct of the UseCase.ReorderListingImages
+type UseCaseMockReorderListingImagesExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockReorderListingImagesParams
+	paramPtrs          *UseCaseMockReorderListingImagesParamPtrs
+	expectationOrigins UseCaseMockReorderListingImagesExpectationOrigins
+	results            *UseCaseMockReorderListingImagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockReorderListingImagesParams contains parameters of the UseCase.ReorderListingImages
+type UseCaseMockReorderListingImagesParams struct {
+	ctx      context.Context
+	userID   uint64
+	id       uint64
+	imageIDs []uint64
+}
+
+// UseCaseMockReorderListingImagesParamPtrs contains pointers to parameters of the UseCase.ReorderListingImages
+type UseCaseMockReorderListingImagesParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	id       *uint64
+	imageIDs *[]uint64
+}
+
+// UseCaseMockReorderListingImagesResults contains results of the UseCase.ReorderListingImages
+type UseCaseMockReorderListingImagesResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockReorderListingImagesOrigins contains origins of expectations of the UseCase.ReorderListingImages
+type UseCaseMockReorderListingImagesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originId       string
+	originImageIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Optional() *mUseCaseMockReorderListingImages {
+	mmReorderListingImages.optional = true
+	return mmReorderListingImages
+}
+
+// Expect sets up expected params for UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Expect(ctx context.Context, userID uint64, id uint64, imageIDs []uint64) *mUseCaseMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &UseCaseMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by ExpectParams functions")
+	}
+
+	mmReorderListingImages.defaultExpectation.params = &UseCaseMockReorderListingImagesParams{ctx, userID, id, imageIDs}
+	mmReorderListingImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReorderListingImages.expectations {
+		if minimock.Equal(e.params, mmReorderListingImages.defaultExpectation.params) {
+			mmReorderListingImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReorderListingImages.defaultExpectation.params)
+		}
+	}
+
+	return mmReorderListingImages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) ExpectCtxParam1(ctx context.Context) *mUseCaseMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &UseCaseMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &UseCaseMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) ExpectUserIDParam2(userID uint64) *mUseCaseMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &UseCaseMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &UseCaseMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.userID = &userID
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) ExpectIdParam3(id uint64) *mUseCaseMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &UseCaseMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &UseCaseMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.id = &id
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// ExpectImageIDsParam4 sets up expected param imageIDs for UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) ExpectImageIDsParam4(imageIDs []uint64) *mUseCaseMockReorderListingImages {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &UseCaseMockReorderListingImagesExpectation{}
+	}
+
+	if mmReorderListingImages.defaultExpectation.params != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Expect")
+	}
+
+	if mmReorderListingImages.defaultExpectation.paramPtrs == nil {
+		mmReorderListingImages.defaultExpectation.paramPtrs = &UseCaseMockReorderListingImagesParamPtrs{}
+	}
+	mmReorderListingImages.defaultExpectation.paramPtrs.imageIDs = &imageIDs
+	mmReorderListingImages.defaultExpectation.expectationOrigins.originImageIDs = minimock.CallerInfo(1)
+
+	return mmReorderListingImages
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Inspect(f func(ctx context.Context, userID uint64, id uint64, imageIDs []uint64)) *mUseCaseMockReorderListingImages {
+	if mmReorderListingImages.mock.inspectFuncReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ReorderListingImages")
+	}
+
+	mmReorderListingImages.mock.inspectFuncReorderListingImages = f
+
+	return mmReorderListingImages
+}
+
+// Return sets up results that will be returned by UseCase.ReorderListingImages
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	if mmReorderListingImages.defaultExpectation == nil {
+		mmReorderListingImages.defaultExpectation = &UseCaseMockReorderListingImagesExpectation{mock: mmReorderListingImages.mock}
+	}
+	mmReorderListingImages.defaultExpectation.results = &UseCaseMockReorderListingImagesResults{lp1, err}
+	mmReorderListingImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReorderListingImages.mock
+}
+
+// Set uses given function f to mock the UseCase.ReorderListingImages method
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Set(f func(ctx context.Context, userID uint64, id uint64, imageIDs []uint64) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmReorderListingImages.defaultExpectation != nil {
+		mmReorderListingImages.mock.t.Fatalf("Default expectation is already set for the UseCase.ReorderListingImages method")
+	}
+
+	if len(mmReorderListingImages.expectations) > 0 {
+		mmReorderListingImages.mock.t.Fatalf("Some expectations are already set for the UseCase.ReorderListingImages method")
+	}
+
+	mmReorderListingImages.mock.funcReorderListingImages = f
+	mmReorderListingImages.mock.funcReorderListingImagesOrigin = minimock.CallerInfo(1)
+	return mmReorderListingImages.mock
+}
+
+// When sets expectation for the UseCase.ReorderListingImages which will trigger the result defined by the following
+// Then helper
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) When(ctx context.Context, userID uint64, id uint64, imageIDs []uint64) *UseCaseMockReorderListingImagesExpectation {
+	if mmReorderListingImages.mock.funcReorderListingImages != nil {
+		mmReorderListingImages.mock.t.Fatalf("UseCaseMock.ReorderListingImages mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockReorderListingImagesExpectation{
+		mock:               mmReorderListingImages.mock,
+		params:             &UseCaseMockReorderListingImagesParams{ctx, userID, id, imageIDs},
+		expectationOrigins: UseCaseMockReorderListingImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReorderListingImages.expectations = append(mmReorderListingImages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ReorderListingImages return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockReorderListingImagesExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockReorderListingImagesResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ReorderListingImages should be invoked
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Times(n uint64) *mUseCaseMockReorderListingImages {
+	if n == 0 {
+		mmReorderListingImages.mock.t.Fatalf("Times of UseCaseMock.ReorderListingImages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReorderListingImages.expectedInvocations, n)
+	mmReorderListingImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReorderListingImages
+}
+
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) invocationsDone() bool {
+	if len(mmReorderListingImages.expectations) == 0 && mmReorderListingImages.defaultExpectation == nil && mmReorderListingImages.mock.funcReorderListingImages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReorderListingImages.mock.afterReorderListingImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReorderListingImages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReorderListingImages implements mm_listing.UseCase
+func (mmReorderListingImages *UseCaseMock) ReorderListingImages(ctx context.Context, userID uint64, id uint64, imageIDs []uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmReorderListingImages.beforeReorderListingImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReorderListingImages.afterReorderListingImagesCounter, 1)
+
+	mmReorderListingImages.t.Helper()
+
+	if mmReorderListingImages.inspectFuncReorderListingImages != nil {
+		mmReorderListingImages.inspectFuncReorderListingImages(ctx, userID, id, imageIDs)
+	}
+
+	mm_params := UseCaseMockReorderListingImagesParams{ctx, userID, id, imageIDs}
+
+	// Record call args
+	mmReorderListingImages.ReorderListingImagesMock.mutex.Lock()
+	mmReorderListingImages.ReorderListingImagesMock.callArgs = append(mmReorderListingImages.ReorderListingImagesMock.callArgs, &mm_params)
+	mmReorderListingImages.ReorderListingImagesMock.mutex.Unlock()
+
+	for _, e := range mmReorderListingImages.ReorderListingImagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmReorderListingImages.ReorderListingImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockReorderListingImagesParams{ctx, userID, id, imageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReorderListingImages.t.Errorf("UseCaseMock.ReorderListingImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReorderListingImages.t.Errorf("UseCaseMock.ReorderListingImages got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmReorderListingImages.t.Errorf("UseCaseMock.ReorderListingImages got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.imageIDs != nil && !minimock.Equal(*mm_want_ptrs.imageIDs, mm_got.imageIDs) {
+				mmReorderListingImages.t.Errorf("UseCaseMock.ReorderListingImages got unexpected parameter imageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.originImageIDs, *mm_want_ptrs.imageIDs, mm_got.imageIDs, minimock.Diff(*mm_want_ptrs.imageIDs, mm_got.imageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReorderListingImages.t.Errorf("UseCaseMock.ReorderListingImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReorderListingImages.ReorderListingImagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReorderListingImages.t.Fatal("No results are set for the UseCaseMock.ReorderListingImages")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmReorderListingImages.funcReorderListingImages != nil {
+		return mmReorderListingImages.funcReorderListingImages(ctx, userID, id, imageIDs)
+	}
+	mmReorderListingImages.t.Fatalf("Unexpected call to UseCaseMock.ReorderListingImages. %v %v %v %v", ctx, userID, id, imageIDs)
+	return
+}
+
+// ReorderListingImagesAfterCounter returns a count of finished UseCaseMock.ReorderListingImages invocations
+func (mmReorderListingImages *UseCaseMock) ReorderListingImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReorderListingImages.afterReorderListingImagesCounter)
+}
+
+// ReorderListingImagesBeforeCounter returns a count of UseCaseMock.ReorderListingImages invocations
+func (mmReorderListingImages *UseCaseMock) ReorderListingImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReorderListingImages.beforeReorderListingImagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ReorderListingImages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReorderListingImages *mUseCaseMockReorderListingImages) Calls() []*UseCaseMockReorderListingImagesParams {
+	mmReorderListingImages.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockReorderListingImagesParams, len(mmReorderListingImages.callArgs))
+	copy(argCopy, mmReorderListingImages.callArgs)
+
+	mmReorderListingImages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReorderListingImagesDone returns true if the count of the ReorderListingImages invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockReorderListingImagesDone() bool {
+	if m.ReorderListingImagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReorderListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReorderListingImagesMock.invocationsDone()
+}
+
+// MinimockReorderListingImagesInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockReorderListingImagesInspect() {
+	for _, e := range m.ReorderListingImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ReorderListingImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReorderListingImagesCounter := mm_atomic.LoadUint64(&m.afterReorderListingImagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReorderListingImagesMock.defaultExpectation != nil && afterReorderListingImagesCounter < 1 {
+		if m.ReorderListingImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ReorderListingImages at\n%s", m.ReorderListingImagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ReorderListingImages at\n%s with params: %#v", m.ReorderListingImagesMock.defaultExpectation.expectationOrigins.origin, *m.ReorderListingImagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReorderListingImages != nil && afterReorderListingImagesCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ReorderListingImages at\n%s", m.funcReorderListingImagesOrigin)
+	}
+
+	if !m.ReorderListingImagesMock.invocationsDone() && afterReorderListingImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ReorderListingImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReorderListingImagesMock.expectedInvocations), m.ReorderListingImagesMock.expectedInvocationsOrigin, afterReorderListingImagesCounter)
+	}
+}
+
+type mUseCaseMockRestoreListing struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockRestoreListingExpectation
+	expectations       []*UseCaseMockRestoreListingExpectation
+
+	callArgs []*UseCaseMockRestoreListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockRestoreListingExpectation specifies expectation struct of the UseCase.RestoreListing
+type UseCaseMockRestoreListingExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockRestoreListingParams
+	paramPtrs          *UseCaseMockRestoreListingParamPtrs
+	expectationOrigins UseCaseMockRestoreListingExpectationOrigins
+	results            *UseCaseMockRestoreListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockRestoreListingParams contains parameters of the UseCase.RestoreListing
+type UseCaseMockRestoreListingParams struct {
+	ctx    context.Context
+	userID uint64
+	id     uint64
+}
+
+// UseCaseMockRestoreListingParamPtrs contains pointers to parameters of the UseCase.RestoreListing
+type UseCaseMockRestoreListingParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	id     *uint64
+}
+
+// UseCaseMockRestoreListingResults contains results of the UseCase.RestoreListing
+type UseCaseMockRestoreListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockRestoreListingOrigins contains origins of expectations of the UseCase.RestoreListing
+type UseCaseMockRestoreListingExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreListing *mUseCaseMockRestoreListing) Optional() *mUseCaseMockRestoreListing {
+	mmRestoreListing.optional = true
+	return mmRestoreListing
+}
+
+// Expect sets up expected params for UseCase.RestoreListing
+func (mmRestoreListing *mUseCaseMockRestoreListing) Expect(ctx context.Context, userID uint64, id uint64) *mUseCaseMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &UseCaseMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreListing.defaultExpectation.params = &UseCaseMockRestoreListingParams{ctx, userID, id}
+	mmRestoreListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreListing.expectations {
+		if minimock.Equal(e.params, mmRestoreListing.defaultExpectation.params) {
+			mmRestoreListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreListing.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.RestoreListing
+func (mmRestoreListing *mUseCaseMockRestoreListing) ExpectCtxParam1(ctx context.Context) *mUseCaseMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &UseCaseMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.params != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Expect")
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs == nil {
+		mmRestoreListing.defaultExpectation.paramPtrs = &UseCaseMockRestoreListingParamPtrs{}
+	}
+	mmRestoreListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreListing
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.RestoreListing
+func (mmRestoreListing *mUseCaseMockRestoreListing) ExpectUserIDParam2(userID uint64) *mUseCaseMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &UseCaseMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.params != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Expect")
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs == nil {
+		mmRestoreListing.defaultExpectation.paramPtrs = &UseCaseMockRestoreListingParamPtrs{}
+	}
+	mmRestoreListing.defaultExpectation.paramPtrs.userID = &userID
+	mmRestoreListing.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRestoreListing
+}
+
+// ExpectIdParam3 sets up expected param id for UseCase.RestoreListing
+func (mmRestoreListing *mUseCaseMockRestoreListing) ExpectIdParam3(id uint64) *mUseCaseMockRestoreListing {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &UseCaseMockRestoreListingExpectation{}
+	}
+
+	if mmRestoreListing.defaultExpectation.params != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Expect")
+	}
+
+	if mmRestoreListing.defaultExpectation.paramPtrs == nil {
+		mmRestoreListing.defaultExpectation.paramPtrs = &UseCaseMockRestoreListingParamPtrs{}
+	}
+	mmRestoreListing.defaultExpectation.paramPtrs.id = &id
+	mmRestoreListing.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestoreListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.RestoreListing
+func (mmRestoreListing *mUseCaseMockRestoreListing) Inspect(f func(ctx context.Context, userID uint64, id uint64)) *mUseCaseMockRestoreListing {
+	if mmRestoreListing.mock.inspectFuncRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("Inspect function is already set for UseCaseMock.RestoreListing")
+	}
+
+	mmRestoreListing.mock.inspectFuncRestoreListing = f
+
+	return mmRestoreListing
+}
+
+// Return sets up results that will be returned by UseCase.RestoreListing
+func (mmRestoreListing *mUseCaseMockRestoreListing) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Set")
+	}
+
+	if mmRestoreListing.defaultExpectation == nil {
+		mmRestoreListing.defaultExpectation = &UseCaseMockRestoreListingExpectation{mock: mmRestoreListing.mock}
+	}
+	mmRestoreListing.defaultExpectation.results = &UseCaseMockRestoreListingResults{lp1, err}
+	mmRestoreListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreListing.mock
+}
+
+// Set uses given function f to mock the UseCase.RestoreListing method
+func (mmRestoreListing *mUseCaseMockRestoreListing) Set(f func(ctx context.Context, userID uint64, id uint64) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmRestoreListing.defaultExpectation != nil {
+		mmRestoreListing.mock.t.Fatalf("Default expectation is already set for the UseCase.RestoreListing method")
+	}
+
+	if len(mmRestoreListing.expectations) > 0 {
+		mmRestoreListing.mock.t.Fatalf("Some expectations are already set for the UseCase.RestoreListing method")
+	}
+
+	mmRestoreListing.mock.funcRestoreListing = f
+	mmRestoreListing.mock.funcRestoreListingOrigin = minimock.CallerInfo(1)
+	return mmRestoreListing.mock
+}
+
+// When sets expectation for the UseCase.RestoreListing which will trigger the result defined by the following
+// Then helper
+func (mmRestoreListing *mUseCaseMockRestoreListing) When(ctx context.Context, userID uint64, id uint64) *UseCaseMockRestoreListingExpectation {
+	if mmRestoreListing.mock.funcRestoreListing != nil {
+		mmRestoreListing.mock.t.Fatalf("UseCaseMock.RestoreListing mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockRestoreListingExpectation{
+		mock:               mmRestoreListing.mock,
+		params:             &UseCaseMockRestoreListingParams{ctx, userID, id},
+		expectationOrigins: UseCaseMockRestoreListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreListing.expectations = append(mmRestoreListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.RestoreListing return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockRestoreListingExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockRestoreListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.RestoreListing should be invoked
+func (mmRestoreListing *mUseCaseMockRestoreListing) Times(n uint64) *mUseCaseMockRestoreListing {
+	if n == 0 {
+		mmRestoreListing.mock.t.Fatalf("Times of UseCaseMock.RestoreListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreListing.expectedInvocations, n)
+	mmRestoreListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreListing
+}
+
+func (mmRestoreListing *mUseCaseMockRestoreListing) invocationsDone() bool {
+	if len(mmRestoreListing.expectations) == 0 && mmRestoreListing.defaultExpectation == nil && mmRestoreListing.mock.funcRestoreListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreListing.mock.afterRestoreListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreListing implements mm_listing.UseCase
+func (mmRestoreListing *UseCaseMock) RestoreListing(ctx context.Context, userID uint64, id uint64) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmRestoreListing.beforeRestoreListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreListing.afterRestoreListingCounter, 1)
+
+	mmRestoreListing.t.Helper()
+
+	if mmRestoreListing.inspectFuncRestoreListing != nil {
+		mmRestoreListing.inspectFuncRestoreListing(ctx, userID, id)
+	}
+
+	mm_params := UseCaseMockRestoreListingParams{ctx, userID, id}
+
+	// Record call args
+	mmRestoreListing.RestoreListingMock.mutex.Lock()
+	mmRestoreListing.RestoreListingMock.callArgs = append(mmRestoreListing.RestoreListingMock.callArgs, &mm_params)
+	mmRestoreListing.RestoreListingMock.mutex.Unlock()
+
+	for _, e := range mmRestoreListing.RestoreListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmRestoreListing.RestoreListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreListing.RestoreListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreListing.RestoreListingMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreListing.RestoreListingMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockRestoreListingParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreListing.t.Errorf("UseCaseMock.RestoreListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRestoreListing.t.Errorf("UseCaseMock.RestoreListing got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestoreListing.t.Errorf("UseCaseMock.RestoreListing got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreListing.t.Errorf("UseCaseMock.RestoreListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreListing.RestoreListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreListing.RestoreListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreListing.t.Fatal("No results are set for the UseCaseMock.RestoreListing")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmRestoreListing.funcRestoreListing != nil {
+		return mmRestoreListing.funcRestoreListing(ctx, userID, id)
+	}
+	mmRestoreListing.t.Fatalf("Unexpected call to UseCaseMock.RestoreListing. %v %v %v", ctx, userID, id)
+	return
+}
+
+// RestoreListingAfterCounter returns a count of finished UseCaseMock.RestoreListing invocations
+func (mmRestoreListing *UseCaseMock) RestoreListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreListing.afterRestoreListingCounter)
+}
+
+// RestoreListingBeforeCounter returns a count of UseCaseMock.RestoreListing invocations
+func (mmRestoreListing *UseCaseMock) RestoreListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreListing.beforeRestoreListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.RestoreListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreListing *mUseCaseMockRestoreListing) Calls() []*UseCaseMockRestoreListingParams {
+	mmRestoreListing.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockRestoreListingParams, len(mmRestoreListing.callArgs))
+	copy(argCopy, mmRestoreListing.callArgs)
+
+	mmRestoreListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreListingDone returns true if the count of the RestoreListing invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockRestoreListingDone() bool {
+	if m.RestoreListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreListingMock.invocationsDone()
+}
+
+// MinimockRestoreListingInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockRestoreListingInspect() {
+	for _, e := range m.RestoreListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.RestoreListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreListingCounter := mm_atomic.LoadUint64(&m.afterRestoreListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreListingMock.defaultExpectation != nil && afterRestoreListingCounter < 1 {
+		if m.RestoreListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.RestoreListing at\n%s", m.RestoreListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.RestoreListing at\n%s with params: %#v", m.RestoreListingMock.defaultExpectation.expectationOrigins.origin, *m.RestoreListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreListing != nil && afterRestoreListingCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.RestoreListing at\n%s", m.funcRestoreListingOrigin)
+	}
+
+	if !m.RestoreListingMock.invocationsDone() && afterRestoreListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.RestoreListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreListingMock.expectedInvocations), m.RestoreListingMock.expectedInvocationsOrigin, afterRestoreListingCounter)
+	}
+}
+
+type mUseCaseMockSearchListings struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockSearchListingsExpectation
+	expectations       []*UseCaseMockSearchListingsExpectation
+
+	callArgs []*UseCaseMockSearchListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockSearchListingsExpectation specifies expectation struct of the UseCase.SearchListings
+type UseCaseMockSearchListingsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockSearchListingsParams
+	paramPtrs          *UseCaseMockSearchListingsParamPtrs
+	expectationOrigins UseCaseMockSearchListingsExpectationOrigins
+	results            *UseCaseMockSearchListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockSearchListingsParams contains parameters of the UseCase.SearchListings
+type UseCaseMockSearchListingsParams struct {
+	ctx     context.Context
+	query   string
+	page    uint32
+	perPage uint32
+}
+
+// UseCaseMockSearchListingsParamPtrs contains pointers to parameters of the UseCase.SearchListings
+type UseCaseMockSearchListingsParamPtrs struct {
+	ctx     *context.Context
+	query   *string
+	page    *uint32
+	perPage *uint32
+}
+
+// UseCaseMockSearchListingsResults contains results of the UseCase.SearchListings
+type UseCaseMockSearchListingsResults struct {
+	lpa1 []*entity.ListingSearchResult
+	u1   uint32
+	err  error
+}
+
+// UseCaseMockSearchListingsOrigins contains origins of expectations of the UseCase.SearchListings
+type UseCaseMockSearchListingsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originQuery   string
+	originPage    string
+	originPerPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchListings *mUseCaseMockSearchListings) Optional() *mUseCaseMockSearchListings {
+	mmSearchListings.optional = true
+	return mmSearchListings
+}
+
+// Expect sets up expected params for UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) Expect(ctx context.Context, query string, page uint32, perPage uint32) *mUseCaseMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &UseCaseMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by ExpectParams functions")
+	}
+
+	mmSearchListings.defaultExpectation.params = &UseCaseMockSearchListingsParams{ctx, query, page, perPage}
+	mmSearchListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchListings.expectations {
+		if minimock.Equal(e.params, mmSearchListings.defaultExpectation.params) {
+			mmSearchListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchListings.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &UseCaseMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.params != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Expect")
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs == nil {
+		mmSearchListings.defaultExpectation.paramPtrs = &UseCaseMockSearchListingsParamPtrs{}
+	}
+	mmSearchListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchListings
+}
+
+// ExpectQueryParam2 sets up expected param query for UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) ExpectQueryParam2(query string) *mUseCaseMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &UseCaseMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.params != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Expect")
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs == nil {
+		mmSearchListings.defaultExpectation.paramPtrs = &UseCaseMockSearchListingsParamPtrs{}
+	}
+	mmSearchListings.defaultExpectation.paramPtrs.query = &query
+	mmSearchListings.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearchListings
+}
+
+// ExpectPageParam3 sets up expected param page for UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) ExpectPageParam3(page uint32) *mUseCaseMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &UseCaseMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.params != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Expect")
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs == nil {
+		mmSearchListings.defaultExpectation.paramPtrs = &UseCaseMockSearchListingsParamPtrs{}
+	}
+	mmSearchListings.defaultExpectation.paramPtrs.page = &page
+	mmSearchListings.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmSearchListings
+}
+
+// ExpectPerPageParam4 sets up expected param perPage for UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) ExpectPerPageParam4(perPage uint32) *mUseCaseMockSearchListings {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &UseCaseMockSearchListingsExpectation{}
+	}
+
+	if mmSearchListings.defaultExpectation.params != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Expect")
+	}
+
+	if mmSearchListings.defaultExpectation.paramPtrs == nil {
+		mmSearchListings.defaultExpectation.paramPtrs = &UseCaseMockSearchListingsParamPtrs{}
+	}
+	mmSearchListings.defaultExpectation.paramPtrs.perPage = &perPage
+	mmSearchListings.defaultExpectation.expectationOrigins.originPerPage = minimock.CallerInfo(1)
+
+	return mmSearchListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) Inspect(f func(ctx context.Context, query string, page uint32, perPage uint32)) *mUseCaseMockSearchListings {
+	if mmSearchListings.mock.inspectFuncSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.SearchListings")
+	}
+
+	mmSearchListings.mock.inspectFuncSearchListings = f
+
+	return mmSearchListings
+}
+
+// Return sets up results that will be returned by UseCase.SearchListings
+func (mmSearchListings *mUseCaseMockSearchListings) Return(lpa1 []*entity.ListingSearchResult, u1 uint32, err error) *UseCaseMock {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	if mmSearchListings.defaultExpectation == nil {
+		mmSearchListings.defaultExpectation = &UseCaseMockSearchListingsExpectation{mock: mmSearchListings.mock}
+	}
+	mmSearchListings.defaultExpectation.results = &UseCaseMockSearchListingsResults{lpa1, u1, err}
+	mmSearchListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchListings.mock
+}
+
+// Set uses given function f to mock the UseCase.SearchListings method
+func (mmSearchListings *mUseCaseMockSearchListings) Set(f func(ctx context.Context, query string, page uint32, perPage uint32) (lpa1 []*entity.ListingSearchResult, u1 uint32, err error)) *UseCaseMock {
+	if mmSearchListings.defaultExpectation != nil {
+		mmSearchListings.mock.t.Fatalf("Default expectation is already set for the UseCase.SearchListings method")
+	}
+
+	if len(mmSearchListings.expectations) > 0 {
+		mmSearchListings.mock.t.Fatalf("Some expectations are already set for the UseCase.SearchListings method")
+	}
+
+	mmSearchListings.mock.funcSearchListings = f
+	mmSearchListings.mock.funcSearchListingsOrigin = minimock.CallerInfo(1)
+	return mmSearchListings.mock
+}
+
+// When sets expectation for the UseCase.SearchListings which will trigger the result defined by the following
+// Then helper
+func (mmSearchListings *mUseCaseMockSearchListings) When(ctx context.Context, query string, page uint32, perPage uint32) *UseCaseMockSearchListingsExpectation {
+	if mmSearchListings.mock.funcSearchListings != nil {
+		mmSearchListings.mock.t.Fatalf("UseCaseMock.SearchListings mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockSearchListingsExpectation{
+		mock:               mmSearchListings.mock,
+		params:             &UseCaseMockSearchListingsParams{ctx, query, page, perPage},
+		expectationOrigins: UseCaseMockSearchListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchListings.expectations = append(mmSearchListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.SearchListings return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockSearchListingsExpectation) Then(lpa1 []*entity.ListingSearchResult, u1 uint32, err error) *UseCaseMock {
+	e.results = &UseCaseMockSearchListingsResults{lpa1, u1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.SearchListings should be invoked
+func (mmSearchListings *mUseCaseMockSearchListings) Times(n uint64) *mUseCaseMockSearchListings {
+	if n == 0 {
+		mmSearchListings.mock.t.Fatalf("Times of UseCaseMock.SearchListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchListings.expectedInvocations, n)
+	mmSearchListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchListings
+}
+
+func (mmSearchListings *mUseCaseMockSearchListings) invocationsDone() bool {
+	if len(mmSearchListings.expectations) == 0 && mmSearchListings.defaultExpectation == nil && mmSearchListings.mock.funcSearchListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchListings.mock.afterSearchListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchListings implements mm_listing.UseCase
+func (mmSearchListings *UseCaseMock) SearchListings(ctx context.Context, query string, page uint32, perPage uint32) (lpa1 []*entity.ListingSearchResult, u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmSearchListings.beforeSearchListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchListings.afterSearchListingsCounter, 1)
+
+	mmSearchListings.t.Helper()
+
+	if mmSearchListings.inspectFuncSearchListings != nil {
+		mmSearchListings.inspectFuncSearchListings(ctx, query, page, perPage)
+	}
+
+	mm_params := UseCaseMockSearchListingsParams{ctx, query, page, perPage}
+
+	// Record call args
+	mmSearchListings.SearchListingsMock.mutex.Lock()
+	mmSearchListings.SearchListingsMock.callArgs = append(mmSearchListings.SearchListingsMock.callArgs, &mm_params)
+	mmSearchListings.SearchListingsMock.mutex.Unlock()
+
+	for _, e := range mmSearchListings.SearchListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.u1, e.results.err
+		}
+	}
+
+	if mmSearchListings.SearchListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchListings.SearchListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchListings.SearchListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchListings.SearchListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockSearchListingsParams{ctx, query, page, perPage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchListings.t.Errorf("UseCaseMock.SearchListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchListings.t.Errorf("UseCaseMock.SearchListings got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmSearchListings.t.Errorf("UseCaseMock.SearchListings got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+			if mm_want_ptrs.perPage != nil && !minimock.Equal(*mm_want_ptrs.perPage, mm_got.perPage) {
+				mmSearchListings.t.Errorf("UseCaseMock.SearchListings got unexpected parameter perPage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.originPerPage, *mm_want_ptrs.perPage, mm_got.perPage, minimock.Diff(*mm_want_ptrs.perPage, mm_got.perPage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchListings.t.Errorf("UseCaseMock.SearchListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchListings.SearchListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchListings.SearchListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchListings.t.Fatal("No results are set for the UseCaseMock.SearchListings")
+		}
+		return (*mm_results).lpa1, (*mm_results).u1, (*mm_results).err
+	}
+	if mmSearchListings.funcSearchListings != nil {
+		return mmSearchListings.funcSearchListings(ctx, query, page, perPage)
+	}
+	mmSearchListings.t.Fatalf("Unexpected call to UseCaseMock.SearchListings. %v %v %v %v", ctx, query, page, perPage)
+	return
+}
+
+// SearchListingsAfterCounter returns a count of finished UseCaseMock.SearchListings invocations
+func (mmSearchListings *UseCaseMock) SearchListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchListings.afterSearchListingsCounter)
+}
+
+// SearchListingsBeforeCounter returns a count of UseCaseMock.SearchListings invocations
+func (mmSearchListings *UseCaseMock) SearchListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchListings.beforeSearchListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.SearchListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchListings *mUseCaseMockSearchListings) Calls() []*UseCaseMockSearchListingsParams {
+	mmSearchListings.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockSearchListingsParams, len(mmSearchListings.callArgs))
+	copy(argCopy, mmSearchListings.callArgs)
+
+	mmSearchListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchListingsDone returns true if the count of the SearchListings invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockSearchListingsDone() bool {
+	if m.SearchListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchListingsMock.invocationsDone()
+}
+
+// MinimockSearchListingsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockSearchListingsInspect() {
+	for _, e := range m.SearchListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.SearchListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchListingsCounter := mm_atomic.LoadUint64(&m.afterSearchListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchListingsMock.defaultExpectation != nil && afterSearchListingsCounter < 1 {
+		if m.SearchListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.SearchListings at\n%s", m.SearchListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.SearchListings at\n%s with params: %#v", m.SearchListingsMock.defaultExpectation.expectationOrigins.origin, *m.SearchListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchListings != nil && afterSearchListingsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.SearchListings at\n%s", m.funcSearchListingsOrigin)
+	}
+
+	if !m.SearchListingsMock.invocationsDone() && afterSearchListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.SearchListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchListingsMock.expectedInvocations), m.SearchListingsMock.expectedInvocationsOrigin, afterSearchListingsCounter)
+	}
+}
+
+type mUseCaseMockSuggestListings struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockSuggestListingsExpectation
+	expectations       []*UseCaseMockSuggestListingsExpectation
+
+	callArgs []*UseCaseMockSuggestListingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockSuggestListingsExpectation specifies expectation struct of the UseCase.SuggestListings
+type UseCaseMockSuggestListingsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockSuggestListingsParams
+	paramPtrs          *UseCaseMockSuggestListingsParamPtrs
+	expectationOrigins UseCaseMockSuggestListingsExpectationOrigins
+	results            *UseCaseMockSuggestListingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockSuggestListingsParams contains parameters of the UseCase.SuggestListings
+type UseCaseMockSuggestListingsParams struct {
+	ctx    context.Context
+	prefix string
+	limit  int
+}
+
+// UseCaseMockSuggestListingsParamPtrs contains pointers to parameters of the UseCase.SuggestListings
+type UseCaseMockSuggestListingsParamPtrs struct {
+	ctx    *context.Context
+	prefix *string
+	limit  *int
+}
+
+// UseCaseMockSuggestListingsResults contains results of the UseCase.SuggestListings
+type UseCaseMockSuggestListingsResults struct {
+	spa1 []*entity.Suggestion
+}
+
+// UseCaseMockSuggestListingsOrigins contains origins of expectations of the UseCase.SuggestListings
+type UseCaseMockSuggestListingsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPrefix string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSuggestListings *mUseCaseMockSuggestListings) Optional() *mUseCaseMockSuggestListings {
+	mmSuggestListings.optional = true
+	return mmSuggestListings
+}
+
+// Expect sets up expected params for UseCase.SuggestListings
+func (mmSuggestListings *mUseCaseMockSuggestListings) Expect(ctx context.Context, prefix string, limit int) *mUseCaseMockSuggestListings {
+	if mmSuggestListings.mock.funcSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Set")
+	}
+
+	if mmSuggestListings.defaultExpectation == nil {
+		mmSuggestListings.defaultExpectation = &UseCaseMockSuggestListingsExpectation{}
+	}
+
+	if mmSuggestListings.defaultExpectation.paramPtrs != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by ExpectParams functions")
+	}
+
+	mmSuggestListings.defaultExpectation.params = &UseCaseMockSuggestListingsParams{ctx, prefix, limit}
+	mmSuggestListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSuggestListings.expectations {
+		if minimock.Equal(e.params, mmSuggestListings.defaultExpectation.params) {
+			mmSuggestListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSuggestListings.defaultExpectation.params)
+		}
+	}
+
+	return mmSuggestListings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.SuggestListings
+func (mmSuggestListings *mUseCaseMockSuggestListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockSuggestListings {
+	if mmSuggestListings.mock.funcSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Set")
+	}
+
+	if mmSuggestListings.defaultExpectation == nil {
+		mmSuggestListings.defaultExpectation = &UseCaseMockSuggestListingsExpectation{}
+	}
+
+	if mmSuggestListings.defaultExpectation.params != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Expect")
+	}
+
+	if mmSuggestListings.defaultExpectation.paramPtrs == nil {
+		mmSuggestListings.defaultExpectation.paramPtrs = &UseCaseMockSuggestListingsParamPtrs{}
+	}
+	mmSuggestListings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSuggestListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSuggestListings
+}
+
+// ExpectPrefixParam2 sets up expected param prefix for UseCase.SuggestListings
+func (mmSuggestListings *mUseCaseMockSuggestListings) ExpectPrefixParam2(prefix string) *mUseCaseMockSuggestListings {
+	if mmSuggestListings.mock.funcSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Set")
+	}
+
+	if mmSuggestListings.defaultExpectation == nil {
+		mmSuggestListings.defaultExpectation = &UseCaseMockSuggestListingsExpectation{}
+	}
+
+	if mmSuggestListings.defaultExpectation.params != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Expect")
+	}
+
+	if mmSuggestListings.defaultExpectation.paramPtrs == nil {
+		mmSuggestListings.defaultExpectation.paramPtrs = &UseCaseMockSuggestListingsParamPtrs{}
+	}
+	mmSuggestListings.defaultExpectation.paramPtrs.prefix = &prefix
+	mmSuggestListings.defaultExpectation.expectationOrigins.originPrefix = minimock.CallerInfo(1)
+
+	return mmSuggestListings
 }
 
-// UseCaseMockGetListingsOrigins contains origins of expectations of the UseCase.GetListings
-type UseCaseMockGetListingsExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originPage     string
-	originPerPage  string
-	originSortBy   string
-	originSortDesc string
-	originMinPrice string
-	originMaxPrice string
+// ExpectLimitParam3 sets up expected param limit for UseCase.SuggestListings
+func (mmSuggestListings *mUseCaseMockSuggestListings) ExpectLimitParam3(limit int) *mUseCaseMockSuggestListings {
+	if mmSuggestListings.mock.funcSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Set")
+	}
+
+	if mmSuggestListings.defaultExpectation == nil {
+		mmSuggestListings.defaultExpectation = &UseCaseMockSuggestListingsExpectation{}
+	}
+
+	if mmSuggestListings.defaultExpectation.params != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Expect")
+	}
+
+	if mmSuggestListings.defaultExpectation.paramPtrs == nil {
+		mmSuggestListings.defaultExpectation.paramPtrs = &UseCaseMockSuggestListingsParamPtrs{}
+	}
+	mmSuggestListings.defaultExpectation.paramPtrs.limit = &limit
+	mmSuggestListings.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSuggestListings
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.SuggestListings
+func (mmSuggestListings *mUseCaseMockSuggestListings) Inspect(f func(ctx context.Context, prefix string, limit int)) *mUseCaseMockSuggestListings {
+	if mmSuggestListings.mock.inspectFuncSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.SuggestListings")
+	}
+
+	mmSuggestListings.mock.inspectFuncSuggestListings = f
+
+	return mmSuggestListings
+}
+
+// Return sets up results that will be returned by UseCase.SuggestListings
+func (mmSuggestListings *mUseCaseMockSuggestListings) Return(spa1 []*entity.Suggestion) *UseCaseMock {
+	if mmSuggestListings.mock.funcSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Set")
+	}
+
+	if mmSuggestListings.defaultExpectation == nil {
+		mmSuggestListings.defaultExpectation = &UseCaseMockSuggestListingsExpectation{mock: mmSuggestListings.mock}
+	}
+	mmSuggestListings.defaultExpectation.results = &UseCaseMockSuggestListingsResults{spa1}
+	mmSuggestListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSuggestListings.mock
+}
+
+// Set uses given function f to mock the UseCase.SuggestListings method
+func (mmSuggestListings *mUseCaseMockSuggestListings) Set(f func(ctx context.Context, prefix string, limit int) (spa1 []*entity.Suggestion)) *UseCaseMock {
+	if mmSuggestListings.defaultExpectation != nil {
+		mmSuggestListings.mock.t.Fatalf("Default expectation is already set for the UseCase.SuggestListings method")
+	}
+
+	if len(mmSuggestListings.expectations) > 0 {
+		mmSuggestListings.mock.t.Fatalf("Some expectations are already set for the UseCase.SuggestListings method")
+	}
+
+	mmSuggestListings.mock.funcSuggestListings = f
+	mmSuggestListings.mock.funcSuggestListingsOrigin = minimock.CallerInfo(1)
+	return mmSuggestListings.mock
+}
+
+// When sets expectation for the UseCase.SuggestListings which will trigger the result defined by the following
+// Then helper
+func (mmSuggestListings *mUseCaseMockSuggestListings) When(ctx context.Context, prefix string, limit int) *UseCaseMockSuggestListingsExpectation {
+	if mmSuggestListings.mock.funcSuggestListings != nil {
+		mmSuggestListings.mock.t.Fatalf("UseCaseMock.SuggestListings mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockSuggestListingsExpectation{
+		mock:               mmSuggestListings.mock,
+		params:             &UseCaseMockSuggestListingsParams{ctx, prefix, limit},
+		expectationOrigins: UseCaseMockSuggestListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSuggestListings.expectations = append(mmSuggestListings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.SuggestListings return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockSuggestListingsExpectation) Then(spa1 []*entity.Suggestion) *UseCaseMock {
+	e.results = &UseCaseMockSuggestListingsResults{spa1}
+	return e.mock
+}
+
+// Times sets number of times UseCase.SuggestListings should be invoked
+func (mmSuggestListings *mUseCaseMockSuggestListings) Times(n uint64) *mUseCaseMockSuggestListings {
+	if n == 0 {
+		mmSuggestListings.mock.t.Fatalf("Times of UseCaseMock.SuggestListings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSuggestListings.expectedInvocations, n)
+	mmSuggestListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSuggestListings
+}
+
+func (mmSuggestListings *mUseCaseMockSuggestListings) invocationsDone() bool {
+	if len(mmSuggestListings.expectations) == 0 && mmSuggestListings.defaultExpectation == nil && mmSuggestListings.mock.funcSuggestListings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSuggestListings.mock.afterSuggestListingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSuggestListings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SuggestListings implements mm_listing.UseCase
+func (mmSuggestListings *UseCaseMock) SuggestListings(ctx context.Context, prefix string, limit int) (spa1 []*entity.Suggestion) {
+	mm_atomic.AddUint64(&mmSuggestListings.beforeSuggestListingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSuggestListings.afterSuggestListingsCounter, 1)
+
+	mmSuggestListings.t.Helper()
+
+	if mmSuggestListings.inspectFuncSuggestListings != nil {
+		mmSuggestListings.inspectFuncSuggestListings(ctx, prefix, limit)
+	}
+
+	mm_params := UseCaseMockSuggestListingsParams{ctx, prefix, limit}
+
+	// Record call args
+	mmSuggestListings.SuggestListingsMock.mutex.Lock()
+	mmSuggestListings.SuggestListingsMock.callArgs = append(mmSuggestListings.SuggestListingsMock.callArgs, &mm_params)
+	mmSuggestListings.SuggestListingsMock.mutex.Unlock()
+
+	for _, e := range mmSuggestListings.SuggestListingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1
+		}
+	}
+
+	if mmSuggestListings.SuggestListingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSuggestListings.SuggestListingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSuggestListings.SuggestListingsMock.defaultExpectation.params
+		mm_want_ptrs := mmSuggestListings.SuggestListingsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockSuggestListingsParams{ctx, prefix, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSuggestListings.t.Errorf("UseCaseMock.SuggestListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuggestListings.SuggestListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.prefix != nil && !minimock.Equal(*mm_want_ptrs.prefix, mm_got.prefix) {
+				mmSuggestListings.t.Errorf("UseCaseMock.SuggestListings got unexpected parameter prefix, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuggestListings.SuggestListingsMock.defaultExpectation.expectationOrigins.originPrefix, *mm_want_ptrs.prefix, mm_got.prefix, minimock.Diff(*mm_want_ptrs.prefix, mm_got.prefix))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSuggestListings.t.Errorf("UseCaseMock.SuggestListings got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuggestListings.SuggestListingsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSuggestListings.t.Errorf("UseCaseMock.SuggestListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSuggestListings.SuggestListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSuggestListings.SuggestListingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSuggestListings.t.Fatal("No results are set for the UseCaseMock.SuggestListings")
+		}
+		return (*mm_results).spa1
+	}
+	if mmSuggestListings.funcSuggestListings != nil {
+		return mmSuggestListings.funcSuggestListings(ctx, prefix, limit)
+	}
+	mmSuggestListings.t.Fatalf("Unexpected call to UseCaseMock.SuggestListings. %v %v %v", ctx, prefix, limit)
+	return
+}
+
+// SuggestListingsAfterCounter returns a count of finished UseCaseMock.SuggestListings invocations
+func (mmSuggestListings *UseCaseMock) SuggestListingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuggestListings.afterSuggestListingsCounter)
+}
+
+// SuggestListingsBeforeCounter returns a count of UseCaseMock.SuggestListings invocations
+func (mmSuggestListings *UseCaseMock) SuggestListingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuggestListings.beforeSuggestListingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.SuggestListings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSuggestListings *mUseCaseMockSuggestListings) Calls() []*UseCaseMockSuggestListingsParams {
+	mmSuggestListings.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockSuggestListingsParams, len(mmSuggestListings.callArgs))
+	copy(argCopy, mmSuggestListings.callArgs)
+
+	mmSuggestListings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSuggestListingsDone returns true if the count of the SuggestListings invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockSuggestListingsDone() bool {
+	if m.SuggestListingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SuggestListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SuggestListingsMock.invocationsDone()
+}
+
+// MinimockSuggestListingsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockSuggestListingsInspect() {
+	for _, e := range m.SuggestListingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.SuggestListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSuggestListingsCounter := mm_atomic.LoadUint64(&m.afterSuggestListingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SuggestListingsMock.defaultExpectation != nil && afterSuggestListingsCounter < 1 {
+		if m.SuggestListingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.SuggestListings at\n%s", m.SuggestListingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.SuggestListings at\n%s with params: %#v", m.SuggestListingsMock.defaultExpectation.expectationOrigins.origin, *m.SuggestListingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSuggestListings != nil && afterSuggestListingsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.SuggestListings at\n%s", m.funcSuggestListingsOrigin)
+	}
+
+	if !m.SuggestListingsMock.invocationsDone() && afterSuggestListingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.SuggestListings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SuggestListingsMock.expectedInvocations), m.SuggestListingsMock.expectedInvocationsOrigin, afterSuggestListingsCounter)
+	}
+}
+
+type mUseCaseMockUpdateListing struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockUpdateListingExpectation
+	expectations       []*UseCaseMockUpdateListingExpectation
+
+	callArgs []*UseCaseMockUpdateListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockUpdateListingExpectation specifies expectation struct of the UseCase.UpdateListing
+type UseCaseMockUpdateListingExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockUpdateListingParams
+	paramPtrs          *UseCaseMockUpdateListingParamPtrs
+	expectationOrigins UseCaseMockUpdateListingExpectationOrigins
+	results            *UseCaseMockUpdateListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockUpdateListingParams contains parameters of the UseCase.UpdateListing
+type UseCaseMockUpdateListingParams struct {
+	ctx    context.Context
+	userID uint64
+	update *entity.ListingUpdate
+}
+
+// UseCaseMockUpdateListingParamPtrs contains pointers to parameters of the UseCase.UpdateListing
+type UseCaseMockUpdateListingParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	update **entity.ListingUpdate
+}
+
+// UseCaseMockUpdateListingResults contains results of the UseCase.UpdateListing
+type UseCaseMockUpdateListingResults struct {
+	lp1 *entity.Listing
+	err error
+}
+
+// UseCaseMockUpdateListingOrigins contains origins of expectations of the UseCase.UpdateListing
+type UseCaseMockUpdateListingExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originUpdate string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -590,432 +9263,663 @@ type UseCaseMockGetListingsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetListings *mUseCaseMockGetListings) Optional() *mUseCaseMockGetListings {
-	mmGetListings.optional = true
-	return mmGetListings
+func (mmUpdateListing *mUseCaseMockUpdateListing) Optional() *mUseCaseMockUpdateListing {
+	mmUpdateListing.optional = true
+	return mmUpdateListing
 }
 
-// Expect sets up expected params for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) Expect(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+// Expect sets up expected params for UseCase.UpdateListing
+func (mmUpdateListing *mUseCaseMockUpdateListing) Expect(ctx context.Context, userID uint64, update *entity.ListingUpdate) *mUseCaseMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &UseCaseMockUpdateListingExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by ExpectParams functions")
+	if mmUpdateListing.defaultExpectation.paramPtrs != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateListing.defaultExpectation.params = &UseCaseMockUpdateListingParams{ctx, userID, update}
+	mmUpdateListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateListing.expectations {
+		if minimock.Equal(e.params, mmUpdateListing.defaultExpectation.params) {
+			mmUpdateListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateListing.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.UpdateListing
+func (mmUpdateListing *mUseCaseMockUpdateListing) ExpectCtxParam1(ctx context.Context) *mUseCaseMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &UseCaseMockUpdateListingExpectation{}
+	}
+
+	if mmUpdateListing.defaultExpectation.params != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Expect")
+	}
+
+	if mmUpdateListing.defaultExpectation.paramPtrs == nil {
+		mmUpdateListing.defaultExpectation.paramPtrs = &UseCaseMockUpdateListingParamPtrs{}
+	}
+	mmUpdateListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateListing
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.UpdateListing
+func (mmUpdateListing *mUseCaseMockUpdateListing) ExpectUserIDParam2(userID uint64) *mUseCaseMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &UseCaseMockUpdateListingExpectation{}
+	}
+
+	if mmUpdateListing.defaultExpectation.params != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Expect")
+	}
+
+	if mmUpdateListing.defaultExpectation.paramPtrs == nil {
+		mmUpdateListing.defaultExpectation.paramPtrs = &UseCaseMockUpdateListingParamPtrs{}
+	}
+	mmUpdateListing.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateListing.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateListing
+}
+
+// ExpectUpdateParam3 sets up expected param update for UseCase.UpdateListing
+func (mmUpdateListing *mUseCaseMockUpdateListing) ExpectUpdateParam3(update *entity.ListingUpdate) *mUseCaseMockUpdateListing {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &UseCaseMockUpdateListingExpectation{}
+	}
+
+	if mmUpdateListing.defaultExpectation.params != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Expect")
+	}
+
+	if mmUpdateListing.defaultExpectation.paramPtrs == nil {
+		mmUpdateListing.defaultExpectation.paramPtrs = &UseCaseMockUpdateListingParamPtrs{}
+	}
+	mmUpdateListing.defaultExpectation.paramPtrs.update = &update
+	mmUpdateListing.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.UpdateListing
+func (mmUpdateListing *mUseCaseMockUpdateListing) Inspect(f func(ctx context.Context, userID uint64, update *entity.ListingUpdate)) *mUseCaseMockUpdateListing {
+	if mmUpdateListing.mock.inspectFuncUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("Inspect function is already set for UseCaseMock.UpdateListing")
+	}
+
+	mmUpdateListing.mock.inspectFuncUpdateListing = f
+
+	return mmUpdateListing
+}
+
+// Return sets up results that will be returned by UseCase.UpdateListing
+func (mmUpdateListing *mUseCaseMockUpdateListing) Return(lp1 *entity.Listing, err error) *UseCaseMock {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Set")
+	}
+
+	if mmUpdateListing.defaultExpectation == nil {
+		mmUpdateListing.defaultExpectation = &UseCaseMockUpdateListingExpectation{mock: mmUpdateListing.mock}
+	}
+	mmUpdateListing.defaultExpectation.results = &UseCaseMockUpdateListingResults{lp1, err}
+	mmUpdateListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateListing.mock
+}
+
+// Set uses given function f to mock the UseCase.UpdateListing method
+func (mmUpdateListing *mUseCaseMockUpdateListing) Set(f func(ctx context.Context, userID uint64, update *entity.ListingUpdate) (lp1 *entity.Listing, err error)) *UseCaseMock {
+	if mmUpdateListing.defaultExpectation != nil {
+		mmUpdateListing.mock.t.Fatalf("Default expectation is already set for the UseCase.UpdateListing method")
+	}
+
+	if len(mmUpdateListing.expectations) > 0 {
+		mmUpdateListing.mock.t.Fatalf("Some expectations are already set for the UseCase.UpdateListing method")
+	}
+
+	mmUpdateListing.mock.funcUpdateListing = f
+	mmUpdateListing.mock.funcUpdateListingOrigin = minimock.CallerInfo(1)
+	return mmUpdateListing.mock
+}
+
+// When sets expectation for the UseCase.UpdateListing which will trigger the result defined by the following
+// Then helper
+func (mmUpdateListing *mUseCaseMockUpdateListing) When(ctx context.Context, userID uint64, update *entity.ListingUpdate) *UseCaseMockUpdateListingExpectation {
+	if mmUpdateListing.mock.funcUpdateListing != nil {
+		mmUpdateListing.mock.t.Fatalf("UseCaseMock.UpdateListing mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockUpdateListingExpectation{
+		mock:               mmUpdateListing.mock,
+		params:             &UseCaseMockUpdateListingParams{ctx, userID, update},
+		expectationOrigins: UseCaseMockUpdateListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateListing.expectations = append(mmUpdateListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.UpdateListing return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockUpdateListingExpectation) Then(lp1 *entity.Listing, err error) *UseCaseMock {
+	e.results = &UseCaseMockUpdateListingResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.UpdateListing should be invoked
+func (mmUpdateListing *mUseCaseMockUpdateListing) Times(n uint64) *mUseCaseMockUpdateListing {
+	if n == 0 {
+		mmUpdateListing.mock.t.Fatalf("Times of UseCaseMock.UpdateListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateListing.expectedInvocations, n)
+	mmUpdateListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateListing
+}
+
+func (mmUpdateListing *mUseCaseMockUpdateListing) invocationsDone() bool {
+	if len(mmUpdateListing.expectations) == 0 && mmUpdateListing.defaultExpectation == nil && mmUpdateListing.mock.funcUpdateListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateListing.mock.afterUpdateListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateListing implements mm_listing.UseCase
+func (mmUpdateListing *UseCaseMock) UpdateListing(ctx context.Context, userID uint64, update *entity.ListingUpdate) (lp1 *entity.Listing, err error) {
+	mm_atomic.AddUint64(&mmUpdateListing.beforeUpdateListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateListing.afterUpdateListingCounter, 1)
+
+	mmUpdateListing.t.Helper()
+
+	if mmUpdateListing.inspectFuncUpdateListing != nil {
+		mmUpdateListing.inspectFuncUpdateListing(ctx, userID, update)
+	}
+
+	mm_params := UseCaseMockUpdateListingParams{ctx, userID, update}
+
+	// Record call args
+	mmUpdateListing.UpdateListingMock.mutex.Lock()
+	mmUpdateListing.UpdateListingMock.callArgs = append(mmUpdateListing.UpdateListingMock.callArgs, &mm_params)
+	mmUpdateListing.UpdateListingMock.mutex.Unlock()
+
+	for _, e := range mmUpdateListing.UpdateListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
 	}
 
-	mmGetListings.defaultExpectation.params = &UseCaseMockGetListingsParams{ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice}
-	mmGetListings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetListings.expectations {
-		if minimock.Equal(e.params, mmGetListings.defaultExpectation.params) {
-			mmGetListings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListings.defaultExpectation.params)
+	if mmUpdateListing.UpdateListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateListing.UpdateListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateListing.UpdateListingMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateListing.UpdateListingMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockUpdateListingParams{ctx, userID, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateListing.t.Errorf("UseCaseMock.UpdateListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateListing.t.Errorf("UseCaseMock.UpdateListing got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateListing.t.Errorf("UseCaseMock.UpdateListing got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateListing.t.Errorf("UseCaseMock.UpdateListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateListing.UpdateListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateListing.UpdateListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateListing.t.Fatal("No results are set for the UseCaseMock.UpdateListing")
 		}
+		return (*mm_results).lp1, (*mm_results).err
 	}
+	if mmUpdateListing.funcUpdateListing != nil {
+		return mmUpdateListing.funcUpdateListing(ctx, userID, update)
+	}
+	mmUpdateListing.t.Fatalf("Unexpected call to UseCaseMock.UpdateListing. %v %v %v", ctx, userID, update)
+	return
+}
 
-	return mmGetListings
+// UpdateListingAfterCounter returns a count of finished UseCaseMock.UpdateListing invocations
+func (mmUpdateListing *UseCaseMock) UpdateListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateListing.afterUpdateListingCounter)
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
-	}
+// UpdateListingBeforeCounter returns a count of UseCaseMock.UpdateListing invocations
+func (mmUpdateListing *UseCaseMock) UpdateListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateListing.beforeUpdateListingCounter)
+}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
-	}
+// Calls returns a list of arguments used in each call to UseCaseMock.UpdateListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateListing *mUseCaseMockUpdateListing) Calls() []*UseCaseMockUpdateListingParams {
+	mmUpdateListing.mutex.RLock()
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
-	}
+	argCopy := make([]*UseCaseMockUpdateListingParams, len(mmUpdateListing.callArgs))
+	copy(argCopy, mmUpdateListing.callArgs)
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
-	}
-	mmGetListings.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetListings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateListing.mutex.RUnlock()
 
-	return mmGetListings
+	return argCopy
 }
 
-// ExpectPageParam2 sets up expected param page for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectPageParam2(page uint32) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
-	}
-
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
-	}
-
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+// MinimockUpdateListingDone returns true if the count of the UpdateListing invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockUpdateListingDone() bool {
+	if m.UpdateListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	for _, e := range m.UpdateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
-	mmGetListings.defaultExpectation.paramPtrs.page = &page
-	mmGetListings.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
 
-	return mmGetListings
+	return m.UpdateListingMock.invocationsDone()
 }
 
-// ExpectPerPageParam3 sets up expected param perPage for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectPerPageParam3(perPage uint32) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+// MinimockUpdateListingInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockUpdateListingInspect() {
+	for _, e := range m.UpdateListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	afterUpdateListingCounter := mm_atomic.LoadUint64(&m.afterUpdateListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateListingMock.defaultExpectation != nil && afterUpdateListingCounter < 1 {
+		if m.UpdateListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateListing at\n%s", m.UpdateListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateListing at\n%s with params: %#v", m.UpdateListingMock.defaultExpectation.expectationOrigins.origin, *m.UpdateListingMock.defaultExpectation.params)
+		}
 	}
-
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateListing != nil && afterUpdateListingCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.UpdateListing at\n%s", m.funcUpdateListingOrigin)
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	if !m.UpdateListingMock.invocationsDone() && afterUpdateListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.UpdateListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateListingMock.expectedInvocations), m.UpdateListingMock.expectedInvocationsOrigin, afterUpdateListingCounter)
 	}
-	mmGetListings.defaultExpectation.paramPtrs.perPage = &perPage
-	mmGetListings.defaultExpectation.expectationOrigins.originPerPage = minimock.CallerInfo(1)
+}
 
-	return mmGetListings
+type mUseCaseMockUpdateSavedSearch struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockUpdateSavedSearchExpectation
+	expectations       []*UseCaseMockUpdateSavedSearchExpectation
+
+	callArgs []*UseCaseMockUpdateSavedSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
 }
 
-// ExpectSortByParam4 sets up expected param sortBy for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectSortByParam4(sortBy string) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
-	}
+// UseCaseMockUpdateSavedSearchExpectation specifies expectation struct of the UseCase.UpdateSavedSearch
+type UseCaseMockUpdateSavedSearchExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockUpdateSavedSearchParams
+	paramPtrs          *UseCaseMockUpdateSavedSearchParamPtrs
+	expectationOrigins UseCaseMockUpdateSavedSearchExpectationOrigins
+	results            *UseCaseMockUpdateSavedSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
-	}
+// UseCaseMockUpdateSavedSearchParams contains parameters of the UseCase.UpdateSavedSearch
+type UseCaseMockUpdateSavedSearchParams struct {
+	ctx    context.Context
+	search *entity.SavedSearch
+}
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
-	}
+// UseCaseMockUpdateSavedSearchParamPtrs contains pointers to parameters of the UseCase.UpdateSavedSearch
+type UseCaseMockUpdateSavedSearchParamPtrs struct {
+	ctx    *context.Context
+	search **entity.SavedSearch
+}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
-	}
-	mmGetListings.defaultExpectation.paramPtrs.sortBy = &sortBy
-	mmGetListings.defaultExpectation.expectationOrigins.originSortBy = minimock.CallerInfo(1)
+// UseCaseMockUpdateSavedSearchResults contains results of the UseCase.UpdateSavedSearch
+type UseCaseMockUpdateSavedSearchResults struct {
+	sp1 *entity.SavedSearch
+	err error
+}
 
-	return mmGetListings
+// UseCaseMockUpdateSavedSearchOrigins contains origins of expectations of the UseCase.UpdateSavedSearch
+type UseCaseMockUpdateSavedSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSearch string
 }
 
-// ExpectSortDescParam5 sets up expected param sortDesc for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectSortDescParam5(sortDesc bool) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Optional() *mUseCaseMockUpdateSavedSearch {
+	mmUpdateSavedSearch.optional = true
+	return mmUpdateSavedSearch
+}
+
+// Expect sets up expected params for UseCase.UpdateSavedSearch
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Expect(ctx context.Context, search *entity.SavedSearch) *mUseCaseMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &UseCaseMockUpdateSavedSearchExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+	if mmUpdateSavedSearch.defaultExpectation.paramPtrs != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by ExpectParams functions")
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	mmUpdateSavedSearch.defaultExpectation.params = &UseCaseMockUpdateSavedSearchParams{ctx, search}
+	mmUpdateSavedSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSavedSearch.expectations {
+		if minimock.Equal(e.params, mmUpdateSavedSearch.defaultExpectation.params) {
+			mmUpdateSavedSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSavedSearch.defaultExpectation.params)
+		}
 	}
-	mmGetListings.defaultExpectation.paramPtrs.sortDesc = &sortDesc
-	mmGetListings.defaultExpectation.expectationOrigins.originSortDesc = minimock.CallerInfo(1)
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// ExpectMinPriceParam6 sets up expected param minPrice for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectMinPriceParam6(minPrice *float32) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UseCase.UpdateSavedSearch
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) ExpectCtxParam1(ctx context.Context) *mUseCaseMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &UseCaseMockUpdateSavedSearchExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+	if mmUpdateSavedSearch.defaultExpectation.params != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Expect")
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	if mmUpdateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmUpdateSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockUpdateSavedSearchParamPtrs{}
 	}
-	mmGetListings.defaultExpectation.paramPtrs.minPrice = &minPrice
-	mmGetListings.defaultExpectation.expectationOrigins.originMinPrice = minimock.CallerInfo(1)
+	mmUpdateSavedSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSavedSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// ExpectMaxPriceParam7 sets up expected param maxPrice for UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) ExpectMaxPriceParam7(maxPrice *float32) *mUseCaseMockGetListings {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+// ExpectSearchParam2 sets up expected param search for UseCase.UpdateSavedSearch
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) ExpectSearchParam2(search *entity.SavedSearch) *mUseCaseMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &UseCaseMockUpdateSavedSearchExpectation{}
 	}
 
-	if mmGetListings.defaultExpectation.params != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Expect")
+	if mmUpdateSavedSearch.defaultExpectation.params != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Expect")
 	}
 
-	if mmGetListings.defaultExpectation.paramPtrs == nil {
-		mmGetListings.defaultExpectation.paramPtrs = &UseCaseMockGetListingsParamPtrs{}
+	if mmUpdateSavedSearch.defaultExpectation.paramPtrs == nil {
+		mmUpdateSavedSearch.defaultExpectation.paramPtrs = &UseCaseMockUpdateSavedSearchParamPtrs{}
 	}
-	mmGetListings.defaultExpectation.paramPtrs.maxPrice = &maxPrice
-	mmGetListings.defaultExpectation.expectationOrigins.originMaxPrice = minimock.CallerInfo(1)
+	mmUpdateSavedSearch.defaultExpectation.paramPtrs.search = &search
+	mmUpdateSavedSearch.defaultExpectation.expectationOrigins.originSearch = minimock.CallerInfo(1)
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// Inspect accepts an inspector function that has same arguments as the UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) Inspect(f func(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32)) *mUseCaseMockGetListings {
-	if mmGetListings.mock.inspectFuncGetListings != nil {
-		mmGetListings.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetListings")
+// Inspect accepts an inspector function that has same arguments as the UseCase.UpdateSavedSearch
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Inspect(f func(ctx context.Context, search *entity.SavedSearch)) *mUseCaseMockUpdateSavedSearch {
+	if mmUpdateSavedSearch.mock.inspectFuncUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("Inspect function is already set for UseCaseMock.UpdateSavedSearch")
 	}
 
-	mmGetListings.mock.inspectFuncGetListings = f
+	mmUpdateSavedSearch.mock.inspectFuncUpdateSavedSearch = f
 
-	return mmGetListings
+	return mmUpdateSavedSearch
 }
 
-// Return sets up results that will be returned by UseCase.GetListings
-func (mmGetListings *mUseCaseMockGetListings) Return(lpa1 []*entity.Listing, u1 uint32, err error) *UseCaseMock {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+// Return sets up results that will be returned by UseCase.UpdateSavedSearch
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Return(sp1 *entity.SavedSearch, err error) *UseCaseMock {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	if mmGetListings.defaultExpectation == nil {
-		mmGetListings.defaultExpectation = &UseCaseMockGetListingsExpectation{mock: mmGetListings.mock}
+	if mmUpdateSavedSearch.defaultExpectation == nil {
+		mmUpdateSavedSearch.defaultExpectation = &UseCaseMockUpdateSavedSearchExpectation{mock: mmUpdateSavedSearch.mock}
 	}
-	mmGetListings.defaultExpectation.results = &UseCaseMockGetListingsResults{lpa1, u1, err}
-	mmGetListings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetListings.mock
+	mmUpdateSavedSearch.defaultExpectation.results = &UseCaseMockUpdateSavedSearchResults{sp1, err}
+	mmUpdateSavedSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSavedSearch.mock
 }
 
-// Set uses given function f to mock the UseCase.GetListings method
-func (mmGetListings *mUseCaseMockGetListings) Set(f func(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32) (lpa1 []*entity.Listing, u1 uint32, err error)) *UseCaseMock {
-	if mmGetListings.defaultExpectation != nil {
-		mmGetListings.mock.t.Fatalf("Default expectation is already set for the UseCase.GetListings method")
+// Set uses given function f to mock the UseCase.UpdateSavedSearch method
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Set(f func(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error)) *UseCaseMock {
+	if mmUpdateSavedSearch.defaultExpectation != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("Default expectation is already set for the UseCase.UpdateSavedSearch method")
 	}
 
-	if len(mmGetListings.expectations) > 0 {
-		mmGetListings.mock.t.Fatalf("Some expectations are already set for the UseCase.GetListings method")
+	if len(mmUpdateSavedSearch.expectations) > 0 {
+		mmUpdateSavedSearch.mock.t.Fatalf("Some expectations are already set for the UseCase.UpdateSavedSearch method")
 	}
 
-	mmGetListings.mock.funcGetListings = f
-	mmGetListings.mock.funcGetListingsOrigin = minimock.CallerInfo(1)
-	return mmGetListings.mock
+	mmUpdateSavedSearch.mock.funcUpdateSavedSearch = f
+	mmUpdateSavedSearch.mock.funcUpdateSavedSearchOrigin = minimock.CallerInfo(1)
+	return mmUpdateSavedSearch.mock
 }
 
-// When sets expectation for the UseCase.GetListings which will trigger the result defined by the following
+// When sets expectation for the UseCase.UpdateSavedSearch which will trigger the result defined by the following
 // Then helper
-func (mmGetListings *mUseCaseMockGetListings) When(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32) *UseCaseMockGetListingsExpectation {
-	if mmGetListings.mock.funcGetListings != nil {
-		mmGetListings.mock.t.Fatalf("UseCaseMock.GetListings mock is already set by Set")
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) When(ctx context.Context, search *entity.SavedSearch) *UseCaseMockUpdateSavedSearchExpectation {
+	if mmUpdateSavedSearch.mock.funcUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.mock.t.Fatalf("UseCaseMock.UpdateSavedSearch mock is already set by Set")
 	}
 
-	expectation := &UseCaseMockGetListingsExpectation{
-		mock:               mmGetListings.mock,
-		params:             &UseCaseMockGetListingsParams{ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice},
-		expectationOrigins: UseCaseMockGetListingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UseCaseMockUpdateSavedSearchExpectation{
+		mock:               mmUpdateSavedSearch.mock,
+		params:             &UseCaseMockUpdateSavedSearchParams{ctx, search},
+		expectationOrigins: UseCaseMockUpdateSavedSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetListings.expectations = append(mmGetListings.expectations, expectation)
+	mmUpdateSavedSearch.expectations = append(mmUpdateSavedSearch.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UseCase.GetListings return parameters for the expectation previously defined by the When method
-func (e *UseCaseMockGetListingsExpectation) Then(lpa1 []*entity.Listing, u1 uint32, err error) *UseCaseMock {
-	e.results = &UseCaseMockGetListingsResults{lpa1, u1, err}
+// Then sets up UseCase.UpdateSavedSearch return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockUpdateSavedSearchExpectation) Then(sp1 *entity.SavedSearch, err error) *UseCaseMock {
+	e.results = &UseCaseMockUpdateSavedSearchResults{sp1, err}
 	return e.mock
 }
 
-// Times sets number of times UseCase.GetListings should be invoked
-func (mmGetListings *mUseCaseMockGetListings) Times(n uint64) *mUseCaseMockGetListings {
+// Times sets number of times UseCase.UpdateSavedSearch should be invoked
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Times(n uint64) *mUseCaseMockUpdateSavedSearch {
 	if n == 0 {
-		mmGetListings.mock.t.Fatalf("Times of UseCaseMock.GetListings mock can not be zero")
+		mmUpdateSavedSearch.mock.t.Fatalf("Times of UseCaseMock.UpdateSavedSearch mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetListings.expectedInvocations, n)
-	mmGetListings.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetListings
+	mm_atomic.StoreUint64(&mmUpdateSavedSearch.expectedInvocations, n)
+	mmUpdateSavedSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSavedSearch
 }
 
-func (mmGetListings *mUseCaseMockGetListings) invocationsDone() bool {
-	if len(mmGetListings.expectations) == 0 && mmGetListings.defaultExpectation == nil && mmGetListings.mock.funcGetListings == nil {
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) invocationsDone() bool {
+	if len(mmUpdateSavedSearch.expectations) == 0 && mmUpdateSavedSearch.defaultExpectation == nil && mmUpdateSavedSearch.mock.funcUpdateSavedSearch == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetListings.mock.afterGetListingsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetListings.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSavedSearch.mock.afterUpdateSavedSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSavedSearch.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetListings implements mm_listing.UseCase
-func (mmGetListings *UseCaseMock) GetListings(ctx context.Context, page uint32, perPage uint32, sortBy string, sortDesc bool, minPrice *float32, maxPrice *float32) (lpa1 []*entity.Listing, u1 uint32, err error) {
-	mm_atomic.AddUint64(&mmGetListings.beforeGetListingsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetListings.afterGetListingsCounter, 1)
+// UpdateSavedSearch implements mm_listing.UseCase
+func (mmUpdateSavedSearch *UseCaseMock) UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (sp1 *entity.SavedSearch, err error) {
+	mm_atomic.AddUint64(&mmUpdateSavedSearch.beforeUpdateSavedSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSavedSearch.afterUpdateSavedSearchCounter, 1)
 
-	mmGetListings.t.Helper()
+	mmUpdateSavedSearch.t.Helper()
 
-	if mmGetListings.inspectFuncGetListings != nil {
-		mmGetListings.inspectFuncGetListings(ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice)
+	if mmUpdateSavedSearch.inspectFuncUpdateSavedSearch != nil {
+		mmUpdateSavedSearch.inspectFuncUpdateSavedSearch(ctx, search)
 	}
 
-	mm_params := UseCaseMockGetListingsParams{ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice}
+	mm_params := UseCaseMockUpdateSavedSearchParams{ctx, search}
 
 	// Record call args
-	mmGetListings.GetListingsMock.mutex.Lock()
-	mmGetListings.GetListingsMock.callArgs = append(mmGetListings.GetListingsMock.callArgs, &mm_params)
-	mmGetListings.GetListingsMock.mutex.Unlock()
+	mmUpdateSavedSearch.UpdateSavedSearchMock.mutex.Lock()
+	mmUpdateSavedSearch.UpdateSavedSearchMock.callArgs = append(mmUpdateSavedSearch.UpdateSavedSearchMock.callArgs, &mm_params)
+	mmUpdateSavedSearch.UpdateSavedSearchMock.mutex.Unlock()
 
-	for _, e := range mmGetListings.GetListingsMock.expectations {
+	for _, e := range mmUpdateSavedSearch.UpdateSavedSearchMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.lpa1, e.results.u1, e.results.err
+			return e.results.sp1, e.results.err
 		}
 	}
 
-	if mmGetListings.GetListingsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetListings.GetListingsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetListings.GetListingsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetListings.GetListingsMock.defaultExpectation.paramPtrs
+	if mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.paramPtrs
 
-		mm_got := UseCaseMockGetListingsParams{ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice}
+		mm_got := UseCaseMockUpdateSavedSearchParams{ctx, search}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
-			}
-
-			if mm_want_ptrs.perPage != nil && !minimock.Equal(*mm_want_ptrs.perPage, mm_got.perPage) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter perPage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originPerPage, *mm_want_ptrs.perPage, mm_got.perPage, minimock.Diff(*mm_want_ptrs.perPage, mm_got.perPage))
-			}
-
-			if mm_want_ptrs.sortBy != nil && !minimock.Equal(*mm_want_ptrs.sortBy, mm_got.sortBy) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter sortBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originSortBy, *mm_want_ptrs.sortBy, mm_got.sortBy, minimock.Diff(*mm_want_ptrs.sortBy, mm_got.sortBy))
-			}
-
-			if mm_want_ptrs.sortDesc != nil && !minimock.Equal(*mm_want_ptrs.sortDesc, mm_got.sortDesc) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter sortDesc, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originSortDesc, *mm_want_ptrs.sortDesc, mm_got.sortDesc, minimock.Diff(*mm_want_ptrs.sortDesc, mm_got.sortDesc))
-			}
-
-			if mm_want_ptrs.minPrice != nil && !minimock.Equal(*mm_want_ptrs.minPrice, mm_got.minPrice) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter minPrice, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originMinPrice, *mm_want_ptrs.minPrice, mm_got.minPrice, minimock.Diff(*mm_want_ptrs.minPrice, mm_got.minPrice))
+				mmUpdateSavedSearch.t.Errorf("UseCaseMock.UpdateSavedSearch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.maxPrice != nil && !minimock.Equal(*mm_want_ptrs.maxPrice, mm_got.maxPrice) {
-				mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameter maxPrice, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.originMaxPrice, *mm_want_ptrs.maxPrice, mm_got.maxPrice, minimock.Diff(*mm_want_ptrs.maxPrice, mm_got.maxPrice))
+			if mm_want_ptrs.search != nil && !minimock.Equal(*mm_want_ptrs.search, mm_got.search) {
+				mmUpdateSavedSearch.t.Errorf("UseCaseMock.UpdateSavedSearch got unexpected parameter search, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.originSearch, *mm_want_ptrs.search, mm_got.search, minimock.Diff(*mm_want_ptrs.search, mm_got.search))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetListings.t.Errorf("UseCaseMock.GetListings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetListings.GetListingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateSavedSearch.t.Errorf("UseCaseMock.UpdateSavedSearch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetListings.GetListingsMock.defaultExpectation.results
+		mm_results := mmUpdateSavedSearch.UpdateSavedSearchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetListings.t.Fatal("No results are set for the UseCaseMock.GetListings")
+			mmUpdateSavedSearch.t.Fatal("No results are set for the UseCaseMock.UpdateSavedSearch")
 		}
-		return (*mm_results).lpa1, (*mm_results).u1, (*mm_results).err
+		return (*mm_results).sp1, (*mm_results).err
 	}
-	if mmGetListings.funcGetListings != nil {
-		return mmGetListings.funcGetListings(ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice)
+	if mmUpdateSavedSearch.funcUpdateSavedSearch != nil {
+		return mmUpdateSavedSearch.funcUpdateSavedSearch(ctx, search)
 	}
-	mmGetListings.t.Fatalf("Unexpected call to UseCaseMock.GetListings. %v %v %v %v %v %v %v", ctx, page, perPage, sortBy, sortDesc, minPrice, maxPrice)
+	mmUpdateSavedSearch.t.Fatalf("Unexpected call to UseCaseMock.UpdateSavedSearch. %v %v", ctx, search)
 	return
 }
 
-// GetListingsAfterCounter returns a count of finished UseCaseMock.GetListings invocations
-func (mmGetListings *UseCaseMock) GetListingsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetListings.afterGetListingsCounter)
+// UpdateSavedSearchAfterCounter returns a count of finished UseCaseMock.UpdateSavedSearch invocations
+func (mmUpdateSavedSearch *UseCaseMock) UpdateSavedSearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSavedSearch.afterUpdateSavedSearchCounter)
 }
 
-// GetListingsBeforeCounter returns a count of UseCaseMock.GetListings invocations
-func (mmGetListings *UseCaseMock) GetListingsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetListings.beforeGetListingsCounter)
+// UpdateSavedSearchBeforeCounter returns a count of UseCaseMock.UpdateSavedSearch invocations
+func (mmUpdateSavedSearch *UseCaseMock) UpdateSavedSearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSavedSearch.beforeUpdateSavedSearchCounter)
 }
 
-// Calls returns a list of arguments used in each call to UseCaseMock.GetListings.
+// Calls returns a list of arguments used in each call to UseCaseMock.UpdateSavedSearch.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetListings *mUseCaseMockGetListings) Calls() []*UseCaseMockGetListingsParams {
-	mmGetListings.mutex.RLock()
+func (mmUpdateSavedSearch *mUseCaseMockUpdateSavedSearch) Calls() []*UseCaseMockUpdateSavedSearchParams {
+	mmUpdateSavedSearch.mutex.RLock()
 
-	argCopy := make([]*UseCaseMockGetListingsParams, len(mmGetListings.callArgs))
-	copy(argCopy, mmGetListings.callArgs)
+	argCopy := make([]*UseCaseMockUpdateSavedSearchParams, len(mmUpdateSavedSearch.callArgs))
+	copy(argCopy, mmUpdateSavedSearch.callArgs)
 
-	mmGetListings.mutex.RUnlock()
+	mmUpdateSavedSearch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetListingsDone returns true if the count of the GetListings invocations corresponds
+// MinimockUpdateSavedSearchDone returns true if the count of the UpdateSavedSearch invocations corresponds
 // the number of defined expectations
-func (m *UseCaseMock) MinimockGetListingsDone() bool {
-	if m.GetListingsMock.optional {
+func (m *UseCaseMock) MinimockUpdateSavedSearchDone() bool {
+	if m.UpdateSavedSearchMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetListingsMock.expectations {
+	for _, e := range m.UpdateSavedSearchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetListingsMock.invocationsDone()
+	return m.UpdateSavedSearchMock.invocationsDone()
 }
 
-// MinimockGetListingsInspect logs each unmet expectation
-func (m *UseCaseMock) MinimockGetListingsInspect() {
-	for _, e := range m.GetListingsMock.expectations {
+// MinimockUpdateSavedSearchInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockUpdateSavedSearchInspect() {
+	for _, e := range m.UpdateSavedSearchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UseCaseMock.UpdateSavedSearch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetListingsCounter := mm_atomic.LoadUint64(&m.afterGetListingsCounter)
+	afterUpdateSavedSearchCounter := mm_atomic.LoadUint64(&m.afterUpdateSavedSearchCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetListingsMock.defaultExpectation != nil && afterGetListingsCounter < 1 {
-		if m.GetListingsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s", m.GetListingsMock.defaultExpectation.returnOrigin)
+	if m.UpdateSavedSearchMock.defaultExpectation != nil && afterUpdateSavedSearchCounter < 1 {
+		if m.UpdateSavedSearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateSavedSearch at\n%s", m.UpdateSavedSearchMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s with params: %#v", m.GetListingsMock.defaultExpectation.expectationOrigins.origin, *m.GetListingsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UseCaseMock.UpdateSavedSearch at\n%s with params: %#v", m.UpdateSavedSearchMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSavedSearchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetListings != nil && afterGetListingsCounter < 1 {
-		m.t.Errorf("Expected call to UseCaseMock.GetListings at\n%s", m.funcGetListingsOrigin)
+	if m.funcUpdateSavedSearch != nil && afterUpdateSavedSearchCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.UpdateSavedSearch at\n%s", m.funcUpdateSavedSearchOrigin)
 	}
 
-	if !m.GetListingsMock.invocationsDone() && afterGetListingsCounter > 0 {
-		m.t.Errorf("Expected %d calls to UseCaseMock.GetListings at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetListingsMock.expectedInvocations), m.GetListingsMock.expectedInvocationsOrigin, afterGetListingsCounter)
+	if !m.UpdateSavedSearchMock.invocationsDone() && afterUpdateSavedSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.UpdateSavedSearch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSavedSearchMock.expectedInvocations), m.UpdateSavedSearchMock.expectedInvocationsOrigin, afterUpdateSavedSearchCounter)
 	}
 }
 
@@ -1023,9 +9927,57 @@ func (m *UseCaseMock) MinimockGetListingsInspect() {
 func (m *UseCaseMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddFavoriteInspect()
+
+			m.MinimockAddListingImagesInspect()
+
+			m.MinimockChangeListingStatusInspect()
+
 			m.MinimockCreateListingInspect()
 
+			m.MinimockCreateSavedSearchInspect()
+
+			m.MinimockDeleteListingInspect()
+
+			m.MinimockDeleteSavedSearchInspect()
+
+			m.MinimockGetListingInspect()
+
+			m.MinimockGetListingFacetsInspect()
+
 			m.MinimockGetListingsInspect()
+
+			m.MinimockGetMyListingsInspect()
+
+			m.MinimockGetSavedSearchInspect()
+
+			m.MinimockGetUserListingsInspect()
+
+			m.MinimockListFavoritesInspect()
+
+			m.MinimockListSavedSearchesInspect()
+
+			m.MinimockMatchSavedSearchesInspect()
+
+			m.MinimockPurgeDeletedListingsInspect()
+
+			m.MinimockRefreshSuggestionsInspect()
+
+			m.MinimockRemoveFavoriteInspect()
+
+			m.MinimockRemoveListingImageInspect()
+
+			m.MinimockReorderListingImagesInspect()
+
+			m.MinimockRestoreListingInspect()
+
+			m.MinimockSearchListingsInspect()
+
+			m.MinimockSuggestListingsInspect()
+
+			m.MinimockUpdateListingInspect()
+
+			m.MinimockUpdateSavedSearchInspect()
 		}
 	})
 }
@@ -1049,6 +10001,30 @@ func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UseCaseMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddFavoriteDone() &&
+		m.MinimockAddListingImagesDone() &&
+		m.MinimockChangeListingStatusDone() &&
 		m.MinimockCreateListingDone() &&
-		m.MinimockGetListingsDone()
+		m.MinimockCreateSavedSearchDone() &&
+		m.MinimockDeleteListingDone() &&
+		m.MinimockDeleteSavedSearchDone() &&
+		m.MinimockGetListingDone() &&
+		m.MinimockGetListingFacetsDone() &&
+		m.MinimockGetListingsDone() &&
+		m.MinimockGetMyListingsDone() &&
+		m.MinimockGetSavedSearchDone() &&
+		m.MinimockGetUserListingsDone() &&
+		m.MinimockListFavoritesDone() &&
+		m.MinimockListSavedSearchesDone() &&
+		m.MinimockMatchSavedSearchesDone() &&
+		m.MinimockPurgeDeletedListingsDone() &&
+		m.MinimockRefreshSuggestionsDone() &&
+		m.MinimockRemoveFavoriteDone() &&
+		m.MinimockRemoveListingImageDone() &&
+		m.MinimockReorderListingImagesDone() &&
+		m.MinimockRestoreListingDone() &&
+		m.MinimockSearchListingsDone() &&
+		m.MinimockSuggestListingsDone() &&
+		m.MinimockUpdateListingDone() &&
+		m.MinimockUpdateSavedSearchDone()
 }
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// GetListingFacets считает объявления в разрезе категорий, статусов и диапазонов цен.
+// Каждый фасет считается по фильтру без собственного условия, чтобы показывать альтернативы текущему выбору
+func (r *Repository) GetListingFacets(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) (*entity.ListingFacets, error) {
+	facets := &entity.ListingFacets{}
+
+	builder := newListingsQueryBuilder(filter)
+	countQuery := "SELECT COUNT(*) " + listingsTableClause + builder.whereClause()
+
+	if err := r.db.QueryRow(ctx, countQuery, builder.args...).Scan(&facets.Total); err != nil {
+		r.logger.Error(ctx, "Ошибка при подсчете объявлений", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+
+	var err error
+	if facets.Categories, err = r.getCategoryFacets(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	if facets.Statuses, err = r.getStatusFacets(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	if facets.PriceHistogram, err = r.getPriceHistogram(ctx, filter, priceBuckets); err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// getCategoryFacets считает объявления по категориям без учета фильтра по категории
+func (r *Repository) getCategoryFacets(ctx context.Context, filter *entity.ListingFilter) ([]*entity.CategoryFacet, error) {
+	facetFilter := *filter
+	facetFilter.CategoryID = nil
+
+	builder := newListingsQueryBuilder(&facetFilter)
+	query := `
+		SELECT c.id, c.name, COUNT(*)
+		FROM listings l
+		JOIN categories c ON c.id = l.category_id
+		WHERE l.deleted_at IS NULL` + builder.whereClause() + `
+		GROUP BY c.id, c.name
+		ORDER BY COUNT(*) DESC, c.name`
+
+	rows, err := r.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при подсчете объявлений по категориям", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+	defer rows.Close()
+
+	facets := make([]*entity.CategoryFacet, 0)
+	for rows.Next() {
+		facet := &entity.CategoryFacet{}
+		if err := rows.Scan(&facet.CategoryID, &facet.Name, &facet.Count); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании фасета категории", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+		}
+		facets = append(facets, facet)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке фасетов категорий", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+
+	return facets, nil
+}
+
+// getStatusFacets считает объявления по статусам, доступным в ленте, без учета фильтра по статусу
+func (r *Repository) getStatusFacets(ctx context.Context, filter *entity.ListingFilter) ([]*entity.StatusFacet, error) {
+	facetFilter := *filter
+	facetFilter.Status = ""
+
+	builder := newListingsQueryBuilder(&facetFilter)
+	builder.where("l.status = %s", string(entity.ListingStatusActive))
+
+	query := `
+		SELECT l.status, COUNT(*) ` + listingsTableClause + builder.whereClause() + `
+		GROUP BY l.status
+		ORDER BY COUNT(*) DESC, l.status`
+
+	rows, err := r.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при подсчете объявлений по статусам", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+	defer rows.Close()
+
+	facets := make([]*entity.StatusFacet, 0)
+	for rows.Next() {
+		facet := &entity.StatusFacet{}
+		if err := rows.Scan(&facet.Status, &facet.Count); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании фасета статуса", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+		}
+		facets = append(facets, facet)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке фасетов статусов", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+
+	return facets, nil
+}
+
+// getPriceHistogram считает объявления по диапазонам цен без учета фильтра по цене.
+// Границы priceBuckets должны быть в одной валюте и отсортированы по возрастанию,
+// в гистограмму попадают только объявления в этой валюте
+func (r *Repository) getPriceHistogram(ctx context.Context, filter *entity.ListingFilter, priceBuckets []entity.Money) ([]*entity.PriceBucket, error) {
+	if len(priceBuckets) == 0 {
+		return []*entity.PriceBucket{}, nil
+	}
+
+	facetFilter := *filter
+	facetFilter.MinPrice = nil
+	facetFilter.MaxPrice = nil
+
+	boundaries := make([]pgtype.Numeric, 0, len(priceBuckets))
+	for _, boundary := range priceBuckets {
+		boundaries = append(boundaries, numericFromMinor(boundary.Amount))
+	}
+
+	builder := newListingsQueryBuilder(&facetFilter)
+	builder.where("l.currency = %s", priceBuckets[0].Currency)
+
+	query := `
+		SELECT width_bucket(l.price, ` + builder.arg(boundaries) + `::numeric[]) AS bucket, COUNT(*) ` +
+		listingsTableClause + builder.whereClause() + `
+		GROUP BY bucket`
+
+	rows, err := r.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при построении гистограммы цен", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+	defer rows.Close()
+
+	// width_bucket возвращает 0 для цен ниже первой границы и len(boundaries) для цен не ниже последней
+	histogram := make([]*entity.PriceBucket, len(priceBuckets)+1)
+	for i := range histogram {
+		bucket := &entity.PriceBucket{}
+		if i > 0 {
+			bucket.From = &priceBuckets[i-1]
+		}
+		if i < len(priceBuckets) {
+			bucket.To = &priceBuckets[i]
+		}
+		histogram[i] = bucket
+	}
+
+	for rows.Next() {
+		var index int
+		var count uint32
+		if err := rows.Scan(&index, &count); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании диапазона цен", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+		}
+		if index >= 0 && index < len(histogram) {
+			histogram[index].Count = count
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке гистограммы цен", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении фасетов")
+	}
+
+	return histogram, nil
+}
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// favoritesListingFKey имя внешнего ключа избранного на объявление
+const favoritesListingFKey = "favorites_listing_id_fkey"
+
+// AddFavorite добавляет объявление в избранное пользователя и задает порог цены для уведомлений.
+// Повторное добавление заменяет порог цены, не меняя дату добавления
+func (r *Repository) AddFavorite(ctx context.Context, userID, listingID uint64, alertPrice *entity.Money) (*entity.FavoriteState, error) {
+	var price pgtype.Numeric
+	var currency *string
+	if alertPrice != nil {
+		price = numericFromMinor(alertPrice.Amount)
+		currency = &alertPrice.Currency
+	}
+
+	// Основной запрос не видит строку, вставленную в CTE, поэтому она учитывается отдельно.
+	// xmax равен нулю только у новой строки, обновленная при конфликте строка не учитывается повторно
+	query := `
+		WITH upserted AS (
+			INSERT INTO favorites (user_id, listing_id, alert_price, alert_currency)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_id, listing_id) DO UPDATE SET
+				alert_price = EXCLUDED.alert_price,
+				alert_currency = EXCLUDED.alert_currency
+			RETURNING xmax = 0 AS inserted
+		)
+		SELECT (SELECT COUNT(*) FROM favorites WHERE listing_id = $2) + (SELECT COUNT(*) FROM upserted WHERE inserted)`
+
+	state := &entity.FavoriteState{ListingID: listingID, IsFavorite: true, AlertPrice: alertPrice}
+	if err := r.db.QueryRow(ctx, query, userID, listingID, price, currency).Scan(&state.FavoritesCount); err != nil {
+		if isForeignKeyViolation(err, favoritesListingFKey) {
+			return nil, app_errors.ErrListingNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при добавлении объявления в избранное",
+			zap.Uint64("user_id", userID),
+			zap.Uint64("listing_id", listingID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при добавлении объявления в избранное")
+	}
+
+	return state, nil
+}
+
+// RemoveFavorite удаляет объявление из избранного пользователя. Отсутствие объявления в избранном не является ошибкой
+func (r *Repository) RemoveFavorite(ctx context.Context, userID, listingID uint64) (*entity.FavoriteState, error) {
+	query := `
+		WITH deleted AS (
+			DELETE FROM favorites
+			WHERE user_id = $1 AND listing_id = $2
+			RETURNING listing_id
+		)
+		SELECT (SELECT COUNT(*) FROM favorites WHERE listing_id = $2) - (SELECT COUNT(*) FROM deleted)`
+
+	state := &entity.FavoriteState{ListingID: listingID}
+	if err := r.db.QueryRow(ctx, query, userID, listingID).Scan(&state.FavoritesCount); err != nil {
+		r.logger.Error(ctx, "Ошибка при удалении объявления из избранного",
+			zap.Uint64("user_id", userID),
+			zap.Uint64("listing_id", listingID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при удалении объявления из избранного")
+	}
+
+	return state, nil
+}
+
+// ListFavorites возвращает страницу избранных объявлений пользователя, недавно добавленные первыми.
+// Удаленные объявления и чужие черновики пропускаются
+func (r *Repository) ListFavorites(ctx context.Context, userID uint64, page, perPage uint32) (*entity.ListingPage, error) {
+	fromClause := `
+		FROM favorites f
+		JOIN listings l ON l.id = f.listing_id
+		JOIN users u ON l.author_id = u.id
+		WHERE f.user_id = $1
+			AND l.deleted_at IS NULL
+			AND (l.status <> 'draft' OR l.author_id = $1)`
+
+	result := &entity.ListingPage{Listings: []*entity.Listing{}}
+
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*)"+fromClause, userID).Scan(&result.Total); err != nil {
+		r.logger.Error(ctx, "Ошибка при подсчете избранных объявлений",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении избранных объявлений")
+	}
+
+	if result.Total == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT ` + listingColumns + fromClause + `
+		ORDER BY f.created_at DESC, l.id DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, userID, perPage, (page-1)*perPage)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении избранных объявлений",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении избранных объявлений")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		listing, err := scanListing(rows)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании избранного объявления", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении избранных объявлений")
+		}
+		listing.IsFavorite = true
+		result.Listings = append(result.Listings, listing)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке избранных объявлений", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении избранных объявлений")
+	}
+
+	return result, nil
+}
+
+// GetFavoriteListingIDs возвращает ID объявлений из listingIDs, добавленных пользователем в избранное
+func (r *Repository) GetFavoriteListingIDs(ctx context.Context, userID uint64, listingIDs []uint64) (map[uint64]bool, error) {
+	rows, err := r.db.Query(ctx,
+		"SELECT listing_id FROM favorites WHERE user_id = $1 AND listing_id = ANY($2)",
+		userID, listingIDs)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении избранного пользователя",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении избранного")
+	}
+	defer rows.Close()
+
+	favorites := make(map[uint64]bool, len(listingIDs))
+	for rows.Next() {
+		var listingID uint64
+		if err := rows.Scan(&listingID); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании избранного", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении избранного")
+		}
+		favorites[listingID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при получении избранного пользователя", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении избранного")
+	}
+
+	return favorites, nil
+}
+
+// GetPriceWatchers возвращает пользователей, добавивших объявление в избранное, вместе с их порогами цены
+func (r *Repository) GetPriceWatchers(ctx context.Context, listingID uint64) ([]*entity.PriceWatcher, error) {
+	rows, err := r.db.Query(ctx,
+		"SELECT user_id, alert_price, alert_currency FROM favorites WHERE listing_id = $1 ORDER BY user_id",
+		listingID)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении подписчиков на цену объявления",
+			zap.Uint64("listing_id", listingID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении подписчиков на цену объявления")
+	}
+	defer rows.Close()
+
+	watchers := []*entity.PriceWatcher{}
+	for rows.Next() {
+		watcher := &entity.PriceWatcher{}
+		var price pgtype.Numeric
+		var currency *string
+		if err := rows.Scan(&watcher.UserID, &price, &currency); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании подписчика на цену объявления", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении подписчиков на цену объявления")
+		}
+		if price.Valid && currency != nil {
+			watcher.AlertPrice = &entity.Money{Amount: minorFromNumeric(price), Currency: *currency}
+		}
+		watchers = append(watchers, watcher)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при получении подписчиков на цену объявления", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении подписчиков на цену объявления")
+	}
+
+	return watchers, nil
+}
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+// listingsFromClause содержит общую часть запросов ленты объявлений
+const listingsFromClause = `
+		FROM listings l
+		JOIN users u ON l.author_id = u.id
+		WHERE l.deleted_at IS NULL`
+
+// listingsTableClause содержит общую часть запросов подсчета, которым не нужны данные автора
+const listingsTableClause = `
+		FROM listings l
+		WHERE l.deleted_at IS NULL`
+
+// highlightOptions задает параметры выделения найденных слов в ts_headline
+const highlightOptions = `StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5`
+
+// queryBuilder собирает условия WHERE и аргументы запроса с нумерацией плейсхолдеров
+type queryBuilder struct {
+	conditions      []string
+	args            []any
+	tsQuery         string
+	displayCurrency string
+}
+
+// arg добавляет аргумент запроса и возвращает его плейсхолдер
+func (b *queryBuilder) arg(value any) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// where добавляет условие, в котором %s заменяются плейсхолдерами переданных аргументов
+func (b *queryBuilder) where(condition string, values ...any) {
+	placeholders := make([]any, 0, len(values))
+	for _, value := range values {
+		placeholders = append(placeholders, b.arg(value))
+	}
+	b.conditions = append(b.conditions, fmt.Sprintf(condition, placeholders...))
+}
+
+// whereClause возвращает условия, дополняющие listingsFromClause
+func (b *queryBuilder) whereClause() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return " AND " + strings.Join(b.conditions, " AND ")
+}
+
+// newListingsQueryBuilder формирует условия выборки объявлений по фильтру
+func newListingsQueryBuilder(filter *entity.ListingFilter) *queryBuilder {
+	b := &queryBuilder{displayCurrency: filter.DisplayCurrency}
+
+	// При конвертации границы цены задаются в валюте отображения, иначе они ограничивают выборку своей валютой.
+	// Границы приходят в одной валюте, это проверяется в usecase
+	if b.displayCurrency != "" {
+		// Объявления в валютах без курса нельзя сравнить с остальными, поэтому они не попадают в выборку
+		b.conditions = append(b.conditions, "l.currency IN (SELECT currency FROM exchange_rates)")
+		if filter.MinPrice != nil {
+			b.where(b.priceExpr()+" >= %s", numericFromMinor(filter.MinPrice.Amount))
+		}
+		if filter.MaxPrice != nil {
+			b.where(b.priceExpr()+" <= %s", numericFromMinor(filter.MaxPrice.Amount))
+		}
+	} else {
+		if filter.MinPrice != nil {
+			b.where("l.currency = %s AND l.price >= %s", filter.MinPrice.Currency, numericFromMinor(filter.MinPrice.Amount))
+		}
+		if filter.MaxPrice != nil {
+			b.where("l.currency = %s AND l.price <= %s", filter.MaxPrice.Currency, numericFromMinor(filter.MaxPrice.Amount))
+		}
+	}
+
+	if filter.Status != "" {
+		b.where("l.status = %s", string(filter.Status))
+	}
+
+	if !filter.PublishedAfter.IsZero() {
+		b.where("l.published_at > %s", filter.PublishedAfter)
+	}
+
+	if !filter.PublishedUntil.IsZero() {
+		b.where("l.published_at <= %s", filter.PublishedUntil)
+	}
+
+	if filter.AuthorID != 0 {
+		b.where("l.author_id = %s", filter.AuthorID)
+	}
+
+	if filter.ExcludeAuthorID != 0 {
+		b.where("l.author_id <> %s", filter.ExcludeAuthorID)
+	}
+
+	if filter.CategoryID != nil {
+		b.where(`l.category_id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM categories WHERE id = %s
+				UNION ALL
+				SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+			)
+			SELECT id FROM subtree
+		)`, *filter.CategoryID)
+	}
+
+	for _, key := range sortedKeys(filter.Attributes) {
+		b.where("l.attributes->>%s::text = %s", key, filter.Attributes[key])
+	}
+
+	for _, key := range sortedKeys(filter.AttributeRanges) {
+		bounds := filter.AttributeRanges[key]
+		value := fmt.Sprintf("CASE WHEN jsonb_typeof(l.attributes->%[1]s::text) = 'number' THEN (l.attributes->>%[1]s::text)::numeric END", b.arg(key))
+		if bounds.Min != nil {
+			b.where(value+" >= %s", *bounds.Min)
+		}
+		if bounds.Max != nil {
+			b.where(value+" <= %s", *bounds.Max)
+		}
+	}
+
+	if filter.Query != "" {
+		placeholder := b.arg(filter.Query)
+		b.tsQuery = fmt.Sprintf("(websearch_to_tsquery('russian', %[1]s) || websearch_to_tsquery('english', %[1]s))", placeholder)
+		b.conditions = append(b.conditions, "l.search_vector @@ "+b.tsQuery)
+	}
+
+	return b
+}
+
+// orderBy возвращает выражение сортировки ленты объявлений
+func (b *queryBuilder) orderBy(filter *entity.ListingFilter) string {
+	sortField := "l.created_at"
+	switch filter.SortBy {
+	case "price":
+		sortField = b.priceExpr()
+	case "relevance":
+		if b.tsQuery != "" {
+			sortField = "ts_rank(l.search_vector, " + b.tsQuery + ")"
+		}
+	}
+
+	sortDirection := "DESC"
+	if !filter.SortDesc {
+		sortDirection = "ASC"
+	}
+
+	// ID обеспечивает однозначный порядок объявлений с одинаковым значением поля сортировки
+	return sortField + " " + sortDirection + ", l.id " + sortDirection
+}
+
+// cursorCondition возвращает условие, отбирающее объявления после позиции курсора
+func (b *queryBuilder) cursorCondition(cursor *entity.ListingCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	operator := ">"
+	if cursor.SortDesc {
+		operator = "<"
+	}
+
+	if cursor.SortBy == "price" {
+		return fmt.Sprintf(" AND (%s, l.id) %s (%s, %s)", b.priceExpr(), operator, b.arg(numericFromMinor(cursor.Price)), b.arg(cursor.ID))
+	}
+
+	return fmt.Sprintf(" AND (l.created_at, l.id) %s (%s, %s)", operator, b.arg(cursor.CreatedAt), b.arg(cursor.ID))
+}
+
+// priceExpr возвращает выражение цены объявления: исходной или сконвертированной в валюту отображения.
+// Сконвертированная цена округляется до минимальных единиц, чтобы сортировка и курсор использовали отображаемое значение
+func (b *queryBuilder) priceExpr() string {
+	if b.displayCurrency == "" {
+		return "l.price"
+	}
+	return fmt.Sprintf(`ROUND(l.price
+			* (SELECT r.rate FROM exchange_rates r WHERE r.currency = l.currency)
+			/ (SELECT r.rate FROM exchange_rates r WHERE r.currency = %s), 2)`, b.arg(b.displayCurrency))
+}
+
+// displayPriceColumn возвращает выражение цены в валюте отображения, если она задана
+func (b *queryBuilder) displayPriceColumn() string {
+	if b.displayCurrency == "" {
+		return ""
+	}
+	return ",\n\t\t\t" + b.priceExpr()
+}
+
+// highlightColumns возвращает выражения для фрагментов с выделенными совпадениями
+func (b *queryBuilder) highlightColumns() string {
+	if b.tsQuery == "" {
+		return ""
+	}
+	return fmt.Sprintf(`,
+		ts_headline('russian', l.title, %[1]s, 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>'),
+		ts_headline('russian', l.description, %[1]s, '%[2]s')`, b.tsQuery, highlightOptions)
+}
+
+// sortedKeys возвращает ключи словаря в отсортированном порядке, чтобы текст запроса не зависел от порядка обхода
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
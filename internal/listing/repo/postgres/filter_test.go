@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+func TestCursorCondition(t *testing.T) {
+	createdAt := time.Date(2025, 8, 20, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		displayCurrency string
+		filter          *entity.ListingFilter
+		cursor          *entity.ListingCursor
+		wantCondition   string
+		wantOrder       string
+		wantArgs        int
+	}{
+		{
+			name:          "новые сначала",
+			filter:        &entity.ListingFilter{SortBy: "created_at", SortDesc: true},
+			cursor:        &entity.ListingCursor{SortBy: "created_at", SortDesc: true, CreatedAt: createdAt, ID: 10},
+			wantCondition: " AND (l.created_at, l.id) < ($1, $2)",
+			wantOrder:     "l.created_at DESC, l.id DESC",
+			wantArgs:      2,
+		},
+		{
+			name:          "старые сначала",
+			filter:        &entity.ListingFilter{SortBy: "created_at"},
+			cursor:        &entity.ListingCursor{SortBy: "created_at", CreatedAt: createdAt, ID: 10},
+			wantCondition: " AND (l.created_at, l.id) > ($1, $2)",
+			wantOrder:     "l.created_at ASC, l.id ASC",
+			wantArgs:      2,
+		},
+		{
+			name:          "дешевые сначала",
+			filter:        &entity.ListingFilter{SortBy: "price"},
+			cursor:        &entity.ListingCursor{SortBy: "price", Price: 150_00, ID: 7},
+			wantCondition: " AND (l.price, l.id) > ($1, $2)",
+			wantOrder:     "l.price ASC, l.id ASC",
+			wantArgs:      2,
+		},
+		{
+			name:            "дорогие сначала в валюте отображения",
+			displayCurrency: "USD",
+			filter:          &entity.ListingFilter{SortBy: "price", SortDesc: true},
+			cursor:          &entity.ListingCursor{SortBy: "price", SortDesc: true, Price: 99, Currency: "USD", ID: 7},
+			wantCondition:   "l.id) < ($2, $3)",
+			wantOrder:       ", l.id DESC",
+			wantArgs:        4,
+		},
+		{
+			name:     "первая страница",
+			filter:   &entity.ListingFilter{SortBy: "created_at", SortDesc: true},
+			cursor:   nil,
+			wantArgs: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &queryBuilder{displayCurrency: tt.displayCurrency}
+
+			condition := b.cursorCondition(tt.cursor)
+			if tt.cursor == nil {
+				if condition != "" {
+					t.Fatalf("условие %q для первой страницы, ожидалась пустая строка", condition)
+				}
+				return
+			}
+			if !strings.Contains(condition, tt.wantCondition) {
+				t.Fatalf("условие %q не содержит %q", condition, tt.wantCondition)
+			}
+			// ID курсора передается последним аргументом условия
+			if id, ok := b.args[len(b.args)-1].(uint64); !ok || id != tt.cursor.ID {
+				t.Fatalf("последний аргумент условия %v, ожидался ID курсора %d", b.args[len(b.args)-1], tt.cursor.ID)
+			}
+
+			order := b.orderBy(tt.filter)
+			if !strings.Contains(order, tt.wantOrder) {
+				t.Fatalf("сортировка %q не содержит %q", order, tt.wantOrder)
+			}
+
+			if len(b.args) != tt.wantArgs {
+				t.Fatalf("аргументов запроса %d, ожидалось %d", len(b.args), tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// listingImageColumns содержит список полей изображения галереи для выборки
+const listingImageColumns = `id, listing_id, url, position, created_at`
+
+// scanListingImages сканирует все изображения галереи из результата запроса
+func scanListingImages(rows pgx.Rows) ([]*entity.ListingImage, error) {
+	defer rows.Close()
+
+	images := make([]*entity.ListingImage, 0)
+	for rows.Next() {
+		image := &entity.ListingImage{}
+		if err := rows.Scan(&image.ID, &image.ListingID, &image.URL, &image.Position, &image.CreatedAt); err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+
+	return images, rows.Err()
+}
+
+// insertListingImages добавляет изображения в галерею объявления, позиции задаются полем Position
+func (r *Repository) insertListingImages(ctx context.Context, listingID uint64, images []*entity.ListingImage) error {
+	urls := make([]string, 0, len(images))
+	positions := make([]int32, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, image.URL)
+		positions = append(positions, int32(image.Position))
+	}
+
+	query := `
+		INSERT INTO listing_images (listing_id, url, position)
+		SELECT $1, url, position
+		FROM unnest($2::text[], $3::int[]) AS i(url, position)
+		RETURNING ` + listingImageColumns
+
+	rows, err := r.db.Query(ctx, query, listingID, urls, positions)
+	if err != nil {
+		return err
+	}
+
+	inserted, err := scanListingImages(rows)
+	if err != nil {
+		return err
+	}
+
+	for i, image := range inserted {
+		images[i].ID = image.ID
+		images[i].ListingID = image.ListingID
+		images[i].CreatedAt = image.CreatedAt
+	}
+
+	return nil
+}
+
+// GetListingImages возвращает галерею объявления в порядке отображения
+func (r *Repository) GetListingImages(ctx context.Context, listingID uint64) ([]*entity.ListingImage, error) {
+	query := `
+		SELECT ` + listingImageColumns + `
+		FROM listing_images
+		WHERE listing_id = $1
+		ORDER BY position`
+
+	rows, err := r.db.Query(ctx, query, listingID)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении изображений объявления",
+			zap.Uint64("listing_id", listingID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении изображений объявления")
+	}
+
+	images, err := scanListingImages(rows)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при сканировании изображений объявления",
+			zap.Uint64("listing_id", listingID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении изображений объявления")
+	}
+
+	return images, nil
+}
+
+// AddListingImages добавляет изображения в конец галереи, не допуская превышения maxImages
+func (r *Repository) AddListingImages(ctx context.Context, listingID uint64, urls []string, maxImages int) ([]*entity.ListingImage, error) {
+	return r.changeListingImages(ctx, listingID, func(txCtx context.Context, count int) error {
+		if count+len(urls) > maxImages {
+			return app_errors.WrapError(app_errors.ErrValidation, "превышено максимальное количество изображений объявления")
+		}
+
+		images := entity.NewListingImages(urls)
+		for _, image := range images {
+			image.Position += uint32(count)
+		}
+
+		return r.insertListingImages(txCtx, listingID, images)
+	})
+}
+
+// DeleteListingImage удаляет изображение из галереи и сдвигает следующие за ним изображения
+func (r *Repository) DeleteListingImage(ctx context.Context, listingID, imageID uint64) ([]*entity.ListingImage, error) {
+	return r.changeListingImages(ctx, listingID, func(txCtx context.Context, count int) error {
+		var position uint32
+		err := r.db.QueryRow(txCtx,
+			"DELETE FROM listing_images WHERE id = $1 AND listing_id = $2 RETURNING position",
+			imageID, listingID,
+		).Scan(&position)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return app_errors.ErrImageNotFound
+			}
+			return err
+		}
+
+		if count == 1 {
+			return app_errors.WrapError(app_errors.ErrValidation, "нельзя удалить единственное изображение объявления")
+		}
+
+		_, err = r.db.Exec(txCtx,
+			"UPDATE listing_images SET position = position - 1 WHERE listing_id = $1 AND position > $2",
+			listingID, position)
+		return err
+	})
+}
+
+// ReorderListingImages задает новый порядок галереи. imageIDs должен содержать все изображения объявления
+func (r *Repository) ReorderListingImages(ctx context.Context, listingID uint64, imageIDs []uint64) ([]*entity.ListingImage, error) {
+	return r.changeListingImages(ctx, listingID, func(txCtx context.Context, count int) error {
+		if len(imageIDs) != count {
+			return app_errors.WrapError(app_errors.ErrValidation, "новый порядок должен содержать все изображения объявления")
+		}
+
+		query := `
+			UPDATE listing_images li SET position = o.position - 1
+			FROM unnest($2::bigint[]) WITH ORDINALITY AS o(id, position)
+			WHERE li.id = o.id AND li.listing_id = $1`
+
+		tag, err := r.db.Exec(txCtx, query, listingID, imageIDs)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() != int64(count) {
+			return app_errors.WrapError(app_errors.ErrValidation, "новый порядок должен содержать все изображения объявления")
+		}
+
+		return nil
+	})
+}
+
+// changeListingImages изменяет галерею в транзакции под блокировкой объявления.
+// После изменения обложка объявления обновляется, а версия объявления увеличивается
+func (r *Repository) changeListingImages(ctx context.Context, listingID uint64, change func(txCtx context.Context, count int) error) ([]*entity.ListingImage, error) {
+	var images []*entity.ListingImage
+
+	err := r.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var count int
+		err := r.db.QueryRow(txCtx, `
+			SELECT (SELECT COUNT(*) FROM listing_images WHERE listing_id = l.id)
+			FROM listings l
+			WHERE l.id = $1 AND l.deleted_at IS NULL
+			FOR UPDATE`, listingID).Scan(&count)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return app_errors.ErrListingNotFound
+			}
+			return err
+		}
+
+		if err := change(txCtx, count); err != nil {
+			return err
+		}
+
+		_, err = r.db.Exec(txCtx, `
+			UPDATE listings SET
+				image_url = (SELECT url FROM listing_images WHERE listing_id = $1 ORDER BY position LIMIT 1),
+				version = version + 1,
+				updated_at = NOW()
+			WHERE id = $1`, listingID)
+		if err != nil {
+			return err
+		}
+
+		rows, err := r.db.Query(txCtx, `
+			SELECT `+listingImageColumns+`
+			FROM listing_images
+			WHERE listing_id = $1
+			ORDER BY position`, listingID)
+		if err != nil {
+			return err
+		}
+
+		images, err = scanListingImages(rows)
+		return err
+	})
+
+	if err != nil {
+		if app_errors.IsNotFound(err) || errors.Is(err, app_errors.ErrValidation) {
+			return nil, err
+		}
+		r.logger.Error(ctx, "Ошибка при изменении изображений объявления",
+			zap.Uint64("listing_id", listingID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при изменении изображений объявления")
+	}
+
+	r.logger.Info(ctx, "Изображения объявления изменены",
+		zap.Uint64("listing_id", listingID),
+		zap.Int("count", len(images)))
+
+	return images, nil
+}
+
+// GetImageVariants возвращает уменьшенные копии изображений, загруженных через API загрузок,
+// сгруппированные по ссылке на исходное изображение. Внешние ссылки в результат не попадают
+func (r *Repository) GetImageVariants(ctx context.Context, urls []string) (map[string][]*entity.ImageVariant, error) {
+	query := `
+		SELECT u.url, v.name, v.storage_key, v.url, v.content_type, v.width, v.height, v.size
+		FROM uploads u
+		JOIN upload_variants v ON v.upload_id = u.id
+		WHERE u.url = ANY($1) AND u.variants_status = 'ready'
+		ORDER BY u.url, v.width`
+
+	rows, err := r.db.Query(ctx, query, urls)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении уменьшенных копий изображений",
+			zap.Int("count", len(urls)),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении уменьшенных копий изображений")
+	}
+	defer rows.Close()
+
+	variants := make(map[string][]*entity.ImageVariant)
+	for rows.Next() {
+		var url string
+		variant := &entity.ImageVariant{}
+		err := rows.Scan(&url, &variant.Name, &variant.StorageKey, &variant.URL, &variant.ContentType,
+			&variant.Width, &variant.Height, &variant.Size)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании уменьшенной копии изображения", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении уменьшенных копий изображений")
+		}
+		variants[url] = append(variants[url], variant)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при получении уменьшенных копий изображений", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении уменьшенных копий изображений")
+	}
+
+	return variants, nil
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// moneyExp задает масштаб колонки price NUMERIC(10,2)
+const moneyExp = -2
+
+// numericFromMinor преобразует сумму в минимальных единицах валюты в значение колонки price
+func numericFromMinor(amount int64) pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(amount), Exp: moneyExp, Valid: true}
+}
+
+// minorFromNumeric преобразует значение колонки price в сумму в минимальных единицах валюты
+func minorFromNumeric(value pgtype.Numeric) int64 {
+	if !value.Valid || value.Int == nil {
+		return 0
+	}
+
+	amount := new(big.Int).Set(value.Int)
+	ten := big.NewInt(10)
+	for exp := value.Exp; exp > moneyExp; exp-- {
+		amount.Mul(amount, ten)
+	}
+	for exp := value.Exp; exp < moneyExp; exp++ {
+		amount.Quo(amount, ten)
+	}
+
+	return amount.Int64()
+}
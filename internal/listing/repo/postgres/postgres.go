@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
 
 	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
@@ -24,6 +26,13 @@ type dbManager interface {
 	GetPool() *pgxpool.Pool
 }
 
+// listingColumns содержит список полей объявления для выборки вместе с именем автора и количеством добавлений в избранное
+const listingColumns = `l.id, l.title, l.description, l.image_url, l.price, l.currency, l.status, l.category_id, l.author_id, u.username, l.created_at, l.updated_at, l.version, l.deleted_at, l.attributes,
+			(SELECT COUNT(*) FROM favorites fc WHERE fc.listing_id = l.id)`
+
+// foreignKeyViolationCode код ошибки PostgreSQL при нарушении внешнего ключа
+const foreignKeyViolationCode = "23503"
+
 // Repository реализует интерфейс listing.Repository
 type Repository struct {
 	db        dbManager
@@ -40,18 +49,64 @@ func New(db dbManager, txManager postgresstorage.Transactor, logger *logger.Logg
 	}
 }
 
+// isForeignKeyViolation проверяет, что ошибка вызвана нарушением указанного внешнего ключа
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == constraint
+}
+
+// scanListing сканирует строку базы данных в структуру Listing.
+// Дополнительные поля, выбранные после listingColumns, сканируются в extra
+func scanListing(row pgx.Row, extra ...any) (*entity.Listing, error) {
+	listing := &entity.Listing{}
+	var createdAt, updatedAt, deletedAt pgtype.Timestamptz
+	var price pgtype.Numeric
+
+	dest := []any{
+		&listing.ID,
+		&listing.Title,
+		&listing.Description,
+		&listing.ImageURL,
+		&price,
+		&listing.Price.Currency,
+		&listing.Status,
+		&listing.CategoryID,
+		&listing.AuthorID,
+		&listing.AuthorUsername,
+		&createdAt,
+		&updatedAt,
+		&listing.Version,
+		&deletedAt,
+		&listing.Attributes,
+		&listing.FavoritesCount,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	listing.Price.Amount = minorFromNumeric(price)
+	listing.CreatedAt = createdAt.Time
+	listing.UpdatedAt = updatedAt.Time
+	if deletedAt.Valid {
+		listing.DeletedAt = &deletedAt.Time
+	}
+	return listing, nil
+}
+
 // CreateListing создает новое объявление
 func (r *Repository) CreateListing(ctx context.Context, listing *entity.Listing) (*entity.Listing, error) {
 	var result *entity.Listing
 
 	err := r.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
 		insertQuery := `
-			INSERT INTO listings (title, description, image_url, price, author_id, created_at)
-			VALUES ($1, $2, $3, $4, $5, $6)
-			RETURNING id, created_at`
+			INSERT INTO listings (title, description, image_url, price, currency, status, category_id, attributes, author_id, created_at, updated_at, published_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, CASE WHEN $6 = 'active' THEN NOW() END)
+			RETURNING id, created_at, updated_at, version`
 
 		var id uint64
-		var createdAt pgtype.Timestamptz
+		var createdAt, updatedAt pgtype.Timestamptz
 
 		err := r.db.QueryRow(
 			txCtx,
@@ -59,18 +114,40 @@ func (r *Repository) CreateListing(ctx context.Context, listing *entity.Listing)
 			listing.Title,
 			listing.Description,
 			listing.ImageURL,
-			listing.Price,
+			numericFromMinor(listing.Price.Amount),
+			listing.Price.Currency,
+			string(listing.Status),
+			listing.CategoryID,
+			listing.Attributes,
 			listing.AuthorID,
 			listing.CreatedAt,
-		).Scan(&id, &createdAt)
+		).Scan(&id, &createdAt, &updatedAt, &listing.Version)
 
 		if err != nil {
+			if isForeignKeyViolation(err, "listings_category_id_fkey") {
+				return app_errors.ErrCategoryNotFound
+			}
 			r.logger.Error(ctx, "Ошибка при создании объявления", zap.Error(err))
 			return app_errors.WrapError(err, "ошибка при создании объявления")
 		}
 
 		listing.ID = id
 		listing.CreatedAt = createdAt.Time
+		listing.UpdatedAt = updatedAt.Time
+
+		historyQuery := `
+			INSERT INTO listing_price_history (listing_id, price, currency, changed_at)
+			VALUES ($1, $2, $3, $4)`
+
+		if _, err := r.db.Exec(txCtx, historyQuery, id, numericFromMinor(listing.Price.Amount), listing.Price.Currency, listing.CreatedAt); err != nil {
+			r.logger.Error(ctx, "Ошибка при сохранении истории цены объявления", zap.Error(err))
+			return app_errors.WrapError(err, "ошибка при создании объявления")
+		}
+
+		if err := r.insertListingImages(txCtx, id, listing.Images); err != nil {
+			r.logger.Error(ctx, "Ошибка при сохранении изображений объявления", zap.Error(err))
+			return app_errors.WrapError(err, "ошибка при создании объявления")
+		}
 
 		userQuery := `
 			SELECT username FROM users WHERE id = $1`
@@ -98,100 +175,451 @@ func (r *Repository) CreateListing(ctx context.Context, listing *entity.Listing)
 }
 
 // GetListings получает список объявлений с фильтрацией и пагинацией
-func (r *Repository) GetListings(ctx context.Context, filter *entity.ListingFilter) ([]*entity.Listing, uint32, error) {
-	baseQuery := `
-		FROM listings l
-		JOIN users u ON l.author_id = u.id
-		WHERE 1=1`
+func (r *Repository) GetListings(ctx context.Context, filter *entity.ListingFilter) (*entity.ListingPage, error) {
+	builder := newListingsQueryBuilder(filter)
+	whereClause := builder.whereClause()
+	countArgs := len(builder.args)
+
+	// Запрашиваем на одно объявление больше, чтобы определить наличие следующей страницы
+	offset := uint32(0)
+	if filter.Cursor == nil {
+		offset = (filter.Page - 1) * filter.PerPage
+	}
+
+	dataQuery := `
+		SELECT ` + listingColumns + builder.displayPriceColumn() + builder.highlightColumns() + listingsFromClause + whereClause + builder.cursorCondition(filter.Cursor) + `
+		ORDER BY ` + builder.orderBy(filter) + `
+		LIMIT ` + builder.arg(filter.PerPage+1) + ` OFFSET ` + builder.arg(offset)
+
+	args := builder.args
+
+	page := &entity.ListingPage{Listings: []*entity.Listing{}}
 
-	conditions := []string{}
-	args := []any{}
-	argIndex := 1
+	switch filter.TotalMode {
+	case entity.TotalModeNone:
+	case entity.TotalModeEstimated:
+		total, err := r.estimateListings(ctx, whereClause, args[:countArgs])
+		if err != nil {
+			return nil, err
+		}
+		page.Total = total
+	default:
+		countQuery := "SELECT COUNT(*) " + listingsTableClause + whereClause
 
-	if filter.MinPrice != nil {
-		conditions = append(conditions, fmt.Sprintf("l.price >= $%d", argIndex))
-		args = append(args, *filter.MinPrice)
-		argIndex++
+		err := r.db.QueryRow(ctx, countQuery, args[:countArgs]...).Scan(&page.Total)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при подсчете объявлений", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении объявлений")
+		}
+
+		if page.Total == 0 {
+			return page, nil
+		}
 	}
 
-	if filter.MaxPrice != nil {
-		conditions = append(conditions, fmt.Sprintf("l.price <= $%d", argIndex))
-		args = append(args, *filter.MaxPrice)
-		argIndex++
+	rows, err := r.db.Query(ctx, dataQuery, args...)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении списка объявлений", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении объявлений")
 	}
+	defer rows.Close()
 
-	whereClause := ""
-	if len(conditions) > 0 {
-		whereClause = " AND " + strings.Join(conditions, " AND ")
+	for rows.Next() {
+		var highlight entity.ListingHighlight
+		var displayPrice pgtype.Numeric
+		var extra []any
+		if filter.DisplayCurrency != "" {
+			extra = append(extra, &displayPrice)
+		}
+		if filter.Query != "" {
+			extra = append(extra, &highlight.Title, &highlight.Description)
+		}
+
+		listing, err := scanListing(rows, extra...)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании строки объявления", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении объявлений")
+		}
+
+		if filter.DisplayCurrency != "" {
+			listing.DisplayPrice = &entity.Money{Amount: minorFromNumeric(displayPrice), Currency: filter.DisplayCurrency}
+		}
+		if filter.Query != "" {
+			listing.Highlight = &highlight
+		}
+
+		page.Listings = append(page.Listings, listing)
 	}
 
-	sortField := "l.created_at"
-	if filter.SortBy == "price" {
-		sortField = "l.price"
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке результатов", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении объявлений")
 	}
 
-	sortDirection := "DESC"
-	if !filter.SortDesc {
-		sortDirection = "ASC"
+	if uint32(len(page.Listings)) > filter.PerPage {
+		page.Listings = page.Listings[:filter.PerPage]
+		if filter.SortBy != "relevance" {
+			last := page.Listings[len(page.Listings)-1]
+			page.NextCursor = entity.NewListingCursor(last, filter.SortBy, filter.SortDesc)
+		}
 	}
 
-	countQuery := "SELECT COUNT(*) " + baseQuery + whereClause
+	return page, nil
+}
+
+// estimateListings возвращает оценку количества объявлений по плану запроса без его выполнения
+func (r *Repository) estimateListings(ctx context.Context, whereClause string, args []any) (uint32, error) {
+	query := "EXPLAIN (FORMAT JSON) SELECT 1 " + listingsTableClause + whereClause
+
+	var plan []byte
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&plan); err != nil {
+		r.logger.Error(ctx, "Ошибка при оценке количества объявлений", zap.Error(err))
+		return 0, app_errors.WrapError(err, "ошибка при получении объявлений")
+	}
+
+	var explain []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil || len(explain) == 0 {
+		r.logger.Error(ctx, "Ошибка при разборе плана запроса", zap.Error(err))
+		return 0, app_errors.WrapError(app_errors.ErrInternal, "ошибка при получении объявлений")
+	}
+
+	return uint32(explain[0].Plan.Rows), nil
+}
+
+// SearchListings выполняет нечеткий поиск объявлений по заголовку с помощью pg_trgm
+func (r *Repository) SearchListings(ctx context.Context, filter *entity.ListingSearchFilter) ([]*entity.ListingSearchResult, uint32, error) {
+	builder := &queryBuilder{}
+	query := builder.arg(filter.Query)
+	builder.conditions = append(builder.conditions, query+" <% l.title")
+	if filter.Status != "" {
+		builder.where("l.status = %s", string(filter.Status))
+	}
+
+	whereClause := builder.whereClause()
+	countArgs := len(builder.args)
+
+	countQuery := "SELECT COUNT(*) " + listingsTableClause + whereClause
 
 	dataQuery := `
-		SELECT l.id, l.title, l.description, l.image_url, l.price, l.author_id, u.username, l.created_at` + baseQuery + whereClause + `
-		ORDER BY ` + sortField + ` ` + sortDirection + `
-		LIMIT $` + fmt.Sprintf("%d", argIndex) + ` OFFSET $` + fmt.Sprintf("%d", argIndex+1)
+		SELECT ` + listingColumns + `, word_similarity(` + query + `, l.title) AS similarity` + listingsFromClause + whereClause + `
+		ORDER BY similarity DESC, l.created_at DESC
+		LIMIT ` + builder.arg(filter.PerPage) + ` OFFSET ` + builder.arg((filter.Page-1)*filter.PerPage)
 
-	args = append(args, filter.PerPage, (filter.Page-1)*filter.PerPage)
+	args := builder.args
 
 	var total uint32
-	err := r.db.QueryRow(ctx, countQuery, args[:argIndex-1]...).Scan(&total)
+	results := make([]*entity.ListingSearchResult, 0)
+
+	// Порог сходства оператора <% задается настройкой сессии, поэтому запросы выполняются в одной транзакции
+	err := r.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := r.db.Exec(txCtx,
+			"SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)",
+			strconv.FormatFloat(filter.SimilarityThreshold, 'f', -1, 64))
+		if err != nil {
+			return app_errors.WrapError(err, "ошибка при настройке порога сходства")
+		}
+
+		err = r.db.QueryRow(txCtx, countQuery, args[:countArgs]...).Scan(&total)
+		if err != nil {
+			return app_errors.WrapError(err, "ошибка при подсчете найденных объявлений")
+		}
+
+		if total == 0 {
+			return nil
+		}
+
+		rows, err := r.db.Query(txCtx, dataQuery, args...)
+		if err != nil {
+			return app_errors.WrapError(err, "ошибка при поиске объявлений")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			result := &entity.ListingSearchResult{}
+
+			result.Listing, err = scanListing(rows, &result.Similarity)
+			if err != nil {
+				return app_errors.WrapError(err, "ошибка при сканировании найденного объявления")
+			}
+
+			results = append(results, result)
+		}
+
+		return rows.Err()
+	})
 	if err != nil {
-		r.logger.Error(ctx, "Ошибка при подсчете объявлений", zap.Error(err))
-		return nil, 0, app_errors.WrapError(err, "ошибка при получении объявлений")
+		r.logger.Error(ctx, "Ошибка при нечетком поиске объявлений",
+			zap.String("query", filter.Query),
+			zap.Error(err))
+		return nil, 0, app_errors.WrapError(err, "ошибка при поиске объявлений")
 	}
 
-	if total == 0 {
-		return []*entity.Listing{}, 0, nil
-	}
+	return results, total, nil
+}
 
-	rows, err := r.db.Query(ctx, dataQuery, args...)
+// GetSuggestionTerms возвращает самые частые слова из заголовков активных объявлений
+func (r *Repository) GetSuggestionTerms(ctx context.Context, limit int) ([]*entity.Suggestion, error) {
+	query := `
+		SELECT t.term, COUNT(DISTINCT t.id) AS listings_count
+		FROM (
+			SELECT l.id, lower(w) AS term
+			FROM listings l, regexp_split_to_table(l.title, '[^[:alnum:]]+') AS w
+			WHERE l.deleted_at IS NULL AND l.status = $1
+		) t
+		WHERE char_length(t.term) >= 2
+		GROUP BY t.term
+		ORDER BY listings_count DESC, t.term
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, string(entity.ListingStatusActive), limit)
 	if err != nil {
-		r.logger.Error(ctx, "Ошибка при получении списка объявлений", zap.Error(err))
-		return nil, 0, app_errors.WrapError(err, "ошибка при получении объявлений")
+		r.logger.Error(ctx, "Ошибка при получении слов для подсказок", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении подсказок")
 	}
 	defer rows.Close()
 
-	listings := make([]*entity.Listing, 0)
+	terms := make([]*entity.Suggestion, 0)
 
 	for rows.Next() {
-		listing := &entity.Listing{}
-		var createdAt pgtype.Timestamptz
-
-		err := rows.Scan(
-			&listing.ID,
-			&listing.Title,
-			&listing.Description,
-			&listing.ImageURL,
-			&listing.Price,
-			&listing.AuthorID,
-			&listing.AuthorUsername,
-			&createdAt,
+		term := &entity.Suggestion{}
+		if err := rows.Scan(&term.Text, &term.ListingsCount); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании слова для подсказок", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении подсказок")
+		}
+		terms = append(terms, term)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке слов для подсказок", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении подсказок")
+	}
+
+	return terms, nil
+}
+
+// GetListingByID получает объявление по ID
+func (r *Repository) GetListingByID(ctx context.Context, id uint64) (*entity.Listing, error) {
+	query := `
+		SELECT ` + listingColumns + `
+		FROM listings l
+		JOIN users u ON l.author_id = u.id
+		WHERE l.id = $1 AND l.deleted_at IS NULL`
+
+	listing, err := scanListing(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrListingNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при получении объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении объявления")
+	}
+
+	return listing, nil
+}
+
+// UpdateListing частично обновляет объявление, если его версия совпадает с ожидаемой
+func (r *Repository) UpdateListing(ctx context.Context, update *entity.ListingUpdate) (*entity.Listing, error) {
+	var price pgtype.Numeric
+	var currency *string
+	if update.Price != nil {
+		price = numericFromMinor(update.Price.Amount)
+		currency = &update.Price.Currency
+	}
+
+	query := `
+		WITH l AS (
+			UPDATE listings SET
+				title = COALESCE($3, title),
+				description = COALESCE($4, description),
+				image_url = COALESCE($5, image_url),
+				price = COALESCE($6, price),
+				currency = COALESCE($7, currency),
+				category_id = COALESCE($8, category_id),
+				attributes = COALESCE($9, attributes),
+				version = version + 1,
+				updated_at = NOW()
+			WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+			RETURNING *
+		), cover AS (
+			-- Обложка хранится и в галерее, поэтому новая ссылка заменяет первое изображение
+			UPDATE listing_images SET url = $5
+			WHERE $5::text IS NOT NULL AND position = 0 AND listing_id IN (SELECT id FROM l)
+		), history AS (
+			-- Подзапрос к listings видит объявление до обновления, поэтому в историю попадает только изменившаяся цена
+			INSERT INTO listing_price_history (listing_id, price, currency)
+			SELECT l.id, l.price, l.currency
+			FROM l
+			JOIN listings p ON p.id = l.id
+			WHERE (l.price, l.currency) IS DISTINCT FROM (p.price, p.currency)
 		)
+		SELECT ` + listingColumns + `
+		FROM l
+		JOIN users u ON l.author_id = u.id`
+
+	listing, err := scanListing(r.db.QueryRow(
+		ctx,
+		query,
+		update.ID,
+		update.Version,
+		update.Title,
+		update.Description,
+		update.ImageURL,
+		price,
+		currency,
+		update.CategoryID,
+		update.Attributes,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrListingConflict
+		}
+		if isForeignKeyViolation(err, "listings_category_id_fkey") {
+			return nil, app_errors.ErrCategoryNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при обновлении объявления",
+			zap.Uint64("listing_id", update.ID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при обновлении объявления")
+	}
 
-		if err != nil {
-			r.logger.Error(ctx, "Ошибка при сканировании строки объявления", zap.Error(err))
-			return nil, 0, app_errors.WrapError(err, "ошибка при получении объявлений")
+	r.logger.Info(ctx, "Объявление успешно обновлено",
+		zap.Uint64("listing_id", listing.ID),
+		zap.Uint64("version", listing.Version))
+
+	return listing, nil
+}
+
+// UpdateListingStatus переводит объявление из статуса from в статус to.
+// Если статус объявления уже изменился, возвращается конфликт
+func (r *Repository) UpdateListingStatus(ctx context.Context, id uint64, from, to entity.ListingStatus) (*entity.Listing, error) {
+	query := `
+		WITH l AS (
+			UPDATE listings SET
+				status = $3,
+				published_at = CASE WHEN $3 = 'active' THEN COALESCE(published_at, NOW()) ELSE published_at END,
+				version = version + 1,
+				updated_at = NOW()
+			WHERE id = $1 AND status = $2 AND deleted_at IS NULL
+			RETURNING *
+		)
+		SELECT ` + listingColumns + `
+		FROM l
+		JOIN users u ON l.author_id = u.id`
+
+	listing, err := scanListing(r.db.QueryRow(ctx, query, id, string(from), string(to)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrListingConflict
 		}
+		r.logger.Error(ctx, "Ошибка при изменении статуса объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при изменении статуса объявления")
+	}
 
-		listing.CreatedAt = createdAt.Time
-		listings = append(listings, listing)
+	r.logger.Info(ctx, "Статус объявления изменен",
+		zap.Uint64("listing_id", id),
+		zap.String("from", string(from)),
+		zap.String("to", string(to)))
+
+	return listing, nil
+}
+
+// GetDeletedListingByID получает удаленное объявление по ID
+func (r *Repository) GetDeletedListingByID(ctx context.Context, id uint64) (*entity.Listing, error) {
+	query := `
+		SELECT ` + listingColumns + `
+		FROM listings l
+		JOIN users u ON l.author_id = u.id
+		WHERE l.id = $1 AND l.deleted_at IS NOT NULL`
+
+	listing, err := scanListing(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrListingNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при получении удаленного объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении объявления")
 	}
 
-	if err = rows.Err(); err != nil {
-		r.logger.Error(ctx, "Ошибка при обработке результатов", zap.Error(err))
-		return nil, 0, app_errors.WrapError(err, "ошибка при получении объявлений")
+	return listing, nil
+}
+
+// DeleteListing помечает объявление удаленным и возвращает время удаления
+func (r *Repository) DeleteListing(ctx context.Context, id uint64) (time.Time, error) {
+	query := `
+		UPDATE listings SET deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at`
+
+	var deletedAt pgtype.Timestamptz
+	err := r.db.QueryRow(ctx, query, id).Scan(&deletedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, app_errors.ErrListingNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при удалении объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return time.Time{}, app_errors.WrapError(err, "ошибка при удалении объявления")
+	}
+
+	r.logger.Info(ctx, "Объявление помечено удаленным", zap.Uint64("listing_id", id))
+
+	return deletedAt.Time, nil
+}
+
+// RestoreListing снимает с объявления отметку об удалении
+func (r *Repository) RestoreListing(ctx context.Context, id uint64) (*entity.Listing, error) {
+	query := `
+		WITH l AS (
+			UPDATE listings SET
+				deleted_at = NULL,
+				published_at = CASE WHEN status = 'active' THEN COALESCE(published_at, NOW()) ELSE published_at END,
+				version = version + 1,
+				updated_at = NOW()
+			WHERE id = $1 AND deleted_at IS NOT NULL
+			RETURNING *
+		)
+		SELECT ` + listingColumns + `
+		FROM l
+		JOIN users u ON l.author_id = u.id`
+
+	listing, err := scanListing(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrListingNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при восстановлении объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при восстановлении объявления")
+	}
+
+	r.logger.Info(ctx, "Объявление восстановлено", zap.Uint64("listing_id", id))
+
+	return listing, nil
+}
+
+// PurgeDeletedListings окончательно удаляет объявления, удаленные раньше указанного времени
+func (r *Repository) PurgeDeletedListings(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `
+		DELETE FROM listings
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1`
+
+	tag, err := r.db.Exec(ctx, query, deletedBefore)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при очистке удаленных объявлений", zap.Error(err))
+		return 0, app_errors.WrapError(err, "ошибка при очистке удаленных объявлений")
 	}
 
-	return listings, total, nil
+	return tag.RowsAffected(), nil
 }
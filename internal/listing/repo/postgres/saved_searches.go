@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// savedSearchColumns содержит список полей сохраненного поиска для выборки
+const savedSearchColumns = `id, user_id, name, filter, frequency, last_published_at, checked_at, created_at, updated_at`
+
+// scanSavedSearch сканирует строку базы данных в структуру SavedSearch
+func scanSavedSearch(row pgx.Row) (*entity.SavedSearch, error) {
+	search := &entity.SavedSearch{}
+	var lastPublishedAt, checkedAt, createdAt, updatedAt pgtype.Timestamptz
+
+	err := row.Scan(
+		&search.ID,
+		&search.UserID,
+		&search.Name,
+		&search.Filter,
+		&search.Frequency,
+		&lastPublishedAt,
+		&checkedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	search.LastPublishedAt = lastPublishedAt.Time
+	search.CheckedAt = checkedAt.Time
+	search.CreatedAt = createdAt.Time
+	search.UpdatedAt = updatedAt.Time
+	return search, nil
+}
+
+// CreateSavedSearch сохраняет поиск пользователя. Уже опубликованные объявления считаются проверенными,
+// поэтому уведомления приходят только о новых. Если у пользователя уже maxPerUser поисков, возвращается ошибка валидации
+func (r *Repository) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch, maxPerUser int) (*entity.SavedSearch, error) {
+	query := `
+		INSERT INTO saved_searches (user_id, name, filter, frequency)
+		SELECT $1::bigint, $2::text, $3::jsonb, $4::text
+		WHERE (SELECT COUNT(*) FROM saved_searches WHERE user_id = $1) < $5
+		RETURNING ` + savedSearchColumns
+
+	created, err := scanSavedSearch(r.db.QueryRow(ctx, query, search.UserID, search.Name, search.Filter, string(search.Frequency), maxPerUser))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.WrapError(app_errors.ErrValidation, "превышено максимальное количество сохраненных поисков")
+		}
+		r.logger.Error(ctx, "Ошибка при сохранении поиска",
+			zap.Uint64("user_id", search.UserID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при сохранении поиска")
+	}
+
+	return created, nil
+}
+
+// GetSavedSearch получает сохраненный поиск пользователя по ID
+func (r *Repository) GetSavedSearch(ctx context.Context, userID, id uint64) (*entity.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE id = $1 AND user_id = $2`
+
+	search, err := scanSavedSearch(r.db.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrSavedSearchNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при получении сохраненного поиска",
+			zap.Uint64("saved_search_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении сохраненного поиска")
+	}
+
+	return search, nil
+}
+
+// ListSavedSearches возвращает сохраненные поиски пользователя в порядке создания
+func (r *Repository) ListSavedSearches(ctx context.Context, userID uint64) ([]*entity.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id = $1 ORDER BY id`
+
+	return r.querySavedSearches(ctx, query, userID)
+}
+
+// UpdateSavedSearch заменяет название, условия и частоту уведомлений сохраненного поиска
+func (r *Repository) UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	query := `
+		UPDATE saved_searches SET
+			name = $3,
+			filter = $4,
+			frequency = $5,
+			updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + savedSearchColumns
+
+	updated, err := scanSavedSearch(r.db.QueryRow(ctx, query, search.ID, search.UserID, search.Name, search.Filter, string(search.Frequency)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrSavedSearchNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при обновлении сохраненного поиска",
+			zap.Uint64("saved_search_id", search.ID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при обновлении сохраненного поиска")
+	}
+
+	return updated, nil
+}
+
+// DeleteSavedSearch удаляет сохраненный поиск пользователя
+func (r *Repository) DeleteSavedSearch(ctx context.Context, userID, id uint64) error {
+	tag, err := r.db.Exec(ctx, "DELETE FROM saved_searches WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при удалении сохраненного поиска",
+			zap.Uint64("saved_search_id", id),
+			zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при удалении сохраненного поиска")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return app_errors.ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+// GetDueSavedSearches возвращает до limit сохраненных поисков с ID больше afterID, которые пора проверить:
+// поиски с мгновенными уведомлениями и ежедневные сводки, проверенные не позже digestBefore
+func (r *Repository) GetDueSavedSearches(ctx context.Context, afterID uint64, digestBefore time.Time, limit int) ([]*entity.SavedSearch, error) {
+	query := `
+		SELECT ` + savedSearchColumns + `
+		FROM saved_searches
+		WHERE id > $1 AND (frequency = 'instant' OR checked_at <= $2)
+		ORDER BY id
+		LIMIT $3`
+
+	return r.querySavedSearches(ctx, query, afterID, digestBefore, limit)
+}
+
+// MarkSavedSearchChecked отмечает, что объявления, опубликованные не позже lastPublishedAt, проверены
+func (r *Repository) MarkSavedSearchChecked(ctx context.Context, id uint64, lastPublishedAt time.Time) error {
+	query := `
+		UPDATE saved_searches SET
+			last_published_at = GREATEST(last_published_at, $2),
+			checked_at = NOW()
+		WHERE id = $1`
+
+	if _, err := r.db.Exec(ctx, query, id, lastPublishedAt); err != nil {
+		r.logger.Error(ctx, "Ошибка при обновлении проверки сохраненного поиска",
+			zap.Uint64("saved_search_id", id),
+			zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при обновлении сохраненного поиска")
+	}
+
+	return nil
+}
+
+// GetPublicationWatermark возвращает границу проверки объявлений — время базы данных, отстающее на commitLag,
+// чтобы транзакции, начатые раньше границы, успели завершиться, — и время последней публикации не позже этой границы
+func (r *Repository) GetPublicationWatermark(ctx context.Context, commitLag time.Duration) (time.Time, time.Time, error) {
+	query := `
+		WITH w AS (SELECT NOW() - make_interval(secs => $1) AS until)
+		SELECT w.until, (
+			SELECT MAX(l.published_at)
+			FROM listings l
+			WHERE l.published_at <= w.until AND l.deleted_at IS NULL
+		)
+		FROM w`
+
+	var until, lastPublishedAt pgtype.Timestamptz
+	if err := r.db.QueryRow(ctx, query, commitLag.Seconds()).Scan(&until, &lastPublishedAt); err != nil {
+		r.logger.Error(ctx, "Ошибка при получении времени последней публикации", zap.Error(err))
+		return time.Time{}, time.Time{}, app_errors.WrapError(err, "ошибка при получении времени последней публикации")
+	}
+
+	return until.Time, lastPublishedAt.Time, nil
+}
+
+// querySavedSearches выполняет запрос, выбирающий savedSearchColumns, и сканирует результат
+func (r *Repository) querySavedSearches(ctx context.Context, query string, args ...any) ([]*entity.SavedSearch, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении сохраненных поисков", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении сохраненных поисков")
+	}
+	defer rows.Close()
+
+	searches := []*entity.SavedSearch{}
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании сохраненного поиска", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении сохраненных поисков")
+		}
+		searches = append(searches, search)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке сохраненных поисков", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении сохраненных поисков")
+	}
+
+	return searches, nil
+}
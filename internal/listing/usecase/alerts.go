@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"go.uber.org/zap"
+)
+
+// notifyPriceChange уведомляет пользователей, добавивших объявление в избранное, о снижении цены.
+// Пользователь с порогом цены получает уведомление, только когда цена впервые опускается до порога,
+// остальные — при любом снижении. Цены в разных валютах сравниваются по текущим курсам.
+// Ошибки доставки не отменяют обновление объявления и только записываются в журнал
+func (uc *UseCase) notifyPriceChange(ctx context.Context, before, after *entity.Listing) {
+	if after.Status != entity.ListingStatusActive || before.Price == after.Price {
+		return
+	}
+
+	watchers, err := uc.repo.GetPriceWatchers(ctx, after.ID)
+	if err != nil {
+		uc.log.Error(ctx, "Не удалось получить подписчиков на цену объявления",
+			zap.Uint64("listing_id", after.ID),
+			zap.Error(err))
+		return
+	}
+	if len(watchers) == 0 {
+		return
+	}
+
+	rates := map[string]float64{}
+	oldPrice, err := uc.baseAmount(ctx, before.Price, rates)
+	if err != nil {
+		return
+	}
+	newPrice, err := uc.baseAmount(ctx, after.Price, rates)
+	if err != nil {
+		return
+	}
+
+	var notifications []*entity.Notification
+	for _, watcher := range watchers {
+		if watcher.UserID == after.AuthorID {
+			continue
+		}
+
+		if watcher.AlertPrice == nil {
+			if newPrice < oldPrice {
+				notifications = append(notifications, priceDropNotification(watcher.UserID, before, after))
+			}
+			continue
+		}
+
+		threshold, err := uc.baseAmount(ctx, *watcher.AlertPrice, rates)
+		if err != nil {
+			continue
+		}
+		if newPrice <= threshold && oldPrice > threshold {
+			notifications = append(notifications, priceThresholdNotification(watcher.UserID, *watcher.AlertPrice, before, after))
+		}
+	}
+
+	if len(notifications) == 0 {
+		return
+	}
+
+	if err := uc.notifier.Notify(ctx, notifications); err != nil {
+		uc.log.Error(ctx, "Ошибка при отправке уведомлений о снижении цены",
+			zap.Uint64("listing_id", after.ID),
+			zap.Int("count", len(notifications)),
+			zap.Error(err))
+		return
+	}
+
+	uc.log.Info(ctx, "Отправлены уведомления о снижении цены",
+		zap.Uint64("listing_id", after.ID),
+		zap.Int("count", len(notifications)))
+}
+
+// baseAmount переводит сумму в минимальные единицы базовой валюты. Курсы кешируются в rates
+func (uc *UseCase) baseAmount(ctx context.Context, price entity.Money, rates map[string]float64) (float64, error) {
+	rate, ok := rates[price.Currency]
+	if !ok {
+		exchangeRate, err := uc.currencyRepo.GetRate(ctx, price.Currency)
+		if err != nil {
+			uc.log.Warn(ctx, "Не удалось получить курс валюты для сравнения цен",
+				zap.String("currency", price.Currency),
+				zap.Error(err))
+			return 0, err
+		}
+		rate = exchangeRate.Rate
+		rates[price.Currency] = rate
+	}
+	return float64(price.Amount) * rate, nil
+}
+
+// priceDropNotification создает уведомление о снижении цены объявления
+func priceDropNotification(userID uint64, before, after *entity.Listing) *entity.Notification {
+	return &entity.Notification{
+		UserID:    userID,
+		Type:      entity.NotificationTypePriceDrop,
+		ListingID: &after.ID,
+		Title:     "Цена снизилась",
+		Message:   fmt.Sprintf("Цена объявления «%s» снизилась с %s до %s", after.Title, before.Price, after.Price),
+		Data: map[string]any{
+			"old_price": before.Price.String(),
+			"new_price": after.Price.String(),
+		},
+	}
+}
+
+// priceThresholdNotification создает уведомление о достижении порога цены, заданного пользователем
+func priceThresholdNotification(userID uint64, alertPrice entity.Money, before, after *entity.Listing) *entity.Notification {
+	return &entity.Notification{
+		UserID:    userID,
+		Type:      entity.NotificationTypePriceThreshold,
+		ListingID: &after.ID,
+		Title:     "Цена достигла порога",
+		Message:   fmt.Sprintf("Цена объявления «%s» опустилась до %s, ваш порог — %s", after.Title, after.Price, alertPrice),
+		Data: map[string]any{
+			"old_price":   before.Price.String(),
+			"new_price":   after.Price.String(),
+			"alert_price": alertPrice.String(),
+		},
+	}
+}
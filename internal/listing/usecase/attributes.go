@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+// validateAttributes проверяет значения атрибутов объявления по схеме категории.
+// Возвращает значения в нормализованном виде, null-значения отбрасываются
+func validateAttributes(schema []*entity.CategoryAttribute, values map[string]any) (map[string]any, error) {
+	attributes := make(map[string]*entity.CategoryAttribute, len(schema))
+	for _, attribute := range schema {
+		attributes[attribute.Key] = attribute
+	}
+
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		attribute, ok := attributes[key]
+		if !ok {
+			return nil, attributeError(key, "атрибут не поддерживается категорией")
+		}
+
+		if value == nil {
+			continue
+		}
+
+		normalized, err := validateAttributeValue(attribute, value)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = normalized
+	}
+
+	for _, attribute := range schema {
+		if _, ok := result[attribute.Key]; attribute.Required && !ok {
+			return nil, attributeError(attribute.Key, "обязательный атрибут не указан")
+		}
+	}
+
+	return result, nil
+}
+
+// validateAttributeValue проверяет значение атрибута на соответствие его типу и ограничениям
+func validateAttributeValue(attribute *entity.CategoryAttribute, value any) (any, error) {
+	switch attribute.Type {
+	case entity.AttributeTypeString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, attributeError(attribute.Key, "ожидается строка")
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil, attributeError(attribute.Key, "значение не может быть пустым")
+		}
+		if attribute.MaxLength != nil && utf8.RuneCountInString(s) > int(*attribute.MaxLength) {
+			return nil, attributeError(attribute.Key, fmt.Sprintf("длина не должна превышать %d символов", *attribute.MaxLength))
+		}
+		return s, nil
+
+	case entity.AttributeTypeEnum:
+		s, ok := value.(string)
+		if !ok || !slices.Contains(attribute.Options, s) {
+			return nil, attributeError(attribute.Key, "допустимые значения: "+strings.Join(attribute.Options, ", "))
+		}
+		return s, nil
+
+	case entity.AttributeTypeNumber:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, attributeError(attribute.Key, "ожидается число")
+		}
+		if attribute.MinValue != nil && n < *attribute.MinValue {
+			return nil, attributeError(attribute.Key, fmt.Sprintf("значение должно быть не меньше %g", *attribute.MinValue))
+		}
+		if attribute.MaxValue != nil && n > *attribute.MaxValue {
+			return nil, attributeError(attribute.Key, fmt.Sprintf("значение должно быть не больше %g", *attribute.MaxValue))
+		}
+		return n, nil
+
+	case entity.AttributeTypeBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, attributeError(attribute.Key, "ожидается логическое значение")
+		}
+		return b, nil
+
+	default:
+		return nil, attributeError(attribute.Key, "неизвестный тип атрибута")
+	}
+}
+
+// attributeError формирует ошибку валидации атрибута
+func attributeError(key, message string) error {
+	return app_errors.WrapError(app_errors.ErrValidation, fmt.Sprintf("атрибут %s: %s", key, message))
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"go.uber.org/zap"
+)
+
+// AddFavorite добавляет объявление в избранное пользователя. Чужой черновик добавить нельзя.
+// Если задан порог цены alertPrice, уведомление придет, когда цена опустится до него,
+// иначе — при любом снижении цены
+func (uc *UseCase) AddFavorite(ctx context.Context, userID, id uint64, alertPrice *entity.Money) (*entity.FavoriteState, error) {
+	if alertPrice != nil {
+		if err := uc.normalizePrice(ctx, alertPrice); err != nil {
+			return nil, err
+		}
+	}
+
+	listing, err := uc.repo.GetListingByID(ctx, id)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить объявление для добавления в избранное",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if !isPublicStatus(listing.Status) && listing.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка добавить в избранное чужой черновик",
+			zap.Uint64("listing_id", id),
+			zap.Uint64("user_id", userID))
+		return nil, app_errors.ErrListingNotFound
+	}
+
+	state, err := uc.repo.AddFavorite(ctx, userID, id, alertPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Объявление добавлено в избранное",
+		zap.Uint64("listing_id", id),
+		zap.Uint64("user_id", userID))
+
+	return state, nil
+}
+
+// RemoveFavorite удаляет объявление из избранного пользователя.
+// Удалить можно и объявление, которое уже недоступно для просмотра
+func (uc *UseCase) RemoveFavorite(ctx context.Context, userID, id uint64) (*entity.FavoriteState, error) {
+	state, err := uc.repo.RemoveFavorite(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Объявление удалено из избранного",
+		zap.Uint64("listing_id", id),
+		zap.Uint64("user_id", userID))
+
+	return state, nil
+}
+
+// ListFavorites возвращает страницу избранных объявлений пользователя
+func (uc *UseCase) ListFavorites(ctx context.Context, userID uint64, page, perPage uint32) (*entity.ListingPage, error) {
+	if page == 0 {
+		page = 1
+	}
+
+	result, err := uc.repo.ListFavorites(ctx, userID, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachImageVariants(ctx, result.Listings...); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Получен список избранных объявлений",
+		zap.Uint64("user_id", userID),
+		zap.Uint32("page", page),
+		zap.Int("count", len(result.Listings)),
+		zap.Uint32("total", result.Total))
+
+	return result, nil
+}
+
+// attachFavorites отмечает объявления, добавленные пользователем в избранное
+func (uc *UseCase) attachFavorites(ctx context.Context, userID uint64, listings ...*entity.Listing) error {
+	if userID == 0 || len(listings) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(listings))
+	for _, listing := range listings {
+		ids = append(ids, listing.ID)
+	}
+
+	favorites, err := uc.repo.GetFavoriteListingIDs(ctx, userID, ids)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении избранного пользователя",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return err
+	}
+
+	for _, listing := range listings {
+		listing.IsFavorite = favorites[listing.ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"go.uber.org/zap"
+)
+
+// AddListingImages добавляет изображения в конец галереи объявления.
+// Изменять галерею может только автор объявления, внешние изображения копируются после проверки прав
+func (uc *UseCase) AddListingImages(ctx context.Context, userID, id uint64, urls []string) (*entity.Listing, error) {
+	return uc.changeListingImages(ctx, userID, id, func() ([]*entity.ListingImage, error) {
+		if len(urls) > uc.cfg.MaxImages {
+			return nil, app_errors.WrapError(app_errors.ErrValidation, "превышено максимальное количество изображений объявления")
+		}
+
+		urls, err := uc.importImages(ctx, userID, urls)
+		if err != nil {
+			return nil, err
+		}
+
+		return uc.repo.AddListingImages(ctx, id, urls, uc.cfg.MaxImages)
+	})
+}
+
+// RemoveListingImage удаляет изображение из галереи объявления.
+// При удалении обложки ее место занимает следующее изображение
+func (uc *UseCase) RemoveListingImage(ctx context.Context, userID, id, imageID uint64) (*entity.Listing, error) {
+	return uc.changeListingImages(ctx, userID, id, func() ([]*entity.ListingImage, error) {
+		return uc.repo.DeleteListingImage(ctx, id, imageID)
+	})
+}
+
+// ReorderListingImages задает новый порядок изображений галереи, первое изображение становится обложкой
+func (uc *UseCase) ReorderListingImages(ctx context.Context, userID, id uint64, imageIDs []uint64) (*entity.Listing, error) {
+	return uc.changeListingImages(ctx, userID, id, func() ([]*entity.ListingImage, error) {
+		return uc.repo.ReorderListingImages(ctx, id, imageIDs)
+	})
+}
+
+// changeListingImages проверяет права автора, выполняет изменение галереи и возвращает объявление с новой галереей
+func (uc *UseCase) changeListingImages(ctx context.Context, userID, id uint64, change func() ([]*entity.ListingImage, error)) (*entity.Listing, error) {
+	current, err := uc.repo.GetListingByID(ctx, id)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить объявление для изменения изображений",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if current.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка изменить изображения чужого объявления",
+			zap.Uint64("listing_id", id),
+			zap.Uint64("user_id", userID))
+		return nil, app_errors.ErrForbidden
+	}
+
+	images, err := change()
+	if err != nil {
+		uc.log.Warn(ctx, "Ошибка при изменении изображений объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	// Обложка и версия меняются вместе с галереей, поэтому объявление перечитывается
+	listing, err := uc.repo.GetListingByID(ctx, id)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении объявления после изменения изображений",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+	listing.Images = images
+
+	if err := uc.attachImageVariants(ctx, listing); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Изображения объявления изменены",
+		zap.Uint64("listing_id", id),
+		zap.Int("count", len(images)))
+
+	return listing, nil
+}
+
+// attachImageVariants заполняет уменьшенные копии обложек и изображений галерей одним запросом
+func (uc *UseCase) attachImageVariants(ctx context.Context, listings ...*entity.Listing) error {
+	urls := make([]string, 0, len(listings))
+	for _, listing := range listings {
+		if listing.ImageURL != "" {
+			urls = append(urls, listing.ImageURL)
+		}
+		for _, image := range listing.Images {
+			urls = append(urls, image.URL)
+		}
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	variants, err := uc.repo.GetImageVariants(ctx, urls)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении уменьшенных копий изображений",
+			zap.Int("count", len(urls)),
+			zap.Error(err))
+		return err
+	}
+
+	for _, listing := range listings {
+		listing.CoverVariants = variants[listing.ImageURL]
+		for _, image := range listing.Images {
+			image.Variants = variants[image.URL]
+		}
+	}
+
+	return nil
+}
+
+// importImages копирует изображения по внешним ссылкам в собственное хранилище и возвращает
+// ссылки на копии в том же порядке. Если импорт выключен, ссылки возвращаются без изменений
+func (uc *UseCase) importImages(ctx context.Context, userID uint64, urls []string) ([]string, error) {
+	if !uc.cfg.ImportRemoteImages {
+		return urls, nil
+	}
+
+	imported := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if url == "" {
+			imported = append(imported, url)
+			continue
+		}
+
+		importedURL, err := uc.uploads.ImportImageURL(ctx, userID, url)
+		if err != nil {
+			uc.log.Warn(ctx, "Не удалось импортировать изображение объявления",
+				zap.String("url", url),
+				zap.Uint64("user_id", userID),
+				zap.Error(err))
+			return nil, err
+		}
+		imported = append(imported, importedURL)
+	}
+
+	return imported, nil
+}
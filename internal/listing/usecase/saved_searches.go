@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	// savedSearchesBatchSize ограничивает количество сохраненных поисков, загружаемых за один запрос
+	savedSearchesBatchSize = 100
+	// savedSearchPreviewSize ограничивает количество объявлений, перечисляемых в уведомлении
+	savedSearchPreviewSize = 3
+	// savedSearchDigestPeriod задает период ежедневной сводки
+	savedSearchDigestPeriod = 24 * time.Hour
+	// savedSearchCommitLag задает отставание границы проверки от текущего времени. Время публикации
+	// фиксируется в начале транзакции, поэтому объявление становится видно другим транзакциям позже
+	savedSearchCommitLag = time.Minute
+)
+
+// CreateSavedSearch сохраняет условия ленты пользователя для уведомлений о новых объявлениях
+func (uc *UseCase) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	if err := uc.prepareSavedSearch(ctx, search); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repo.CreateSavedSearch(ctx, search, uc.cfg.MaxSavedSearches)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Поиск сохранен",
+		zap.Uint64("saved_search_id", created.ID),
+		zap.Uint64("user_id", created.UserID),
+		zap.String("frequency", string(created.Frequency)))
+
+	return created, nil
+}
+
+// GetSavedSearch возвращает сохраненный поиск пользователя
+func (uc *UseCase) GetSavedSearch(ctx context.Context, userID, id uint64) (*entity.SavedSearch, error) {
+	return uc.repo.GetSavedSearch(ctx, userID, id)
+}
+
+// ListSavedSearches возвращает сохраненные поиски пользователя
+func (uc *UseCase) ListSavedSearches(ctx context.Context, userID uint64) ([]*entity.SavedSearch, error) {
+	return uc.repo.ListSavedSearches(ctx, userID)
+}
+
+// UpdateSavedSearch заменяет название, условия и частоту уведомлений сохраненного поиска.
+// Объявления, проверенные до изменения, повторно не проверяются
+func (uc *UseCase) UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	if err := uc.prepareSavedSearch(ctx, search); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repo.UpdateSavedSearch(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Сохраненный поиск обновлен",
+		zap.Uint64("saved_search_id", updated.ID),
+		zap.Uint64("user_id", updated.UserID))
+
+	return updated, nil
+}
+
+// DeleteSavedSearch удаляет сохраненный поиск пользователя
+func (uc *UseCase) DeleteSavedSearch(ctx context.Context, userID, id uint64) error {
+	if err := uc.repo.DeleteSavedSearch(ctx, userID, id); err != nil {
+		return err
+	}
+
+	uc.log.Info(ctx, "Сохраненный поиск удален",
+		zap.Uint64("saved_search_id", id),
+		zap.Uint64("user_id", userID))
+
+	return nil
+}
+
+// MatchSavedSearches проверяет новые объявления по сохраненным поискам и отправляет уведомления.
+// Поиски с мгновенными уведомлениями проверяются при каждом вызове, ежедневные сводки — раз в сутки.
+// Возвращает количество отправленных уведомлений
+func (uc *UseCase) MatchSavedSearches(ctx context.Context) (int, error) {
+	until, lastPublishedAt, err := uc.repo.GetPublicationWatermark(ctx, savedSearchCommitLag)
+	if err != nil {
+		return 0, err
+	}
+
+	digestBefore := time.Now().Add(-savedSearchDigestPeriod)
+	sent := 0
+
+	var afterID uint64
+	for {
+		searches, err := uc.repo.GetDueSavedSearches(ctx, afterID, digestBefore, savedSearchesBatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, search := range searches {
+			afterID = search.ID
+
+			notified, err := uc.matchSavedSearch(ctx, search, until, lastPublishedAt)
+			if err != nil {
+				if ctx.Err() != nil {
+					return sent, ctx.Err()
+				}
+				uc.log.Error(ctx, "Ошибка при проверке сохраненного поиска",
+					zap.Uint64("saved_search_id", search.ID),
+					zap.Error(err))
+				continue
+			}
+			if notified {
+				sent++
+			}
+		}
+
+		if len(searches) < savedSearchesBatchSize {
+			break
+		}
+	}
+
+	if sent > 0 {
+		uc.log.Info(ctx, "Отправлены уведомления по сохраненным поискам", zap.Int("count", sent))
+	}
+
+	return sent, nil
+}
+
+// matchSavedSearch ищет объявления, впервые ставшие активными после предыдущей проверки поиска и не позже until,
+// и уведомляет о них пользователя. Учитываются как новые объявления, так и опубликованные черновики.
+// Собственные объявления пользователя не учитываются
+func (uc *UseCase) matchSavedSearch(ctx context.Context, search *entity.SavedSearch, until, lastPublishedAt time.Time) (bool, error) {
+	hasNewListings := lastPublishedAt.After(search.LastPublishedAt)
+	if !hasNewListings && search.Frequency == entity.SearchFrequencyInstant {
+		return false, nil
+	}
+
+	notified := false
+	if hasNewListings {
+		filter := search.Filter.ListingFilter()
+		filter.Page = 1
+		filter.PerPage = savedSearchPreviewSize
+		filter.SortBy = normalizeSortBy(filter.SortBy, filter.Query)
+		filter.TotalMode = entity.TotalModeExact
+		filter.PublishedAfter = search.LastPublishedAt
+		filter.PublishedUntil = until
+		filter.ExcludeAuthorID = search.UserID
+		// Поиски, сохраненные до ограничения ленты активными объявлениями, могут содержать другой статус
+		filter.Status = entity.ListingStatusActive
+
+		page, err := uc.repo.GetListings(ctx, filter)
+		if err != nil {
+			return false, err
+		}
+
+		if page.Total > 0 {
+			notification := savedSearchNotification(search, page)
+			if err := uc.notifier.Notify(ctx, []*entity.Notification{notification}); err != nil {
+				return false, err
+			}
+			notified = true
+		}
+	}
+
+	if err := uc.repo.MarkSavedSearchChecked(ctx, search.ID, until); err != nil {
+		return notified, err
+	}
+
+	return notified, nil
+}
+
+// prepareSavedSearch проверяет сохраненный поиск и подставляет значения по умолчанию
+func (uc *UseCase) prepareSavedSearch(ctx context.Context, search *entity.SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	if search.Name == "" {
+		return app_errors.WrapError(app_errors.ErrValidation, "название сохраненного поиска не может быть пустым")
+	}
+
+	switch search.Frequency {
+	case "":
+		search.Frequency = entity.SearchFrequencyInstant
+	case entity.SearchFrequencyInstant, entity.SearchFrequencyDaily:
+	default:
+		return app_errors.WrapError(app_errors.ErrValidation, "неизвестная частота уведомлений")
+	}
+
+	search.Filter.Query = strings.TrimSpace(search.Filter.Query)
+	search.Filter.SortBy = normalizeSortBy(search.Filter.SortBy, search.Filter.Query)
+
+	// Границы цены в фильтре общие с сохраненным поиском, поэтому подставленная валюта сохраняется вместе с ним
+	if err := uc.validateFilter(search.Filter.ListingFilter()); err != nil {
+		return err
+	}
+
+	if search.Filter.DisplayCurrency != "" {
+		if err := uc.checkCurrency(ctx, search.Filter.DisplayCurrency); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// savedSearchNotification создает уведомление о новых объявлениях сохраненного поиска
+func savedSearchNotification(search *entity.SavedSearch, page *entity.ListingPage) *entity.Notification {
+	titles := make([]string, 0, len(page.Listings))
+	listingIDs := make([]any, 0, len(page.Listings))
+	for _, listing := range page.Listings {
+		titles = append(titles, "«"+listing.Title+"»")
+		listingIDs = append(listingIDs, listing.ID)
+	}
+
+	message := fmt.Sprintf("Новых объявлений: %d", page.Total)
+	if search.Frequency == entity.SearchFrequencyDaily {
+		message = fmt.Sprintf("Новых объявлений за сутки: %d", page.Total)
+	}
+	if len(titles) > 0 {
+		message += ". Среди них " + strings.Join(titles, ", ")
+	}
+
+	notification := &entity.Notification{
+		UserID:  search.UserID,
+		Type:    entity.NotificationTypeSavedSearch,
+		Title:   fmt.Sprintf("Новые объявления по поиску «%s»", search.Name),
+		Message: message,
+		Data: map[string]any{
+			"saved_search_id": search.ID,
+			"frequency":       string(search.Frequency),
+			"total":           page.Total,
+			"listing_ids":     listingIDs,
+		},
+	}
+
+	if page.Total == 1 && len(page.Listings) == 1 {
+		notification.ListingID = &page.Listings[0].ID
+	}
+
+	return notification
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+// statusTransitions определяет допустимые переходы между статусами объявления
+var statusTransitions = map[entity.ListingStatus][]entity.ListingStatus{
+	entity.ListingStatusDraft: {
+		entity.ListingStatusActive,
+		entity.ListingStatusArchived,
+	},
+	entity.ListingStatusActive: {
+		entity.ListingStatusReserved,
+		entity.ListingStatusSold,
+		entity.ListingStatusArchived,
+	},
+	entity.ListingStatusReserved: {
+		entity.ListingStatusActive,
+		entity.ListingStatusSold,
+		entity.ListingStatusArchived,
+	},
+	entity.ListingStatusSold: {
+		entity.ListingStatusArchived,
+	},
+	entity.ListingStatusArchived: {
+		entity.ListingStatusDraft,
+		entity.ListingStatusActive,
+	},
+}
+
+// canTransition проверяет, можно ли перевести объявление из статуса from в статус to
+func canTransition(from, to entity.ListingStatus) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isPublicStatus проверяет, видно ли объявление с данным статусом всем пользователям
+func isPublicStatus(status entity.ListingStatus) bool {
+	return status != entity.ListingStatusDraft
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+// suggestionCache хранит слова для подсказок в памяти процесса,
+// чтобы не обращаться к таблице объявлений на каждый ввод символа
+type suggestionCache struct {
+	mu    sync.RWMutex
+	terms []*entity.Suggestion
+}
+
+// replace заменяет содержимое кеша. Слова сортируются по алфавиту для поиска по префиксу
+func (c *suggestionCache) replace(terms []*entity.Suggestion) {
+	sorted := make([]*entity.Suggestion, len(terms))
+	copy(sorted, terms)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Text < sorted[j].Text
+	})
+
+	c.mu.Lock()
+	c.terms = sorted
+	c.mu.Unlock()
+}
+
+// find возвращает до limit самых популярных слов, начинающихся с prefix
+func (c *suggestionCache) find(prefix string, limit int) []*entity.Suggestion {
+	c.mu.RLock()
+	terms := c.terms
+	c.mu.RUnlock()
+
+	start := sort.Search(len(terms), func(i int) bool {
+		return terms[i].Text >= prefix
+	})
+
+	matches := make([]*entity.Suggestion, 0)
+	for i := start; i < len(terms) && strings.HasPrefix(terms[i].Text, prefix); i++ {
+		matches = append(matches, terms[i])
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].ListingsCount > matches[j].ListingsCount
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
@@ -2,86 +2,685 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/auth"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/category"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/currency"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/listing"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload"
 	"go.uber.org/zap"
 )
 
+// Config содержит настройки бизнес-логики объявлений
+type Config struct {
+	// DeletedRetention определяет, сколько хранятся удаленные объявления до окончательного удаления
+	DeletedRetention time.Duration
+	// SimilarityThreshold задает минимальное сходство запроса с заголовком при нечетком поиске
+	SimilarityThreshold float64
+	// SuggestionsMaxTerms ограничивает количество слов в кеше подсказок
+	SuggestionsMaxTerms int
+	// PriceBuckets задает границы диапазонов гистограммы цен по умолчанию
+	PriceBuckets []float32
+	// DefaultCurrency используется для цен и фильтров, в которых валюта не указана
+	DefaultCurrency string
+	// MaxImages ограничивает количество изображений в галерее объявления
+	MaxImages int
+	// ImportRemoteImages включает копирование изображений по внешним ссылкам в собственное хранилище
+	ImportRemoteImages bool
+	// MaxSavedSearches ограничивает количество сохраненных поисков пользователя
+	MaxSavedSearches int
+}
+
+// defaultSuggestionsLimit используется, если количество подсказок не указано
+const defaultSuggestionsLimit = 10
+
 // UseCase реализует интерфейс listing.UseCase
 type UseCase struct {
-	repo listing.Repository
-	log  *logger.Logger
+	repo         listing.Repository
+	categoryRepo category.Repository
+	currencyRepo currency.Repository
+	userRepo     auth.Repository
+	uploads      upload.UseCase
+	notifier     notification.Notifier
+	cfg          Config
+	suggestions  *suggestionCache
+	log          *logger.Logger
 }
 
 // New создает новый экземпляр UseCase
-func New(repo listing.Repository, log *logger.Logger) *UseCase {
+func New(repo listing.Repository, categoryRepo category.Repository, currencyRepo currency.Repository, userRepo auth.Repository, uploads upload.UseCase, notifier notification.Notifier, cfg Config, log *logger.Logger) *UseCase {
 	return &UseCase{
-		repo: repo,
-		log:  log,
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		currencyRepo: currencyRepo,
+		userRepo:     userRepo,
+		uploads:      uploads,
+		notifier:     notifier,
+		cfg:          cfg,
+		suggestions:  &suggestionCache{},
+		log:          log,
 	}
 }
 
-// CreateListing создает новое объявление
-func (uc *UseCase) CreateListing(ctx context.Context, authorID uint64, title, description, imageURL string, price float32) (*entity.Listing, error) {
-	listing := entity.NewListing(title, description, imageURL, price, authorID)
+// CreateListing создает новое объявление. Объявление может быть создано черновиком или сразу активным
+func (uc *UseCase) CreateListing(ctx context.Context, listing *entity.Listing) (*entity.Listing, error) {
+	if listing.Status == "" {
+		listing.Status = entity.ListingStatusActive
+	}
+
+	if listing.Status != entity.ListingStatusDraft && listing.Status != entity.ListingStatusActive {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "объявление можно создать только черновиком или активным")
+	}
+
+	if err := uc.normalizePrice(ctx, &listing.Price); err != nil {
+		return nil, err
+	}
+
+	// Обложка всегда первое изображение галереи
+	if len(listing.Images) == 0 {
+		listing.Images = entity.NewListingImages([]string{listing.ImageURL})
+	}
+	if len(listing.Images) > uc.cfg.MaxImages {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "превышено максимальное количество изображений объявления")
+	}
+
+	urls := make([]string, 0, len(listing.Images))
+	for _, image := range listing.Images {
+		urls = append(urls, image.URL)
+	}
+	urls, err := uc.importImages(ctx, listing.AuthorID, urls)
+	if err != nil {
+		return nil, err
+	}
+	for i, image := range listing.Images {
+		image.URL = urls[i]
+	}
+	listing.ImageURL = listing.Images[0].URL
+
+	attributes, err := uc.prepareAttributes(ctx, listing.CategoryID, listing.Attributes)
+	if err != nil {
+		uc.log.Warn(ctx, "Некорректные атрибуты объявления",
+			zap.Uint64("category_id", listing.CategoryID),
+			zap.Error(err))
+		return nil, err
+	}
+	listing.Attributes = attributes
 
 	createdListing, err := uc.repo.CreateListing(ctx, listing)
 	if err != nil {
 		uc.log.Error(ctx, "Ошибка при создании объявления",
-			zap.String("title", title),
-			zap.Uint64("author_id", authorID),
+			zap.String("title", listing.Title),
+			zap.Uint64("author_id", listing.AuthorID),
 			zap.Error(err))
 		return nil, err
 	}
 
 	uc.log.Info(ctx, "Объявление успешно создано",
 		zap.Uint64("listing_id", createdListing.ID),
-		zap.Uint64("author_id", authorID))
+		zap.Uint64("author_id", createdListing.AuthorID))
 
 	return createdListing, nil
 }
 
-// GetListings получает список объявлений с фильтрацией, сортировкой и пагинацией
-func (uc *UseCase) GetListings(ctx context.Context, page, perPage uint32, sortBy string, sortDesc bool, minPrice, maxPrice *float32) ([]*entity.Listing, uint32, error) {
-	if minPrice != nil && maxPrice != nil && *minPrice > *maxPrice {
-		return nil, 0, app_errors.WrapError(app_errors.ErrValidation, "минимальная цена не может быть больше максимальной")
+// GetListings получает список объявлений с фильтрацией, сортировкой и пагинацией.
+// По умолчанию в ленту попадают только активные объявления. Если задан курсор,
+// выборка продолжается после него, иначе используется номер страницы.
+// Если задана валюта отображения, цены конвертируются в нее по текущим курсам
+func (uc *UseCase) GetListings(ctx context.Context, filter *entity.ListingFilter) (*entity.ListingPage, error) {
+	if err := uc.validateFilter(filter); err != nil {
+		return nil, err
 	}
 
-	validSortFields := map[string]bool{
-		"created_at": true,
-		"price":      true,
+	return uc.queryListings(ctx, filter)
+}
+
+// GetUserListings получает ленту объявлений автора с именем username.
+// Как и в общей ленте, возвращаются только активные объявления
+func (uc *UseCase) GetUserListings(ctx context.Context, username string, filter *entity.ListingFilter) (*entity.ListingPage, error) {
+	author, err := uc.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить автора объявлений",
+			zap.String("username", username),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if err := uc.validateFilter(filter); err != nil {
+		return nil, err
 	}
+	filter.AuthorID = author.ID
+
+	return uc.queryListings(ctx, filter)
+}
+
+// GetMyListings получает объявления пользователя userID, включая черновики, забронированные,
+// проданные и архивные. Если статус не указан, возвращаются объявления во всех статусах
+func (uc *UseCase) GetMyListings(ctx context.Context, userID uint64, filter *entity.ListingFilter) (*entity.ListingPage, error) {
+	if err := uc.validateFilterBounds(filter); err != nil {
+		return nil, err
+	}
+	filter.AuthorID = userID
+
+	return uc.queryListings(ctx, filter)
+}
+
+// queryListings получает страницу ленты по проверенному фильтру
+func (uc *UseCase) queryListings(ctx context.Context, filter *entity.ListingFilter) (*entity.ListingPage, error) {
+	if filter.DisplayCurrency != "" {
+		if err := uc.checkCurrency(ctx, filter.DisplayCurrency); err != nil {
+			return nil, err
+		}
+	}
+
+	filter.SortBy = normalizeSortBy(filter.SortBy, filter.Query)
 
-	if !validSortFields[sortBy] {
-		sortBy = "created_at"
+	if filter.Cursor != nil {
+		if filter.SortBy == "relevance" {
+			return nil, app_errors.WrapError(app_errors.ErrValidation, "токен страницы не поддерживается при сортировке по релевантности")
+		}
+		if filter.Cursor.SortBy != filter.SortBy || filter.Cursor.SortDesc != filter.SortDesc || filter.Cursor.Currency != filter.DisplayCurrency {
+			return nil, app_errors.WrapError(app_errors.ErrValidation, "токен страницы не соответствует сортировке запроса")
+		}
+	} else if filter.Page == 0 {
+		filter.Page = 1
 	}
 
-	filter := &entity.ListingFilter{
-		Page:     page,
-		PerPage:  perPage,
-		SortBy:   sortBy,
-		SortDesc: sortDesc,
-		MinPrice: minPrice,
-		MaxPrice: maxPrice,
+	if filter.TotalMode == "" {
+		filter.TotalMode = entity.TotalModeExact
 	}
 
-	listings, total, err := uc.repo.GetListings(ctx, filter)
+	page, err := uc.repo.GetListings(ctx, filter)
 	if err != nil {
 		uc.log.Error(ctx, "Ошибка при получении списка объявлений",
-			zap.Uint32("page", page),
-			zap.Uint32("per_page", perPage),
+			zap.Uint32("page", filter.Page),
+			zap.Uint32("per_page", filter.PerPage),
 			zap.Error(err))
-		return nil, 0, err
+		return nil, err
+	}
+
+	if err := uc.attachImageVariants(ctx, page.Listings...); err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachFavorites(ctx, filter.ViewerID, page.Listings...); err != nil {
+		return nil, err
 	}
 
 	uc.log.Info(ctx, "Успешно получен список объявлений",
-		zap.Uint32("page", page),
-		zap.Uint32("per_page", perPage),
-		zap.Int("count", len(listings)),
+		zap.Uint32("page", filter.Page),
+		zap.Uint32("per_page", filter.PerPage),
+		zap.Uint64("author_id", filter.AuthorID),
+		zap.Bool("cursor", filter.Cursor != nil),
+		zap.String("total_mode", string(filter.TotalMode)),
+		zap.Int("count", len(page.Listings)),
+		zap.Uint32("total", page.Total))
+
+	return page, nil
+}
+
+// GetListingFacets считает объявления ленты в разрезе категорий, статусов и диапазонов цен.
+// Если границы диапазонов цен не переданы, используются границы из конфигурации.
+// Гистограмма строится в валюте currency, по умолчанию — в валюте фильтра по цене
+func (uc *UseCase) GetListingFacets(ctx context.Context, filter *entity.ListingFilter, currency string, priceBuckets []float32) (*entity.ListingFacets, error) {
+	if err := uc.validateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	if currency == "" {
+		switch {
+		case filter.MinPrice != nil:
+			currency = filter.MinPrice.Currency
+		case filter.MaxPrice != nil:
+			currency = filter.MaxPrice.Currency
+		default:
+			currency = uc.cfg.DefaultCurrency
+		}
+	}
+
+	if len(priceBuckets) == 0 {
+		priceBuckets = uc.cfg.PriceBuckets
+	}
+	priceBuckets = slices.Clone(priceBuckets)
+	slices.Sort(priceBuckets)
+	priceBuckets = slices.Compact(priceBuckets)
+
+	boundaries := make([]entity.Money, 0, len(priceBuckets))
+	for _, boundary := range priceBuckets {
+		boundaries = append(boundaries, entity.NewMoneyFromFloat(float64(boundary), currency))
+	}
+
+	facets, err := uc.repo.GetListingFacets(ctx, filter, boundaries)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении фасетов ленты", zap.Error(err))
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// SearchListings выполняет нечеткий поиск активных объявлений по заголовку
+func (uc *UseCase) SearchListings(ctx context.Context, query string, page, perPage uint32) ([]*entity.ListingSearchResult, uint32, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if utf8.RuneCountInString(query) < 2 {
+		return nil, 0, app_errors.WrapError(app_errors.ErrValidation, "поисковый запрос должен содержать не менее 2 символов")
+	}
+
+	filter := &entity.ListingSearchFilter{
+		Query:               query,
+		Page:                page,
+		PerPage:             perPage,
+		Status:              entity.ListingStatusActive,
+		SimilarityThreshold: uc.cfg.SimilarityThreshold,
+	}
+
+	results, total, err := uc.repo.SearchListings(ctx, filter)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при нечетком поиске объявлений",
+			zap.String("query", query),
+			zap.Error(err))
+		return nil, 0, err
+	}
+
+	uc.log.Info(ctx, "Выполнен нечеткий поиск объявлений",
+		zap.String("query", query),
+		zap.Int("count", len(results)),
 		zap.Uint32("total", total))
 
-	return listings, total, nil
+	return results, total, nil
+}
+
+// SuggestListings возвращает подсказки для строки поиска из кеша
+func (uc *UseCase) SuggestListings(ctx context.Context, prefix string, limit int) []*entity.Suggestion {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" {
+		return []*entity.Suggestion{}
+	}
+
+	if limit <= 0 {
+		limit = defaultSuggestionsLimit
+	}
+
+	return uc.suggestions.find(prefix, limit)
+}
+
+// RefreshSuggestions перечитывает слова для подсказок из базы данных
+func (uc *UseCase) RefreshSuggestions(ctx context.Context) error {
+	terms, err := uc.repo.GetSuggestionTerms(ctx, uc.cfg.SuggestionsMaxTerms)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при обновлении подсказок", zap.Error(err))
+		return err
+	}
+
+	uc.suggestions.replace(terms)
+
+	uc.log.Debug(ctx, "Кеш подсказок обновлен", zap.Int("terms", len(terms)))
+
+	return nil
+}
+
+// GetListing получает объявление по ID. Черновик доступен только автору
+func (uc *UseCase) GetListing(ctx context.Context, userID, id uint64) (*entity.Listing, error) {
+	listing, err := uc.repo.GetListingByID(ctx, id)
+	if err != nil {
+		if app_errors.IsNotFound(err) {
+			uc.log.Warn(ctx, "Объявление не найдено", zap.Uint64("listing_id", id))
+			return nil, err
+		}
+		uc.log.Error(ctx, "Ошибка при получении объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if !isPublicStatus(listing.Status) && listing.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка просмотра чужого черновика",
+			zap.Uint64("listing_id", id),
+			zap.Uint64("user_id", userID))
+		return nil, app_errors.ErrListingNotFound
+	}
+
+	listing.Images, err = uc.repo.GetListingImages(ctx, id)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при получении изображений объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if err := uc.attachImageVariants(ctx, listing); err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachFavorites(ctx, userID, listing); err != nil {
+		return nil, err
+	}
+
+	return listing, nil
+}
+
+// UpdateListing частично обновляет объявление. Редактировать объявление может только его автор
+func (uc *UseCase) UpdateListing(ctx context.Context, userID uint64, update *entity.ListingUpdate) (*entity.Listing, error) {
+	if update.IsEmpty() {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "не указаны поля для обновления")
+	}
+
+	current, err := uc.repo.GetListingByID(ctx, update.ID)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить объявление для обновления",
+			zap.Uint64("listing_id", update.ID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if current.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка редактировать чужое объявление",
+			zap.Uint64("listing_id", update.ID),
+			zap.Uint64("user_id", userID))
+		return nil, app_errors.ErrForbidden
+	}
+
+	if current.Version != update.Version {
+		uc.log.Warn(ctx, "Конфликт версий при обновлении объявления",
+			zap.Uint64("listing_id", update.ID),
+			zap.Uint64("expected_version", update.Version),
+			zap.Uint64("current_version", current.Version))
+		return nil, app_errors.ErrListingConflict
+	}
+
+	if update.Price != nil {
+		if err := uc.normalizePrice(ctx, update.Price); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.ImageURL != nil {
+		urls, err := uc.importImages(ctx, userID, []string{*update.ImageURL})
+		if err != nil {
+			return nil, err
+		}
+		update.ImageURL = &urls[0]
+	}
+
+	if update.CategoryID != nil || update.Attributes != nil {
+		categoryID := current.CategoryID
+		if update.CategoryID != nil {
+			categoryID = *update.CategoryID
+		}
+
+		values := current.Attributes
+		if update.Attributes != nil {
+			values = update.Attributes
+		}
+
+		attributes, err := uc.prepareAttributes(ctx, categoryID, values)
+		if err != nil {
+			uc.log.Warn(ctx, "Некорректные атрибуты объявления",
+				zap.Uint64("listing_id", update.ID),
+				zap.Uint64("category_id", categoryID),
+				zap.Error(err))
+			return nil, err
+		}
+		update.Attributes = attributes
+	}
+
+	updated, err := uc.repo.UpdateListing(ctx, update)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при обновлении объявления",
+			zap.Uint64("listing_id", update.ID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Объявление успешно обновлено",
+		zap.Uint64("listing_id", updated.ID),
+		zap.Uint64("version", updated.Version))
+
+	if update.Price != nil {
+		uc.notifyPriceChange(ctx, current, updated)
+	}
+
+	return updated, nil
+}
+
+// ChangeListingStatus переводит объявление в новый статус с учетом допустимых переходов.
+// Изменить статус может только автор объявления
+func (uc *UseCase) ChangeListingStatus(ctx context.Context, userID, id uint64, status entity.ListingStatus) (*entity.Listing, error) {
+	current, err := uc.repo.GetListingByID(ctx, id)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить объявление для изменения статуса",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if current.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка изменить статус чужого объявления",
+			zap.Uint64("listing_id", id),
+			zap.Uint64("user_id", userID))
+		return nil, app_errors.ErrForbidden
+	}
+
+	if !canTransition(current.Status, status) {
+		return nil, app_errors.WrapError(app_errors.ErrValidation,
+			fmt.Sprintf("недопустимый переход статуса объявления: %s -> %s", current.Status, status))
+	}
+
+	updated, err := uc.repo.UpdateListingStatus(ctx, id, current.Status, status)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при изменении статуса объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Статус объявления изменен",
+		zap.Uint64("listing_id", id),
+		zap.String("status", string(status)))
+
+	return updated, nil
+}
+
+// DeleteListing помечает объявление удаленным и возвращает время, до которого его можно восстановить.
+// Удалить объявление может только его автор
+func (uc *UseCase) DeleteListing(ctx context.Context, userID, id uint64) (time.Time, error) {
+	current, err := uc.repo.GetListingByID(ctx, id)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить объявление для удаления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return time.Time{}, err
+	}
+
+	if current.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка удалить чужое объявление",
+			zap.Uint64("listing_id", id),
+			zap.Uint64("user_id", userID))
+		return time.Time{}, app_errors.ErrForbidden
+	}
+
+	deletedAt, err := uc.repo.DeleteListing(ctx, id)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при удалении объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return time.Time{}, err
+	}
+
+	uc.log.Info(ctx, "Объявление удалено",
+		zap.Uint64("listing_id", id),
+		zap.Uint64("user_id", userID))
+
+	return deletedAt.Add(uc.cfg.DeletedRetention), nil
+}
+
+// RestoreListing восстанавливает удаленное объявление, если срок хранения еще не истек.
+// Восстановить объявление может только его автор
+func (uc *UseCase) RestoreListing(ctx context.Context, userID, id uint64) (*entity.Listing, error) {
+	deleted, err := uc.repo.GetDeletedListingByID(ctx, id)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось получить удаленное объявление",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if deleted.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка восстановить чужое объявление",
+			zap.Uint64("listing_id", id),
+			zap.Uint64("user_id", userID))
+		return nil, app_errors.ErrForbidden
+	}
+
+	if deleted.DeletedAt != nil && time.Since(*deleted.DeletedAt) > uc.cfg.DeletedRetention {
+		uc.log.Warn(ctx, "Срок восстановления объявления истек", zap.Uint64("listing_id", id))
+		return nil, app_errors.ErrListingNotFound
+	}
+
+	restored, err := uc.repo.RestoreListing(ctx, id)
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при восстановлении объявления",
+			zap.Uint64("listing_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Объявление восстановлено",
+		zap.Uint64("listing_id", id),
+		zap.Uint64("user_id", userID))
+
+	return restored, nil
+}
+
+// PurgeDeletedListings окончательно удаляет объявления, срок хранения которых истек
+func (uc *UseCase) PurgeDeletedListings(ctx context.Context) (int64, error) {
+	purged, err := uc.repo.PurgeDeletedListings(ctx, time.Now().Add(-uc.cfg.DeletedRetention))
+	if err != nil {
+		uc.log.Error(ctx, "Ошибка при очистке удаленных объявлений", zap.Error(err))
+		return 0, err
+	}
+
+	if purged > 0 {
+		uc.log.Info(ctx, "Удаленные объявления окончательно удалены", zap.Int64("count", purged))
+	}
+
+	return purged, nil
+}
+
+// prepareAttributes проверяет существование категории и значения атрибутов по ее схеме
+func (uc *UseCase) prepareAttributes(ctx context.Context, categoryID uint64, values map[string]any) (map[string]any, error) {
+	if _, err := uc.categoryRepo.GetCategoryByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	schema, err := uc.categoryRepo.GetCategoryAttributes(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateAttributes(schema, values)
+}
+
+// validateFilter проверяет фильтр ленты и подставляет значения по умолчанию.
+// В публичную ленту попадают только активные объявления, остальные статусы видны автору в GetMyListings
+func (uc *UseCase) validateFilter(filter *entity.ListingFilter) error {
+	if err := uc.validateFilterBounds(filter); err != nil {
+		return err
+	}
+
+	if filter.Status == "" {
+		filter.Status = entity.ListingStatusActive
+	}
+
+	if filter.Status != entity.ListingStatusActive {
+		return app_errors.WrapError(app_errors.ErrValidation, "в ленте объявлений отображаются только активные объявления")
+	}
+
+	return nil
+}
+
+// validateFilterBounds проверяет границы цены и атрибутов в фильтре ленты.
+// При конвертации границы цены задаются в валюте отображения
+func (uc *UseCase) validateFilterBounds(filter *entity.ListingFilter) error {
+	for _, price := range []*entity.Money{filter.MinPrice, filter.MaxPrice} {
+		if price == nil {
+			continue
+		}
+		if filter.DisplayCurrency != "" {
+			if price.Currency != "" && price.Currency != filter.DisplayCurrency {
+				return app_errors.WrapError(app_errors.ErrValidation, "границы цены должны быть в валюте отображения")
+			}
+			price.Currency = filter.DisplayCurrency
+		} else if price.Currency == "" {
+			price.Currency = uc.cfg.DefaultCurrency
+		}
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil {
+		if filter.MinPrice.Currency != filter.MaxPrice.Currency {
+			return app_errors.WrapError(app_errors.ErrValidation, "минимальная и максимальная цена должны быть в одной валюте")
+		}
+		if filter.MinPrice.Amount > filter.MaxPrice.Amount {
+			return app_errors.WrapError(app_errors.ErrValidation, "минимальная цена не может быть больше максимальной")
+		}
+	}
+
+	for key, bounds := range filter.AttributeRanges {
+		if bounds.Min != nil && bounds.Max != nil && *bounds.Min > *bounds.Max {
+			return attributeError(key, "минимальное значение не может быть больше максимального")
+		}
+	}
+
+	return nil
+}
+
+// normalizeSortBy возвращает поле сортировки ленты. Неизвестное поле и сортировка по релевантности
+// без поискового запроса заменяются сортировкой по дате создания
+func normalizeSortBy(sortBy, query string) string {
+	validSortFields := map[string]bool{
+		"created_at": true,
+		"price":      true,
+		"relevance":  true,
+	}
+
+	if !validSortFields[sortBy] || (sortBy == "relevance" && query == "") {
+		return "created_at"
+	}
+	return sortBy
+}
+
+// normalizePrice проверяет цену объявления и подставляет валюту по умолчанию.
+// Допускаются только валюты с известным курсом, чтобы объявление можно было конвертировать
+func (uc *UseCase) normalizePrice(ctx context.Context, price *entity.Money) error {
+	if price.Amount <= 0 {
+		return app_errors.WrapError(app_errors.ErrValidation, "цена должна быть больше нуля")
+	}
+
+	if price.Currency == "" {
+		price.Currency = uc.cfg.DefaultCurrency
+	}
+
+	return uc.checkCurrency(ctx, price.Currency)
+}
+
+// checkCurrency проверяет, что для валюты известен курс
+func (uc *UseCase) checkCurrency(ctx context.Context, code string) error {
+	if _, err := uc.currencyRepo.GetRate(ctx, code); err != nil {
+		if app_errors.IsNotFound(err) {
+			return app_errors.WrapError(app_errors.ErrValidation, fmt.Sprintf("валюта %s не поддерживается", code))
+		}
+		uc.log.Error(ctx, "Ошибка при получении курса валюты",
+			zap.String("currency", code),
+			zap.Error(err))
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/listing"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// Purger периодически окончательно удаляет объявления, срок хранения которых истек
+type Purger struct {
+	listingUC listing.UseCase
+	interval  time.Duration
+	log       *logger.Logger
+}
+
+// NewPurger создает новый экземпляр Purger
+func NewPurger(listingUC listing.UseCase, interval time.Duration, log *logger.Logger) *Purger {
+	return &Purger{
+		listingUC: listingUC,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run запускает очистку и блокируется до отмены контекста
+func (p *Purger) Run(ctx context.Context) {
+	p.log.Info(ctx, "Очистка удаленных объявлений запущена", zap.Duration("interval", p.interval))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			p.log.Info(ctx, "Очистка удаленных объявлений остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	if _, err := p.listingUC.PurgeDeletedListings(ctx); err != nil && ctx.Err() == nil {
+		p.log.Error(ctx, "Ошибка при очистке удаленных объявлений", zap.Error(err))
+	}
+}
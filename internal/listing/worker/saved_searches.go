@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/listing"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// SavedSearchMatcher периодически проверяет новые объявления по сохраненным поискам пользователей
+type SavedSearchMatcher struct {
+	listingUC listing.UseCase
+	interval  time.Duration
+	log       *logger.Logger
+}
+
+// NewSavedSearchMatcher создает новый экземпляр SavedSearchMatcher
+func NewSavedSearchMatcher(listingUC listing.UseCase, interval time.Duration, log *logger.Logger) *SavedSearchMatcher {
+	return &SavedSearchMatcher{
+		listingUC: listingUC,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run запускает проверку сохраненных поисков и блокируется до отмены контекста
+func (m *SavedSearchMatcher) Run(ctx context.Context) {
+	m.log.Info(ctx, "Проверка сохраненных поисков запущена", zap.Duration("interval", m.interval))
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.match(ctx)
+
+		select {
+		case <-ctx.Done():
+			m.log.Info(ctx, "Проверка сохраненных поисков остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *SavedSearchMatcher) match(ctx context.Context) {
+	if _, err := m.listingUC.MatchSavedSearches(ctx); err != nil && ctx.Err() == nil {
+		m.log.Error(ctx, "Ошибка при проверке сохраненных поисков", zap.Error(err))
+	}
+}
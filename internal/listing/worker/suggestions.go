@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/listing"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// SuggestionsRefresher периодически обновляет кеш подсказок для строки поиска
+type SuggestionsRefresher struct {
+	listingUC listing.UseCase
+	interval  time.Duration
+	log       *logger.Logger
+}
+
+// NewSuggestionsRefresher создает новый экземпляр SuggestionsRefresher
+func NewSuggestionsRefresher(listingUC listing.UseCase, interval time.Duration, log *logger.Logger) *SuggestionsRefresher {
+	return &SuggestionsRefresher{
+		listingUC: listingUC,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run обновляет кеш подсказок и блокируется до отмены контекста
+func (s *SuggestionsRefresher) Run(ctx context.Context) {
+	s.log.Info(ctx, "Обновление подсказок запущено", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.log.Info(ctx, "Обновление подсказок остановлено")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SuggestionsRefresher) refresh(ctx context.Context) {
+	if err := s.listingUC.RefreshSuggestions(ctx); err != nil && ctx.Err() == nil {
+		s.log.Error(ctx, "Ошибка при обновлении подсказок", zap.Error(err))
+	}
+}
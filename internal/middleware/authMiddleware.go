@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/auth"
 	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
@@ -15,8 +16,11 @@ import (
 
 type userIDKey string
 
+type tokenExpiresAtKey string
+
 const (
-	UserIDKey userIDKey = "user_id"
+	UserIDKey         userIDKey         = "user_id"
+	TokenExpiresAtKey tokenExpiresAtKey = "token_expires_at"
 )
 
 type authRequirement int
@@ -29,9 +33,31 @@ const (
 
 // methodAuthMap определяет требования к аутентификации для различных методов
 var methodAuthMap = map[string]authRequirement{
-	"/auth.AuthService/Login":                 authExempt,
-	"/auth.AuthService/Register":              authExempt,
-	"/listings.ListingsService/CreateListing": authRequired,
+	"/auth.AuthService/Login":                               authExempt,
+	"/auth.AuthService/Register":                            authExempt,
+	"/auth.AuthService/GetMe":                               authRequired,
+	"/auth.AuthService/RefreshToken":                        authExempt,
+	"/auth.AuthService/Logout":                              authExempt,
+	"/listings.ListingsService/CreateListing":               authRequired,
+	"/listings.ListingsService/UpdateListing":               authRequired,
+	"/listings.ListingsService/DeleteListing":               authRequired,
+	"/listings.ListingsService/RestoreListing":              authRequired,
+	"/listings.ListingsService/ChangeListingStatus":         authRequired,
+	"/listings.ListingsService/AddListingImages":            authRequired,
+	"/listings.ListingsService/RemoveListingImage":          authRequired,
+	"/listings.ListingsService/ReorderListingImages":        authRequired,
+	"/listings.ListingsService/AddFavorite":                 authRequired,
+	"/listings.ListingsService/RemoveFavorite":              authRequired,
+	"/listings.ListingsService/ListFavorites":               authRequired,
+	"/listings.ListingsService/CreateSavedSearch":           authRequired,
+	"/listings.ListingsService/ListSavedSearches":           authRequired,
+	"/listings.ListingsService/GetSavedSearch":              authRequired,
+	"/listings.ListingsService/UpdateSavedSearch":           authRequired,
+	"/listings.ListingsService/DeleteSavedSearch":           authRequired,
+	"/listings.ListingsService/GetMyListings":               authRequired,
+	"/uploads.UploadsService/UploadImage":                   authRequired,
+	"/notifications.NotificationsService/ListNotifications": authRequired,
+	"/users.UsersService/UpdateMyProfile":                   authRequired,
 }
 
 func getAuthRequirement(method string) authRequirement {
@@ -49,44 +75,80 @@ func AuthInterceptor(authUC auth.UseCase, log *logger.Logger) grpc.UnaryServerIn
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		method := info.FullMethod
-		authReq := getAuthRequirement(method)
-
-		if authReq == authExempt {
-			return handler(ctx, req)
-		}
-		token, ok := extractToken(ctx, log, method)
-
-		if !ok {
-			if authReq == authRequired {
-				log.Warn(ctx, "Отказ в доступе: аутентификация обязательна",
-					zap.String("method", method))
-				return nil, status.Error(codes.Unauthenticated, "требуется авторизация")
-			}
-			return handler(ctx, req)
+		newCtx, err := authenticate(ctx, authUC, log, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(newCtx, req)
+	}
+}
 
-		userID, err := authUC.VerifyToken(ctx, token)
+// AuthStreamInterceptor создает потоковый gRPC интерцептор для авторизации
+func AuthStreamInterceptor(authUC auth.UseCase, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		newCtx, err := authenticate(ss.Context(), authUC, log, info.FullMethod)
 		if err != nil {
-			log.Warn(ctx, "Ошибка верификации токена",
-				zap.String("method", method),
-				zap.Error(err),
-			)
-
-			if authReq == authRequired {
-				return nil, status.Error(codes.Unauthenticated, "недействительный токен")
-			}
-			return handler(ctx, req)
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+	}
+}
+
+// authenticatedStream подменяет контекст потока контекстом с ID пользователя
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст потока с ID пользователя
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
 
-		newCtx := context.WithValue(ctx, UserIDKey, userID)
-		log.Debug(ctx, "Пользователь авторизован",
-			zap.Uint64("user_id", userID),
+// authenticate проверяет токен запроса согласно требованиям метода и добавляет ID пользователя в контекст
+func authenticate(ctx context.Context, authUC auth.UseCase, log *logger.Logger, method string) (context.Context, error) {
+	authReq := getAuthRequirement(method)
+
+	if authReq == authExempt {
+		return ctx, nil
+	}
+	token, ok := extractToken(ctx, log, method)
+
+	if !ok {
+		if authReq == authRequired {
+			log.Warn(ctx, "Отказ в доступе: аутентификация обязательна",
+				zap.String("method", method))
+			return nil, status.Error(codes.Unauthenticated, "требуется авторизация")
+		}
+		return ctx, nil
+	}
+
+	claims, err := authUC.VerifyToken(ctx, token)
+	if err != nil {
+		log.Warn(ctx, "Ошибка верификации токена",
 			zap.String("method", method),
+			zap.Error(err),
 		)
 
-		return handler(newCtx, req)
+		if authReq == authRequired {
+			return nil, status.Error(codes.Unauthenticated, "недействительный токен")
+		}
+		return ctx, nil
 	}
+
+	newCtx := context.WithValue(ctx, UserIDKey, claims.UserID)
+	newCtx = context.WithValue(newCtx, TokenExpiresAtKey, claims.ExpiresAt)
+	log.Debug(ctx, "Пользователь авторизован",
+		zap.Uint64("user_id", claims.UserID),
+		zap.String("method", method),
+	)
+
+	return newCtx, nil
 }
 
 // extractToken извлекает токен из метаданных запроса
@@ -117,3 +179,9 @@ func GetUserID(ctx context.Context) (uint64, bool) {
 	userID, ok := ctx.Value(UserIDKey).(uint64)
 	return userID, ok
 }
+
+// GetTokenExpiresAt возвращает время истечения токена текущего запроса из контекста
+func GetTokenExpiresAt(ctx context.Context) (time.Time, bool) {
+	expiresAt, ok := ctx.Value(TokenExpiresAtKey).(time.Time)
+	return expiresAt, ok
+}
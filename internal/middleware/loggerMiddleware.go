@@ -43,6 +43,46 @@ func UnaryLoggerInterceptor(log *logger.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamLoggerInterceptor создает потоковый gRPC интерцептор для логирования
+func StreamLoggerInterceptor(log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		requestID := generateRequestID(ss.Context())
+		ctx := context.WithValue(ss.Context(), logger.RequestID, requestID)
+		start := time.Now()
+		err := handler(srv, &loggedStream{ServerStream: ss, ctx: ctx})
+
+		code := codes.OK
+		if err != nil {
+			code = status.Code(err)
+		}
+
+		log.Info(ctx, "gRPC поток завершен",
+			zap.String("method", info.FullMethod),
+			zap.String("status", code.String()),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("request_id", requestID),
+		)
+
+		return err
+	}
+}
+
+// loggedStream подменяет контекст потока контекстом с ID запроса
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст потока с ID запроса
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
+
 func generateRequestID(ctx context.Context) string {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if ok {
@@ -23,25 +23,65 @@ func ValidationUnaryInterceptor(log *logger.Logger) grpc.UnaryServerInterceptor
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		if isGRPCServiceRequest(info) {
+		if isGRPCServiceRequest(info.FullMethod) {
 			return handler(ctx, req)
 		}
-		if v, ok := req.(validator); ok {
-			if err := v.Validate(); err != nil {
-				log.Error(ctx, "Ошибка валидации запроса",
-					zap.String("method", info.FullMethod),
-					zap.String("error", err.Error()),
-				)
-				validationErr := adapter.WrapValidationError(err)
-				return nil, adapter.MapError(validationErr)
-			}
+		if err := validate(ctx, log, info.FullMethod, req); err != nil {
+			return nil, err
 		}
 		return handler(ctx, req)
 	}
 }
 
-func isGRPCServiceRequest(info *grpc.UnaryServerInfo) bool {
-	return strings.HasPrefix(info.FullMethod, "/grpc.") ||
-		info.FullMethod == "/grpc.health.v1.Health/Check" ||
-		info.FullMethod == wellknown.HealthCheck
+// ValidationStreamInterceptor создает потоковый интерцептор, проверяющий каждое входящее сообщение потока
+func ValidationStreamInterceptor(log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if isGRPCServiceRequest(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &validatingStream{ServerStream: ss, log: log, method: info.FullMethod})
+	}
+}
+
+// validatingStream проверяет сообщения потока по мере их получения
+type validatingStream struct {
+	grpc.ServerStream
+	log    *logger.Logger
+	method string
+}
+
+// RecvMsg получает сообщение потока и проверяет его
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(s.Context(), s.log, s.method, m)
+}
+
+// validate проверяет запрос, если для него сгенерированы правила валидации
+func validate(ctx context.Context, log *logger.Logger, method string, req interface{}) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+	if err := v.Validate(); err != nil {
+		log.Error(ctx, "Ошибка валидации запроса",
+			zap.String("method", method),
+			zap.String("error", err.Error()),
+		)
+		validationErr := adapter.WrapValidationError(err)
+		return adapter.MapError(validationErr)
+	}
+	return nil
+}
+
+func isGRPCServiceRequest(method string) bool {
+	return strings.HasPrefix(method, "/grpc.") ||
+		method == "/grpc.health.v1.Health/Check" ||
+		method == wellknown.HealthCheck
 }
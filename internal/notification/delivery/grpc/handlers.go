@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+	notifications_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/notifications"
+	"go.uber.org/zap"
+)
+
+// Handler структура обработчика gRPC запросов
+type Handler struct {
+	notifications_pb.UnimplementedNotificationsServiceServer
+	notificationUC notification.UseCase
+	log            *logger.Logger
+}
+
+// New создает новый экземпляр Handler
+func New(notificationUC notification.UseCase, log *logger.Logger) *Handler {
+	return &Handler{
+		notificationUC: notificationUC,
+		log:            log,
+	}
+}
+
+// ListNotifications обрабатывает запрос на получение уведомлений текущего пользователя
+func (h *Handler) ListNotifications(ctx context.Context, req *notifications_pb.ListNotificationsRequest) (*notifications_pb.ListNotificationsResponse, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка получить уведомления без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	notifications, total, err := h.notificationUC.ListNotifications(ctx, userID, req.Page, req.PerPage)
+	if err != nil {
+		h.log.Error(ctx, "Ошибка при получении уведомлений", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	response := &notifications_pb.ListNotificationsResponse{
+		Notifications: make([]*notifications_pb.Notification, 0, len(notifications)),
+		Total:         total,
+		Page:          max(req.Page, 1),
+		PerPage:       req.PerPage,
+		TotalPages:    (total + req.PerPage - 1) / req.PerPage,
+	}
+
+	for _, n := range notifications {
+		response.Notifications = append(response.Notifications, adapter.MapNotificationToProto(n))
+	}
+
+	return response, nil
+}
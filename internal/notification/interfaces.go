@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+type Repository interface {
+	CreateNotifications(ctx context.Context, notifications []*entity.Notification) error
+	ListNotifications(ctx context.Context, userID uint64, page, perPage uint32) ([]*entity.Notification, uint32, error)
+}
+
+// Notifier доставляет уведомления пользователям, например сохраняет их в ленту приложения
+type Notifier interface {
+	Notify(ctx context.Context, notifications []*entity.Notification) error
+}
+
+type UseCase interface {
+	ListNotifications(ctx context.Context, userID uint64, page, perPage uint32) ([]*entity.Notification, uint32, error)
+}
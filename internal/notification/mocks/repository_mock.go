@@ -0,0 +1,836 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/notification.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_notification.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateNotifications          func(ctx context.Context, notifications []*entity.Notification) (err error)
+	funcCreateNotificationsOrigin    string
+	inspectFuncCreateNotifications   func(ctx context.Context, notifications []*entity.Notification)
+	afterCreateNotificationsCounter  uint64
+	beforeCreateNotificationsCounter uint64
+	CreateNotificationsMock          mRepositoryMockCreateNotifications
+
+	funcListNotifications          func(ctx context.Context, userID uint64, page uint32, perPage uint32) (npa1 []*entity.Notification, u1 uint32, err error)
+	funcListNotificationsOrigin    string
+	inspectFuncListNotifications   func(ctx context.Context, userID uint64, page uint32, perPage uint32)
+	afterListNotificationsCounter  uint64
+	beforeListNotificationsCounter uint64
+	ListNotificationsMock          mRepositoryMockListNotifications
+}
+
+// NewRepositoryMock returns a mock for mm_notification.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateNotificationsMock = mRepositoryMockCreateNotifications{mock: m}
+	m.CreateNotificationsMock.callArgs = []*RepositoryMockCreateNotificationsParams{}
+
+	m.ListNotificationsMock = mRepositoryMockListNotifications{mock: m}
+	m.ListNotificationsMock.callArgs = []*RepositoryMockListNotificationsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockCreateNotifications struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateNotificationsExpectation
+	expectations       []*RepositoryMockCreateNotificationsExpectation
+
+	callArgs []*RepositoryMockCreateNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateNotificationsExpectation specifies expectation struct of the Repository.CreateNotifications
+type RepositoryMockCreateNotificationsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateNotificationsParams
+	paramPtrs          *RepositoryMockCreateNotificationsParamPtrs
+	expectationOrigins RepositoryMockCreateNotificationsExpectationOrigins
+	results            *RepositoryMockCreateNotificationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateNotificationsParams contains parameters of the Repository.CreateNotifications
+type RepositoryMockCreateNotificationsParams struct {
+	ctx           context.Context
+	notifications []*entity.Notification
+}
+
+// RepositoryMockCreateNotificationsParamPtrs contains pointers to parameters of the Repository.CreateNotifications
+type RepositoryMockCreateNotificationsParamPtrs struct {
+	ctx           *context.Context
+	notifications *[]*entity.Notification
+}
+
+// RepositoryMockCreateNotificationsResults contains results of the Repository.CreateNotifications
+type RepositoryMockCreateNotificationsResults struct {
+	err error
+}
+
+// RepositoryMockCreateNotificationsOrigins contains origins of expectations of the Repository.CreateNotifications
+type RepositoryMockCreateNotificationsExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originNotifications string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Optional() *mRepositoryMockCreateNotifications {
+	mmCreateNotifications.optional = true
+	return mmCreateNotifications
+}
+
+// Expect sets up expected params for Repository.CreateNotifications
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Expect(ctx context.Context, notifications []*entity.Notification) *mRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &RepositoryMockCreateNotificationsExpectation{}
+	}
+
+	if mmCreateNotifications.defaultExpectation.paramPtrs != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmCreateNotifications.defaultExpectation.params = &RepositoryMockCreateNotificationsParams{ctx, notifications}
+	mmCreateNotifications.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateNotifications.expectations {
+		if minimock.Equal(e.params, mmCreateNotifications.defaultExpectation.params) {
+			mmCreateNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateNotifications
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &RepositoryMockCreateNotificationsExpectation{}
+	}
+
+	if mmCreateNotifications.defaultExpectation.params != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Expect")
+	}
+
+	if mmCreateNotifications.defaultExpectation.paramPtrs == nil {
+		mmCreateNotifications.defaultExpectation.paramPtrs = &RepositoryMockCreateNotificationsParamPtrs{}
+	}
+	mmCreateNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateNotifications.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateNotifications
+}
+
+// ExpectNotificationsParam2 sets up expected param notifications for Repository.CreateNotifications
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) ExpectNotificationsParam2(notifications []*entity.Notification) *mRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &RepositoryMockCreateNotificationsExpectation{}
+	}
+
+	if mmCreateNotifications.defaultExpectation.params != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Expect")
+	}
+
+	if mmCreateNotifications.defaultExpectation.paramPtrs == nil {
+		mmCreateNotifications.defaultExpectation.paramPtrs = &RepositoryMockCreateNotificationsParamPtrs{}
+	}
+	mmCreateNotifications.defaultExpectation.paramPtrs.notifications = &notifications
+	mmCreateNotifications.defaultExpectation.expectationOrigins.originNotifications = minimock.CallerInfo(1)
+
+	return mmCreateNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateNotifications
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Inspect(f func(ctx context.Context, notifications []*entity.Notification)) *mRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.inspectFuncCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateNotifications")
+	}
+
+	mmCreateNotifications.mock.inspectFuncCreateNotifications = f
+
+	return mmCreateNotifications
+}
+
+// Return sets up results that will be returned by Repository.CreateNotifications
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Return(err error) *RepositoryMock {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &RepositoryMockCreateNotificationsExpectation{mock: mmCreateNotifications.mock}
+	}
+	mmCreateNotifications.defaultExpectation.results = &RepositoryMockCreateNotificationsResults{err}
+	mmCreateNotifications.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateNotifications.mock
+}
+
+// Set uses given function f to mock the Repository.CreateNotifications method
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Set(f func(ctx context.Context, notifications []*entity.Notification) (err error)) *RepositoryMock {
+	if mmCreateNotifications.defaultExpectation != nil {
+		mmCreateNotifications.mock.t.Fatalf("Default expectation is already set for the Repository.CreateNotifications method")
+	}
+
+	if len(mmCreateNotifications.expectations) > 0 {
+		mmCreateNotifications.mock.t.Fatalf("Some expectations are already set for the Repository.CreateNotifications method")
+	}
+
+	mmCreateNotifications.mock.funcCreateNotifications = f
+	mmCreateNotifications.mock.funcCreateNotificationsOrigin = minimock.CallerInfo(1)
+	return mmCreateNotifications.mock
+}
+
+// When sets expectation for the Repository.CreateNotifications which will trigger the result defined by the following
+// Then helper
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) When(ctx context.Context, notifications []*entity.Notification) *RepositoryMockCreateNotificationsExpectation {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("RepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateNotificationsExpectation{
+		mock:               mmCreateNotifications.mock,
+		params:             &RepositoryMockCreateNotificationsParams{ctx, notifications},
+		expectationOrigins: RepositoryMockCreateNotificationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateNotifications.expectations = append(mmCreateNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreateNotifications return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateNotificationsExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateNotificationsResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreateNotifications should be invoked
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Times(n uint64) *mRepositoryMockCreateNotifications {
+	if n == 0 {
+		mmCreateNotifications.mock.t.Fatalf("Times of RepositoryMock.CreateNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateNotifications.expectedInvocations, n)
+	mmCreateNotifications.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateNotifications
+}
+
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) invocationsDone() bool {
+	if len(mmCreateNotifications.expectations) == 0 && mmCreateNotifications.defaultExpectation == nil && mmCreateNotifications.mock.funcCreateNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateNotifications.mock.afterCreateNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateNotifications implements mm_notification.Repository
+func (mmCreateNotifications *RepositoryMock) CreateNotifications(ctx context.Context, notifications []*entity.Notification) (err error) {
+	mm_atomic.AddUint64(&mmCreateNotifications.beforeCreateNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateNotifications.afterCreateNotificationsCounter, 1)
+
+	mmCreateNotifications.t.Helper()
+
+	if mmCreateNotifications.inspectFuncCreateNotifications != nil {
+		mmCreateNotifications.inspectFuncCreateNotifications(ctx, notifications)
+	}
+
+	mm_params := RepositoryMockCreateNotificationsParams{ctx, notifications}
+
+	// Record call args
+	mmCreateNotifications.CreateNotificationsMock.mutex.Lock()
+	mmCreateNotifications.CreateNotificationsMock.callArgs = append(mmCreateNotifications.CreateNotificationsMock.callArgs, &mm_params)
+	mmCreateNotifications.CreateNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmCreateNotifications.CreateNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateNotifications.CreateNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateNotifications.CreateNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateNotifications.CreateNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateNotifications.CreateNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateNotificationsParams{ctx, notifications}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateNotifications.t.Errorf("RepositoryMock.CreateNotifications got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateNotifications.CreateNotificationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.notifications != nil && !minimock.Equal(*mm_want_ptrs.notifications, mm_got.notifications) {
+				mmCreateNotifications.t.Errorf("RepositoryMock.CreateNotifications got unexpected parameter notifications, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateNotifications.CreateNotificationsMock.defaultExpectation.expectationOrigins.originNotifications, *mm_want_ptrs.notifications, mm_got.notifications, minimock.Diff(*mm_want_ptrs.notifications, mm_got.notifications))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateNotifications.t.Errorf("RepositoryMock.CreateNotifications got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateNotifications.CreateNotificationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateNotifications.CreateNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateNotifications.t.Fatal("No results are set for the RepositoryMock.CreateNotifications")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateNotifications.funcCreateNotifications != nil {
+		return mmCreateNotifications.funcCreateNotifications(ctx, notifications)
+	}
+	mmCreateNotifications.t.Fatalf("Unexpected call to RepositoryMock.CreateNotifications. %v %v", ctx, notifications)
+	return
+}
+
+// CreateNotificationsAfterCounter returns a count of finished RepositoryMock.CreateNotifications invocations
+func (mmCreateNotifications *RepositoryMock) CreateNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateNotifications.afterCreateNotificationsCounter)
+}
+
+// CreateNotificationsBeforeCounter returns a count of RepositoryMock.CreateNotifications invocations
+func (mmCreateNotifications *RepositoryMock) CreateNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateNotifications.beforeCreateNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateNotifications *mRepositoryMockCreateNotifications) Calls() []*RepositoryMockCreateNotificationsParams {
+	mmCreateNotifications.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateNotificationsParams, len(mmCreateNotifications.callArgs))
+	copy(argCopy, mmCreateNotifications.callArgs)
+
+	mmCreateNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateNotificationsDone returns true if the count of the CreateNotifications invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateNotificationsDone() bool {
+	if m.CreateNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateNotificationsMock.invocationsDone()
+}
+
+// MinimockCreateNotificationsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateNotificationsInspect() {
+	for _, e := range m.CreateNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreateNotifications at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateNotificationsCounter := mm_atomic.LoadUint64(&m.afterCreateNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateNotificationsMock.defaultExpectation != nil && afterCreateNotificationsCounter < 1 {
+		if m.CreateNotificationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateNotifications at\n%s", m.CreateNotificationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreateNotifications at\n%s with params: %#v", m.CreateNotificationsMock.defaultExpectation.expectationOrigins.origin, *m.CreateNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateNotifications != nil && afterCreateNotificationsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateNotifications at\n%s", m.funcCreateNotificationsOrigin)
+	}
+
+	if !m.CreateNotificationsMock.invocationsDone() && afterCreateNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateNotifications at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateNotificationsMock.expectedInvocations), m.CreateNotificationsMock.expectedInvocationsOrigin, afterCreateNotificationsCounter)
+	}
+}
+
+type mRepositoryMockListNotifications struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListNotificationsExpectation
+	expectations       []*RepositoryMockListNotificationsExpectation
+
+	callArgs []*RepositoryMockListNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListNotificationsExpectation specifies expectation struct of the Repository.ListNotifications
+type RepositoryMockListNotificationsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListNotificationsParams
+	paramPtrs          *RepositoryMockListNotificationsParamPtrs
+	expectationOrigins RepositoryMockListNotificationsExpectationOrigins
+	results            *RepositoryMockListNotificationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListNotificationsParams contains parameters of the Repository.ListNotifications
+type RepositoryMockListNotificationsParams struct {
+	ctx     context.Context
+	userID  uint64
+	page    uint32
+	perPage uint32
+}
+
+// RepositoryMockListNotificationsParamPtrs contains pointers to parameters of the Repository.ListNotifications
+type RepositoryMockListNotificationsParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	page    *uint32
+	perPage *uint32
+}
+
+// RepositoryMockListNotificationsResults contains results of the Repository.ListNotifications
+type RepositoryMockListNotificationsResults struct {
+	npa1 []*entity.Notification
+	u1   uint32
+	err  error
+}
+
+// RepositoryMockListNotificationsOrigins contains origins of expectations of the Repository.ListNotifications
+type RepositoryMockListNotificationsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originPage    string
+	originPerPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListNotifications *mRepositoryMockListNotifications) Optional() *mRepositoryMockListNotifications {
+	mmListNotifications.optional = true
+	return mmListNotifications
+}
+
+// Expect sets up expected params for Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) Expect(ctx context.Context, userID uint64, page uint32, perPage uint32) *mRepositoryMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &RepositoryMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmListNotifications.defaultExpectation.params = &RepositoryMockListNotificationsParams{ctx, userID, page, perPage}
+	mmListNotifications.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListNotifications.expectations {
+		if minimock.Equal(e.params, mmListNotifications.defaultExpectation.params) {
+			mmListNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmListNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &RepositoryMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &RepositoryMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNotifications.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) ExpectUserIDParam2(userID uint64) *mRepositoryMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &RepositoryMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &RepositoryMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.userID = &userID
+	mmListNotifications.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// ExpectPageParam3 sets up expected param page for Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) ExpectPageParam3(page uint32) *mRepositoryMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &RepositoryMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &RepositoryMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.page = &page
+	mmListNotifications.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// ExpectPerPageParam4 sets up expected param perPage for Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) ExpectPerPageParam4(perPage uint32) *mRepositoryMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &RepositoryMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &RepositoryMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.perPage = &perPage
+	mmListNotifications.defaultExpectation.expectationOrigins.originPerPage = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) Inspect(f func(ctx context.Context, userID uint64, page uint32, perPage uint32)) *mRepositoryMockListNotifications {
+	if mmListNotifications.mock.inspectFuncListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNotifications")
+	}
+
+	mmListNotifications.mock.inspectFuncListNotifications = f
+
+	return mmListNotifications
+}
+
+// Return sets up results that will be returned by Repository.ListNotifications
+func (mmListNotifications *mRepositoryMockListNotifications) Return(npa1 []*entity.Notification, u1 uint32, err error) *RepositoryMock {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &RepositoryMockListNotificationsExpectation{mock: mmListNotifications.mock}
+	}
+	mmListNotifications.defaultExpectation.results = &RepositoryMockListNotificationsResults{npa1, u1, err}
+	mmListNotifications.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListNotifications.mock
+}
+
+// Set uses given function f to mock the Repository.ListNotifications method
+func (mmListNotifications *mRepositoryMockListNotifications) Set(f func(ctx context.Context, userID uint64, page uint32, perPage uint32) (npa1 []*entity.Notification, u1 uint32, err error)) *RepositoryMock {
+	if mmListNotifications.defaultExpectation != nil {
+		mmListNotifications.mock.t.Fatalf("Default expectation is already set for the Repository.ListNotifications method")
+	}
+
+	if len(mmListNotifications.expectations) > 0 {
+		mmListNotifications.mock.t.Fatalf("Some expectations are already set for the Repository.ListNotifications method")
+	}
+
+	mmListNotifications.mock.funcListNotifications = f
+	mmListNotifications.mock.funcListNotificationsOrigin = minimock.CallerInfo(1)
+	return mmListNotifications.mock
+}
+
+// When sets expectation for the Repository.ListNotifications which will trigger the result defined by the following
+// Then helper
+func (mmListNotifications *mRepositoryMockListNotifications) When(ctx context.Context, userID uint64, page uint32, perPage uint32) *RepositoryMockListNotificationsExpectation {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("RepositoryMock.ListNotifications mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListNotificationsExpectation{
+		mock:               mmListNotifications.mock,
+		params:             &RepositoryMockListNotificationsParams{ctx, userID, page, perPage},
+		expectationOrigins: RepositoryMockListNotificationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListNotifications.expectations = append(mmListNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListNotifications return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListNotificationsExpectation) Then(npa1 []*entity.Notification, u1 uint32, err error) *RepositoryMock {
+	e.results = &RepositoryMockListNotificationsResults{npa1, u1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListNotifications should be invoked
+func (mmListNotifications *mRepositoryMockListNotifications) Times(n uint64) *mRepositoryMockListNotifications {
+	if n == 0 {
+		mmListNotifications.mock.t.Fatalf("Times of RepositoryMock.ListNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListNotifications.expectedInvocations, n)
+	mmListNotifications.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListNotifications
+}
+
+func (mmListNotifications *mRepositoryMockListNotifications) invocationsDone() bool {
+	if len(mmListNotifications.expectations) == 0 && mmListNotifications.defaultExpectation == nil && mmListNotifications.mock.funcListNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListNotifications.mock.afterListNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListNotifications implements mm_notification.Repository
+func (mmListNotifications *RepositoryMock) ListNotifications(ctx context.Context, userID uint64, page uint32, perPage uint32) (npa1 []*entity.Notification, u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmListNotifications.beforeListNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNotifications.afterListNotificationsCounter, 1)
+
+	mmListNotifications.t.Helper()
+
+	if mmListNotifications.inspectFuncListNotifications != nil {
+		mmListNotifications.inspectFuncListNotifications(ctx, userID, page, perPage)
+	}
+
+	mm_params := RepositoryMockListNotificationsParams{ctx, userID, page, perPage}
+
+	// Record call args
+	mmListNotifications.ListNotificationsMock.mutex.Lock()
+	mmListNotifications.ListNotificationsMock.callArgs = append(mmListNotifications.ListNotificationsMock.callArgs, &mm_params)
+	mmListNotifications.ListNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmListNotifications.ListNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.npa1, e.results.u1, e.results.err
+		}
+	}
+
+	if mmListNotifications.ListNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNotifications.ListNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNotifications.ListNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListNotifications.ListNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListNotificationsParams{ctx, userID, page, perPage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListNotifications.t.Errorf("RepositoryMock.ListNotifications got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListNotifications.t.Errorf("RepositoryMock.ListNotifications got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListNotifications.t.Errorf("RepositoryMock.ListNotifications got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+			if mm_want_ptrs.perPage != nil && !minimock.Equal(*mm_want_ptrs.perPage, mm_got.perPage) {
+				mmListNotifications.t.Errorf("RepositoryMock.ListNotifications got unexpected parameter perPage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originPerPage, *mm_want_ptrs.perPage, mm_got.perPage, minimock.Diff(*mm_want_ptrs.perPage, mm_got.perPage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListNotifications.t.Errorf("RepositoryMock.ListNotifications got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListNotifications.ListNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListNotifications.t.Fatal("No results are set for the RepositoryMock.ListNotifications")
+		}
+		return (*mm_results).npa1, (*mm_results).u1, (*mm_results).err
+	}
+	if mmListNotifications.funcListNotifications != nil {
+		return mmListNotifications.funcListNotifications(ctx, userID, page, perPage)
+	}
+	mmListNotifications.t.Fatalf("Unexpected call to RepositoryMock.ListNotifications. %v %v %v %v", ctx, userID, page, perPage)
+	return
+}
+
+// ListNotificationsAfterCounter returns a count of finished RepositoryMock.ListNotifications invocations
+func (mmListNotifications *RepositoryMock) ListNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNotifications.afterListNotificationsCounter)
+}
+
+// ListNotificationsBeforeCounter returns a count of RepositoryMock.ListNotifications invocations
+func (mmListNotifications *RepositoryMock) ListNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNotifications.beforeListNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListNotifications *mRepositoryMockListNotifications) Calls() []*RepositoryMockListNotificationsParams {
+	mmListNotifications.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListNotificationsParams, len(mmListNotifications.callArgs))
+	copy(argCopy, mmListNotifications.callArgs)
+
+	mmListNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListNotificationsDone returns true if the count of the ListNotifications invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListNotificationsDone() bool {
+	if m.ListNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListNotificationsMock.invocationsDone()
+}
+
+// MinimockListNotificationsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListNotificationsInspect() {
+	for _, e := range m.ListNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListNotifications at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListNotificationsCounter := mm_atomic.LoadUint64(&m.afterListNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListNotificationsMock.defaultExpectation != nil && afterListNotificationsCounter < 1 {
+		if m.ListNotificationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListNotifications at\n%s", m.ListNotificationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListNotifications at\n%s with params: %#v", m.ListNotificationsMock.defaultExpectation.expectationOrigins.origin, *m.ListNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListNotifications != nil && afterListNotificationsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListNotifications at\n%s", m.funcListNotificationsOrigin)
+	}
+
+	if !m.ListNotificationsMock.invocationsDone() && afterListNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListNotifications at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNotificationsMock.expectedInvocations), m.ListNotificationsMock.expectedInvocationsOrigin, afterListNotificationsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateNotificationsInspect()
+
+			m.MinimockListNotificationsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateNotificationsDone() &&
+		m.MinimockListNotificationsDone()
+}
@@ -0,0 +1,481 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/notification.UseCase -o usecase_mock.go -n UseCaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UseCaseMock implements mm_notification.UseCase
+type UseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListNotifications          func(ctx context.Context, userID uint64, page uint32, perPage uint32) (npa1 []*entity.Notification, u1 uint32, err error)
+	funcListNotificationsOrigin    string
+	inspectFuncListNotifications   func(ctx context.Context, userID uint64, page uint32, perPage uint32)
+	afterListNotificationsCounter  uint64
+	beforeListNotificationsCounter uint64
+	ListNotificationsMock          mUseCaseMockListNotifications
+}
+
+// NewUseCaseMock returns a mock for mm_notification.UseCase
+func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
+	m := &UseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListNotificationsMock = mUseCaseMockListNotifications{mock: m}
+	m.ListNotificationsMock.callArgs = []*UseCaseMockListNotificationsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUseCaseMockListNotifications struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockListNotificationsExpectation
+	expectations       []*UseCaseMockListNotificationsExpectation
+
+	callArgs []*UseCaseMockListNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockListNotificationsExpectation specifies expectation struct of the UseCase.ListNotifications
+type UseCaseMockListNotificationsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockListNotificationsParams
+	paramPtrs          *UseCaseMockListNotificationsParamPtrs
+	expectationOrigins UseCaseMockListNotificationsExpectationOrigins
+	results            *UseCaseMockListNotificationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockListNotificationsParams contains parameters of the UseCase.ListNotifications
+type UseCaseMockListNotificationsParams struct {
+	ctx     context.Context
+	userID  uint64
+	page    uint32
+	perPage uint32
+}
+
+// UseCaseMockListNotificationsParamPtrs contains pointers to parameters of the UseCase.ListNotifications
+type UseCaseMockListNotificationsParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	page    *uint32
+	perPage *uint32
+}
+
+// UseCaseMockListNotificationsResults contains results of the UseCase.ListNotifications
+type UseCaseMockListNotificationsResults struct {
+	npa1 []*entity.Notification
+	u1   uint32
+	err  error
+}
+
+// UseCaseMockListNotificationsOrigins contains origins of expectations of the UseCase.ListNotifications
+type UseCaseMockListNotificationsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originPage    string
+	originPerPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListNotifications *mUseCaseMockListNotifications) Optional() *mUseCaseMockListNotifications {
+	mmListNotifications.optional = true
+	return mmListNotifications
+}
+
+// Expect sets up expected params for UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) Expect(ctx context.Context, userID uint64, page uint32, perPage uint32) *mUseCaseMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &UseCaseMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmListNotifications.defaultExpectation.params = &UseCaseMockListNotificationsParams{ctx, userID, page, perPage}
+	mmListNotifications.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListNotifications.expectations {
+		if minimock.Equal(e.params, mmListNotifications.defaultExpectation.params) {
+			mmListNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmListNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) ExpectCtxParam1(ctx context.Context) *mUseCaseMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &UseCaseMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &UseCaseMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNotifications.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) ExpectUserIDParam2(userID uint64) *mUseCaseMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &UseCaseMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &UseCaseMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.userID = &userID
+	mmListNotifications.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// ExpectPageParam3 sets up expected param page for UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) ExpectPageParam3(page uint32) *mUseCaseMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &UseCaseMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &UseCaseMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.page = &page
+	mmListNotifications.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// ExpectPerPageParam4 sets up expected param perPage for UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) ExpectPerPageParam4(perPage uint32) *mUseCaseMockListNotifications {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &UseCaseMockListNotificationsExpectation{}
+	}
+
+	if mmListNotifications.defaultExpectation.params != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Expect")
+	}
+
+	if mmListNotifications.defaultExpectation.paramPtrs == nil {
+		mmListNotifications.defaultExpectation.paramPtrs = &UseCaseMockListNotificationsParamPtrs{}
+	}
+	mmListNotifications.defaultExpectation.paramPtrs.perPage = &perPage
+	mmListNotifications.defaultExpectation.expectationOrigins.originPerPage = minimock.CallerInfo(1)
+
+	return mmListNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) Inspect(f func(ctx context.Context, userID uint64, page uint32, perPage uint32)) *mUseCaseMockListNotifications {
+	if mmListNotifications.mock.inspectFuncListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ListNotifications")
+	}
+
+	mmListNotifications.mock.inspectFuncListNotifications = f
+
+	return mmListNotifications
+}
+
+// Return sets up results that will be returned by UseCase.ListNotifications
+func (mmListNotifications *mUseCaseMockListNotifications) Return(npa1 []*entity.Notification, u1 uint32, err error) *UseCaseMock {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	if mmListNotifications.defaultExpectation == nil {
+		mmListNotifications.defaultExpectation = &UseCaseMockListNotificationsExpectation{mock: mmListNotifications.mock}
+	}
+	mmListNotifications.defaultExpectation.results = &UseCaseMockListNotificationsResults{npa1, u1, err}
+	mmListNotifications.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListNotifications.mock
+}
+
+// Set uses given function f to mock the UseCase.ListNotifications method
+func (mmListNotifications *mUseCaseMockListNotifications) Set(f func(ctx context.Context, userID uint64, page uint32, perPage uint32) (npa1 []*entity.Notification, u1 uint32, err error)) *UseCaseMock {
+	if mmListNotifications.defaultExpectation != nil {
+		mmListNotifications.mock.t.Fatalf("Default expectation is already set for the UseCase.ListNotifications method")
+	}
+
+	if len(mmListNotifications.expectations) > 0 {
+		mmListNotifications.mock.t.Fatalf("Some expectations are already set for the UseCase.ListNotifications method")
+	}
+
+	mmListNotifications.mock.funcListNotifications = f
+	mmListNotifications.mock.funcListNotificationsOrigin = minimock.CallerInfo(1)
+	return mmListNotifications.mock
+}
+
+// When sets expectation for the UseCase.ListNotifications which will trigger the result defined by the following
+// Then helper
+func (mmListNotifications *mUseCaseMockListNotifications) When(ctx context.Context, userID uint64, page uint32, perPage uint32) *UseCaseMockListNotificationsExpectation {
+	if mmListNotifications.mock.funcListNotifications != nil {
+		mmListNotifications.mock.t.Fatalf("UseCaseMock.ListNotifications mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockListNotificationsExpectation{
+		mock:               mmListNotifications.mock,
+		params:             &UseCaseMockListNotificationsParams{ctx, userID, page, perPage},
+		expectationOrigins: UseCaseMockListNotificationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListNotifications.expectations = append(mmListNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ListNotifications return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockListNotificationsExpectation) Then(npa1 []*entity.Notification, u1 uint32, err error) *UseCaseMock {
+	e.results = &UseCaseMockListNotificationsResults{npa1, u1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ListNotifications should be invoked
+func (mmListNotifications *mUseCaseMockListNotifications) Times(n uint64) *mUseCaseMockListNotifications {
+	if n == 0 {
+		mmListNotifications.mock.t.Fatalf("Times of UseCaseMock.ListNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListNotifications.expectedInvocations, n)
+	mmListNotifications.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListNotifications
+}
+
+func (mmListNotifications *mUseCaseMockListNotifications) invocationsDone() bool {
+	if len(mmListNotifications.expectations) == 0 && mmListNotifications.defaultExpectation == nil && mmListNotifications.mock.funcListNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListNotifications.mock.afterListNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListNotifications implements mm_notification.UseCase
+func (mmListNotifications *UseCaseMock) ListNotifications(ctx context.Context, userID uint64, page uint32, perPage uint32) (npa1 []*entity.Notification, u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmListNotifications.beforeListNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNotifications.afterListNotificationsCounter, 1)
+
+	mmListNotifications.t.Helper()
+
+	if mmListNotifications.inspectFuncListNotifications != nil {
+		mmListNotifications.inspectFuncListNotifications(ctx, userID, page, perPage)
+	}
+
+	mm_params := UseCaseMockListNotificationsParams{ctx, userID, page, perPage}
+
+	// Record call args
+	mmListNotifications.ListNotificationsMock.mutex.Lock()
+	mmListNotifications.ListNotificationsMock.callArgs = append(mmListNotifications.ListNotificationsMock.callArgs, &mm_params)
+	mmListNotifications.ListNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmListNotifications.ListNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.npa1, e.results.u1, e.results.err
+		}
+	}
+
+	if mmListNotifications.ListNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNotifications.ListNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNotifications.ListNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListNotifications.ListNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockListNotificationsParams{ctx, userID, page, perPage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListNotifications.t.Errorf("UseCaseMock.ListNotifications got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListNotifications.t.Errorf("UseCaseMock.ListNotifications got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListNotifications.t.Errorf("UseCaseMock.ListNotifications got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+			if mm_want_ptrs.perPage != nil && !minimock.Equal(*mm_want_ptrs.perPage, mm_got.perPage) {
+				mmListNotifications.t.Errorf("UseCaseMock.ListNotifications got unexpected parameter perPage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.originPerPage, *mm_want_ptrs.perPage, mm_got.perPage, minimock.Diff(*mm_want_ptrs.perPage, mm_got.perPage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListNotifications.t.Errorf("UseCaseMock.ListNotifications got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListNotifications.ListNotificationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListNotifications.ListNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListNotifications.t.Fatal("No results are set for the UseCaseMock.ListNotifications")
+		}
+		return (*mm_results).npa1, (*mm_results).u1, (*mm_results).err
+	}
+	if mmListNotifications.funcListNotifications != nil {
+		return mmListNotifications.funcListNotifications(ctx, userID, page, perPage)
+	}
+	mmListNotifications.t.Fatalf("Unexpected call to UseCaseMock.ListNotifications. %v %v %v %v", ctx, userID, page, perPage)
+	return
+}
+
+// ListNotificationsAfterCounter returns a count of finished UseCaseMock.ListNotifications invocations
+func (mmListNotifications *UseCaseMock) ListNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNotifications.afterListNotificationsCounter)
+}
+
+// ListNotificationsBeforeCounter returns a count of UseCaseMock.ListNotifications invocations
+func (mmListNotifications *UseCaseMock) ListNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNotifications.beforeListNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ListNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListNotifications *mUseCaseMockListNotifications) Calls() []*UseCaseMockListNotificationsParams {
+	mmListNotifications.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockListNotificationsParams, len(mmListNotifications.callArgs))
+	copy(argCopy, mmListNotifications.callArgs)
+
+	mmListNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListNotificationsDone returns true if the count of the ListNotifications invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockListNotificationsDone() bool {
+	if m.ListNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListNotificationsMock.invocationsDone()
+}
+
+// MinimockListNotificationsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockListNotificationsInspect() {
+	for _, e := range m.ListNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ListNotifications at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListNotificationsCounter := mm_atomic.LoadUint64(&m.afterListNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListNotificationsMock.defaultExpectation != nil && afterListNotificationsCounter < 1 {
+		if m.ListNotificationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ListNotifications at\n%s", m.ListNotificationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ListNotifications at\n%s with params: %#v", m.ListNotificationsMock.defaultExpectation.expectationOrigins.origin, *m.ListNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListNotifications != nil && afterListNotificationsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ListNotifications at\n%s", m.funcListNotificationsOrigin)
+	}
+
+	if !m.ListNotificationsMock.invocationsDone() && afterListNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ListNotifications at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNotificationsMock.expectedInvocations), m.ListNotificationsMock.expectedInvocationsOrigin, afterListNotificationsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListNotificationsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListNotificationsDone()
+}
@@ -0,0 +1,23 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+)
+
+// InAppNotifier сохраняет уведомления в ленту пользователя внутри приложения
+type InAppNotifier struct {
+	repo notification.Repository
+}
+
+// NewInAppNotifier создает уведомитель, сохраняющий уведомления в репозиторий
+func NewInAppNotifier(repo notification.Repository) *InAppNotifier {
+	return &InAppNotifier{repo: repo}
+}
+
+// Notify сохраняет уведомления
+func (n *InAppNotifier) Notify(ctx context.Context, notifications []*entity.Notification) error {
+	return n.repo.CreateNotifications(ctx, notifications)
+}
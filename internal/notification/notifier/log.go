@@ -0,0 +1,36 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"go.uber.org/zap"
+)
+
+// LogNotifier записывает уведомления в журнал приложения
+type LogNotifier struct {
+	log *logger.Logger
+}
+
+// NewLogNotifier создает уведомитель, пишущий уведомления в журнал
+func NewLogNotifier(log *logger.Logger) *LogNotifier {
+	return &LogNotifier{log: log}
+}
+
+// Notify записывает каждое уведомление в журнал
+func (n *LogNotifier) Notify(ctx context.Context, notifications []*entity.Notification) error {
+	for _, notification := range notifications {
+		fields := []zap.Field{
+			zap.Uint64("user_id", notification.UserID),
+			zap.String("type", string(notification.Type)),
+			zap.String("title", notification.Title),
+			zap.String("message", notification.Message),
+		}
+		if notification.ListingID != nil {
+			fields = append(fields, zap.Uint64("listing_id", *notification.ListingID))
+		}
+		n.log.Info(ctx, "Уведомление пользователю", fields...)
+	}
+	return nil
+}
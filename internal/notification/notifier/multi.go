@@ -0,0 +1,34 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+)
+
+// MultiNotifier доставляет уведомления через несколько каналов
+type MultiNotifier struct {
+	notifiers []notification.Notifier
+}
+
+// NewMultiNotifier создает уведомитель, передающий уведомления каждому из notifiers
+func NewMultiNotifier(notifiers ...notification.Notifier) *MultiNotifier {
+	return &MultiNotifier{notifiers: notifiers}
+}
+
+// Notify передает уведомления всем каналам. Ошибка одного канала не мешает доставке через остальные
+func (n *MultiNotifier) Notify(ctx context.Context, notifications []*entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, notifier := range n.notifiers {
+		if err := notifier.Notify(ctx, notifications); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+	postgresstorage "github.com/Snake1-1eyes/vk_task_marketplace/pkg/postgres_storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type dbManager interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	GetPool() *pgxpool.Pool
+}
+
+// Repository реализует интерфейс notification.Repository
+type Repository struct {
+	db        dbManager
+	txManager postgresstorage.Transactor
+	logger    *logger.Logger
+}
+
+// New создает новый экземпляр репозитория
+func New(db dbManager, txManager postgresstorage.Transactor, logger *logger.Logger) notification.Repository {
+	return &Repository{
+		db:        db,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+// CreateNotifications сохраняет уведомления в одной транзакции и заполняет их ID и дату создания
+func (r *Repository) CreateNotifications(ctx context.Context, notifications []*entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO notifications (user_id, type, listing_id, title, message, data)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`
+
+	err := r.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		for _, n := range notifications {
+			data := n.Data
+			if data == nil {
+				data = map[string]any{}
+			}
+
+			var createdAt pgtype.Timestamptz
+			if err := r.db.QueryRow(txCtx, query, n.UserID, string(n.Type), n.ListingID, n.Title, n.Message, data).Scan(&n.ID, &createdAt); err != nil {
+				return err
+			}
+			n.CreatedAt = createdAt.Time
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при сохранении уведомлений",
+			zap.Int("count", len(notifications)),
+			zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при сохранении уведомлений")
+	}
+
+	return nil
+}
+
+// ListNotifications возвращает страницу уведомлений пользователя, новые первыми, и их общее количество
+func (r *Repository) ListNotifications(ctx context.Context, userID uint64, page, perPage uint32) ([]*entity.Notification, uint32, error) {
+	var total uint32
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM notifications WHERE user_id = $1", userID).Scan(&total); err != nil {
+		r.logger.Error(ctx, "Ошибка при подсчете уведомлений",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return nil, 0, app_errors.WrapError(err, "ошибка при получении уведомлений")
+	}
+
+	notifications := []*entity.Notification{}
+	if total == 0 {
+		return notifications, 0, nil
+	}
+
+	query := `
+		SELECT id, user_id, type, listing_id, title, message, data, created_at
+		FROM notifications
+		WHERE user_id = $1
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, userID, perPage, (page-1)*perPage)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении уведомлений",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return nil, 0, app_errors.WrapError(err, "ошибка при получении уведомлений")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		n := &entity.Notification{}
+		var listingID pgtype.Int8
+		var createdAt pgtype.Timestamptz
+		if err := rows.Scan(&n.ID, &n.UserID, &n.Type, &listingID, &n.Title, &n.Message, &n.Data, &createdAt); err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании уведомления", zap.Error(err))
+			return nil, 0, app_errors.WrapError(err, "ошибка при получении уведомлений")
+		}
+		if listingID.Valid {
+			id := uint64(listingID.Int64)
+			n.ListingID = &id
+		}
+		n.CreatedAt = createdAt.Time
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при обработке уведомлений", zap.Error(err))
+		return nil, 0, app_errors.WrapError(err, "ошибка при получении уведомлений")
+	}
+
+	return notifications, total, nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/notification"
+	"go.uber.org/zap"
+)
+
+// UseCase реализует интерфейс notification.UseCase
+type UseCase struct {
+	repo notification.Repository
+	log  *logger.Logger
+}
+
+// New создает новый экземпляр UseCase
+func New(repo notification.Repository, log *logger.Logger) *UseCase {
+	return &UseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// ListNotifications возвращает страницу уведомлений пользователя
+func (uc *UseCase) ListNotifications(ctx context.Context, userID uint64, page, perPage uint32) ([]*entity.Notification, uint32, error) {
+	if page == 0 {
+		page = 1
+	}
+
+	notifications, total, err := uc.repo.ListNotifications(ctx, userID, page, perPage)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	uc.log.Info(ctx, "Получен список уведомлений",
+		zap.Uint64("user_id", userID),
+		zap.Uint32("page", page),
+		zap.Int("count", len(notifications)),
+		zap.Uint32("total", total))
+
+	return notifications, total, nil
+}
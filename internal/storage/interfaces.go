@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// Storage хранит файлы по ключу и выдает публичные ссылки на них
+type Storage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
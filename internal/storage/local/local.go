@@ -0,0 +1,99 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Storage хранит файлы в каталоге на локальном диске. Файлы раздаются HTTP Gateway по publicURL
+type Storage struct {
+	dir       string
+	publicURL string
+}
+
+// New создает локальное хранилище в каталоге dir
+func New(dir, publicURL string) *Storage {
+	return &Storage{
+		dir:       dir,
+		publicURL: strings.TrimRight(publicURL, "/"),
+	}
+}
+
+// Put записывает файл во временный файл и переименовывает его, чтобы не отдавать частично записанные файлы
+func (s *Storage) Put(_ context.Context, key string, body io.Reader, _ int64, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("не удалось создать каталог для файла: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("не удалось создать временный файл: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("не удалось записать файл: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("не удалось записать файл: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("не удалось сохранить файл: %w", err)
+	}
+
+	return nil
+}
+
+// Get открывает файл для чтения, вызывающий обязан закрыть его
+func (s *Storage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть файл: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete удаляет файл, отсутствующий файл не считается ошибкой
+func (s *Storage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("не удалось удалить файл: %w", err)
+	}
+
+	return nil
+}
+
+// URL возвращает публичную ссылку на файл
+func (s *Storage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+// path возвращает путь к файлу, не допуская выхода за пределы каталога хранилища
+func (s *Storage) path(key string) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, filepath.Clean(s.dir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("недопустимый ключ файла: %s", key)
+	}
+	return path, nil
+}
@@ -0,0 +1,124 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config содержит параметры подключения к S3-совместимому хранилищу
+type Config struct {
+	// Endpoint задает адрес хранилища, например http://minio:9000
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PublicURL задает адрес, по которому клиенты получают файлы бакета
+	PublicURL string
+	Timeout   time.Duration
+}
+
+// Storage хранит файлы в бакете S3-совместимого хранилища (AWS S3, MinIO и т.п.).
+// Запросы адресуют бакет в пути и подписываются AWS Signature Version 4
+type Storage struct {
+	cfg    Config
+	client *http.Client
+}
+
+// New создает хранилище для бакета cfg.Bucket
+func New(cfg Config) *Storage {
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+	return &Storage{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Put загружает объект в бакет
+func (s *Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), body)
+	if err != nil {
+		return fmt.Errorf("не удалось создать запрос к хранилищу: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	return s.do(req, http.StatusOK)
+}
+
+// Get скачивает объект из бакета, вызывающий обязан закрыть тело ответа
+func (s *Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось создать запрос к хранилищу: %w", err)
+	}
+	sign(req, s.cfg.AccessKey, s.cfg.SecretKey, s.cfg.Region, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка запроса к хранилищу: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("хранилище вернуло статус %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return resp.Body, nil
+}
+
+// Delete удаляет объект из бакета, отсутствующий объект не считается ошибкой
+func (s *Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("не удалось создать запрос к хранилищу: %w", err)
+	}
+
+	return s.do(req, http.StatusNoContent, http.StatusOK)
+}
+
+// URL возвращает публичную ссылку на объект
+func (s *Storage) URL(key string) string {
+	return s.cfg.PublicURL + "/" + escapeKey(key)
+}
+
+// do подписывает и выполняет запрос, ожидая один из статусов expected
+func (s *Storage) do(req *http.Request, expected ...int) error {
+	sign(req, s.cfg.AccessKey, s.cfg.SecretKey, s.cfg.Region, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ошибка запроса к хранилищу: %w", err)
+	}
+	defer resp.Body.Close()
+
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return nil
+		}
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("хранилище вернуло статус %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+}
+
+// objectURL возвращает адрес объекта с бакетом в пути
+func (s *Storage) objectURL(key string) string {
+	return s.cfg.Endpoint + "/" + url.PathEscape(s.cfg.Bucket) + "/" + escapeKey(key)
+}
+
+// escapeKey экранирует сегменты ключа, сохраняя разделители пути
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
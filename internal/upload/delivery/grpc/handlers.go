@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload"
+	uploads_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/uploads"
+	"go.uber.org/zap"
+)
+
+// Handler структура обработчика gRPC запросов
+type Handler struct {
+	uploads_pb.UnimplementedUploadsServiceServer
+	uploadUC upload.UseCase
+	log      *logger.Logger
+}
+
+// New создает новый экземпляр Handler
+func New(uploadUC upload.UseCase, log *logger.Logger) *Handler {
+	return &Handler{
+		uploadUC: uploadUC,
+		log:      log,
+	}
+}
+
+// UploadImage обрабатывает потоковую загрузку изображения
+func (h *Handler) UploadImage(stream uploads_pb.UploadsService_UploadImageServer) error {
+	ctx := stream.Context()
+
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка загрузить изображение без авторизации")
+		return adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return adapter.MapError(app_errors.WrapError(app_errors.ErrValidation, "поток загрузки пуст"))
+		}
+		return err
+	}
+
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return adapter.MapError(app_errors.WrapError(app_errors.ErrValidation, "первое сообщение должно содержать метаданные файла"))
+	}
+	if err := first.Validate(); err != nil {
+		return adapter.MapError(app_errors.WrapError(app_errors.ErrValidation, err.Error()))
+	}
+
+	upload, err := h.uploadUC.UploadImage(ctx, userID, metadata.Filename, &chunkReader{stream: stream})
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при загрузке изображения",
+			zap.String("filename", metadata.Filename),
+			zap.Error(err))
+		return adapter.MapError(err)
+	}
+
+	return stream.SendAndClose(adapter.MapUploadToProto(upload))
+}
+
+// chunkReader читает содержимое файла из сообщений потока загрузки
+type chunkReader struct {
+	stream uploads_pb.UploadsService_UploadImageServer
+	chunk  []byte
+}
+
+// Read возвращает очередную часть файла, io.EOF означает, что клиент завершил передачу
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if err := msg.Validate(); err != nil {
+			return 0, app_errors.WrapError(app_errors.ErrValidation, err.Error())
+		}
+		if _, ok := msg.Data.(*uploads_pb.UploadImageRequest_Chunk); !ok {
+			return 0, app_errors.WrapError(app_errors.ErrValidation, "метаданные файла можно передать только в первом сообщении")
+		}
+		r.chunk = msg.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
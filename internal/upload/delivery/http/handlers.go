@@ -0,0 +1,123 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	uploads_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/uploads"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// fileFormField имя поля формы с загружаемым файлом
+	fileFormField = "file"
+	// chunkSize размер части файла в одном сообщении gRPC-потока
+	chunkSize = 64 * 1024
+	// multipartOverhead запас на заголовки и границы multipart-запроса сверх размера файла
+	multipartOverhead = 64 * 1024
+)
+
+// Handler принимает multipart-загрузки на HTTP Gateway и передает их в gRPC-поток UploadsService
+type Handler struct {
+	client  uploads_pb.UploadsServiceClient
+	mux     *runtime.ServeMux
+	maxSize int64
+	log     *logger.Logger
+}
+
+// New создает новый экземпляр Handler. Ошибки возвращаются через обработчик ошибок mux
+func New(client uploads_pb.UploadsServiceClient, mux *runtime.ServeMux, maxSize int64, log *logger.Logger) *Handler {
+	return &Handler{
+		client:  client,
+		mux:     mux,
+		maxSize: maxSize,
+		log:     log,
+	}
+}
+
+// UploadImage обрабатывает POST /v1/uploads/images с файлом в поле file формы multipart/form-data.
+// Файл передается в gRPC частями по мере чтения, не сохраняясь в памяти Gateway целиком
+func (h *Handler) UploadImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	_, marshaler := runtime.MarshalerForRequest(h.mux, r)
+
+	writeError := func(err error) {
+		runtime.HTTPError(ctx, h.mux, marshaler, w, r, err)
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+multipartOverhead)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeError(adapter.MapError(app_errors.WrapError(app_errors.ErrValidation, "ожидается запрос multipart/form-data")))
+		return
+	}
+
+	var part io.Reader
+	var filename string
+	for {
+		p, err := reader.NextPart()
+		if err != nil {
+			writeError(adapter.MapError(app_errors.WrapError(app_errors.ErrValidation, "поле file не найдено в форме")))
+			return
+		}
+		if p.FormName() == fileFormField {
+			part, filename = p, p.FileName()
+			break
+		}
+	}
+
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	stream, err := h.client.UploadImage(ctx)
+	if err != nil {
+		h.log.Error(ctx, "Не удалось открыть поток загрузки", zap.Error(err))
+		writeError(err)
+		return
+	}
+
+	err = stream.Send(&uploads_pb.UploadImageRequest{
+		Data: &uploads_pb.UploadImageRequest_Metadata{Metadata: &uploads_pb.UploadMetadata{Filename: filename}},
+	})
+
+	buf := make([]byte, chunkSize)
+	for err == nil {
+		n, readErr := part.Read(buf)
+		if n > 0 {
+			err = stream.Send(&uploads_pb.UploadImageRequest{
+				Data: &uploads_pb.UploadImageRequest_Chunk{Chunk: buf[:n]},
+			})
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			h.log.Warn(ctx, "Ошибка при чтении загружаемого файла", zap.Error(readErr))
+			writeError(adapter.MapError(app_errors.WrapError(app_errors.ErrValidation, "не удалось прочитать файл: "+readErr.Error())))
+			return
+		}
+	}
+
+	// При ошибке отправки сервер уже завершил поток, его ответ содержит причину
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		writeError(err)
+		return
+	}
+
+	serverMetadata := runtime.ServerMetadata{TrailerMD: stream.Trailer()}
+	if header, err := stream.Header(); err == nil {
+		serverMetadata.HeaderMD = header
+	}
+	ctx = runtime.NewServerMetadataContext(ctx, serverMetadata)
+
+	runtime.ForwardResponseMessage(ctx, h.mux, marshaler, w, r, response)
+}
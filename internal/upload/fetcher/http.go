@@ -0,0 +1,171 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrForbiddenAddress возвращается при обращении к адресам внутренних сетей
+	ErrForbiddenAddress = errors.New("адрес недоступен для загрузки")
+	// ErrTooLarge возвращается, если размер файла превышает лимит
+	ErrTooLarge = errors.New("размер файла превышает допустимый")
+)
+
+// blockedPrefixes содержит служебные и внутренние диапазоны адресов, которые не покрываются
+// методами net.IP: CGNAT, сети для тестов и документации, NAT64 и зарезервированные адреса
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// Config содержит ограничения загрузки файлов по ссылке
+type Config struct {
+	// Timeout ограничивает время всего запроса, включая перенаправления и чтение тела
+	Timeout time.Duration
+	// MaxRedirects ограничивает количество перенаправлений
+	MaxRedirects int
+	// AllowPrivate разрешает адреса внутренних сетей, используется только при локальной разработке
+	AllowPrivate bool
+}
+
+// HTTPFetcher скачивает файлы по HTTP(S) с защитой от SSRF. Адрес проверяется при установке
+// соединения уже после разрешения имени, поэтому подмена DNS-записи между проверкой
+// и запросом не позволяет обратиться во внутреннюю сеть. Это же относится к перенаправлениям
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+// NewHTTPFetcher создает HTTPFetcher с заданными ограничениями
+func NewHTTPFetcher(cfg Config) *HTTPFetcher {
+	dialer := &net.Dialer{
+		Timeout: cfg.Timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			if cfg.AllowPrivate {
+				return nil
+			}
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(addrPort.Addr()) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+
+	transport := &http.Transport{
+		// Прокси из окружения не используется, иначе соединение устанавливалось бы с прокси, а не с проверенным адресом
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   cfg.Timeout,
+		ResponseHeaderTimeout: cfg.Timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+
+	return &HTTPFetcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) > cfg.MaxRedirects {
+					return fmt.Errorf("превышено количество перенаправлений: %d", cfg.MaxRedirects)
+				}
+				return checkScheme(req.URL)
+			},
+		},
+	}
+}
+
+// Fetch скачивает файл по ссылке rawURL, прерывая загрузку при превышении maxSize байт
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string, maxSize int64) ([]byte, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("некорректная ссылка: %w", err)
+	}
+	if err := checkScheme(target); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("некорректная ссылка: %w", err)
+	}
+	req.Header.Set("Accept", "image/*")
+	req.Header.Set("User-Agent", "marketplace-image-fetcher/1.0")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if errors.Is(err, ErrForbiddenAddress) {
+			return nil, ErrForbiddenAddress
+		}
+		return nil, fmt.Errorf("ошибка запроса: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("сервер вернул статус %d", resp.StatusCode)
+	}
+
+	if resp.ContentLength > maxSize {
+		return nil, ErrTooLarge
+	}
+
+	// Content-Length может отсутствовать или быть неверным, поэтому лимит проверяется и при чтении
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения ответа: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrTooLarge
+	}
+
+	return data, nil
+}
+
+// checkScheme разрешает только ссылки HTTP(S) с указанным хостом
+func checkScheme(target *url.URL) error {
+	if (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return fmt.Errorf("поддерживаются только ссылки http и https")
+	}
+	return nil
+}
+
+// isPublicAddr сообщает, относится ли адрес к публичному интернету
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,112 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want bool
+	}{
+		{name: "публичный IPv4", addr: "93.184.216.34", want: true},
+		{name: "публичный IPv6", addr: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{name: "loopback", addr: "127.0.0.1", want: false},
+		{name: "loopback IPv6", addr: "::1", want: false},
+		{name: "частная сеть 10/8", addr: "10.1.2.3", want: false},
+		{name: "частная сеть 172.16/12", addr: "172.31.255.255", want: false},
+		{name: "частная сеть 192.168/16", addr: "192.168.0.1", want: false},
+		{name: "unique local IPv6", addr: "fd00::1", want: false},
+		{name: "link-local и метаданные облака", addr: "169.254.169.254", want: false},
+		{name: "link-local IPv6", addr: "fe80::1", want: false},
+		{name: "неуказанный адрес", addr: "0.0.0.0", want: false},
+		{name: "сеть 0/8", addr: "0.1.2.3", want: false},
+		{name: "multicast", addr: "224.0.0.1", want: false},
+		{name: "CGNAT", addr: "100.64.0.1", want: false},
+		{name: "граница CGNAT", addr: "100.128.0.1", want: true},
+		{name: "IETF 192.0.0/24", addr: "192.0.0.8", want: false},
+		{name: "документация TEST-NET-1", addr: "192.0.2.1", want: false},
+		{name: "бенчмарки 198.18/15", addr: "198.19.255.1", want: false},
+		{name: "документация TEST-NET-2", addr: "198.51.100.7", want: false},
+		{name: "документация TEST-NET-3", addr: "203.0.113.9", want: false},
+		{name: "зарезервированный 240/4", addr: "250.0.0.1", want: false},
+		{name: "широковещательный", addr: "255.255.255.255", want: false},
+		{name: "loopback в IPv4-mapped IPv6", addr: "::ffff:127.0.0.1", want: false},
+		{name: "частная сеть в IPv4-mapped IPv6", addr: "::ffff:10.0.0.1", want: false},
+		{name: "публичный в IPv4-mapped IPv6", addr: "::ffff:93.184.216.34", want: true},
+		{name: "NAT64", addr: "64:ff9b::7f00:1", want: false},
+		{name: "локальный NAT64", addr: "64:ff9b:1::a00:1", want: false},
+		{name: "документация IPv6", addr: "2001:db8::1", want: false},
+		{name: "пустой адрес", addr: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var addr netip.Addr
+			if tt.addr != "" {
+				addr = netip.MustParseAddr(tt.addr)
+			}
+			if got := isPublicAddr(addr); got != tt.want {
+				t.Errorf("isPublicAddr(%q) = %v, ожидалось %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPFetcherBlocksPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("internal"))
+	}))
+	defer server.Close()
+
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, server.URL, http.StatusFound)
+	}))
+	defer redirect.Close()
+
+	tests := []struct {
+		name         string
+		allowPrivate bool
+		url          string
+		wantErr      error
+	}{
+		{name: "прямое обращение к loopback", url: server.URL, wantErr: ErrForbiddenAddress},
+		{name: "перенаправление на loopback", url: redirect.URL, wantErr: ErrForbiddenAddress},
+		{name: "localhost по имени", url: "http://localhost:1/", wantErr: ErrForbiddenAddress},
+		{name: "разрешенные внутренние адреса", allowPrivate: true, url: redirect.URL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewHTTPFetcher(Config{Timeout: 5 * time.Second, MaxRedirects: 3, AllowPrivate: tt.allowPrivate})
+
+			_, err := f.Fetch(context.Background(), tt.url, 1024)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("неожиданная ошибка: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ошибка %v, ожидалась %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHTTPFetcherRejectsUnsupportedSchemes(t *testing.T) {
+	f := NewHTTPFetcher(Config{Timeout: time.Second, MaxRedirects: 3})
+
+	for _, rawURL := range []string{"file:///etc/passwd", "ftp://example.com/a.jpg", "gopher://example.com", "http://"} {
+		if _, err := f.Fetch(context.Background(), rawURL, 1024); err == nil {
+			t.Errorf("Fetch(%q) не вернул ошибку", rawURL)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Package imaging содержит обработку загруженных изображений: удаление метаданных
+// и построение уменьшенных копий. WebP декодируется пакетом golang.org/x/image/webp,
+// кодировщика WebP нет, поэтому копии кодируются в JPEG или PNG
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	_ "golang.org/x/image/webp"
+)
+
+// MaxPixels ограничивает размер декодируемого изображения. Размеры проверяются
+// по заголовку до распаковки, чтобы небольшой файл не занял гигабайты памяти
+const MaxPixels = 50_000_000
+
+// ErrUnsupported возвращается для форматов, которые нельзя декодировать
+var ErrUnsupported = errors.New("формат изображения не поддерживается для обработки")
+
+// ErrTooLarge возвращается, если размеры изображения превышают MaxPixels
+var ErrTooLarge = errors.New("размер изображения превышает допустимый")
+
+// Format задает формат кодирования уменьшенных копий
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+)
+
+// ParseFormat проверяет название формата
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case FormatJPEG, FormatPNG:
+		return Format(name), nil
+	default:
+		return "", fmt.Errorf("формат %q не поддерживается, доступны jpeg и png", name)
+	}
+}
+
+// ContentType возвращает MIME-тип формата
+func (f Format) ContentType() string {
+	if f == FormatPNG {
+		return "image/png"
+	}
+	return "image/jpeg"
+}
+
+// Extension возвращает расширение файла формата
+func (f Format) Extension() string {
+	if f == FormatPNG {
+		return ".png"
+	}
+	return ".jpg"
+}
+
+// Verify проверяет по заголовку, что файл является изображением допустимого размера.
+// Для WebP дополнительно проверяется структура контейнера, заголовок читается только из первых блоков
+func Verify(contentType string, data []byte) error {
+	if contentType == "image/webp" {
+		if _, err := stripWebP(data); err != nil {
+			return err
+		}
+	}
+
+	_, err := decodeConfig(data)
+	return err
+}
+
+// Decode декодирует изображение и поворачивает JPEG согласно тегу EXIF Orientation
+func Decode(data []byte) (image.Image, error) {
+	format, err := decodeConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+
+	if format == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	return img, nil
+}
+
+// decodeConfig читает заголовок изображения и проверяет его размеры, возвращая название формата
+func decodeConfig(data []byte) (string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return "", ErrUnsupported
+		}
+		return "", fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return "", fmt.Errorf("%w: %dx%d", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+
+	return format, nil
+}
+
+// Encode кодирует изображение в заданном формате. Результат не содержит метаданных.
+// Прозрачные области при кодировании в JPEG заполняются белым цветом
+func Encode(w io.Writer, img image.Image, format Format, quality int) error {
+	if format == FormatPNG {
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		return encoder.Encode(w, img)
+	}
+
+	return jpeg.Encode(w, flatten(img), &jpeg.Options{Quality: quality})
+}
+
+// Resize уменьшает изображение до ширины width с сохранением пропорций, усредняя
+// попадающие в каждый пиксель области исходного изображения. Изображения уже
+// заданной ширины не увеличиваются
+func Resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	if width <= 0 || width >= srcWidth {
+		return img
+	}
+	height := max(1, (srcHeight*width+srcWidth/2)/srcWidth)
+
+	src := toRGBA(img)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	// Границы областей по горизонтали одинаковы для всех строк
+	xFrom := make([]int, width)
+	xTo := make([]int, width)
+	for x := range width {
+		xFrom[x] = x * srcWidth / width
+		xTo[x] = max(xFrom[x]+1, (x+1)*srcWidth/width)
+	}
+
+	for y := range height {
+		yFrom := y * srcHeight / height
+		yTo := max(yFrom+1, (y+1)*srcHeight/height)
+
+		for x := range width {
+			var r, g, b, a uint64
+			for sy := yFrom; sy < yTo; sy++ {
+				row := src.Pix[sy*src.Stride+xFrom[x]*4 : sy*src.Stride+xTo[x]*4]
+				for i := 0; i < len(row); i += 4 {
+					r += uint64(row[i])
+					g += uint64(row[i+1])
+					b += uint64(row[i+2])
+					a += uint64(row[i+3])
+				}
+			}
+
+			count := uint64((yTo - yFrom) * (xTo[x] - xFrom[x]))
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8((r + count/2) / count)
+			dst.Pix[i+1] = uint8((g + count/2) / count)
+			dst.Pix[i+2] = uint8((b + count/2) / count)
+			dst.Pix[i+3] = uint8((a + count/2) / count)
+		}
+	}
+
+	return dst
+}
+
+// orient поворачивает и отражает изображение согласно значению тега EXIF Orientation
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	src := toRGBA(img)
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+
+	// Значения 5-8 поворачивают изображение на 90 градусов
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := range height {
+		for x := range width {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = width-1-x, y
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dx, dy = x, height-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			si := y*src.Stride + x*4
+			di := dy*dst.Stride + dx*4
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+
+	return dst
+}
+
+// toRGBA приводит изображение к RGBA с началом координат в нуле
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	return dst
+}
+
+// flatten накладывает изображение с прозрачностью на белый фон
+func flatten(img image.Image) image.Image {
+	if opaque, ok := img.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		return img
+	}
+
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,360 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// ErrCorrupted возвращается, если структура файла изображения нарушена
+var ErrCorrupted = errors.New("файл изображения поврежден")
+
+// reorientQuality задает качество JPEG при повороте изображения согласно EXIF Orientation
+const reorientQuality = 92
+
+// Sanitize удаляет из изображения метаданные, которые могут раскрыть данные автора:
+// EXIF (координаты съемки, модель камеры), XMP, IPTC и текстовые комментарии.
+// Если JPEG повернут тегом Orientation, изображение поворачивается и перекодируется,
+// иначе после удаления EXIF оно отображалось бы повернутым
+func Sanitize(contentType string, data []byte) ([]byte, error) {
+	switch contentType {
+	case "image/jpeg":
+		if jpegOrientation(data) > 1 {
+			img, err := Decode(data)
+			if err != nil {
+				return nil, err
+			}
+			var buf bytes.Buffer
+			if err := Encode(&buf, img, FormatJPEG, reorientQuality); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		}
+		return stripJPEG(data)
+	case "image/png":
+		return stripPNG(data)
+	case "image/webp":
+		return stripWebP(data)
+	case "image/gif":
+		return stripGIF(data)
+	default:
+		return data, nil
+	}
+}
+
+// jpegSegment описывает сегмент JPEG от маркера до конца данных сегмента
+type jpegSegment struct {
+	marker     byte
+	start, end int
+}
+
+// jpegSegments возвращает сегменты заголовка JPEG и смещение начала данных скана (SOS)
+func jpegSegments(data []byte) ([]jpegSegment, int, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, 0, ErrCorrupted
+	}
+
+	var segments []jpegSegment
+	pos := 2
+	for {
+		if pos+4 > len(data) || data[pos] != 0xFF {
+			return nil, 0, ErrCorrupted
+		}
+
+		marker := data[pos+1]
+		switch {
+		case marker == 0xFF:
+			// Байты-заполнители перед маркером
+			pos++
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			return segments, pos, nil
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			// Маркеры без данных
+			segments = append(segments, jpegSegment{marker: marker, start: pos, end: pos + 2})
+			pos += 2
+			continue
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, 0, ErrCorrupted
+		}
+		segments = append(segments, jpegSegment{marker: marker, start: pos, end: end})
+		pos = end
+	}
+}
+
+// dropJPEGSegment сообщает, содержит ли сегмент метаданные. Сохраняются JFIF (APP0),
+// цветовой профиль ICC (APP2) и Adobe (APP14), без которых искажаются цвета
+func dropJPEGSegment(data []byte, segment jpegSegment) bool {
+	switch {
+	case segment.marker == 0xFE:
+		return true
+	case segment.marker == 0xE2:
+		// MPF ссылается на дополнительные изображения после конца основного, они отбрасываются
+		return bytes.HasPrefix(data[segment.start+4:segment.end], []byte("MPF\x00"))
+	case segment.marker == 0xEE:
+		return false
+	default:
+		return segment.marker >= 0xE1 && segment.marker <= 0xEF
+	}
+}
+
+// stripJPEG удаляет сегменты с метаданными и данные после конца изображения
+func stripJPEG(data []byte) ([]byte, error) {
+	segments, scan, err := jpegSegments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:2]...)
+	for _, segment := range segments {
+		if !dropJPEGSegment(data, segment) {
+			out = append(out, data[segment.start:segment.end]...)
+		}
+	}
+
+	// В сжатых данных байт 0xFF всегда экранируется, поэтому первый маркер EOI — конец изображения.
+	// После него камеры дописывают превью со своими EXIF
+	rest := data[scan:]
+	if end := bytes.Index(rest, []byte{0xFF, 0xD9}); end >= 0 {
+		rest = rest[:end+2]
+	}
+
+	return append(out, rest...), nil
+}
+
+// jpegOrientation возвращает значение тега EXIF Orientation или 1, если тег не задан
+func jpegOrientation(data []byte) int {
+	segments, _, err := jpegSegments(data)
+	if err != nil {
+		return 1
+	}
+
+	for _, segment := range segments {
+		if segment.marker != 0xE1 {
+			continue
+		}
+		payload := data[segment.start+4 : segment.end]
+		if bytes.HasPrefix(payload, []byte("Exif\x00\x00")) {
+			return exifOrientation(payload[6:])
+		}
+	}
+
+	return 1
+}
+
+// exifOrientation читает тег Orientation (0x0112) из первого каталога TIFF-структуры EXIF
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int64(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > int64(len(tiff)) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := int(ifd) + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if orientation := int(order.Uint16(tiff[entry+8:])); orientation >= 1 && orientation <= 8 {
+				return orientation
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
+
+// pngMetadataChunks содержит типы блоков PNG с метаданными
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// stripPNG удаляет блоки с EXIF и текстовыми метаданными
+func stripPNG(data []byte) ([]byte, error) {
+	const signatureLen = 8
+	if len(data) < signatureLen || string(data[:signatureLen]) != "\x89PNG\r\n\x1a\n" {
+		return nil, ErrCorrupted
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:signatureLen]...)
+	for pos := signatureLen; pos < len(data); {
+		if pos+12 > len(data) {
+			return nil, ErrCorrupted
+		}
+		length := int64(binary.BigEndian.Uint32(data[pos:]))
+		end := int64(pos) + 12 + length
+		if end > int64(len(data)) {
+			return nil, ErrCorrupted
+		}
+
+		chunkType := string(data[pos+4 : pos+8])
+		if !pngMetadataChunks[chunkType] {
+			out = append(out, data[pos:end]...)
+		}
+		pos = int(end)
+
+		if chunkType == "IEND" {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// stripWebP удаляет блоки EXIF и XMP и сбрасывает соответствующие флаги заголовка VP8X
+func stripWebP(data []byte) ([]byte, error) {
+	const (
+		headerLen = 12
+		flagEXIF  = 0x08
+		flagXMP   = 0x04
+	)
+	if len(data) < headerLen || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, ErrCorrupted
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:headerLen]...)
+	for pos := headerLen; pos < len(data); {
+		if pos+8 > len(data) {
+			return nil, ErrCorrupted
+		}
+		size := int64(binary.LittleEndian.Uint32(data[pos+4:]))
+		// Данные блока выравниваются до четной длины
+		end := int64(pos) + 8 + size + size%2
+		if end > int64(len(data)) {
+			return nil, ErrCorrupted
+		}
+
+		switch string(data[pos : pos+4]) {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), data[pos:end]...)
+			if len(chunk) > 8 {
+				chunk[8] &^= flagEXIF | flagXMP
+			}
+			out = append(out, chunk...)
+		default:
+			out = append(out, data[pos:end]...)
+		}
+		pos = int(end)
+	}
+
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, nil
+}
+
+// gifLoopApplications содержит идентификаторы расширений приложения, задающих число повторов анимации
+var gifLoopApplications = map[string]bool{
+	"NETSCAPE2.0": true,
+	"ANIMEXTS1.0": true,
+}
+
+// stripGIF удаляет расширения с комментариями и данными приложений (XMP, ICC и другие),
+// сохраняя только расширение с числом повторов анимации, и данные после конца файла
+func stripGIF(data []byte) ([]byte, error) {
+	const (
+		headerLen        = 13
+		colorTableFlag   = 0x80
+		extensionBlock   = 0x21
+		imageBlock       = 0x2C
+		trailer          = 0x3B
+		commentLabel     = 0xFE
+		applicationLabel = 0xFF
+	)
+	if len(data) < headerLen || (string(data[:6]) != "GIF87a" && string(data[:6]) != "GIF89a") {
+		return nil, ErrCorrupted
+	}
+
+	pos := headerLen
+	if data[10]&colorTableFlag != 0 {
+		pos += 3 << (data[10]&0x07 + 1)
+	}
+	if pos > len(data) {
+		return nil, ErrCorrupted
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:pos]...)
+	for {
+		if pos >= len(data) {
+			return nil, ErrCorrupted
+		}
+
+		start := pos
+		keep := true
+		switch data[pos] {
+		case trailer:
+			return append(out, trailer), nil
+		case extensionBlock:
+			if pos+2 > len(data) {
+				return nil, ErrCorrupted
+			}
+			switch data[pos+1] {
+			case commentLabel:
+				keep = false
+			case applicationLabel:
+				// Первый подблок расширения приложения содержит 11 байт идентификатора
+				keep = pos+14 <= len(data) && data[pos+2] == 11 && gifLoopApplications[string(data[pos+3:pos+14])]
+			}
+			pos += 2
+		case imageBlock:
+			if pos+10 > len(data) {
+				return nil, ErrCorrupted
+			}
+			flags := data[pos+9]
+			pos += 10
+			if flags&colorTableFlag != 0 {
+				pos += 3 << (flags&0x07 + 1)
+			}
+			// Минимальный размер кода LZW
+			pos++
+		default:
+			return nil, ErrCorrupted
+		}
+
+		// Данные блока состоят из подблоков, каждый начинается с байта длины, нулевая длина завершает блок
+		for {
+			if pos >= len(data) {
+				return nil, ErrCorrupted
+			}
+			size := int(data[pos])
+			pos += 1 + size
+			if size == 0 {
+				break
+			}
+		}
+		if pos > len(data) {
+			return nil, ErrCorrupted
+		}
+
+		if keep {
+			out = append(out, data[start:pos]...)
+		}
+	}
+}
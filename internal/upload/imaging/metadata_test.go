@@ -0,0 +1,81 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+)
+
+// gifExtension собирает расширение GIF с одним подблоком данных
+func gifExtension(label byte, blocks ...[]byte) []byte {
+	ext := []byte{0x21, label}
+	for _, block := range blocks {
+		ext = append(ext, byte(len(block)))
+		ext = append(ext, block...)
+	}
+	return append(ext, 0)
+}
+
+func TestSanitizeGIF(t *testing.T) {
+	frame := image.NewPaletted(image.Rect(0, 0, 4, 4), color.Palette{color.Black, color.White})
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, &gif.GIF{Image: []*image.Paletted{frame, frame}, Delay: []int{10, 10}}); err != nil {
+		t.Fatalf("не удалось закодировать GIF: %v", err)
+	}
+	clean := buf.Bytes()
+	body, trailer := clean[:len(clean)-1], clean[len(clean)-1:]
+
+	comment := gifExtension(0xFE, []byte("shot on iPhone, 55.75N 37.61E"))
+	xmp := gifExtension(0xFF, []byte("XMP DataXMP"), []byte("<x:xmpmeta/>"))
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    []byte
+		wantErr error
+	}{
+		{name: "без метаданных", data: clean, want: clean},
+		{name: "комментарий и XMP", data: concat(body, comment, xmp, trailer), want: clean},
+		{name: "данные после конца файла", data: concat(clean, []byte("trailing")), want: clean},
+		{name: "обрезанный файл", data: clean[:len(clean)-8], wantErr: ErrCorrupted},
+		{name: "не GIF", data: []byte("GIF00a not really"), wantErr: ErrCorrupted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sanitize("image/gif", tt.data)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ошибка %v, ожидалась %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Fatalf("результат длиной %d байт не совпадает с ожидаемым длиной %d байт", len(got), len(tt.want))
+			}
+
+			// Расширение NETSCAPE2.0 с числом повторов анимации сохраняется
+			decoded, err := gif.DecodeAll(bytes.NewReader(got))
+			if err != nil {
+				t.Fatalf("результат не декодируется: %v", err)
+			}
+			if len(decoded.Image) != 2 || decoded.LoopCount != 0 {
+				t.Fatalf("кадров %d, повторов %d, ожидалось 2 кадра и бесконечный повтор", len(decoded.Image), decoded.LoopCount)
+			}
+		})
+	}
+}
+
+func concat(parts ...[]byte) []byte {
+	var out []byte
+	for _, part := range parts {
+		out = append(out, part...)
+	}
+	return out
+}
@@ -0,0 +1,28 @@
+package upload
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+type Repository interface {
+	CreateUpload(ctx context.Context, upload *entity.Upload) (*entity.Upload, error)
+	GetUploadByStorageKey(ctx context.Context, key string) (*entity.Upload, error)
+	ClaimPendingUploads(ctx context.Context, limit int, staleAfter time.Duration) ([]*entity.Upload, error)
+	SaveUploadVariants(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) error
+	FailUploadVariants(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) error
+}
+
+// Fetcher скачивает файлы по внешним ссылкам
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string, maxSize int64) ([]byte, error)
+}
+
+type UseCase interface {
+	UploadImage(ctx context.Context, userID uint64, filename string, body io.Reader) (*entity.Upload, error)
+	ImportImageURL(ctx context.Context, userID uint64, rawURL string) (string, error)
+	ProcessPendingVariants(ctx context.Context) (int, error)
+}
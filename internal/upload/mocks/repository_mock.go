@@ -0,0 +1,1965 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/upload.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_upload.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimPendingUploads          func(ctx context.Context, limit int, staleAfter time.Duration) (upa1 []*entity.Upload, err error)
+	funcClaimPendingUploadsOrigin    string
+	inspectFuncClaimPendingUploads   func(ctx context.Context, limit int, staleAfter time.Duration)
+	afterClaimPendingUploadsCounter  uint64
+	beforeClaimPendingUploadsCounter uint64
+	ClaimPendingUploadsMock          mRepositoryMockClaimPendingUploads
+
+	funcCreateUpload          func(ctx context.Context, upload *entity.Upload) (up1 *entity.Upload, err error)
+	funcCreateUploadOrigin    string
+	inspectFuncCreateUpload   func(ctx context.Context, upload *entity.Upload)
+	afterCreateUploadCounter  uint64
+	beforeCreateUploadCounter uint64
+	CreateUploadMock          mRepositoryMockCreateUpload
+
+	funcFailUploadVariants          func(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) (err error)
+	funcFailUploadVariantsOrigin    string
+	inspectFuncFailUploadVariants   func(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int)
+	afterFailUploadVariantsCounter  uint64
+	beforeFailUploadVariantsCounter uint64
+	FailUploadVariantsMock          mRepositoryMockFailUploadVariants
+
+	funcGetUploadByStorageKey          func(ctx context.Context, key string) (up1 *entity.Upload, err error)
+	funcGetUploadByStorageKeyOrigin    string
+	inspectFuncGetUploadByStorageKey   func(ctx context.Context, key string)
+	afterGetUploadByStorageKeyCounter  uint64
+	beforeGetUploadByStorageKeyCounter uint64
+	GetUploadByStorageKeyMock          mRepositoryMockGetUploadByStorageKey
+
+	funcSaveUploadVariants          func(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) (err error)
+	funcSaveUploadVariantsOrigin    string
+	inspectFuncSaveUploadVariants   func(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant)
+	afterSaveUploadVariantsCounter  uint64
+	beforeSaveUploadVariantsCounter uint64
+	SaveUploadVariantsMock          mRepositoryMockSaveUploadVariants
+}
+
+// NewRepositoryMock returns a mock for mm_upload.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimPendingUploadsMock = mRepositoryMockClaimPendingUploads{mock: m}
+	m.ClaimPendingUploadsMock.callArgs = []*RepositoryMockClaimPendingUploadsParams{}
+
+	m.CreateUploadMock = mRepositoryMockCreateUpload{mock: m}
+	m.CreateUploadMock.callArgs = []*RepositoryMockCreateUploadParams{}
+
+	m.FailUploadVariantsMock = mRepositoryMockFailUploadVariants{mock: m}
+	m.FailUploadVariantsMock.callArgs = []*RepositoryMockFailUploadVariantsParams{}
+
+	m.GetUploadByStorageKeyMock = mRepositoryMockGetUploadByStorageKey{mock: m}
+	m.GetUploadByStorageKeyMock.callArgs = []*RepositoryMockGetUploadByStorageKeyParams{}
+
+	m.SaveUploadVariantsMock = mRepositoryMockSaveUploadVariants{mock: m}
+	m.SaveUploadVariantsMock.callArgs = []*RepositoryMockSaveUploadVariantsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockClaimPendingUploads struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockClaimPendingUploadsExpectation
+	expectations       []*RepositoryMockClaimPendingUploadsExpectation
+
+	callArgs []*RepositoryMockClaimPendingUploadsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockClaimPendingUploadsExpectation specifies expectation struct of the Repository.ClaimPendingUploads
+type RepositoryMockClaimPendingUploadsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockClaimPendingUploadsParams
+	paramPtrs          *RepositoryMockClaimPendingUploadsParamPtrs
+	expectationOrigins RepositoryMockClaimPendingUploadsExpectationOrigins
+	results            *RepositoryMockClaimPendingUploadsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockClaimPendingUploadsParams contains parameters of the Repository.ClaimPendingUploads
+type RepositoryMockClaimPendingUploadsParams struct {
+	ctx        context.Context
+	limit      int
+	staleAfter time.Duration
+}
+
+// RepositoryMockClaimPendingUploadsParamPtrs contains pointers to parameters of the Repository.ClaimPendingUploads
+type RepositoryMockClaimPendingUploadsParamPtrs struct {
+	ctx        *context.Context
+	limit      *int
+	staleAfter *time.Duration
+}
+
+// RepositoryMockClaimPendingUploadsResults contains results of the Repository.ClaimPendingUploads
+type RepositoryMockClaimPendingUploadsResults struct {
+	upa1 []*entity.Upload
+	err  error
+}
+
+// RepositoryMockClaimPendingUploadsOrigins contains origins of expectations of the Repository.ClaimPendingUploads
+type RepositoryMockClaimPendingUploadsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originLimit      string
+	originStaleAfter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Optional() *mRepositoryMockClaimPendingUploads {
+	mmClaimPendingUploads.optional = true
+	return mmClaimPendingUploads
+}
+
+// Expect sets up expected params for Repository.ClaimPendingUploads
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Expect(ctx context.Context, limit int, staleAfter time.Duration) *mRepositoryMockClaimPendingUploads {
+	if mmClaimPendingUploads.mock.funcClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Set")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation == nil {
+		mmClaimPendingUploads.defaultExpectation = &RepositoryMockClaimPendingUploadsExpectation{}
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.paramPtrs != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by ExpectParams functions")
+	}
+
+	mmClaimPendingUploads.defaultExpectation.params = &RepositoryMockClaimPendingUploadsParams{ctx, limit, staleAfter}
+	mmClaimPendingUploads.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimPendingUploads.expectations {
+		if minimock.Equal(e.params, mmClaimPendingUploads.defaultExpectation.params) {
+			mmClaimPendingUploads.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimPendingUploads.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimPendingUploads
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ClaimPendingUploads
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) ExpectCtxParam1(ctx context.Context) *mRepositoryMockClaimPendingUploads {
+	if mmClaimPendingUploads.mock.funcClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Set")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation == nil {
+		mmClaimPendingUploads.defaultExpectation = &RepositoryMockClaimPendingUploadsExpectation{}
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.params != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Expect")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.paramPtrs == nil {
+		mmClaimPendingUploads.defaultExpectation.paramPtrs = &RepositoryMockClaimPendingUploadsParamPtrs{}
+	}
+	mmClaimPendingUploads.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimPendingUploads.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimPendingUploads
+}
+
+// ExpectLimitParam2 sets up expected param limit for Repository.ClaimPendingUploads
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) ExpectLimitParam2(limit int) *mRepositoryMockClaimPendingUploads {
+	if mmClaimPendingUploads.mock.funcClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Set")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation == nil {
+		mmClaimPendingUploads.defaultExpectation = &RepositoryMockClaimPendingUploadsExpectation{}
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.params != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Expect")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.paramPtrs == nil {
+		mmClaimPendingUploads.defaultExpectation.paramPtrs = &RepositoryMockClaimPendingUploadsParamPtrs{}
+	}
+	mmClaimPendingUploads.defaultExpectation.paramPtrs.limit = &limit
+	mmClaimPendingUploads.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmClaimPendingUploads
+}
+
+// ExpectStaleAfterParam3 sets up expected param staleAfter for Repository.ClaimPendingUploads
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) ExpectStaleAfterParam3(staleAfter time.Duration) *mRepositoryMockClaimPendingUploads {
+	if mmClaimPendingUploads.mock.funcClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Set")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation == nil {
+		mmClaimPendingUploads.defaultExpectation = &RepositoryMockClaimPendingUploadsExpectation{}
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.params != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Expect")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation.paramPtrs == nil {
+		mmClaimPendingUploads.defaultExpectation.paramPtrs = &RepositoryMockClaimPendingUploadsParamPtrs{}
+	}
+	mmClaimPendingUploads.defaultExpectation.paramPtrs.staleAfter = &staleAfter
+	mmClaimPendingUploads.defaultExpectation.expectationOrigins.originStaleAfter = minimock.CallerInfo(1)
+
+	return mmClaimPendingUploads
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ClaimPendingUploads
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Inspect(f func(ctx context.Context, limit int, staleAfter time.Duration)) *mRepositoryMockClaimPendingUploads {
+	if mmClaimPendingUploads.mock.inspectFuncClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ClaimPendingUploads")
+	}
+
+	mmClaimPendingUploads.mock.inspectFuncClaimPendingUploads = f
+
+	return mmClaimPendingUploads
+}
+
+// Return sets up results that will be returned by Repository.ClaimPendingUploads
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Return(upa1 []*entity.Upload, err error) *RepositoryMock {
+	if mmClaimPendingUploads.mock.funcClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Set")
+	}
+
+	if mmClaimPendingUploads.defaultExpectation == nil {
+		mmClaimPendingUploads.defaultExpectation = &RepositoryMockClaimPendingUploadsExpectation{mock: mmClaimPendingUploads.mock}
+	}
+	mmClaimPendingUploads.defaultExpectation.results = &RepositoryMockClaimPendingUploadsResults{upa1, err}
+	mmClaimPendingUploads.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimPendingUploads.mock
+}
+
+// Set uses given function f to mock the Repository.ClaimPendingUploads method
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Set(f func(ctx context.Context, limit int, staleAfter time.Duration) (upa1 []*entity.Upload, err error)) *RepositoryMock {
+	if mmClaimPendingUploads.defaultExpectation != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("Default expectation is already set for the Repository.ClaimPendingUploads method")
+	}
+
+	if len(mmClaimPendingUploads.expectations) > 0 {
+		mmClaimPendingUploads.mock.t.Fatalf("Some expectations are already set for the Repository.ClaimPendingUploads method")
+	}
+
+	mmClaimPendingUploads.mock.funcClaimPendingUploads = f
+	mmClaimPendingUploads.mock.funcClaimPendingUploadsOrigin = minimock.CallerInfo(1)
+	return mmClaimPendingUploads.mock
+}
+
+// When sets expectation for the Repository.ClaimPendingUploads which will trigger the result defined by the following
+// Then helper
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) When(ctx context.Context, limit int, staleAfter time.Duration) *RepositoryMockClaimPendingUploadsExpectation {
+	if mmClaimPendingUploads.mock.funcClaimPendingUploads != nil {
+		mmClaimPendingUploads.mock.t.Fatalf("RepositoryMock.ClaimPendingUploads mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockClaimPendingUploadsExpectation{
+		mock:               mmClaimPendingUploads.mock,
+		params:             &RepositoryMockClaimPendingUploadsParams{ctx, limit, staleAfter},
+		expectationOrigins: RepositoryMockClaimPendingUploadsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimPendingUploads.expectations = append(mmClaimPendingUploads.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ClaimPendingUploads return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockClaimPendingUploadsExpectation) Then(upa1 []*entity.Upload, err error) *RepositoryMock {
+	e.results = &RepositoryMockClaimPendingUploadsResults{upa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ClaimPendingUploads should be invoked
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Times(n uint64) *mRepositoryMockClaimPendingUploads {
+	if n == 0 {
+		mmClaimPendingUploads.mock.t.Fatalf("Times of RepositoryMock.ClaimPendingUploads mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimPendingUploads.expectedInvocations, n)
+	mmClaimPendingUploads.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimPendingUploads
+}
+
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) invocationsDone() bool {
+	if len(mmClaimPendingUploads.expectations) == 0 && mmClaimPendingUploads.defaultExpectation == nil && mmClaimPendingUploads.mock.funcClaimPendingUploads == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimPendingUploads.mock.afterClaimPendingUploadsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimPendingUploads.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimPendingUploads implements mm_upload.Repository
+func (mmClaimPendingUploads *RepositoryMock) ClaimPendingUploads(ctx context.Context, limit int, staleAfter time.Duration) (upa1 []*entity.Upload, err error) {
+	mm_atomic.AddUint64(&mmClaimPendingUploads.beforeClaimPendingUploadsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimPendingUploads.afterClaimPendingUploadsCounter, 1)
+
+	mmClaimPendingUploads.t.Helper()
+
+	if mmClaimPendingUploads.inspectFuncClaimPendingUploads != nil {
+		mmClaimPendingUploads.inspectFuncClaimPendingUploads(ctx, limit, staleAfter)
+	}
+
+	mm_params := RepositoryMockClaimPendingUploadsParams{ctx, limit, staleAfter}
+
+	// Record call args
+	mmClaimPendingUploads.ClaimPendingUploadsMock.mutex.Lock()
+	mmClaimPendingUploads.ClaimPendingUploadsMock.callArgs = append(mmClaimPendingUploads.ClaimPendingUploadsMock.callArgs, &mm_params)
+	mmClaimPendingUploads.ClaimPendingUploadsMock.mutex.Unlock()
+
+	for _, e := range mmClaimPendingUploads.ClaimPendingUploadsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockClaimPendingUploadsParams{ctx, limit, staleAfter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimPendingUploads.t.Errorf("RepositoryMock.ClaimPendingUploads got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimPendingUploads.t.Errorf("RepositoryMock.ClaimPendingUploads got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.staleAfter != nil && !minimock.Equal(*mm_want_ptrs.staleAfter, mm_got.staleAfter) {
+				mmClaimPendingUploads.t.Errorf("RepositoryMock.ClaimPendingUploads got unexpected parameter staleAfter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.expectationOrigins.originStaleAfter, *mm_want_ptrs.staleAfter, mm_got.staleAfter, minimock.Diff(*mm_want_ptrs.staleAfter, mm_got.staleAfter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimPendingUploads.t.Errorf("RepositoryMock.ClaimPendingUploads got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimPendingUploads.ClaimPendingUploadsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimPendingUploads.t.Fatal("No results are set for the RepositoryMock.ClaimPendingUploads")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmClaimPendingUploads.funcClaimPendingUploads != nil {
+		return mmClaimPendingUploads.funcClaimPendingUploads(ctx, limit, staleAfter)
+	}
+	mmClaimPendingUploads.t.Fatalf("Unexpected call to RepositoryMock.ClaimPendingUploads. %v %v %v", ctx, limit, staleAfter)
+	return
+}
+
+// ClaimPendingUploadsAfterCounter returns a count of finished RepositoryMock.ClaimPendingUploads invocations
+func (mmClaimPendingUploads *RepositoryMock) ClaimPendingUploadsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimPendingUploads.afterClaimPendingUploadsCounter)
+}
+
+// ClaimPendingUploadsBeforeCounter returns a count of RepositoryMock.ClaimPendingUploads invocations
+func (mmClaimPendingUploads *RepositoryMock) ClaimPendingUploadsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimPendingUploads.beforeClaimPendingUploadsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ClaimPendingUploads.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimPendingUploads *mRepositoryMockClaimPendingUploads) Calls() []*RepositoryMockClaimPendingUploadsParams {
+	mmClaimPendingUploads.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockClaimPendingUploadsParams, len(mmClaimPendingUploads.callArgs))
+	copy(argCopy, mmClaimPendingUploads.callArgs)
+
+	mmClaimPendingUploads.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimPendingUploadsDone returns true if the count of the ClaimPendingUploads invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockClaimPendingUploadsDone() bool {
+	if m.ClaimPendingUploadsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimPendingUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimPendingUploadsMock.invocationsDone()
+}
+
+// MinimockClaimPendingUploadsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockClaimPendingUploadsInspect() {
+	for _, e := range m.ClaimPendingUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ClaimPendingUploads at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimPendingUploadsCounter := mm_atomic.LoadUint64(&m.afterClaimPendingUploadsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimPendingUploadsMock.defaultExpectation != nil && afterClaimPendingUploadsCounter < 1 {
+		if m.ClaimPendingUploadsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ClaimPendingUploads at\n%s", m.ClaimPendingUploadsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ClaimPendingUploads at\n%s with params: %#v", m.ClaimPendingUploadsMock.defaultExpectation.expectationOrigins.origin, *m.ClaimPendingUploadsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimPendingUploads != nil && afterClaimPendingUploadsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ClaimPendingUploads at\n%s", m.funcClaimPendingUploadsOrigin)
+	}
+
+	if !m.ClaimPendingUploadsMock.invocationsDone() && afterClaimPendingUploadsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ClaimPendingUploads at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimPendingUploadsMock.expectedInvocations), m.ClaimPendingUploadsMock.expectedInvocationsOrigin, afterClaimPendingUploadsCounter)
+	}
+}
+
+type mRepositoryMockCreateUpload struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateUploadExpectation
+	expectations       []*RepositoryMockCreateUploadExpectation
+
+	callArgs []*RepositoryMockCreateUploadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateUploadExpectation specifies expectation struct of the Repository.CreateUpload
+type RepositoryMockCreateUploadExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateUploadParams
+	paramPtrs          *RepositoryMockCreateUploadParamPtrs
+	expectationOrigins RepositoryMockCreateUploadExpectationOrigins
+	results            *RepositoryMockCreateUploadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateUploadParams contains parameters of the Repository.CreateUpload
+type RepositoryMockCreateUploadParams struct {
+	ctx    context.Context
+	upload *entity.Upload
+}
+
+// RepositoryMockCreateUploadParamPtrs contains pointers to parameters of the Repository.CreateUpload
+type RepositoryMockCreateUploadParamPtrs struct {
+	ctx    *context.Context
+	upload **entity.Upload
+}
+
+// RepositoryMockCreateUploadResults contains results of the Repository.CreateUpload
+type RepositoryMockCreateUploadResults struct {
+	up1 *entity.Upload
+	err error
+}
+
+// RepositoryMockCreateUploadOrigins contains origins of expectations of the Repository.CreateUpload
+type RepositoryMockCreateUploadExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpload string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUpload *mRepositoryMockCreateUpload) Optional() *mRepositoryMockCreateUpload {
+	mmCreateUpload.optional = true
+	return mmCreateUpload
+}
+
+// Expect sets up expected params for Repository.CreateUpload
+func (mmCreateUpload *mRepositoryMockCreateUpload) Expect(ctx context.Context, upload *entity.Upload) *mRepositoryMockCreateUpload {
+	if mmCreateUpload.mock.funcCreateUpload != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Set")
+	}
+
+	if mmCreateUpload.defaultExpectation == nil {
+		mmCreateUpload.defaultExpectation = &RepositoryMockCreateUploadExpectation{}
+	}
+
+	if mmCreateUpload.defaultExpectation.paramPtrs != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUpload.defaultExpectation.params = &RepositoryMockCreateUploadParams{ctx, upload}
+	mmCreateUpload.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUpload.expectations {
+		if minimock.Equal(e.params, mmCreateUpload.defaultExpectation.params) {
+			mmCreateUpload.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUpload.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUpload
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateUpload
+func (mmCreateUpload *mRepositoryMockCreateUpload) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateUpload {
+	if mmCreateUpload.mock.funcCreateUpload != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Set")
+	}
+
+	if mmCreateUpload.defaultExpectation == nil {
+		mmCreateUpload.defaultExpectation = &RepositoryMockCreateUploadExpectation{}
+	}
+
+	if mmCreateUpload.defaultExpectation.params != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Expect")
+	}
+
+	if mmCreateUpload.defaultExpectation.paramPtrs == nil {
+		mmCreateUpload.defaultExpectation.paramPtrs = &RepositoryMockCreateUploadParamPtrs{}
+	}
+	mmCreateUpload.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUpload.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUpload
+}
+
+// ExpectUploadParam2 sets up expected param upload for Repository.CreateUpload
+func (mmCreateUpload *mRepositoryMockCreateUpload) ExpectUploadParam2(upload *entity.Upload) *mRepositoryMockCreateUpload {
+	if mmCreateUpload.mock.funcCreateUpload != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Set")
+	}
+
+	if mmCreateUpload.defaultExpectation == nil {
+		mmCreateUpload.defaultExpectation = &RepositoryMockCreateUploadExpectation{}
+	}
+
+	if mmCreateUpload.defaultExpectation.params != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Expect")
+	}
+
+	if mmCreateUpload.defaultExpectation.paramPtrs == nil {
+		mmCreateUpload.defaultExpectation.paramPtrs = &RepositoryMockCreateUploadParamPtrs{}
+	}
+	mmCreateUpload.defaultExpectation.paramPtrs.upload = &upload
+	mmCreateUpload.defaultExpectation.expectationOrigins.originUpload = minimock.CallerInfo(1)
+
+	return mmCreateUpload
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateUpload
+func (mmCreateUpload *mRepositoryMockCreateUpload) Inspect(f func(ctx context.Context, upload *entity.Upload)) *mRepositoryMockCreateUpload {
+	if mmCreateUpload.mock.inspectFuncCreateUpload != nil {
+		mmCreateUpload.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateUpload")
+	}
+
+	mmCreateUpload.mock.inspectFuncCreateUpload = f
+
+	return mmCreateUpload
+}
+
+// Return sets up results that will be returned by Repository.CreateUpload
+func (mmCreateUpload *mRepositoryMockCreateUpload) Return(up1 *entity.Upload, err error) *RepositoryMock {
+	if mmCreateUpload.mock.funcCreateUpload != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Set")
+	}
+
+	if mmCreateUpload.defaultExpectation == nil {
+		mmCreateUpload.defaultExpectation = &RepositoryMockCreateUploadExpectation{mock: mmCreateUpload.mock}
+	}
+	mmCreateUpload.defaultExpectation.results = &RepositoryMockCreateUploadResults{up1, err}
+	mmCreateUpload.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUpload.mock
+}
+
+// Set uses given function f to mock the Repository.CreateUpload method
+func (mmCreateUpload *mRepositoryMockCreateUpload) Set(f func(ctx context.Context, upload *entity.Upload) (up1 *entity.Upload, err error)) *RepositoryMock {
+	if mmCreateUpload.defaultExpectation != nil {
+		mmCreateUpload.mock.t.Fatalf("Default expectation is already set for the Repository.CreateUpload method")
+	}
+
+	if len(mmCreateUpload.expectations) > 0 {
+		mmCreateUpload.mock.t.Fatalf("Some expectations are already set for the Repository.CreateUpload method")
+	}
+
+	mmCreateUpload.mock.funcCreateUpload = f
+	mmCreateUpload.mock.funcCreateUploadOrigin = minimock.CallerInfo(1)
+	return mmCreateUpload.mock
+}
+
+// When sets expectation for the Repository.CreateUpload which will trigger the result defined by the following
+// Then helper
+func (mmCreateUpload *mRepositoryMockCreateUpload) When(ctx context.Context, upload *entity.Upload) *RepositoryMockCreateUploadExpectation {
+	if mmCreateUpload.mock.funcCreateUpload != nil {
+		mmCreateUpload.mock.t.Fatalf("RepositoryMock.CreateUpload mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateUploadExpectation{
+		mock:               mmCreateUpload.mock,
+		params:             &RepositoryMockCreateUploadParams{ctx, upload},
+		expectationOrigins: RepositoryMockCreateUploadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUpload.expectations = append(mmCreateUpload.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreateUpload return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateUploadExpectation) Then(up1 *entity.Upload, err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateUploadResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreateUpload should be invoked
+func (mmCreateUpload *mRepositoryMockCreateUpload) Times(n uint64) *mRepositoryMockCreateUpload {
+	if n == 0 {
+		mmCreateUpload.mock.t.Fatalf("Times of RepositoryMock.CreateUpload mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUpload.expectedInvocations, n)
+	mmCreateUpload.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUpload
+}
+
+func (mmCreateUpload *mRepositoryMockCreateUpload) invocationsDone() bool {
+	if len(mmCreateUpload.expectations) == 0 && mmCreateUpload.defaultExpectation == nil && mmCreateUpload.mock.funcCreateUpload == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUpload.mock.afterCreateUploadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUpload.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUpload implements mm_upload.Repository
+func (mmCreateUpload *RepositoryMock) CreateUpload(ctx context.Context, upload *entity.Upload) (up1 *entity.Upload, err error) {
+	mm_atomic.AddUint64(&mmCreateUpload.beforeCreateUploadCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUpload.afterCreateUploadCounter, 1)
+
+	mmCreateUpload.t.Helper()
+
+	if mmCreateUpload.inspectFuncCreateUpload != nil {
+		mmCreateUpload.inspectFuncCreateUpload(ctx, upload)
+	}
+
+	mm_params := RepositoryMockCreateUploadParams{ctx, upload}
+
+	// Record call args
+	mmCreateUpload.CreateUploadMock.mutex.Lock()
+	mmCreateUpload.CreateUploadMock.callArgs = append(mmCreateUpload.CreateUploadMock.callArgs, &mm_params)
+	mmCreateUpload.CreateUploadMock.mutex.Unlock()
+
+	for _, e := range mmCreateUpload.CreateUploadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmCreateUpload.CreateUploadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUpload.CreateUploadMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUpload.CreateUploadMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUpload.CreateUploadMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateUploadParams{ctx, upload}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUpload.t.Errorf("RepositoryMock.CreateUpload got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUpload.CreateUploadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.upload != nil && !minimock.Equal(*mm_want_ptrs.upload, mm_got.upload) {
+				mmCreateUpload.t.Errorf("RepositoryMock.CreateUpload got unexpected parameter upload, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUpload.CreateUploadMock.defaultExpectation.expectationOrigins.originUpload, *mm_want_ptrs.upload, mm_got.upload, minimock.Diff(*mm_want_ptrs.upload, mm_got.upload))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUpload.t.Errorf("RepositoryMock.CreateUpload got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUpload.CreateUploadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUpload.CreateUploadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUpload.t.Fatal("No results are set for the RepositoryMock.CreateUpload")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmCreateUpload.funcCreateUpload != nil {
+		return mmCreateUpload.funcCreateUpload(ctx, upload)
+	}
+	mmCreateUpload.t.Fatalf("Unexpected call to RepositoryMock.CreateUpload. %v %v", ctx, upload)
+	return
+}
+
+// CreateUploadAfterCounter returns a count of finished RepositoryMock.CreateUpload invocations
+func (mmCreateUpload *RepositoryMock) CreateUploadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUpload.afterCreateUploadCounter)
+}
+
+// CreateUploadBeforeCounter returns a count of RepositoryMock.CreateUpload invocations
+func (mmCreateUpload *RepositoryMock) CreateUploadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUpload.beforeCreateUploadCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateUpload.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUpload *mRepositoryMockCreateUpload) Calls() []*RepositoryMockCreateUploadParams {
+	mmCreateUpload.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateUploadParams, len(mmCreateUpload.callArgs))
+	copy(argCopy, mmCreateUpload.callArgs)
+
+	mmCreateUpload.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUploadDone returns true if the count of the CreateUpload invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateUploadDone() bool {
+	if m.CreateUploadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUploadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUploadMock.invocationsDone()
+}
+
+// MinimockCreateUploadInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateUploadInspect() {
+	for _, e := range m.CreateUploadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreateUpload at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUploadCounter := mm_atomic.LoadUint64(&m.afterCreateUploadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUploadMock.defaultExpectation != nil && afterCreateUploadCounter < 1 {
+		if m.CreateUploadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateUpload at\n%s", m.CreateUploadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreateUpload at\n%s with params: %#v", m.CreateUploadMock.defaultExpectation.expectationOrigins.origin, *m.CreateUploadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUpload != nil && afterCreateUploadCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateUpload at\n%s", m.funcCreateUploadOrigin)
+	}
+
+	if !m.CreateUploadMock.invocationsDone() && afterCreateUploadCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateUpload at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUploadMock.expectedInvocations), m.CreateUploadMock.expectedInvocationsOrigin, afterCreateUploadCounter)
+	}
+}
+
+type mRepositoryMockFailUploadVariants struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockFailUploadVariantsExpectation
+	expectations       []*RepositoryMockFailUploadVariantsExpectation
+
+	callArgs []*RepositoryMockFailUploadVariantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockFailUploadVariantsExpectation specifies expectation struct of the Repository.FailUploadVariants
+type RepositoryMockFailUploadVariantsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockFailUploadVariantsParams
+	paramPtrs          *RepositoryMockFailUploadVariantsParamPtrs
+	expectationOrigins RepositoryMockFailUploadVariantsExpectationOrigins
+	results            *RepositoryMockFailUploadVariantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockFailUploadVariantsParams contains parameters of the Repository.FailUploadVariants
+type RepositoryMockFailUploadVariantsParams struct {
+	ctx         context.Context
+	uploadID    uint64
+	permanent   bool
+	maxAttempts int
+}
+
+// RepositoryMockFailUploadVariantsParamPtrs contains pointers to parameters of the Repository.FailUploadVariants
+type RepositoryMockFailUploadVariantsParamPtrs struct {
+	ctx         *context.Context
+	uploadID    *uint64
+	permanent   *bool
+	maxAttempts *int
+}
+
+// RepositoryMockFailUploadVariantsResults contains results of the Repository.FailUploadVariants
+type RepositoryMockFailUploadVariantsResults struct {
+	err error
+}
+
+// RepositoryMockFailUploadVariantsOrigins contains origins of expectations of the Repository.FailUploadVariants
+type RepositoryMockFailUploadVariantsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUploadID    string
+	originPermanent   string
+	originMaxAttempts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Optional() *mRepositoryMockFailUploadVariants {
+	mmFailUploadVariants.optional = true
+	return mmFailUploadVariants
+}
+
+// Expect sets up expected params for Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Expect(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) *mRepositoryMockFailUploadVariants {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	if mmFailUploadVariants.defaultExpectation == nil {
+		mmFailUploadVariants.defaultExpectation = &RepositoryMockFailUploadVariantsExpectation{}
+	}
+
+	if mmFailUploadVariants.defaultExpectation.paramPtrs != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by ExpectParams functions")
+	}
+
+	mmFailUploadVariants.defaultExpectation.params = &RepositoryMockFailUploadVariantsParams{ctx, uploadID, permanent, maxAttempts}
+	mmFailUploadVariants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFailUploadVariants.expectations {
+		if minimock.Equal(e.params, mmFailUploadVariants.defaultExpectation.params) {
+			mmFailUploadVariants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFailUploadVariants.defaultExpectation.params)
+		}
+	}
+
+	return mmFailUploadVariants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) ExpectCtxParam1(ctx context.Context) *mRepositoryMockFailUploadVariants {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	if mmFailUploadVariants.defaultExpectation == nil {
+		mmFailUploadVariants.defaultExpectation = &RepositoryMockFailUploadVariantsExpectation{}
+	}
+
+	if mmFailUploadVariants.defaultExpectation.params != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Expect")
+	}
+
+	if mmFailUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmFailUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockFailUploadVariantsParamPtrs{}
+	}
+	mmFailUploadVariants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFailUploadVariants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFailUploadVariants
+}
+
+// ExpectUploadIDParam2 sets up expected param uploadID for Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) ExpectUploadIDParam2(uploadID uint64) *mRepositoryMockFailUploadVariants {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	if mmFailUploadVariants.defaultExpectation == nil {
+		mmFailUploadVariants.defaultExpectation = &RepositoryMockFailUploadVariantsExpectation{}
+	}
+
+	if mmFailUploadVariants.defaultExpectation.params != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Expect")
+	}
+
+	if mmFailUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmFailUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockFailUploadVariantsParamPtrs{}
+	}
+	mmFailUploadVariants.defaultExpectation.paramPtrs.uploadID = &uploadID
+	mmFailUploadVariants.defaultExpectation.expectationOrigins.originUploadID = minimock.CallerInfo(1)
+
+	return mmFailUploadVariants
+}
+
+// ExpectPermanentParam3 sets up expected param permanent for Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) ExpectPermanentParam3(permanent bool) *mRepositoryMockFailUploadVariants {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	if mmFailUploadVariants.defaultExpectation == nil {
+		mmFailUploadVariants.defaultExpectation = &RepositoryMockFailUploadVariantsExpectation{}
+	}
+
+	if mmFailUploadVariants.defaultExpectation.params != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Expect")
+	}
+
+	if mmFailUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmFailUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockFailUploadVariantsParamPtrs{}
+	}
+	mmFailUploadVariants.defaultExpectation.paramPtrs.permanent = &permanent
+	mmFailUploadVariants.defaultExpectation.expectationOrigins.originPermanent = minimock.CallerInfo(1)
+
+	return mmFailUploadVariants
+}
+
+// ExpectMaxAttemptsParam4 sets up expected param maxAttempts for Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) ExpectMaxAttemptsParam4(maxAttempts int) *mRepositoryMockFailUploadVariants {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	if mmFailUploadVariants.defaultExpectation == nil {
+		mmFailUploadVariants.defaultExpectation = &RepositoryMockFailUploadVariantsExpectation{}
+	}
+
+	if mmFailUploadVariants.defaultExpectation.params != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Expect")
+	}
+
+	if mmFailUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmFailUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockFailUploadVariantsParamPtrs{}
+	}
+	mmFailUploadVariants.defaultExpectation.paramPtrs.maxAttempts = &maxAttempts
+	mmFailUploadVariants.defaultExpectation.expectationOrigins.originMaxAttempts = minimock.CallerInfo(1)
+
+	return mmFailUploadVariants
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Inspect(f func(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int)) *mRepositoryMockFailUploadVariants {
+	if mmFailUploadVariants.mock.inspectFuncFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("Inspect function is already set for RepositoryMock.FailUploadVariants")
+	}
+
+	mmFailUploadVariants.mock.inspectFuncFailUploadVariants = f
+
+	return mmFailUploadVariants
+}
+
+// Return sets up results that will be returned by Repository.FailUploadVariants
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Return(err error) *RepositoryMock {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	if mmFailUploadVariants.defaultExpectation == nil {
+		mmFailUploadVariants.defaultExpectation = &RepositoryMockFailUploadVariantsExpectation{mock: mmFailUploadVariants.mock}
+	}
+	mmFailUploadVariants.defaultExpectation.results = &RepositoryMockFailUploadVariantsResults{err}
+	mmFailUploadVariants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFailUploadVariants.mock
+}
+
+// Set uses given function f to mock the Repository.FailUploadVariants method
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Set(f func(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) (err error)) *RepositoryMock {
+	if mmFailUploadVariants.defaultExpectation != nil {
+		mmFailUploadVariants.mock.t.Fatalf("Default expectation is already set for the Repository.FailUploadVariants method")
+	}
+
+	if len(mmFailUploadVariants.expectations) > 0 {
+		mmFailUploadVariants.mock.t.Fatalf("Some expectations are already set for the Repository.FailUploadVariants method")
+	}
+
+	mmFailUploadVariants.mock.funcFailUploadVariants = f
+	mmFailUploadVariants.mock.funcFailUploadVariantsOrigin = minimock.CallerInfo(1)
+	return mmFailUploadVariants.mock
+}
+
+// When sets expectation for the Repository.FailUploadVariants which will trigger the result defined by the following
+// Then helper
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) When(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) *RepositoryMockFailUploadVariantsExpectation {
+	if mmFailUploadVariants.mock.funcFailUploadVariants != nil {
+		mmFailUploadVariants.mock.t.Fatalf("RepositoryMock.FailUploadVariants mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockFailUploadVariantsExpectation{
+		mock:               mmFailUploadVariants.mock,
+		params:             &RepositoryMockFailUploadVariantsParams{ctx, uploadID, permanent, maxAttempts},
+		expectationOrigins: RepositoryMockFailUploadVariantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFailUploadVariants.expectations = append(mmFailUploadVariants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.FailUploadVariants return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockFailUploadVariantsExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockFailUploadVariantsResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.FailUploadVariants should be invoked
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Times(n uint64) *mRepositoryMockFailUploadVariants {
+	if n == 0 {
+		mmFailUploadVariants.mock.t.Fatalf("Times of RepositoryMock.FailUploadVariants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFailUploadVariants.expectedInvocations, n)
+	mmFailUploadVariants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFailUploadVariants
+}
+
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) invocationsDone() bool {
+	if len(mmFailUploadVariants.expectations) == 0 && mmFailUploadVariants.defaultExpectation == nil && mmFailUploadVariants.mock.funcFailUploadVariants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFailUploadVariants.mock.afterFailUploadVariantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFailUploadVariants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FailUploadVariants implements mm_upload.Repository
+func (mmFailUploadVariants *RepositoryMock) FailUploadVariants(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) (err error) {
+	mm_atomic.AddUint64(&mmFailUploadVariants.beforeFailUploadVariantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFailUploadVariants.afterFailUploadVariantsCounter, 1)
+
+	mmFailUploadVariants.t.Helper()
+
+	if mmFailUploadVariants.inspectFuncFailUploadVariants != nil {
+		mmFailUploadVariants.inspectFuncFailUploadVariants(ctx, uploadID, permanent, maxAttempts)
+	}
+
+	mm_params := RepositoryMockFailUploadVariantsParams{ctx, uploadID, permanent, maxAttempts}
+
+	// Record call args
+	mmFailUploadVariants.FailUploadVariantsMock.mutex.Lock()
+	mmFailUploadVariants.FailUploadVariantsMock.callArgs = append(mmFailUploadVariants.FailUploadVariantsMock.callArgs, &mm_params)
+	mmFailUploadVariants.FailUploadVariantsMock.mutex.Unlock()
+
+	for _, e := range mmFailUploadVariants.FailUploadVariantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.params
+		mm_want_ptrs := mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockFailUploadVariantsParams{ctx, uploadID, permanent, maxAttempts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFailUploadVariants.t.Errorf("RepositoryMock.FailUploadVariants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.uploadID != nil && !minimock.Equal(*mm_want_ptrs.uploadID, mm_got.uploadID) {
+				mmFailUploadVariants.t.Errorf("RepositoryMock.FailUploadVariants got unexpected parameter uploadID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.expectationOrigins.originUploadID, *mm_want_ptrs.uploadID, mm_got.uploadID, minimock.Diff(*mm_want_ptrs.uploadID, mm_got.uploadID))
+			}
+
+			if mm_want_ptrs.permanent != nil && !minimock.Equal(*mm_want_ptrs.permanent, mm_got.permanent) {
+				mmFailUploadVariants.t.Errorf("RepositoryMock.FailUploadVariants got unexpected parameter permanent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.expectationOrigins.originPermanent, *mm_want_ptrs.permanent, mm_got.permanent, minimock.Diff(*mm_want_ptrs.permanent, mm_got.permanent))
+			}
+
+			if mm_want_ptrs.maxAttempts != nil && !minimock.Equal(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts) {
+				mmFailUploadVariants.t.Errorf("RepositoryMock.FailUploadVariants got unexpected parameter maxAttempts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.expectationOrigins.originMaxAttempts, *mm_want_ptrs.maxAttempts, mm_got.maxAttempts, minimock.Diff(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFailUploadVariants.t.Errorf("RepositoryMock.FailUploadVariants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFailUploadVariants.FailUploadVariantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFailUploadVariants.t.Fatal("No results are set for the RepositoryMock.FailUploadVariants")
+		}
+		return (*mm_results).err
+	}
+	if mmFailUploadVariants.funcFailUploadVariants != nil {
+		return mmFailUploadVariants.funcFailUploadVariants(ctx, uploadID, permanent, maxAttempts)
+	}
+	mmFailUploadVariants.t.Fatalf("Unexpected call to RepositoryMock.FailUploadVariants. %v %v %v %v", ctx, uploadID, permanent, maxAttempts)
+	return
+}
+
+// FailUploadVariantsAfterCounter returns a count of finished RepositoryMock.FailUploadVariants invocations
+func (mmFailUploadVariants *RepositoryMock) FailUploadVariantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFailUploadVariants.afterFailUploadVariantsCounter)
+}
+
+// FailUploadVariantsBeforeCounter returns a count of RepositoryMock.FailUploadVariants invocations
+func (mmFailUploadVariants *RepositoryMock) FailUploadVariantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFailUploadVariants.beforeFailUploadVariantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.FailUploadVariants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFailUploadVariants *mRepositoryMockFailUploadVariants) Calls() []*RepositoryMockFailUploadVariantsParams {
+	mmFailUploadVariants.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockFailUploadVariantsParams, len(mmFailUploadVariants.callArgs))
+	copy(argCopy, mmFailUploadVariants.callArgs)
+
+	mmFailUploadVariants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFailUploadVariantsDone returns true if the count of the FailUploadVariants invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockFailUploadVariantsDone() bool {
+	if m.FailUploadVariantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FailUploadVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FailUploadVariantsMock.invocationsDone()
+}
+
+// MinimockFailUploadVariantsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockFailUploadVariantsInspect() {
+	for _, e := range m.FailUploadVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.FailUploadVariants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFailUploadVariantsCounter := mm_atomic.LoadUint64(&m.afterFailUploadVariantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FailUploadVariantsMock.defaultExpectation != nil && afterFailUploadVariantsCounter < 1 {
+		if m.FailUploadVariantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.FailUploadVariants at\n%s", m.FailUploadVariantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.FailUploadVariants at\n%s with params: %#v", m.FailUploadVariantsMock.defaultExpectation.expectationOrigins.origin, *m.FailUploadVariantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFailUploadVariants != nil && afterFailUploadVariantsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.FailUploadVariants at\n%s", m.funcFailUploadVariantsOrigin)
+	}
+
+	if !m.FailUploadVariantsMock.invocationsDone() && afterFailUploadVariantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.FailUploadVariants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FailUploadVariantsMock.expectedInvocations), m.FailUploadVariantsMock.expectedInvocationsOrigin, afterFailUploadVariantsCounter)
+	}
+}
+
+type mRepositoryMockGetUploadByStorageKey struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetUploadByStorageKeyExpectation
+	expectations       []*RepositoryMockGetUploadByStorageKeyExpectation
+
+	callArgs []*RepositoryMockGetUploadByStorageKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetUploadByStorageKeyExpectation specifies expectation struct of the Repository.GetUploadByStorageKey
+type RepositoryMockGetUploadByStorageKeyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetUploadByStorageKeyParams
+	paramPtrs          *RepositoryMockGetUploadByStorageKeyParamPtrs
+	expectationOrigins RepositoryMockGetUploadByStorageKeyExpectationOrigins
+	results            *RepositoryMockGetUploadByStorageKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetUploadByStorageKeyParams contains parameters of the Repository.GetUploadByStorageKey
+type RepositoryMockGetUploadByStorageKeyParams struct {
+	ctx context.Context
+	key string
+}
+
+// RepositoryMockGetUploadByStorageKeyParamPtrs contains pointers to parameters of the Repository.GetUploadByStorageKey
+type RepositoryMockGetUploadByStorageKeyParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RepositoryMockGetUploadByStorageKeyResults contains results of the Repository.GetUploadByStorageKey
+type RepositoryMockGetUploadByStorageKeyResults struct {
+	up1 *entity.Upload
+	err error
+}
+
+// RepositoryMockGetUploadByStorageKeyOrigins contains origins of expectations of the Repository.GetUploadByStorageKey
+type RepositoryMockGetUploadByStorageKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Optional() *mRepositoryMockGetUploadByStorageKey {
+	mmGetUploadByStorageKey.optional = true
+	return mmGetUploadByStorageKey
+}
+
+// Expect sets up expected params for Repository.GetUploadByStorageKey
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Expect(ctx context.Context, key string) *mRepositoryMockGetUploadByStorageKey {
+	if mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Set")
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation == nil {
+		mmGetUploadByStorageKey.defaultExpectation = &RepositoryMockGetUploadByStorageKeyExpectation{}
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation.paramPtrs != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by ExpectParams functions")
+	}
+
+	mmGetUploadByStorageKey.defaultExpectation.params = &RepositoryMockGetUploadByStorageKeyParams{ctx, key}
+	mmGetUploadByStorageKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUploadByStorageKey.expectations {
+		if minimock.Equal(e.params, mmGetUploadByStorageKey.defaultExpectation.params) {
+			mmGetUploadByStorageKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUploadByStorageKey.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUploadByStorageKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetUploadByStorageKey
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetUploadByStorageKey {
+	if mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Set")
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation == nil {
+		mmGetUploadByStorageKey.defaultExpectation = &RepositoryMockGetUploadByStorageKeyExpectation{}
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation.params != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Expect")
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation.paramPtrs == nil {
+		mmGetUploadByStorageKey.defaultExpectation.paramPtrs = &RepositoryMockGetUploadByStorageKeyParamPtrs{}
+	}
+	mmGetUploadByStorageKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUploadByStorageKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUploadByStorageKey
+}
+
+// ExpectKeyParam2 sets up expected param key for Repository.GetUploadByStorageKey
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) ExpectKeyParam2(key string) *mRepositoryMockGetUploadByStorageKey {
+	if mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Set")
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation == nil {
+		mmGetUploadByStorageKey.defaultExpectation = &RepositoryMockGetUploadByStorageKeyExpectation{}
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation.params != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Expect")
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation.paramPtrs == nil {
+		mmGetUploadByStorageKey.defaultExpectation.paramPtrs = &RepositoryMockGetUploadByStorageKeyParamPtrs{}
+	}
+	mmGetUploadByStorageKey.defaultExpectation.paramPtrs.key = &key
+	mmGetUploadByStorageKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGetUploadByStorageKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetUploadByStorageKey
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Inspect(f func(ctx context.Context, key string)) *mRepositoryMockGetUploadByStorageKey {
+	if mmGetUploadByStorageKey.mock.inspectFuncGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetUploadByStorageKey")
+	}
+
+	mmGetUploadByStorageKey.mock.inspectFuncGetUploadByStorageKey = f
+
+	return mmGetUploadByStorageKey
+}
+
+// Return sets up results that will be returned by Repository.GetUploadByStorageKey
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Return(up1 *entity.Upload, err error) *RepositoryMock {
+	if mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Set")
+	}
+
+	if mmGetUploadByStorageKey.defaultExpectation == nil {
+		mmGetUploadByStorageKey.defaultExpectation = &RepositoryMockGetUploadByStorageKeyExpectation{mock: mmGetUploadByStorageKey.mock}
+	}
+	mmGetUploadByStorageKey.defaultExpectation.results = &RepositoryMockGetUploadByStorageKeyResults{up1, err}
+	mmGetUploadByStorageKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUploadByStorageKey.mock
+}
+
+// Set uses given function f to mock the Repository.GetUploadByStorageKey method
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Set(f func(ctx context.Context, key string) (up1 *entity.Upload, err error)) *RepositoryMock {
+	if mmGetUploadByStorageKey.defaultExpectation != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("Default expectation is already set for the Repository.GetUploadByStorageKey method")
+	}
+
+	if len(mmGetUploadByStorageKey.expectations) > 0 {
+		mmGetUploadByStorageKey.mock.t.Fatalf("Some expectations are already set for the Repository.GetUploadByStorageKey method")
+	}
+
+	mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey = f
+	mmGetUploadByStorageKey.mock.funcGetUploadByStorageKeyOrigin = minimock.CallerInfo(1)
+	return mmGetUploadByStorageKey.mock
+}
+
+// When sets expectation for the Repository.GetUploadByStorageKey which will trigger the result defined by the following
+// Then helper
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) When(ctx context.Context, key string) *RepositoryMockGetUploadByStorageKeyExpectation {
+	if mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.mock.t.Fatalf("RepositoryMock.GetUploadByStorageKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetUploadByStorageKeyExpectation{
+		mock:               mmGetUploadByStorageKey.mock,
+		params:             &RepositoryMockGetUploadByStorageKeyParams{ctx, key},
+		expectationOrigins: RepositoryMockGetUploadByStorageKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUploadByStorageKey.expectations = append(mmGetUploadByStorageKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetUploadByStorageKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetUploadByStorageKeyExpectation) Then(up1 *entity.Upload, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetUploadByStorageKeyResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetUploadByStorageKey should be invoked
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Times(n uint64) *mRepositoryMockGetUploadByStorageKey {
+	if n == 0 {
+		mmGetUploadByStorageKey.mock.t.Fatalf("Times of RepositoryMock.GetUploadByStorageKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUploadByStorageKey.expectedInvocations, n)
+	mmGetUploadByStorageKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUploadByStorageKey
+}
+
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) invocationsDone() bool {
+	if len(mmGetUploadByStorageKey.expectations) == 0 && mmGetUploadByStorageKey.defaultExpectation == nil && mmGetUploadByStorageKey.mock.funcGetUploadByStorageKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUploadByStorageKey.mock.afterGetUploadByStorageKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUploadByStorageKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUploadByStorageKey implements mm_upload.Repository
+func (mmGetUploadByStorageKey *RepositoryMock) GetUploadByStorageKey(ctx context.Context, key string) (up1 *entity.Upload, err error) {
+	mm_atomic.AddUint64(&mmGetUploadByStorageKey.beforeGetUploadByStorageKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUploadByStorageKey.afterGetUploadByStorageKeyCounter, 1)
+
+	mmGetUploadByStorageKey.t.Helper()
+
+	if mmGetUploadByStorageKey.inspectFuncGetUploadByStorageKey != nil {
+		mmGetUploadByStorageKey.inspectFuncGetUploadByStorageKey(ctx, key)
+	}
+
+	mm_params := RepositoryMockGetUploadByStorageKeyParams{ctx, key}
+
+	// Record call args
+	mmGetUploadByStorageKey.GetUploadByStorageKeyMock.mutex.Lock()
+	mmGetUploadByStorageKey.GetUploadByStorageKeyMock.callArgs = append(mmGetUploadByStorageKey.GetUploadByStorageKeyMock.callArgs, &mm_params)
+	mmGetUploadByStorageKey.GetUploadByStorageKeyMock.mutex.Unlock()
+
+	for _, e := range mmGetUploadByStorageKey.GetUploadByStorageKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetUploadByStorageKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUploadByStorageKey.t.Errorf("RepositoryMock.GetUploadByStorageKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGetUploadByStorageKey.t.Errorf("RepositoryMock.GetUploadByStorageKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUploadByStorageKey.t.Errorf("RepositoryMock.GetUploadByStorageKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUploadByStorageKey.GetUploadByStorageKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUploadByStorageKey.t.Fatal("No results are set for the RepositoryMock.GetUploadByStorageKey")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUploadByStorageKey.funcGetUploadByStorageKey != nil {
+		return mmGetUploadByStorageKey.funcGetUploadByStorageKey(ctx, key)
+	}
+	mmGetUploadByStorageKey.t.Fatalf("Unexpected call to RepositoryMock.GetUploadByStorageKey. %v %v", ctx, key)
+	return
+}
+
+// GetUploadByStorageKeyAfterCounter returns a count of finished RepositoryMock.GetUploadByStorageKey invocations
+func (mmGetUploadByStorageKey *RepositoryMock) GetUploadByStorageKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUploadByStorageKey.afterGetUploadByStorageKeyCounter)
+}
+
+// GetUploadByStorageKeyBeforeCounter returns a count of RepositoryMock.GetUploadByStorageKey invocations
+func (mmGetUploadByStorageKey *RepositoryMock) GetUploadByStorageKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUploadByStorageKey.beforeGetUploadByStorageKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetUploadByStorageKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUploadByStorageKey *mRepositoryMockGetUploadByStorageKey) Calls() []*RepositoryMockGetUploadByStorageKeyParams {
+	mmGetUploadByStorageKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetUploadByStorageKeyParams, len(mmGetUploadByStorageKey.callArgs))
+	copy(argCopy, mmGetUploadByStorageKey.callArgs)
+
+	mmGetUploadByStorageKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUploadByStorageKeyDone returns true if the count of the GetUploadByStorageKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetUploadByStorageKeyDone() bool {
+	if m.GetUploadByStorageKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUploadByStorageKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUploadByStorageKeyMock.invocationsDone()
+}
+
+// MinimockGetUploadByStorageKeyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetUploadByStorageKeyInspect() {
+	for _, e := range m.GetUploadByStorageKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetUploadByStorageKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUploadByStorageKeyCounter := mm_atomic.LoadUint64(&m.afterGetUploadByStorageKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUploadByStorageKeyMock.defaultExpectation != nil && afterGetUploadByStorageKeyCounter < 1 {
+		if m.GetUploadByStorageKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetUploadByStorageKey at\n%s", m.GetUploadByStorageKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetUploadByStorageKey at\n%s with params: %#v", m.GetUploadByStorageKeyMock.defaultExpectation.expectationOrigins.origin, *m.GetUploadByStorageKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUploadByStorageKey != nil && afterGetUploadByStorageKeyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetUploadByStorageKey at\n%s", m.funcGetUploadByStorageKeyOrigin)
+	}
+
+	if !m.GetUploadByStorageKeyMock.invocationsDone() && afterGetUploadByStorageKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetUploadByStorageKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUploadByStorageKeyMock.expectedInvocations), m.GetUploadByStorageKeyMock.expectedInvocationsOrigin, afterGetUploadByStorageKeyCounter)
+	}
+}
+
+type mRepositoryMockSaveUploadVariants struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSaveUploadVariantsExpectation
+	expectations       []*RepositoryMockSaveUploadVariantsExpectation
+
+	callArgs []*RepositoryMockSaveUploadVariantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSaveUploadVariantsExpectation specifies expectation struct of the Repository.SaveUploadVariants
+type RepositoryMockSaveUploadVariantsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSaveUploadVariantsParams
+	paramPtrs          *RepositoryMockSaveUploadVariantsParamPtrs
+	expectationOrigins RepositoryMockSaveUploadVariantsExpectationOrigins
+	results            *RepositoryMockSaveUploadVariantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSaveUploadVariantsParams contains parameters of the Repository.SaveUploadVariants
+type RepositoryMockSaveUploadVariantsParams struct {
+	ctx      context.Context
+	uploadID uint64
+	variants []*entity.ImageVariant
+}
+
+// RepositoryMockSaveUploadVariantsParamPtrs contains pointers to parameters of the Repository.SaveUploadVariants
+type RepositoryMockSaveUploadVariantsParamPtrs struct {
+	ctx      *context.Context
+	uploadID *uint64
+	variants *[]*entity.ImageVariant
+}
+
+// RepositoryMockSaveUploadVariantsResults contains results of the Repository.SaveUploadVariants
+type RepositoryMockSaveUploadVariantsResults struct {
+	err error
+}
+
+// RepositoryMockSaveUploadVariantsOrigins contains origins of expectations of the Repository.SaveUploadVariants
+type RepositoryMockSaveUploadVariantsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUploadID string
+	originVariants string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Optional() *mRepositoryMockSaveUploadVariants {
+	mmSaveUploadVariants.optional = true
+	return mmSaveUploadVariants
+}
+
+// Expect sets up expected params for Repository.SaveUploadVariants
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Expect(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) *mRepositoryMockSaveUploadVariants {
+	if mmSaveUploadVariants.mock.funcSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Set")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation == nil {
+		mmSaveUploadVariants.defaultExpectation = &RepositoryMockSaveUploadVariantsExpectation{}
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.paramPtrs != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by ExpectParams functions")
+	}
+
+	mmSaveUploadVariants.defaultExpectation.params = &RepositoryMockSaveUploadVariantsParams{ctx, uploadID, variants}
+	mmSaveUploadVariants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveUploadVariants.expectations {
+		if minimock.Equal(e.params, mmSaveUploadVariants.defaultExpectation.params) {
+			mmSaveUploadVariants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveUploadVariants.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveUploadVariants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SaveUploadVariants
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSaveUploadVariants {
+	if mmSaveUploadVariants.mock.funcSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Set")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation == nil {
+		mmSaveUploadVariants.defaultExpectation = &RepositoryMockSaveUploadVariantsExpectation{}
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.params != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Expect")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmSaveUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockSaveUploadVariantsParamPtrs{}
+	}
+	mmSaveUploadVariants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveUploadVariants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveUploadVariants
+}
+
+// ExpectUploadIDParam2 sets up expected param uploadID for Repository.SaveUploadVariants
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) ExpectUploadIDParam2(uploadID uint64) *mRepositoryMockSaveUploadVariants {
+	if mmSaveUploadVariants.mock.funcSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Set")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation == nil {
+		mmSaveUploadVariants.defaultExpectation = &RepositoryMockSaveUploadVariantsExpectation{}
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.params != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Expect")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmSaveUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockSaveUploadVariantsParamPtrs{}
+	}
+	mmSaveUploadVariants.defaultExpectation.paramPtrs.uploadID = &uploadID
+	mmSaveUploadVariants.defaultExpectation.expectationOrigins.originUploadID = minimock.CallerInfo(1)
+
+	return mmSaveUploadVariants
+}
+
+// ExpectVariantsParam3 sets up expected param variants for Repository.SaveUploadVariants
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) ExpectVariantsParam3(variants []*entity.ImageVariant) *mRepositoryMockSaveUploadVariants {
+	if mmSaveUploadVariants.mock.funcSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Set")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation == nil {
+		mmSaveUploadVariants.defaultExpectation = &RepositoryMockSaveUploadVariantsExpectation{}
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.params != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Expect")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation.paramPtrs == nil {
+		mmSaveUploadVariants.defaultExpectation.paramPtrs = &RepositoryMockSaveUploadVariantsParamPtrs{}
+	}
+	mmSaveUploadVariants.defaultExpectation.paramPtrs.variants = &variants
+	mmSaveUploadVariants.defaultExpectation.expectationOrigins.originVariants = minimock.CallerInfo(1)
+
+	return mmSaveUploadVariants
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SaveUploadVariants
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Inspect(f func(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant)) *mRepositoryMockSaveUploadVariants {
+	if mmSaveUploadVariants.mock.inspectFuncSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SaveUploadVariants")
+	}
+
+	mmSaveUploadVariants.mock.inspectFuncSaveUploadVariants = f
+
+	return mmSaveUploadVariants
+}
+
+// Return sets up results that will be returned by Repository.SaveUploadVariants
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Return(err error) *RepositoryMock {
+	if mmSaveUploadVariants.mock.funcSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Set")
+	}
+
+	if mmSaveUploadVariants.defaultExpectation == nil {
+		mmSaveUploadVariants.defaultExpectation = &RepositoryMockSaveUploadVariantsExpectation{mock: mmSaveUploadVariants.mock}
+	}
+	mmSaveUploadVariants.defaultExpectation.results = &RepositoryMockSaveUploadVariantsResults{err}
+	mmSaveUploadVariants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveUploadVariants.mock
+}
+
+// Set uses given function f to mock the Repository.SaveUploadVariants method
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Set(f func(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) (err error)) *RepositoryMock {
+	if mmSaveUploadVariants.defaultExpectation != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("Default expectation is already set for the Repository.SaveUploadVariants method")
+	}
+
+	if len(mmSaveUploadVariants.expectations) > 0 {
+		mmSaveUploadVariants.mock.t.Fatalf("Some expectations are already set for the Repository.SaveUploadVariants method")
+	}
+
+	mmSaveUploadVariants.mock.funcSaveUploadVariants = f
+	mmSaveUploadVariants.mock.funcSaveUploadVariantsOrigin = minimock.CallerInfo(1)
+	return mmSaveUploadVariants.mock
+}
+
+// When sets expectation for the Repository.SaveUploadVariants which will trigger the result defined by the following
+// Then helper
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) When(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) *RepositoryMockSaveUploadVariantsExpectation {
+	if mmSaveUploadVariants.mock.funcSaveUploadVariants != nil {
+		mmSaveUploadVariants.mock.t.Fatalf("RepositoryMock.SaveUploadVariants mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSaveUploadVariantsExpectation{
+		mock:               mmSaveUploadVariants.mock,
+		params:             &RepositoryMockSaveUploadVariantsParams{ctx, uploadID, variants},
+		expectationOrigins: RepositoryMockSaveUploadVariantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveUploadVariants.expectations = append(mmSaveUploadVariants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SaveUploadVariants return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSaveUploadVariantsExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSaveUploadVariantsResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.SaveUploadVariants should be invoked
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Times(n uint64) *mRepositoryMockSaveUploadVariants {
+	if n == 0 {
+		mmSaveUploadVariants.mock.t.Fatalf("Times of RepositoryMock.SaveUploadVariants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveUploadVariants.expectedInvocations, n)
+	mmSaveUploadVariants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveUploadVariants
+}
+
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) invocationsDone() bool {
+	if len(mmSaveUploadVariants.expectations) == 0 && mmSaveUploadVariants.defaultExpectation == nil && mmSaveUploadVariants.mock.funcSaveUploadVariants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveUploadVariants.mock.afterSaveUploadVariantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveUploadVariants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveUploadVariants implements mm_upload.Repository
+func (mmSaveUploadVariants *RepositoryMock) SaveUploadVariants(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) (err error) {
+	mm_atomic.AddUint64(&mmSaveUploadVariants.beforeSaveUploadVariantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveUploadVariants.afterSaveUploadVariantsCounter, 1)
+
+	mmSaveUploadVariants.t.Helper()
+
+	if mmSaveUploadVariants.inspectFuncSaveUploadVariants != nil {
+		mmSaveUploadVariants.inspectFuncSaveUploadVariants(ctx, uploadID, variants)
+	}
+
+	mm_params := RepositoryMockSaveUploadVariantsParams{ctx, uploadID, variants}
+
+	// Record call args
+	mmSaveUploadVariants.SaveUploadVariantsMock.mutex.Lock()
+	mmSaveUploadVariants.SaveUploadVariantsMock.callArgs = append(mmSaveUploadVariants.SaveUploadVariantsMock.callArgs, &mm_params)
+	mmSaveUploadVariants.SaveUploadVariantsMock.mutex.Unlock()
+
+	for _, e := range mmSaveUploadVariants.SaveUploadVariantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSaveUploadVariantsParams{ctx, uploadID, variants}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveUploadVariants.t.Errorf("RepositoryMock.SaveUploadVariants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.uploadID != nil && !minimock.Equal(*mm_want_ptrs.uploadID, mm_got.uploadID) {
+				mmSaveUploadVariants.t.Errorf("RepositoryMock.SaveUploadVariants got unexpected parameter uploadID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.expectationOrigins.originUploadID, *mm_want_ptrs.uploadID, mm_got.uploadID, minimock.Diff(*mm_want_ptrs.uploadID, mm_got.uploadID))
+			}
+
+			if mm_want_ptrs.variants != nil && !minimock.Equal(*mm_want_ptrs.variants, mm_got.variants) {
+				mmSaveUploadVariants.t.Errorf("RepositoryMock.SaveUploadVariants got unexpected parameter variants, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.expectationOrigins.originVariants, *mm_want_ptrs.variants, mm_got.variants, minimock.Diff(*mm_want_ptrs.variants, mm_got.variants))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveUploadVariants.t.Errorf("RepositoryMock.SaveUploadVariants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveUploadVariants.SaveUploadVariantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveUploadVariants.t.Fatal("No results are set for the RepositoryMock.SaveUploadVariants")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveUploadVariants.funcSaveUploadVariants != nil {
+		return mmSaveUploadVariants.funcSaveUploadVariants(ctx, uploadID, variants)
+	}
+	mmSaveUploadVariants.t.Fatalf("Unexpected call to RepositoryMock.SaveUploadVariants. %v %v %v", ctx, uploadID, variants)
+	return
+}
+
+// SaveUploadVariantsAfterCounter returns a count of finished RepositoryMock.SaveUploadVariants invocations
+func (mmSaveUploadVariants *RepositoryMock) SaveUploadVariantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveUploadVariants.afterSaveUploadVariantsCounter)
+}
+
+// SaveUploadVariantsBeforeCounter returns a count of RepositoryMock.SaveUploadVariants invocations
+func (mmSaveUploadVariants *RepositoryMock) SaveUploadVariantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveUploadVariants.beforeSaveUploadVariantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SaveUploadVariants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveUploadVariants *mRepositoryMockSaveUploadVariants) Calls() []*RepositoryMockSaveUploadVariantsParams {
+	mmSaveUploadVariants.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSaveUploadVariantsParams, len(mmSaveUploadVariants.callArgs))
+	copy(argCopy, mmSaveUploadVariants.callArgs)
+
+	mmSaveUploadVariants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveUploadVariantsDone returns true if the count of the SaveUploadVariants invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSaveUploadVariantsDone() bool {
+	if m.SaveUploadVariantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveUploadVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveUploadVariantsMock.invocationsDone()
+}
+
+// MinimockSaveUploadVariantsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSaveUploadVariantsInspect() {
+	for _, e := range m.SaveUploadVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SaveUploadVariants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveUploadVariantsCounter := mm_atomic.LoadUint64(&m.afterSaveUploadVariantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveUploadVariantsMock.defaultExpectation != nil && afterSaveUploadVariantsCounter < 1 {
+		if m.SaveUploadVariantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SaveUploadVariants at\n%s", m.SaveUploadVariantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SaveUploadVariants at\n%s with params: %#v", m.SaveUploadVariantsMock.defaultExpectation.expectationOrigins.origin, *m.SaveUploadVariantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveUploadVariants != nil && afterSaveUploadVariantsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SaveUploadVariants at\n%s", m.funcSaveUploadVariantsOrigin)
+	}
+
+	if !m.SaveUploadVariantsMock.invocationsDone() && afterSaveUploadVariantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SaveUploadVariants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveUploadVariantsMock.expectedInvocations), m.SaveUploadVariantsMock.expectedInvocationsOrigin, afterSaveUploadVariantsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimPendingUploadsInspect()
+
+			m.MinimockCreateUploadInspect()
+
+			m.MinimockFailUploadVariantsInspect()
+
+			m.MinimockGetUploadByStorageKeyInspect()
+
+			m.MinimockSaveUploadVariantsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimPendingUploadsDone() &&
+		m.MinimockCreateUploadDone() &&
+		m.MinimockFailUploadVariantsDone() &&
+		m.MinimockGetUploadByStorageKeyDone() &&
+		m.MinimockSaveUploadVariantsDone()
+}
@@ -0,0 +1,1193 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/upload.UseCase -o usecase_mock.go -n UseCaseMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UseCaseMock implements mm_upload.UseCase
+type UseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcImportImageURL          func(ctx context.Context, userID uint64, rawURL string) (s1 string, err error)
+	funcImportImageURLOrigin    string
+	inspectFuncImportImageURL   func(ctx context.Context, userID uint64, rawURL string)
+	afterImportImageURLCounter  uint64
+	beforeImportImageURLCounter uint64
+	ImportImageURLMock          mUseCaseMockImportImageURL
+
+	funcProcessPendingVariants          func(ctx context.Context) (i1 int, err error)
+	funcProcessPendingVariantsOrigin    string
+	inspectFuncProcessPendingVariants   func(ctx context.Context)
+	afterProcessPendingVariantsCounter  uint64
+	beforeProcessPendingVariantsCounter uint64
+	ProcessPendingVariantsMock          mUseCaseMockProcessPendingVariants
+
+	funcUploadImage          func(ctx context.Context, userID uint64, filename string, body io.Reader) (up1 *entity.Upload, err error)
+	funcUploadImageOrigin    string
+	inspectFuncUploadImage   func(ctx context.Context, userID uint64, filename string, body io.Reader)
+	afterUploadImageCounter  uint64
+	beforeUploadImageCounter uint64
+	UploadImageMock          mUseCaseMockUploadImage
+}
+
+// NewUseCaseMock returns a mock for mm_upload.UseCase
+func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
+	m := &UseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ImportImageURLMock = mUseCaseMockImportImageURL{mock: m}
+	m.ImportImageURLMock.callArgs = []*UseCaseMockImportImageURLParams{}
+
+	m.ProcessPendingVariantsMock = mUseCaseMockProcessPendingVariants{mock: m}
+	m.ProcessPendingVariantsMock.callArgs = []*UseCaseMockProcessPendingVariantsParams{}
+
+	m.UploadImageMock = mUseCaseMockUploadImage{mock: m}
+	m.UploadImageMock.callArgs = []*UseCaseMockUploadImageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUseCaseMockImportImageURL struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockImportImageURLExpectation
+	expectations       []*UseCaseMockImportImageURLExpectation
+
+	callArgs []*UseCaseMockImportImageURLParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockImportImageURLExpectation specifies expectation struct of the UseCase.ImportImageURL
+type UseCaseMockImportImageURLExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockImportImageURLParams
+	paramPtrs          *UseCaseMockImportImageURLParamPtrs
+	expectationOrigins UseCaseMockImportImageURLExpectationOrigins
+	results            *UseCaseMockImportImageURLResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockImportImageURLParams contains parameters of the UseCase.ImportImageURL
+type UseCaseMockImportImageURLParams struct {
+	ctx    context.Context
+	userID uint64
+	rawURL string
+}
+
+// UseCaseMockImportImageURLParamPtrs contains pointers to parameters of the UseCase.ImportImageURL
+type UseCaseMockImportImageURLParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	rawURL *string
+}
+
+// UseCaseMockImportImageURLResults contains results of the UseCase.ImportImageURL
+type UseCaseMockImportImageURLResults struct {
+	s1  string
+	err error
+}
+
+// UseCaseMockImportImageURLOrigins contains origins of expectations of the UseCase.ImportImageURL
+type UseCaseMockImportImageURLExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originRawURL string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImportImageURL *mUseCaseMockImportImageURL) Optional() *mUseCaseMockImportImageURL {
+	mmImportImageURL.optional = true
+	return mmImportImageURL
+}
+
+// Expect sets up expected params for UseCase.ImportImageURL
+func (mmImportImageURL *mUseCaseMockImportImageURL) Expect(ctx context.Context, userID uint64, rawURL string) *mUseCaseMockImportImageURL {
+	if mmImportImageURL.mock.funcImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Set")
+	}
+
+	if mmImportImageURL.defaultExpectation == nil {
+		mmImportImageURL.defaultExpectation = &UseCaseMockImportImageURLExpectation{}
+	}
+
+	if mmImportImageURL.defaultExpectation.paramPtrs != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by ExpectParams functions")
+	}
+
+	mmImportImageURL.defaultExpectation.params = &UseCaseMockImportImageURLParams{ctx, userID, rawURL}
+	mmImportImageURL.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImportImageURL.expectations {
+		if minimock.Equal(e.params, mmImportImageURL.defaultExpectation.params) {
+			mmImportImageURL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportImageURL.defaultExpectation.params)
+		}
+	}
+
+	return mmImportImageURL
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ImportImageURL
+func (mmImportImageURL *mUseCaseMockImportImageURL) ExpectCtxParam1(ctx context.Context) *mUseCaseMockImportImageURL {
+	if mmImportImageURL.mock.funcImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Set")
+	}
+
+	if mmImportImageURL.defaultExpectation == nil {
+		mmImportImageURL.defaultExpectation = &UseCaseMockImportImageURLExpectation{}
+	}
+
+	if mmImportImageURL.defaultExpectation.params != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Expect")
+	}
+
+	if mmImportImageURL.defaultExpectation.paramPtrs == nil {
+		mmImportImageURL.defaultExpectation.paramPtrs = &UseCaseMockImportImageURLParamPtrs{}
+	}
+	mmImportImageURL.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImportImageURL.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImportImageURL
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.ImportImageURL
+func (mmImportImageURL *mUseCaseMockImportImageURL) ExpectUserIDParam2(userID uint64) *mUseCaseMockImportImageURL {
+	if mmImportImageURL.mock.funcImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Set")
+	}
+
+	if mmImportImageURL.defaultExpectation == nil {
+		mmImportImageURL.defaultExpectation = &UseCaseMockImportImageURLExpectation{}
+	}
+
+	if mmImportImageURL.defaultExpectation.params != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Expect")
+	}
+
+	if mmImportImageURL.defaultExpectation.paramPtrs == nil {
+		mmImportImageURL.defaultExpectation.paramPtrs = &UseCaseMockImportImageURLParamPtrs{}
+	}
+	mmImportImageURL.defaultExpectation.paramPtrs.userID = &userID
+	mmImportImageURL.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmImportImageURL
+}
+
+// ExpectRawURLParam3 sets up expected param rawURL for UseCase.ImportImageURL
+func (mmImportImageURL *mUseCaseMockImportImageURL) ExpectRawURLParam3(rawURL string) *mUseCaseMockImportImageURL {
+	if mmImportImageURL.mock.funcImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Set")
+	}
+
+	if mmImportImageURL.defaultExpectation == nil {
+		mmImportImageURL.defaultExpectation = &UseCaseMockImportImageURLExpectation{}
+	}
+
+	if mmImportImageURL.defaultExpectation.params != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Expect")
+	}
+
+	if mmImportImageURL.defaultExpectation.paramPtrs == nil {
+		mmImportImageURL.defaultExpectation.paramPtrs = &UseCaseMockImportImageURLParamPtrs{}
+	}
+	mmImportImageURL.defaultExpectation.paramPtrs.rawURL = &rawURL
+	mmImportImageURL.defaultExpectation.expectationOrigins.originRawURL = minimock.CallerInfo(1)
+
+	return mmImportImageURL
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ImportImageURL
+func (mmImportImageURL *mUseCaseMockImportImageURL) Inspect(f func(ctx context.Context, userID uint64, rawURL string)) *mUseCaseMockImportImageURL {
+	if mmImportImageURL.mock.inspectFuncImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ImportImageURL")
+	}
+
+	mmImportImageURL.mock.inspectFuncImportImageURL = f
+
+	return mmImportImageURL
+}
+
+// Return sets up results that will be returned by UseCase.ImportImageURL
+func (mmImportImageURL *mUseCaseMockImportImageURL) Return(s1 string, err error) *UseCaseMock {
+	if mmImportImageURL.mock.funcImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Set")
+	}
+
+	if mmImportImageURL.defaultExpectation == nil {
+		mmImportImageURL.defaultExpectation = &UseCaseMockImportImageURLExpectation{mock: mmImportImageURL.mock}
+	}
+	mmImportImageURL.defaultExpectation.results = &UseCaseMockImportImageURLResults{s1, err}
+	mmImportImageURL.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImportImageURL.mock
+}
+
+// Set uses given function f to mock the UseCase.ImportImageURL method
+func (mmImportImageURL *mUseCaseMockImportImageURL) Set(f func(ctx context.Context, userID uint64, rawURL string) (s1 string, err error)) *UseCaseMock {
+	if mmImportImageURL.defaultExpectation != nil {
+		mmImportImageURL.mock.t.Fatalf("Default expectation is already set for the UseCase.ImportImageURL method")
+	}
+
+	if len(mmImportImageURL.expectations) > 0 {
+		mmImportImageURL.mock.t.Fatalf("Some expectations are already set for the UseCase.ImportImageURL method")
+	}
+
+	mmImportImageURL.mock.funcImportImageURL = f
+	mmImportImageURL.mock.funcImportImageURLOrigin = minimock.CallerInfo(1)
+	return mmImportImageURL.mock
+}
+
+// When sets expectation for the UseCase.ImportImageURL which will trigger the result defined by the following
+// Then helper
+func (mmImportImageURL *mUseCaseMockImportImageURL) When(ctx context.Context, userID uint64, rawURL string) *UseCaseMockImportImageURLExpectation {
+	if mmImportImageURL.mock.funcImportImageURL != nil {
+		mmImportImageURL.mock.t.Fatalf("UseCaseMock.ImportImageURL mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockImportImageURLExpectation{
+		mock:               mmImportImageURL.mock,
+		params:             &UseCaseMockImportImageURLParams{ctx, userID, rawURL},
+		expectationOrigins: UseCaseMockImportImageURLExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImportImageURL.expectations = append(mmImportImageURL.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ImportImageURL return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockImportImageURLExpectation) Then(s1 string, err error) *UseCaseMock {
+	e.results = &UseCaseMockImportImageURLResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ImportImageURL should be invoked
+func (mmImportImageURL *mUseCaseMockImportImageURL) Times(n uint64) *mUseCaseMockImportImageURL {
+	if n == 0 {
+		mmImportImageURL.mock.t.Fatalf("Times of UseCaseMock.ImportImageURL mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImportImageURL.expectedInvocations, n)
+	mmImportImageURL.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImportImageURL
+}
+
+func (mmImportImageURL *mUseCaseMockImportImageURL) invocationsDone() bool {
+	if len(mmImportImageURL.expectations) == 0 && mmImportImageURL.defaultExpectation == nil && mmImportImageURL.mock.funcImportImageURL == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImportImageURL.mock.afterImportImageURLCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImportImageURL.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ImportImageURL implements mm_upload.UseCase
+func (mmImportImageURL *UseCaseMock) ImportImageURL(ctx context.Context, userID uint64, rawURL string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmImportImageURL.beforeImportImageURLCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportImageURL.afterImportImageURLCounter, 1)
+
+	mmImportImageURL.t.Helper()
+
+	if mmImportImageURL.inspectFuncImportImageURL != nil {
+		mmImportImageURL.inspectFuncImportImageURL(ctx, userID, rawURL)
+	}
+
+	mm_params := UseCaseMockImportImageURLParams{ctx, userID, rawURL}
+
+	// Record call args
+	mmImportImageURL.ImportImageURLMock.mutex.Lock()
+	mmImportImageURL.ImportImageURLMock.callArgs = append(mmImportImageURL.ImportImageURLMock.callArgs, &mm_params)
+	mmImportImageURL.ImportImageURLMock.mutex.Unlock()
+
+	for _, e := range mmImportImageURL.ImportImageURLMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmImportImageURL.ImportImageURLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportImageURL.ImportImageURLMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportImageURL.ImportImageURLMock.defaultExpectation.params
+		mm_want_ptrs := mmImportImageURL.ImportImageURLMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockImportImageURLParams{ctx, userID, rawURL}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImportImageURL.t.Errorf("UseCaseMock.ImportImageURL got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportImageURL.ImportImageURLMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmImportImageURL.t.Errorf("UseCaseMock.ImportImageURL got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportImageURL.ImportImageURLMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.rawURL != nil && !minimock.Equal(*mm_want_ptrs.rawURL, mm_got.rawURL) {
+				mmImportImageURL.t.Errorf("UseCaseMock.ImportImageURL got unexpected parameter rawURL, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportImageURL.ImportImageURLMock.defaultExpectation.expectationOrigins.originRawURL, *mm_want_ptrs.rawURL, mm_got.rawURL, minimock.Diff(*mm_want_ptrs.rawURL, mm_got.rawURL))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportImageURL.t.Errorf("UseCaseMock.ImportImageURL got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImportImageURL.ImportImageURLMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportImageURL.ImportImageURLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportImageURL.t.Fatal("No results are set for the UseCaseMock.ImportImageURL")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmImportImageURL.funcImportImageURL != nil {
+		return mmImportImageURL.funcImportImageURL(ctx, userID, rawURL)
+	}
+	mmImportImageURL.t.Fatalf("Unexpected call to UseCaseMock.ImportImageURL. %v %v %v", ctx, userID, rawURL)
+	return
+}
+
+// ImportImageURLAfterCounter returns a count of finished UseCaseMock.ImportImageURL invocations
+func (mmImportImageURL *UseCaseMock) ImportImageURLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportImageURL.afterImportImageURLCounter)
+}
+
+// ImportImageURLBeforeCounter returns a count of UseCaseMock.ImportImageURL invocations
+func (mmImportImageURL *UseCaseMock) ImportImageURLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportImageURL.beforeImportImageURLCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ImportImageURL.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportImageURL *mUseCaseMockImportImageURL) Calls() []*UseCaseMockImportImageURLParams {
+	mmImportImageURL.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockImportImageURLParams, len(mmImportImageURL.callArgs))
+	copy(argCopy, mmImportImageURL.callArgs)
+
+	mmImportImageURL.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportImageURLDone returns true if the count of the ImportImageURL invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockImportImageURLDone() bool {
+	if m.ImportImageURLMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportImageURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportImageURLMock.invocationsDone()
+}
+
+// MinimockImportImageURLInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockImportImageURLInspect() {
+	for _, e := range m.ImportImageURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ImportImageURL at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportImageURLCounter := mm_atomic.LoadUint64(&m.afterImportImageURLCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportImageURLMock.defaultExpectation != nil && afterImportImageURLCounter < 1 {
+		if m.ImportImageURLMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ImportImageURL at\n%s", m.ImportImageURLMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ImportImageURL at\n%s with params: %#v", m.ImportImageURLMock.defaultExpectation.expectationOrigins.origin, *m.ImportImageURLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportImageURL != nil && afterImportImageURLCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ImportImageURL at\n%s", m.funcImportImageURLOrigin)
+	}
+
+	if !m.ImportImageURLMock.invocationsDone() && afterImportImageURLCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ImportImageURL at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportImageURLMock.expectedInvocations), m.ImportImageURLMock.expectedInvocationsOrigin, afterImportImageURLCounter)
+	}
+}
+
+type mUseCaseMockProcessPendingVariants struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockProcessPendingVariantsExpectation
+	expectations       []*UseCaseMockProcessPendingVariantsExpectation
+
+	callArgs []*UseCaseMockProcessPendingVariantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockProcessPendingVariantsExpectation specifies expectation struct of the UseCase.ProcessPendingVariants
+type UseCaseMockProcessPendingVariantsExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockProcessPendingVariantsParams
+	paramPtrs          *UseCaseMockProcessPendingVariantsParamPtrs
+	expectationOrigins UseCaseMockProcessPendingVariantsExpectationOrigins
+	results            *UseCaseMockProcessPendingVariantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockProcessPendingVariantsParams contains parameters of the UseCase.ProcessPendingVariants
+type UseCaseMockProcessPendingVariantsParams struct {
+	ctx context.Context
+}
+
+// UseCaseMockProcessPendingVariantsParamPtrs contains pointers to parameters of the UseCase.ProcessPendingVariants
+type UseCaseMockProcessPendingVariantsParamPtrs struct {
+	ctx *context.Context
+}
+
+// UseCaseMockProcessPendingVariantsResults contains results of the UseCase.ProcessPendingVariants
+type UseCaseMockProcessPendingVariantsResults struct {
+	i1  int
+	err error
+}
+
+// UseCaseMockProcessPendingVariantsOrigins contains origins of expectations of the UseCase.ProcessPendingVariants
+type UseCaseMockProcessPendingVariantsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Optional() *mUseCaseMockProcessPendingVariants {
+	mmProcessPendingVariants.optional = true
+	return mmProcessPendingVariants
+}
+
+// Expect sets up expected params for UseCase.ProcessPendingVariants
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Expect(ctx context.Context) *mUseCaseMockProcessPendingVariants {
+	if mmProcessPendingVariants.mock.funcProcessPendingVariants != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("UseCaseMock.ProcessPendingVariants mock is already set by Set")
+	}
+
+	if mmProcessPendingVariants.defaultExpectation == nil {
+		mmProcessPendingVariants.defaultExpectation = &UseCaseMockProcessPendingVariantsExpectation{}
+	}
+
+	if mmProcessPendingVariants.defaultExpectation.paramPtrs != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("UseCaseMock.ProcessPendingVariants mock is already set by ExpectParams functions")
+	}
+
+	mmProcessPendingVariants.defaultExpectation.params = &UseCaseMockProcessPendingVariantsParams{ctx}
+	mmProcessPendingVariants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmProcessPendingVariants.expectations {
+		if minimock.Equal(e.params, mmProcessPendingVariants.defaultExpectation.params) {
+			mmProcessPendingVariants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProcessPendingVariants.defaultExpectation.params)
+		}
+	}
+
+	return mmProcessPendingVariants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.ProcessPendingVariants
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) ExpectCtxParam1(ctx context.Context) *mUseCaseMockProcessPendingVariants {
+	if mmProcessPendingVariants.mock.funcProcessPendingVariants != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("UseCaseMock.ProcessPendingVariants mock is already set by Set")
+	}
+
+	if mmProcessPendingVariants.defaultExpectation == nil {
+		mmProcessPendingVariants.defaultExpectation = &UseCaseMockProcessPendingVariantsExpectation{}
+	}
+
+	if mmProcessPendingVariants.defaultExpectation.params != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("UseCaseMock.ProcessPendingVariants mock is already set by Expect")
+	}
+
+	if mmProcessPendingVariants.defaultExpectation.paramPtrs == nil {
+		mmProcessPendingVariants.defaultExpectation.paramPtrs = &UseCaseMockProcessPendingVariantsParamPtrs{}
+	}
+	mmProcessPendingVariants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmProcessPendingVariants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmProcessPendingVariants
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.ProcessPendingVariants
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Inspect(f func(ctx context.Context)) *mUseCaseMockProcessPendingVariants {
+	if mmProcessPendingVariants.mock.inspectFuncProcessPendingVariants != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("Inspect function is already set for UseCaseMock.ProcessPendingVariants")
+	}
+
+	mmProcessPendingVariants.mock.inspectFuncProcessPendingVariants = f
+
+	return mmProcessPendingVariants
+}
+
+// Return sets up results that will be returned by UseCase.ProcessPendingVariants
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Return(i1 int, err error) *UseCaseMock {
+	if mmProcessPendingVariants.mock.funcProcessPendingVariants != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("UseCaseMock.ProcessPendingVariants mock is already set by Set")
+	}
+
+	if mmProcessPendingVariants.defaultExpectation == nil {
+		mmProcessPendingVariants.defaultExpectation = &UseCaseMockProcessPendingVariantsExpectation{mock: mmProcessPendingVariants.mock}
+	}
+	mmProcessPendingVariants.defaultExpectation.results = &UseCaseMockProcessPendingVariantsResults{i1, err}
+	mmProcessPendingVariants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmProcessPendingVariants.mock
+}
+
+// Set uses given function f to mock the UseCase.ProcessPendingVariants method
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Set(f func(ctx context.Context) (i1 int, err error)) *UseCaseMock {
+	if mmProcessPendingVariants.defaultExpectation != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("Default expectation is already set for the UseCase.ProcessPendingVariants method")
+	}
+
+	if len(mmProcessPendingVariants.expectations) > 0 {
+		mmProcessPendingVariants.mock.t.Fatalf("Some expectations are already set for the UseCase.ProcessPendingVariants method")
+	}
+
+	mmProcessPendingVariants.mock.funcProcessPendingVariants = f
+	mmProcessPendingVariants.mock.funcProcessPendingVariantsOrigin = minimock.CallerInfo(1)
+	return mmProcessPendingVariants.mock
+}
+
+// When sets expectation for the UseCase.ProcessPendingVariants which will trigger the result defined by the following
+// Then helper
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) When(ctx context.Context) *UseCaseMockProcessPendingVariantsExpectation {
+	if mmProcessPendingVariants.mock.funcProcessPendingVariants != nil {
+		mmProcessPendingVariants.mock.t.Fatalf("UseCaseMock.ProcessPendingVariants mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockProcessPendingVariantsExpectation{
+		mock:               mmProcessPendingVariants.mock,
+		params:             &UseCaseMockProcessPendingVariantsParams{ctx},
+		expectationOrigins: UseCaseMockProcessPendingVariantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmProcessPendingVariants.expectations = append(mmProcessPendingVariants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.ProcessPendingVariants return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockProcessPendingVariantsExpectation) Then(i1 int, err error) *UseCaseMock {
+	e.results = &UseCaseMockProcessPendingVariantsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.ProcessPendingVariants should be invoked
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Times(n uint64) *mUseCaseMockProcessPendingVariants {
+	if n == 0 {
+		mmProcessPendingVariants.mock.t.Fatalf("Times of UseCaseMock.ProcessPendingVariants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmProcessPendingVariants.expectedInvocations, n)
+	mmProcessPendingVariants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmProcessPendingVariants
+}
+
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) invocationsDone() bool {
+	if len(mmProcessPendingVariants.expectations) == 0 && mmProcessPendingVariants.defaultExpectation == nil && mmProcessPendingVariants.mock.funcProcessPendingVariants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmProcessPendingVariants.mock.afterProcessPendingVariantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmProcessPendingVariants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ProcessPendingVariants implements mm_upload.UseCase
+func (mmProcessPendingVariants *UseCaseMock) ProcessPendingVariants(ctx context.Context) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmProcessPendingVariants.beforeProcessPendingVariantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmProcessPendingVariants.afterProcessPendingVariantsCounter, 1)
+
+	mmProcessPendingVariants.t.Helper()
+
+	if mmProcessPendingVariants.inspectFuncProcessPendingVariants != nil {
+		mmProcessPendingVariants.inspectFuncProcessPendingVariants(ctx)
+	}
+
+	mm_params := UseCaseMockProcessPendingVariantsParams{ctx}
+
+	// Record call args
+	mmProcessPendingVariants.ProcessPendingVariantsMock.mutex.Lock()
+	mmProcessPendingVariants.ProcessPendingVariantsMock.callArgs = append(mmProcessPendingVariants.ProcessPendingVariantsMock.callArgs, &mm_params)
+	mmProcessPendingVariants.ProcessPendingVariantsMock.mutex.Unlock()
+
+	for _, e := range mmProcessPendingVariants.ProcessPendingVariantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation.params
+		mm_want_ptrs := mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockProcessPendingVariantsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmProcessPendingVariants.t.Errorf("UseCaseMock.ProcessPendingVariants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmProcessPendingVariants.t.Errorf("UseCaseMock.ProcessPendingVariants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmProcessPendingVariants.ProcessPendingVariantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmProcessPendingVariants.t.Fatal("No results are set for the UseCaseMock.ProcessPendingVariants")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmProcessPendingVariants.funcProcessPendingVariants != nil {
+		return mmProcessPendingVariants.funcProcessPendingVariants(ctx)
+	}
+	mmProcessPendingVariants.t.Fatalf("Unexpected call to UseCaseMock.ProcessPendingVariants. %v", ctx)
+	return
+}
+
+// ProcessPendingVariantsAfterCounter returns a count of finished UseCaseMock.ProcessPendingVariants invocations
+func (mmProcessPendingVariants *UseCaseMock) ProcessPendingVariantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProcessPendingVariants.afterProcessPendingVariantsCounter)
+}
+
+// ProcessPendingVariantsBeforeCounter returns a count of UseCaseMock.ProcessPendingVariants invocations
+func (mmProcessPendingVariants *UseCaseMock) ProcessPendingVariantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProcessPendingVariants.beforeProcessPendingVariantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.ProcessPendingVariants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmProcessPendingVariants *mUseCaseMockProcessPendingVariants) Calls() []*UseCaseMockProcessPendingVariantsParams {
+	mmProcessPendingVariants.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockProcessPendingVariantsParams, len(mmProcessPendingVariants.callArgs))
+	copy(argCopy, mmProcessPendingVariants.callArgs)
+
+	mmProcessPendingVariants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockProcessPendingVariantsDone returns true if the count of the ProcessPendingVariants invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockProcessPendingVariantsDone() bool {
+	if m.ProcessPendingVariantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ProcessPendingVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ProcessPendingVariantsMock.invocationsDone()
+}
+
+// MinimockProcessPendingVariantsInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockProcessPendingVariantsInspect() {
+	for _, e := range m.ProcessPendingVariantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.ProcessPendingVariants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterProcessPendingVariantsCounter := mm_atomic.LoadUint64(&m.afterProcessPendingVariantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProcessPendingVariantsMock.defaultExpectation != nil && afterProcessPendingVariantsCounter < 1 {
+		if m.ProcessPendingVariantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.ProcessPendingVariants at\n%s", m.ProcessPendingVariantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.ProcessPendingVariants at\n%s with params: %#v", m.ProcessPendingVariantsMock.defaultExpectation.expectationOrigins.origin, *m.ProcessPendingVariantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProcessPendingVariants != nil && afterProcessPendingVariantsCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.ProcessPendingVariants at\n%s", m.funcProcessPendingVariantsOrigin)
+	}
+
+	if !m.ProcessPendingVariantsMock.invocationsDone() && afterProcessPendingVariantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.ProcessPendingVariants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ProcessPendingVariantsMock.expectedInvocations), m.ProcessPendingVariantsMock.expectedInvocationsOrigin, afterProcessPendingVariantsCounter)
+	}
+}
+
+type mUseCaseMockUploadImage struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockUploadImageExpectation
+	expectations       []*UseCaseMockUploadImageExpectation
+
+	callArgs []*UseCaseMockUploadImageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockUploadImageExpectation specifies expectation struct of the UseCase.UploadImage
+type UseCaseMockUploadImageExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockUploadImageParams
+	paramPtrs          *UseCaseMockUploadImageParamPtrs
+	expectationOrigins UseCaseMockUploadImageExpectationOrigins
+	results            *UseCaseMockUploadImageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockUploadImageParams contains parameters of the UseCase.UploadImage
+type UseCaseMockUploadImageParams struct {
+	ctx      context.Context
+	userID   uint64
+	filename string
+	body     io.Reader
+}
+
+// UseCaseMockUploadImageParamPtrs contains pointers to parameters of the UseCase.UploadImage
+type UseCaseMockUploadImageParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	filename *string
+	body     *io.Reader
+}
+
+// UseCaseMockUploadImageResults contains results of the UseCase.UploadImage
+type UseCaseMockUploadImageResults struct {
+	up1 *entity.Upload
+	err error
+}
+
+// UseCaseMockUploadImageOrigins contains origins of expectations of the UseCase.UploadImage
+type UseCaseMockUploadImageExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originFilename string
+	originBody     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUploadImage *mUseCaseMockUploadImage) Optional() *mUseCaseMockUploadImage {
+	mmUploadImage.optional = true
+	return mmUploadImage
+}
+
+// Expect sets up expected params for UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) Expect(ctx context.Context, userID uint64, filename string, body io.Reader) *mUseCaseMockUploadImage {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	if mmUploadImage.defaultExpectation == nil {
+		mmUploadImage.defaultExpectation = &UseCaseMockUploadImageExpectation{}
+	}
+
+	if mmUploadImage.defaultExpectation.paramPtrs != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by ExpectParams functions")
+	}
+
+	mmUploadImage.defaultExpectation.params = &UseCaseMockUploadImageParams{ctx, userID, filename, body}
+	mmUploadImage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUploadImage.expectations {
+		if minimock.Equal(e.params, mmUploadImage.defaultExpectation.params) {
+			mmUploadImage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadImage.defaultExpectation.params)
+		}
+	}
+
+	return mmUploadImage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) ExpectCtxParam1(ctx context.Context) *mUseCaseMockUploadImage {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	if mmUploadImage.defaultExpectation == nil {
+		mmUploadImage.defaultExpectation = &UseCaseMockUploadImageExpectation{}
+	}
+
+	if mmUploadImage.defaultExpectation.params != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Expect")
+	}
+
+	if mmUploadImage.defaultExpectation.paramPtrs == nil {
+		mmUploadImage.defaultExpectation.paramPtrs = &UseCaseMockUploadImageParamPtrs{}
+	}
+	mmUploadImage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUploadImage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUploadImage
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) ExpectUserIDParam2(userID uint64) *mUseCaseMockUploadImage {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	if mmUploadImage.defaultExpectation == nil {
+		mmUploadImage.defaultExpectation = &UseCaseMockUploadImageExpectation{}
+	}
+
+	if mmUploadImage.defaultExpectation.params != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Expect")
+	}
+
+	if mmUploadImage.defaultExpectation.paramPtrs == nil {
+		mmUploadImage.defaultExpectation.paramPtrs = &UseCaseMockUploadImageParamPtrs{}
+	}
+	mmUploadImage.defaultExpectation.paramPtrs.userID = &userID
+	mmUploadImage.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUploadImage
+}
+
+// ExpectFilenameParam3 sets up expected param filename for UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) ExpectFilenameParam3(filename string) *mUseCaseMockUploadImage {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	if mmUploadImage.defaultExpectation == nil {
+		mmUploadImage.defaultExpectation = &UseCaseMockUploadImageExpectation{}
+	}
+
+	if mmUploadImage.defaultExpectation.params != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Expect")
+	}
+
+	if mmUploadImage.defaultExpectation.paramPtrs == nil {
+		mmUploadImage.defaultExpectation.paramPtrs = &UseCaseMockUploadImageParamPtrs{}
+	}
+	mmUploadImage.defaultExpectation.paramPtrs.filename = &filename
+	mmUploadImage.defaultExpectation.expectationOrigins.originFilename = minimock.CallerInfo(1)
+
+	return mmUploadImage
+}
+
+// ExpectBodyParam4 sets up expected param body for UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) ExpectBodyParam4(body io.Reader) *mUseCaseMockUploadImage {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	if mmUploadImage.defaultExpectation == nil {
+		mmUploadImage.defaultExpectation = &UseCaseMockUploadImageExpectation{}
+	}
+
+	if mmUploadImage.defaultExpectation.params != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Expect")
+	}
+
+	if mmUploadImage.defaultExpectation.paramPtrs == nil {
+		mmUploadImage.defaultExpectation.paramPtrs = &UseCaseMockUploadImageParamPtrs{}
+	}
+	mmUploadImage.defaultExpectation.paramPtrs.body = &body
+	mmUploadImage.defaultExpectation.expectationOrigins.originBody = minimock.CallerInfo(1)
+
+	return mmUploadImage
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) Inspect(f func(ctx context.Context, userID uint64, filename string, body io.Reader)) *mUseCaseMockUploadImage {
+	if mmUploadImage.mock.inspectFuncUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("Inspect function is already set for UseCaseMock.UploadImage")
+	}
+
+	mmUploadImage.mock.inspectFuncUploadImage = f
+
+	return mmUploadImage
+}
+
+// Return sets up results that will be returned by UseCase.UploadImage
+func (mmUploadImage *mUseCaseMockUploadImage) Return(up1 *entity.Upload, err error) *UseCaseMock {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	if mmUploadImage.defaultExpectation == nil {
+		mmUploadImage.defaultExpectation = &UseCaseMockUploadImageExpectation{mock: mmUploadImage.mock}
+	}
+	mmUploadImage.defaultExpectation.results = &UseCaseMockUploadImageResults{up1, err}
+	mmUploadImage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUploadImage.mock
+}
+
+// Set uses given function f to mock the UseCase.UploadImage method
+func (mmUploadImage *mUseCaseMockUploadImage) Set(f func(ctx context.Context, userID uint64, filename string, body io.Reader) (up1 *entity.Upload, err error)) *UseCaseMock {
+	if mmUploadImage.defaultExpectation != nil {
+		mmUploadImage.mock.t.Fatalf("Default expectation is already set for the UseCase.UploadImage method")
+	}
+
+	if len(mmUploadImage.expectations) > 0 {
+		mmUploadImage.mock.t.Fatalf("Some expectations are already set for the UseCase.UploadImage method")
+	}
+
+	mmUploadImage.mock.funcUploadImage = f
+	mmUploadImage.mock.funcUploadImageOrigin = minimock.CallerInfo(1)
+	return mmUploadImage.mock
+}
+
+// When sets expectation for the UseCase.UploadImage which will trigger the result defined by the following
+// Then helper
+func (mmUploadImage *mUseCaseMockUploadImage) When(ctx context.Context, userID uint64, filename string, body io.Reader) *UseCaseMockUploadImageExpectation {
+	if mmUploadImage.mock.funcUploadImage != nil {
+		mmUploadImage.mock.t.Fatalf("UseCaseMock.UploadImage mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockUploadImageExpectation{
+		mock:               mmUploadImage.mock,
+		params:             &UseCaseMockUploadImageParams{ctx, userID, filename, body},
+		expectationOrigins: UseCaseMockUploadImageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUploadImage.expectations = append(mmUploadImage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.UploadImage return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockUploadImageExpectation) Then(up1 *entity.Upload, err error) *UseCaseMock {
+	e.results = &UseCaseMockUploadImageResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.UploadImage should be invoked
+func (mmUploadImage *mUseCaseMockUploadImage) Times(n uint64) *mUseCaseMockUploadImage {
+	if n == 0 {
+		mmUploadImage.mock.t.Fatalf("Times of UseCaseMock.UploadImage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUploadImage.expectedInvocations, n)
+	mmUploadImage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUploadImage
+}
+
+func (mmUploadImage *mUseCaseMockUploadImage) invocationsDone() bool {
+	if len(mmUploadImage.expectations) == 0 && mmUploadImage.defaultExpectation == nil && mmUploadImage.mock.funcUploadImage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUploadImage.mock.afterUploadImageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUploadImage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UploadImage implements mm_upload.UseCase
+func (mmUploadImage *UseCaseMock) UploadImage(ctx context.Context, userID uint64, filename string, body io.Reader) (up1 *entity.Upload, err error) {
+	mm_atomic.AddUint64(&mmUploadImage.beforeUploadImageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadImage.afterUploadImageCounter, 1)
+
+	mmUploadImage.t.Helper()
+
+	if mmUploadImage.inspectFuncUploadImage != nil {
+		mmUploadImage.inspectFuncUploadImage(ctx, userID, filename, body)
+	}
+
+	mm_params := UseCaseMockUploadImageParams{ctx, userID, filename, body}
+
+	// Record call args
+	mmUploadImage.UploadImageMock.mutex.Lock()
+	mmUploadImage.UploadImageMock.callArgs = append(mmUploadImage.UploadImageMock.callArgs, &mm_params)
+	mmUploadImage.UploadImageMock.mutex.Unlock()
+
+	for _, e := range mmUploadImage.UploadImageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUploadImage.UploadImageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadImage.UploadImageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadImage.UploadImageMock.defaultExpectation.params
+		mm_want_ptrs := mmUploadImage.UploadImageMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockUploadImageParams{ctx, userID, filename, body}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUploadImage.t.Errorf("UseCaseMock.UploadImage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadImage.UploadImageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUploadImage.t.Errorf("UseCaseMock.UploadImage got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadImage.UploadImageMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.filename != nil && !minimock.Equal(*mm_want_ptrs.filename, mm_got.filename) {
+				mmUploadImage.t.Errorf("UseCaseMock.UploadImage got unexpected parameter filename, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadImage.UploadImageMock.defaultExpectation.expectationOrigins.originFilename, *mm_want_ptrs.filename, mm_got.filename, minimock.Diff(*mm_want_ptrs.filename, mm_got.filename))
+			}
+
+			if mm_want_ptrs.body != nil && !minimock.Equal(*mm_want_ptrs.body, mm_got.body) {
+				mmUploadImage.t.Errorf("UseCaseMock.UploadImage got unexpected parameter body, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadImage.UploadImageMock.defaultExpectation.expectationOrigins.originBody, *mm_want_ptrs.body, mm_got.body, minimock.Diff(*mm_want_ptrs.body, mm_got.body))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUploadImage.t.Errorf("UseCaseMock.UploadImage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUploadImage.UploadImageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUploadImage.UploadImageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUploadImage.t.Fatal("No results are set for the UseCaseMock.UploadImage")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUploadImage.funcUploadImage != nil {
+		return mmUploadImage.funcUploadImage(ctx, userID, filename, body)
+	}
+	mmUploadImage.t.Fatalf("Unexpected call to UseCaseMock.UploadImage. %v %v %v %v", ctx, userID, filename, body)
+	return
+}
+
+// UploadImageAfterCounter returns a count of finished UseCaseMock.UploadImage invocations
+func (mmUploadImage *UseCaseMock) UploadImageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadImage.afterUploadImageCounter)
+}
+
+// UploadImageBeforeCounter returns a count of UseCaseMock.UploadImage invocations
+func (mmUploadImage *UseCaseMock) UploadImageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadImage.beforeUploadImageCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.UploadImage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUploadImage *mUseCaseMockUploadImage) Calls() []*UseCaseMockUploadImageParams {
+	mmUploadImage.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockUploadImageParams, len(mmUploadImage.callArgs))
+	copy(argCopy, mmUploadImage.callArgs)
+
+	mmUploadImage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadImageDone returns true if the count of the UploadImage invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockUploadImageDone() bool {
+	if m.UploadImageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UploadImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UploadImageMock.invocationsDone()
+}
+
+// MinimockUploadImageInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockUploadImageInspect() {
+	for _, e := range m.UploadImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.UploadImage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUploadImageCounter := mm_atomic.LoadUint64(&m.afterUploadImageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadImageMock.defaultExpectation != nil && afterUploadImageCounter < 1 {
+		if m.UploadImageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.UploadImage at\n%s", m.UploadImageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.UploadImage at\n%s with params: %#v", m.UploadImageMock.defaultExpectation.expectationOrigins.origin, *m.UploadImageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadImage != nil && afterUploadImageCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.UploadImage at\n%s", m.funcUploadImageOrigin)
+	}
+
+	if !m.UploadImageMock.invocationsDone() && afterUploadImageCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.UploadImage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadImageMock.expectedInvocations), m.UploadImageMock.expectedInvocationsOrigin, afterUploadImageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockImportImageURLInspect()
+
+			m.MinimockProcessPendingVariantsInspect()
+
+			m.MinimockUploadImageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockImportImageURLDone() &&
+		m.MinimockProcessPendingVariantsDone() &&
+		m.MinimockUploadImageDone()
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	postgresstorage "github.com/Snake1-1eyes/vk_task_marketplace/pkg/postgres_storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type dbManager interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	GetPool() *pgxpool.Pool
+}
+
+// Repository реализует интерфейс upload.Repository
+type Repository struct {
+	db        dbManager
+	txManager postgresstorage.Transactor
+	logger    *logger.Logger
+}
+
+// New создает новый экземпляр репозитория
+func New(db dbManager, txManager postgresstorage.Transactor, logger *logger.Logger) *Repository {
+	return &Repository{
+		db:        db,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+// CreateUpload сохраняет сведения о загруженном файле. Уменьшенные копии изображения
+// строятся позже фоновым процессом, поэтому загрузка создается в статусе pending
+func (r *Repository) CreateUpload(ctx context.Context, upload *entity.Upload) (*entity.Upload, error) {
+	query := `
+		INSERT INTO uploads (author_id, storage_key, url, content_type, size, variants_status)
+		VALUES ($1, $2, $3, $4, $5, 'pending')
+		RETURNING id, variants_status, created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		upload.AuthorID,
+		upload.StorageKey,
+		upload.URL,
+		upload.ContentType,
+		upload.Size,
+	).Scan(&upload.ID, &upload.VariantsStatus, &upload.CreatedAt)
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при сохранении загрузки",
+			zap.Uint64("author_id", upload.AuthorID),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при сохранении загрузки")
+	}
+
+	return upload, nil
+}
+
+// GetUploadByStorageKey находит загрузку по ключу файла в хранилище
+func (r *Repository) GetUploadByStorageKey(ctx context.Context, key string) (*entity.Upload, error) {
+	query := `
+		SELECT id, author_id, storage_key, url, content_type, size, variants_status, created_at
+		FROM uploads
+		WHERE storage_key = $1`
+
+	upload := &entity.Upload{}
+	err := r.db.QueryRow(ctx, query, key).Scan(
+		&upload.ID,
+		&upload.AuthorID,
+		&upload.StorageKey,
+		&upload.URL,
+		&upload.ContentType,
+		&upload.Size,
+		&upload.VariantsStatus,
+		&upload.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrImageNotFound
+		}
+		r.logger.Error(ctx, "Ошибка при получении загрузки",
+			zap.String("storage_key", key),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении загрузки")
+	}
+
+	return upload, nil
+}
+
+// ClaimPendingUploads забирает в обработку до limit загрузок без уменьшенных копий.
+// Повторно выдаются и загрузки, обработка которых зависла дольше staleAfter,
+// например из-за перезапуска сервиса. SKIP LOCKED позволяет нескольким экземплярам
+// сервиса разбирать очередь, не получая одни и те же загрузки
+func (r *Repository) ClaimPendingUploads(ctx context.Context, limit int, staleAfter time.Duration) ([]*entity.Upload, error) {
+	query := `
+		UPDATE uploads SET
+			variants_status = 'processing',
+			variants_attempts = variants_attempts + 1,
+			variants_updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM uploads
+			WHERE variants_status = 'pending'
+				OR (variants_status = 'processing' AND variants_updated_at < $2)
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, author_id, storage_key, url, content_type, size, variants_status, created_at`
+
+	rows, err := r.db.Query(ctx, query, limit, time.Now().Add(-staleAfter))
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при получении загрузок для обработки", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении загрузок для обработки")
+	}
+	defer rows.Close()
+
+	uploads := make([]*entity.Upload, 0, limit)
+	for rows.Next() {
+		upload := &entity.Upload{}
+		err := rows.Scan(
+			&upload.ID,
+			&upload.AuthorID,
+			&upload.StorageKey,
+			&upload.URL,
+			&upload.ContentType,
+			&upload.Size,
+			&upload.VariantsStatus,
+			&upload.CreatedAt,
+		)
+		if err != nil {
+			r.logger.Error(ctx, "Ошибка при сканировании загрузки", zap.Error(err))
+			return nil, app_errors.WrapError(err, "ошибка при получении загрузок для обработки")
+		}
+		uploads = append(uploads, upload)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(ctx, "Ошибка при получении загрузок для обработки", zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении загрузок для обработки")
+	}
+
+	return uploads, nil
+}
+
+// SaveUploadVariants сохраняет уменьшенные копии изображения и отмечает загрузку обработанной
+func (r *Repository) SaveUploadVariants(ctx context.Context, uploadID uint64, variants []*entity.ImageVariant) error {
+	names := make([]string, 0, len(variants))
+	keys := make([]string, 0, len(variants))
+	urls := make([]string, 0, len(variants))
+	contentTypes := make([]string, 0, len(variants))
+	widths := make([]int32, 0, len(variants))
+	heights := make([]int32, 0, len(variants))
+	sizes := make([]int64, 0, len(variants))
+	for _, variant := range variants {
+		names = append(names, variant.Name)
+		keys = append(keys, variant.StorageKey)
+		urls = append(urls, variant.URL)
+		contentTypes = append(contentTypes, variant.ContentType)
+		widths = append(widths, int32(variant.Width))
+		heights = append(heights, int32(variant.Height))
+		sizes = append(sizes, variant.Size)
+	}
+
+	err := r.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := r.db.Exec(txCtx, "DELETE FROM upload_variants WHERE upload_id = $1", uploadID); err != nil {
+			return err
+		}
+
+		_, err := r.db.Exec(txCtx, `
+			INSERT INTO upload_variants (upload_id, name, storage_key, url, content_type, width, height, size)
+			SELECT $1, v.name, v.storage_key, v.url, v.content_type, v.width, v.height, v.size
+			FROM unnest($2::text[], $3::text[], $4::text[], $5::text[], $6::int[], $7::int[], $8::bigint[])
+				AS v(name, storage_key, url, content_type, width, height, size)`,
+			uploadID, names, keys, urls, contentTypes, widths, heights, sizes)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.db.Exec(txCtx, `
+			UPDATE uploads SET variants_status = 'ready', variants_updated_at = NOW()
+			WHERE id = $1`, uploadID)
+		return err
+	})
+	if err != nil {
+		r.logger.Error(ctx, "Ошибка при сохранении уменьшенных копий изображения",
+			zap.Uint64("upload_id", uploadID),
+			zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при сохранении уменьшенных копий изображения")
+	}
+
+	return nil
+}
+
+// FailUploadVariants возвращает загрузку в очередь после ошибки обработки.
+// Загрузка помечается как failed, если ошибка постоянная или исчерпаны maxAttempts попыток
+func (r *Repository) FailUploadVariants(ctx context.Context, uploadID uint64, permanent bool, maxAttempts int) error {
+	query := `
+		UPDATE uploads SET
+			variants_status = CASE WHEN $2 OR variants_attempts >= $3 THEN 'failed' ELSE 'pending' END,
+			variants_updated_at = NOW()
+		WHERE id = $1`
+
+	if _, err := r.db.Exec(ctx, query, uploadID, permanent, maxAttempts); err != nil {
+		r.logger.Error(ctx, "Ошибка при обновлении статуса обработки изображения",
+			zap.Uint64("upload_id", uploadID),
+			zap.Error(err))
+		return app_errors.WrapError(err, "ошибка при обновлении статуса обработки изображения")
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/storage"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/fetcher"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/imaging"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// sniffLen количество первых байт файла, по которым определяется тип содержимого
+const sniffLen = 512
+
+// imageExtensions сопоставляет поддерживаемые типы изображений с расширениями файлов
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// Config содержит настройки загрузки файлов
+type Config struct {
+	// MaxSize ограничивает размер загружаемого файла в байтах
+	MaxSize int64
+	// AllowedTypes задает допустимые типы изображений
+	AllowedTypes []string
+	// Variants описывает уменьшенные копии, которые строятся для каждого изображения
+	Variants []VariantSpec
+	// VariantQuality задает качество JPEG уменьшенных копий
+	VariantQuality int
+}
+
+// UseCase реализует интерфейс upload.UseCase
+type UseCase struct {
+	repo    upload.Repository
+	storage storage.Storage
+	fetcher upload.Fetcher
+	cfg     Config
+	log     *logger.Logger
+}
+
+// New создает новый экземпляр UseCase
+func New(repo upload.Repository, storage storage.Storage, fetcher upload.Fetcher, cfg Config, log *logger.Logger) *UseCase {
+	return &UseCase{
+		repo:    repo,
+		storage: storage,
+		fetcher: fetcher,
+		cfg:     cfg,
+		log:     log,
+	}
+}
+
+// UploadImage сохраняет изображение в хранилище и возвращает ссылку на него.
+// Тип определяется по содержимому файла, а не по имени или заголовкам клиента.
+// Уменьшенные копии строятся асинхронно, см. ProcessPendingVariants
+func (uc *UseCase) UploadImage(ctx context.Context, userID uint64, filename string, body io.Reader) (*entity.Upload, error) {
+	// Читается на байт больше лимита, чтобы отличить файл допустимого размера от слишком большого
+	data, err := io.ReadAll(io.LimitReader(body, uc.cfg.MaxSize+1))
+	if err != nil {
+		uc.log.Warn(ctx, "Ошибка при чтении загружаемого файла",
+			zap.String("filename", filename),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при чтении файла")
+	}
+
+	return uc.store(ctx, userID, filename, data)
+}
+
+// ImportImageURL скачивает изображение по внешней ссылке и сохраняет его в хранилище так же,
+// как загруженный файл, возвращая ссылку на копию. Ссылки на собственное хранилище не копируются,
+// а проверяются по загрузкам пользователя. Ошибки загрузки по ссылке считаются ошибками валидации,
+// так как ссылку передает клиент. Причина ошибки пишется только в лог, чтобы клиент не мог
+// по ответу узнать адреса внутренней сети и устройство фильтра адресов
+func (uc *UseCase) ImportImageURL(ctx context.Context, userID uint64, rawURL string) (string, error) {
+	if strings.HasPrefix(rawURL, uc.storage.URL("")) {
+		return uc.ownUploadURL(ctx, userID, rawURL)
+	}
+
+	data, err := uc.fetcher.Fetch(ctx, rawURL, uc.cfg.MaxSize)
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		uc.log.Warn(ctx, "Не удалось скачать изображение по ссылке",
+			zap.String("url", rawURL),
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		if errors.Is(err, fetcher.ErrTooLarge) {
+			return "", app_errors.WrapError(app_errors.ErrValidation,
+				fmt.Sprintf("размер изображения по ссылке %s превышает %d байт", rawURL, uc.cfg.MaxSize))
+		}
+		return "", app_errors.WrapError(app_errors.ErrValidation,
+			fmt.Sprintf("не удалось скачать изображение по ссылке %s", rawURL))
+	}
+
+	created, err := uc.store(ctx, userID, rawURL, data)
+	if err != nil {
+		return "", err
+	}
+
+	return created.URL, nil
+}
+
+// ownUploadURL проверяет ссылку на собственное хранилище: ключ файла не должен выходить за пределы хранилища,
+// а файл должен быть загружен этим же пользователем. Возвращает ссылку, сохраненную при загрузке
+func (uc *UseCase) ownUploadURL(ctx context.Context, userID uint64, rawURL string) (string, error) {
+	invalid := app_errors.WrapError(app_errors.ErrValidation,
+		fmt.Sprintf("ссылка %s не указывает на изображение, загруженное пользователем", rawURL))
+
+	escapedKey := strings.TrimPrefix(rawURL, uc.storage.URL(""))
+	if strings.ContainsAny(escapedKey, "?#") {
+		return "", invalid
+	}
+
+	key, err := url.PathUnescape(escapedKey)
+	if err != nil || key == "" || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") || path.IsAbs(key) {
+		return "", invalid
+	}
+
+	created, err := uc.repo.GetUploadByStorageKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrImageNotFound) {
+			return "", invalid
+		}
+		return "", err
+	}
+
+	if created.AuthorID != userID {
+		uc.log.Warn(ctx, "Попытка использовать изображение другого пользователя",
+			zap.Uint64("upload_id", created.ID),
+			zap.Uint64("user_id", userID))
+		return "", invalid
+	}
+
+	return created.URL, nil
+}
+
+// store проверяет изображение, удаляет из него метаданные и сохраняет в хранилище.
+// source содержит имя файла или исходную ссылку и используется в журнале
+func (uc *UseCase) store(ctx context.Context, userID uint64, source string, data []byte) (*entity.Upload, error) {
+	if len(data) == 0 {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "файл пуст")
+	}
+
+	if int64(len(data)) > uc.cfg.MaxSize {
+		return nil, app_errors.WrapError(app_errors.ErrValidation,
+			fmt.Sprintf("размер файла превышает %d байт", uc.cfg.MaxSize))
+	}
+
+	contentType := http.DetectContentType(data[:min(len(data), sniffLen)])
+	extension, ok := uc.allowedExtension(contentType)
+	if !ok {
+		uc.log.Warn(ctx, "Загрузка файла неподдерживаемого типа",
+			zap.String("source", source),
+			zap.String("content_type", contentType))
+		return nil, app_errors.WrapError(app_errors.ErrValidation,
+			fmt.Sprintf("тип файла %s не поддерживается", contentType))
+	}
+
+	// Совпадения первых байт с сигнатурой недостаточно, заголовок изображения должен читаться целиком
+	if err := imaging.Verify(contentType, data); err != nil {
+		uc.log.Warn(ctx, "Файл не является корректным изображением",
+			zap.String("source", source),
+			zap.String("content_type", contentType),
+			zap.Error(err))
+		return nil, app_errors.WrapError(app_errors.ErrValidation, err.Error())
+	}
+
+	// Метаданные удаляются до сохранения, чтобы исходный файл не раскрывал координаты съемки
+	data, err := imaging.Sanitize(contentType, data)
+	if err != nil {
+		uc.log.Warn(ctx, "Не удалось удалить метаданные изображения",
+			zap.String("source", source),
+			zap.String("content_type", contentType),
+			zap.Error(err))
+		return nil, app_errors.WrapError(app_errors.ErrValidation, err.Error())
+	}
+
+	key := fmt.Sprintf("images/%s/%s%s", time.Now().UTC().Format("2006/01"), uuid.NewString(), extension)
+
+	if err := uc.storage.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		uc.log.Error(ctx, "Ошибка при сохранении файла в хранилище",
+			zap.String("key", key),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при сохранении файла")
+	}
+
+	created, err := uc.repo.CreateUpload(ctx, &entity.Upload{
+		AuthorID:    userID,
+		StorageKey:  key,
+		URL:         uc.storage.URL(key),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	})
+	if err != nil {
+		if deleteErr := uc.storage.Delete(ctx, key); deleteErr != nil {
+			uc.log.Warn(ctx, "Не удалось удалить файл после ошибки сохранения загрузки",
+				zap.String("key", key),
+				zap.Error(deleteErr))
+		}
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Изображение загружено",
+		zap.Uint64("upload_id", created.ID),
+		zap.Uint64("user_id", userID),
+		zap.String("source", source),
+		zap.String("content_type", contentType),
+		zap.Int64("size", created.Size))
+
+	return created, nil
+}
+
+// allowedExtension возвращает расширение файла, если тип содержимого разрешен конфигурацией
+func (uc *UseCase) allowedExtension(contentType string) (string, bool) {
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return "", false
+	}
+
+	for _, allowed := range uc.cfg.AllowedTypes {
+		if allowed == contentType {
+			return extension, true
+		}
+	}
+
+	return "", false
+}
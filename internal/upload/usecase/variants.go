@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload/imaging"
+	"go.uber.org/zap"
+)
+
+const (
+	// variantsBatchSize количество загрузок, забираемых в обработку за один раз
+	variantsBatchSize = 10
+	// variantsStaleAfter время, после которого зависшая обработка начинается заново
+	variantsStaleAfter = 10 * time.Minute
+	// variantsMaxAttempts количество попыток обработки при временных ошибках хранилища
+	variantsMaxAttempts = 3
+	// maxVariantWidth ограничивает ширину уменьшенной копии
+	maxVariantWidth = 4096
+)
+
+// variantNamePattern ограничивает имена копий, так как имя входит в ключ файла
+var variantNamePattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// VariantSpec описывает уменьшенную копию изображения
+type VariantSpec struct {
+	Name   string
+	Width  int
+	Format imaging.Format
+}
+
+// ParseVariantSpecs разбирает описания копий вида name:width[:format], например thumb:200:jpeg.
+// По умолчанию копии кодируются в JPEG
+func ParseVariantSpecs(values []string) ([]VariantSpec, error) {
+	specs := make([]VariantSpec, 0, len(values))
+	names := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		parts := strings.Split(strings.TrimSpace(value), ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("некорректное описание копии %q, ожидается name:width[:format]", value)
+		}
+
+		spec := VariantSpec{Name: parts[0], Format: imaging.FormatJPEG}
+		if !variantNamePattern.MatchString(spec.Name) {
+			return nil, fmt.Errorf("некорректное имя копии %q", spec.Name)
+		}
+		if names[spec.Name] {
+			return nil, fmt.Errorf("копия %q описана несколько раз", spec.Name)
+		}
+		names[spec.Name] = true
+
+		width, err := strconv.Atoi(parts[1])
+		if err != nil || width <= 0 || width > maxVariantWidth {
+			return nil, fmt.Errorf("некорректная ширина копии %q: %s", spec.Name, parts[1])
+		}
+		spec.Width = width
+
+		if len(parts) == 3 {
+			if spec.Format, err = imaging.ParseFormat(parts[2]); err != nil {
+				return nil, fmt.Errorf("копия %q: %w", spec.Name, err)
+			}
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+// ProcessPendingVariants строит уменьшенные копии для очередной партии загрузок
+// и возвращает количество обработанных загрузок
+func (uc *UseCase) ProcessPendingVariants(ctx context.Context) (int, error) {
+	uploads, err := uc.repo.ClaimPendingUploads(ctx, variantsBatchSize, variantsStaleAfter)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, upload := range uploads {
+		if ctx.Err() != nil {
+			// Оставшиеся загрузки вернутся в очередь по истечении variantsStaleAfter
+			return 0, ctx.Err()
+		}
+
+		variants, err := uc.buildVariants(ctx, upload)
+		if err != nil {
+			permanent := isPermanentImageError(err)
+			uc.log.Warn(ctx, "Не удалось построить уменьшенные копии изображения",
+				zap.Uint64("upload_id", upload.ID),
+				zap.String("key", upload.StorageKey),
+				zap.Bool("permanent", permanent),
+				zap.Error(err))
+			if err := uc.repo.FailUploadVariants(ctx, upload.ID, permanent, variantsMaxAttempts); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err := uc.repo.SaveUploadVariants(ctx, upload.ID, variants); err != nil {
+			return 0, err
+		}
+
+		uc.log.Info(ctx, "Уменьшенные копии изображения построены",
+			zap.Uint64("upload_id", upload.ID),
+			zap.Int("count", len(variants)))
+	}
+
+	return len(uploads), nil
+}
+
+// buildVariants читает исходное изображение из хранилища, строит и сохраняет его уменьшенные копии
+func (uc *UseCase) buildVariants(ctx context.Context, upload *entity.Upload) ([]*entity.ImageVariant, error) {
+	body, err := uc.storage.Get(ctx, upload.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать файл: %w", err)
+	}
+
+	img, err := imaging.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]*entity.ImageVariant, 0, len(uc.cfg.Variants))
+	for _, spec := range uc.cfg.Variants {
+		resized := imaging.Resize(img, spec.Width)
+
+		var buf bytes.Buffer
+		if err := imaging.Encode(&buf, resized, spec.Format, uc.cfg.VariantQuality); err != nil {
+			return nil, fmt.Errorf("не удалось закодировать копию %s: %w", spec.Name, err)
+		}
+
+		key := variantKey(upload.StorageKey, spec)
+		size := int64(buf.Len())
+		if err := uc.storage.Put(ctx, key, &buf, size, spec.Format.ContentType()); err != nil {
+			return nil, err
+		}
+
+		bounds := resized.Bounds()
+		variants = append(variants, &entity.ImageVariant{
+			Name:        spec.Name,
+			StorageKey:  key,
+			URL:         uc.storage.URL(key),
+			ContentType: spec.Format.ContentType(),
+			Width:       uint32(bounds.Dx()),
+			Height:      uint32(bounds.Dy()),
+			Size:        size,
+		})
+	}
+
+	return variants, nil
+}
+
+// variantKey строит ключ копии из ключа исходного файла, например
+// images/2025/08/<uuid>.png -> variants/thumb/images/2025/08/<uuid>.jpg
+func variantKey(key string, spec VariantSpec) string {
+	return path.Join("variants", spec.Name, strings.TrimSuffix(key, path.Ext(key))+spec.Format.Extension())
+}
+
+// isPermanentImageError сообщает, что повторная обработка изображения не поможет
+func isPermanentImageError(err error) bool {
+	return errors.Is(err, imaging.ErrUnsupported) ||
+		errors.Is(err, imaging.ErrCorrupted) ||
+		errors.Is(err, imaging.ErrTooLarge)
+}
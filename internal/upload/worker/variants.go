@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/upload"
+	"go.uber.org/zap"
+)
+
+// VariantsProcessor периодически строит уменьшенные копии загруженных изображений
+type VariantsProcessor struct {
+	uploadUC upload.UseCase
+	interval time.Duration
+	log      *logger.Logger
+}
+
+// NewVariantsProcessor создает новый экземпляр VariantsProcessor
+func NewVariantsProcessor(uploadUC upload.UseCase, interval time.Duration, log *logger.Logger) *VariantsProcessor {
+	return &VariantsProcessor{
+		uploadUC: uploadUC,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run обрабатывает очередь изображений и блокируется до отмены контекста
+func (p *VariantsProcessor) Run(ctx context.Context) {
+	p.log.Info(ctx, "Обработка изображений запущена", zap.Duration("interval", p.interval))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			p.log.Info(ctx, "Обработка изображений остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// process разбирает очередь партиями, пока в ней есть изображения
+func (p *VariantsProcessor) process(ctx context.Context) {
+	for ctx.Err() == nil {
+		processed, err := p.uploadUC.ProcessPendingVariants(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.log.Error(ctx, "Ошибка при обработке изображений", zap.Error(err))
+			}
+			return
+		}
+		if processed == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/adapter"
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/middleware"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/user"
+	users_pb "github.com/Snake1-1eyes/vk_task_marketplace/pkg/api/users"
+	"go.uber.org/zap"
+)
+
+// Handler структура обработчика gRPC запросов
+type Handler struct {
+	users_pb.UnimplementedUsersServiceServer
+	userUC user.UseCase
+	log    *logger.Logger
+}
+
+// New создает новый экземпляр Handler
+func New(userUC user.UseCase, log *logger.Logger) *Handler {
+	return &Handler{
+		userUC: userUC,
+		log:    log,
+	}
+}
+
+// GetUserProfile обрабатывает запрос на получение публичного профиля пользователя
+func (h *Handler) GetUserProfile(ctx context.Context, req *users_pb.GetUserProfileRequest) (*users_pb.UserProfile, error) {
+	profile, err := h.userUC.GetUserProfile(ctx, req.Username)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при получении профиля пользователя",
+			zap.String("username", req.Username),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapUserProfileToProto(profile), nil
+}
+
+// UpdateMyProfile обрабатывает запрос на редактирование профиля текущего пользователя
+func (h *Handler) UpdateMyProfile(ctx context.Context, req *users_pb.UpdateMyProfileRequest) (*users_pb.UserProfile, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		h.log.Warn(ctx, "Попытка редактировать профиль без авторизации")
+		return nil, adapter.MapError(app_errors.ErrUnauthorized)
+	}
+
+	update, err := buildProfileUpdate(req)
+	if err != nil {
+		h.log.Warn(ctx, "Некорректная маска обновления профиля", zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	profile, err := h.userUC.UpdateMyProfile(ctx, userID, update)
+	if err != nil {
+		h.log.Warn(ctx, "Ошибка при обновлении профиля",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return nil, adapter.MapError(err)
+	}
+
+	return adapter.MapUserProfileToProto(profile), nil
+}
+
+// buildProfileUpdate преобразует запрос с маской обновления в частичное обновление профиля
+func buildProfileUpdate(req *users_pb.UpdateMyProfileRequest) (*entity.ProfileUpdate, error) {
+	update := &entity.ProfileUpdate{}
+
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "display_name":
+			update.DisplayName = &req.DisplayName
+		case "avatar_url":
+			update.AvatarURL = &req.AvatarUrl
+		case "bio":
+			update.Bio = &req.Bio
+		case "city":
+			update.City = &req.City
+		default:
+			return nil, app_errors.WrapError(app_errors.ErrValidation, "поле "+path+" не может быть обновлено")
+		}
+	}
+
+	return update, nil
+}
@@ -0,0 +1,18 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+)
+
+type Repository interface {
+	GetProfileByUsername(ctx context.Context, username string) (*entity.UserProfile, error)
+	GetProfileByID(ctx context.Context, id uint64) (*entity.UserProfile, error)
+	UpdateProfile(ctx context.Context, id uint64, update *entity.ProfileUpdate) (*entity.UserProfile, error)
+}
+
+type UseCase interface {
+	GetUserProfile(ctx context.Context, username string) (*entity.UserProfile, error)
+	UpdateMyProfile(ctx context.Context, userID uint64, update *entity.ProfileUpdate) (*entity.UserProfile, error)
+}
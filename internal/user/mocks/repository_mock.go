@@ -0,0 +1,1161 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/user.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_user.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetProfileByID          func(ctx context.Context, id uint64) (up1 *entity.UserProfile, err error)
+	funcGetProfileByIDOrigin    string
+	inspectFuncGetProfileByID   func(ctx context.Context, id uint64)
+	afterGetProfileByIDCounter  uint64
+	beforeGetProfileByIDCounter uint64
+	GetProfileByIDMock          mRepositoryMockGetProfileByID
+
+	funcGetProfileByUsername          func(ctx context.Context, username string) (up1 *entity.UserProfile, err error)
+	funcGetProfileByUsernameOrigin    string
+	inspectFuncGetProfileByUsername   func(ctx context.Context, username string)
+	afterGetProfileByUsernameCounter  uint64
+	beforeGetProfileByUsernameCounter uint64
+	GetProfileByUsernameMock          mRepositoryMockGetProfileByUsername
+
+	funcUpdateProfile          func(ctx context.Context, id uint64, update *entity.ProfileUpdate) (up1 *entity.UserProfile, err error)
+	funcUpdateProfileOrigin    string
+	inspectFuncUpdateProfile   func(ctx context.Context, id uint64, update *entity.ProfileUpdate)
+	afterUpdateProfileCounter  uint64
+	beforeUpdateProfileCounter uint64
+	UpdateProfileMock          mRepositoryMockUpdateProfile
+}
+
+// NewRepositoryMock returns a mock for mm_user.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetProfileByIDMock = mRepositoryMockGetProfileByID{mock: m}
+	m.GetProfileByIDMock.callArgs = []*RepositoryMockGetProfileByIDParams{}
+
+	m.GetProfileByUsernameMock = mRepositoryMockGetProfileByUsername{mock: m}
+	m.GetProfileByUsernameMock.callArgs = []*RepositoryMockGetProfileByUsernameParams{}
+
+	m.UpdateProfileMock = mRepositoryMockUpdateProfile{mock: m}
+	m.UpdateProfileMock.callArgs = []*RepositoryMockUpdateProfileParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetProfileByID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetProfileByIDExpectation
+	expectations       []*RepositoryMockGetProfileByIDExpectation
+
+	callArgs []*RepositoryMockGetProfileByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetProfileByIDExpectation specifies expectation struct of the Repository.GetProfileByID
+type RepositoryMockGetProfileByIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetProfileByIDParams
+	paramPtrs          *RepositoryMockGetProfileByIDParamPtrs
+	expectationOrigins RepositoryMockGetProfileByIDExpectationOrigins
+	results            *RepositoryMockGetProfileByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetProfileByIDParams contains parameters of the Repository.GetProfileByID
+type RepositoryMockGetProfileByIDParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryMockGetProfileByIDParamPtrs contains pointers to parameters of the Repository.GetProfileByID
+type RepositoryMockGetProfileByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryMockGetProfileByIDResults contains results of the Repository.GetProfileByID
+type RepositoryMockGetProfileByIDResults struct {
+	up1 *entity.UserProfile
+	err error
+}
+
+// RepositoryMockGetProfileByIDOrigins contains origins of expectations of the Repository.GetProfileByID
+type RepositoryMockGetProfileByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Optional() *mRepositoryMockGetProfileByID {
+	mmGetProfileByID.optional = true
+	return mmGetProfileByID
+}
+
+// Expect sets up expected params for Repository.GetProfileByID
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Expect(ctx context.Context, id uint64) *mRepositoryMockGetProfileByID {
+	if mmGetProfileByID.mock.funcGetProfileByID != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Set")
+	}
+
+	if mmGetProfileByID.defaultExpectation == nil {
+		mmGetProfileByID.defaultExpectation = &RepositoryMockGetProfileByIDExpectation{}
+	}
+
+	if mmGetProfileByID.defaultExpectation.paramPtrs != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetProfileByID.defaultExpectation.params = &RepositoryMockGetProfileByIDParams{ctx, id}
+	mmGetProfileByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetProfileByID.expectations {
+		if minimock.Equal(e.params, mmGetProfileByID.defaultExpectation.params) {
+			mmGetProfileByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetProfileByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetProfileByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetProfileByID
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetProfileByID {
+	if mmGetProfileByID.mock.funcGetProfileByID != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Set")
+	}
+
+	if mmGetProfileByID.defaultExpectation == nil {
+		mmGetProfileByID.defaultExpectation = &RepositoryMockGetProfileByIDExpectation{}
+	}
+
+	if mmGetProfileByID.defaultExpectation.params != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Expect")
+	}
+
+	if mmGetProfileByID.defaultExpectation.paramPtrs == nil {
+		mmGetProfileByID.defaultExpectation.paramPtrs = &RepositoryMockGetProfileByIDParamPtrs{}
+	}
+	mmGetProfileByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetProfileByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetProfileByID
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.GetProfileByID
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) ExpectIdParam2(id uint64) *mRepositoryMockGetProfileByID {
+	if mmGetProfileByID.mock.funcGetProfileByID != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Set")
+	}
+
+	if mmGetProfileByID.defaultExpectation == nil {
+		mmGetProfileByID.defaultExpectation = &RepositoryMockGetProfileByIDExpectation{}
+	}
+
+	if mmGetProfileByID.defaultExpectation.params != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Expect")
+	}
+
+	if mmGetProfileByID.defaultExpectation.paramPtrs == nil {
+		mmGetProfileByID.defaultExpectation.paramPtrs = &RepositoryMockGetProfileByIDParamPtrs{}
+	}
+	mmGetProfileByID.defaultExpectation.paramPtrs.id = &id
+	mmGetProfileByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetProfileByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetProfileByID
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryMockGetProfileByID {
+	if mmGetProfileByID.mock.inspectFuncGetProfileByID != nil {
+		mmGetProfileByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetProfileByID")
+	}
+
+	mmGetProfileByID.mock.inspectFuncGetProfileByID = f
+
+	return mmGetProfileByID
+}
+
+// Return sets up results that will be returned by Repository.GetProfileByID
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Return(up1 *entity.UserProfile, err error) *RepositoryMock {
+	if mmGetProfileByID.mock.funcGetProfileByID != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Set")
+	}
+
+	if mmGetProfileByID.defaultExpectation == nil {
+		mmGetProfileByID.defaultExpectation = &RepositoryMockGetProfileByIDExpectation{mock: mmGetProfileByID.mock}
+	}
+	mmGetProfileByID.defaultExpectation.results = &RepositoryMockGetProfileByIDResults{up1, err}
+	mmGetProfileByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetProfileByID.mock
+}
+
+// Set uses given function f to mock the Repository.GetProfileByID method
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Set(f func(ctx context.Context, id uint64) (up1 *entity.UserProfile, err error)) *RepositoryMock {
+	if mmGetProfileByID.defaultExpectation != nil {
+		mmGetProfileByID.mock.t.Fatalf("Default expectation is already set for the Repository.GetProfileByID method")
+	}
+
+	if len(mmGetProfileByID.expectations) > 0 {
+		mmGetProfileByID.mock.t.Fatalf("Some expectations are already set for the Repository.GetProfileByID method")
+	}
+
+	mmGetProfileByID.mock.funcGetProfileByID = f
+	mmGetProfileByID.mock.funcGetProfileByIDOrigin = minimock.CallerInfo(1)
+	return mmGetProfileByID.mock
+}
+
+// When sets expectation for the Repository.GetProfileByID which will trigger the result defined by the following
+// Then helper
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) When(ctx context.Context, id uint64) *RepositoryMockGetProfileByIDExpectation {
+	if mmGetProfileByID.mock.funcGetProfileByID != nil {
+		mmGetProfileByID.mock.t.Fatalf("RepositoryMock.GetProfileByID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetProfileByIDExpectation{
+		mock:               mmGetProfileByID.mock,
+		params:             &RepositoryMockGetProfileByIDParams{ctx, id},
+		expectationOrigins: RepositoryMockGetProfileByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetProfileByID.expectations = append(mmGetProfileByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetProfileByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetProfileByIDExpectation) Then(up1 *entity.UserProfile, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetProfileByIDResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetProfileByID should be invoked
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Times(n uint64) *mRepositoryMockGetProfileByID {
+	if n == 0 {
+		mmGetProfileByID.mock.t.Fatalf("Times of RepositoryMock.GetProfileByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetProfileByID.expectedInvocations, n)
+	mmGetProfileByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetProfileByID
+}
+
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) invocationsDone() bool {
+	if len(mmGetProfileByID.expectations) == 0 && mmGetProfileByID.defaultExpectation == nil && mmGetProfileByID.mock.funcGetProfileByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetProfileByID.mock.afterGetProfileByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetProfileByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetProfileByID implements mm_user.Repository
+func (mmGetProfileByID *RepositoryMock) GetProfileByID(ctx context.Context, id uint64) (up1 *entity.UserProfile, err error) {
+	mm_atomic.AddUint64(&mmGetProfileByID.beforeGetProfileByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetProfileByID.afterGetProfileByIDCounter, 1)
+
+	mmGetProfileByID.t.Helper()
+
+	if mmGetProfileByID.inspectFuncGetProfileByID != nil {
+		mmGetProfileByID.inspectFuncGetProfileByID(ctx, id)
+	}
+
+	mm_params := RepositoryMockGetProfileByIDParams{ctx, id}
+
+	// Record call args
+	mmGetProfileByID.GetProfileByIDMock.mutex.Lock()
+	mmGetProfileByID.GetProfileByIDMock.callArgs = append(mmGetProfileByID.GetProfileByIDMock.callArgs, &mm_params)
+	mmGetProfileByID.GetProfileByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetProfileByID.GetProfileByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetProfileByID.GetProfileByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetProfileByID.GetProfileByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetProfileByID.GetProfileByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetProfileByID.GetProfileByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetProfileByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetProfileByID.t.Errorf("RepositoryMock.GetProfileByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProfileByID.GetProfileByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetProfileByID.t.Errorf("RepositoryMock.GetProfileByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProfileByID.GetProfileByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetProfileByID.t.Errorf("RepositoryMock.GetProfileByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetProfileByID.GetProfileByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetProfileByID.GetProfileByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetProfileByID.t.Fatal("No results are set for the RepositoryMock.GetProfileByID")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetProfileByID.funcGetProfileByID != nil {
+		return mmGetProfileByID.funcGetProfileByID(ctx, id)
+	}
+	mmGetProfileByID.t.Fatalf("Unexpected call to RepositoryMock.GetProfileByID. %v %v", ctx, id)
+	return
+}
+
+// GetProfileByIDAfterCounter returns a count of finished RepositoryMock.GetProfileByID invocations
+func (mmGetProfileByID *RepositoryMock) GetProfileByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProfileByID.afterGetProfileByIDCounter)
+}
+
+// GetProfileByIDBeforeCounter returns a count of RepositoryMock.GetProfileByID invocations
+func (mmGetProfileByID *RepositoryMock) GetProfileByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProfileByID.beforeGetProfileByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetProfileByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetProfileByID *mRepositoryMockGetProfileByID) Calls() []*RepositoryMockGetProfileByIDParams {
+	mmGetProfileByID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetProfileByIDParams, len(mmGetProfileByID.callArgs))
+	copy(argCopy, mmGetProfileByID.callArgs)
+
+	mmGetProfileByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetProfileByIDDone returns true if the count of the GetProfileByID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetProfileByIDDone() bool {
+	if m.GetProfileByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetProfileByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetProfileByIDMock.invocationsDone()
+}
+
+// MinimockGetProfileByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetProfileByIDInspect() {
+	for _, e := range m.GetProfileByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetProfileByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetProfileByIDCounter := mm_atomic.LoadUint64(&m.afterGetProfileByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetProfileByIDMock.defaultExpectation != nil && afterGetProfileByIDCounter < 1 {
+		if m.GetProfileByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetProfileByID at\n%s", m.GetProfileByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetProfileByID at\n%s with params: %#v", m.GetProfileByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetProfileByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetProfileByID != nil && afterGetProfileByIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetProfileByID at\n%s", m.funcGetProfileByIDOrigin)
+	}
+
+	if !m.GetProfileByIDMock.invocationsDone() && afterGetProfileByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetProfileByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetProfileByIDMock.expectedInvocations), m.GetProfileByIDMock.expectedInvocationsOrigin, afterGetProfileByIDCounter)
+	}
+}
+
+type mRepositoryMockGetProfileByUsername struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetProfileByUsernameExpectation
+	expectations       []*RepositoryMockGetProfileByUsernameExpectation
+
+	callArgs []*RepositoryMockGetProfileByUsernameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetProfileByUsernameExpectation specifies expectation struct of the Repository.GetProfileByUsername
+type RepositoryMockGetProfileByUsernameExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetProfileByUsernameParams
+	paramPtrs          *RepositoryMockGetProfileByUsernameParamPtrs
+	expectationOrigins RepositoryMockGetProfileByUsernameExpectationOrigins
+	results            *RepositoryMockGetProfileByUsernameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetProfileByUsernameParams contains parameters of the Repository.GetProfileByUsername
+type RepositoryMockGetProfileByUsernameParams struct {
+	ctx      context.Context
+	username string
+}
+
+// RepositoryMockGetProfileByUsernameParamPtrs contains pointers to parameters of the Repository.GetProfileByUsername
+type RepositoryMockGetProfileByUsernameParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// RepositoryMockGetProfileByUsernameResults contains results of the Repository.GetProfileByUsername
+type RepositoryMockGetProfileByUsernameResults struct {
+	up1 *entity.UserProfile
+	err error
+}
+
+// RepositoryMockGetProfileByUsernameOrigins contains origins of expectations of the Repository.GetProfileByUsername
+type RepositoryMockGetProfileByUsernameExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Optional() *mRepositoryMockGetProfileByUsername {
+	mmGetProfileByUsername.optional = true
+	return mmGetProfileByUsername
+}
+
+// Expect sets up expected params for Repository.GetProfileByUsername
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Expect(ctx context.Context, username string) *mRepositoryMockGetProfileByUsername {
+	if mmGetProfileByUsername.mock.funcGetProfileByUsername != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Set")
+	}
+
+	if mmGetProfileByUsername.defaultExpectation == nil {
+		mmGetProfileByUsername.defaultExpectation = &RepositoryMockGetProfileByUsernameExpectation{}
+	}
+
+	if mmGetProfileByUsername.defaultExpectation.paramPtrs != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by ExpectParams functions")
+	}
+
+	mmGetProfileByUsername.defaultExpectation.params = &RepositoryMockGetProfileByUsernameParams{ctx, username}
+	mmGetProfileByUsername.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetProfileByUsername.expectations {
+		if minimock.Equal(e.params, mmGetProfileByUsername.defaultExpectation.params) {
+			mmGetProfileByUsername.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetProfileByUsername.defaultExpectation.params)
+		}
+	}
+
+	return mmGetProfileByUsername
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetProfileByUsername
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetProfileByUsername {
+	if mmGetProfileByUsername.mock.funcGetProfileByUsername != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Set")
+	}
+
+	if mmGetProfileByUsername.defaultExpectation == nil {
+		mmGetProfileByUsername.defaultExpectation = &RepositoryMockGetProfileByUsernameExpectation{}
+	}
+
+	if mmGetProfileByUsername.defaultExpectation.params != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Expect")
+	}
+
+	if mmGetProfileByUsername.defaultExpectation.paramPtrs == nil {
+		mmGetProfileByUsername.defaultExpectation.paramPtrs = &RepositoryMockGetProfileByUsernameParamPtrs{}
+	}
+	mmGetProfileByUsername.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetProfileByUsername.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetProfileByUsername
+}
+
+// ExpectUsernameParam2 sets up expected param username for Repository.GetProfileByUsername
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) ExpectUsernameParam2(username string) *mRepositoryMockGetProfileByUsername {
+	if mmGetProfileByUsername.mock.funcGetProfileByUsername != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Set")
+	}
+
+	if mmGetProfileByUsername.defaultExpectation == nil {
+		mmGetProfileByUsername.defaultExpectation = &RepositoryMockGetProfileByUsernameExpectation{}
+	}
+
+	if mmGetProfileByUsername.defaultExpectation.params != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Expect")
+	}
+
+	if mmGetProfileByUsername.defaultExpectation.paramPtrs == nil {
+		mmGetProfileByUsername.defaultExpectation.paramPtrs = &RepositoryMockGetProfileByUsernameParamPtrs{}
+	}
+	mmGetProfileByUsername.defaultExpectation.paramPtrs.username = &username
+	mmGetProfileByUsername.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetProfileByUsername
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetProfileByUsername
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Inspect(f func(ctx context.Context, username string)) *mRepositoryMockGetProfileByUsername {
+	if mmGetProfileByUsername.mock.inspectFuncGetProfileByUsername != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetProfileByUsername")
+	}
+
+	mmGetProfileByUsername.mock.inspectFuncGetProfileByUsername = f
+
+	return mmGetProfileByUsername
+}
+
+// Return sets up results that will be returned by Repository.GetProfileByUsername
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Return(up1 *entity.UserProfile, err error) *RepositoryMock {
+	if mmGetProfileByUsername.mock.funcGetProfileByUsername != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Set")
+	}
+
+	if mmGetProfileByUsername.defaultExpectation == nil {
+		mmGetProfileByUsername.defaultExpectation = &RepositoryMockGetProfileByUsernameExpectation{mock: mmGetProfileByUsername.mock}
+	}
+	mmGetProfileByUsername.defaultExpectation.results = &RepositoryMockGetProfileByUsernameResults{up1, err}
+	mmGetProfileByUsername.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetProfileByUsername.mock
+}
+
+// Set uses given function f to mock the Repository.GetProfileByUsername method
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Set(f func(ctx context.Context, username string) (up1 *entity.UserProfile, err error)) *RepositoryMock {
+	if mmGetProfileByUsername.defaultExpectation != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("Default expectation is already set for the Repository.GetProfileByUsername method")
+	}
+
+	if len(mmGetProfileByUsername.expectations) > 0 {
+		mmGetProfileByUsername.mock.t.Fatalf("Some expectations are already set for the Repository.GetProfileByUsername method")
+	}
+
+	mmGetProfileByUsername.mock.funcGetProfileByUsername = f
+	mmGetProfileByUsername.mock.funcGetProfileByUsernameOrigin = minimock.CallerInfo(1)
+	return mmGetProfileByUsername.mock
+}
+
+// When sets expectation for the Repository.GetProfileByUsername which will trigger the result defined by the following
+// Then helper
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) When(ctx context.Context, username string) *RepositoryMockGetProfileByUsernameExpectation {
+	if mmGetProfileByUsername.mock.funcGetProfileByUsername != nil {
+		mmGetProfileByUsername.mock.t.Fatalf("RepositoryMock.GetProfileByUsername mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetProfileByUsernameExpectation{
+		mock:               mmGetProfileByUsername.mock,
+		params:             &RepositoryMockGetProfileByUsernameParams{ctx, username},
+		expectationOrigins: RepositoryMockGetProfileByUsernameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetProfileByUsername.expectations = append(mmGetProfileByUsername.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetProfileByUsername return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetProfileByUsernameExpectation) Then(up1 *entity.UserProfile, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetProfileByUsernameResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetProfileByUsername should be invoked
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Times(n uint64) *mRepositoryMockGetProfileByUsername {
+	if n == 0 {
+		mmGetProfileByUsername.mock.t.Fatalf("Times of RepositoryMock.GetProfileByUsername mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetProfileByUsername.expectedInvocations, n)
+	mmGetProfileByUsername.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetProfileByUsername
+}
+
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) invocationsDone() bool {
+	if len(mmGetProfileByUsername.expectations) == 0 && mmGetProfileByUsername.defaultExpectation == nil && mmGetProfileByUsername.mock.funcGetProfileByUsername == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetProfileByUsername.mock.afterGetProfileByUsernameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetProfileByUsername.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetProfileByUsername implements mm_user.Repository
+func (mmGetProfileByUsername *RepositoryMock) GetProfileByUsername(ctx context.Context, username string) (up1 *entity.UserProfile, err error) {
+	mm_atomic.AddUint64(&mmGetProfileByUsername.beforeGetProfileByUsernameCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetProfileByUsername.afterGetProfileByUsernameCounter, 1)
+
+	mmGetProfileByUsername.t.Helper()
+
+	if mmGetProfileByUsername.inspectFuncGetProfileByUsername != nil {
+		mmGetProfileByUsername.inspectFuncGetProfileByUsername(ctx, username)
+	}
+
+	mm_params := RepositoryMockGetProfileByUsernameParams{ctx, username}
+
+	// Record call args
+	mmGetProfileByUsername.GetProfileByUsernameMock.mutex.Lock()
+	mmGetProfileByUsername.GetProfileByUsernameMock.callArgs = append(mmGetProfileByUsername.GetProfileByUsernameMock.callArgs, &mm_params)
+	mmGetProfileByUsername.GetProfileByUsernameMock.mutex.Unlock()
+
+	for _, e := range mmGetProfileByUsername.GetProfileByUsernameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.params
+		mm_want_ptrs := mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetProfileByUsernameParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetProfileByUsername.t.Errorf("RepositoryMock.GetProfileByUsername got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetProfileByUsername.t.Errorf("RepositoryMock.GetProfileByUsername got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetProfileByUsername.t.Errorf("RepositoryMock.GetProfileByUsername got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetProfileByUsername.GetProfileByUsernameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetProfileByUsername.t.Fatal("No results are set for the RepositoryMock.GetProfileByUsername")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetProfileByUsername.funcGetProfileByUsername != nil {
+		return mmGetProfileByUsername.funcGetProfileByUsername(ctx, username)
+	}
+	mmGetProfileByUsername.t.Fatalf("Unexpected call to RepositoryMock.GetProfileByUsername. %v %v", ctx, username)
+	return
+}
+
+// GetProfileByUsernameAfterCounter returns a count of finished RepositoryMock.GetProfileByUsername invocations
+func (mmGetProfileByUsername *RepositoryMock) GetProfileByUsernameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProfileByUsername.afterGetProfileByUsernameCounter)
+}
+
+// GetProfileByUsernameBeforeCounter returns a count of RepositoryMock.GetProfileByUsername invocations
+func (mmGetProfileByUsername *RepositoryMock) GetProfileByUsernameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProfileByUsername.beforeGetProfileByUsernameCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetProfileByUsername.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetProfileByUsername *mRepositoryMockGetProfileByUsername) Calls() []*RepositoryMockGetProfileByUsernameParams {
+	mmGetProfileByUsername.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetProfileByUsernameParams, len(mmGetProfileByUsername.callArgs))
+	copy(argCopy, mmGetProfileByUsername.callArgs)
+
+	mmGetProfileByUsername.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetProfileByUsernameDone returns true if the count of the GetProfileByUsername invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetProfileByUsernameDone() bool {
+	if m.GetProfileByUsernameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetProfileByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetProfileByUsernameMock.invocationsDone()
+}
+
+// MinimockGetProfileByUsernameInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetProfileByUsernameInspect() {
+	for _, e := range m.GetProfileByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetProfileByUsername at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetProfileByUsernameCounter := mm_atomic.LoadUint64(&m.afterGetProfileByUsernameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetProfileByUsernameMock.defaultExpectation != nil && afterGetProfileByUsernameCounter < 1 {
+		if m.GetProfileByUsernameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetProfileByUsername at\n%s", m.GetProfileByUsernameMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetProfileByUsername at\n%s with params: %#v", m.GetProfileByUsernameMock.defaultExpectation.expectationOrigins.origin, *m.GetProfileByUsernameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetProfileByUsername != nil && afterGetProfileByUsernameCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetProfileByUsername at\n%s", m.funcGetProfileByUsernameOrigin)
+	}
+
+	if !m.GetProfileByUsernameMock.invocationsDone() && afterGetProfileByUsernameCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetProfileByUsername at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetProfileByUsernameMock.expectedInvocations), m.GetProfileByUsernameMock.expectedInvocationsOrigin, afterGetProfileByUsernameCounter)
+	}
+}
+
+type mRepositoryMockUpdateProfile struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateProfileExpectation
+	expectations       []*RepositoryMockUpdateProfileExpectation
+
+	callArgs []*RepositoryMockUpdateProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdateProfileExpectation specifies expectation struct of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdateProfileParams
+	paramPtrs          *RepositoryMockUpdateProfileParamPtrs
+	expectationOrigins RepositoryMockUpdateProfileExpectationOrigins
+	results            *RepositoryMockUpdateProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdateProfileParams contains parameters of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileParams struct {
+	ctx    context.Context
+	id     uint64
+	update *entity.ProfileUpdate
+}
+
+// RepositoryMockUpdateProfileParamPtrs contains pointers to parameters of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileParamPtrs struct {
+	ctx    *context.Context
+	id     *uint64
+	update **entity.ProfileUpdate
+}
+
+// RepositoryMockUpdateProfileResults contains results of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileResults struct {
+	up1 *entity.UserProfile
+	err error
+}
+
+// RepositoryMockUpdateProfileOrigins contains origins of expectations of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Optional() *mRepositoryMockUpdateProfile {
+	mmUpdateProfile.optional = true
+	return mmUpdateProfile
+}
+
+// Expect sets up expected params for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Expect(ctx context.Context, id uint64, update *entity.ProfileUpdate) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateProfile.defaultExpectation.params = &RepositoryMockUpdateProfileParams{ctx, id, update}
+	mmUpdateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateProfile.defaultExpectation.params) {
+			mmUpdateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &RepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) ExpectIdParam2(id uint64) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &RepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.id = &id
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
+}
+
+// ExpectUpdateParam3 sets up expected param update for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) ExpectUpdateParam3(update *entity.ProfileUpdate) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &RepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.update = &update
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Inspect(f func(ctx context.Context, id uint64, update *entity.ProfileUpdate)) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateProfile")
+	}
+
+	mmUpdateProfile.mock.inspectFuncUpdateProfile = f
+
+	return mmUpdateProfile
+}
+
+// Return sets up results that will be returned by Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Return(up1 *entity.UserProfile, err error) *RepositoryMock {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{mock: mmUpdateProfile.mock}
+	}
+	mmUpdateProfile.defaultExpectation.results = &RepositoryMockUpdateProfileResults{up1, err}
+	mmUpdateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
+}
+
+// Set uses given function f to mock the Repository.UpdateProfile method
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Set(f func(ctx context.Context, id uint64, update *entity.ProfileUpdate) (up1 *entity.UserProfile, err error)) *RepositoryMock {
+	if mmUpdateProfile.defaultExpectation != nil {
+		mmUpdateProfile.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateProfile method")
+	}
+
+	if len(mmUpdateProfile.expectations) > 0 {
+		mmUpdateProfile.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateProfile method")
+	}
+
+	mmUpdateProfile.mock.funcUpdateProfile = f
+	mmUpdateProfile.mock.funcUpdateProfileOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
+}
+
+// When sets expectation for the Repository.UpdateProfile which will trigger the result defined by the following
+// Then helper
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) When(ctx context.Context, id uint64, update *entity.ProfileUpdate) *RepositoryMockUpdateProfileExpectation {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpdateProfileExpectation{
+		mock:               mmUpdateProfile.mock,
+		params:             &RepositoryMockUpdateProfileParams{ctx, id, update},
+		expectationOrigins: RepositoryMockUpdateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateProfile.expectations = append(mmUpdateProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpdateProfile return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateProfileExpectation) Then(up1 *entity.UserProfile, err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateProfileResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpdateProfile should be invoked
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Times(n uint64) *mRepositoryMockUpdateProfile {
+	if n == 0 {
+		mmUpdateProfile.mock.t.Fatalf("Times of RepositoryMock.UpdateProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateProfile.expectedInvocations, n)
+	mmUpdateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile
+}
+
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) invocationsDone() bool {
+	if len(mmUpdateProfile.expectations) == 0 && mmUpdateProfile.defaultExpectation == nil && mmUpdateProfile.mock.funcUpdateProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.mock.afterUpdateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateProfile implements mm_user.Repository
+func (mmUpdateProfile *RepositoryMock) UpdateProfile(ctx context.Context, id uint64, update *entity.ProfileUpdate) (up1 *entity.UserProfile, err error) {
+	mm_atomic.AddUint64(&mmUpdateProfile.beforeUpdateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateProfile.afterUpdateProfileCounter, 1)
+
+	mmUpdateProfile.t.Helper()
+
+	if mmUpdateProfile.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.inspectFuncUpdateProfile(ctx, id, update)
+	}
+
+	mm_params := RepositoryMockUpdateProfileParams{ctx, id, update}
+
+	// Record call args
+	mmUpdateProfile.UpdateProfileMock.mutex.Lock()
+	mmUpdateProfile.UpdateProfileMock.callArgs = append(mmUpdateProfile.UpdateProfileMock.callArgs, &mm_params)
+	mmUpdateProfile.UpdateProfileMock.mutex.Unlock()
+
+	for _, e := range mmUpdateProfile.UpdateProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUpdateProfile.UpdateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateProfile.UpdateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateProfile.UpdateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateProfile.UpdateProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpdateProfileParams{ctx, id, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateProfile.UpdateProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateProfile.t.Fatal("No results are set for the RepositoryMock.UpdateProfile")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUpdateProfile.funcUpdateProfile != nil {
+		return mmUpdateProfile.funcUpdateProfile(ctx, id, update)
+	}
+	mmUpdateProfile.t.Fatalf("Unexpected call to RepositoryMock.UpdateProfile. %v %v %v", ctx, id, update)
+	return
+}
+
+// UpdateProfileAfterCounter returns a count of finished RepositoryMock.UpdateProfile invocations
+func (mmUpdateProfile *RepositoryMock) UpdateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.afterUpdateProfileCounter)
+}
+
+// UpdateProfileBeforeCounter returns a count of RepositoryMock.UpdateProfile invocations
+func (mmUpdateProfile *RepositoryMock) UpdateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.beforeUpdateProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Calls() []*RepositoryMockUpdateProfileParams {
+	mmUpdateProfile.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockUpdateProfileParams, len(mmUpdateProfile.callArgs))
+	copy(argCopy, mmUpdateProfile.callArgs)
+
+	mmUpdateProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateProfileDone returns true if the count of the UpdateProfile invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpdateProfileDone() bool {
+	if m.UpdateProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateProfileMock.invocationsDone()
+}
+
+// MinimockUpdateProfileInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateProfileInspect() {
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateProfileMock.defaultExpectation != nil && afterUpdateProfileCounter < 1 {
+		if m.UpdateProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s", m.UpdateProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s with params: %#v", m.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *m.UpdateProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateProfile != nil && afterUpdateProfileCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s", m.funcUpdateProfileOrigin)
+	}
+
+	if !m.UpdateProfileMock.invocationsDone() && afterUpdateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateProfileMock.expectedInvocations), m.UpdateProfileMock.expectedInvocationsOrigin, afterUpdateProfileCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetProfileByIDInspect()
+
+			m.MinimockGetProfileByUsernameInspect()
+
+			m.MinimockUpdateProfileInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetProfileByIDDone() &&
+		m.MinimockGetProfileByUsernameDone() &&
+		m.MinimockUpdateProfileDone()
+}
@@ -0,0 +1,805 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Snake1-1eyes/vk_task_marketplace/internal/user.UseCase -o usecase_mock.go -n UseCaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UseCaseMock implements mm_user.UseCase
+type UseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetUserProfile          func(ctx context.Context, username string) (up1 *entity.UserProfile, err error)
+	funcGetUserProfileOrigin    string
+	inspectFuncGetUserProfile   func(ctx context.Context, username string)
+	afterGetUserProfileCounter  uint64
+	beforeGetUserProfileCounter uint64
+	GetUserProfileMock          mUseCaseMockGetUserProfile
+
+	funcUpdateMyProfile          func(ctx context.Context, userID uint64, update *entity.ProfileUpdate) (up1 *entity.UserProfile, err error)
+	funcUpdateMyProfileOrigin    string
+	inspectFuncUpdateMyProfile   func(ctx context.Context, userID uint64, update *entity.ProfileUpdate)
+	afterUpdateMyProfileCounter  uint64
+	beforeUpdateMyProfileCounter uint64
+	UpdateMyProfileMock          mUseCaseMockUpdateMyProfile
+}
+
+// NewUseCaseMock returns a mock for mm_user.UseCase
+func NewUseCaseMock(t minimock.Tester) *UseCaseMock {
+	m := &UseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetUserProfileMock = mUseCaseMockGetUserProfile{mock: m}
+	m.GetUserProfileMock.callArgs = []*UseCaseMockGetUserProfileParams{}
+
+	m.UpdateMyProfileMock = mUseCaseMockUpdateMyProfile{mock: m}
+	m.UpdateMyProfileMock.callArgs = []*UseCaseMockUpdateMyProfileParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUseCaseMockGetUserProfile struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockGetUserProfileExpectation
+	expectations       []*UseCaseMockGetUserProfileExpectation
+
+	callArgs []*UseCaseMockGetUserProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockGetUserProfileExpectation specifies expectation struct of the UseCase.GetUserProfile
+type UseCaseMockGetUserProfileExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockGetUserProfileParams
+	paramPtrs          *UseCaseMockGetUserProfileParamPtrs
+	expectationOrigins UseCaseMockGetUserProfileExpectationOrigins
+	results            *UseCaseMockGetUserProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockGetUserProfileParams contains parameters of the UseCase.GetUserProfile
+type UseCaseMockGetUserProfileParams struct {
+	ctx      context.Context
+	username string
+}
+
+// UseCaseMockGetUserProfileParamPtrs contains pointers to parameters of the UseCase.GetUserProfile
+type UseCaseMockGetUserProfileParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// UseCaseMockGetUserProfileResults contains results of the UseCase.GetUserProfile
+type UseCaseMockGetUserProfileResults struct {
+	up1 *entity.UserProfile
+	err error
+}
+
+// UseCaseMockGetUserProfileOrigins contains origins of expectations of the UseCase.GetUserProfile
+type UseCaseMockGetUserProfileExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Optional() *mUseCaseMockGetUserProfile {
+	mmGetUserProfile.optional = true
+	return mmGetUserProfile
+}
+
+// Expect sets up expected params for UseCase.GetUserProfile
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Expect(ctx context.Context, username string) *mUseCaseMockGetUserProfile {
+	if mmGetUserProfile.mock.funcGetUserProfile != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Set")
+	}
+
+	if mmGetUserProfile.defaultExpectation == nil {
+		mmGetUserProfile.defaultExpectation = &UseCaseMockGetUserProfileExpectation{}
+	}
+
+	if mmGetUserProfile.defaultExpectation.paramPtrs != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserProfile.defaultExpectation.params = &UseCaseMockGetUserProfileParams{ctx, username}
+	mmGetUserProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserProfile.expectations {
+		if minimock.Equal(e.params, mmGetUserProfile.defaultExpectation.params) {
+			mmGetUserProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.GetUserProfile
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) ExpectCtxParam1(ctx context.Context) *mUseCaseMockGetUserProfile {
+	if mmGetUserProfile.mock.funcGetUserProfile != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Set")
+	}
+
+	if mmGetUserProfile.defaultExpectation == nil {
+		mmGetUserProfile.defaultExpectation = &UseCaseMockGetUserProfileExpectation{}
+	}
+
+	if mmGetUserProfile.defaultExpectation.params != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Expect")
+	}
+
+	if mmGetUserProfile.defaultExpectation.paramPtrs == nil {
+		mmGetUserProfile.defaultExpectation.paramPtrs = &UseCaseMockGetUserProfileParamPtrs{}
+	}
+	mmGetUserProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserProfile
+}
+
+// ExpectUsernameParam2 sets up expected param username for UseCase.GetUserProfile
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) ExpectUsernameParam2(username string) *mUseCaseMockGetUserProfile {
+	if mmGetUserProfile.mock.funcGetUserProfile != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Set")
+	}
+
+	if mmGetUserProfile.defaultExpectation == nil {
+		mmGetUserProfile.defaultExpectation = &UseCaseMockGetUserProfileExpectation{}
+	}
+
+	if mmGetUserProfile.defaultExpectation.params != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Expect")
+	}
+
+	if mmGetUserProfile.defaultExpectation.paramPtrs == nil {
+		mmGetUserProfile.defaultExpectation.paramPtrs = &UseCaseMockGetUserProfileParamPtrs{}
+	}
+	mmGetUserProfile.defaultExpectation.paramPtrs.username = &username
+	mmGetUserProfile.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.GetUserProfile
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Inspect(f func(ctx context.Context, username string)) *mUseCaseMockGetUserProfile {
+	if mmGetUserProfile.mock.inspectFuncGetUserProfile != nil {
+		mmGetUserProfile.mock.t.Fatalf("Inspect function is already set for UseCaseMock.GetUserProfile")
+	}
+
+	mmGetUserProfile.mock.inspectFuncGetUserProfile = f
+
+	return mmGetUserProfile
+}
+
+// Return sets up results that will be returned by UseCase.GetUserProfile
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Return(up1 *entity.UserProfile, err error) *UseCaseMock {
+	if mmGetUserProfile.mock.funcGetUserProfile != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Set")
+	}
+
+	if mmGetUserProfile.defaultExpectation == nil {
+		mmGetUserProfile.defaultExpectation = &UseCaseMockGetUserProfileExpectation{mock: mmGetUserProfile.mock}
+	}
+	mmGetUserProfile.defaultExpectation.results = &UseCaseMockGetUserProfileResults{up1, err}
+	mmGetUserProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserProfile.mock
+}
+
+// Set uses given function f to mock the UseCase.GetUserProfile method
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Set(f func(ctx context.Context, username string) (up1 *entity.UserProfile, err error)) *UseCaseMock {
+	if mmGetUserProfile.defaultExpectation != nil {
+		mmGetUserProfile.mock.t.Fatalf("Default expectation is already set for the UseCase.GetUserProfile method")
+	}
+
+	if len(mmGetUserProfile.expectations) > 0 {
+		mmGetUserProfile.mock.t.Fatalf("Some expectations are already set for the UseCase.GetUserProfile method")
+	}
+
+	mmGetUserProfile.mock.funcGetUserProfile = f
+	mmGetUserProfile.mock.funcGetUserProfileOrigin = minimock.CallerInfo(1)
+	return mmGetUserProfile.mock
+}
+
+// When sets expectation for the UseCase.GetUserProfile which will trigger the result defined by the following
+// Then helper
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) When(ctx context.Context, username string) *UseCaseMockGetUserProfileExpectation {
+	if mmGetUserProfile.mock.funcGetUserProfile != nil {
+		mmGetUserProfile.mock.t.Fatalf("UseCaseMock.GetUserProfile mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockGetUserProfileExpectation{
+		mock:               mmGetUserProfile.mock,
+		params:             &UseCaseMockGetUserProfileParams{ctx, username},
+		expectationOrigins: UseCaseMockGetUserProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserProfile.expectations = append(mmGetUserProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.GetUserProfile return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockGetUserProfileExpectation) Then(up1 *entity.UserProfile, err error) *UseCaseMock {
+	e.results = &UseCaseMockGetUserProfileResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.GetUserProfile should be invoked
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Times(n uint64) *mUseCaseMockGetUserProfile {
+	if n == 0 {
+		mmGetUserProfile.mock.t.Fatalf("Times of UseCaseMock.GetUserProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserProfile.expectedInvocations, n)
+	mmGetUserProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserProfile
+}
+
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) invocationsDone() bool {
+	if len(mmGetUserProfile.expectations) == 0 && mmGetUserProfile.defaultExpectation == nil && mmGetUserProfile.mock.funcGetUserProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserProfile.mock.afterGetUserProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserProfile implements mm_user.UseCase
+func (mmGetUserProfile *UseCaseMock) GetUserProfile(ctx context.Context, username string) (up1 *entity.UserProfile, err error) {
+	mm_atomic.AddUint64(&mmGetUserProfile.beforeGetUserProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserProfile.afterGetUserProfileCounter, 1)
+
+	mmGetUserProfile.t.Helper()
+
+	if mmGetUserProfile.inspectFuncGetUserProfile != nil {
+		mmGetUserProfile.inspectFuncGetUserProfile(ctx, username)
+	}
+
+	mm_params := UseCaseMockGetUserProfileParams{ctx, username}
+
+	// Record call args
+	mmGetUserProfile.GetUserProfileMock.mutex.Lock()
+	mmGetUserProfile.GetUserProfileMock.callArgs = append(mmGetUserProfile.GetUserProfileMock.callArgs, &mm_params)
+	mmGetUserProfile.GetUserProfileMock.mutex.Unlock()
+
+	for _, e := range mmGetUserProfile.GetUserProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserProfile.GetUserProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserProfile.GetUserProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserProfile.GetUserProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserProfile.GetUserProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockGetUserProfileParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserProfile.t.Errorf("UseCaseMock.GetUserProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserProfile.GetUserProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserProfile.t.Errorf("UseCaseMock.GetUserProfile got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserProfile.GetUserProfileMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserProfile.t.Errorf("UseCaseMock.GetUserProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserProfile.GetUserProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserProfile.GetUserProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserProfile.t.Fatal("No results are set for the UseCaseMock.GetUserProfile")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserProfile.funcGetUserProfile != nil {
+		return mmGetUserProfile.funcGetUserProfile(ctx, username)
+	}
+	mmGetUserProfile.t.Fatalf("Unexpected call to UseCaseMock.GetUserProfile. %v %v", ctx, username)
+	return
+}
+
+// GetUserProfileAfterCounter returns a count of finished UseCaseMock.GetUserProfile invocations
+func (mmGetUserProfile *UseCaseMock) GetUserProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserProfile.afterGetUserProfileCounter)
+}
+
+// GetUserProfileBeforeCounter returns a count of UseCaseMock.GetUserProfile invocations
+func (mmGetUserProfile *UseCaseMock) GetUserProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserProfile.beforeGetUserProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.GetUserProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserProfile *mUseCaseMockGetUserProfile) Calls() []*UseCaseMockGetUserProfileParams {
+	mmGetUserProfile.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockGetUserProfileParams, len(mmGetUserProfile.callArgs))
+	copy(argCopy, mmGetUserProfile.callArgs)
+
+	mmGetUserProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserProfileDone returns true if the count of the GetUserProfile invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockGetUserProfileDone() bool {
+	if m.GetUserProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserProfileMock.invocationsDone()
+}
+
+// MinimockGetUserProfileInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockGetUserProfileInspect() {
+	for _, e := range m.GetUserProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.GetUserProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserProfileCounter := mm_atomic.LoadUint64(&m.afterGetUserProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserProfileMock.defaultExpectation != nil && afterGetUserProfileCounter < 1 {
+		if m.GetUserProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.GetUserProfile at\n%s", m.GetUserProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.GetUserProfile at\n%s with params: %#v", m.GetUserProfileMock.defaultExpectation.expectationOrigins.origin, *m.GetUserProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserProfile != nil && afterGetUserProfileCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.GetUserProfile at\n%s", m.funcGetUserProfileOrigin)
+	}
+
+	if !m.GetUserProfileMock.invocationsDone() && afterGetUserProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.GetUserProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserProfileMock.expectedInvocations), m.GetUserProfileMock.expectedInvocationsOrigin, afterGetUserProfileCounter)
+	}
+}
+
+type mUseCaseMockUpdateMyProfile struct {
+	optional           bool
+	mock               *UseCaseMock
+	defaultExpectation *UseCaseMockUpdateMyProfileExpectation
+	expectations       []*UseCaseMockUpdateMyProfileExpectation
+
+	callArgs []*UseCaseMockUpdateMyProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UseCaseMockUpdateMyProfileExpectation specifies expectation struct of the UseCase.UpdateMyProfile
+type UseCaseMockUpdateMyProfileExpectation struct {
+	mock               *UseCaseMock
+	params             *UseCaseMockUpdateMyProfileParams
+	paramPtrs          *UseCaseMockUpdateMyProfileParamPtrs
+	expectationOrigins UseCaseMockUpdateMyProfileExpectationOrigins
+	results            *UseCaseMockUpdateMyProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UseCaseMockUpdateMyProfileParams contains parameters of the UseCase.UpdateMyProfile
+type UseCaseMockUpdateMyProfileParams struct {
+	ctx    context.Context
+	userID uint64
+	update *entity.ProfileUpdate
+}
+
+// UseCaseMockUpdateMyProfileParamPtrs contains pointers to parameters of the UseCase.UpdateMyProfile
+type UseCaseMockUpdateMyProfileParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	update **entity.ProfileUpdate
+}
+
+// UseCaseMockUpdateMyProfileResults contains results of the UseCase.UpdateMyProfile
+type UseCaseMockUpdateMyProfileResults struct {
+	up1 *entity.UserProfile
+	err error
+}
+
+// UseCaseMockUpdateMyProfileOrigins contains origins of expectations of the UseCase.UpdateMyProfile
+type UseCaseMockUpdateMyProfileExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Optional() *mUseCaseMockUpdateMyProfile {
+	mmUpdateMyProfile.optional = true
+	return mmUpdateMyProfile
+}
+
+// Expect sets up expected params for UseCase.UpdateMyProfile
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Expect(ctx context.Context, userID uint64, update *entity.ProfileUpdate) *mUseCaseMockUpdateMyProfile {
+	if mmUpdateMyProfile.mock.funcUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Set")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation == nil {
+		mmUpdateMyProfile.defaultExpectation = &UseCaseMockUpdateMyProfileExpectation{}
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateMyProfile.defaultExpectation.params = &UseCaseMockUpdateMyProfileParams{ctx, userID, update}
+	mmUpdateMyProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateMyProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateMyProfile.defaultExpectation.params) {
+			mmUpdateMyProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateMyProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateMyProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UseCase.UpdateMyProfile
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) ExpectCtxParam1(ctx context.Context) *mUseCaseMockUpdateMyProfile {
+	if mmUpdateMyProfile.mock.funcUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Set")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation == nil {
+		mmUpdateMyProfile.defaultExpectation = &UseCaseMockUpdateMyProfileExpectation{}
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.params != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Expect")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateMyProfile.defaultExpectation.paramPtrs = &UseCaseMockUpdateMyProfileParamPtrs{}
+	}
+	mmUpdateMyProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateMyProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateMyProfile
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UseCase.UpdateMyProfile
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) ExpectUserIDParam2(userID uint64) *mUseCaseMockUpdateMyProfile {
+	if mmUpdateMyProfile.mock.funcUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Set")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation == nil {
+		mmUpdateMyProfile.defaultExpectation = &UseCaseMockUpdateMyProfileExpectation{}
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.params != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Expect")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateMyProfile.defaultExpectation.paramPtrs = &UseCaseMockUpdateMyProfileParamPtrs{}
+	}
+	mmUpdateMyProfile.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateMyProfile.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateMyProfile
+}
+
+// ExpectUpdateParam3 sets up expected param update for UseCase.UpdateMyProfile
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) ExpectUpdateParam3(update *entity.ProfileUpdate) *mUseCaseMockUpdateMyProfile {
+	if mmUpdateMyProfile.mock.funcUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Set")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation == nil {
+		mmUpdateMyProfile.defaultExpectation = &UseCaseMockUpdateMyProfileExpectation{}
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.params != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Expect")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateMyProfile.defaultExpectation.paramPtrs = &UseCaseMockUpdateMyProfileParamPtrs{}
+	}
+	mmUpdateMyProfile.defaultExpectation.paramPtrs.update = &update
+	mmUpdateMyProfile.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateMyProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the UseCase.UpdateMyProfile
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Inspect(f func(ctx context.Context, userID uint64, update *entity.ProfileUpdate)) *mUseCaseMockUpdateMyProfile {
+	if mmUpdateMyProfile.mock.inspectFuncUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("Inspect function is already set for UseCaseMock.UpdateMyProfile")
+	}
+
+	mmUpdateMyProfile.mock.inspectFuncUpdateMyProfile = f
+
+	return mmUpdateMyProfile
+}
+
+// Return sets up results that will be returned by UseCase.UpdateMyProfile
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Return(up1 *entity.UserProfile, err error) *UseCaseMock {
+	if mmUpdateMyProfile.mock.funcUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Set")
+	}
+
+	if mmUpdateMyProfile.defaultExpectation == nil {
+		mmUpdateMyProfile.defaultExpectation = &UseCaseMockUpdateMyProfileExpectation{mock: mmUpdateMyProfile.mock}
+	}
+	mmUpdateMyProfile.defaultExpectation.results = &UseCaseMockUpdateMyProfileResults{up1, err}
+	mmUpdateMyProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateMyProfile.mock
+}
+
+// Set uses given function f to mock the UseCase.UpdateMyProfile method
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Set(f func(ctx context.Context, userID uint64, update *entity.ProfileUpdate) (up1 *entity.UserProfile, err error)) *UseCaseMock {
+	if mmUpdateMyProfile.defaultExpectation != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("Default expectation is already set for the UseCase.UpdateMyProfile method")
+	}
+
+	if len(mmUpdateMyProfile.expectations) > 0 {
+		mmUpdateMyProfile.mock.t.Fatalf("Some expectations are already set for the UseCase.UpdateMyProfile method")
+	}
+
+	mmUpdateMyProfile.mock.funcUpdateMyProfile = f
+	mmUpdateMyProfile.mock.funcUpdateMyProfileOrigin = minimock.CallerInfo(1)
+	return mmUpdateMyProfile.mock
+}
+
+// When sets expectation for the UseCase.UpdateMyProfile which will trigger the result defined by the following
+// Then helper
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) When(ctx context.Context, userID uint64, update *entity.ProfileUpdate) *UseCaseMockUpdateMyProfileExpectation {
+	if mmUpdateMyProfile.mock.funcUpdateMyProfile != nil {
+		mmUpdateMyProfile.mock.t.Fatalf("UseCaseMock.UpdateMyProfile mock is already set by Set")
+	}
+
+	expectation := &UseCaseMockUpdateMyProfileExpectation{
+		mock:               mmUpdateMyProfile.mock,
+		params:             &UseCaseMockUpdateMyProfileParams{ctx, userID, update},
+		expectationOrigins: UseCaseMockUpdateMyProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateMyProfile.expectations = append(mmUpdateMyProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UseCase.UpdateMyProfile return parameters for the expectation previously defined by the When method
+func (e *UseCaseMockUpdateMyProfileExpectation) Then(up1 *entity.UserProfile, err error) *UseCaseMock {
+	e.results = &UseCaseMockUpdateMyProfileResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UseCase.UpdateMyProfile should be invoked
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Times(n uint64) *mUseCaseMockUpdateMyProfile {
+	if n == 0 {
+		mmUpdateMyProfile.mock.t.Fatalf("Times of UseCaseMock.UpdateMyProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateMyProfile.expectedInvocations, n)
+	mmUpdateMyProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateMyProfile
+}
+
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) invocationsDone() bool {
+	if len(mmUpdateMyProfile.expectations) == 0 && mmUpdateMyProfile.defaultExpectation == nil && mmUpdateMyProfile.mock.funcUpdateMyProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateMyProfile.mock.afterUpdateMyProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateMyProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateMyProfile implements mm_user.UseCase
+func (mmUpdateMyProfile *UseCaseMock) UpdateMyProfile(ctx context.Context, userID uint64, update *entity.ProfileUpdate) (up1 *entity.UserProfile, err error) {
+	mm_atomic.AddUint64(&mmUpdateMyProfile.beforeUpdateMyProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateMyProfile.afterUpdateMyProfileCounter, 1)
+
+	mmUpdateMyProfile.t.Helper()
+
+	if mmUpdateMyProfile.inspectFuncUpdateMyProfile != nil {
+		mmUpdateMyProfile.inspectFuncUpdateMyProfile(ctx, userID, update)
+	}
+
+	mm_params := UseCaseMockUpdateMyProfileParams{ctx, userID, update}
+
+	// Record call args
+	mmUpdateMyProfile.UpdateMyProfileMock.mutex.Lock()
+	mmUpdateMyProfile.UpdateMyProfileMock.callArgs = append(mmUpdateMyProfile.UpdateMyProfileMock.callArgs, &mm_params)
+	mmUpdateMyProfile.UpdateMyProfileMock.mutex.Unlock()
+
+	for _, e := range mmUpdateMyProfile.UpdateMyProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := UseCaseMockUpdateMyProfileParams{ctx, userID, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateMyProfile.t.Errorf("UseCaseMock.UpdateMyProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateMyProfile.t.Errorf("UseCaseMock.UpdateMyProfile got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateMyProfile.t.Errorf("UseCaseMock.UpdateMyProfile got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateMyProfile.t.Errorf("UseCaseMock.UpdateMyProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateMyProfile.UpdateMyProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateMyProfile.t.Fatal("No results are set for the UseCaseMock.UpdateMyProfile")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUpdateMyProfile.funcUpdateMyProfile != nil {
+		return mmUpdateMyProfile.funcUpdateMyProfile(ctx, userID, update)
+	}
+	mmUpdateMyProfile.t.Fatalf("Unexpected call to UseCaseMock.UpdateMyProfile. %v %v %v", ctx, userID, update)
+	return
+}
+
+// UpdateMyProfileAfterCounter returns a count of finished UseCaseMock.UpdateMyProfile invocations
+func (mmUpdateMyProfile *UseCaseMock) UpdateMyProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateMyProfile.afterUpdateMyProfileCounter)
+}
+
+// UpdateMyProfileBeforeCounter returns a count of UseCaseMock.UpdateMyProfile invocations
+func (mmUpdateMyProfile *UseCaseMock) UpdateMyProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateMyProfile.beforeUpdateMyProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to UseCaseMock.UpdateMyProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateMyProfile *mUseCaseMockUpdateMyProfile) Calls() []*UseCaseMockUpdateMyProfileParams {
+	mmUpdateMyProfile.mutex.RLock()
+
+	argCopy := make([]*UseCaseMockUpdateMyProfileParams, len(mmUpdateMyProfile.callArgs))
+	copy(argCopy, mmUpdateMyProfile.callArgs)
+
+	mmUpdateMyProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateMyProfileDone returns true if the count of the UpdateMyProfile invocations corresponds
+// the number of defined expectations
+func (m *UseCaseMock) MinimockUpdateMyProfileDone() bool {
+	if m.UpdateMyProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMyProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMyProfileMock.invocationsDone()
+}
+
+// MinimockUpdateMyProfileInspect logs each unmet expectation
+func (m *UseCaseMock) MinimockUpdateMyProfileInspect() {
+	for _, e := range m.UpdateMyProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateMyProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateMyProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateMyProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMyProfileMock.defaultExpectation != nil && afterUpdateMyProfileCounter < 1 {
+		if m.UpdateMyProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateMyProfile at\n%s", m.UpdateMyProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UseCaseMock.UpdateMyProfile at\n%s with params: %#v", m.UpdateMyProfileMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMyProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateMyProfile != nil && afterUpdateMyProfileCounter < 1 {
+		m.t.Errorf("Expected call to UseCaseMock.UpdateMyProfile at\n%s", m.funcUpdateMyProfileOrigin)
+	}
+
+	if !m.UpdateMyProfileMock.invocationsDone() && afterUpdateMyProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to UseCaseMock.UpdateMyProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMyProfileMock.expectedInvocations), m.UpdateMyProfileMock.expectedInvocationsOrigin, afterUpdateMyProfileCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetUserProfileInspect()
+
+			m.MinimockUpdateMyProfileInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetUserProfileDone() &&
+		m.MinimockUpdateMyProfileDone()
+}
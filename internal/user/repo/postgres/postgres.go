@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/user"
+	postgresstorage "github.com/Snake1-1eyes/vk_task_marketplace/pkg/postgres_storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// profileColumns перечисляет поля профиля, u — строка таблицы users.
+// Количество активных объявлений считается по неудаленным объявлениям пользователя
+const profileColumns = `
+	u.id, u.username, u.display_name, u.avatar_url, u.bio, u.city, u.created_at,
+	u.rating::float8, u.ratings_count,
+	(
+		SELECT COUNT(*)
+		FROM listings l
+		WHERE l.author_id = u.id AND l.status = 'active' AND l.deleted_at IS NULL
+	)`
+
+type dbManager interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	GetPool() *pgxpool.Pool
+}
+
+// Repository реализует интерфейс user.Repository
+type Repository struct {
+	db        dbManager
+	txManager postgresstorage.Transactor
+	logger    *logger.Logger
+}
+
+// New создает новый экземпляр репозитория
+func New(db dbManager, txManager postgresstorage.Transactor, logger *logger.Logger) user.Repository {
+	return &Repository{
+		db:        db,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+// scanProfile сканирует строку базы данных в структуру UserProfile
+func scanProfile(row pgx.Row) (*entity.UserProfile, error) {
+	profile := &entity.UserProfile{}
+	var createdAt pgtype.Timestamptz
+	var activeListings int64
+
+	err := row.Scan(
+		&profile.ID,
+		&profile.Username,
+		&profile.DisplayName,
+		&profile.AvatarURL,
+		&profile.Bio,
+		&profile.City,
+		&createdAt,
+		&profile.Rating,
+		&profile.RatingsCount,
+		&activeListings,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	profile.CreatedAt = createdAt.Time
+	profile.ActiveListingsCount = uint32(activeListings)
+	return profile, nil
+}
+
+// GetProfileByUsername получает профиль пользователя по имени
+func (r *Repository) GetProfileByUsername(ctx context.Context, username string) (*entity.UserProfile, error) {
+	query := `
+		SELECT ` + profileColumns + `
+		FROM users u
+		WHERE u.username = $1`
+
+	profile, err := scanProfile(r.db.QueryRow(ctx, query, username))
+	if err != nil {
+		if errors.Is(err, app_errors.ErrUserNotFound) {
+			return nil, err
+		}
+		r.logger.Error(ctx, "Ошибка при получении профиля пользователя",
+			zap.String("username", username),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении профиля пользователя")
+	}
+
+	return profile, nil
+}
+
+// GetProfileByID получает профиль пользователя по ID
+func (r *Repository) GetProfileByID(ctx context.Context, id uint64) (*entity.UserProfile, error) {
+	query := `
+		SELECT ` + profileColumns + `
+		FROM users u
+		WHERE u.id = $1`
+
+	profile, err := scanProfile(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, app_errors.ErrUserNotFound) {
+			return nil, err
+		}
+		r.logger.Error(ctx, "Ошибка при получении профиля пользователя",
+			zap.Uint64("user_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при получении профиля пользователя")
+	}
+
+	return profile, nil
+}
+
+// UpdateProfile обновляет переданные поля профиля пользователя и возвращает обновленный профиль
+func (r *Repository) UpdateProfile(ctx context.Context, id uint64, update *entity.ProfileUpdate) (*entity.UserProfile, error) {
+	query := `
+		WITH u AS (
+			UPDATE users SET
+				display_name = COALESCE($2::text, display_name),
+				avatar_url = COALESCE($3::text, avatar_url),
+				bio = COALESCE($4::text, bio),
+				city = COALESCE($5::text, city),
+				updated_at = NOW()
+			WHERE id = $1
+			RETURNING *
+		)
+		SELECT ` + profileColumns + `
+		FROM u`
+
+	profile, err := scanProfile(r.db.QueryRow(ctx, query, id, update.DisplayName, update.AvatarURL, update.Bio, update.City))
+	if err != nil {
+		if errors.Is(err, app_errors.ErrUserNotFound) {
+			return nil, err
+		}
+		r.logger.Error(ctx, "Ошибка при обновлении профиля пользователя",
+			zap.Uint64("user_id", id),
+			zap.Error(err))
+		return nil, app_errors.WrapError(err, "ошибка при обновлении профиля пользователя")
+	}
+
+	r.logger.Info(ctx, "Профиль пользователя обновлен", zap.Uint64("user_id", id))
+
+	return profile, nil
+}
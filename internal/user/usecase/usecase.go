@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	app_errors "github.com/Snake1-1eyes/vk_task_marketplace/internal/app_errors"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/entity"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/logger"
+	"github.com/Snake1-1eyes/vk_task_marketplace/internal/user"
+	"go.uber.org/zap"
+)
+
+const (
+	maxDisplayNameLength = 50
+	maxBioLength         = 500
+	maxCityLength        = 100
+)
+
+// UseCase реализует интерфейс user.UseCase
+type UseCase struct {
+	repo user.Repository
+	log  *logger.Logger
+}
+
+// New создает новый экземпляр UseCase
+func New(repo user.Repository, log *logger.Logger) *UseCase {
+	return &UseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// GetUserProfile возвращает публичный профиль пользователя по имени
+func (uc *UseCase) GetUserProfile(ctx context.Context, username string) (*entity.UserProfile, error) {
+	profile, err := uc.repo.GetProfileByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx, "Получен профиль пользователя",
+		zap.String("username", username),
+		zap.Uint64("user_id", profile.ID))
+
+	return profile, nil
+}
+
+// UpdateMyProfile обновляет профиль текущего пользователя.
+// Значения полей очищаются от пробелов по краям, пустая строка очищает поле
+func (uc *UseCase) UpdateMyProfile(ctx context.Context, userID uint64, update *entity.ProfileUpdate) (*entity.UserProfile, error) {
+	if update.IsEmpty() {
+		return nil, app_errors.WrapError(app_errors.ErrValidation, "не указаны поля для обновления")
+	}
+
+	if err := normalizeProfileUpdate(update); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.repo.UpdateProfile(ctx, userID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// normalizeProfileUpdate очищает значения полей профиля и проверяет их длину и формат ссылки на аватар
+func normalizeProfileUpdate(update *entity.ProfileUpdate) error {
+	fields := []struct {
+		value  *string
+		name   string
+		maxLen int
+	}{
+		{update.DisplayName, "отображаемое имя", maxDisplayNameLength},
+		{update.Bio, "описание профиля", maxBioLength},
+		{update.City, "город", maxCityLength},
+	}
+
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+		*field.value = strings.TrimSpace(*field.value)
+		if utf8.RuneCountInString(*field.value) > field.maxLen {
+			return app_errors.WrapError(app_errors.ErrValidation,
+				fmt.Sprintf("%s не может быть длиннее %d символов", field.name, field.maxLen))
+		}
+	}
+
+	if update.AvatarURL != nil {
+		*update.AvatarURL = strings.TrimSpace(*update.AvatarURL)
+		if *update.AvatarURL != "" && !isHTTPURL(*update.AvatarURL) {
+			return app_errors.WrapError(app_errors.ErrValidation, "ссылка на аватар должна быть абсолютной ссылкой http или https")
+		}
+	}
+
+	return nil
+}
+
+// isHTTPURL проверяет, что строка является абсолютной ссылкой http или https
+func isHTTPURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
@@ -20,15 +20,16 @@ type JWTConfig struct {
 	TokenDuration time.Duration
 }
 
-// GenerateJWT генерирует JWT токен для пользователя
-func GenerateJWT(userID uint64, config JWTConfig) (string, error) {
-	expirationTime := time.Now().Add(config.TokenDuration)
+// GenerateJWT генерирует JWT токен для пользователя и возвращает его вместе со временем истечения
+func GenerateJWT(userID uint64, config JWTConfig) (string, time.Time, error) {
+	now := time.Now()
+	expirationTime := now.Add(config.TokenDuration)
 
 	claims := &JWTClaims{
 		UserID: userID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 
@@ -36,14 +37,14 @@ func GenerateJWT(userID uint64, config JWTConfig) (string, error) {
 	tokenString, err := token.SignedString([]byte(config.SecretKey))
 
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
-	return tokenString, nil
+	return tokenString, expirationTime, nil
 }
 
-// VerifyToken проверяет токен и возвращает ID пользователя
-func VerifyToken(tokenString string, secretKey string) (uint64, error) {
+// VerifyToken проверяет токен и возвращает его данные
+func VerifyToken(tokenString string, secretKey string) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("неожиданный метод подписи: %v", token.Header["alg"])
@@ -52,12 +53,12 @@ func VerifyToken(tokenString string, secretKey string) (uint64, error) {
 	})
 
 	if err != nil {
-		return 0, app_errors.ErrInvalidToken
+		return nil, app_errors.ErrInvalidToken
 	}
 
 	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-		return claims.UserID, nil
+		return claims, nil
 	}
 
-	return 0, app_errors.ErrInvalidToken
+	return nil, app_errors.ErrInvalidToken
 }
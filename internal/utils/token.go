@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// refreshTokenBytes определяет количество случайных байт в refresh-токене
+const refreshTokenBytes = 32
+
+// GenerateRefreshToken генерирует непрозрачный refresh-токен из криптографически случайных байт
+func GenerateRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken возвращает SHA-256 хеш токена для хранения в базе данных.
+// Токен содержит достаточно случайных байт, поэтому медленное хеширование, как для паролей, не требуется
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE listings
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE listings SET updated_at = created_at;
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE listings
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS version;
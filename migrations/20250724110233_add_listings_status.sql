@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE listings
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active'
+        CHECK (status IN ('draft', 'active', 'reserved', 'sold', 'archived'));
+CREATE INDEX IF NOT EXISTS idx_listings_status ON listings(status);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_listings_status;
+ALTER TABLE listings DROP COLUMN IF EXISTS status;
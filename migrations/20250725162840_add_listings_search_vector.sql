@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', title), 'A') ||
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('russian', description), 'B') ||
+        setweight(to_tsvector('english', description), 'B')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_listings_search_vector ON listings USING GIN (search_vector);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_listings_search_vector;
+ALTER TABLE listings DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS categories (
+    id BIGSERIAL PRIMARY KEY,
+    parent_id BIGINT REFERENCES categories(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories(parent_id);
+
+INSERT INTO categories (parent_id, name, slug, position) VALUES
+    (NULL, 'Электроника', 'electronics', 1),
+    (NULL, 'Транспорт', 'transport', 2),
+    (NULL, 'Недвижимость', 'real-estate', 3),
+    (NULL, 'Другое', 'other', 100);
+
+INSERT INTO categories (parent_id, name, slug, position)
+SELECT c.id, v.name, v.slug, v.position
+FROM (VALUES
+    ('electronics', 'Ноутбуки', 'laptops', 1),
+    ('electronics', 'Телефоны', 'phones', 2),
+    ('transport', 'Автомобили', 'cars', 1),
+    ('transport', 'Велосипеды', 'bicycles', 2)
+) AS v(parent_slug, name, slug, position)
+JOIN categories c ON c.slug = v.parent_slug;
+
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES categories(id) ON DELETE RESTRICT;
+UPDATE listings SET category_id = (SELECT id FROM categories WHERE slug = 'other') WHERE category_id IS NULL;
+ALTER TABLE listings ALTER COLUMN category_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_listings_category_id ON listings(category_id);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_listings_category_id;
+ALTER TABLE listings DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS category_attributes (
+    id BIGSERIAL PRIMARY KEY,
+    category_id BIGINT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(10) NOT NULL CHECK (type IN ('string', 'number', 'enum', 'bool')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    min_value NUMERIC,
+    max_value NUMERIC,
+    max_length INT,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    position INT NOT NULL DEFAULT 0,
+    UNIQUE (category_id, key)
+);
+
+INSERT INTO category_attributes (category_id, key, name, type, required, min_value, max_value, max_length, options, position)
+SELECT c.id, v.key, v.name, v.type, v.required, v.min_value, v.max_value, v.max_length, v.options, v.position
+FROM (VALUES
+    ('electronics', 'condition', 'Состояние', 'enum', TRUE, NULL::NUMERIC, NULL::NUMERIC, NULL::INT, ARRAY['new', 'used'], 1),
+    ('electronics', 'brand', 'Производитель', 'string', FALSE, NULL, NULL, 50, ARRAY[]::TEXT[], 2),
+    ('laptops', 'ram_gb', 'Оперативная память, ГБ', 'number', TRUE, 1, 512, NULL, ARRAY[]::TEXT[], 1),
+    ('laptops', 'screen_size', 'Диагональ экрана, дюймы', 'number', FALSE, 10, 21, NULL, ARRAY[]::TEXT[], 2),
+    ('phones', 'storage_gb', 'Встроенная память, ГБ', 'number', FALSE, 1, 2048, NULL, ARRAY[]::TEXT[], 1),
+    ('cars', 'mileage_km', 'Пробег, км', 'number', TRUE, 0, 5000000, NULL, ARRAY[]::TEXT[], 1),
+    ('cars', 'year', 'Год выпуска', 'number', FALSE, 1900, 2100, NULL, ARRAY[]::TEXT[], 2),
+    ('cars', 'transmission', 'Коробка передач', 'enum', FALSE, NULL, NULL, NULL, ARRAY['manual', 'automatic', 'robot', 'variator'], 3),
+    ('bicycles', 'electric', 'Электровелосипед', 'bool', FALSE, NULL, NULL, NULL, ARRAY[]::TEXT[], 1)
+) AS v(category_slug, key, name, type, required, min_value, max_value, max_length, options, position)
+JOIN categories c ON c.slug = v.category_slug;
+
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE listings DROP COLUMN IF EXISTS attributes;
+DROP TABLE IF EXISTS category_attributes;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+CREATE INDEX IF NOT EXISTS idx_listings_currency_price ON listings(currency, price);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_listings_currency_price;
+ALTER TABLE listings DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency CHAR(3) PRIMARY KEY,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Курс задается как стоимость единицы валюты в базовой валюте, для базовой валюты он равен 1
+INSERT INTO exchange_rates (currency, rate) VALUES
+    ('RUB', 1),
+    ('USD', 90),
+    ('EUR', 98)
+ON CONFLICT (currency) DO NOTHING;
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS exchange_rates;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS listing_images (
+    id BIGSERIAL PRIMARY KEY,
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    position INT NOT NULL CHECK (position >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Проверка откладывается до конца транзакции, чтобы позиции можно было переставлять
+    CONSTRAINT listing_images_listing_id_position_key UNIQUE (listing_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+
+-- Существующая картинка объявления становится обложкой галереи.
+-- listings.image_url и дальше хранит обложку, чтобы лента не обращалась к галерее
+INSERT INTO listing_images (listing_id, url, position)
+SELECT id, image_url, 0 FROM listings WHERE image_url <> '';
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS listing_images;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Уже загруженные изображения получают статус pending и обрабатываются фоновым процессом
+ALTER TABLE uploads
+    ADD COLUMN IF NOT EXISTS variants_status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (variants_status IN ('pending', 'processing', 'ready', 'failed')),
+    ADD COLUMN IF NOT EXISTS variants_attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS variants_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_uploads_variants_queue ON uploads(id)
+    WHERE variants_status IN ('pending', 'processing');
+CREATE INDEX IF NOT EXISTS idx_uploads_url ON uploads(url);
+
+CREATE TABLE IF NOT EXISTS upload_variants (
+    upload_id BIGINT NOT NULL REFERENCES uploads(id) ON DELETE CASCADE,
+    name VARCHAR(32) NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    size BIGINT NOT NULL CHECK (size > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (upload_id, name)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS upload_variants;
+DROP INDEX IF EXISTS idx_uploads_url;
+DROP INDEX IF EXISTS idx_uploads_variants_queue;
+ALTER TABLE uploads
+    DROP COLUMN IF EXISTS variants_updated_at,
+    DROP COLUMN IF EXISTS variants_attempts,
+    DROP COLUMN IF EXISTS variants_status;
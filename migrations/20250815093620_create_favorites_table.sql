@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS favorites (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, listing_id)
+);
+-- Первичный ключ покрывает выборку избранного пользователя, индекс нужен для подсчета добавлений объявления
+CREATE INDEX IF NOT EXISTS idx_favorites_listing_id ON favorites(listing_id);
+CREATE INDEX IF NOT EXISTS idx_favorites_user_id_created_at ON favorites(user_id, created_at DESC);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS favorites;
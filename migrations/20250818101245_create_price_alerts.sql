@@ -0,0 +1,40 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS listing_price_history (
+    id BIGSERIAL PRIMARY KEY,
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    price NUMERIC(10, 2) NOT NULL CHECK (price > 0),
+    currency CHAR(3) NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_listing_price_history_listing_id ON listing_price_history(listing_id, changed_at DESC);
+
+-- Текущая цена существующих объявлений становится первой записью истории
+INSERT INTO listing_price_history (listing_id, price, currency, changed_at)
+SELECT id, price, currency, created_at FROM listings;
+
+-- Порог цены, при достижении которого пользователь получает уведомление
+ALTER TABLE favorites
+    ADD COLUMN IF NOT EXISTS alert_price NUMERIC(10, 2) CHECK (alert_price > 0),
+    ADD COLUMN IF NOT EXISTS alert_currency CHAR(3),
+    ADD CONSTRAINT favorites_alert_price_currency_check CHECK ((alert_price IS NULL) = (alert_currency IS NULL));
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    listing_id BIGINT REFERENCES listings(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    message TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications(user_id, id DESC);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS notifications;
+ALTER TABLE favorites
+    DROP CONSTRAINT IF EXISTS favorites_alert_price_currency_check,
+    DROP COLUMN IF EXISTS alert_currency,
+    DROP COLUMN IF EXISTS alert_price;
+DROP TABLE IF EXISTS listing_price_history;
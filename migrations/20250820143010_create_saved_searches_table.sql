@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Время, когда объявление впервые стало активным: при создании или публикации черновика.
+-- По нему сохраненные поиски находят новые объявления
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+UPDATE listings SET published_at = created_at WHERE status <> 'draft';
+CREATE INDEX IF NOT EXISTS idx_listings_published_at ON listings(published_at) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    filter JSONB NOT NULL DEFAULT '{}',
+    frequency VARCHAR(16) NOT NULL DEFAULT 'instant' CHECK (frequency IN ('instant', 'daily')),
+    -- Объявления, опубликованные не позже last_published_at, уже проверены на соответствие поиску
+    last_published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user_id ON saved_searches(user_id, id);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS saved_searches;
+DROP INDEX IF EXISTS idx_listings_published_at;
+ALTER TABLE listings DROP COLUMN IF EXISTS published_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS display_name VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS avatar_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS bio VARCHAR(500) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS city VARCHAR(100) NOT NULL DEFAULT '',
+    -- Средняя оценка продавца и количество оценок, пока отзывов нет, рейтинг равен 0
+    ADD COLUMN IF NOT EXISTS rating NUMERIC(3, 2) NOT NULL DEFAULT 0 CHECK (rating BETWEEN 0 AND 5),
+    ADD COLUMN IF NOT EXISTS ratings_count INTEGER NOT NULL DEFAULT 0 CHECK (ratings_count >= 0),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+-- Индекс для подсчета активных объявлений в профиле пользователя
+CREATE INDEX IF NOT EXISTS idx_listings_author_id_status ON listings(author_id, status) WHERE deleted_at IS NULL;
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_listings_author_id_status;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS ratings_count,
+    DROP COLUMN IF EXISTS rating,
+    DROP COLUMN IF EXISTS city,
+    DROP COLUMN IF EXISTS bio,
+    DROP COLUMN IF EXISTS avatar_url,
+    DROP COLUMN IF EXISTS display_name;
@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: categories/categories.proto
+
+package api
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	AttributeType_ATTRIBUTE_TYPE_NUMBER      AttributeType = 2
+	AttributeType_ATTRIBUTE_TYPE_ENUM        AttributeType = 3
+	AttributeType_ATTRIBUTE_TYPE_BOOL        AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_ENUM",
+		4: "ATTRIBUTE_TYPE_BOOL",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_ENUM":        3,
+		"ATTRIBUTE_TYPE_BOOL":        4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_categories_categories_proto_enumTypes[0].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_categories_categories_proto_enumTypes[0]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{0}
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      *uint64                `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_categories_categories_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListCategoriesRequest) GetParentId() uint64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_categories_categories_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootId        *uint64                `protobuf:"varint,1,opt,name=root_id,json=rootId,proto3,oneof" json:"root_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_categories_categories_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCategoryTreeRequest) GetRootId() uint64 {
+	if x != nil && x.RootId != nil {
+		return *x.RootId
+	}
+	return 0
+}
+
+type CategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*CategoryNode        `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeResponse) Reset() {
+	*x = CategoryTreeResponse{}
+	mi := &file_categories_categories_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeResponse) ProtoMessage() {}
+
+func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*CategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CategoryTreeResponse) GetRoots() []*CategoryNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type GetCategoryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryAttributesRequest) Reset() {
+	*x = GetCategoryAttributesRequest{}
+	mi := &file_categories_categories_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *GetCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryAttributesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*CategoryAttribute   `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttributesResponse) Reset() {
+	*x = CategoryAttributesResponse{}
+	mi := &file_categories_categories_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributesResponse) ProtoMessage() {}
+
+func (x *CategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*CategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CategoryAttributesResponse) GetAttributes() []*CategoryAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CategoryAttribute struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Key       string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type      AttributeType          `protobuf:"varint,3,opt,name=type,proto3,enum=categories.AttributeType" json:"type,omitempty"`
+	Required  bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	MinValue  *float64               `protobuf:"fixed64,5,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue  *float64               `protobuf:"fixed64,6,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	MaxLength *int32                 `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
+	Options   []string               `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`
+	// Категория, в которой объявлен атрибут
+	CategoryId    uint64 `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttribute) Reset() {
+	*x = CategoryAttribute{}
+	mi := &file_categories_categories_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttribute) ProtoMessage() {}
+
+func (x *CategoryAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttribute.ProtoReflect.Descriptor instead.
+func (*CategoryAttribute) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CategoryAttribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CategoryAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryAttribute) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *CategoryAttribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CategoryAttribute) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *CategoryAttribute) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *CategoryAttribute) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *CategoryAttribute) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CategoryAttribute) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      *uint64                `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_categories_categories_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Category) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetParentId() uint64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_categories_categories_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_categories_categories_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_categories_categories_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+var File_categories_categories_proto protoreflect.FileDescriptor
+
+const file_categories_categories_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcategories/categories.proto\x12\n" +
+	"categories\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"G\n" +
+	"\x15ListCategoriesRequest\x12 \n" +
+	"\tparent_id\x18\x01 \x01(\x04H\x00R\bparentId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_id\"N\n" +
+	"\x16ListCategoriesResponse\x124\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x14.categories.CategoryR\n" +
+	"categories\"B\n" +
+	"\x16GetCategoryTreeRequest\x12\x1c\n" +
+	"\aroot_id\x18\x01 \x01(\x04H\x00R\x06rootId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_root_id\"F\n" +
+	"\x14CategoryTreeResponse\x12.\n" +
+	"\x05roots\x18\x01 \x03(\v2\x18.categories.CategoryNodeR\x05roots\".\n" +
+	"\x1cGetCategoryAttributesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"[\n" +
+	"\x1aCategoryAttributesResponse\x12=\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x1d.categories.CategoryAttributeR\n" +
+	"attributes\"\xd2\x02\n" +
+	"\x11CategoryAttribute\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.categories.AttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12 \n" +
+	"\tmin_value\x18\x05 \x01(\x01H\x00R\bminValue\x88\x01\x01\x12 \n" +
+	"\tmax_value\x18\x06 \x01(\x01H\x01R\bmaxValue\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_length\x18\a \x01(\x05H\x02R\tmaxLength\x88\x01\x01\x12\x18\n" +
+	"\aoptions\x18\b \x03(\tR\aoptions\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\x04R\n" +
+	"categoryIdB\f\n" +
+	"\n" +
+	"_min_valueB\f\n" +
+	"\n" +
+	"_max_valueB\r\n" +
+	"\v_max_length\"r\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\x04H\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slugB\f\n" +
+	"\n" +
+	"_parent_id\"v\n" +
+	"\fCategoryNode\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.categories.CategoryR\bcategory\x124\n" +
+	"\bchildren\x18\x02 \x03(\v2\x18.categories.CategoryNodeR\bchildren*\x97\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_ENUM\x10\x03\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_BOOL\x10\x042\xa5\b\n" +
+	"\x11CategoriesService\x12\xe7\x02\n" +
+	"\x0eListCategories\x12!.categories.ListCategoriesRequest\x1a\".categories.ListCategoriesResponse\"\x8d\x02\x92A\xf3\x01\x122Получение списка категорий\x1a\xbc\x01Возвращает дочерние категории указанной категории или категории верхнего уровня, если parent_id не указан\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12\xa4\x02\n" +
+	"\x0fGetCategoryTree\x12\".categories.GetCategoryTreeRequest\x1a .categories.CategoryTreeResponse\"\xca\x01\x92A\xab\x01\x122Получение дерева категорий\x1auВозвращает дерево категорий целиком или поддерево с корнем root_id\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/tree\x12\xfe\x02\n" +
+	"\x15GetCategoryAttributes\x12(.categories.GetCategoryAttributesRequest\x1a&.categories.CategoryAttributesResponse\"\x92\x02\x92A\xe8\x01\x128Получение атрибутов категории\x1a\xab\x01Возвращает атрибуты объявлений категории, включая унаследованные от родительских категорий\x82\xd3\xe4\x93\x02 \x12\x1e/v1/categories/{id}/attributesB\xee\x01\x92A\xbd\x01\x12\x83\x01\n" +
+	"\x1aMarketplace Categories API\x12^API для просмотра категорий объявлений маркетплейса2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ+github.com/Snake1-1eyes/marketplace/pkg/apib\x06proto3"
+
+var (
+	file_categories_categories_proto_rawDescOnce sync.Once
+	file_categories_categories_proto_rawDescData []byte
+)
+
+func file_categories_categories_proto_rawDescGZIP() []byte {
+	file_categories_categories_proto_rawDescOnce.Do(func() {
+		file_categories_categories_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_categories_categories_proto_rawDesc), len(file_categories_categories_proto_rawDesc)))
+	})
+	return file_categories_categories_proto_rawDescData
+}
+
+var file_categories_categories_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_categories_categories_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_categories_categories_proto_goTypes = []any{
+	(AttributeType)(0),                   // 0: categories.AttributeType
+	(*ListCategoriesRequest)(nil),        // 1: categories.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),       // 2: categories.ListCategoriesResponse
+	(*GetCategoryTreeRequest)(nil),       // 3: categories.GetCategoryTreeRequest
+	(*CategoryTreeResponse)(nil),         // 4: categories.CategoryTreeResponse
+	(*GetCategoryAttributesRequest)(nil), // 5: categories.GetCategoryAttributesRequest
+	(*CategoryAttributesResponse)(nil),   // 6: categories.CategoryAttributesResponse
+	(*CategoryAttribute)(nil),            // 7: categories.CategoryAttribute
+	(*Category)(nil),                     // 8: categories.Category
+	(*CategoryNode)(nil),                 // 9: categories.CategoryNode
+}
+var file_categories_categories_proto_depIdxs = []int32{
+	8, // 0: categories.ListCategoriesResponse.categories:type_name -> categories.Category
+	9, // 1: categories.CategoryTreeResponse.roots:type_name -> categories.CategoryNode
+	7, // 2: categories.CategoryAttributesResponse.attributes:type_name -> categories.CategoryAttribute
+	0, // 3: categories.CategoryAttribute.type:type_name -> categories.AttributeType
+	8, // 4: categories.CategoryNode.category:type_name -> categories.Category
+	9, // 5: categories.CategoryNode.children:type_name -> categories.CategoryNode
+	1, // 6: categories.CategoriesService.ListCategories:input_type -> categories.ListCategoriesRequest
+	3, // 7: categories.CategoriesService.GetCategoryTree:input_type -> categories.GetCategoryTreeRequest
+	5, // 8: categories.CategoriesService.GetCategoryAttributes:input_type -> categories.GetCategoryAttributesRequest
+	2, // 9: categories.CategoriesService.ListCategories:output_type -> categories.ListCategoriesResponse
+	4, // 10: categories.CategoriesService.GetCategoryTree:output_type -> categories.CategoryTreeResponse
+	6, // 11: categories.CategoriesService.GetCategoryAttributes:output_type -> categories.CategoryAttributesResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_categories_categories_proto_init() }
+func file_categories_categories_proto_init() {
+	if File_categories_categories_proto != nil {
+		return
+	}
+	file_categories_categories_proto_msgTypes[0].OneofWrappers = []any{}
+	file_categories_categories_proto_msgTypes[2].OneofWrappers = []any{}
+	file_categories_categories_proto_msgTypes[6].OneofWrappers = []any{}
+	file_categories_categories_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_categories_categories_proto_rawDesc), len(file_categories_categories_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_categories_categories_proto_goTypes,
+		DependencyIndexes: file_categories_categories_proto_depIdxs,
+		EnumInfos:         file_categories_categories_proto_enumTypes,
+		MessageInfos:      file_categories_categories_proto_msgTypes,
+	}.Build()
+	File_categories_categories_proto = out.File
+	file_categories_categories_proto_goTypes = nil
+	file_categories_categories_proto_depIdxs = nil
+}
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: categories/categories.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CategoriesService_ListCategories_FullMethodName        = "/categories.CategoriesService/ListCategories"
+	CategoriesService_GetCategoryTree_FullMethodName       = "/categories.CategoriesService/GetCategoryTree"
+	CategoriesService_GetCategoryAttributes_FullMethodName = "/categories.CategoriesService/GetCategoryAttributes"
+)
+
+// CategoriesServiceClient is the client API for CategoriesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoriesServiceClient interface {
+	// Получение списка категорий
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// Получение дерева категорий
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*CategoryTreeResponse, error)
+	// Получение схемы атрибутов категории
+	GetCategoryAttributes(ctx context.Context, in *GetCategoryAttributesRequest, opts ...grpc.CallOption) (*CategoryAttributesResponse, error)
+}
+
+type categoriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoriesServiceClient(cc grpc.ClientConnInterface) CategoriesServiceClient {
+	return &categoriesServiceClient{cc}
+}
+
+func (c *categoriesServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, CategoriesService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoriesServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*CategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryTreeResponse)
+	err := c.cc.Invoke(ctx, CategoriesService_GetCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoriesServiceClient) GetCategoryAttributes(ctx context.Context, in *GetCategoryAttributesRequest, opts ...grpc.CallOption) (*CategoryAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttributesResponse)
+	err := c.cc.Invoke(ctx, CategoriesService_GetCategoryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoriesServiceServer is the server API for CategoriesService service.
+// All implementations must embed UnimplementedCategoriesServiceServer
+// for forward compatibility.
+type CategoriesServiceServer interface {
+	// Получение списка категорий
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// Получение дерева категорий
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*CategoryTreeResponse, error)
+	// Получение схемы атрибутов категории
+	GetCategoryAttributes(context.Context, *GetCategoryAttributesRequest) (*CategoryAttributesResponse, error)
+	mustEmbedUnimplementedCategoriesServiceServer()
+}
+
+// UnimplementedCategoriesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCategoriesServiceServer struct{}
+
+func (UnimplementedCategoriesServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedCategoriesServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*CategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedCategoriesServiceServer) GetCategoryAttributes(context.Context, *GetCategoryAttributesRequest) (*CategoryAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryAttributes not implemented")
+}
+func (UnimplementedCategoriesServiceServer) mustEmbedUnimplementedCategoriesServiceServer() {}
+func (UnimplementedCategoriesServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCategoriesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CategoriesServiceServer will
+// result in compilation errors.
+type UnsafeCategoriesServiceServer interface {
+	mustEmbedUnimplementedCategoriesServiceServer()
+}
+
+func RegisterCategoriesServiceServer(s grpc.ServiceRegistrar, srv CategoriesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCategoriesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CategoriesService_ServiceDesc, srv)
+}
+
+func _CategoriesService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoriesServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoriesService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoriesServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoriesService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoriesServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoriesService_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoriesServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoriesService_GetCategoryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoriesServiceServer).GetCategoryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoriesService_GetCategoryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoriesServiceServer).GetCategoryAttributes(ctx, req.(*GetCategoryAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoriesService_ServiceDesc is the grpc.ServiceDesc for CategoriesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CategoriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "categories.CategoriesService",
+	HandlerType: (*CategoriesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCategories",
+			Handler:    _CategoriesService_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _CategoriesService_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "GetCategoryAttributes",
+			Handler:    _CategoriesService_GetCategoryAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "categories/categories.proto",
+}
@@ -12,6 +12,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,12 +27,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListingStatus int32
+
+const (
+	ListingStatus_LISTING_STATUS_UNSPECIFIED ListingStatus = 0
+	ListingStatus_LISTING_STATUS_DRAFT       ListingStatus = 1
+	ListingStatus_LISTING_STATUS_ACTIVE      ListingStatus = 2
+	ListingStatus_LISTING_STATUS_RESERVED    ListingStatus = 3
+	ListingStatus_LISTING_STATUS_SOLD        ListingStatus = 4
+	ListingStatus_LISTING_STATUS_ARCHIVED    ListingStatus = 5
+)
+
+// Enum value maps for ListingStatus.
+var (
+	ListingStatus_name = map[int32]string{
+		0: "LISTING_STATUS_UNSPECIFIED",
+		1: "LISTING_STATUS_DRAFT",
+		2: "LISTING_STATUS_ACTIVE",
+		3: "LISTING_STATUS_RESERVED",
+		4: "LISTING_STATUS_SOLD",
+		5: "LISTING_STATUS_ARCHIVED",
+	}
+	ListingStatus_value = map[string]int32{
+		"LISTING_STATUS_UNSPECIFIED": 0,
+		"LISTING_STATUS_DRAFT":       1,
+		"LISTING_STATUS_ACTIVE":      2,
+		"LISTING_STATUS_RESERVED":    3,
+		"LISTING_STATUS_SOLD":        4,
+		"LISTING_STATUS_ARCHIVED":    5,
+	}
+)
+
+func (x ListingStatus) Enum() *ListingStatus {
+	p := new(ListingStatus)
+	*p = x
+	return p
+}
+
+func (x ListingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_listings_listings_proto_enumTypes[0].Descriptor()
+}
+
+func (ListingStatus) Type() protoreflect.EnumType {
+	return &file_listings_listings_proto_enumTypes[0]
+}
+
+func (x ListingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListingStatus.Descriptor instead.
+func (ListingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{0}
+}
+
 type SortField int32
 
 const (
 	SortField_SORT_FIELD_UNSPECIFIED SortField = 0
 	SortField_SORT_FIELD_CREATED_AT  SortField = 1
 	SortField_SORT_FIELD_PRICE       SortField = 2
+	SortField_SORT_FIELD_RELEVANCE   SortField = 3
 )
 
 // Enum value maps for SortField.
@@ -39,11 +100,13 @@ var (
 		0: "SORT_FIELD_UNSPECIFIED",
 		1: "SORT_FIELD_CREATED_AT",
 		2: "SORT_FIELD_PRICE",
+		3: "SORT_FIELD_RELEVANCE",
 	}
 	SortField_value = map[string]int32{
 		"SORT_FIELD_UNSPECIFIED": 0,
 		"SORT_FIELD_CREATED_AT":  1,
 		"SORT_FIELD_PRICE":       2,
+		"SORT_FIELD_RELEVANCE":   3,
 	}
 )
 
@@ -58,11 +121,11 @@ func (x SortField) String() string {
 }
 
 func (SortField) Descriptor() protoreflect.EnumDescriptor {
-	return file_listings_listings_proto_enumTypes[0].Descriptor()
+	return file_listings_listings_proto_enumTypes[1].Descriptor()
 }
 
 func (SortField) Type() protoreflect.EnumType {
-	return &file_listings_listings_proto_enumTypes[0]
+	return &file_listings_listings_proto_enumTypes[1]
 }
 
 func (x SortField) Number() protoreflect.EnumNumber {
@@ -71,7 +134,7 @@ func (x SortField) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortField.Descriptor instead.
 func (SortField) EnumDescriptor() ([]byte, []int) {
-	return file_listings_listings_proto_rawDescGZIP(), []int{0}
+	return file_listings_listings_proto_rawDescGZIP(), []int{1}
 }
 
 type SortOrder int32
@@ -107,11 +170,11 @@ func (x SortOrder) String() string {
 }
 
 func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_listings_listings_proto_enumTypes[1].Descriptor()
+	return file_listings_listings_proto_enumTypes[2].Descriptor()
 }
 
 func (SortOrder) Type() protoreflect.EnumType {
-	return &file_listings_listings_proto_enumTypes[1]
+	return &file_listings_listings_proto_enumTypes[2]
 }
 
 func (x SortOrder) Number() protoreflect.EnumNumber {
@@ -120,15 +183,129 @@ func (x SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortOrder.Descriptor instead.
 func (SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_listings_listings_proto_rawDescGZIP(), []int{1}
+	return file_listings_listings_proto_rawDescGZIP(), []int{2}
+}
+
+type TotalMode int32
+
+const (
+	TotalMode_TOTAL_MODE_UNSPECIFIED TotalMode = 0
+	// Точный подсчет через COUNT(*)
+	TotalMode_TOTAL_MODE_EXACT TotalMode = 1
+	// Оценка планировщика PostgreSQL без выполнения подсчета
+	TotalMode_TOTAL_MODE_ESTIMATED TotalMode = 2
+	// Подсчет не выполняется, total и total_pages равны нулю
+	TotalMode_TOTAL_MODE_NONE TotalMode = 3
+)
+
+// Enum value maps for TotalMode.
+var (
+	TotalMode_name = map[int32]string{
+		0: "TOTAL_MODE_UNSPECIFIED",
+		1: "TOTAL_MODE_EXACT",
+		2: "TOTAL_MODE_ESTIMATED",
+		3: "TOTAL_MODE_NONE",
+	}
+	TotalMode_value = map[string]int32{
+		"TOTAL_MODE_UNSPECIFIED": 0,
+		"TOTAL_MODE_EXACT":       1,
+		"TOTAL_MODE_ESTIMATED":   2,
+		"TOTAL_MODE_NONE":        3,
+	}
+)
+
+func (x TotalMode) Enum() *TotalMode {
+	p := new(TotalMode)
+	*p = x
+	return p
+}
+
+func (x TotalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TotalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_listings_listings_proto_enumTypes[3].Descriptor()
+}
+
+func (TotalMode) Type() protoreflect.EnumType {
+	return &file_listings_listings_proto_enumTypes[3]
+}
+
+func (x TotalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TotalMode.Descriptor instead.
+func (TotalMode) EnumDescriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{3}
+}
+
+type SearchFrequency int32
+
+const (
+	SearchFrequency_SEARCH_FREQUENCY_UNSPECIFIED SearchFrequency = 0
+	// Уведомление о новых объявлениях сразу после их появления
+	SearchFrequency_SEARCH_FREQUENCY_INSTANT SearchFrequency = 1
+	// Ежедневная сводка новых объявлений
+	SearchFrequency_SEARCH_FREQUENCY_DAILY SearchFrequency = 2
+)
+
+// Enum value maps for SearchFrequency.
+var (
+	SearchFrequency_name = map[int32]string{
+		0: "SEARCH_FREQUENCY_UNSPECIFIED",
+		1: "SEARCH_FREQUENCY_INSTANT",
+		2: "SEARCH_FREQUENCY_DAILY",
+	}
+	SearchFrequency_value = map[string]int32{
+		"SEARCH_FREQUENCY_UNSPECIFIED": 0,
+		"SEARCH_FREQUENCY_INSTANT":     1,
+		"SEARCH_FREQUENCY_DAILY":       2,
+	}
+)
+
+func (x SearchFrequency) Enum() *SearchFrequency {
+	p := new(SearchFrequency)
+	*p = x
+	return p
+}
+
+func (x SearchFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_listings_listings_proto_enumTypes[4].Descriptor()
+}
+
+func (SearchFrequency) Type() protoreflect.EnumType {
+	return &file_listings_listings_proto_enumTypes[4]
+}
+
+func (x SearchFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchFrequency.Descriptor instead.
+func (SearchFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{4}
 }
 
 type CreateListingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Обложка объявления, первое изображение галереи
+	ImageUrl string `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Price    *Money `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	// Начальный статус: черновик или активное (по умолчанию)
+	Status     ListingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	CategoryId uint64        `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Значения атрибутов категории
+	Attributes *structpb.Struct `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// Остальные изображения галереи в порядке отображения после обложки
+	Images        []string `protobuf:"bytes,9,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -184,26 +361,71 @@ func (x *CreateListingRequest) GetImageUrl() string {
 	return ""
 }
 
-func (x *CreateListingRequest) GetPrice() float32 {
+func (x *CreateListingRequest) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
+	return nil
+}
+
+func (x *CreateListingRequest) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *CreateListingRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
 	return 0
 }
 
+func (x *CreateListingRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateListingRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 type GetListingsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Пагинация
+	// Пагинация по номеру страницы, по умолчанию первая страница
 	Page    uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PerPage uint32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
 	// Сортировка
 	SortBy    SortField `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=listings.SortField" json:"sort_by,omitempty"`
 	SortOrder SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=listings.SortOrder" json:"sort_order,omitempty"`
-	// Фильтрация по цене
-	MinPrice      *float32 `protobuf:"fixed32,5,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
-	MaxPrice      *float32 `protobuf:"fixed32,6,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MinPrice  *Money    `protobuf:"bytes,15,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice  *Money    `protobuf:"bytes,16,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// Фильтрация по статусу, по умолчанию только активные
+	Status ListingStatus `protobuf:"varint,7,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	// Полнотекстовый поиск по заголовку и описанию
+	Query string `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	// Фильтрация по категории, включая все вложенные категории
+	CategoryId *uint64 `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Фильтрация по точному значению атрибутов: attributes[brand]=Lenovo
+	Attributes map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Фильтрация по диапазону числовых атрибутов: attributes_min[ram_gb]=8
+	AttributesMin map[string]float64 `protobuf:"bytes,11,rep,name=attributes_min,json=attributesMin,proto3" json:"attributes_min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	AttributesMax map[string]float64 `protobuf:"bytes,12,rep,name=attributes_max,json=attributesMax,proto3" json:"attributes_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Курсор следующей страницы из next_page_token предыдущего ответа. Если указан, page игнорируется
+	PageToken string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Способ подсчета total, по умолчанию точный
+	TotalMode TotalMode `protobuf:"varint,14,opt,name=total_mode,json=totalMode,proto3,enum=listings.TotalMode" json:"total_mode,omitempty"`
+	// Валюта отображения: цены конвертируются в нее по текущим курсам, фильтр и сортировка по цене
+	// применяются к сконвертированным суммам, границы цены задаются в этой валюте
+	DisplayCurrency string `protobuf:"bytes,17,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetListingsRequest) Reset() {
@@ -264,48 +486,120 @@ func (x *GetListingsRequest) GetSortOrder() SortOrder {
 	return SortOrder_SORT_ORDER_UNSPECIFIED
 }
 
-func (x *GetListingsRequest) GetMinPrice() float32 {
-	if x != nil && x.MinPrice != nil {
-		return *x.MinPrice
+func (x *GetListingsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
 	}
-	return 0
+	return nil
+}
+
+func (x *GetListingsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetListingsRequest) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *GetListingsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (x *GetListingsRequest) GetMaxPrice() float32 {
-	if x != nil && x.MaxPrice != nil {
-		return *x.MaxPrice
+func (x *GetListingsRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
 	return 0
 }
 
-type ListingResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrl       string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Price          float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	AuthorUsername string                 `protobuf:"bytes,6,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsOwner        bool                   `protobuf:"varint,8,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetListingsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
 }
 
-func (x *ListingResponse) Reset() {
-	*x = ListingResponse{}
+func (x *GetListingsRequest) GetAttributesMin() map[string]float64 {
+	if x != nil {
+		return x.AttributesMin
+	}
+	return nil
+}
+
+func (x *GetListingsRequest) GetAttributesMax() map[string]float64 {
+	if x != nil {
+		return x.AttributesMax
+	}
+	return nil
+}
+
+func (x *GetListingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetListingsRequest) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_UNSPECIFIED
+}
+
+func (x *GetListingsRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+// Параметры ленты совпадают с одноименными полями GetListingsRequest
+type GetUserListingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Username        string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Page            uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage         uint32                 `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	SortBy          SortField              `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=listings.SortField" json:"sort_by,omitempty"`
+	SortOrder       SortOrder              `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,enum=listings.SortOrder" json:"sort_order,omitempty"`
+	MinPrice        *Money                 `protobuf:"bytes,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice        *Money                 `protobuf:"bytes,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Status          ListingStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	Query           string                 `protobuf:"bytes,9,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId      *uint64                `protobuf:"varint,10,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Attributes      map[string]string      `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AttributesMin   map[string]float64     `protobuf:"bytes,12,rep,name=attributes_min,json=attributesMin,proto3" json:"attributes_min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	AttributesMax   map[string]float64     `protobuf:"bytes,13,rep,name=attributes_max,json=attributesMax,proto3" json:"attributes_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	PageToken       string                 `protobuf:"bytes,14,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	TotalMode       TotalMode              `protobuf:"varint,15,opt,name=total_mode,json=totalMode,proto3,enum=listings.TotalMode" json:"total_mode,omitempty"`
+	DisplayCurrency string                 `protobuf:"bytes,16,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserListingsRequest) Reset() {
+	*x = GetUserListingsRequest{}
 	mi := &file_listings_listings_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListingResponse) String() string {
+func (x *GetUserListingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListingResponse) ProtoMessage() {}
+func (*GetUserListingsRequest) ProtoMessage() {}
 
-func (x *ListingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserListingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_listings_listings_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -317,92 +611,160 @@ func (x *ListingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListingResponse.ProtoReflect.Descriptor instead.
-func (*ListingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserListingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserListingsRequest) Descriptor() ([]byte, []int) {
 	return file_listings_listings_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListingResponse) GetId() uint64 {
+func (x *GetUserListingsRequest) GetUsername() string {
 	if x != nil {
-		return x.Id
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserListingsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *ListingResponse) GetTitle() string {
+func (x *GetUserListingsRequest) GetPerPage() uint32 {
 	if x != nil {
-		return x.Title
+		return x.PerPage
 	}
-	return ""
+	return 0
 }
 
-func (x *ListingResponse) GetDescription() string {
+func (x *GetUserListingsRequest) GetSortBy() SortField {
 	if x != nil {
-		return x.Description
+		return x.SortBy
 	}
-	return ""
+	return SortField_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *ListingResponse) GetImageUrl() string {
+func (x *GetUserListingsRequest) GetSortOrder() SortOrder {
 	if x != nil {
-		return x.ImageUrl
+		return x.SortOrder
 	}
-	return ""
+	return SortOrder_SORT_ORDER_UNSPECIFIED
 }
 
-func (x *ListingResponse) GetPrice() float32 {
+func (x *GetUserListingsRequest) GetMinPrice() *Money {
 	if x != nil {
-		return x.Price
+		return x.MinPrice
 	}
-	return 0
+	return nil
 }
 
-func (x *ListingResponse) GetAuthorUsername() string {
+func (x *GetUserListingsRequest) GetMaxPrice() *Money {
 	if x != nil {
-		return x.AuthorUsername
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetUserListingsRequest) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserListingsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
-func (x *ListingResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetUserListingsRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetUserListingsRequest) GetAttributes() map[string]string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Attributes
 	}
 	return nil
 }
 
-func (x *ListingResponse) GetIsOwner() bool {
+func (x *GetUserListingsRequest) GetAttributesMin() map[string]float64 {
 	if x != nil {
-		return x.IsOwner
+		return x.AttributesMin
 	}
-	return false
+	return nil
 }
 
-type ListingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Listings      []*ListingResponse     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       uint32                 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	TotalPages    uint32                 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetUserListingsRequest) GetAttributesMax() map[string]float64 {
+	if x != nil {
+		return x.AttributesMax
+	}
+	return nil
 }
 
-func (x *ListingsResponse) Reset() {
-	*x = ListingsResponse{}
+func (x *GetUserListingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetUserListingsRequest) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_UNSPECIFIED
+}
+
+func (x *GetUserListingsRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+// Параметры ленты совпадают с одноименными полями GetListingsRequest. Статус по умолчанию не ограничивается,
+// черновики доступны
+type GetMyListingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage         uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	SortBy          SortField              `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=listings.SortField" json:"sort_by,omitempty"`
+	SortOrder       SortOrder              `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=listings.SortOrder" json:"sort_order,omitempty"`
+	MinPrice        *Money                 `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice        *Money                 `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Status          ListingStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	Query           string                 `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId      *uint64                `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Attributes      map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AttributesMin   map[string]float64     `protobuf:"bytes,11,rep,name=attributes_min,json=attributesMin,proto3" json:"attributes_min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	AttributesMax   map[string]float64     `protobuf:"bytes,12,rep,name=attributes_max,json=attributesMax,proto3" json:"attributes_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	PageToken       string                 `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	TotalMode       TotalMode              `protobuf:"varint,14,opt,name=total_mode,json=totalMode,proto3,enum=listings.TotalMode" json:"total_mode,omitempty"`
+	DisplayCurrency string                 `protobuf:"bytes,15,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMyListingsRequest) Reset() {
+	*x = GetMyListingsRequest{}
 	mi := &file_listings_listings_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListingsResponse) String() string {
+func (x *GetMyListingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListingsResponse) ProtoMessage() {}
+func (*GetMyListingsRequest) ProtoMessage() {}
 
-func (x *ListingsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMyListingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_listings_listings_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,104 +776,3137 @@ func (x *ListingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListingsResponse.ProtoReflect.Descriptor instead.
-func (*ListingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMyListingsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyListingsRequest) Descriptor() ([]byte, []int) {
 	return file_listings_listings_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *GetMyListingsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMyListingsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *GetMyListingsRequest) GetSortBy() SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetMyListingsRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *GetMyListingsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *GetMyListingsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetMyListingsRequest) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *GetMyListingsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetMyListingsRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetMyListingsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetMyListingsRequest) GetAttributesMin() map[string]float64 {
+	if x != nil {
+		return x.AttributesMin
+	}
+	return nil
+}
+
+func (x *GetMyListingsRequest) GetAttributesMax() map[string]float64 {
+	if x != nil {
+		return x.AttributesMax
+	}
+	return nil
+}
+
+func (x *GetMyListingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetMyListingsRequest) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_UNSPECIFIED
+}
+
+func (x *GetMyListingsRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+type GetListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListingRequest) Reset() {
+	*x = GetListingRequest{}
+	mi := &file_listings_listings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingRequest) ProtoMessage() {}
+
+func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingRequest.ProtoReflect.Descriptor instead.
+func (*GetListingRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetListingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateListingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Версия объявления, на основе которой сделаны изменения
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Список обновляемых полей: title, description, image_url, price, category_id, attributes
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Price         *Money                 `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryId    uint64                 `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,9,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListingRequest) Reset() {
+	*x = UpdateListingRequest{}
+	mi := &file_listings_listings_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListingRequest) ProtoMessage() {}
+
+func (x *UpdateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListingRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateListingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateListingRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateListingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateListingRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateListingRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateListingRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateListingRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateListingRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateListingRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DeleteListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListingRequest) Reset() {
+	*x = DeleteListingRequest{}
+	mi := &file_listings_listings_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListingRequest) ProtoMessage() {}
+
+func (x *DeleteListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListingRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteListingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteListingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Время, до которого объявление можно восстановить
+	RestoreUntil  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=restore_until,json=restoreUntil,proto3" json:"restore_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListingResponse) Reset() {
+	*x = DeleteListingResponse{}
+	mi := &file_listings_listings_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListingResponse) ProtoMessage() {}
+
+func (x *DeleteListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListingResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteListingResponse) GetRestoreUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestoreUntil
+	}
+	return nil
+}
+
+type RestoreListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreListingRequest) Reset() {
+	*x = RestoreListingRequest{}
+	mi := &file_listings_listings_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreListingRequest) ProtoMessage() {}
+
+func (x *RestoreListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreListingRequest.ProtoReflect.Descriptor instead.
+func (*RestoreListingRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreListingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SearchListingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchListingsRequest) Reset() {
+	*x = SearchListingsRequest{}
+	mi := &file_listings_listings_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchListingsRequest) ProtoMessage() {}
+
+func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchListingsRequest.ProtoReflect.Descriptor instead.
+func (*SearchListingsRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchListingsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchListingsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListingSearchResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Listing *ListingResponse       `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	// Степень сходства запроса с заголовком от 0 до 1
+	Similarity    float32 `protobuf:"fixed32,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingSearchResult) Reset() {
+	*x = ListingSearchResult{}
+	mi := &file_listings_listings_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingSearchResult) ProtoMessage() {}
+
+func (x *ListingSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingSearchResult.ProtoReflect.Descriptor instead.
+func (*ListingSearchResult) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListingSearchResult) GetListing() *ListingResponse {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *ListingSearchResult) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type SearchListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ListingSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	TotalPages    uint32                 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchListingsResponse) Reset() {
+	*x = SearchListingsResponse{}
+	mi := &file_listings_listings_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchListingsResponse) ProtoMessage() {}
+
+func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchListingsResponse.ProtoReflect.Descriptor instead.
+func (*SearchListingsResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchListingsResponse) GetResults() []*ListingSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchListingsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchListingsResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchListingsResponse) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *SearchListingsResponse) GetTotalPages() uint32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type GetListingFacetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinPrice      *Money                 `protobuf:"bytes,10,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      *Money                 `protobuf:"bytes,11,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Status        ListingStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId    *uint64                `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AttributesMin map[string]float64     `protobuf:"bytes,7,rep,name=attributes_min,json=attributesMin,proto3" json:"attributes_min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	AttributesMax map[string]float64     `protobuf:"bytes,8,rep,name=attributes_max,json=attributesMax,proto3" json:"attributes_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Границы диапазонов гистограммы цен, по умолчанию берутся из конфигурации
+	PriceBuckets []float32 `protobuf:"fixed32,9,rep,packed,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	// Валюта гистограммы цен, по умолчанию валюта фильтра по цене или валюта по умолчанию
+	Currency      string `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListingFacetsRequest) Reset() {
+	*x = GetListingFacetsRequest{}
+	mi := &file_listings_listings_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListingFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingFacetsRequest) ProtoMessage() {}
+
+func (x *GetListingFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetListingFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetListingFacetsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *GetListingFacetsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetListingFacetsRequest) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *GetListingFacetsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetListingFacetsRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetListingFacetsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetListingFacetsRequest) GetAttributesMin() map[string]float64 {
+	if x != nil {
+		return x.AttributesMin
+	}
+	return nil
+}
+
+func (x *GetListingFacetsRequest) GetAttributesMax() map[string]float64 {
+	if x != nil {
+		return x.AttributesMax
+	}
+	return nil
+}
+
+func (x *GetListingFacetsRequest) GetPriceBuckets() []float32 {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+func (x *GetListingFacetsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CategoryFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    uint64                 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_listings_listings_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CategoryFacet) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryFacet) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StatusFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ListingStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusFacet) Reset() {
+	*x = StatusFacet{}
+	mi := &file_listings_listings_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusFacet) ProtoMessage() {}
+
+func (x *StatusFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusFacet.ProtoReflect.Descriptor instead.
+func (*StatusFacet) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StatusFacet) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *StatusFacet) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriceBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Нижняя граница включительно, не задана для первого диапазона
+	From *Money `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// Верхняя граница не включительно, не задана для последнего диапазона
+	To            *Money `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Count         uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBucket) Reset() {
+	*x = PriceBucket{}
+	mi := &file_listings_listings_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBucket) ProtoMessage() {}
+
+func (x *PriceBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBucket.ProtoReflect.Descriptor instead.
+func (*PriceBucket) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PriceBucket) GetFrom() *Money {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PriceBucket) GetTo() *Money {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PriceBucket) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListingFacetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Количество объявлений, подходящих под фильтр целиком
+	Total          uint32           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Categories     []*CategoryFacet `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Statuses       []*StatusFacet   `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	PriceHistogram []*PriceBucket   `protobuf:"bytes,4,rep,name=price_histogram,json=priceHistogram,proto3" json:"price_histogram,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListingFacetsResponse) Reset() {
+	*x = ListingFacetsResponse{}
+	mi := &file_listings_listings_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingFacetsResponse) ProtoMessage() {}
+
+func (x *ListingFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingFacetsResponse.ProtoReflect.Descriptor instead.
+func (*ListingFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListingFacetsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListingFacetsResponse) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListingFacetsResponse) GetStatuses() []*StatusFacet {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListingFacetsResponse) GetPriceHistogram() []*PriceBucket {
+	if x != nil {
+		return x.PriceHistogram
+	}
+	return nil
+}
+
+type SuggestListingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Prefix string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Количество подсказок, по умолчанию 10
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestListingsRequest) Reset() {
+	*x = SuggestListingsRequest{}
+	mi := &file_listings_listings_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestListingsRequest) ProtoMessage() {}
+
+func (x *SuggestListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestListingsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestListingsRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SuggestListingsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestListingsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Количество активных объявлений, в заголовке которых встречается слово
+	ListingsCount uint32 `protobuf:"varint,2,opt,name=listings_count,json=listingsCount,proto3" json:"listings_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_listings_listings_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetListingsCount() uint32 {
+	if x != nil {
+		return x.ListingsCount
+	}
+	return 0
+}
+
+type SuggestListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestListingsResponse) Reset() {
+	*x = SuggestListingsResponse{}
+	mi := &file_listings_listings_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestListingsResponse) ProtoMessage() {}
+
+func (x *SuggestListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestListingsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestListingsResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SuggestListingsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ChangeListingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        ListingStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeListingStatusRequest) Reset() {
+	*x = ChangeListingStatusRequest{}
+	mi := &file_listings_listings_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeListingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeListingStatusRequest) ProtoMessage() {}
+
+func (x *ChangeListingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeListingStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeListingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChangeListingStatusRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeListingStatusRequest) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+type AddListingImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Urls          []string               `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddListingImagesRequest) Reset() {
+	*x = AddListingImagesRequest{}
+	mi := &file_listings_listings_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddListingImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListingImagesRequest) ProtoMessage() {}
+
+func (x *AddListingImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListingImagesRequest.ProtoReflect.Descriptor instead.
+func (*AddListingImagesRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddListingImagesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddListingImagesRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type RemoveListingImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImageId       uint64                 `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveListingImageRequest) Reset() {
+	*x = RemoveListingImageRequest{}
+	mi := &file_listings_listings_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveListingImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveListingImageRequest) ProtoMessage() {}
+
+func (x *RemoveListingImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveListingImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveListingImageRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveListingImageRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveListingImageRequest) GetImageId() uint64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+type ReorderListingImagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID всех изображений галереи в новом порядке
+	ImageIds      []uint64 `protobuf:"varint,2,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderListingImagesRequest) Reset() {
+	*x = ReorderListingImagesRequest{}
+	mi := &file_listings_listings_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderListingImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderListingImagesRequest) ProtoMessage() {}
+
+func (x *ReorderListingImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderListingImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderListingImagesRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReorderListingImagesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReorderListingImagesRequest) GetImageIds() []uint64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type ListingImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Position      uint32                 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Variants      []*ImageVariant        `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingImage) Reset() {
+	*x = ListingImage{}
+	mi := &file_listings_listings_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingImage) ProtoMessage() {}
+
+func (x *ListingImage) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingImage.ProtoReflect.Descriptor instead.
+func (*ListingImage) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListingImage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListingImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ListingImage) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ListingImage) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Уменьшенная копия изображения, загруженного через API загрузок
+type ImageVariant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Имя копии из конфигурации, например thumb или medium
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Width         uint32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	ContentType   string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	mi := &file_listings_listings_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImageVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// Денежная сумма: units — целая часть, nanos — дробная часть в миллиардных долях (как google.type.Money).
+// Цены объявлений хранятся с точностью до сотых, поэтому nanos должно быть кратно 10 000 000,
+// а целая часть ограничена размером колонки цены NUMERIC(10, 2)
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Units int64                  `protobuf:"varint,1,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos int32                  `protobuf:"varint,2,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	// Код валюты ISO 4217, по умолчанию используется валюта маркетплейса
+	CurrencyCode  string `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_listings_listings_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ListingResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Устарело, совпадает с cover_image_url
+	//
+	// Deprecated: Marked as deprecated in listings/listings.proto.
+	ImageUrl       string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Price          *Money                 `protobuf:"bytes,15,opt,name=price,proto3" json:"price,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,6,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsOwner        bool                   `protobuf:"varint,8,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+	Version        uint64                 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status         ListingStatus          `protobuf:"varint,11,opt,name=status,proto3,enum=listings.ListingStatus" json:"status,omitempty"`
+	// Фрагменты с выделенными совпадениями, заполняются при поиске по query
+	Highlight  *ListingHighlight `protobuf:"bytes,12,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	CategoryId uint64            `protobuf:"varint,13,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes *structpb.Struct  `protobuf:"bytes,14,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// Цена в валюте отображения, заполняется при указании display_currency
+	DisplayPrice *Money `protobuf:"bytes,16,opt,name=display_price,json=displayPrice,proto3" json:"display_price,omitempty"`
+	// Обложка объявления, первое изображение галереи
+	CoverImageUrl string `protobuf:"bytes,17,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`
+	// Галерея объявления, заполняется только при получении одного объявления
+	Images []*ListingImage `protobuf:"bytes,18,rep,name=images,proto3" json:"images,omitempty"`
+	// Уменьшенные копии обложки, пусты для внешних ссылок и еще не обработанных изображений
+	CoverVariants []*ImageVariant `protobuf:"bytes,19,rep,name=cover_variants,json=coverVariants,proto3" json:"cover_variants,omitempty"`
+	// Объявление в избранном текущего пользователя, для анонимных запросов всегда false
+	IsFavorite bool `protobuf:"varint,20,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	// Количество пользователей, добавивших объявление в избранное
+	FavoritesCount uint32 `protobuf:"varint,21,opt,name=favorites_count,json=favoritesCount,proto3" json:"favorites_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListingResponse) Reset() {
+	*x = ListingResponse{}
+	mi := &file_listings_listings_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingResponse) ProtoMessage() {}
+
+func (x *ListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingResponse.ProtoReflect.Descriptor instead.
+func (*ListingResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListingResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListingResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in listings/listings.proto.
+func (x *ListingResponse) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ListingResponse) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *ListingResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+func (x *ListingResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetStatus() ListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *ListingResponse) GetHighlight() *ListingHighlight {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetDisplayPrice() *Money {
+	if x != nil {
+		return x.DisplayPrice
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *ListingResponse) GetImages() []*ListingImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetCoverVariants() []*ImageVariant {
+	if x != nil {
+		return x.CoverVariants
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *ListingResponse) GetFavoritesCount() uint32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+type ListingHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingHighlight) Reset() {
+	*x = ListingHighlight{}
+	mi := &file_listings_listings_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingHighlight) ProtoMessage() {}
+
+func (x *ListingHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingHighlight.ProtoReflect.Descriptor instead.
+func (*ListingHighlight) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListingHighlight) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListingHighlight) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListingsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Listings   []*ListingResponse     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	Total      uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page       uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage    uint32                 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	TotalPages uint32                 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Курсор следующей страницы, пустой на последней странице и при сортировке по релевантности
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Способ, которым посчитан total
+	TotalMode     TotalMode `protobuf:"varint,7,opt,name=total_mode,json=totalMode,proto3,enum=listings.TotalMode" json:"total_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingsResponse) Reset() {
+	*x = ListingsResponse{}
+	mi := &file_listings_listings_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingsResponse) ProtoMessage() {}
+
+func (x *ListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingsResponse.ProtoReflect.Descriptor instead.
+func (*ListingsResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{29}
+}
+
 func (x *ListingsResponse) GetListings() []*ListingResponse {
 	if x != nil {
-		return x.Listings
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *ListingsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListingsResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListingsResponse) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListingsResponse) GetTotalPages() uint32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListingsResponse) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_UNSPECIFIED
+}
+
+type AddFavoriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Порог цены для уведомления. Если не задан, уведомление приходит при любом снижении цены
+	AlertPrice    *Money `protobuf:"bytes,2,opt,name=alert_price,json=alertPrice,proto3" json:"alert_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_listings_listings_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddFavoriteRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddFavoriteRequest) GetAlertPrice() *Money {
+	if x != nil {
+		return x.AlertPrice
+	}
+	return nil
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_listings_listings_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveFavoriteRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FavoriteResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ListingId      uint64                 `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	IsFavorite     bool                   `protobuf:"varint,2,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	FavoritesCount uint32                 `protobuf:"varint,3,opt,name=favorites_count,json=favoritesCount,proto3" json:"favorites_count,omitempty"`
+	AlertPrice     *Money                 `protobuf:"bytes,4,opt,name=alert_price,json=alertPrice,proto3" json:"alert_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FavoriteResponse) Reset() {
+	*x = FavoriteResponse{}
+	mi := &file_listings_listings_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteResponse) ProtoMessage() {}
+
+func (x *FavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteResponse.ProtoReflect.Descriptor instead.
+func (*FavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FavoriteResponse) GetListingId() uint64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *FavoriteResponse) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *FavoriteResponse) GetFavoritesCount() uint32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *FavoriteResponse) GetAlertPrice() *Money {
+	if x != nil {
+		return x.AlertPrice
+	}
+	return nil
+}
+
+type ListFavoritesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// По умолчанию первая страница
+	Page          uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_listings_listings_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListFavoritesRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFavoritesRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*ListingResponse     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	TotalPages    uint32                 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_listings_listings_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListFavoritesResponse) GetListings() []*ListingResponse {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFavoritesResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFavoritesResponse) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListFavoritesResponse) GetTotalPages() uint32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Условия ленты объявлений, сохраняемые вместе с поиском. Поля совпадают с одноименными полями GetListingsRequest
+type SavedSearchFilter struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SortBy          SortField              `protobuf:"varint,1,opt,name=sort_by,json=sortBy,proto3,enum=listings.SortField" json:"sort_by,omitempty"`
+	SortOrder       SortOrder              `protobuf:"varint,2,opt,name=sort_order,json=sortOrder,proto3,enum=listings.SortOrder" json:"sort_order,omitempty"`
+	MinPrice        *Money                 `protobuf:"bytes,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice        *Money                 `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Query           string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId      *uint64                `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Attributes      map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AttributesMin   map[string]float64     `protobuf:"bytes,8,rep,name=attributes_min,json=attributesMin,proto3" json:"attributes_min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	AttributesMax   map[string]float64     `protobuf:"bytes,9,rep,name=attributes_max,json=attributesMax,proto3" json:"attributes_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	DisplayCurrency string                 `protobuf:"bytes,10,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SavedSearchFilter) Reset() {
+	*x = SavedSearchFilter{}
+	mi := &file_listings_listings_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchFilter) ProtoMessage() {}
+
+func (x *SavedSearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchFilter.ProtoReflect.Descriptor instead.
+func (*SavedSearchFilter) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SavedSearchFilter) GetSortBy() SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *SavedSearchFilter) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *SavedSearchFilter) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *SavedSearchFilter) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *SavedSearchFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedSearchFilter) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SavedSearchFilter) GetAttributesMin() map[string]float64 {
+	if x != nil {
+		return x.AttributesMin
+	}
+	return nil
+}
+
+func (x *SavedSearchFilter) GetAttributesMax() map[string]float64 {
+	if x != nil {
+		return x.AttributesMax
+	}
+	return nil
+}
+
+func (x *SavedSearchFilter) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Filter *SavedSearchFilter     `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// По умолчанию мгновенные уведомления
+	Frequency     SearchFrequency `protobuf:"varint,3,opt,name=frequency,proto3,enum=listings.SearchFrequency" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_listings_listings_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetFilter() *SavedSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateSavedSearchRequest) GetFrequency() SearchFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return SearchFrequency_SEARCH_FREQUENCY_UNSPECIFIED
+}
+
+type ListSavedSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_listings_listings_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{37}
+}
+
+type ListSavedSearchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches []*SavedSearchResponse `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_listings_listings_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearchResponse {
+	if x != nil {
+		return x.SavedSearches
 	}
 	return nil
 }
 
-func (x *ListingsResponse) GetTotal() uint32 {
+type GetSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedSearchRequest) Reset() {
+	*x = GetSavedSearchRequest{}
+	mi := &file_listings_listings_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedSearchRequest) ProtoMessage() {}
+
+func (x *GetSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[39]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSavedSearchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListingsResponse) GetPage() uint32 {
+type UpdateSavedSearchRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter *SavedSearchFilter     `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// По умолчанию мгновенные уведомления
+	Frequency     SearchFrequency `protobuf:"varint,4,opt,name=frequency,proto3,enum=listings.SearchFrequency" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_listings_listings_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
+
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[40]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateSavedSearchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListingsResponse) GetPerPage() uint32 {
+func (x *UpdateSavedSearchRequest) GetName() string {
 	if x != nil {
-		return x.PerPage
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetFilter() *SavedSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *UpdateSavedSearchRequest) GetFrequency() SearchFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return SearchFrequency_SEARCH_FREQUENCY_UNSPECIFIED
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_listings_listings_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteSavedSearchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListingsResponse) GetTotalPages() uint32 {
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_listings_listings_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[42]
 	if x != nil {
-		return x.TotalPages
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{42}
+}
+
+type SavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *SavedSearchFilter     `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Frequency     SearchFrequency        `protobuf:"varint,4,opt,name=frequency,proto3,enum=listings.SearchFrequency" json:"frequency,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchResponse) Reset() {
+	*x = SavedSearchResponse{}
+	mi := &file_listings_listings_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchResponse) ProtoMessage() {}
+
+func (x *SavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_listings_listings_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_listings_listings_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SavedSearchResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
+func (x *SavedSearchResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearchResponse) GetFilter() *SavedSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SavedSearchResponse) GetFrequency() SearchFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return SearchFrequency_SEARCH_FREQUENCY_UNSPECIFIED
+}
+
+func (x *SavedSearchResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedSearchResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 var File_listings_listings_proto protoreflect.FileDescriptor
 
 const file_listings_listings_proto_rawDesc = "" +
 	"\n" +
-	"\x17listings/listings.proto\x12\blistings\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xae\x01\n" +
+	"\x17listings/listings.proto\x12\blistings\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8e\x03\n" +
 	"\x14CreateListingRequest\x12\x1f\n" +
 	"\x05title\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x05\x18dR\x05title\x12,\n" +
 	"\vdescription\x18\x02 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\n" +
 	"\x18\xe8\aR\vdescription\x12%\n" +
-	"\timage_url\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\bimageUrl\x12 \n" +
-	"\x05price\x18\x04 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00R\x05price\"\xb3\x02\n" +
-	"\x12GetListingsRequest\x12\x1d\n" +
-	"\x04page\x18\x01 \x01(\rB\t\xfaB\x06*\x04\x18d \x00R\x04page\x12$\n" +
+	"\timage_url\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\bimageUrl\x12/\n" +
+	"\x05price\x18\b \x01(\v2\x0f.listings.MoneyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05price\x12=\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.listings.ListingStatusB\f\xfaB\t\x82\x01\x06\x18\x00\x18\x01\x18\x02R\x06status\x12(\n" +
+	"\vcategory_id\x18\x06 \x01(\x04B\a\xfaB\x042\x02 \x00R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"attributes\x18\a \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12'\n" +
+	"\x06images\x18\t \x03(\tB\x0f\xfaB\f\x92\x01\t\x10\t\"\x05r\x03\x88\x01\x01R\x06imagesJ\x04\b\x04\x10\x05\"\x96\t\n" +
+	"\x12GetListingsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02\x18dR\x04page\x12$\n" +
+	"\bper_page\x18\x02 \x01(\rB\t\xfaB\x06*\x04\x182 \x00R\aperPage\x12,\n" +
+	"\asort_by\x18\x03 \x01(\x0e2\x13.listings.SortFieldR\x06sortBy\x122\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x0e2\x13.listings.SortOrderR\tsortOrder\x12,\n" +
+	"\tmin_price\x18\x0f \x01(\v2\x0f.listings.MoneyR\bminPrice\x12,\n" +
+	"\tmax_price\x18\x10 \x01(\v2\x0f.listings.MoneyR\bmaxPrice\x129\n" +
+	"\x06status\x18\a \x01(\x0e2\x17.listings.ListingStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x1e\n" +
+	"\x05query\x18\b \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05query\x12-\n" +
+	"\vcategory_id\x18\t \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12r\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2,.listings.GetListingsRequest.AttributesEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\n" +
+	"attributes\x12|\n" +
+	"\x0eattributes_min\x18\v \x03(\v2/.listings.GetListingsRequest.AttributesMinEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMin\x12|\n" +
+	"\x0eattributes_max\x18\f \x03(\v2/.listings.GetListingsRequest.AttributesMaxEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMax\x12'\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\tpageToken\x12<\n" +
+	"\n" +
+	"total_mode\x18\x0e \x01(\x0e2\x13.listings.TotalModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\ttotalMode\x12?\n" +
+	"\x10display_currency\x18\x11 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\x0fdisplayCurrency\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01B\x0e\n" +
+	"\f_category_idJ\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xd5\t\n" +
+	"\x16GetUserListingsRequest\x127\n" +
+	"\busername\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x03\x1822\x10^[a-zA-Z0-9_-]+$R\busername\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\rB\a\xfaB\x04*\x02\x18dR\x04page\x12$\n" +
+	"\bper_page\x18\x03 \x01(\rB\t\xfaB\x06*\x04\x182 \x00R\aperPage\x12,\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x13.listings.SortFieldR\x06sortBy\x122\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x0e2\x13.listings.SortOrderR\tsortOrder\x12,\n" +
+	"\tmin_price\x18\x06 \x01(\v2\x0f.listings.MoneyR\bminPrice\x12,\n" +
+	"\tmax_price\x18\a \x01(\v2\x0f.listings.MoneyR\bmaxPrice\x129\n" +
+	"\x06status\x18\b \x01(\x0e2\x17.listings.ListingStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x1e\n" +
+	"\x05query\x18\t \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05query\x12-\n" +
+	"\vcategory_id\x18\n" +
+	" \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12v\n" +
+	"\n" +
+	"attributes\x18\v \x03(\v20.listings.GetUserListingsRequest.AttributesEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\n" +
+	"attributes\x12\x80\x01\n" +
+	"\x0eattributes_min\x18\f \x03(\v23.listings.GetUserListingsRequest.AttributesMinEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMin\x12\x80\x01\n" +
+	"\x0eattributes_max\x18\r \x03(\v23.listings.GetUserListingsRequest.AttributesMaxEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMax\x12'\n" +
+	"\n" +
+	"page_token\x18\x0e \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\tpageToken\x12<\n" +
+	"\n" +
+	"total_mode\x18\x0f \x01(\x0e2\x13.listings.TotalModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\ttotalMode\x12?\n" +
+	"\x10display_currency\x18\x10 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\x0fdisplayCurrency\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01B\x0e\n" +
+	"\f_category_id\"\x92\t\n" +
+	"\x14GetMyListingsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02\x18dR\x04page\x12$\n" +
 	"\bper_page\x18\x02 \x01(\rB\t\xfaB\x06*\x04\x182 \x00R\aperPage\x12,\n" +
 	"\asort_by\x18\x03 \x01(\x0e2\x13.listings.SortFieldR\x06sortBy\x122\n" +
 	"\n" +
 	"sort_order\x18\x04 \x01(\x0e2\x13.listings.SortOrderR\tsortOrder\x12,\n" +
-	"\tmin_price\x18\x05 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05-\x00\x00\x00\x00H\x00R\bminPrice\x88\x01\x01\x12,\n" +
-	"\tmax_price\x18\x06 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00H\x01R\bmaxPrice\x88\x01\x01B\f\n" +
+	"\tmin_price\x18\x05 \x01(\v2\x0f.listings.MoneyR\bminPrice\x12,\n" +
+	"\tmax_price\x18\x06 \x01(\v2\x0f.listings.MoneyR\bmaxPrice\x129\n" +
+	"\x06status\x18\a \x01(\x0e2\x17.listings.ListingStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x1e\n" +
+	"\x05query\x18\b \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05query\x12-\n" +
+	"\vcategory_id\x18\t \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12t\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2..listings.GetMyListingsRequest.AttributesEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\n" +
+	"attributes\x12~\n" +
+	"\x0eattributes_min\x18\v \x03(\v21.listings.GetMyListingsRequest.AttributesMinEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMin\x12~\n" +
+	"\x0eattributes_max\x18\f \x03(\v21.listings.GetMyListingsRequest.AttributesMaxEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMax\x12'\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\tpageToken\x12<\n" +
+	"\n" +
+	"total_mode\x18\x0e \x01(\x0e2\x13.listings.TotalModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\ttotalMode\x12?\n" +
+	"\x10display_currency\x18\x0f \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\x0fdisplayCurrency\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01B\x0e\n" +
+	"\f_category_id\",\n" +
+	"\x11GetListingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\x9f\x03\n" +
+	"\x14UpdateListingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12!\n" +
+	"\aversion\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\aversion\x12E\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\x12\"\n" +
+	"\x05title\x18\x04 \x01(\tB\f\xfaB\tr\a\x10\x05\x18d\xd0\x01\x01R\x05title\x12/\n" +
+	"\vdescription\x18\x05 \x01(\tB\r\xfaB\n" +
+	"r\b\x10\n" +
+	"\x18\xe8\a\xd0\x01\x01R\vdescription\x12(\n" +
+	"\timage_url\x18\x06 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\x88\x01\x01R\bimageUrl\x12%\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\x0f.listings.MoneyR\x05price\x12\x1f\n" +
+	"\vcategory_id\x18\b \x01(\x04R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"attributes\x18\t \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesJ\x04\b\a\x10\b\"/\n" +
+	"\x14DeleteListingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"X\n" +
+	"\x15DeleteListingResponse\x12?\n" +
+	"\rrestore_until\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\frestoreUntil\"0\n" +
+	"\x15RestoreListingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"}\n" +
+	"\x15SearchListingsRequest\x12\x1f\n" +
+	"\x05query\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18dR\x05query\x12\x1d\n" +
+	"\x04page\x18\x02 \x01(\rB\t\xfaB\x06*\x04\x18d \x00R\x04page\x12$\n" +
+	"\bper_page\x18\x03 \x01(\rB\t\xfaB\x06*\x04\x182 \x00R\aperPage\"j\n" +
+	"\x13ListingSearchResult\x123\n" +
+	"\alisting\x18\x01 \x01(\v2\x19.listings.ListingResponseR\alisting\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x02R\n" +
+	"similarity\"\xb7\x01\n" +
+	"\x16SearchListingsResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.listings.ListingSearchResultR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\rR\aperPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\rR\n" +
+	"totalPages\"\xc9\a\n" +
+	"\x17GetListingFacetsRequest\x12,\n" +
+	"\tmin_price\x18\n" +
+	" \x01(\v2\x0f.listings.MoneyR\bminPrice\x12,\n" +
+	"\tmax_price\x18\v \x01(\v2\x0f.listings.MoneyR\bmaxPrice\x129\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.listings.ListingStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x1e\n" +
+	"\x05query\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05query\x12-\n" +
+	"\vcategory_id\x18\x05 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12w\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v21.listings.GetListingFacetsRequest.AttributesEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\n" +
+	"attributes\x12\x81\x01\n" +
+	"\x0eattributes_min\x18\a \x03(\v24.listings.GetListingFacetsRequest.AttributesMinEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMin\x12\x81\x01\n" +
+	"\x0eattributes_max\x18\b \x03(\v24.listings.GetListingFacetsRequest.AttributesMaxEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMax\x126\n" +
+	"\rprice_buckets\x18\t \x03(\x02B\x11\xfaB\x0e\x92\x01\v\x10\x14\"\a\n" +
+	"\x05%\x00\x00\x00\x00R\fpriceBuckets\x120\n" +
+	"\bcurrency\x18\f \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\bcurrency\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01B\x0e\n" +
+	"\f_category_idJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"Z\n" +
+	"\rCategoryFacet\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x04R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\rR\x05count\"T\n" +
+	"\vStatusFacet\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.listings.ListingStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"u\n" +
+	"\vPriceBucket\x12#\n" +
+	"\x04from\x18\x04 \x01(\v2\x0f.listings.MoneyR\x04from\x12\x1f\n" +
+	"\x02to\x18\x05 \x01(\v2\x0f.listings.MoneyR\x02to\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\rR\x05countJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\xd9\x01\n" +
+	"\x15ListingFacetsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\rR\x05total\x127\n" +
 	"\n" +
-	"_min_priceB\f\n" +
+	"categories\x18\x02 \x03(\v2\x17.listings.CategoryFacetR\n" +
+	"categories\x121\n" +
+	"\bstatuses\x18\x03 \x03(\v2\x15.listings.StatusFacetR\bstatuses\x12>\n" +
+	"\x0fprice_histogram\x18\x04 \x03(\v2\x15.listings.PriceBucketR\x0epriceHistogram\"Z\n" +
+	"\x16SuggestListingsRequest\x12!\n" +
+	"\x06prefix\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x06prefix\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\rB\a\xfaB\x04*\x02\x18\x14R\x05limit\"G\n" +
 	"\n" +
-	"_max_price\"\x8b\x02\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12%\n" +
+	"\x0elistings_count\x18\x02 \x01(\rR\rlistingsCount\"Q\n" +
+	"\x17SuggestListingsResponse\x126\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x14.listings.SuggestionR\vsuggestions\"r\n" +
+	"\x1aChangeListingStatusRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.listings.ListingStatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06status\"Y\n" +
+	"\x17AddListingImagesRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12%\n" +
+	"\x04urls\x18\x02 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x10\n" +
+	"\"\x05r\x03\x88\x01\x01R\x04urls\"X\n" +
+	"\x19RemoveListingImageRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\"\n" +
+	"\bimage_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\aimageId\"a\n" +
+	"\x1bReorderListingImagesRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12)\n" +
+	"\timage_ids\x18\x02 \x03(\x04B\f\xfaB\t\x92\x01\x06\b\x01\x10\n" +
+	"\x18\x01R\bimageIds\"\x80\x01\n" +
+	"\fListingImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\rR\bposition\x122\n" +
+	"\bvariants\x18\x04 \x03(\v2\x16.listings.ImageVariantR\bvariants\"\x85\x01\n" +
+	"\fImageVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\rR\x06height\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\"\x8b\x01\n" +
+	"\x05Money\x12\"\n" +
+	"\x05units\x18\x01 \x01(\x03B\f\xfaB\t\"\a\x18\xff\xc1\xd7/(\x00R\x05units\x12#\n" +
+	"\x05nanos\x18\x02 \x01(\x05B\r\xfaB\n" +
+	"\x1a\b\x18\xff\x93\xeb\xdc\x03(\x00R\x05nanos\x129\n" +
+	"\rcurrency_code\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\fcurrencyCode\"\xd7\x06\n" +
 	"\x0fListingResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
-	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x02R\x05price\x12'\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\timage_url\x18\x04 \x01(\tB\x02\x18\x01R\bimageUrl\x12%\n" +
+	"\x05price\x18\x0f \x01(\v2\x0f.listings.MoneyR\x05price\x12'\n" +
 	"\x0fauthor_username\x18\x06 \x01(\tR\x0eauthorUsername\x129\n" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
-	"\bis_owner\x18\b \x01(\bR\aisOwner\"\xaf\x01\n" +
+	"\bis_owner\x18\b \x01(\bR\aisOwner\x12\x18\n" +
+	"\aversion\x18\t \x01(\x04R\aversion\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\x06status\x18\v \x01(\x0e2\x17.listings.ListingStatusR\x06status\x128\n" +
+	"\thighlight\x18\f \x01(\v2\x1a.listings.ListingHighlightR\thighlight\x12\x1f\n" +
+	"\vcategory_id\x18\r \x01(\x04R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"attributes\x18\x0e \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x124\n" +
+	"\rdisplay_price\x18\x10 \x01(\v2\x0f.listings.MoneyR\fdisplayPrice\x12&\n" +
+	"\x0fcover_image_url\x18\x11 \x01(\tR\rcoverImageUrl\x12.\n" +
+	"\x06images\x18\x12 \x03(\v2\x16.listings.ListingImageR\x06images\x12=\n" +
+	"\x0ecover_variants\x18\x13 \x03(\v2\x16.listings.ImageVariantR\rcoverVariants\x12\x1f\n" +
+	"\vis_favorite\x18\x14 \x01(\bR\n" +
+	"isFavorite\x12'\n" +
+	"\x0ffavorites_count\x18\x15 \x01(\rR\x0efavoritesCountJ\x04\b\x05\x10\x06\"J\n" +
+	"\x10ListingHighlight\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x8b\x02\n" +
 	"\x10ListingsResponse\x125\n" +
 	"\blistings\x18\x01 \x03(\v2\x19.listings.ListingResponseR\blistings\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\rR\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\rR\x04page\x12\x19\n" +
 	"\bper_page\x18\x04 \x01(\rR\aperPage\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\rR\n" +
-	"totalPages*X\n" +
+	"totalPages\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\x122\n" +
+	"\n" +
+	"total_mode\x18\a \x01(\x0e2\x13.listings.TotalModeR\ttotalMode\"_\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x120\n" +
+	"\valert_price\x18\x02 \x01(\v2\x0f.listings.MoneyR\n" +
+	"alertPrice\"0\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\xad\x01\n" +
+	"\x10FavoriteResponse\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x04R\tlistingId\x12\x1f\n" +
+	"\vis_favorite\x18\x02 \x01(\bR\n" +
+	"isFavorite\x12'\n" +
+	"\x0ffavorites_count\x18\x03 \x01(\rR\x0efavoritesCount\x120\n" +
+	"\valert_price\x18\x04 \x01(\v2\x0f.listings.MoneyR\n" +
+	"alertPrice\"Y\n" +
+	"\x14ListFavoritesRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02\x18dR\x04page\x12$\n" +
+	"\bper_page\x18\x02 \x01(\rB\t\xfaB\x06*\x04\x182 \x00R\aperPage\"\xb4\x01\n" +
+	"\x15ListFavoritesResponse\x125\n" +
+	"\blistings\x18\x01 \x03(\v2\x19.listings.ListingResponseR\blistings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\rR\aperPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\rR\n" +
+	"totalPages\"\xa1\a\n" +
+	"\x11SavedSearchFilter\x12,\n" +
+	"\asort_by\x18\x01 \x01(\x0e2\x13.listings.SortFieldR\x06sortBy\x122\n" +
+	"\n" +
+	"sort_order\x18\x02 \x01(\x0e2\x13.listings.SortOrderR\tsortOrder\x12,\n" +
+	"\tmin_price\x18\x03 \x01(\v2\x0f.listings.MoneyR\bminPrice\x12,\n" +
+	"\tmax_price\x18\x04 \x01(\v2\x0f.listings.MoneyR\bmaxPrice\x12\x1e\n" +
+	"\x05query\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05query\x12-\n" +
+	"\vcategory_id\x18\x06 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12q\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v2+.listings.SavedSearchFilter.AttributesEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\n" +
+	"attributes\x12{\n" +
+	"\x0eattributes_min\x18\b \x03(\v2..listings.SavedSearchFilter.AttributesMinEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMin\x12{\n" +
+	"\x0eattributes_max\x18\t \x03(\v2..listings.SavedSearchFilter.AttributesMaxEntryB$\xfaB!\x9a\x01\x1e\x10\n" +
+	"\"\x1ar\x182\x16^[a-z][a-z0-9_]{0,49}$R\rattributesMax\x12?\n" +
+	"\x10display_currency\x18\n" +
+	" \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\x0fdisplayCurrency\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a@\n" +
+	"\x12AttributesMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01B\x0e\n" +
+	"\f_category_id\"\xbb\x01\n" +
+	"\x18CreateSavedSearchRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12=\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1b.listings.SavedSearchFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06filter\x12A\n" +
+	"\tfrequency\x18\x03 \x01(\x0e2\x19.listings.SearchFrequencyB\b\xfaB\x05\x82\x01\x02\x10\x01R\tfrequency\"\x1a\n" +
+	"\x18ListSavedSearchesRequest\"a\n" +
+	"\x19ListSavedSearchesResponse\x12D\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x1d.listings.SavedSearchResponseR\rsavedSearches\"0\n" +
+	"\x15GetSavedSearchRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\xd4\x01\n" +
+	"\x18UpdateSavedSearchRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12=\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1b.listings.SavedSearchFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06filter\x12A\n" +
+	"\tfrequency\x18\x04 \x01(\x0e2\x19.listings.SearchFrequencyB\b\xfaB\x05\x82\x01\x02\x10\x01R\tfrequency\"3\n" +
+	"\x18DeleteSavedSearchRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\x1b\n" +
+	"\x19DeleteSavedSearchResponse\"\x9d\x02\n" +
+	"\x13SavedSearchResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1b.listings.SavedSearchFilterR\x06filter\x127\n" +
+	"\tfrequency\x18\x04 \x01(\x0e2\x19.listings.SearchFrequencyR\tfrequency\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xb7\x01\n" +
+	"\rListingStatus\x12\x1e\n" +
+	"\x1aLISTING_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LISTING_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15LISTING_STATUS_ACTIVE\x10\x02\x12\x1b\n" +
+	"\x17LISTING_STATUS_RESERVED\x10\x03\x12\x17\n" +
+	"\x13LISTING_STATUS_SOLD\x10\x04\x12\x1b\n" +
+	"\x17LISTING_STATUS_ARCHIVED\x10\x05*r\n" +
 	"\tSortField\x12\x1a\n" +
 	"\x16SORT_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15SORT_FIELD_CREATED_AT\x10\x01\x12\x14\n" +
-	"\x10SORT_FIELD_PRICE\x10\x02*P\n" +
+	"\x10SORT_FIELD_PRICE\x10\x02\x12\x18\n" +
+	"\x14SORT_FIELD_RELEVANCE\x10\x03*P\n" +
 	"\tSortOrder\x12\x1a\n" +
 	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
-	"\x0fSORT_ORDER_DESC\x10\x022\xf1\x04\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*l\n" +
+	"\tTotalMode\x12\x1a\n" +
+	"\x16TOTAL_MODE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TOTAL_MODE_EXACT\x10\x01\x12\x18\n" +
+	"\x14TOTAL_MODE_ESTIMATED\x10\x02\x12\x13\n" +
+	"\x0fTOTAL_MODE_NONE\x10\x03*m\n" +
+	"\x0fSearchFrequency\x12 \n" +
+	"\x1cSEARCH_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SEARCH_FREQUENCY_INSTANT\x10\x01\x12\x1a\n" +
+	"\x16SEARCH_FREQUENCY_DAILY\x10\x022\xb9C\n" +
 	"\x0fListingsService\x12\xb0\x02\n" +
 	"\rCreateListing\x12\x1e.listings.CreateListingRequest\x1a\x19.listings.ListingResponse\"\xe3\x01\x92A\xc8\x01\x122Создание нового объявления\x1a\x91\x01Создает новое объявление с указанным заголовком, текстом, изображением и ценой\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/listings\x12\xaa\x02\n" +
-	"\vGetListings\x12\x1c.listings.GetListingsRequest\x1a\x1a.listings.ListingsResponse\"\xe0\x01\x92A\xc8\x01\x122Получение ленты объявлений\x1a\x91\x01Возвращает ленту объявлений с возможностью сортировки, фильтрации и пагинации\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/listingsB\xf1\x01\x92A\xc0\x01\x12\x86\x01\n" +
+	"\vGetListings\x12\x1c.listings.GetListingsRequest\x1a\x1a.listings.ListingsResponse\"\xe0\x01\x92A\xc8\x01\x122Получение ленты объявлений\x1a\x91\x01Возвращает ленту объявлений с возможностью сортировки, фильтрации и пагинации\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/listings\x12\xe0\x01\n" +
+	"\n" +
+	"GetListing\x12\x1b.listings.GetListingRequest\x1a\x19.listings.ListingResponse\"\x99\x01\x92A}\x12'Получение объявления\x1aRВозвращает объявление по его идентификатору\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/listings/{id}\x12\xb2\x03\n" +
+	"\rUpdateListing\x12\x1e.listings.UpdateListingRequest\x1a\x19.listings.ListingResponse\"\xe5\x02\x92A\xc5\x02\x121Редактирование объявления\x1a\x8f\x02Частично обновляет объявление автора. Обновляются только поля из update_mask. Если version не совпадает с текущей версией объявления, возвращается конфликт\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/listings/{id}\x12\x83\x03\n" +
+	"\rDeleteListing\x12\x1e.listings.DeleteListingRequest\x1a\x1f.listings.DeleteListingResponse\"\xb0\x02\x92A\x93\x02\x12%Удаление объявления\x1a\xe9\x01Помечает объявление автора удаленным. Объявление можно восстановить до момента restore_until, после чего оно удаляется окончательно\x82\xd3\xe4\x93\x02\x13*\x11/v1/listings/{id}\x12\xe3\x02\n" +
+	"\x0eRestoreListing\x12\x1f.listings.RestoreListingRequest\x1a\x19.listings.ListingResponse\"\x94\x02\x92A\xec\x01\x121Восстановление объявления\x1a\xb6\x01Восстанавливает удаленное объявление автора, если срок хранения удаленных объявлений еще не истек\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/listings/{id}/restore\x12\xbd\x03\n" +
+	"\x13ChangeListingStatus\x12$.listings.ChangeListingStatusRequest\x1a\x19.listings.ListingResponse\"\xe4\x02\x92A\xbd\x02\x126Изменение статуса объявления\x1a\x82\x02Переводит объявление автора в новый статус. Допустимы только разрешенные переходы, например проданное объявление нельзя вернуть в черновик\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/listings/{id}/status\x12\x9e\x03\n" +
+	"\x0eSearchListings\x12\x1f.listings.SearchListingsRequest\x1a .listings.SearchListingsResponse\"\xc8\x02\x92A\xa9\x02\x120Нечеткий поиск объявлений\x1a\xf4\x01Ищет активные объявления по заголовку с учетом опечаток (триграммное сходство) и возвращает степень сходства для каждого результата\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/listings/search\x12\xec\x02\n" +
+	"\x0fSuggestListings\x12 .listings.SuggestListingsRequest\x1a!.listings.SuggestListingsResponse\"\x93\x02\x92A\xf3\x01\x12&Подсказки для поиска\x1a\xc8\x01Возвращает наиболее популярные слова из заголовков активных объявлений, начинающиеся с указанного префикса\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/listings/suggest\x12\xca\x03\n" +
+	"\x10GetListingFacets\x12!.listings.GetListingFacetsRequest\x1a\x1f.listings.ListingFacetsResponse\"\xf1\x02\x92A\xd2\x02\x12,Фасеты ленты объявлений\x1a\xa1\x02Возвращает количество объявлений по категориям, статусам и диапазонам цен для текущего фильтра ленты. Каждый фасет считается без учета собственного фильтра\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/listings/facets\x12\xc6\x02\n" +
+	"\x10AddListingImages\x12!.listings.AddListingImagesRequest\x1a\x19.listings.ListingResponse\"\xf3\x01\x92A\xcc\x01\x12+Добавление изображений\x1a\x9c\x01Добавляет изображения в конец галереи объявления. Доступно только автору объявления\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/listings/{id}/images\x12\x8e\x03\n" +
+	"\x12RemoveListingImage\x12#.listings.RemoveListingImageRequest\x1a\x19.listings.ListingResponse\"\xb7\x02\x92A\x88\x02\x12'Удаление изображения\x1a\xdc\x01Удаляет изображение из галереи объявления. Единственное изображение удалить нельзя. Доступно только автору объявления\x82\xd3\xe4\x93\x02%*#/v1/listings/{id}/images/{image_id}\x12\xb2\x03\n" +
+	"\x14ReorderListingImages\x12%.listings.ReorderListingImagesRequest\x1a\x19.listings.ListingResponse\"\xd7\x02\x92A\xaa\x02\x128Изменение порядка изображений\x1a\xed\x01Задает новый порядок изображений галереи. Первое изображение становится обложкой объявления. Доступно только автору объявления\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/listings/{id}/images/order\x12\xcc\x04\n" +
+	"\vAddFavorite\x12\x1c.listings.AddFavoriteRequest\x1a\x1a.listings.FavoriteResponse\"\x82\x04\x92A\xd9\x03\x12*Добавление в избранное\x1a\xaa\x03Добавляет объявление в избранное текущего пользователя и подписывает его на снижение цены. Если указан alert_price, уведомление придет, когда цена опустится до этого порога. Повторное добавление не является ошибкой и заменяет порог цены\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/listings/{id}/favorite\x12\x8b\x03\n" +
+	"\x0eRemoveFavorite\x12\x1f.listings.RemoveFavoriteRequest\x1a\x1a.listings.FavoriteResponse\"\xbb\x02\x92A\x95\x02\x12*Удаление из избранного\x1a\xe6\x01Удаляет объявление из избранного текущего пользователя. Удаление отсутствующего в избранном объявления не является ошибкой\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/listings/{id}/favorite\x12\x9f\x03\n" +
+	"\rListFavorites\x12\x1e.listings.ListFavoritesRequest\x1a\x1f.listings.ListFavoritesResponse\"\xcc\x02\x92A\xb0\x02\x12'Избранные объявления\x1a\x84\x02Возвращает избранные объявления текущего пользователя, недавно добавленные первыми. Удаленные объявления и чужие черновики не возвращаются\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/me/favorites\x12\x96\x03\n" +
+	"\x11CreateSavedSearch\x12\".listings.CreateSavedSearchRequest\x1a\x1d.listings.SavedSearchResponse\"\xbd\x02\x92A\x99\x02\x12!Сохранение поиска\x1a\xf3\x01Сохраняет условия ленты объявлений. О новых подходящих объявлениях пользователь получает уведомления сразу или ежедневной сводкой\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/me/saved-searches\x12\xad\x02\n" +
+	"\x11ListSavedSearches\x12\".listings.ListSavedSearchesRequest\x1a#.listings.ListSavedSearchesResponse\"\xce\x01\x92A\xad\x01\x12#Сохраненные поиски\x1a\x85\x01Возвращает сохраненные поиски текущего пользователя в порядке создания\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/me/saved-searches\x12\xb1\x01\n" +
+	"\x0eGetSavedSearch\x12\x1f.listings.GetSavedSearchRequest\x1a\x1d.listings.SavedSearchResponse\"_\x92A:\x128Получение сохраненного поиска\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/me/saved-searches/{id}\x12\x93\x03\n" +
+	"\x11UpdateSavedSearch\x12\".listings.UpdateSavedSearchRequest\x1a\x1d.listings.SavedSearchResponse\"\xba\x02\x92A\x91\x02\x128Изменение сохраненного поиска\x1a\xd4\x01Заменяет название, условия и частоту уведомлений. Объявления, опубликованные до изменения, повторно не проверяются\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/v1/me/saved-searches/{id}\x12\xbb\x01\n" +
+	"\x11DeleteSavedSearch\x12\".listings.DeleteSavedSearchRequest\x1a#.listings.DeleteSavedSearchResponse\"]\x92A8\x126Удаление сохраненного поиска\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/me/saved-searches/{id}\x12\xb8\x03\n" +
+	"\x0fGetUserListings\x12 .listings.GetUserListingsRequest\x1a\x1a.listings.ListingsResponse\"\xe6\x02\x92A\xbd\x02\x12-Объявления пользователя\x1a\x8b\x02Возвращает опубликованные объявления пользователя с теми же фильтрами, сортировкой и пагинацией, что и общая лента. По умолчанию только активные\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/users/{username}/listings\x12\x8a\x03\n" +
+	"\rGetMyListings\x12\x1e.listings.GetMyListingsRequest\x1a\x1a.listings.ListingsResponse\"\xbc\x02\x92A\xa1\x02\x12\x1bМои объявления\x1a\x81\x02Возвращает объявления текущего пользователя, включая черновики и архивные. Если статус не указан, возвращаются объявления во всех статусах\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/me/listingsB\xf1\x01\x92A\xc0\x01\x12\x86\x01\n" +
 	"\x18Marketplace Listings API\x12cAPI для управления и просмотра объявлений маркетплейса2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ+github.com/Snake1-1eyes/marketplace/pkg/apib\x06proto3"
 
 var (
@@ -526,31 +3921,210 @@ func file_listings_listings_proto_rawDescGZIP() []byte {
 	return file_listings_listings_proto_rawDescData
 }
 
-var file_listings_listings_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_listings_listings_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_listings_listings_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_listings_listings_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_listings_listings_proto_goTypes = []any{
-	(SortField)(0),                // 0: listings.SortField
-	(SortOrder)(0),                // 1: listings.SortOrder
-	(*CreateListingRequest)(nil),  // 2: listings.CreateListingRequest
-	(*GetListingsRequest)(nil),    // 3: listings.GetListingsRequest
-	(*ListingResponse)(nil),       // 4: listings.ListingResponse
-	(*ListingsResponse)(nil),      // 5: listings.ListingsResponse
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(ListingStatus)(0),                  // 0: listings.ListingStatus
+	(SortField)(0),                      // 1: listings.SortField
+	(SortOrder)(0),                      // 2: listings.SortOrder
+	(TotalMode)(0),                      // 3: listings.TotalMode
+	(SearchFrequency)(0),                // 4: listings.SearchFrequency
+	(*CreateListingRequest)(nil),        // 5: listings.CreateListingRequest
+	(*GetListingsRequest)(nil),          // 6: listings.GetListingsRequest
+	(*GetUserListingsRequest)(nil),      // 7: listings.GetUserListingsRequest
+	(*GetMyListingsRequest)(nil),        // 8: listings.GetMyListingsRequest
+	(*GetListingRequest)(nil),           // 9: listings.GetListingRequest
+	(*UpdateListingRequest)(nil),        // 10: listings.UpdateListingRequest
+	(*DeleteListingRequest)(nil),        // 11: listings.DeleteListingRequest
+	(*DeleteListingResponse)(nil),       // 12: listings.DeleteListingResponse
+	(*RestoreListingRequest)(nil),       // 13: listings.RestoreListingRequest
+	(*SearchListingsRequest)(nil),       // 14: listings.SearchListingsRequest
+	(*ListingSearchResult)(nil),         // 15: listings.ListingSearchResult
+	(*SearchListingsResponse)(nil),      // 16: listings.SearchListingsResponse
+	(*GetListingFacetsRequest)(nil),     // 17: listings.GetListingFacetsRequest
+	(*CategoryFacet)(nil),               // 18: listings.CategoryFacet
+	(*StatusFacet)(nil),                 // 19: listings.StatusFacet
+	(*PriceBucket)(nil),                 // 20: listings.PriceBucket
+	(*ListingFacetsResponse)(nil),       // 21: listings.ListingFacetsResponse
+	(*SuggestListingsRequest)(nil),      // 22: listings.SuggestListingsRequest
+	(*Suggestion)(nil),                  // 23: listings.Suggestion
+	(*SuggestListingsResponse)(nil),     // 24: listings.SuggestListingsResponse
+	(*ChangeListingStatusRequest)(nil),  // 25: listings.ChangeListingStatusRequest
+	(*AddListingImagesRequest)(nil),     // 26: listings.AddListingImagesRequest
+	(*RemoveListingImageRequest)(nil),   // 27: listings.RemoveListingImageRequest
+	(*ReorderListingImagesRequest)(nil), // 28: listings.ReorderListingImagesRequest
+	(*ListingImage)(nil),                // 29: listings.ListingImage
+	(*ImageVariant)(nil),                // 30: listings.ImageVariant
+	(*Money)(nil),                       // 31: listings.Money
+	(*ListingResponse)(nil),             // 32: listings.ListingResponse
+	(*ListingHighlight)(nil),            // 33: listings.ListingHighlight
+	(*ListingsResponse)(nil),            // 34: listings.ListingsResponse
+	(*AddFavoriteRequest)(nil),          // 35: listings.AddFavoriteRequest
+	(*RemoveFavoriteRequest)(nil),       // 36: listings.RemoveFavoriteRequest
+	(*FavoriteResponse)(nil),            // 37: listings.FavoriteResponse
+	(*ListFavoritesRequest)(nil),        // 38: listings.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),       // 39: listings.ListFavoritesResponse
+	(*SavedSearchFilter)(nil),           // 40: listings.SavedSearchFilter
+	(*CreateSavedSearchRequest)(nil),    // 41: listings.CreateSavedSearchRequest
+	(*ListSavedSearchesRequest)(nil),    // 42: listings.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),   // 43: listings.ListSavedSearchesResponse
+	(*GetSavedSearchRequest)(nil),       // 44: listings.GetSavedSearchRequest
+	(*UpdateSavedSearchRequest)(nil),    // 45: listings.UpdateSavedSearchRequest
+	(*DeleteSavedSearchRequest)(nil),    // 46: listings.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),   // 47: listings.DeleteSavedSearchResponse
+	(*SavedSearchResponse)(nil),         // 48: listings.SavedSearchResponse
+	nil,                                 // 49: listings.GetListingsRequest.AttributesEntry
+	nil,                                 // 50: listings.GetListingsRequest.AttributesMinEntry
+	nil,                                 // 51: listings.GetListingsRequest.AttributesMaxEntry
+	nil,                                 // 52: listings.GetUserListingsRequest.AttributesEntry
+	nil,                                 // 53: listings.GetUserListingsRequest.AttributesMinEntry
+	nil,                                 // 54: listings.GetUserListingsRequest.AttributesMaxEntry
+	nil,                                 // 55: listings.GetMyListingsRequest.AttributesEntry
+	nil,                                 // 56: listings.GetMyListingsRequest.AttributesMinEntry
+	nil,                                 // 57: listings.GetMyListingsRequest.AttributesMaxEntry
+	nil,                                 // 58: listings.GetListingFacetsRequest.AttributesEntry
+	nil,                                 // 59: listings.GetListingFacetsRequest.AttributesMinEntry
+	nil,                                 // 60: listings.GetListingFacetsRequest.AttributesMaxEntry
+	nil,                                 // 61: listings.SavedSearchFilter.AttributesEntry
+	nil,                                 // 62: listings.SavedSearchFilter.AttributesMinEntry
+	nil,                                 // 63: listings.SavedSearchFilter.AttributesMaxEntry
+	(*structpb.Struct)(nil),             // 64: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil),       // 65: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),       // 66: google.protobuf.Timestamp
 }
 var file_listings_listings_proto_depIdxs = []int32{
-	0, // 0: listings.GetListingsRequest.sort_by:type_name -> listings.SortField
-	1, // 1: listings.GetListingsRequest.sort_order:type_name -> listings.SortOrder
-	6, // 2: listings.ListingResponse.created_at:type_name -> google.protobuf.Timestamp
-	4, // 3: listings.ListingsResponse.listings:type_name -> listings.ListingResponse
-	2, // 4: listings.ListingsService.CreateListing:input_type -> listings.CreateListingRequest
-	3, // 5: listings.ListingsService.GetListings:input_type -> listings.GetListingsRequest
-	4, // 6: listings.ListingsService.CreateListing:output_type -> listings.ListingResponse
-	5, // 7: listings.ListingsService.GetListings:output_type -> listings.ListingsResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	31,  // 0: listings.CreateListingRequest.price:type_name -> listings.Money
+	0,   // 1: listings.CreateListingRequest.status:type_name -> listings.ListingStatus
+	64,  // 2: listings.CreateListingRequest.attributes:type_name -> google.protobuf.Struct
+	1,   // 3: listings.GetListingsRequest.sort_by:type_name -> listings.SortField
+	2,   // 4: listings.GetListingsRequest.sort_order:type_name -> listings.SortOrder
+	31,  // 5: listings.GetListingsRequest.min_price:type_name -> listings.Money
+	31,  // 6: listings.GetListingsRequest.max_price:type_name -> listings.Money
+	0,   // 7: listings.GetListingsRequest.status:type_name -> listings.ListingStatus
+	49,  // 8: listings.GetListingsRequest.attributes:type_name -> listings.GetListingsRequest.AttributesEntry
+	50,  // 9: listings.GetListingsRequest.attributes_min:type_name -> listings.GetListingsRequest.AttributesMinEntry
+	51,  // 10: listings.GetListingsRequest.attributes_max:type_name -> listings.GetListingsRequest.AttributesMaxEntry
+	3,   // 11: listings.GetListingsRequest.total_mode:type_name -> listings.TotalMode
+	1,   // 12: listings.GetUserListingsRequest.sort_by:type_name -> listings.SortField
+	2,   // 13: listings.GetUserListingsRequest.sort_order:type_name -> listings.SortOrder
+	31,  // 14: listings.GetUserListingsRequest.min_price:type_name -> listings.Money
+	31,  // 15: listings.GetUserListingsRequest.max_price:type_name -> listings.Money
+	0,   // 16: listings.GetUserListingsRequest.status:type_name -> listings.ListingStatus
+	52,  // 17: listings.GetUserListingsRequest.attributes:type_name -> listings.GetUserListingsRequest.AttributesEntry
+	53,  // 18: listings.GetUserListingsRequest.attributes_min:type_name -> listings.GetUserListingsRequest.AttributesMinEntry
+	54,  // 19: listings.GetUserListingsRequest.attributes_max:type_name -> listings.GetUserListingsRequest.AttributesMaxEntry
+	3,   // 20: listings.GetUserListingsRequest.total_mode:type_name -> listings.TotalMode
+	1,   // 21: listings.GetMyListingsRequest.sort_by:type_name -> listings.SortField
+	2,   // 22: listings.GetMyListingsRequest.sort_order:type_name -> listings.SortOrder
+	31,  // 23: listings.GetMyListingsRequest.min_price:type_name -> listings.Money
+	31,  // 24: listings.GetMyListingsRequest.max_price:type_name -> listings.Money
+	0,   // 25: listings.GetMyListingsRequest.status:type_name -> listings.ListingStatus
+	55,  // 26: listings.GetMyListingsRequest.attributes:type_name -> listings.GetMyListingsRequest.AttributesEntry
+	56,  // 27: listings.GetMyListingsRequest.attributes_min:type_name -> listings.GetMyListingsRequest.AttributesMinEntry
+	57,  // 28: listings.GetMyListingsRequest.attributes_max:type_name -> listings.GetMyListingsRequest.AttributesMaxEntry
+	3,   // 29: listings.GetMyListingsRequest.total_mode:type_name -> listings.TotalMode
+	65,  // 30: listings.UpdateListingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	31,  // 31: listings.UpdateListingRequest.price:type_name -> listings.Money
+	64,  // 32: listings.UpdateListingRequest.attributes:type_name -> google.protobuf.Struct
+	66,  // 33: listings.DeleteListingResponse.restore_until:type_name -> google.protobuf.Timestamp
+	32,  // 34: listings.ListingSearchResult.listing:type_name -> listings.ListingResponse
+	15,  // 35: listings.SearchListingsResponse.results:type_name -> listings.ListingSearchResult
+	31,  // 36: listings.GetListingFacetsRequest.min_price:type_name -> listings.Money
+	31,  // 37: listings.GetListingFacetsRequest.max_price:type_name -> listings.Money
+	0,   // 38: listings.GetListingFacetsRequest.status:type_name -> listings.ListingStatus
+	58,  // 39: listings.GetListingFacetsRequest.attributes:type_name -> listings.GetListingFacetsRequest.AttributesEntry
+	59,  // 40: listings.GetListingFacetsRequest.attributes_min:type_name -> listings.GetListingFacetsRequest.AttributesMinEntry
+	60,  // 41: listings.GetListingFacetsRequest.attributes_max:type_name -> listings.GetListingFacetsRequest.AttributesMaxEntry
+	0,   // 42: listings.StatusFacet.status:type_name -> listings.ListingStatus
+	31,  // 43: listings.PriceBucket.from:type_name -> listings.Money
+	31,  // 44: listings.PriceBucket.to:type_name -> listings.Money
+	18,  // 45: listings.ListingFacetsResponse.categories:type_name -> listings.CategoryFacet
+	19,  // 46: listings.ListingFacetsResponse.statuses:type_name -> listings.StatusFacet
+	20,  // 47: listings.ListingFacetsResponse.price_histogram:type_name -> listings.PriceBucket
+	23,  // 48: listings.SuggestListingsResponse.suggestions:type_name -> listings.Suggestion
+	0,   // 49: listings.ChangeListingStatusRequest.status:type_name -> listings.ListingStatus
+	30,  // 50: listings.ListingImage.variants:type_name -> listings.ImageVariant
+	31,  // 51: listings.ListingResponse.price:type_name -> listings.Money
+	66,  // 52: listings.ListingResponse.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 53: listings.ListingResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 54: listings.ListingResponse.status:type_name -> listings.ListingStatus
+	33,  // 55: listings.ListingResponse.highlight:type_name -> listings.ListingHighlight
+	64,  // 56: listings.ListingResponse.attributes:type_name -> google.protobuf.Struct
+	31,  // 57: listings.ListingResponse.display_price:type_name -> listings.Money
+	29,  // 58: listings.ListingResponse.images:type_name -> listings.ListingImage
+	30,  // 59: listings.ListingResponse.cover_variants:type_name -> listings.ImageVariant
+	32,  // 60: listings.ListingsResponse.listings:type_name -> listings.ListingResponse
+	3,   // 61: listings.ListingsResponse.total_mode:type_name -> listings.TotalMode
+	31,  // 62: listings.AddFavoriteRequest.alert_price:type_name -> listings.Money
+	31,  // 63: listings.FavoriteResponse.alert_price:type_name -> listings.Money
+	32,  // 64: listings.ListFavoritesResponse.listings:type_name -> listings.ListingResponse
+	1,   // 65: listings.SavedSearchFilter.sort_by:type_name -> listings.SortField
+	2,   // 66: listings.SavedSearchFilter.sort_order:type_name -> listings.SortOrder
+	31,  // 67: listings.SavedSearchFilter.min_price:type_name -> listings.Money
+	31,  // 68: listings.SavedSearchFilter.max_price:type_name -> listings.Money
+	61,  // 69: listings.SavedSearchFilter.attributes:type_name -> listings.SavedSearchFilter.AttributesEntry
+	62,  // 70: listings.SavedSearchFilter.attributes_min:type_name -> listings.SavedSearchFilter.AttributesMinEntry
+	63,  // 71: listings.SavedSearchFilter.attributes_max:type_name -> listings.SavedSearchFilter.AttributesMaxEntry
+	40,  // 72: listings.CreateSavedSearchRequest.filter:type_name -> listings.SavedSearchFilter
+	4,   // 73: listings.CreateSavedSearchRequest.frequency:type_name -> listings.SearchFrequency
+	48,  // 74: listings.ListSavedSearchesResponse.saved_searches:type_name -> listings.SavedSearchResponse
+	40,  // 75: listings.UpdateSavedSearchRequest.filter:type_name -> listings.SavedSearchFilter
+	4,   // 76: listings.UpdateSavedSearchRequest.frequency:type_name -> listings.SearchFrequency
+	40,  // 77: listings.SavedSearchResponse.filter:type_name -> listings.SavedSearchFilter
+	4,   // 78: listings.SavedSearchResponse.frequency:type_name -> listings.SearchFrequency
+	66,  // 79: listings.SavedSearchResponse.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 80: listings.SavedSearchResponse.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 81: listings.ListingsService.CreateListing:input_type -> listings.CreateListingRequest
+	6,   // 82: listings.ListingsService.GetListings:input_type -> listings.GetListingsRequest
+	9,   // 83: listings.ListingsService.GetListing:input_type -> listings.GetListingRequest
+	10,  // 84: listings.ListingsService.UpdateListing:input_type -> listings.UpdateListingRequest
+	11,  // 85: listings.ListingsService.DeleteListing:input_type -> listings.DeleteListingRequest
+	13,  // 86: listings.ListingsService.RestoreListing:input_type -> listings.RestoreListingRequest
+	25,  // 87: listings.ListingsService.ChangeListingStatus:input_type -> listings.ChangeListingStatusRequest
+	14,  // 88: listings.ListingsService.SearchListings:input_type -> listings.SearchListingsRequest
+	22,  // 89: listings.ListingsService.SuggestListings:input_type -> listings.SuggestListingsRequest
+	17,  // 90: listings.ListingsService.GetListingFacets:input_type -> listings.GetListingFacetsRequest
+	26,  // 91: listings.ListingsService.AddListingImages:input_type -> listings.AddListingImagesRequest
+	27,  // 92: listings.ListingsService.RemoveListingImage:input_type -> listings.RemoveListingImageRequest
+	28,  // 93: listings.ListingsService.ReorderListingImages:input_type -> listings.ReorderListingImagesRequest
+	35,  // 94: listings.ListingsService.AddFavorite:input_type -> listings.AddFavoriteRequest
+	36,  // 95: listings.ListingsService.RemoveFavorite:input_type -> listings.RemoveFavoriteRequest
+	38,  // 96: listings.ListingsService.ListFavorites:input_type -> listings.ListFavoritesRequest
+	41,  // 97: listings.ListingsService.CreateSavedSearch:input_type -> listings.CreateSavedSearchRequest
+	42,  // 98: listings.ListingsService.ListSavedSearches:input_type -> listings.ListSavedSearchesRequest
+	44,  // 99: listings.ListingsService.GetSavedSearch:input_type -> listings.GetSavedSearchRequest
+	45,  // 100: listings.ListingsService.UpdateSavedSearch:input_type -> listings.UpdateSavedSearchRequest
+	46,  // 101: listings.ListingsService.DeleteSavedSearch:input_type -> listings.DeleteSavedSearchRequest
+	7,   // 102: listings.ListingsService.GetUserListings:input_type -> listings.GetUserListingsRequest
+	8,   // 103: listings.ListingsService.GetMyListings:input_type -> listings.GetMyListingsRequest
+	32,  // 104: listings.ListingsService.CreateListing:output_type -> listings.ListingResponse
+	34,  // 105: listings.ListingsService.GetListings:output_type -> listings.ListingsResponse
+	32,  // 106: listings.ListingsService.GetListing:output_type -> listings.ListingResponse
+	32,  // 107: listings.ListingsService.UpdateListing:output_type -> listings.ListingResponse
+	12,  // 108: listings.ListingsService.DeleteListing:output_type -> listings.DeleteListingResponse
+	32,  // 109: listings.ListingsService.RestoreListing:output_type -> listings.ListingResponse
+	32,  // 110: listings.ListingsService.ChangeListingStatus:output_type -> listings.ListingResponse
+	16,  // 111: listings.ListingsService.SearchListings:output_type -> listings.SearchListingsResponse
+	24,  // 112: listings.ListingsService.SuggestListings:output_type -> listings.SuggestListingsResponse
+	21,  // 113: listings.ListingsService.GetListingFacets:output_type -> listings.ListingFacetsResponse
+	32,  // 114: listings.ListingsService.AddListingImages:output_type -> listings.ListingResponse
+	32,  // 115: listings.ListingsService.RemoveListingImage:output_type -> listings.ListingResponse
+	32,  // 116: listings.ListingsService.ReorderListingImages:output_type -> listings.ListingResponse
+	37,  // 117: listings.ListingsService.AddFavorite:output_type -> listings.FavoriteResponse
+	37,  // 118: listings.ListingsService.RemoveFavorite:output_type -> listings.FavoriteResponse
+	39,  // 119: listings.ListingsService.ListFavorites:output_type -> listings.ListFavoritesResponse
+	48,  // 120: listings.ListingsService.CreateSavedSearch:output_type -> listings.SavedSearchResponse
+	43,  // 121: listings.ListingsService.ListSavedSearches:output_type -> listings.ListSavedSearchesResponse
+	48,  // 122: listings.ListingsService.GetSavedSearch:output_type -> listings.SavedSearchResponse
+	48,  // 123: listings.ListingsService.UpdateSavedSearch:output_type -> listings.SavedSearchResponse
+	47,  // 124: listings.ListingsService.DeleteSavedSearch:output_type -> listings.DeleteSavedSearchResponse
+	34,  // 125: listings.ListingsService.GetUserListings:output_type -> listings.ListingsResponse
+	34,  // 126: listings.ListingsService.GetMyListings:output_type -> listings.ListingsResponse
+	104, // [104:127] is the sub-list for method output_type
+	81,  // [81:104] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_listings_listings_proto_init() }
@@ -559,13 +4133,17 @@ func file_listings_listings_proto_init() {
 		return
 	}
 	file_listings_listings_proto_msgTypes[1].OneofWrappers = []any{}
+	file_listings_listings_proto_msgTypes[2].OneofWrappers = []any{}
+	file_listings_listings_proto_msgTypes[3].OneofWrappers = []any{}
+	file_listings_listings_proto_msgTypes[12].OneofWrappers = []any{}
+	file_listings_listings_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_listings_listings_proto_rawDesc), len(file_listings_listings_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -100,9 +100,60 @@ func (m *CreateListingRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPrice() <= 0 {
+	if m.GetPrice() == nil {
 		err := CreateListingRequestValidationError{
 			field:  "Price",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateListingRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateListingRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateListingRequestValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _CreateListingRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := CreateListingRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [LISTING_STATUS_UNSPECIFIED LISTING_STATUS_DRAFT LISTING_STATUS_ACTIVE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCategoryId() <= 0 {
+		err := CreateListingRequestValidationError{
+			field:  "CategoryId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -111,6 +162,72 @@ func (m *CreateListingRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateListingRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateListingRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateListingRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetImages()) > 9 {
+		err := CreateListingRequestValidationError{
+			field:  "Images",
+			reason: "value must contain no more than 9 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if uri, err := url.Parse(item); err != nil {
+			err = CreateListingRequestValidationError{
+				field:  fmt.Sprintf("Images[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateListingRequestValidationError{
+				field:  fmt.Sprintf("Images[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateListingRequestMultiError(errors)
 	}
@@ -191,6 +308,12 @@ var _ interface {
 	ErrorName() string
 } = CreateListingRequestValidationError{}
 
+var _CreateListingRequest_Status_InLookup = map[ListingStatus]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
 // Validate checks the field values on GetListingsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -213,10 +336,10 @@ func (m *GetListingsRequest) validate(all bool) error {
 
 	var errors []error
 
-	if val := m.GetPage(); val <= 0 || val > 100 {
+	if m.GetPage() > 100 {
 		err := GetListingsRequestValidationError{
 			field:  "Page",
-			reason: "value must be inside range (0, 100]",
+			reason: "value must be less than or equal to 100",
 		}
 		if !all {
 			return err
@@ -239,12 +362,228 @@ func (m *GetListingsRequest) validate(all bool) error {
 
 	// no validation rules for SortOrder
 
-	if m.MinPrice != nil {
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListingsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListingsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListingsRequestValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListingsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListingsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListingsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ListingStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetListingsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := GetListingsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 10 {
+		err := GetListingsRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_GetListingsRequest_Attributes_Pattern.MatchString(key) {
+				err := GetListingsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if len(m.GetAttributesMin()) > 10 {
+		err := GetListingsRequestValidationError{
+			field:  "AttributesMin",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMin()))
+		i := 0
+		for key := range m.GetAttributesMin() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMin()[key]
+			_ = val
+
+			if !_GetListingsRequest_AttributesMin_Pattern.MatchString(key) {
+				err := GetListingsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMin[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMin[key]
+		}
+	}
+
+	if len(m.GetAttributesMax()) > 10 {
+		err := GetListingsRequestValidationError{
+			field:  "AttributesMax",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMax()))
+		i := 0
+		for key := range m.GetAttributesMax() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMax()[key]
+			_ = val
+
+			if !_GetListingsRequest_AttributesMax_Pattern.MatchString(key) {
+				err := GetListingsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMax[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMax[key]
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 512 {
+		err := GetListingsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TotalMode_name[int32(m.GetTotalMode())]; !ok {
+		err := GetListingsRequestValidationError{
+			field:  "TotalMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDisplayCurrency() != "" {
 
-		if m.GetMinPrice() < 0 {
+		if !_GetListingsRequest_DisplayCurrency_Pattern.MatchString(m.GetDisplayCurrency()) {
 			err := GetListingsRequestValidationError{
-				field:  "MinPrice",
-				reason: "value must be greater than or equal to 0",
+				field:  "DisplayCurrency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
 			}
 			if !all {
 				return err
@@ -254,11 +593,11 @@ func (m *GetListingsRequest) validate(all bool) error {
 
 	}
 
-	if m.MaxPrice != nil {
+	if m.CategoryId != nil {
 
-		if m.GetMaxPrice() <= 0 {
+		if m.GetCategoryId() <= 0 {
 			err := GetListingsRequestValidationError{
-				field:  "MaxPrice",
+				field:  "CategoryId",
 				reason: "value must be greater than 0",
 			}
 			if !all {
@@ -349,85 +688,6645 @@ var _ interface {
 	ErrorName() string
 } = GetListingsRequestValidationError{}
 
-// Validate checks the field values on ListingResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListingResponse) Validate() error {
+var _GetListingsRequest_Attributes_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetListingsRequest_AttributesMin_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetListingsRequest_AttributesMax_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetListingsRequest_DisplayCurrency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on GetUserListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserListingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListingResponse with the rules
+// ValidateAll checks the field values on GetUserListingsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListingResponseMultiError, or nil if none found.
-func (m *ListingResponse) ValidateAll() error {
+// GetUserListingsRequestMultiError, or nil if none found.
+func (m *GetUserListingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListingResponse) validate(all bool) error {
+func (m *GetUserListingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Title
-
-	// no validation rules for Description
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 50 {
+		err := GetUserListingsRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 3 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ImageUrl
+	if !_GetUserListingsRequest_Username_Pattern.MatchString(m.GetUsername()) {
+		err := GetUserListingsRequestValidationError{
+			field:  "Username",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Price
+	if m.GetPage() > 100 {
+		err := GetUserListingsRequestValidationError{
+			field:  "Page",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for AuthorUsername
+	if val := m.GetPerPage(); val <= 0 || val > 50 {
+		err := GetUserListingsRequestValidationError{
+			field:  "PerPage",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserListingsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserListingsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserListingsRequestValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserListingsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserListingsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserListingsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ListingStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetUserListingsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := GetUserListingsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 10 {
+		err := GetUserListingsRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_GetUserListingsRequest_Attributes_Pattern.MatchString(key) {
+				err := GetUserListingsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if len(m.GetAttributesMin()) > 10 {
+		err := GetUserListingsRequestValidationError{
+			field:  "AttributesMin",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMin()))
+		i := 0
+		for key := range m.GetAttributesMin() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMin()[key]
+			_ = val
+
+			if !_GetUserListingsRequest_AttributesMin_Pattern.MatchString(key) {
+				err := GetUserListingsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMin[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMin[key]
+		}
+	}
+
+	if len(m.GetAttributesMax()) > 10 {
+		err := GetUserListingsRequestValidationError{
+			field:  "AttributesMax",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMax()))
+		i := 0
+		for key := range m.GetAttributesMax() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMax()[key]
+			_ = val
+
+			if !_GetUserListingsRequest_AttributesMax_Pattern.MatchString(key) {
+				err := GetUserListingsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMax[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMax[key]
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 512 {
+		err := GetUserListingsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TotalMode_name[int32(m.GetTotalMode())]; !ok {
+		err := GetUserListingsRequestValidationError{
+			field:  "TotalMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDisplayCurrency() != "" {
+
+		if !_GetUserListingsRequest_DisplayCurrency_Pattern.MatchString(m.GetDisplayCurrency()) {
+			err := GetUserListingsRequestValidationError{
+				field:  "DisplayCurrency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CategoryId != nil {
+
+		if m.GetCategoryId() <= 0 {
+			err := GetUserListingsRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserListingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserListingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserListingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserListingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserListingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserListingsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserListingsRequestValidationError is the validation error returned by
+// GetUserListingsRequest.Validate if the designated constraints aren't met.
+type GetUserListingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserListingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserListingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserListingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserListingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserListingsRequestValidationError) ErrorName() string {
+	return "GetUserListingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserListingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserListingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserListingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserListingsRequestValidationError{}
+
+var _GetUserListingsRequest_Username_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+var _GetUserListingsRequest_Attributes_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetUserListingsRequest_AttributesMin_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetUserListingsRequest_AttributesMax_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetUserListingsRequest_DisplayCurrency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on GetMyListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyListingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyListingsRequestMultiError, or nil if none found.
+func (m *GetMyListingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyListingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() > 100 {
+		err := GetMyListingsRequestValidationError{
+			field:  "Page",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPerPage(); val <= 0 || val > 50 {
+		err := GetMyListingsRequestValidationError{
+			field:  "PerPage",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMyListingsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMyListingsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMyListingsRequestValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMyListingsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMyListingsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMyListingsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ListingStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetMyListingsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := GetMyListingsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 10 {
+		err := GetMyListingsRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_GetMyListingsRequest_Attributes_Pattern.MatchString(key) {
+				err := GetMyListingsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if len(m.GetAttributesMin()) > 10 {
+		err := GetMyListingsRequestValidationError{
+			field:  "AttributesMin",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMin()))
+		i := 0
+		for key := range m.GetAttributesMin() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMin()[key]
+			_ = val
+
+			if !_GetMyListingsRequest_AttributesMin_Pattern.MatchString(key) {
+				err := GetMyListingsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMin[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMin[key]
+		}
+	}
+
+	if len(m.GetAttributesMax()) > 10 {
+		err := GetMyListingsRequestValidationError{
+			field:  "AttributesMax",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMax()))
+		i := 0
+		for key := range m.GetAttributesMax() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMax()[key]
+			_ = val
+
+			if !_GetMyListingsRequest_AttributesMax_Pattern.MatchString(key) {
+				err := GetMyListingsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMax[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMax[key]
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 512 {
+		err := GetMyListingsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TotalMode_name[int32(m.GetTotalMode())]; !ok {
+		err := GetMyListingsRequestValidationError{
+			field:  "TotalMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDisplayCurrency() != "" {
+
+		if !_GetMyListingsRequest_DisplayCurrency_Pattern.MatchString(m.GetDisplayCurrency()) {
+			err := GetMyListingsRequestValidationError{
+				field:  "DisplayCurrency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CategoryId != nil {
+
+		if m.GetCategoryId() <= 0 {
+			err := GetMyListingsRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMyListingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyListingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyListingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyListingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyListingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyListingsRequestMultiError) AllErrors() []error { return m }
+
+// GetMyListingsRequestValidationError is the validation error returned by
+// GetMyListingsRequest.Validate if the designated constraints aren't met.
+type GetMyListingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyListingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyListingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyListingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyListingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyListingsRequestValidationError) ErrorName() string {
+	return "GetMyListingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyListingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyListingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyListingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyListingsRequestValidationError{}
+
+var _GetMyListingsRequest_Attributes_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetMyListingsRequest_AttributesMin_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetMyListingsRequest_AttributesMax_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetMyListingsRequest_DisplayCurrency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on GetListingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListingRequestMultiError, or nil if none found.
+func (m *GetListingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetListingRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListingRequestMultiError is an error wrapping multiple validation errors
+// returned by GetListingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetListingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListingRequestMultiError) AllErrors() []error { return m }
+
+// GetListingRequestValidationError is the validation error returned by
+// GetListingRequest.Validate if the designated constraints aren't met.
+type GetListingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListingRequestValidationError) ErrorName() string {
+	return "GetListingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListingRequestValidationError{}
+
+// Validate checks the field values on UpdateListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListingRequestMultiError, or nil if none found.
+func (m *UpdateListingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateListingRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := UpdateListingRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdateListingRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListingRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListingRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListingRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTitle() != "" {
+
+		if l := utf8.RuneCountInString(m.GetTitle()); l < 5 || l > 100 {
+			err := UpdateListingRequestValidationError{
+				field:  "Title",
+				reason: "value length must be between 5 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDescription() != "" {
+
+		if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 1000 {
+			err := UpdateListingRequestValidationError{
+				field:  "Description",
+				reason: "value length must be between 10 and 1000 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetImageUrl() != "" {
+
+		if uri, err := url.Parse(m.GetImageUrl()); err != nil {
+			err = UpdateListingRequestValidationError{
+				field:  "ImageUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateListingRequestValidationError{
+				field:  "ImageUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListingRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListingRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListingRequestValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryId
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListingRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListingRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListingRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateListingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateListingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateListingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateListingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListingRequestMultiError) AllErrors() []error { return m }
+
+// UpdateListingRequestValidationError is the validation error returned by
+// UpdateListingRequest.Validate if the designated constraints aren't met.
+type UpdateListingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListingRequestValidationError) ErrorName() string {
+	return "UpdateListingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListingRequestValidationError{}
+
+// Validate checks the field values on DeleteListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteListingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteListingRequestMultiError, or nil if none found.
+func (m *DeleteListingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteListingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteListingRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteListingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteListingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteListingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteListingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteListingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteListingRequestMultiError) AllErrors() []error { return m }
+
+// DeleteListingRequestValidationError is the validation error returned by
+// DeleteListingRequest.Validate if the designated constraints aren't met.
+type DeleteListingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteListingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteListingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteListingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteListingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteListingRequestValidationError) ErrorName() string {
+	return "DeleteListingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteListingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteListingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteListingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteListingRequestValidationError{}
+
+// Validate checks the field values on DeleteListingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteListingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteListingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteListingResponseMultiError, or nil if none found.
+func (m *DeleteListingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteListingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRestoreUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteListingResponseValidationError{
+					field:  "RestoreUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteListingResponseValidationError{
+					field:  "RestoreUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRestoreUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteListingResponseValidationError{
+				field:  "RestoreUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteListingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteListingResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteListingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteListingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteListingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteListingResponseMultiError) AllErrors() []error { return m }
+
+// DeleteListingResponseValidationError is the validation error returned by
+// DeleteListingResponse.Validate if the designated constraints aren't met.
+type DeleteListingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteListingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteListingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteListingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteListingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteListingResponseValidationError) ErrorName() string {
+	return "DeleteListingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteListingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteListingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteListingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteListingResponseValidationError{}
+
+// Validate checks the field values on RestoreListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreListingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreListingRequestMultiError, or nil if none found.
+func (m *RestoreListingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreListingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RestoreListingRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreListingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreListingRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreListingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreListingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreListingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreListingRequestMultiError) AllErrors() []error { return m }
+
+// RestoreListingRequestValidationError is the validation error returned by
+// RestoreListingRequest.Validate if the designated constraints aren't met.
+type RestoreListingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreListingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreListingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreListingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreListingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreListingRequestValidationError) ErrorName() string {
+	return "RestoreListingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreListingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreListingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreListingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreListingRequestValidationError{}
+
+// Validate checks the field values on SearchListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchListingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchListingsRequestMultiError, or nil if none found.
+func (m *SearchListingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchListingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 2 || l > 100 {
+		err := SearchListingsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPage(); val <= 0 || val > 100 {
+		err := SearchListingsRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPerPage(); val <= 0 || val > 50 {
+		err := SearchListingsRequestValidationError{
+			field:  "PerPage",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchListingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchListingsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchListingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchListingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchListingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchListingsRequestMultiError) AllErrors() []error { return m }
+
+// SearchListingsRequestValidationError is the validation error returned by
+// SearchListingsRequest.Validate if the designated constraints aren't met.
+type SearchListingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchListingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchListingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchListingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchListingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchListingsRequestValidationError) ErrorName() string {
+	return "SearchListingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchListingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchListingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchListingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchListingsRequestValidationError{}
+
+// Validate checks the field values on ListingSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListingSearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListingSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListingSearchResultMultiError, or nil if none found.
+func (m *ListingSearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListingSearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetListing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingSearchResultValidationError{
+					field:  "Listing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingSearchResultValidationError{
+					field:  "Listing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingSearchResultValidationError{
+				field:  "Listing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Similarity
+
+	if len(errors) > 0 {
+		return ListingSearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingSearchResultMultiError is an error wrapping multiple validation
+// errors returned by ListingSearchResult.ValidateAll() if the designated
+// constraints aren't met.
+type ListingSearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingSearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingSearchResultMultiError) AllErrors() []error { return m }
+
+// ListingSearchResultValidationError is the validation error returned by
+// ListingSearchResult.Validate if the designated constraints aren't met.
+type ListingSearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingSearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingSearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingSearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingSearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingSearchResultValidationError) ErrorName() string {
+	return "ListingSearchResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListingSearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListingSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingSearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingSearchResultValidationError{}
+
+// Validate checks the field values on SearchListingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchListingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchListingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchListingsResponseMultiError, or nil if none found.
+func (m *SearchListingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchListingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchListingsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchListingsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchListingsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PerPage
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return SearchListingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchListingsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchListingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchListingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchListingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchListingsResponseMultiError) AllErrors() []error { return m }
+
+// SearchListingsResponseValidationError is the validation error returned by
+// SearchListingsResponse.Validate if the designated constraints aren't met.
+type SearchListingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchListingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchListingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchListingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchListingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchListingsResponseValidationError) ErrorName() string {
+	return "SearchListingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchListingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchListingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchListingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchListingsResponseValidationError{}
+
+// Validate checks the field values on GetListingFacetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListingFacetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListingFacetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListingFacetsRequestMultiError, or nil if none found.
+func (m *GetListingFacetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListingFacetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListingFacetsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListingFacetsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListingFacetsRequestValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListingFacetsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListingFacetsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListingFacetsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ListingStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetListingFacetsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := GetListingFacetsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 10 {
+		err := GetListingFacetsRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_GetListingFacetsRequest_Attributes_Pattern.MatchString(key) {
+				err := GetListingFacetsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if len(m.GetAttributesMin()) > 10 {
+		err := GetListingFacetsRequestValidationError{
+			field:  "AttributesMin",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMin()))
+		i := 0
+		for key := range m.GetAttributesMin() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMin()[key]
+			_ = val
+
+			if !_GetListingFacetsRequest_AttributesMin_Pattern.MatchString(key) {
+				err := GetListingFacetsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMin[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMin[key]
+		}
+	}
+
+	if len(m.GetAttributesMax()) > 10 {
+		err := GetListingFacetsRequestValidationError{
+			field:  "AttributesMax",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMax()))
+		i := 0
+		for key := range m.GetAttributesMax() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMax()[key]
+			_ = val
+
+			if !_GetListingFacetsRequest_AttributesMax_Pattern.MatchString(key) {
+				err := GetListingFacetsRequestValidationError{
+					field:  fmt.Sprintf("AttributesMax[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMax[key]
+		}
+	}
+
+	if len(m.GetPriceBuckets()) > 20 {
+		err := GetListingFacetsRequestValidationError{
+			field:  "PriceBuckets",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPriceBuckets() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := GetListingFacetsRequestValidationError{
+				field:  fmt.Sprintf("PriceBuckets[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCurrency() != "" {
+
+		if !_GetListingFacetsRequest_Currency_Pattern.MatchString(m.GetCurrency()) {
+			err := GetListingFacetsRequestValidationError{
+				field:  "Currency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CategoryId != nil {
+
+		if m.GetCategoryId() <= 0 {
+			err := GetListingFacetsRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListingFacetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListingFacetsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListingFacetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListingFacetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListingFacetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListingFacetsRequestMultiError) AllErrors() []error { return m }
+
+// GetListingFacetsRequestValidationError is the validation error returned by
+// GetListingFacetsRequest.Validate if the designated constraints aren't met.
+type GetListingFacetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListingFacetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListingFacetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListingFacetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListingFacetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListingFacetsRequestValidationError) ErrorName() string {
+	return "GetListingFacetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListingFacetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListingFacetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListingFacetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListingFacetsRequestValidationError{}
+
+var _GetListingFacetsRequest_Attributes_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetListingFacetsRequest_AttributesMin_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetListingFacetsRequest_AttributesMax_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _GetListingFacetsRequest_Currency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on CategoryFacet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryFacet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryFacet with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryFacetMultiError, or
+// nil if none found.
+func (m *CategoryFacet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryFacet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Name
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return CategoryFacetMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryFacetMultiError is an error wrapping multiple validation errors
+// returned by CategoryFacet.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryFacetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryFacetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryFacetMultiError) AllErrors() []error { return m }
+
+// CategoryFacetValidationError is the validation error returned by
+// CategoryFacet.Validate if the designated constraints aren't met.
+type CategoryFacetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryFacetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryFacetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryFacetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryFacetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryFacetValidationError) ErrorName() string { return "CategoryFacetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryFacetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryFacet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryFacetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryFacetValidationError{}
+
+// Validate checks the field values on StatusFacet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusFacet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusFacet with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusFacetMultiError, or
+// nil if none found.
+func (m *StatusFacet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusFacet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return StatusFacetMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusFacetMultiError is an error wrapping multiple validation errors
+// returned by StatusFacet.ValidateAll() if the designated constraints aren't met.
+type StatusFacetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusFacetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusFacetMultiError) AllErrors() []error { return m }
+
+// StatusFacetValidationError is the validation error returned by
+// StatusFacet.Validate if the designated constraints aren't met.
+type StatusFacetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusFacetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusFacetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusFacetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusFacetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusFacetValidationError) ErrorName() string { return "StatusFacetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusFacetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusFacet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusFacetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusFacetValidationError{}
+
+// Validate checks the field values on PriceBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PriceBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriceBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PriceBucketMultiError, or
+// nil if none found.
+func (m *PriceBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriceBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriceBucketValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriceBucketValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriceBucketValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriceBucketValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriceBucketValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriceBucketValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return PriceBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriceBucketMultiError is an error wrapping multiple validation errors
+// returned by PriceBucket.ValidateAll() if the designated constraints aren't met.
+type PriceBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriceBucketMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriceBucketMultiError) AllErrors() []error { return m }
+
+// PriceBucketValidationError is the validation error returned by
+// PriceBucket.Validate if the designated constraints aren't met.
+type PriceBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriceBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriceBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriceBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriceBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriceBucketValidationError) ErrorName() string { return "PriceBucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PriceBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriceBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriceBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriceBucketValidationError{}
+
+// Validate checks the field values on ListingFacetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListingFacetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListingFacetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListingFacetsResponseMultiError, or nil if none found.
+func (m *ListingFacetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListingFacetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingFacetsResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingFacetsResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingFacetsResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingFacetsResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingFacetsResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingFacetsResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPriceHistogram() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingFacetsResponseValidationError{
+						field:  fmt.Sprintf("PriceHistogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingFacetsResponseValidationError{
+						field:  fmt.Sprintf("PriceHistogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingFacetsResponseValidationError{
+					field:  fmt.Sprintf("PriceHistogram[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListingFacetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingFacetsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListingFacetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListingFacetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingFacetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingFacetsResponseMultiError) AllErrors() []error { return m }
+
+// ListingFacetsResponseValidationError is the validation error returned by
+// ListingFacetsResponse.Validate if the designated constraints aren't met.
+type ListingFacetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingFacetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingFacetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingFacetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingFacetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingFacetsResponseValidationError) ErrorName() string {
+	return "ListingFacetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListingFacetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListingFacetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingFacetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingFacetsResponseValidationError{}
+
+// Validate checks the field values on SuggestListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestListingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestListingsRequestMultiError, or nil if none found.
+func (m *SuggestListingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestListingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPrefix()); l < 1 || l > 50 {
+		err := SuggestListingsRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 20 {
+		err := SuggestListingsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 20",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuggestListingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestListingsRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestListingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestListingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestListingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestListingsRequestMultiError) AllErrors() []error { return m }
+
+// SuggestListingsRequestValidationError is the validation error returned by
+// SuggestListingsRequest.Validate if the designated constraints aren't met.
+type SuggestListingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestListingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestListingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestListingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestListingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestListingsRequestValidationError) ErrorName() string {
+	return "SuggestListingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestListingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestListingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestListingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestListingsRequestValidationError{}
+
+// Validate checks the field values on Suggestion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Suggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Suggestion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuggestionMultiError, or
+// nil if none found.
+func (m *Suggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Suggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for ListingsCount
+
+	if len(errors) > 0 {
+		return SuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestionMultiError is an error wrapping multiple validation errors
+// returned by Suggestion.ValidateAll() if the designated constraints aren't met.
+type SuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestionMultiError) AllErrors() []error { return m }
+
+// SuggestionValidationError is the validation error returned by
+// Suggestion.Validate if the designated constraints aren't met.
+type SuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestionValidationError) ErrorName() string { return "SuggestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestionValidationError{}
+
+// Validate checks the field values on SuggestListingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestListingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestListingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestListingsResponseMultiError, or nil if none found.
+func (m *SuggestListingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestListingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestListingsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestListingsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestListingsResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestListingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestListingsResponseMultiError is an error wrapping multiple validation
+// errors returned by SuggestListingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestListingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestListingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestListingsResponseMultiError) AllErrors() []error { return m }
+
+// SuggestListingsResponseValidationError is the validation error returned by
+// SuggestListingsResponse.Validate if the designated constraints aren't met.
+type SuggestListingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestListingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestListingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestListingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestListingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestListingsResponseValidationError) ErrorName() string {
+	return "SuggestListingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestListingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestListingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestListingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestListingsResponseValidationError{}
+
+// Validate checks the field values on ChangeListingStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeListingStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeListingStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeListingStatusRequestMultiError, or nil if none found.
+func (m *ChangeListingStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeListingStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ChangeListingStatusRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ChangeListingStatusRequest_Status_NotInLookup[m.GetStatus()]; ok {
+		err := ChangeListingStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must not be in list [LISTING_STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ListingStatus_name[int32(m.GetStatus())]; !ok {
+		err := ChangeListingStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangeListingStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeListingStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by ChangeListingStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeListingStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeListingStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeListingStatusRequestMultiError) AllErrors() []error { return m }
+
+// ChangeListingStatusRequestValidationError is the validation error returned
+// by ChangeListingStatusRequest.Validate if the designated constraints aren't met.
+type ChangeListingStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeListingStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeListingStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeListingStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeListingStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeListingStatusRequestValidationError) ErrorName() string {
+	return "ChangeListingStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeListingStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeListingStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeListingStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeListingStatusRequestValidationError{}
+
+var _ChangeListingStatusRequest_Status_NotInLookup = map[ListingStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AddListingImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddListingImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddListingImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddListingImagesRequestMultiError, or nil if none found.
+func (m *AddListingImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddListingImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AddListingImagesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUrls()); l < 1 || l > 10 {
+		err := AddListingImagesRequestValidationError{
+			field:  "Urls",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if uri, err := url.Parse(item); err != nil {
+			err = AddListingImagesRequestValidationError{
+				field:  fmt.Sprintf("Urls[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := AddListingImagesRequestValidationError{
+				field:  fmt.Sprintf("Urls[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddListingImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddListingImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by AddListingImagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddListingImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddListingImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddListingImagesRequestMultiError) AllErrors() []error { return m }
+
+// AddListingImagesRequestValidationError is the validation error returned by
+// AddListingImagesRequest.Validate if the designated constraints aren't met.
+type AddListingImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddListingImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddListingImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddListingImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddListingImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddListingImagesRequestValidationError) ErrorName() string {
+	return "AddListingImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddListingImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddListingImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddListingImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddListingImagesRequestValidationError{}
+
+// Validate checks the field values on RemoveListingImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveListingImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveListingImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveListingImageRequestMultiError, or nil if none found.
+func (m *RemoveListingImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveListingImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RemoveListingImageRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetImageId() <= 0 {
+		err := RemoveListingImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveListingImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveListingImageRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveListingImageRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveListingImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveListingImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveListingImageRequestMultiError) AllErrors() []error { return m }
+
+// RemoveListingImageRequestValidationError is the validation error returned by
+// RemoveListingImageRequest.Validate if the designated constraints aren't met.
+type RemoveListingImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveListingImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveListingImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveListingImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveListingImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveListingImageRequestValidationError) ErrorName() string {
+	return "RemoveListingImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveListingImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveListingImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveListingImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveListingImageRequestValidationError{}
+
+// Validate checks the field values on ReorderListingImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderListingImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderListingImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderListingImagesRequestMultiError, or nil if none found.
+func (m *ReorderListingImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderListingImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ReorderListingImagesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetImageIds()); l < 1 || l > 10 {
+		err := ReorderListingImagesRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ReorderListingImagesRequest_ImageIds_Unique := make(map[uint64]struct{}, len(m.GetImageIds()))
+
+	for idx, item := range m.GetImageIds() {
+		_, _ = idx, item
+
+		if _, exists := _ReorderListingImagesRequest_ImageIds_Unique[item]; exists {
+			err := ReorderListingImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ReorderListingImagesRequest_ImageIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for ImageIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return ReorderListingImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderListingImagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ReorderListingImagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReorderListingImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderListingImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderListingImagesRequestMultiError) AllErrors() []error { return m }
+
+// ReorderListingImagesRequestValidationError is the validation error returned
+// by ReorderListingImagesRequest.Validate if the designated constraints
+// aren't met.
+type ReorderListingImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderListingImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderListingImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderListingImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderListingImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderListingImagesRequestValidationError) ErrorName() string {
+	return "ReorderListingImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderListingImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderListingImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderListingImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderListingImagesRequestValidationError{}
+
+// Validate checks the field values on ListingImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListingImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListingImage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListingImageMultiError, or
+// nil if none found.
+func (m *ListingImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListingImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for Position
+
+	for idx, item := range m.GetVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingImageValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingImageValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingImageValidationError{
+					field:  fmt.Sprintf("Variants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListingImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingImageMultiError is an error wrapping multiple validation errors
+// returned by ListingImage.ValidateAll() if the designated constraints aren't met.
+type ListingImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingImageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingImageMultiError) AllErrors() []error { return m }
+
+// ListingImageValidationError is the validation error returned by
+// ListingImage.Validate if the designated constraints aren't met.
+type ListingImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingImageValidationError) ErrorName() string { return "ListingImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListingImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListingImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingImageValidationError{}
+
+// Validate checks the field values on ImageVariant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageVariant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageVariant with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageVariantMultiError, or
+// nil if none found.
+func (m *ImageVariant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageVariant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Url
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return ImageVariantMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageVariantMultiError is an error wrapping multiple validation errors
+// returned by ImageVariant.ValidateAll() if the designated constraints aren't met.
+type ImageVariantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageVariantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageVariantMultiError) AllErrors() []error { return m }
+
+// ImageVariantValidationError is the validation error returned by
+// ImageVariant.Validate if the designated constraints aren't met.
+type ImageVariantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageVariantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageVariantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageVariantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageVariantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageVariantValidationError) ErrorName() string { return "ImageVariantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageVariantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageVariant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageVariantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageVariantValidationError{}
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetUnits(); val < 0 || val > 99999999 {
+		err := MoneyValidationError{
+			field:  "Units",
+			reason: "value must be inside range [0, 99999999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetNanos(); val < 0 || val > 999999999 {
+		err := MoneyValidationError{
+			field:  "Nanos",
+			reason: "value must be inside range [0, 999999999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCurrencyCode() != "" {
+
+		if !_Money_CurrencyCode_Pattern.MatchString(m.GetCurrencyCode()) {
+			err := MoneyValidationError{
+				field:  "CurrencyCode",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
+var _Money_CurrencyCode_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on ListingResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListingResponseMultiError, or nil if none found.
+func (m *ListingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ImageUrl
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingResponseValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AuthorUsername
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsOwner
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetHighlight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "Highlight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "Highlight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHighlight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingResponseValidationError{
+				field:  "Highlight",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryId
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingResponseValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDisplayPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "DisplayPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListingResponseValidationError{
+					field:  "DisplayPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisplayPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListingResponseValidationError{
+				field:  "DisplayPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CoverImageUrl
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingResponseValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCoverVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingResponseValidationError{
+						field:  fmt.Sprintf("CoverVariants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingResponseValidationError{
+						field:  fmt.Sprintf("CoverVariants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingResponseValidationError{
+					field:  fmt.Sprintf("CoverVariants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsFavorite
+
+	// no validation rules for FavoritesCount
+
+	if len(errors) > 0 {
+		return ListingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingResponseMultiError is an error wrapping multiple validation errors
+// returned by ListingResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingResponseMultiError) AllErrors() []error { return m }
+
+// ListingResponseValidationError is the validation error returned by
+// ListingResponse.Validate if the designated constraints aren't met.
+type ListingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingResponseValidationError) ErrorName() string { return "ListingResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingResponseValidationError{}
+
+// Validate checks the field values on ListingHighlight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListingHighlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListingHighlight with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListingHighlightMultiError, or nil if none found.
+func (m *ListingHighlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListingHighlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return ListingHighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingHighlightMultiError is an error wrapping multiple validation errors
+// returned by ListingHighlight.ValidateAll() if the designated constraints
+// aren't met.
+type ListingHighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingHighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingHighlightMultiError) AllErrors() []error { return m }
+
+// ListingHighlightValidationError is the validation error returned by
+// ListingHighlight.Validate if the designated constraints aren't met.
+type ListingHighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingHighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingHighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingHighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingHighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingHighlightValidationError) ErrorName() string { return "ListingHighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListingHighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListingHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingHighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingHighlightValidationError{}
+
+// Validate checks the field values on ListingsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListingsResponseMultiError, or nil if none found.
+func (m *ListingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetListings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListingsResponseValidationError{
+						field:  fmt.Sprintf("Listings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListingsResponseValidationError{
+						field:  fmt.Sprintf("Listings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListingsResponseValidationError{
+					field:  fmt.Sprintf("Listings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PerPage
+
+	// no validation rules for TotalPages
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalMode
+
+	if len(errors) > 0 {
+		return ListingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListingsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingsResponseMultiError) AllErrors() []error { return m }
+
+// ListingsResponseValidationError is the validation error returned by
+// ListingsResponse.Validate if the designated constraints aren't met.
+type ListingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingsResponseValidationError) ErrorName() string { return "ListingsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingsResponseValidationError{}
+
+// Validate checks the field values on AddFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddFavoriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddFavoriteRequestMultiError, or nil if none found.
+func (m *AddFavoriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddFavoriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AddFavoriteRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAlertPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddFavoriteRequestValidationError{
+					field:  "AlertPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddFavoriteRequestValidationError{
+					field:  "AlertPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAlertPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddFavoriteRequestValidationError{
+				field:  "AlertPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddFavoriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddFavoriteRequestMultiError is an error wrapping multiple validation errors
+// returned by AddFavoriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddFavoriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddFavoriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddFavoriteRequestMultiError) AllErrors() []error { return m }
+
+// AddFavoriteRequestValidationError is the validation error returned by
+// AddFavoriteRequest.Validate if the designated constraints aren't met.
+type AddFavoriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddFavoriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddFavoriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddFavoriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddFavoriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddFavoriteRequestValidationError) ErrorName() string {
+	return "AddFavoriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddFavoriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddFavoriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddFavoriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddFavoriteRequestValidationError{}
+
+// Validate checks the field values on RemoveFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFavoriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFavoriteRequestMultiError, or nil if none found.
+func (m *RemoveFavoriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFavoriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RemoveFavoriteRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveFavoriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFavoriteRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveFavoriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveFavoriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFavoriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFavoriteRequestMultiError) AllErrors() []error { return m }
+
+// RemoveFavoriteRequestValidationError is the validation error returned by
+// RemoveFavoriteRequest.Validate if the designated constraints aren't met.
+type RemoveFavoriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFavoriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFavoriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFavoriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFavoriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFavoriteRequestValidationError) ErrorName() string {
+	return "RemoveFavoriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFavoriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFavoriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFavoriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFavoriteRequestValidationError{}
+
+// Validate checks the field values on FavoriteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FavoriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavoriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FavoriteResponseMultiError, or nil if none found.
+func (m *FavoriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavoriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ListingId
+
+	// no validation rules for IsFavorite
+
+	// no validation rules for FavoritesCount
+
+	if all {
+		switch v := interface{}(m.GetAlertPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FavoriteResponseValidationError{
+					field:  "AlertPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FavoriteResponseValidationError{
+					field:  "AlertPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAlertPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FavoriteResponseValidationError{
+				field:  "AlertPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FavoriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavoriteResponseMultiError is an error wrapping multiple validation errors
+// returned by FavoriteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FavoriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavoriteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavoriteResponseMultiError) AllErrors() []error { return m }
+
+// FavoriteResponseValidationError is the validation error returned by
+// FavoriteResponse.Validate if the designated constraints aren't met.
+type FavoriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavoriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavoriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavoriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavoriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavoriteResponseValidationError) ErrorName() string { return "FavoriteResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavoriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavoriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavoriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavoriteResponseValidationError{}
+
+// Validate checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesRequestMultiError, or nil if none found.
+func (m *ListFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() > 100 {
+		err := ListFavoritesRequestValidationError{
+			field:  "Page",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPerPage(); val <= 0 || val > 50 {
+		err := ListFavoritesRequestValidationError{
+			field:  "PerPage",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// ListFavoritesRequestValidationError is the validation error returned by
+// ListFavoritesRequest.Validate if the designated constraints aren't met.
+type ListFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesRequestValidationError) ErrorName() string {
+	return "ListFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesRequestValidationError{}
+
+// Validate checks the field values on ListFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesResponseMultiError, or nil if none found.
+func (m *ListFavoritesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetListings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Listings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Listings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFavoritesResponseValidationError{
+					field:  fmt.Sprintf("Listings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PerPage
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListFavoritesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFavoritesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFavoritesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesResponseMultiError) AllErrors() []error { return m }
+
+// ListFavoritesResponseValidationError is the validation error returned by
+// ListFavoritesResponse.Validate if the designated constraints aren't met.
+type ListFavoritesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesResponseValidationError) ErrorName() string {
+	return "ListFavoritesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesResponseValidationError{}
+
+// Validate checks the field values on SavedSearchFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearchFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavedSearchFilterMultiError, or nil if none found.
+func (m *SavedSearchFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearchFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchFilterValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchFilterValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchFilterValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchFilterValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchFilterValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchFilterValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := SavedSearchFilterValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 10 {
+		err := SavedSearchFilterValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if !_SavedSearchFilter_Attributes_Pattern.MatchString(key) {
+				err := SavedSearchFilterValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if len(m.GetAttributesMin()) > 10 {
+		err := SavedSearchFilterValidationError{
+			field:  "AttributesMin",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMin()))
+		i := 0
+		for key := range m.GetAttributesMin() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMin()[key]
+			_ = val
+
+			if !_SavedSearchFilter_AttributesMin_Pattern.MatchString(key) {
+				err := SavedSearchFilterValidationError{
+					field:  fmt.Sprintf("AttributesMin[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMin[key]
+		}
+	}
+
+	if len(m.GetAttributesMax()) > 10 {
+		err := SavedSearchFilterValidationError{
+			field:  "AttributesMax",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributesMax()))
+		i := 0
+		for key := range m.GetAttributesMax() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributesMax()[key]
+			_ = val
+
+			if !_SavedSearchFilter_AttributesMax_Pattern.MatchString(key) {
+				err := SavedSearchFilterValidationError{
+					field:  fmt.Sprintf("AttributesMax[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_]{0,49}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for AttributesMax[key]
+		}
+	}
+
+	if m.GetDisplayCurrency() != "" {
+
+		if !_SavedSearchFilter_DisplayCurrency_Pattern.MatchString(m.GetDisplayCurrency()) {
+			err := SavedSearchFilterValidationError{
+				field:  "DisplayCurrency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CategoryId != nil {
+
+		if m.GetCategoryId() <= 0 {
+			err := SavedSearchFilterValidationError{
+				field:  "CategoryId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchFilterMultiError is an error wrapping multiple validation errors
+// returned by SavedSearchFilter.ValidateAll() if the designated constraints
+// aren't met.
+type SavedSearchFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchFilterMultiError) AllErrors() []error { return m }
+
+// SavedSearchFilterValidationError is the validation error returned by
+// SavedSearchFilter.Validate if the designated constraints aren't met.
+type SavedSearchFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchFilterValidationError) ErrorName() string {
+	return "SavedSearchFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavedSearchFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearchFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchFilterValidationError{}
+
+var _SavedSearchFilter_Attributes_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _SavedSearchFilter_AttributesMin_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _SavedSearchFilter_AttributesMax_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]{0,49}$")
+
+var _SavedSearchFilter_DisplayCurrency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on CreateSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSavedSearchRequestMultiError, or nil if none found.
+func (m *CreateSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateSavedSearchRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFilter() == nil {
+		err := CreateSavedSearchRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSavedSearchRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := SearchFrequency_name[int32(m.GetFrequency())]; !ok {
+		err := CreateSavedSearchRequestValidationError{
+			field:  "Frequency",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// CreateSavedSearchRequestValidationError is the validation error returned by
+// CreateSavedSearchRequest.Validate if the designated constraints aren't met.
+type CreateSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSavedSearchRequestValidationError) ErrorName() string {
+	return "CreateSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSavedSearchRequestValidationError{}
+
+// Validate checks the field values on ListSavedSearchesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSavedSearchesRequestMultiError, or nil if none found.
+func (m *ListSavedSearchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSavedSearchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSavedSearchesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSavedSearchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchesRequestMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchesRequestValidationError is the validation error returned by
+// ListSavedSearchesRequest.Validate if the designated constraints aren't met.
+type ListSavedSearchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchesRequestValidationError) ErrorName() string {
+	return "ListSavedSearchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchesRequestValidationError{}
+
+// Validate checks the field values on ListSavedSearchesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSavedSearchesResponseMultiError, or nil if none found.
+func (m *ListSavedSearchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSavedSearches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("SavedSearches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("SavedSearches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSavedSearchesResponseValidationError{
+					field:  fmt.Sprintf("SavedSearches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSavedSearchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSavedSearchesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSavedSearchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchesResponseMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchesResponseValidationError is the validation error returned by
+// ListSavedSearchesResponse.Validate if the designated constraints aren't met.
+type ListSavedSearchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchesResponseValidationError) ErrorName() string {
+	return "ListSavedSearchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchesResponseValidationError{}
+
+// Validate checks the field values on GetSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSavedSearchRequestMultiError, or nil if none found.
+func (m *GetSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetSavedSearchRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// GetSavedSearchRequestValidationError is the validation error returned by
+// GetSavedSearchRequest.Validate if the designated constraints aren't met.
+type GetSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedSearchRequestValidationError) ErrorName() string {
+	return "GetSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedSearchRequestValidationError{}
+
+// Validate checks the field values on UpdateSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSavedSearchRequestMultiError, or nil if none found.
+func (m *UpdateSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateSavedSearchRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdateSavedSearchRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFilter() == nil {
+		err := UpdateSavedSearchRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSavedSearchRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := SearchFrequency_name[int32(m.GetFrequency())]; !ok {
+		err := UpdateSavedSearchRequestValidationError{
+			field:  "Frequency",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSavedSearchRequestValidationError is the validation error returned by
+// UpdateSavedSearchRequest.Validate if the designated constraints aren't met.
+type UpdateSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSavedSearchRequestValidationError) ErrorName() string {
+	return "UpdateSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSavedSearchRequestValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListingResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListingResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+// ValidateAll checks the field values on DeleteSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchRequestMultiError, or nil if none found.
+func (m *DeleteSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteSavedSearchRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListingResponseValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for IsOwner
+	if len(errors) > 0 {
+		return DeleteSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchRequestValidationError is the validation error returned by
+// DeleteSavedSearchRequest.Validate if the designated constraints aren't met.
+type DeleteSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchRequestValidationError) ErrorName() string {
+	return "DeleteSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchRequestValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchResponseMultiError, or nil if none found.
+func (m *DeleteSavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if len(errors) > 0 {
-		return ListingResponseMultiError(errors)
+		return DeleteSavedSearchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListingResponseMultiError is an error wrapping multiple validation errors
-// returned by ListingResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListingResponseMultiError []error
+// DeleteSavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteSavedSearchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListingResponseMultiError) Error() string {
+func (m DeleteSavedSearchResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -436,11 +7335,11 @@ func (m ListingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListingResponseMultiError) AllErrors() []error { return m }
+func (m DeleteSavedSearchResponseMultiError) AllErrors() []error { return m }
 
-// ListingResponseValidationError is the validation error returned by
-// ListingResponse.Validate if the designated constraints aren't met.
-type ListingResponseValidationError struct {
+// DeleteSavedSearchResponseValidationError is the validation error returned by
+// DeleteSavedSearchResponse.Validate if the designated constraints aren't met.
+type DeleteSavedSearchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -448,22 +7347,24 @@ type ListingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListingResponseValidationError) Field() string { return e.field }
+func (e DeleteSavedSearchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListingResponseValidationError) Reason() string { return e.reason }
+func (e DeleteSavedSearchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListingResponseValidationError) Cause() error { return e.cause }
+func (e DeleteSavedSearchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListingResponseValidationError) Key() bool { return e.key }
+func (e DeleteSavedSearchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListingResponseValidationError) ErrorName() string { return "ListingResponseValidationError" }
+func (e DeleteSavedSearchResponseValidationError) ErrorName() string {
+	return "DeleteSavedSearchResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListingResponseValidationError) Error() string {
+func (e DeleteSavedSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -475,14 +7376,14 @@ func (e ListingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListingResponse.%s: %s%s",
+		"invalid %sDeleteSavedSearchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListingResponseValidationError{}
+var _ error = DeleteSavedSearchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -490,86 +7391,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListingResponseValidationError{}
+} = DeleteSavedSearchResponseValidationError{}
 
-// Validate checks the field values on ListingsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListingsResponse) Validate() error {
+// Validate checks the field values on SavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListingsResponse with the rules
+// ValidateAll checks the field values on SavedSearchResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListingsResponseMultiError, or nil if none found.
-func (m *ListingsResponse) ValidateAll() error {
+// SavedSearchResponseMultiError, or nil if none found.
+func (m *SavedSearchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListingsResponse) validate(all bool) error {
+func (m *SavedSearchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetListings() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListingsResponseValidationError{
-						field:  fmt.Sprintf("Listings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListingsResponseValidationError{
-						field:  fmt.Sprintf("Listings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListingsResponseValidationError{
-					field:  fmt.Sprintf("Listings[%v]", idx),
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "Filter",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchResponseValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
-
-	// no validation rules for Page
+	// no validation rules for Frequency
 
-	// no validation rules for PerPage
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for TotalPages
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListingsResponseMultiError(errors)
+		return SavedSearchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListingsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListingsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListingsResponseMultiError []error
+// SavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by SavedSearchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SavedSearchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListingsResponseMultiError) Error() string {
+func (m SavedSearchResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -578,11 +7530,11 @@ func (m ListingsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListingsResponseMultiError) AllErrors() []error { return m }
+func (m SavedSearchResponseMultiError) AllErrors() []error { return m }
 
-// ListingsResponseValidationError is the validation error returned by
-// ListingsResponse.Validate if the designated constraints aren't met.
-type ListingsResponseValidationError struct {
+// SavedSearchResponseValidationError is the validation error returned by
+// SavedSearchResponse.Validate if the designated constraints aren't met.
+type SavedSearchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -590,22 +7542,24 @@ type ListingsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListingsResponseValidationError) Field() string { return e.field }
+func (e SavedSearchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListingsResponseValidationError) Reason() string { return e.reason }
+func (e SavedSearchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListingsResponseValidationError) Cause() error { return e.cause }
+func (e SavedSearchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListingsResponseValidationError) Key() bool { return e.key }
+func (e SavedSearchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListingsResponseValidationError) ErrorName() string { return "ListingsResponseValidationError" }
+func (e SavedSearchResponseValidationError) ErrorName() string {
+	return "SavedSearchResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListingsResponseValidationError) Error() string {
+func (e SavedSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -617,14 +7571,14 @@ func (e ListingsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListingsResponse.%s: %s%s",
+		"invalid %sSavedSearchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListingsResponseValidationError{}
+var _ error = SavedSearchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -632,4 +7586,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListingsResponseValidationError{}
+} = SavedSearchResponseValidationError{}
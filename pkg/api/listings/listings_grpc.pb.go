@@ -19,8 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ListingsService_CreateListing_FullMethodName = "/listings.ListingsService/CreateListing"
-	ListingsService_GetListings_FullMethodName   = "/listings.ListingsService/GetListings"
+	ListingsService_CreateListing_FullMethodName        = "/listings.ListingsService/CreateListing"
+	ListingsService_GetListings_FullMethodName          = "/listings.ListingsService/GetListings"
+	ListingsService_GetListing_FullMethodName           = "/listings.ListingsService/GetListing"
+	ListingsService_UpdateListing_FullMethodName        = "/listings.ListingsService/UpdateListing"
+	ListingsService_DeleteListing_FullMethodName        = "/listings.ListingsService/DeleteListing"
+	ListingsService_RestoreListing_FullMethodName       = "/listings.ListingsService/RestoreListing"
+	ListingsService_ChangeListingStatus_FullMethodName  = "/listings.ListingsService/ChangeListingStatus"
+	ListingsService_SearchListings_FullMethodName       = "/listings.ListingsService/SearchListings"
+	ListingsService_SuggestListings_FullMethodName      = "/listings.ListingsService/SuggestListings"
+	ListingsService_GetListingFacets_FullMethodName     = "/listings.ListingsService/GetListingFacets"
+	ListingsService_AddListingImages_FullMethodName     = "/listings.ListingsService/AddListingImages"
+	ListingsService_RemoveListingImage_FullMethodName   = "/listings.ListingsService/RemoveListingImage"
+	ListingsService_ReorderListingImages_FullMethodName = "/listings.ListingsService/ReorderListingImages"
+	ListingsService_AddFavorite_FullMethodName          = "/listings.ListingsService/AddFavorite"
+	ListingsService_RemoveFavorite_FullMethodName       = "/listings.ListingsService/RemoveFavorite"
+	ListingsService_ListFavorites_FullMethodName        = "/listings.ListingsService/ListFavorites"
+	ListingsService_CreateSavedSearch_FullMethodName    = "/listings.ListingsService/CreateSavedSearch"
+	ListingsService_ListSavedSearches_FullMethodName    = "/listings.ListingsService/ListSavedSearches"
+	ListingsService_GetSavedSearch_FullMethodName       = "/listings.ListingsService/GetSavedSearch"
+	ListingsService_UpdateSavedSearch_FullMethodName    = "/listings.ListingsService/UpdateSavedSearch"
+	ListingsService_DeleteSavedSearch_FullMethodName    = "/listings.ListingsService/DeleteSavedSearch"
+	ListingsService_GetUserListings_FullMethodName      = "/listings.ListingsService/GetUserListings"
+	ListingsService_GetMyListings_FullMethodName        = "/listings.ListingsService/GetMyListings"
 )
 
 // ListingsServiceClient is the client API for ListingsService service.
@@ -31,6 +52,48 @@ type ListingsServiceClient interface {
 	CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
 	// Получение ленты объявлений
 	GetListings(ctx context.Context, in *GetListingsRequest, opts ...grpc.CallOption) (*ListingsResponse, error)
+	// Получение объявления по ID
+	GetListing(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Редактирование объявления
+	UpdateListing(ctx context.Context, in *UpdateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Удаление объявления
+	DeleteListing(ctx context.Context, in *DeleteListingRequest, opts ...grpc.CallOption) (*DeleteListingResponse, error)
+	// Восстановление удаленного объявления
+	RestoreListing(ctx context.Context, in *RestoreListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Изменение статуса объявления
+	ChangeListingStatus(ctx context.Context, in *ChangeListingStatusRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Нечеткий поиск объявлений
+	SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error)
+	// Подсказки для строки поиска
+	SuggestListings(ctx context.Context, in *SuggestListingsRequest, opts ...grpc.CallOption) (*SuggestListingsResponse, error)
+	// Количество объявлений по категориям, статусам и диапазонам цен
+	GetListingFacets(ctx context.Context, in *GetListingFacetsRequest, opts ...grpc.CallOption) (*ListingFacetsResponse, error)
+	// Добавление изображений в галерею объявления
+	AddListingImages(ctx context.Context, in *AddListingImagesRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Удаление изображения из галереи объявления
+	RemoveListingImage(ctx context.Context, in *RemoveListingImageRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Изменение порядка изображений в галерее объявления
+	ReorderListingImages(ctx context.Context, in *ReorderListingImagesRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	// Добавление объявления в избранное
+	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	// Удаление объявления из избранного
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	// Список избранных объявлений
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error)
+	// Сохранение поиска
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	// Список сохраненных поисков
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	// Получение сохраненного поиска
+	GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	// Изменение сохраненного поиска
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	// Удаление сохраненного поиска
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	// Объявления пользователя
+	GetUserListings(ctx context.Context, in *GetUserListingsRequest, opts ...grpc.CallOption) (*ListingsResponse, error)
+	// Мои объявления
+	GetMyListings(ctx context.Context, in *GetMyListingsRequest, opts ...grpc.CallOption) (*ListingsResponse, error)
 }
 
 type listingsServiceClient struct {
@@ -61,6 +124,216 @@ func (c *listingsServiceClient) GetListings(ctx context.Context, in *GetListings
 	return out, nil
 }
 
+func (c *listingsServiceClient) GetListing(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) UpdateListing(ctx context.Context, in *UpdateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_UpdateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) DeleteListing(ctx context.Context, in *DeleteListingRequest, opts ...grpc.CallOption) (*DeleteListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_DeleteListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RestoreListing(ctx context.Context, in *RestoreListingRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_RestoreListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ChangeListingStatus(ctx context.Context, in *ChangeListingStatusRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ChangeListingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchListingsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_SearchListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) SuggestListings(ctx context.Context, in *SuggestListingsRequest, opts ...grpc.CallOption) (*SuggestListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestListingsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_SuggestListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetListingFacets(ctx context.Context, in *GetListingFacetsRequest, opts ...grpc.CallOption) (*ListingFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingFacetsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetListingFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) AddListingImages(ctx context.Context, in *AddListingImagesRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_AddListingImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RemoveListingImage(ctx context.Context, in *RemoveListingImageRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_RemoveListingImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ReorderListingImages(ctx context.Context, in *ReorderListingImagesRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ReorderListingImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, ListingsService_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, ListingsService_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, ListingsService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, ListingsService_UpdateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, ListingsService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetUserListings(ctx context.Context, in *GetUserListingsRequest, opts ...grpc.CallOption) (*ListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetUserListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetMyListings(ctx context.Context, in *GetMyListingsRequest, opts ...grpc.CallOption) (*ListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetMyListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ListingsServiceServer is the server API for ListingsService service.
 // All implementations must embed UnimplementedListingsServiceServer
 // for forward compatibility.
@@ -69,6 +342,48 @@ type ListingsServiceServer interface {
 	CreateListing(context.Context, *CreateListingRequest) (*ListingResponse, error)
 	// Получение ленты объявлений
 	GetListings(context.Context, *GetListingsRequest) (*ListingsResponse, error)
+	// Получение объявления по ID
+	GetListing(context.Context, *GetListingRequest) (*ListingResponse, error)
+	// Редактирование объявления
+	UpdateListing(context.Context, *UpdateListingRequest) (*ListingResponse, error)
+	// Удаление объявления
+	DeleteListing(context.Context, *DeleteListingRequest) (*DeleteListingResponse, error)
+	// Восстановление удаленного объявления
+	RestoreListing(context.Context, *RestoreListingRequest) (*ListingResponse, error)
+	// Изменение статуса объявления
+	ChangeListingStatus(context.Context, *ChangeListingStatusRequest) (*ListingResponse, error)
+	// Нечеткий поиск объявлений
+	SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error)
+	// Подсказки для строки поиска
+	SuggestListings(context.Context, *SuggestListingsRequest) (*SuggestListingsResponse, error)
+	// Количество объявлений по категориям, статусам и диапазонам цен
+	GetListingFacets(context.Context, *GetListingFacetsRequest) (*ListingFacetsResponse, error)
+	// Добавление изображений в галерею объявления
+	AddListingImages(context.Context, *AddListingImagesRequest) (*ListingResponse, error)
+	// Удаление изображения из галереи объявления
+	RemoveListingImage(context.Context, *RemoveListingImageRequest) (*ListingResponse, error)
+	// Изменение порядка изображений в галерее объявления
+	ReorderListingImages(context.Context, *ReorderListingImagesRequest) (*ListingResponse, error)
+	// Добавление объявления в избранное
+	AddFavorite(context.Context, *AddFavoriteRequest) (*FavoriteResponse, error)
+	// Удаление объявления из избранного
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*FavoriteResponse, error)
+	// Список избранных объявлений
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error)
+	// Сохранение поиска
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error)
+	// Список сохраненных поисков
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	// Получение сохраненного поиска
+	GetSavedSearch(context.Context, *GetSavedSearchRequest) (*SavedSearchResponse, error)
+	// Изменение сохраненного поиска
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error)
+	// Удаление сохраненного поиска
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	// Объявления пользователя
+	GetUserListings(context.Context, *GetUserListingsRequest) (*ListingsResponse, error)
+	// Мои объявления
+	GetMyListings(context.Context, *GetMyListingsRequest) (*ListingsResponse, error)
 	mustEmbedUnimplementedListingsServiceServer()
 }
 
@@ -85,6 +400,69 @@ func (UnimplementedListingsServiceServer) CreateListing(context.Context, *Create
 func (UnimplementedListingsServiceServer) GetListings(context.Context, *GetListingsRequest) (*ListingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetListings not implemented")
 }
+func (UnimplementedListingsServiceServer) GetListing(context.Context, *GetListingRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListing not implemented")
+}
+func (UnimplementedListingsServiceServer) UpdateListing(context.Context, *UpdateListingRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateListing not implemented")
+}
+func (UnimplementedListingsServiceServer) DeleteListing(context.Context, *DeleteListingRequest) (*DeleteListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteListing not implemented")
+}
+func (UnimplementedListingsServiceServer) RestoreListing(context.Context, *RestoreListingRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreListing not implemented")
+}
+func (UnimplementedListingsServiceServer) ChangeListingStatus(context.Context, *ChangeListingStatusRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeListingStatus not implemented")
+}
+func (UnimplementedListingsServiceServer) SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchListings not implemented")
+}
+func (UnimplementedListingsServiceServer) SuggestListings(context.Context, *SuggestListingsRequest) (*SuggestListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestListings not implemented")
+}
+func (UnimplementedListingsServiceServer) GetListingFacets(context.Context, *GetListingFacetsRequest) (*ListingFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListingFacets not implemented")
+}
+func (UnimplementedListingsServiceServer) AddListingImages(context.Context, *AddListingImagesRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListingImages not implemented")
+}
+func (UnimplementedListingsServiceServer) RemoveListingImage(context.Context, *RemoveListingImageRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListingImage not implemented")
+}
+func (UnimplementedListingsServiceServer) ReorderListingImages(context.Context, *ReorderListingImagesRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderListingImages not implemented")
+}
+func (UnimplementedListingsServiceServer) AddFavorite(context.Context, *AddFavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedListingsServiceServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedListingsServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedListingsServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedListingsServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedListingsServiceServer) GetSavedSearch(context.Context, *GetSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearch not implemented")
+}
+func (UnimplementedListingsServiceServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (UnimplementedListingsServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedListingsServiceServer) GetUserListings(context.Context, *GetUserListingsRequest) (*ListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserListings not implemented")
+}
+func (UnimplementedListingsServiceServer) GetMyListings(context.Context, *GetMyListingsRequest) (*ListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyListings not implemented")
+}
 func (UnimplementedListingsServiceServer) mustEmbedUnimplementedListingsServiceServer() {}
 func (UnimplementedListingsServiceServer) testEmbeddedByValue()                         {}
 
@@ -142,6 +520,384 @@ func _ListingsService_GetListings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListingsService_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetListing(ctx, req.(*GetListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_UpdateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).UpdateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_UpdateListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).UpdateListing(ctx, req.(*UpdateListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_DeleteListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).DeleteListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_DeleteListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).DeleteListing(ctx, req.(*DeleteListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RestoreListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RestoreListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RestoreListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RestoreListing(ctx, req.(*RestoreListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ChangeListingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeListingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ChangeListingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ChangeListingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ChangeListingStatus(ctx, req.(*ChangeListingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_SearchListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).SearchListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_SearchListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).SearchListings(ctx, req.(*SearchListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_SuggestListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).SuggestListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_SuggestListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).SuggestListings(ctx, req.(*SuggestListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetListingFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetListingFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetListingFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetListingFacets(ctx, req.(*GetListingFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_AddListingImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddListingImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).AddListingImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_AddListingImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).AddListingImages(ctx, req.(*AddListingImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RemoveListingImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveListingImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RemoveListingImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RemoveListingImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RemoveListingImage(ctx, req.(*RemoveListingImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ReorderListingImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderListingImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ReorderListingImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ReorderListingImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ReorderListingImages(ctx, req.(*ReorderListingImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).AddFavorite(ctx, req.(*AddFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetSavedSearch(ctx, req.(*GetSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_UpdateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetUserListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetUserListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetUserListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetUserListings(ctx, req.(*GetUserListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetMyListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetMyListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetMyListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetMyListings(ctx, req.(*GetMyListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ListingsService_ServiceDesc is the grpc.ServiceDesc for ListingsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +913,90 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetListings",
 			Handler:    _ListingsService_GetListings_Handler,
 		},
+		{
+			MethodName: "GetListing",
+			Handler:    _ListingsService_GetListing_Handler,
+		},
+		{
+			MethodName: "UpdateListing",
+			Handler:    _ListingsService_UpdateListing_Handler,
+		},
+		{
+			MethodName: "DeleteListing",
+			Handler:    _ListingsService_DeleteListing_Handler,
+		},
+		{
+			MethodName: "RestoreListing",
+			Handler:    _ListingsService_RestoreListing_Handler,
+		},
+		{
+			MethodName: "ChangeListingStatus",
+			Handler:    _ListingsService_ChangeListingStatus_Handler,
+		},
+		{
+			MethodName: "SearchListings",
+			Handler:    _ListingsService_SearchListings_Handler,
+		},
+		{
+			MethodName: "SuggestListings",
+			Handler:    _ListingsService_SuggestListings_Handler,
+		},
+		{
+			MethodName: "GetListingFacets",
+			Handler:    _ListingsService_GetListingFacets_Handler,
+		},
+		{
+			MethodName: "AddListingImages",
+			Handler:    _ListingsService_AddListingImages_Handler,
+		},
+		{
+			MethodName: "RemoveListingImage",
+			Handler:    _ListingsService_RemoveListingImage_Handler,
+		},
+		{
+			MethodName: "ReorderListingImages",
+			Handler:    _ListingsService_ReorderListingImages_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _ListingsService_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _ListingsService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _ListingsService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _ListingsService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _ListingsService_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "GetSavedSearch",
+			Handler:    _ListingsService_GetSavedSearch_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _ListingsService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _ListingsService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetUserListings",
+			Handler:    _ListingsService_GetUserListings_Handler,
+		},
+		{
+			MethodName: "GetMyListings",
+			Handler:    _ListingsService_GetMyListings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "listings/listings.proto",
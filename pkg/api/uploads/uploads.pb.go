@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: uploads/uploads.proto
+
+package api
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VariantsStatus int32
+
+const (
+	VariantsStatus_VARIANTS_STATUS_UNSPECIFIED VariantsStatus = 0
+	VariantsStatus_VARIANTS_STATUS_PENDING     VariantsStatus = 1
+	VariantsStatus_VARIANTS_STATUS_PROCESSING  VariantsStatus = 2
+	VariantsStatus_VARIANTS_STATUS_READY       VariantsStatus = 3
+	VariantsStatus_VARIANTS_STATUS_FAILED      VariantsStatus = 4
+)
+
+// Enum value maps for VariantsStatus.
+var (
+	VariantsStatus_name = map[int32]string{
+		0: "VARIANTS_STATUS_UNSPECIFIED",
+		1: "VARIANTS_STATUS_PENDING",
+		2: "VARIANTS_STATUS_PROCESSING",
+		3: "VARIANTS_STATUS_READY",
+		4: "VARIANTS_STATUS_FAILED",
+	}
+	VariantsStatus_value = map[string]int32{
+		"VARIANTS_STATUS_UNSPECIFIED": 0,
+		"VARIANTS_STATUS_PENDING":     1,
+		"VARIANTS_STATUS_PROCESSING":  2,
+		"VARIANTS_STATUS_READY":       3,
+		"VARIANTS_STATUS_FAILED":      4,
+	}
+)
+
+func (x VariantsStatus) Enum() *VariantsStatus {
+	p := new(VariantsStatus)
+	*p = x
+	return p
+}
+
+func (x VariantsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariantsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_uploads_uploads_proto_enumTypes[0].Descriptor()
+}
+
+func (VariantsStatus) Type() protoreflect.EnumType {
+	return &file_uploads_uploads_proto_enumTypes[0]
+}
+
+func (x VariantsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariantsStatus.Descriptor instead.
+func (VariantsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_uploads_uploads_proto_rawDescGZIP(), []int{0}
+}
+
+type UploadImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadImageRequest_Metadata
+	//	*UploadImageRequest_Chunk
+	Data          isUploadImageRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	mi := &file_uploads_uploads_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uploads_uploads_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_uploads_uploads_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadImageRequest) GetData() isUploadImageRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetMetadata() *UploadMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadImageRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadImageRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadImageRequest_Data interface {
+	isUploadImageRequest_Data()
+}
+
+type UploadImageRequest_Metadata struct {
+	Metadata *UploadMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadImageRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadImageRequest_Metadata) isUploadImageRequest_Data() {}
+
+func (*UploadImageRequest_Chunk) isUploadImageRequest_Data() {}
+
+type UploadMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Исходное имя файла, используется только для журналирования
+	Filename      string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMetadata) Reset() {
+	*x = UploadMetadata{}
+	mi := &file_uploads_uploads_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMetadata) ProtoMessage() {}
+
+func (x *UploadMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_uploads_uploads_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMetadata.ProtoReflect.Descriptor instead.
+func (*UploadMetadata) Descriptor() ([]byte, []int) {
+	return file_uploads_uploads_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type UploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ссылка на загруженный файл, которую можно передать в image_url и images при создании объявления
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Тип содержимого, определенный по самому файлу
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Уменьшенные копии строятся асинхронно после загрузки
+	VariantsStatus VariantsStatus `protobuf:"varint,5,opt,name=variants_status,json=variantsStatus,proto3,enum=uploads.VariantsStatus" json:"variants_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadResponse) Reset() {
+	*x = UploadResponse{}
+	mi := &file_uploads_uploads_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadResponse) ProtoMessage() {}
+
+func (x *UploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uploads_uploads_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadResponse.ProtoReflect.Descriptor instead.
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return file_uploads_uploads_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UploadResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UploadResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadResponse) GetVariantsStatus() VariantsStatus {
+	if x != nil {
+		return x.VariantsStatus
+	}
+	return VariantsStatus_VARIANTS_STATUS_UNSPECIFIED
+}
+
+var File_uploads_uploads_proto protoreflect.FileDescriptor
+
+const file_uploads_uploads_proto_rawDesc = "" +
+	"\n" +
+	"\x15uploads/uploads.proto\x12\auploads\x1a\x17validate/validate.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"v\n" +
+	"\x12UploadImageRequest\x125\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x17.uploads.UploadMetadataH\x00R\bmetadata\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xfaB\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\x06\n" +
+	"\x04data\"6\n" +
+	"\x0eUploadMetadata\x12$\n" +
+	"\bfilename\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\bfilename\"\xab\x01\n" +
+	"\x0eUploadResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x04R\x04size\x12@\n" +
+	"\x0fvariants_status\x18\x05 \x01(\x0e2\x17.uploads.VariantsStatusR\x0evariantsStatus*\xa5\x01\n" +
+	"\x0eVariantsStatus\x12\x1f\n" +
+	"\x1bVARIANTS_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17VARIANTS_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aVARIANTS_STATUS_PROCESSING\x10\x02\x12\x19\n" +
+	"\x15VARIANTS_STATUS_READY\x10\x03\x12\x1a\n" +
+	"\x16VARIANTS_STATUS_FAILED\x10\x042W\n" +
+	"\x0eUploadsService\x12E\n" +
+	"\vUploadImage\x12\x1b.uploads.UploadImageRequest\x1a\x17.uploads.UploadResponse(\x01B\xd3\x01\x92A\xa2\x01\x12i\n" +
+	"\x17Marketplace Uploads API\x12GAPI для загрузки изображений объявлений2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ+github.com/Snake1-1eyes/marketplace/pkg/apib\x06proto3"
+
+var (
+	file_uploads_uploads_proto_rawDescOnce sync.Once
+	file_uploads_uploads_proto_rawDescData []byte
+)
+
+func file_uploads_uploads_proto_rawDescGZIP() []byte {
+	file_uploads_uploads_proto_rawDescOnce.Do(func() {
+		file_uploads_uploads_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_uploads_uploads_proto_rawDesc), len(file_uploads_uploads_proto_rawDesc)))
+	})
+	return file_uploads_uploads_proto_rawDescData
+}
+
+var file_uploads_uploads_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_uploads_uploads_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_uploads_uploads_proto_goTypes = []any{
+	(VariantsStatus)(0),        // 0: uploads.VariantsStatus
+	(*UploadImageRequest)(nil), // 1: uploads.UploadImageRequest
+	(*UploadMetadata)(nil),     // 2: uploads.UploadMetadata
+	(*UploadResponse)(nil),     // 3: uploads.UploadResponse
+}
+var file_uploads_uploads_proto_depIdxs = []int32{
+	2, // 0: uploads.UploadImageRequest.metadata:type_name -> uploads.UploadMetadata
+	0, // 1: uploads.UploadResponse.variants_status:type_name -> uploads.VariantsStatus
+	1, // 2: uploads.UploadsService.UploadImage:input_type -> uploads.UploadImageRequest
+	3, // 3: uploads.UploadsService.UploadImage:output_type -> uploads.UploadResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_uploads_uploads_proto_init() }
+func file_uploads_uploads_proto_init() {
+	if File_uploads_uploads_proto != nil {
+		return
+	}
+	file_uploads_uploads_proto_msgTypes[0].OneofWrappers = []any{
+		(*UploadImageRequest_Metadata)(nil),
+		(*UploadImageRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_uploads_uploads_proto_rawDesc), len(file_uploads_uploads_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_uploads_uploads_proto_goTypes,
+		DependencyIndexes: file_uploads_uploads_proto_depIdxs,
+		EnumInfos:         file_uploads_uploads_proto_enumTypes,
+		MessageInfos:      file_uploads_uploads_proto_msgTypes,
+	}.Build()
+	File_uploads_uploads_proto = out.File
+	file_uploads_uploads_proto_goTypes = nil
+	file_uploads_uploads_proto_depIdxs = nil
+}
@@ -0,0 +1,428 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: uploads/uploads.proto
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UploadImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadImageRequestMultiError, or nil if none found.
+func (m *UploadImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *UploadImageRequest_Metadata:
+		if v == nil {
+			err := UploadImageRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadImageRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadImageRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadImageRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadImageRequest_Chunk:
+		if v == nil {
+			err := UploadImageRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if len(m.GetChunk()) > 1048576 {
+			err := UploadImageRequestValidationError{
+				field:  "Chunk",
+				reason: "value length must be at most 1048576 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadImageRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadImageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadImageRequestMultiError) AllErrors() []error { return m }
+
+// UploadImageRequestValidationError is the validation error returned by
+// UploadImageRequest.Validate if the designated constraints aren't met.
+type UploadImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadImageRequestValidationError) ErrorName() string {
+	return "UploadImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadImageRequestValidationError{}
+
+// Validate checks the field values on UploadMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadMetadataMultiError,
+// or nil if none found.
+func (m *UploadMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFilename()) > 255 {
+		err := UploadMetadataValidationError{
+			field:  "Filename",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadMetadataMultiError is an error wrapping multiple validation errors
+// returned by UploadMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type UploadMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadMetadataMultiError) AllErrors() []error { return m }
+
+// UploadMetadataValidationError is the validation error returned by
+// UploadMetadata.Validate if the designated constraints aren't met.
+type UploadMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadMetadataValidationError) ErrorName() string { return "UploadMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadMetadataValidationError{}
+
+// Validate checks the field values on UploadResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadResponseMultiError,
+// or nil if none found.
+func (m *UploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for VariantsStatus
+
+	if len(errors) > 0 {
+		return UploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadResponseMultiError is an error wrapping multiple validation errors
+// returned by UploadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadResponseMultiError) AllErrors() []error { return m }
+
+// UploadResponseValidationError is the validation error returned by
+// UploadResponse.Validate if the designated constraints aren't met.
+type UploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadResponseValidationError) ErrorName() string { return "UploadResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadResponseValidationError{}
@@ -0,0 +1,118 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: uploads/uploads.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UploadsService_UploadImage_FullMethodName = "/uploads.UploadsService/UploadImage"
+)
+
+// UploadsServiceClient is the client API for UploadsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UploadsServiceClient interface {
+	// Загрузка изображения потоком частей. Первое сообщение содержит метаданные файла,
+	// следующие — части содержимого. Через HTTP Gateway доступна как multipart-загрузка POST /v1/uploads/images
+	UploadImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadImageRequest, UploadResponse], error)
+}
+
+type uploadsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUploadsServiceClient(cc grpc.ClientConnInterface) UploadsServiceClient {
+	return &uploadsServiceClient{cc}
+}
+
+func (c *uploadsServiceClient) UploadImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadImageRequest, UploadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UploadsService_ServiceDesc.Streams[0], UploadsService_UploadImage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadImageRequest, UploadResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UploadsService_UploadImageClient = grpc.ClientStreamingClient[UploadImageRequest, UploadResponse]
+
+// UploadsServiceServer is the server API for UploadsService service.
+// All implementations must embed UnimplementedUploadsServiceServer
+// for forward compatibility.
+type UploadsServiceServer interface {
+	// Загрузка изображения потоком частей. Первое сообщение содержит метаданные файла,
+	// следующие — части содержимого. Через HTTP Gateway доступна как multipart-загрузка POST /v1/uploads/images
+	UploadImage(grpc.ClientStreamingServer[UploadImageRequest, UploadResponse]) error
+	mustEmbedUnimplementedUploadsServiceServer()
+}
+
+// UnimplementedUploadsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUploadsServiceServer struct{}
+
+func (UnimplementedUploadsServiceServer) UploadImage(grpc.ClientStreamingServer[UploadImageRequest, UploadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedUploadsServiceServer) mustEmbedUnimplementedUploadsServiceServer() {}
+func (UnimplementedUploadsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeUploadsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UploadsServiceServer will
+// result in compilation errors.
+type UnsafeUploadsServiceServer interface {
+	mustEmbedUnimplementedUploadsServiceServer()
+}
+
+func RegisterUploadsServiceServer(s grpc.ServiceRegistrar, srv UploadsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUploadsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UploadsService_ServiceDesc, srv)
+}
+
+func _UploadsService_UploadImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UploadsServiceServer).UploadImage(&grpc.GenericServerStream[UploadImageRequest, UploadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UploadsService_UploadImageServer = grpc.ClientStreamingServer[UploadImageRequest, UploadResponse]
+
+// UploadsService_ServiceDesc is the grpc.ServiceDesc for UploadsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UploadsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "uploads.UploadsService",
+	HandlerType: (*UploadsServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadImage",
+			Handler:       _UploadsService_UploadImage_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "uploads/uploads.proto",
+}
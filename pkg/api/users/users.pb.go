@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: users/users.proto
+
+package api
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_users_users_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUserProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UpdateMyProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Список обновляемых полей: display_name, avatar_url, bio, city
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Ссылка на изображение, например загруженное через POST /v1/uploads/images
+	AvatarUrl     string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Bio           string `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	City          string `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyProfileRequest) Reset() {
+	*x = UpdateMyProfileRequest{}
+	mi := &file_users_users_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyProfileRequest) ProtoMessage() {}
+
+func (x *UpdateMyProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyProfileRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateMyProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMyProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateMyProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateMyProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateMyProfileRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type UserProfile struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username    string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl   string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Bio         string                 `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	City        string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	// Дата регистрации
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActiveListingsCount uint32                 `protobuf:"varint,8,opt,name=active_listings_count,json=activeListingsCount,proto3" json:"active_listings_count,omitempty"`
+	// Средняя оценка продавца от 0 до 5
+	Rating        float64 `protobuf:"fixed64,9,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingsCount  uint32  `protobuf:"varint,10,opt,name=ratings_count,json=ratingsCount,proto3" json:"ratings_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_users_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserProfile) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserProfile) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserProfile) GetActiveListingsCount() uint32 {
+	if x != nil {
+		return x.ActiveListingsCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UserProfile) GetRatingsCount() uint32 {
+	if x != nil {
+		return x.RatingsCount
+	}
+	return 0
+}
+
+var File_users_users_proto protoreflect.FileDescriptor
+
+const file_users_users_proto_rawDesc = "" +
+	"\n" +
+	"\x11users/users.proto\x12\x05users\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"P\n" +
+	"\x15GetUserProfileRequest\x127\n" +
+	"\busername\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x03\x1822\x10^[a-zA-Z0-9_-]+$R\busername\"\xf0\x01\n" +
+	"\x16UpdateMyProfileRequest\x12E\n" +
+	"\vupdate_mask\x18\x01 \x01(\v2\x1a.google.protobuf.FieldMaskB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\x12*\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182R\vdisplayName\x12*\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\x88\x01\x01R\tavatarUrl\x12\x1a\n" +
+	"\x03bio\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x03bio\x12\x1b\n" +
+	"\x04city\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18dR\x04city\"\xcd\x02\n" +
+	"\vUserProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x10\n" +
+	"\x03bio\x18\x05 \x01(\tR\x03bio\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x122\n" +
+	"\x15active_listings_count\x18\b \x01(\rR\x13activeListingsCount\x12\x16\n" +
+	"\x06rating\x18\t \x01(\x01R\x06rating\x12#\n" +
+	"\rratings_count\x18\n" +
+	" \x01(\rR\fratingsCount2\x96\x06\n" +
+	"\fUsersService\x12\x9c\x03\n" +
+	"\x0eGetUserProfile\x12\x1c.users.GetUserProfileRequest\x1a\x12.users.UserProfile\"\xd7\x02\x92A\xb7\x02\x12'Профиль пользователя\x1a\x8b\x02Возвращает публичный профиль пользователя: отображаемое имя, аватар, описание, город, дату регистрации, количество активных объявлений и рейтинг\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/users/{username}\x12\xe6\x02\n" +
+	"\x0fUpdateMyProfile\x12\x1d.users.UpdateMyProfileRequest\x1a\x12.users.UserProfile\"\x9f\x02\x92A\x82\x02\x12+Редактирование профиля\x1a\xd2\x01Частично обновляет профиль текущего пользователя. Обновляются только поля из update_mask, пустое значение очищает поле\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/me/profileB\x8e\x02\x92A\xdd\x01\x12\xa3\x01\n" +
+	"\x15Marketplace Users API\x12\x82\x01API для просмотра и редактирования профилей пользователей маркетплейса2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ+github.com/Snake1-1eyes/marketplace/pkg/apib\x06proto3"
+
+var (
+	file_users_users_proto_rawDescOnce sync.Once
+	file_users_users_proto_rawDescData []byte
+)
+
+func file_users_users_proto_rawDescGZIP() []byte {
+	file_users_users_proto_rawDescOnce.Do(func() {
+		file_users_users_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_users_users_proto_rawDesc), len(file_users_users_proto_rawDesc)))
+	})
+	return file_users_users_proto_rawDescData
+}
+
+var file_users_users_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_users_users_proto_goTypes = []any{
+	(*GetUserProfileRequest)(nil),  // 0: users.GetUserProfileRequest
+	(*UpdateMyProfileRequest)(nil), // 1: users.UpdateMyProfileRequest
+	(*UserProfile)(nil),            // 2: users.UserProfile
+	(*fieldmaskpb.FieldMask)(nil),  // 3: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+}
+var file_users_users_proto_depIdxs = []int32{
+	3, // 0: users.UpdateMyProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4, // 1: users.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	0, // 2: users.UsersService.GetUserProfile:input_type -> users.GetUserProfileRequest
+	1, // 3: users.UsersService.UpdateMyProfile:input_type -> users.UpdateMyProfileRequest
+	2, // 4: users.UsersService.GetUserProfile:output_type -> users.UserProfile
+	2, // 5: users.UsersService.UpdateMyProfile:output_type -> users.UserProfile
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_users_users_proto_init() }
+func file_users_users_proto_init() {
+	if File_users_users_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_users_proto_rawDesc), len(file_users_users_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_users_users_proto_goTypes,
+		DependencyIndexes: file_users_users_proto_depIdxs,
+		MessageInfos:      file_users_users_proto_msgTypes,
+	}.Build()
+	File_users_users_proto = out.File
+	file_users_users_proto_goTypes = nil
+	file_users_users_proto_depIdxs = nil
+}